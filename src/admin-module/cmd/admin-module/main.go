@@ -1,7 +1,8 @@
 // Точка входа Admin Module — управляющий модуль системы Artstore.
 // Загружает конфигурацию, подключается к PostgreSQL, применяет миграции,
-// инициализирует Keycloak и SE клиенты, создаёт сервисный слой и API handlers,
-// запускает фоновые задачи (sync SE, sync SA, topologymetrics),
+// инициализирует Identity Provider (Keycloak или встроенный) и SE клиент,
+// создаёт сервисный слой и API handlers,
+// запускает фоновые задачи (sync SE, sync SA, репликация файлов, topologymetrics),
 // HTTP-сервер с JWT middleware и graceful shutdown.
 package main
 
@@ -23,6 +24,8 @@ import (
 	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
 	"github.com/bigkaa/goartstore/admin-module/internal/config"
 	"github.com/bigkaa/goartstore/admin-module/internal/database"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp/local"
 	"github.com/bigkaa/goartstore/admin-module/internal/keycloak"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
@@ -91,79 +94,225 @@ func main() {
 		logger.Info("CA-сертификат загружен", slog.String("path", cfg.CACertPath))
 	}
 
-	// 6. Keycloak Admin API клиент
-	kcClient := keycloak.New(
-		cfg.KeycloakURL,
-		cfg.KeycloakRealm,
-		cfg.KeycloakClientID,
-		cfg.KeycloakClientSecret,
-		httpClientCA, // nil — стандартный пул CA
-		cfg.KeycloakTokenRefreshThreshold,
-		cfg.KeycloakReadinessTimeout,
-		logger,
-	)
-	logger.Info("Keycloak клиент создан",
-		slog.String("url", cfg.KeycloakURL),
-		slog.String("realm", cfg.KeycloakRealm),
+	// 6. Identity Provider (AM_IDP_PROVIDER): Keycloak Admin API или встроенный IdP
+	var (
+		idpProvider idp.Provider
+		localIdP    *local.Provider
+		// idpURL — URL Keycloak или issuer локального IdP
+		idpURL = cfg.KeycloakURL
 	)
+	if cfg.IdPProvider == config.IdPProviderLocal {
+		idpURL = cfg.LocalIdPIssuer
+		localIdP, err = local.New(
+			repository.NewLocalUserRepository(pool),
+			repository.NewLocalClientRepository(pool),
+			repository.NewSigningKeyRepository(pool),
+			local.Config{
+				Issuer:            cfg.LocalIdPIssuer,
+				AccessTokenTTL:    cfg.LocalIdPTokenTTL,
+				RefreshTokenTTL:   cfg.LocalIdPRefreshTokenTTL,
+				MaxFailedLogins:   cfg.LocalIdPMaxFailedLogins,
+				LockoutDuration:   cfg.LocalIdPLockoutDuration,
+				SelfClientID:      localIdPSelfClientID,
+				SelfScopes:        strings.Fields(localIdPSelfScopes),
+				UserScopes:        strings.Fields(localIdPUserScopes),
+				BootstrapUsername: cfg.LocalIdPAdminUsername,
+				BootstrapPassword: cfg.LocalIdPAdminPassword,
+				BootstrapGroups:   cfg.RoleAdminGroups,
+
+				KeyAlgorithm:        cfg.KeysAlgorithm,
+				MasterKey:           cfg.KeysMasterKey,
+				KeyRotationInterval: cfg.KeysRotationInterval,
+				KeyOverlap:          cfg.KeysOverlap,
+				KeyPublishLead:      cfg.KeysPublishLead,
+			},
+			logger,
+		)
+		if err != nil {
+			logger.Error("Ошибка создания локального IdP", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		if err = localIdP.Start(ctx); err != nil {
+			logger.Error("Ошибка запуска локального IdP", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		defer localIdP.Stop()
+		idpProvider = localIdP
+	} else {
+		kcClient := keycloak.New(
+			cfg.KeycloakURL,
+			cfg.KeycloakRealm,
+			cfg.KeycloakClientID,
+			cfg.KeycloakClientSecret,
+			httpClientCA, // nil — стандартный пул CA
+			cfg.KeycloakTokenRefreshThreshold,
+			cfg.KeycloakReadinessTimeout,
+			logger,
+		)
+		idpProvider = keycloak.NewProvider(kcClient, logger)
+		logger.Info("Keycloak клиент создан",
+			slog.String("url", cfg.KeycloakURL),
+			slog.String("realm", cfg.KeycloakRealm),
+		)
+	}
 
 	// 7. SE HTTP-клиент
-	seClient, err := seclient.New(cfg.CACertPath, cfg.SEClientTimeout, kcClient.TokenProvider(), logger)
+	seClient, err := seclient.New(cfg.CACertPath, cfg.SEClientTimeout, idpProvider.TokenProvider(), logger)
 	if err != nil {
 		logger.Error("Ошибка создания SE-клиента", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
 
 	// 8. Repositories
-	roleRepo := repository.NewRoleOverrideRepository(pool)
+	roleOverrideRepo := repository.NewRoleOverrideRepository(pool)
+	roleRepo := repository.NewRoleRepository(pool)
 	saRepo := repository.NewServiceAccountRepository(pool)
 	seRepo := repository.NewStorageElementRepository(pool)
+	saGrantRepo := repository.NewSAGrantRepository(pool)
 	fileRepo := repository.NewFileRegistryRepository(pool)
+	replicaRepo := repository.NewFileReplicaRepository(pool)
 	syncStateRepo := repository.NewSyncStateRepository(pool)
+	syncRunRepo := repository.NewSyncRunRepository(pool)
+	syncCheckpointRepo := repository.NewSyncCheckpointRepository(pool)
+	seWriteRepo := repository.NewSEWriteRepository(pool)
+	fileBatchRepo := repository.NewFileBatchRepository(pool)
+	reconcileReportRepo := repository.NewReconcileReportRepository(pool)
+	capacityRepo := repository.NewCapacitySnapshotRepository(pool)
+	alertRuleRepo := repository.NewAlertRuleRepository(pool)
+	alertStateRepo := repository.NewAlertStateRepository(pool)
+	webhookEndpointRepo := repository.NewWebhookEndpointRepository(pool)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(pool)
+	auditRepo := repository.NewAuditEventRepository(pool)
+	personalTokenRepo := repository.NewPersonalTokenRepository(pool)
+	collectionRepo := repository.NewCollectionRepository(pool)
+	txRunner := repository.NewTxRunner(pool)
 
 	// 9. Services
-	adminUsersSvc := service.NewAdminUserService(
-		kcClient, roleRepo,
+	auditSvc := service.NewAuditService(txRunner, auditRepo, logger)
+	// Каталог ролей: встроенные admin/readonly и пользовательские роли из БД
+	roleSvc := service.NewRoleService(
+		roleRepo, auditSvc,
 		cfg.RoleAdminGroups, cfg.RoleReadonlyGroups,
 		logger,
 	)
+	if err = roleSvc.Reload(ctx); err != nil {
+		logger.Warn("Ошибка загрузки каталога ролей, используются встроенные роли",
+			slog.String("error", err.Error()),
+		)
+	}
+	collectionSvc := service.NewCollectionService(collectionRepo, auditSvc, logger)
+	adminUsersSvc := service.NewAdminUserService(
+		idpProvider, roleOverrideRepo, auditSvc, roleSvc,
+		logger,
+	)
 	serviceAcctsSvc := service.NewServiceAccountService(
-		kcClient, saRepo,
+		idpProvider, saRepo, auditSvc,
 		cfg.KeycloakSAPrefix,
+		service.SecretPolicy{
+			Lifetime:      cfg.SASecretLifetime,
+			RotationGrace: cfg.SASecretRotationGrace,
+			ExpiryWarning: cfg.SASecretExpiryWarning,
+		},
+		logger,
+	)
+	saGrantsSvc := service.NewSAGrantService(
+		idpProvider, saRepo, saGrantRepo, seRepo, auditSvc,
+		logger,
+	)
+	collectionClaimsSvc := service.NewCollectionClaimService(
+		idpProvider, saRepo, collectionRepo,
 		logger,
 	)
 	storageElemsSvc := service.NewStorageElementService(
-		seClient, seRepo, fileRepo,
+		seClient, seRepo, fileRepo, auditSvc,
+		logger,
+	)
+	seWriteSvc := service.NewSEWriteService(
+		seClient, seRepo, seWriteRepo,
+		cfg.SEWriteRetryInterval,
 		logger,
 	)
 	filesSvc := service.NewFileRegistryService(
-		fileRepo, seRepo,
+		fileRepo, seRepo, replicaRepo, seWriteRepo, seWriteSvc, auditSvc,
+		logger,
+	)
+	fileBatchSvc := service.NewFileBatchService(
+		fileBatchRepo, fileRepo, filesSvc, auditSvc,
+		logger,
+	)
+	placementSvc := service.NewPlacementService(
+		seRepo, cfg.PlacementPolicy, cfg.PlacementReservationTTL,
+		logger,
+	)
+	reconcileSvc := service.NewReconcileService(
+		seClient, seRepo, reconcileReportRepo,
+		logger,
+	)
+	fileTransferSvc := service.NewFileTransferService(
+		seClient, seRepo, filesSvc, placementSvc, collectionSvc,
 		logger,
 	)
+	capacitySvc := service.NewCapacityService(
+		capacityRepo, cfg.CapacityHistoryRetention, cfg.CapacityForecastWindow,
+		logger,
+	)
+	webhookSvc := service.NewWebhookService(
+		webhookEndpointRepo, webhookDeliveryRepo, auditSvc,
+		cfg.WebhookTimeout, cfg.WebhookMaxAttempts, cfg.WebhookDeliveryRetention,
+		logger,
+	)
+	alertSvc := service.NewAlertService(
+		alertRuleRepo, alertStateRepo, seRepo, syncRunRepo, capacitySvc, webhookSvc, auditSvc,
+		cfg.AlertEvalInterval,
+		logger,
+	)
+	alertSvc.SetSecretExpirySource(serviceAcctsSvc)
 	idpSvc := service.NewIDPService(
-		kcClient, saRepo, syncStateRepo,
-		cfg.KeycloakURL, cfg.KeycloakRealm, cfg.KeycloakSAPrefix,
+		idpProvider, saRepo, syncStateRepo,
+		idpURL, cfg.KeycloakRealm, cfg.KeycloakSAPrefix,
+		logger,
+	)
+	signingKeySvc := service.NewSigningKeyService(idpProvider, auditSvc, logger)
+	personalTokenSvc := service.NewPersonalTokenService(
+		personalTokenRepo, idpProvider, auditSvc,
+		cfg.PATMaxTTL, cfg.PATMaxPerUser,
 		logger,
 	)
 
 	// 10. Фоновые сервисы синхронизации
 	storageSyncSvc := service.NewStorageSyncService(
-		seClient, seRepo, fileRepo, syncStateRepo,
-		cfg.SyncPageSize, cfg.SyncInterval,
+		seClient, seRepo, fileRepo, replicaRepo, syncStateRepo, syncRunRepo, syncCheckpointRepo,
+		cfg.SyncPageSize, cfg.SyncInterval, cfg.SyncFullInterval,
+		logger,
+	)
+	replicationSvc := service.NewReplicationService(
+		seClient, seRepo, replicaRepo,
+		cfg.ReplicationFactor, cfg.ReplicationPolicy, cfg.ReplicationSpreadLabel, cfg.ReplicationInterval,
 		logger,
 	)
 	saSyncSvc := service.NewSASyncService(
-		kcClient, saRepo, syncStateRepo,
+		idpProvider, saRepo, syncStateRepo,
 		cfg.KeycloakSAPrefix, cfg.SASyncInterval,
 		logger,
 	)
+	saSecretExpirySvc := service.NewSASecretExpiryService(
+		serviceAcctsSvc, cfg.SASecretCheckInterval,
+		logger,
+	)
 
 	// Подключаем sync-сервисы к основным сервисам
 	storageElemsSvc.SetSyncService(storageSyncSvc)
+	storageSyncSvc.SetCapacityService(capacitySvc)
 	idpSvc.SetSASyncService(saSyncSvc)
+	saSyncSvc.SetGrantService(saGrantsSvc)
+	saSyncSvc.SetCollectionClaimService(collectionClaimsSvc)
+	collectionSvc.SetClaimService(collectionClaimsSvc)
+	if replicationSvc.Enabled() {
+		filesSvc.SetReplicationService(replicationSvc)
+	}
 
 	// 11. Начальная синхронизация SA при старте
-	logger.Info("Начальная синхронизация SA с Keycloak...")
+	logger.Info("Начальная синхронизация SA с IdP...")
 	if result, syncErr := saSyncSvc.SyncNow(ctx); syncErr != nil {
 		logger.Warn("Ошибка начальной синхронизации SA",
 			slog.String("error", syncErr.Error()),
@@ -177,47 +326,76 @@ func main() {
 		)
 	}
 
-	// 12. Readiness checkers (PostgreSQL + Keycloak)
+	// 12. Readiness checkers (PostgreSQL + IdP)
 	pgChecker := database.NewReadinessChecker(pool)
-	kcChecker, err := middleware.NewKeycloakReadinessChecker(cfg.JWTJWKSURL, cfg.CACertPath, cfg.KeycloakReadinessTimeout)
-	if err != nil {
-		logger.Error("Ошибка создания Keycloak readiness checker", slog.String("error", err.Error()))
-		os.Exit(1)
+	var idpChecker handlers.ReadinessChecker = localIdP
+	if localIdP == nil {
+		idpChecker, err = middleware.NewKeycloakReadinessChecker(cfg.JWTJWKSURL, cfg.CACertPath, cfg.KeycloakReadinessTimeout)
+		if err != nil {
+			logger.Error("Ошибка создания Keycloak readiness checker", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
 	}
-	healthHandler := handlers.NewHealthHandler(pgChecker, kcChecker)
+	healthHandler := handlers.NewHealthHandler(pgChecker, idpChecker, idpProvider.Name())
 
 	// 13. API handler (реализует generated.ServerInterface)
 	apiHandler := handlers.NewAPIHandler(
 		healthHandler,
 		adminUsersSvc,
 		serviceAcctsSvc,
+		saGrantsSvc,
 		storageElemsSvc,
+		placementSvc,
 		filesSvc,
+		fileBatchSvc,
 		idpSvc,
+		signingKeySvc,
+		personalTokenSvc,
+		roleSvc,
+		collectionSvc,
+		auditSvc,
+		alertSvc,
+		webhookSvc,
 		logger,
 	)
 
 	// 14. JWT middleware
 	// Адаптер RoleOverrideRepository → middleware.RoleOverrideProvider
-	roleProvider := &roleOverrideAdapter{repo: roleRepo}
-
-	jwtAuth, err := middleware.NewJWTAuth(
-		cfg.JWTJWKSURL,
-		cfg.CACertPath,
-		cfg.JWTIssuer,
-		roleProvider,
-		cfg.RoleAdminGroups,
-		cfg.RoleReadonlyGroups,
-		cfg.JWKSClientTimeout,
-		cfg.JWKSRefreshInterval,
-		cfg.JWTLeeway,
-		logger,
-	)
+	roleProvider := &roleOverrideAdapter{repo: roleOverrideRepo}
+
+	var jwtAuth *middleware.JWTAuth
+	if localIdP != nil {
+		// Ключи локального IdP проверяются в памяти, без запроса JWKS по HTTP
+		jwtAuth, err = middleware.NewJWTAuthFromStorage(
+			localIdP.JWKS(),
+			cfg.JWTIssuer,
+			roleProvider,
+			cfg.RoleAdminGroups,
+			cfg.RoleReadonlyGroups,
+			cfg.JWTLeeway,
+			logger,
+		)
+	} else {
+		jwtAuth, err = middleware.NewJWTAuth(
+			cfg.JWTJWKSURL,
+			cfg.CACertPath,
+			cfg.JWTIssuer,
+			roleProvider,
+			cfg.RoleAdminGroups,
+			cfg.RoleReadonlyGroups,
+			cfg.JWKSClientTimeout,
+			cfg.JWKSRefreshInterval,
+			cfg.JWTLeeway,
+			logger,
+		)
+	}
 	if err != nil {
 		logger.Error("Ошибка создания JWT middleware", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
 	defer jwtAuth.Close()
+	jwtAuth.SetPersonalTokenResolver(personalTokenSvc)
+	jwtAuth.SetRoleCatalog(roleSvc)
 	logger.Info("JWT middleware инициализирован",
 		slog.String("jwks_url", cfg.JWTJWKSURL),
 		slog.String("issuer", cfg.JWTIssuer),
@@ -226,8 +404,18 @@ func main() {
 	// 15. Запуск фоновых задач
 	storageSyncSvc.Start(ctx)
 	saSyncSvc.Start(ctx)
-
-	// 15.1 topologymetrics — мониторинг зависимостей (PostgreSQL + Keycloak)
+	saSecretExpirySvc.Start(ctx)
+	replicationSvc.Start(ctx)
+	seWriteSvc.Start(ctx)
+	fileBatchSvc.Start(ctx)
+	reconcileSvc.Start(ctx)
+	capacitySvc.Start(ctx)
+	webhookSvc.Start(ctx)
+	personalTokenSvc.Start(ctx)
+	roleSvc.Start(ctx)
+
+	// 15.1 topologymetrics — мониторинг зависимостей (PostgreSQL + Keycloak).
+	// Локальный IdP — часть Admin Module, отдельной зависимостью не является.
 	//
 	// Определение имени владельца пода для метки name:
 	// 1. DEPHEALTH_NAME (env) → использовать как есть
@@ -238,13 +426,18 @@ func main() {
 		dephealthName = resolveOwnerFromHostname(logger, "admin-module")
 	}
 
+	keycloakJWKSURL := cfg.JWTJWKSURL
+	if localIdP != nil {
+		keycloakJWKSURL = ""
+	}
+
 	var dephealthSvc *service.DephealthService
 	dephealthSvc, dephealthErr := service.NewDephealthService(
 		dephealthName,
 		cfg.DephealthGroup,
 		pgDB,
 		cfg.DatabaseURL(),
-		cfg.JWTJWKSURL,
+		keycloakJWKSURL,
 		cfg.DephealthCheckInterval,
 		cfg.TLSSkipVerify,
 		cfg.DephealthIsEntry,
@@ -269,12 +462,17 @@ func main() {
 
 			// 15.1.1 Подключаем dephealth к StorageElementService
 			storageElemsSvc.SetDephealthService(dephealthSvc)
+			placementSvc.SetDephealthService(dephealthSvc)
+			alertSvc.SetDephealthService(dephealthSvc)
 
 			// 15.1.2 Загрузка всех SE из БД как динамических endpoints
 			loadSEEndpoints(ctx, seRepo, dephealthSvc, logger)
 		}
 	}
 
+	// 15.1.3 Проверка правил оповещений — после подключения dephealth
+	alertSvc.Start(ctx)
+
 	// 15.2 i18n — интернационализация Admin UI (English + Русский)
 	i18nBundle := i18n.Init(logger)
 	if err = i18n.LoadFromEmbedFS(i18nBundle, logger); err != nil {
@@ -284,12 +482,19 @@ func main() {
 
 	// 16. Admin UI (опционально, если AM_UI_ENABLED=true)
 	var uiComponents *server.UIComponents
+	var uiSessionsSvc *service.UISessionService
 	if cfg.UIEnabled {
-		// Определяем secure cookie: true если Keycloak URL начинается с https
-		secureCookie := strings.HasPrefix(cfg.KeycloakURL, "https")
-
-		// Session Manager — шифрование/дешифрование UI-сессий (AES-256-GCM)
-		sessionMgr, sessionErr := auth.NewSessionManager(cfg.UISessionSecret, secureCookie)
+		// Определяем secure cookie: true если URL IdP начинается с https
+		secureCookie := strings.HasPrefix(idpURL, "https")
+
+		// Session Manager — серверные UI-сессии в PostgreSQL, токены шифруются AES-256-GCM
+		uiSessionRepo := repository.NewUISessionRepository(pool)
+		sessionMgr, sessionErr := auth.NewSessionManager(cfg.UISessionSecret, secureCookie, uiSessionRepo,
+			auth.SessionTimeouts{
+				Idle:     cfg.UISessionIdleTimeout,
+				Absolute: cfg.UISessionAbsoluteTimeout,
+			},
+		)
 		if sessionErr != nil {
 			logger.Error("Ошибка создания Session Manager", slog.String("error", sessionErr.Error()))
 			os.Exit(1)
@@ -299,32 +504,58 @@ func main() {
 			logger.Warn("AM_UI_SESSION_SECRET не задан, UI-сессии не сохраняются между рестартами")
 		}
 
-		// OIDC-клиент для авторизации через Keycloak (PKCE)
-		oidcClient := auth.NewOIDCClient(auth.OIDCConfig{
-			KeycloakURL:        cfg.KeycloakURL,
-			BrowserKeycloakURL: cfg.UIKeycloakURL,
-			Realm:              cfg.KeycloakRealm,
-			ClientID:           cfg.UIOIDCClientID,
-			HTTPClient:         httpClientCA,
-			Timeout:            cfg.OIDCClientTimeout,
-		})
-
-		// Auth handler — login/callback/logout
-		authHandler := uihandlers.NewAuthHandler(
-			oidcClient, sessionMgr,
-			cfg.RoleAdminGroups, cfg.RoleReadonlyGroups,
-			secureCookie,
+		// Список и завершение UI-сессий, удаление истёкших и сессий прежнего ключа
+		uiSessionsSvc = service.NewUISessionService(
+			uiSessionRepo, auditSvc,
+			sessionMgr.KeyID(), cfg.UISessionIdleTimeout,
 			logger,
 		)
+		uiSessionsSvc.Start(ctx)
+
+		// Auth handler — login/callback/logout: вход по паролю (локальный IdP)
+		// или OIDC-клиент для авторизации через Keycloak (PKCE)
+		var (
+			authHandler    *uihandlers.AuthHandler
+			tokenRefresher auth.TokenRefresher
+		)
+		if localIdP != nil {
+			uiLogin := &localUILoginAdapter{provider: localIdP}
+			authHandler = uihandlers.NewPasswordAuthHandler(
+				uiLogin, sessionMgr, roleProvider,
+				cfg.RoleAdminGroups, cfg.RoleReadonlyGroups,
+				secureCookie,
+				logger,
+			)
+			tokenRefresher = uiLogin
+		} else {
+			oidcClient := auth.NewOIDCClient(auth.OIDCConfig{
+				KeycloakURL:        cfg.KeycloakURL,
+				BrowserKeycloakURL: cfg.UIKeycloakURL,
+				Realm:              cfg.KeycloakRealm,
+				ClientID:           cfg.UIOIDCClientID,
+				HTTPClient:         httpClientCA,
+				Timeout:            cfg.OIDCClientTimeout,
+			})
+			authHandler = uihandlers.NewAuthHandler(
+				oidcClient, sessionMgr, roleProvider,
+				cfg.RoleAdminGroups, cfg.RoleReadonlyGroups,
+				secureCookie,
+				logger,
+			)
+			tokenRefresher = oidcClient
+		}
+		authHandler.SetRoleCatalog(roleSvc)
 
-		// UI auth middleware — проверка сессии, авто-refresh токенов
-		uiAuthMiddleware := uimiddleware.NewUIAuth(sessionMgr, oidcClient, logger)
+		// UI auth middleware — проверка сессии, авто-refresh токенов,
+		// разрешения пользователя по каталогу ролей
+		uiAuthMiddleware := uimiddleware.NewUIAuth(sessionMgr, tokenRefresher, roleSvc, roleProvider, logger)
 
 		// Dashboard handler — страница Dashboard с реальными данными
 		dashboardHandler := uihandlers.NewDashboardHandler(
 			storageElemsSvc,
 			filesSvc,
 			serviceAcctsSvc,
+			capacitySvc,
 			dephealthSvc, // может быть nil — Dashboard обработает gracefully
 			logger,
 		)
@@ -333,27 +564,38 @@ func main() {
 		storageElementsHandler := uihandlers.NewStorageElementsHandler(
 			storageElemsSvc,
 			filesSvc,
+			capacitySvc,
+			reconcileSvc,
 			logger,
 		)
 
 		// Files handler — файловый реестр
 		filesHandler := uihandlers.NewFilesHandler(
 			filesSvc,
+			fileBatchSvc,
+			fileTransferSvc,
 			storageElemsSvc,
+			collectionSvc,
 			logger,
 		)
 
-		// Access handler — управление доступом (пользователи + SA)
+		// Access handler — управление доступом (пользователи, SA, роли)
 		accessHandler := uihandlers.NewAccessHandler(
 			adminUsersSvc,
 			serviceAcctsSvc,
 			idpSvc,
+			uiSessionsSvc,
+			roleSvc,
+			collectionSvc,
 			logger,
 		)
 
+		// Profile handler — профиль пользователя и personal access tokens
+		profileHandler := uihandlers.NewProfileHandler(personalTokenSvc, logger)
+
 		// UI Settings Service (настройки Admin UI, Prometheus конфигурация)
 		uiSettingsRepo := repository.NewUISettingsRepository(pool)
-		uiSettingsSvc := service.NewUISettingsService(uiSettingsRepo, logger)
+		uiSettingsSvc := service.NewUISettingsService(uiSettingsRepo, auditSvc, logger)
 
 		// Prometheus-клиент для UI (опциональные графики latency)
 		promClient := uiprometheus.New(uiSettingsSvc, cfg.PrometheusClientTimeout, logger)
@@ -369,13 +611,19 @@ func main() {
 			logger,
 		)
 
-		// Settings handler — страница настроек (admin only)
+		// Settings handler — страница настроек (разрешение settings:write)
 		settingsHandler := uihandlers.NewSettingsHandler(
 			uiSettingsSvc,
 			promClient,
+			alertSvc,
+			webhookSvc,
+			storageElemsSvc,
 			logger,
 		)
 
+		// Audit handler — журнал аудита (разрешение audit:read)
+		auditHandler := uihandlers.NewAuditHandler(auditSvc, logger)
+
 		// Events handler — SSE endpoints для real-time обновлений
 		eventsHandler := uihandlers.NewEventsHandler(
 			storageElemsSvc,
@@ -391,12 +639,15 @@ func main() {
 			StorageElementsHandler: storageElementsHandler,
 			FilesHandler:           filesHandler,
 			AccessHandler:          accessHandler,
+			ProfileHandler:         profileHandler,
 			MonitoringHandler:      monitoringHandler,
 			SettingsHandler:        settingsHandler,
+			AuditHandler:           auditHandler,
 			EventsHandler:          eventsHandler,
 		}
 
 		logger.Info("Admin UI инициализирован",
+			slog.String("idp_provider", idpProvider.Name()),
 			slog.String("oidc_client_id", cfg.UIOIDCClientID),
 			slog.Bool("secure_cookie", secureCookie),
 		)
@@ -405,7 +656,7 @@ func main() {
 	}
 
 	// 17. Создание и запуск HTTP-сервера
-	srv := server.New(cfg, logger, apiHandler, jwtAuth, uiComponents)
+	srv := server.New(cfg, logger, apiHandler, jwtAuth, localIdP, uiComponents)
 	if err = srv.Run(); err != nil {
 		logger.Error("Ошибка сервера", slog.String("error", err.Error()))
 		os.Exit(1)
@@ -419,6 +670,19 @@ func main() {
 	}
 	storageSyncSvc.Stop()
 	saSyncSvc.Stop()
+	saSecretExpirySvc.Stop()
+	replicationSvc.Stop()
+	fileBatchSvc.Stop() // до seWriteSvc: операции откладывают записи на SE в outbox
+	seWriteSvc.Stop()
+	reconcileSvc.Stop()
+	capacitySvc.Stop()
+	alertSvc.Stop()
+	webhookSvc.Stop()
+	personalTokenSvc.Stop()
+	roleSvc.Stop()
+	if uiSessionsSvc != nil {
+		uiSessionsSvc.Stop()
+	}
 
 	logger.Info("Admin Module остановлен")
 }
@@ -435,7 +699,7 @@ func loadSEEndpoints(
 	logger *slog.Logger,
 ) {
 	// Загружаем все SE (limit 10000 — достаточно для любого реального развёртывания)
-	seList, err := seRepo.List(ctx, nil, nil, 10000, 0)
+	seList, err := seRepo.List(ctx, repository.StorageElementFilters{}, 10000, 0)
 	if err != nil {
 		logger.Warn("Не удалось загрузить SE для dephealth",
 			slog.String("error", err.Error()),
@@ -470,6 +734,50 @@ func loadSEEndpoints(
 
 // --- Вспомогательные типы ---
 
+// Параметры токенов локального IdP.
+const (
+	// localIdPSelfClientID — client_id токенов самого Admin Module (запросы к SE)
+	localIdPSelfClientID = "artstore-admin-module"
+	// localIdPSelfScopes — scopes токенов Admin Module
+	localIdPSelfScopes = "files:read files:write storage:read storage:write admin:read admin:write"
+	// localIdPUserScopes — scopes токенов пользователей Admin UI
+	localIdPUserScopes = "openid profile email groups files:read files:write"
+)
+
+// localUILoginAdapter — адаптер локального IdP → auth.PasswordAuthenticator
+// и auth.TokenRefresher для Admin UI.
+type localUILoginAdapter struct {
+	provider *local.Provider
+}
+
+// PasswordLogin выполняет вход по имени пользователя и паролю.
+func (a *localUILoginAdapter) PasswordLogin(ctx context.Context, username, password string) (*auth.TokenResponse, error) {
+	tokens, err := a.provider.PasswordLogin(ctx, username, password)
+	if err != nil {
+		return nil, err
+	}
+	return uiTokenResponse(tokens), nil
+}
+
+// RefreshTokens обновляет токены UI-сессии.
+func (a *localUILoginAdapter) RefreshTokens(refreshToken string) (*auth.TokenResponse, error) {
+	tokens, err := a.provider.Refresh(context.Background(), refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return uiTokenResponse(tokens), nil
+}
+
+// uiTokenResponse преобразует токены локального IdP в auth.TokenResponse.
+func uiTokenResponse(t *local.UserTokens) *auth.TokenResponse {
+	return &auth.TokenResponse{
+		AccessToken:  t.AccessToken,
+		RefreshToken: t.RefreshToken,
+		TokenType:    t.TokenType,
+		ExpiresIn:    t.ExpiresIn,
+	}
+}
+
 // roleOverrideAdapter — адаптер RoleOverrideRepository → middleware.RoleOverrideProvider.
 // Преобразует *model.RoleOverride в *string (additional_role).
 type roleOverrideAdapter struct {
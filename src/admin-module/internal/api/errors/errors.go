@@ -15,6 +15,7 @@ const (
 	CodeUnauthorized    = "UNAUTHORIZED"
 	CodeForbidden       = "FORBIDDEN"
 	CodeConflict        = "CONFLICT"
+	CodeQuotaExceeded   = "QUOTA_EXCEEDED"
 	CodeSEUnavailable   = "SE_UNAVAILABLE"
 	CodeIDPUnavailable  = "IDP_UNAVAILABLE"
 	CodeInternalError   = "INTERNAL_ERROR"
@@ -71,6 +72,11 @@ func Conflict(w http.ResponseWriter, message string) {
 	WriteError(w, http.StatusConflict, CodeConflict, message)
 }
 
+// QuotaExceeded — 403 превышена квота коллекции.
+func QuotaExceeded(w http.ResponseWriter, message string) {
+	WriteError(w, http.StatusForbidden, CodeQuotaExceeded, message)
+}
+
 // SEUnavailable — 502 Storage Element недоступен.
 func SEUnavailable(w http.ResponseWriter, message string) {
 	WriteError(w, http.StatusBadGateway, CodeSEUnavailable, message)
@@ -17,6 +17,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
@@ -27,6 +28,9 @@ type ServerInterface interface {
 	// Список пользователей
 	// (GET /api/v1/admin-users)
 	ListAdminUsers(w http.ResponseWriter, r *http.Request, params ListAdminUsersParams)
+	// Создать пользователя (локальный IdP)
+	// (POST /api/v1/admin-users)
+	CreateAdminUser(w http.ResponseWriter, r *http.Request)
 	// Удалить локальные дополнения пользователя
 	// (DELETE /api/v1/admin-users/{id})
 	DeleteAdminUser(w http.ResponseWriter, r *http.Request, id UserId)
@@ -36,15 +40,81 @@ type ServerInterface interface {
 	// Обновить локальные дополнения пользователя
 	// (PUT /api/v1/admin-users/{id})
 	UpdateAdminUser(w http.ResponseWriter, r *http.Request, id UserId)
+	// Удалить учётную запись (локальный IdP)
+	// (DELETE /api/v1/admin-users/{id}/account)
+	DeleteAdminUserAccount(w http.ResponseWriter, r *http.Request, id UserId)
+	// Изменить учётную запись (локальный IdP)
+	// (PUT /api/v1/admin-users/{id}/account)
+	UpdateAdminUserAccount(w http.ResponseWriter, r *http.Request, id UserId)
+	// Установить пароль (локальный IdP)
+	// (PUT /api/v1/admin-users/{id}/password)
+	SetAdminUserPassword(w http.ResponseWriter, r *http.Request, id UserId)
 	// Установить/изменить локальное дополнение роли
 	// (POST /api/v1/admin-users/{id}/role-override)
 	SetRoleOverride(w http.ResponseWriter, r *http.Request, id UserId)
+	// Список правил оповещений
+	// (GET /api/v1/alert-rules)
+	ListAlertRules(w http.ResponseWriter, r *http.Request)
+	// Создание правила оповещения
+	// (POST /api/v1/alert-rules)
+	CreateAlertRule(w http.ResponseWriter, r *http.Request)
+	// Удаление правила оповещения
+	// (DELETE /api/v1/alert-rules/{id})
+	DeleteAlertRule(w http.ResponseWriter, r *http.Request, id AlertRuleId)
+	// Правило оповещения
+	// (GET /api/v1/alert-rules/{id})
+	GetAlertRule(w http.ResponseWriter, r *http.Request, id AlertRuleId)
+	// Замена правила оповещения
+	// (PUT /api/v1/alert-rules/{id})
+	UpdateAlertRule(w http.ResponseWriter, r *http.Request, id AlertRuleId)
+	// Сработавшие оповещения
+	// (GET /api/v1/alerts)
+	ListAlerts(w http.ResponseWriter, r *http.Request)
+	// Журнал аудита
+	// (GET /api/v1/audit-events)
+	ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams)
+	// Список коллекций
+	// (GET /api/v1/collections)
+	ListCollections(w http.ResponseWriter, r *http.Request)
+	// Создать коллекцию
+	// (POST /api/v1/collections)
+	CreateCollection(w http.ResponseWriter, r *http.Request)
+	// Удалить коллекцию
+	// (DELETE /api/v1/collections/{id})
+	DeleteCollection(w http.ResponseWriter, r *http.Request, id CollectionId)
+	// Получить коллекцию
+	// (GET /api/v1/collections/{id})
+	GetCollection(w http.ResponseWriter, r *http.Request, id CollectionId)
+	// Изменить коллекцию
+	// (PUT /api/v1/collections/{id})
+	UpdateCollection(w http.ResponseWriter, r *http.Request, id CollectionId)
+	// Участники коллекции
+	// (GET /api/v1/collections/{id}/members)
+	ListCollectionMembers(w http.ResponseWriter, r *http.Request, id CollectionId)
+	// Добавить участника или изменить его права
+	// (PUT /api/v1/collections/{id}/members)
+	SetCollectionMember(w http.ResponseWriter, r *http.Request, id CollectionId)
+	// Удалить участника коллекции
+	// (DELETE /api/v1/collections/{id}/members/{subject_type}/{subject_id})
+	RemoveCollectionMember(w http.ResponseWriter, r *http.Request, id CollectionId, subjectType RemoveCollectionMemberParamsSubjectType, subjectId string)
 	// Список файлов
 	// (GET /api/v1/files)
 	ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams)
 	// Регистрация файла
 	// (POST /api/v1/files)
 	RegisterFile(w http.ResponseWriter, r *http.Request)
+	// Групповая операция над файлами
+	// (POST /api/v1/files/batch)
+	CreateFileBatch(w http.ResponseWriter, r *http.Request)
+	// Состояние групповой операции
+	// (GET /api/v1/files/batch/{id})
+	GetFileBatch(w http.ResponseWriter, r *http.Request, id FileBatchId)
+	// Отмена групповой операции
+	// (POST /api/v1/files/batch/{id}/cancel)
+	CancelFileBatch(w http.ResponseWriter, r *http.Request, id FileBatchId)
+	// Результаты групповой операции по файлам
+	// (GET /api/v1/files/batch/{id}/results)
+	ListFileBatchResults(w http.ResponseWriter, r *http.Request, id FileBatchId, params ListFileBatchResultsParams)
 	// Soft delete файла
 	// (DELETE /api/v1/files/{file_id})
 	DeleteFile(w http.ResponseWriter, r *http.Request, fileId FileId)
@@ -60,6 +130,39 @@ type ServerInterface interface {
 	// Принудительная синхронизация SA
 	// (POST /api/v1/idp/sync-sa)
 	SyncServiceAccounts(w http.ResponseWriter, r *http.Request)
+	// Ротация ключа подписи JWT
+	// (POST /api/v1/keys/rotate)
+	RotateSigningKey(w http.ResponseWriter, r *http.Request)
+	// Состояние ключей подписи JWT
+	// (GET /api/v1/keys/status)
+	GetSigningKeysStatus(w http.ResponseWriter, r *http.Request)
+	// Каталог разрешений
+	// (GET /api/v1/permissions)
+	ListPermissions(w http.ResponseWriter, r *http.Request)
+	// Список personal access tokens
+	// (GET /api/v1/personal-tokens)
+	ListPersonalTokens(w http.ResponseWriter, r *http.Request, params ListPersonalTokensParams)
+	// Создать personal access token
+	// (POST /api/v1/personal-tokens)
+	CreatePersonalToken(w http.ResponseWriter, r *http.Request)
+	// Отозвать personal access token
+	// (DELETE /api/v1/personal-tokens/{id})
+	RevokePersonalToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Список ролей
+	// (GET /api/v1/roles)
+	ListRoles(w http.ResponseWriter, r *http.Request)
+	// Создать пользовательскую роль
+	// (POST /api/v1/roles)
+	CreateRole(w http.ResponseWriter, r *http.Request)
+	// Удалить пользовательскую роль
+	// (DELETE /api/v1/roles/{name})
+	DeleteRole(w http.ResponseWriter, r *http.Request, name RoleName)
+	// Получить роль
+	// (GET /api/v1/roles/{name})
+	GetRole(w http.ResponseWriter, r *http.Request, name RoleName)
+	// Изменить пользовательскую роль
+	// (PUT /api/v1/roles/{name})
+	UpdateRole(w http.ResponseWriter, r *http.Request, name RoleName)
 	// Список сервисных аккаунтов
 	// (GET /api/v1/service-accounts)
 	ListServiceAccounts(w http.ResponseWriter, r *http.Request, params ListServiceAccountsParams)
@@ -75,6 +178,12 @@ type ServerInterface interface {
 	// Обновить сервисный аккаунт
 	// (PUT /api/v1/service-accounts/{id})
 	UpdateServiceAccount(w http.ResponseWriter, r *http.Request, id ServiceAccountId)
+	// Ограничения scopes SA по ресурсам
+	// (GET /api/v1/service-accounts/{id}/grants)
+	GetServiceAccountGrants(w http.ResponseWriter, r *http.Request, id ServiceAccountId)
+	// Заменить ограничения scopes SA по ресурсам
+	// (PUT /api/v1/service-accounts/{id}/grants)
+	ReplaceServiceAccountGrants(w http.ResponseWriter, r *http.Request, id ServiceAccountId)
 	// Ротация secret
 	// (POST /api/v1/service-accounts/{id}/rotate-secret)
 	RotateSecret(w http.ResponseWriter, r *http.Request, id ServiceAccountId)
@@ -87,6 +196,12 @@ type ServerInterface interface {
 	// Предпросмотр Storage Element
 	// (POST /api/v1/storage-elements/discover)
 	DiscoverStorageElement(w http.ResponseWriter, r *http.Request)
+	// Освобождение резервирования места на SE
+	// (DELETE /api/v1/storage-elements/reservations/{reservation_id})
+	ReleaseStorageElementReservation(w http.ResponseWriter, r *http.Request, reservationId ReservationId)
+	// Выбор Storage Element для загрузки
+	// (POST /api/v1/storage-elements/select)
+	SelectStorageElement(w http.ResponseWriter, r *http.Request)
 	// Удалить Storage Element
 	// (DELETE /api/v1/storage-elements/{id})
 	DeleteStorageElement(w http.ResponseWriter, r *http.Request, id StorageElementId)
@@ -99,6 +214,36 @@ type ServerInterface interface {
 	// Синхронизация Storage Element
 	// (POST /api/v1/storage-elements/{id}/sync)
 	SyncStorageElement(w http.ResponseWriter, r *http.Request, id StorageElementId)
+	// История задач синхронизации
+	// (GET /api/v1/sync-jobs)
+	ListSyncJobs(w http.ResponseWriter, r *http.Request, params ListSyncJobsParams)
+	// Состояние задачи синхронизации
+	// (GET /api/v1/sync-jobs/{id})
+	GetSyncJob(w http.ResponseWriter, r *http.Request, id SyncJobId)
+	// Отмена задачи синхронизации
+	// (POST /api/v1/sync-jobs/{id}/cancel)
+	CancelSyncJob(w http.ResponseWriter, r *http.Request, id SyncJobId)
+	// Список webhook endpoints
+	// (GET /api/v1/webhooks)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	// Создание webhook endpoint
+	// (POST /api/v1/webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	// Удаление webhook endpoint
+	// (DELETE /api/v1/webhooks/{id})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, id WebhookId)
+	// Webhook endpoint
+	// (GET /api/v1/webhooks/{id})
+	GetWebhook(w http.ResponseWriter, r *http.Request, id WebhookId)
+	// Обновление webhook endpoint
+	// (PUT /api/v1/webhooks/{id})
+	UpdateWebhook(w http.ResponseWriter, r *http.Request, id WebhookId)
+	// Журнал доставок webhook
+	// (GET /api/v1/webhooks/{id}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id WebhookId, params ListWebhookDeliveriesParams)
+	// Тестовая доставка
+	// (POST /api/v1/webhooks/{id}/test)
+	TestWebhook(w http.ResponseWriter, r *http.Request, id WebhookId)
 	// Liveness probe
 	// (GET /health/live)
 	HealthLive(w http.ResponseWriter, r *http.Request)
@@ -126,6 +271,12 @@ func (_ Unimplemented) ListAdminUsers(w http.ResponseWriter, r *http.Request, pa
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Создать пользователя (локальный IdP)
+// (POST /api/v1/admin-users)
+func (_ Unimplemented) CreateAdminUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Удалить локальные дополнения пользователя
 // (DELETE /api/v1/admin-users/{id})
 func (_ Unimplemented) DeleteAdminUser(w http.ResponseWriter, r *http.Request, id UserId) {
@@ -144,12 +295,120 @@ func (_ Unimplemented) UpdateAdminUser(w http.ResponseWriter, r *http.Request, i
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Удалить учётную запись (локальный IdP)
+// (DELETE /api/v1/admin-users/{id}/account)
+func (_ Unimplemented) DeleteAdminUserAccount(w http.ResponseWriter, r *http.Request, id UserId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить учётную запись (локальный IdP)
+// (PUT /api/v1/admin-users/{id}/account)
+func (_ Unimplemented) UpdateAdminUserAccount(w http.ResponseWriter, r *http.Request, id UserId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить пароль (локальный IdP)
+// (PUT /api/v1/admin-users/{id}/password)
+func (_ Unimplemented) SetAdminUserPassword(w http.ResponseWriter, r *http.Request, id UserId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Установить/изменить локальное дополнение роли
 // (POST /api/v1/admin-users/{id}/role-override)
 func (_ Unimplemented) SetRoleOverride(w http.ResponseWriter, r *http.Request, id UserId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Список правил оповещений
+// (GET /api/v1/alert-rules)
+func (_ Unimplemented) ListAlertRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создание правила оповещения
+// (POST /api/v1/alert-rules)
+func (_ Unimplemented) CreateAlertRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаление правила оповещения
+// (DELETE /api/v1/alert-rules/{id})
+func (_ Unimplemented) DeleteAlertRule(w http.ResponseWriter, r *http.Request, id AlertRuleId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Правило оповещения
+// (GET /api/v1/alert-rules/{id})
+func (_ Unimplemented) GetAlertRule(w http.ResponseWriter, r *http.Request, id AlertRuleId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Замена правила оповещения
+// (PUT /api/v1/alert-rules/{id})
+func (_ Unimplemented) UpdateAlertRule(w http.ResponseWriter, r *http.Request, id AlertRuleId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сработавшие оповещения
+// (GET /api/v1/alerts)
+func (_ Unimplemented) ListAlerts(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал аудита
+// (GET /api/v1/audit-events)
+func (_ Unimplemented) ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список коллекций
+// (GET /api/v1/collections)
+func (_ Unimplemented) ListCollections(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать коллекцию
+// (POST /api/v1/collections)
+func (_ Unimplemented) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить коллекцию
+// (DELETE /api/v1/collections/{id})
+func (_ Unimplemented) DeleteCollection(w http.ResponseWriter, r *http.Request, id CollectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить коллекцию
+// (GET /api/v1/collections/{id})
+func (_ Unimplemented) GetCollection(w http.ResponseWriter, r *http.Request, id CollectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить коллекцию
+// (PUT /api/v1/collections/{id})
+func (_ Unimplemented) UpdateCollection(w http.ResponseWriter, r *http.Request, id CollectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Участники коллекции
+// (GET /api/v1/collections/{id}/members)
+func (_ Unimplemented) ListCollectionMembers(w http.ResponseWriter, r *http.Request, id CollectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить участника или изменить его права
+// (PUT /api/v1/collections/{id}/members)
+func (_ Unimplemented) SetCollectionMember(w http.ResponseWriter, r *http.Request, id CollectionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить участника коллекции
+// (DELETE /api/v1/collections/{id}/members/{subject_type}/{subject_id})
+func (_ Unimplemented) RemoveCollectionMember(w http.ResponseWriter, r *http.Request, id CollectionId, subjectType RemoveCollectionMemberParamsSubjectType, subjectId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Список файлов
 // (GET /api/v1/files)
 func (_ Unimplemented) ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams) {
@@ -162,6 +421,30 @@ func (_ Unimplemented) RegisterFile(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Групповая операция над файлами
+// (POST /api/v1/files/batch)
+func (_ Unimplemented) CreateFileBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Состояние групповой операции
+// (GET /api/v1/files/batch/{id})
+func (_ Unimplemented) GetFileBatch(w http.ResponseWriter, r *http.Request, id FileBatchId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отмена групповой операции
+// (POST /api/v1/files/batch/{id}/cancel)
+func (_ Unimplemented) CancelFileBatch(w http.ResponseWriter, r *http.Request, id FileBatchId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Результаты групповой операции по файлам
+// (GET /api/v1/files/batch/{id}/results)
+func (_ Unimplemented) ListFileBatchResults(w http.ResponseWriter, r *http.Request, id FileBatchId, params ListFileBatchResultsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Soft delete файла
 // (DELETE /api/v1/files/{file_id})
 func (_ Unimplemented) DeleteFile(w http.ResponseWriter, r *http.Request, fileId FileId) {
@@ -192,6 +475,72 @@ func (_ Unimplemented) SyncServiceAccounts(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Ротация ключа подписи JWT
+// (POST /api/v1/keys/rotate)
+func (_ Unimplemented) RotateSigningKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Состояние ключей подписи JWT
+// (GET /api/v1/keys/status)
+func (_ Unimplemented) GetSigningKeysStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Каталог разрешений
+// (GET /api/v1/permissions)
+func (_ Unimplemented) ListPermissions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список personal access tokens
+// (GET /api/v1/personal-tokens)
+func (_ Unimplemented) ListPersonalTokens(w http.ResponseWriter, r *http.Request, params ListPersonalTokensParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать personal access token
+// (POST /api/v1/personal-tokens)
+func (_ Unimplemented) CreatePersonalToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать personal access token
+// (DELETE /api/v1/personal-tokens/{id})
+func (_ Unimplemented) RevokePersonalToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список ролей
+// (GET /api/v1/roles)
+func (_ Unimplemented) ListRoles(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать пользовательскую роль
+// (POST /api/v1/roles)
+func (_ Unimplemented) CreateRole(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить пользовательскую роль
+// (DELETE /api/v1/roles/{name})
+func (_ Unimplemented) DeleteRole(w http.ResponseWriter, r *http.Request, name RoleName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить роль
+// (GET /api/v1/roles/{name})
+func (_ Unimplemented) GetRole(w http.ResponseWriter, r *http.Request, name RoleName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить пользовательскую роль
+// (PUT /api/v1/roles/{name})
+func (_ Unimplemented) UpdateRole(w http.ResponseWriter, r *http.Request, name RoleName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Список сервисных аккаунтов
 // (GET /api/v1/service-accounts)
 func (_ Unimplemented) ListServiceAccounts(w http.ResponseWriter, r *http.Request, params ListServiceAccountsParams) {
@@ -222,6 +571,18 @@ func (_ Unimplemented) UpdateServiceAccount(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Ограничения scopes SA по ресурсам
+// (GET /api/v1/service-accounts/{id}/grants)
+func (_ Unimplemented) GetServiceAccountGrants(w http.ResponseWriter, r *http.Request, id ServiceAccountId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить ограничения scopes SA по ресурсам
+// (PUT /api/v1/service-accounts/{id}/grants)
+func (_ Unimplemented) ReplaceServiceAccountGrants(w http.ResponseWriter, r *http.Request, id ServiceAccountId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Ротация secret
 // (POST /api/v1/service-accounts/{id}/rotate-secret)
 func (_ Unimplemented) RotateSecret(w http.ResponseWriter, r *http.Request, id ServiceAccountId) {
@@ -246,6 +607,18 @@ func (_ Unimplemented) DiscoverStorageElement(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Освобождение резервирования места на SE
+// (DELETE /api/v1/storage-elements/reservations/{reservation_id})
+func (_ Unimplemented) ReleaseStorageElementReservation(w http.ResponseWriter, r *http.Request, reservationId ReservationId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выбор Storage Element для загрузки
+// (POST /api/v1/storage-elements/select)
+func (_ Unimplemented) SelectStorageElement(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Удалить Storage Element
 // (DELETE /api/v1/storage-elements/{id})
 func (_ Unimplemented) DeleteStorageElement(w http.ResponseWriter, r *http.Request, id StorageElementId) {
@@ -270,6 +643,66 @@ func (_ Unimplemented) SyncStorageElement(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// История задач синхронизации
+// (GET /api/v1/sync-jobs)
+func (_ Unimplemented) ListSyncJobs(w http.ResponseWriter, r *http.Request, params ListSyncJobsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Состояние задачи синхронизации
+// (GET /api/v1/sync-jobs/{id})
+func (_ Unimplemented) GetSyncJob(w http.ResponseWriter, r *http.Request, id SyncJobId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отмена задачи синхронизации
+// (POST /api/v1/sync-jobs/{id}/cancel)
+func (_ Unimplemented) CancelSyncJob(w http.ResponseWriter, r *http.Request, id SyncJobId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список webhook endpoints
+// (GET /api/v1/webhooks)
+func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создание webhook endpoint
+// (POST /api/v1/webhooks)
+func (_ Unimplemented) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаление webhook endpoint
+// (DELETE /api/v1/webhooks/{id})
+func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, id WebhookId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Webhook endpoint
+// (GET /api/v1/webhooks/{id})
+func (_ Unimplemented) GetWebhook(w http.ResponseWriter, r *http.Request, id WebhookId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновление webhook endpoint
+// (PUT /api/v1/webhooks/{id})
+func (_ Unimplemented) UpdateWebhook(w http.ResponseWriter, r *http.Request, id WebhookId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал доставок webhook
+// (GET /api/v1/webhooks/{id}/deliveries)
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id WebhookId, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Тестовая доставка
+// (POST /api/v1/webhooks/{id}/test)
+func (_ Unimplemented) TestWebhook(w http.ResponseWriter, r *http.Request, id WebhookId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Liveness probe
 // (GET /health/live)
 func (_ Unimplemented) HealthLive(w http.ResponseWriter, r *http.Request) {
@@ -358,6 +791,26 @@ func (siw *ServerInterfaceWrapper) ListAdminUsers(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// CreateAdminUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateAdminUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAdminUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteAdminUser operation middleware
 func (siw *ServerInterfaceWrapper) DeleteAdminUser(w http.ResponseWriter, r *http.Request) {
 
@@ -451,8 +904,8 @@ func (siw *ServerInterfaceWrapper) UpdateAdminUser(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// SetRoleOverride operation middleware
-func (siw *ServerInterfaceWrapper) SetRoleOverride(w http.ResponseWriter, r *http.Request) {
+// DeleteAdminUserAccount operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminUserAccount(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -472,7 +925,7 @@ func (siw *ServerInterfaceWrapper) SetRoleOverride(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetRoleOverride(w, r, id)
+		siw.Handler.DeleteAdminUserAccount(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -482,70 +935,28 @@ func (siw *ServerInterfaceWrapper) SetRoleOverride(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListFiles operation middleware
-func (siw *ServerInterfaceWrapper) ListFiles(w http.ResponseWriter, r *http.Request) {
+// UpdateAdminUserAccount operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAdminUserAccount(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:read"})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListFilesParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "retention_policy" -------------
+	// ------------- Path parameter "id" -------------
+	var id UserId
 
-	err = runtime.BindQueryParameter("form", true, false, "retention_policy", r.URL.Query(), &params.RetentionPolicy)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "retention_policy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "storage_element_id" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "storage_element_id", r.URL.Query(), &params.StorageElementId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "storage_element_id", Err: err})
-		return
-	}
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "uploaded_by" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "uploaded_by", r.URL.Query(), &params.UploadedBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploaded_by", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListFiles(w, r, params)
+		siw.Handler.UpdateAdminUserAccount(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -555,17 +966,28 @@ func (siw *ServerInterfaceWrapper) ListFiles(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// RegisterFile operation middleware
-func (siw *ServerInterfaceWrapper) RegisterFile(w http.ResponseWriter, r *http.Request) {
+// SetAdminUserPassword operation middleware
+func (siw *ServerInterfaceWrapper) SetAdminUserPassword(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RegisterFile(w, r)
+		siw.Handler.SetAdminUserPassword(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -575,28 +997,28 @@ func (siw *ServerInterfaceWrapper) RegisterFile(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteFile operation middleware
-func (siw *ServerInterfaceWrapper) DeleteFile(w http.ResponseWriter, r *http.Request) {
+// SetRoleOverride operation middleware
+func (siw *ServerInterfaceWrapper) SetRoleOverride(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "file_id" -------------
-	var fileId FileId
+	// ------------- Path parameter "id" -------------
+	var id UserId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "file_id", chi.URLParam(r, "file_id"), &fileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteFile(w, r, fileId)
+		siw.Handler.SetRoleOverride(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -606,28 +1028,68 @@ func (siw *ServerInterfaceWrapper) DeleteFile(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetFile operation middleware
-func (siw *ServerInterfaceWrapper) GetFile(w http.ResponseWriter, r *http.Request) {
+// ListAlertRules operation middleware
+func (siw *ServerInterfaceWrapper) ListAlertRules(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAlertRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateAlertRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAlertRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlertRule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "file_id" -------------
-	var fileId FileId
+	// ------------- Path parameter "id" -------------
+	var id AlertRuleId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "file_id", chi.URLParam(r, "file_id"), &fileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:read"})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFile(w, r, fileId)
+		siw.Handler.DeleteAlertRule(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -637,28 +1099,28 @@ func (siw *ServerInterfaceWrapper) GetFile(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateFile operation middleware
-func (siw *ServerInterfaceWrapper) UpdateFile(w http.ResponseWriter, r *http.Request) {
+// GetAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertRule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "file_id" -------------
-	var fileId FileId
+	// ------------- Path parameter "id" -------------
+	var id AlertRuleId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "file_id", chi.URLParam(r, "file_id"), &fileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateFile(w, r, fileId)
+		siw.Handler.GetAlertRule(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -668,17 +1130,28 @@ func (siw *ServerInterfaceWrapper) UpdateFile(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetIdpStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetIdpStatus(w http.ResponseWriter, r *http.Request) {
+// UpdateAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAlertRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id AlertRuleId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetIdpStatus(w, r)
+		siw.Handler.UpdateAlertRule(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -688,17 +1161,17 @@ func (siw *ServerInterfaceWrapper) GetIdpStatus(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// SyncServiceAccounts operation middleware
-func (siw *ServerInterfaceWrapper) SyncServiceAccounts(w http.ResponseWriter, r *http.Request) {
+// ListAlerts operation middleware
+func (siw *ServerInterfaceWrapper) ListAlerts(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SyncServiceAccounts(w, r)
+		siw.Handler.ListAlerts(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -708,19 +1181,19 @@ func (siw *ServerInterfaceWrapper) SyncServiceAccounts(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListServiceAccounts operation middleware
-func (siw *ServerInterfaceWrapper) ListServiceAccounts(w http.ResponseWriter, r *http.Request) {
+// ListAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:read"})
 
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListServiceAccountsParams
+	var params ListAuditEventsParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -738,16 +1211,56 @@ func (siw *ServerInterfaceWrapper) ListServiceAccounts(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Optional query parameter "actor" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "target_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target_type", r.URL.Query(), &params.TargetType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "target_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target_id", r.URL.Query(), &params.TargetId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListServiceAccounts(w, r, params)
+		siw.Handler.ListAuditEvents(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -757,8 +1270,8 @@ func (siw *ServerInterfaceWrapper) ListServiceAccounts(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// CreateServiceAccount operation middleware
-func (siw *ServerInterfaceWrapper) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+// ListCollections operation middleware
+func (siw *ServerInterfaceWrapper) ListCollections(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -767,7 +1280,7 @@ func (siw *ServerInterfaceWrapper) CreateServiceAccount(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateServiceAccount(w, r)
+		siw.Handler.ListCollections(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -777,13 +1290,157 @@ func (siw *ServerInterfaceWrapper) CreateServiceAccount(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteServiceAccount operation middleware
-func (siw *ServerInterfaceWrapper) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+// CreateCollection operation middleware
+func (siw *ServerInterfaceWrapper) CreateCollection(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCollection(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCollection operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCollection(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id ServiceAccountId
+	var id CollectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCollection(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCollection operation middleware
+func (siw *ServerInterfaceWrapper) GetCollection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id CollectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCollection(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCollection operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCollection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id CollectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCollection(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCollectionMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListCollectionMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id CollectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCollectionMembers(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetCollectionMember operation middleware
+func (siw *ServerInterfaceWrapper) SetCollectionMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id CollectionId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -791,45 +1448,1386 @@ func (siw *ServerInterfaceWrapper) DeleteServiceAccount(w http.ResponseWriter, r
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteServiceAccount(w, r, id)
-	}))
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetCollectionMember(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveCollectionMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveCollectionMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id CollectionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "subject_type" -------------
+	var subjectType RemoveCollectionMemberParamsSubjectType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subject_type", chi.URLParam(r, "subject_type"), &subjectType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject_type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "subject_id" -------------
+	var subjectId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subject_id", chi.URLParam(r, "subject_id"), &subjectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveCollectionMember(w, r, id, subjectType, subjectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFiles operation middleware
+func (siw *ServerInterfaceWrapper) ListFiles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFilesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "retention_policy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "retention_policy", r.URL.Query(), &params.RetentionPolicy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "retention_policy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "storage_element_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "storage_element_id", r.URL.Query(), &params.StorageElementId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "storage_element_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "collection_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "collection_id", r.URL.Query(), &params.CollectionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "collection_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "uploaded_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "uploaded_by", r.URL.Query(), &params.UploadedBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploaded_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filename" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filename", r.URL.Query(), &params.Filename)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "content_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "content_type", r.URL.Query(), &params.ContentType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "content_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_size", r.URL.Query(), &params.MinSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_size", r.URL.Query(), &params.MaxSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "uploaded_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "uploaded_after", r.URL.Query(), &params.UploadedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploaded_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "uploaded_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "uploaded_before", r.URL.Query(), &params.UploadedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploaded_before", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFiles(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterFile operation middleware
+func (siw *ServerInterfaceWrapper) RegisterFile(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterFile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateFileBatch operation middleware
+func (siw *ServerInterfaceWrapper) CreateFileBatch(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateFileBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFileBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetFileBatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id FileBatchId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFileBatch(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelFileBatch operation middleware
+func (siw *ServerInterfaceWrapper) CancelFileBatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id FileBatchId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelFileBatch(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFileBatchResults operation middleware
+func (siw *ServerInterfaceWrapper) ListFileBatchResults(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id FileBatchId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFileBatchResultsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFileBatchResults(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteFile operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "file_id" -------------
+	var fileId FileId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file_id", chi.URLParam(r, "file_id"), &fileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFile(w, r, fileId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFile operation middleware
+func (siw *ServerInterfaceWrapper) GetFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "file_id" -------------
+	var fileId FileId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file_id", chi.URLParam(r, "file_id"), &fileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFile(w, r, fileId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateFile operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "file_id" -------------
+	var fileId FileId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file_id", chi.URLParam(r, "file_id"), &fileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateFile(w, r, fileId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIdpStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetIdpStatus(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIdpStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SyncServiceAccounts operation middleware
+func (siw *ServerInterfaceWrapper) SyncServiceAccounts(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SyncServiceAccounts(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateSigningKey operation middleware
+func (siw *ServerInterfaceWrapper) RotateSigningKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateSigningKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSigningKeysStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetSigningKeysStatus(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSigningKeysStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPermissions operation middleware
+func (siw *ServerInterfaceWrapper) ListPermissions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPermissions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPersonalTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListPersonalTokens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPersonalTokensParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPersonalTokens(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePersonalToken operation middleware
+func (siw *ServerInterfaceWrapper) CreatePersonalToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePersonalToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokePersonalToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokePersonalToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokePersonalToken(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListRoles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRoles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRole operation middleware
+func (siw *ServerInterfaceWrapper) CreateRole(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRole(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRole operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name RoleName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRole(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRole operation middleware
+func (siw *ServerInterfaceWrapper) GetRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name RoleName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRole(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name RoleName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRole(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListServiceAccounts operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceAccounts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServiceAccountsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "secret_expiring_within_days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "secret_expiring_within_days", r.URL.Query(), &params.SecretExpiringWithinDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "secret_expiring_within_days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListServiceAccounts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceAccount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServiceAccountId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServiceAccount(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) GetServiceAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServiceAccountId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServiceAccount(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) UpdateServiceAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServiceAccountId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateServiceAccount(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServiceAccountGrants operation middleware
+func (siw *ServerInterfaceWrapper) GetServiceAccountGrants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServiceAccountId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServiceAccountGrants(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceServiceAccountGrants operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceServiceAccountGrants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServiceAccountId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceServiceAccountGrants(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateSecret operation middleware
+func (siw *ServerInterfaceWrapper) RotateSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServiceAccountId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateSecret(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListStorageElements operation middleware
+func (siw *ServerInterfaceWrapper) ListStorageElements(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStorageElementsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStorageElements(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateStorageElement operation middleware
+func (siw *ServerInterfaceWrapper) CreateStorageElement(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateStorageElement(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DiscoverStorageElement operation middleware
+func (siw *ServerInterfaceWrapper) DiscoverStorageElement(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiscoverStorageElement(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReleaseStorageElementReservation operation middleware
+func (siw *ServerInterfaceWrapper) ReleaseStorageElementReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "reservation_id" -------------
+	var reservationId ReservationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservation_id", chi.URLParam(r, "reservation_id"), &reservationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reservation_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReleaseStorageElementReservation(w, r, reservationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SelectStorageElement operation middleware
+func (siw *ServerInterfaceWrapper) SelectStorageElement(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SelectStorageElement(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteStorageElement operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStorageElement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id StorageElementId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStorageElement(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStorageElement operation middleware
+func (siw *ServerInterfaceWrapper) GetStorageElement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id StorageElementId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStorageElement(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateStorageElement operation middleware
+func (siw *ServerInterfaceWrapper) UpdateStorageElement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id StorageElementId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateStorageElement(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SyncStorageElement operation middleware
+func (siw *ServerInterfaceWrapper) SyncStorageElement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id StorageElementId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SyncStorageElement(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSyncJobs operation middleware
+func (siw *ServerInterfaceWrapper) ListSyncJobs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSyncJobsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// GetServiceAccount operation middleware
-func (siw *ServerInterfaceWrapper) GetServiceAccount(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional query parameter "storage_element_id" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "storage_element_id", r.URL.Query(), &params.StorageElementId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "storage_element_id", Err: err})
+		return
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id ServiceAccountId
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "trigger" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "trigger", r.URL.Query(), &params.Trigger)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trigger", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServiceAccount(w, r, id)
+		siw.Handler.ListSyncJobs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -839,13 +2837,13 @@ func (siw *ServerInterfaceWrapper) GetServiceAccount(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateServiceAccount operation middleware
-func (siw *ServerInterfaceWrapper) UpdateServiceAccount(w http.ResponseWriter, r *http.Request) {
+// GetSyncJob operation middleware
+func (siw *ServerInterfaceWrapper) GetSyncJob(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id ServiceAccountId
+	var id SyncJobId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -855,12 +2853,12 @@ func (siw *ServerInterfaceWrapper) UpdateServiceAccount(w http.ResponseWriter, r
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateServiceAccount(w, r, id)
+		siw.Handler.GetSyncJob(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -870,13 +2868,13 @@ func (siw *ServerInterfaceWrapper) UpdateServiceAccount(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// RotateSecret operation middleware
-func (siw *ServerInterfaceWrapper) RotateSecret(w http.ResponseWriter, r *http.Request) {
+// CancelSyncJob operation middleware
+func (siw *ServerInterfaceWrapper) CancelSyncJob(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id ServiceAccountId
+	var id SyncJobId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -886,12 +2884,12 @@ func (siw *ServerInterfaceWrapper) RotateSecret(w http.ResponseWriter, r *http.R
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RotateSecret(w, r, id)
+		siw.Handler.CancelSyncJob(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -901,54 +2899,17 @@ func (siw *ServerInterfaceWrapper) RotateSecret(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListStorageElements operation middleware
-func (siw *ServerInterfaceWrapper) ListStorageElements(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:read"})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:read"})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListStorageElementsParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "mode" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListStorageElements(w, r, params)
+		siw.Handler.ListWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -958,17 +2919,17 @@ func (siw *ServerInterfaceWrapper) ListStorageElements(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// CreateStorageElement operation middleware
-func (siw *ServerInterfaceWrapper) CreateStorageElement(w http.ResponseWriter, r *http.Request) {
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateStorageElement(w, r)
+		siw.Handler.CreateWebhook(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -978,17 +2939,28 @@ func (siw *ServerInterfaceWrapper) CreateStorageElement(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DiscoverStorageElement operation middleware
-func (siw *ServerInterfaceWrapper) DiscoverStorageElement(w http.ResponseWriter, r *http.Request) {
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DiscoverStorageElement(w, r)
+		siw.Handler.DeleteWebhook(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -998,13 +2970,13 @@ func (siw *ServerInterfaceWrapper) DiscoverStorageElement(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteStorageElement operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStorageElement(w http.ResponseWriter, r *http.Request) {
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id StorageElementId
+	var id WebhookId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -1014,12 +2986,12 @@ func (siw *ServerInterfaceWrapper) DeleteStorageElement(w http.ResponseWriter, r
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteStorageElement(w, r, id)
+		siw.Handler.GetWebhook(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1029,13 +3001,13 @@ func (siw *ServerInterfaceWrapper) DeleteStorageElement(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetStorageElement operation middleware
-func (siw *ServerInterfaceWrapper) GetStorageElement(w http.ResponseWriter, r *http.Request) {
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id StorageElementId
+	var id WebhookId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -1045,12 +3017,12 @@ func (siw *ServerInterfaceWrapper) GetStorageElement(w http.ResponseWriter, r *h
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:read"})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStorageElement(w, r, id)
+		siw.Handler.UpdateWebhook(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1060,13 +3032,13 @@ func (siw *ServerInterfaceWrapper) GetStorageElement(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateStorageElement operation middleware
-func (siw *ServerInterfaceWrapper) UpdateStorageElement(w http.ResponseWriter, r *http.Request) {
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id StorageElementId
+	var id WebhookId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -1076,12 +3048,39 @@ func (siw *ServerInterfaceWrapper) UpdateStorageElement(w http.ResponseWriter, r
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:read"})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateStorageElement(w, r, id)
+		siw.Handler.ListWebhookDeliveries(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1091,13 +3090,13 @@ func (siw *ServerInterfaceWrapper) UpdateStorageElement(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// SyncStorageElement operation middleware
-func (siw *ServerInterfaceWrapper) SyncStorageElement(w http.ResponseWriter, r *http.Request) {
+// TestWebhook operation middleware
+func (siw *ServerInterfaceWrapper) TestWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id StorageElementId
+	var id WebhookId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -1107,12 +3106,12 @@ func (siw *ServerInterfaceWrapper) SyncStorageElement(w http.ResponseWriter, r *
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:write"})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SyncStorageElement(w, r, id)
+		siw.Handler.TestWebhook(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1283,6 +3282,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/admin-users", wrapper.ListAdminUsers)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin-users", wrapper.CreateAdminUser)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/api/v1/admin-users/{id}", wrapper.DeleteAdminUser)
 	})
@@ -1292,15 +3294,81 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/admin-users/{id}", wrapper.UpdateAdminUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/admin-users/{id}/account", wrapper.DeleteAdminUserAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/admin-users/{id}/account", wrapper.UpdateAdminUserAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/admin-users/{id}/password", wrapper.SetAdminUserPassword)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/admin-users/{id}/role-override", wrapper.SetRoleOverride)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/alert-rules", wrapper.ListAlertRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/alert-rules", wrapper.CreateAlertRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/alert-rules/{id}", wrapper.DeleteAlertRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/alert-rules/{id}", wrapper.GetAlertRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/alert-rules/{id}", wrapper.UpdateAlertRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/alerts", wrapper.ListAlerts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/audit-events", wrapper.ListAuditEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/collections", wrapper.ListCollections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/collections", wrapper.CreateCollection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/collections/{id}", wrapper.DeleteCollection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/collections/{id}", wrapper.GetCollection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/collections/{id}", wrapper.UpdateCollection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/collections/{id}/members", wrapper.ListCollectionMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/collections/{id}/members", wrapper.SetCollectionMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/collections/{id}/members/{subject_type}/{subject_id}", wrapper.RemoveCollectionMember)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/files", wrapper.ListFiles)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/files", wrapper.RegisterFile)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/files/batch", wrapper.CreateFileBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/files/batch/{id}", wrapper.GetFileBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/files/batch/{id}/cancel", wrapper.CancelFileBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/files/batch/{id}/results", wrapper.ListFileBatchResults)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/api/v1/files/{file_id}", wrapper.DeleteFile)
 	})
@@ -1316,6 +3384,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/idp/sync-sa", wrapper.SyncServiceAccounts)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/keys/rotate", wrapper.RotateSigningKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/keys/status", wrapper.GetSigningKeysStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/permissions", wrapper.ListPermissions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/personal-tokens", wrapper.ListPersonalTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/personal-tokens", wrapper.CreatePersonalToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/personal-tokens/{id}", wrapper.RevokePersonalToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/roles", wrapper.ListRoles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/roles", wrapper.CreateRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/roles/{name}", wrapper.DeleteRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/roles/{name}", wrapper.GetRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/roles/{name}", wrapper.UpdateRole)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/service-accounts", wrapper.ListServiceAccounts)
 	})
@@ -1331,6 +3432,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/service-accounts/{id}", wrapper.UpdateServiceAccount)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/service-accounts/{id}/grants", wrapper.GetServiceAccountGrants)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/service-accounts/{id}/grants", wrapper.ReplaceServiceAccountGrants)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/service-accounts/{id}/rotate-secret", wrapper.RotateSecret)
 	})
@@ -1343,6 +3450,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/storage-elements/discover", wrapper.DiscoverStorageElement)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/storage-elements/reservations/{reservation_id}", wrapper.ReleaseStorageElementReservation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/storage-elements/select", wrapper.SelectStorageElement)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/api/v1/storage-elements/{id}", wrapper.DeleteStorageElement)
 	})
@@ -1355,6 +3468,36 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/storage-elements/{id}/sync", wrapper.SyncStorageElement)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/sync-jobs", wrapper.ListSyncJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/sync-jobs/{id}", wrapper.GetSyncJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/sync-jobs/{id}/cancel", wrapper.CancelSyncJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/webhooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/webhooks", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/webhooks/{id}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/webhooks/{id}", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/webhooks/{id}", wrapper.UpdateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/webhooks/{id}/deliveries", wrapper.ListWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/webhooks/{id}/test", wrapper.TestWebhook)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health/live", wrapper.HealthLive)
 	})
@@ -1371,156 +3514,437 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9bW/bRrroX5nD3g+yDm3LjpOmBg5wvYmTdddNcqxkC5w6kBlpbLORSC1JufUJDPil",
-	"abtIb7Pb04u7uGfPtj27wP2quNFacWz5Lwz/wv6Si3lmSM6Qwxc5kpu0AYrUksiZZ2ae97d5pNXtVtu2",
-	"sOW52vwjrW04Rgt72IFPN8wmXmrQvxrYrTtm2zNtS5vX7t1buo78z0iXvCAvSVfTNZN+3Ta8TU3XLKOF",
-	"tXlt3WzimtnQdM3Bv+uYDm5o857Twbrm1jdxy6DDrttOy/C0ea3TgSe97TZ91fUc09rQdnZ0bdlsmV4S",
-	"AvJ/yYC8JH3/C9Lz9/x9ckgGiByRLjkjfX+P9MgLRE5JF9Ef/V3SJaek739OegGsv+tgZzsCtgnTiKA1",
-	"8LrRaXra/Eylomst41Oz1WnBJ/rRtPjHEGbT8vAGdgDo2+vrLlZB/QM5IT3/96RHwSE9RAb+PsDpf0G6",
-	"dCuRv8dXcBztawxWm42uBFaEraKErYqdLbOOF+p1u2N56ae7R3r+LjkEWE7JgPxIN7hLjilg/gE59ffT",
-	"Dv6Vz7zq2Y6xgRebuIUzQOSPIf7cmIC552JHBcJv8Ha9aRsPUcfFDlq6jkoUqIlzQRGfdYc+7LZty8WM",
-	"Dm3ngdloYIt+qNuWR5c7/0gz2u2mWTcoRNMfuzb8jD81Wu0mhj8dx3bYKw06/o3bK79aun598Zamay3s",
-	"usYG/Zb8F+mR52QAZNT198nA/4KeOCJnQDiHiDwnL/2niBz6T8gZ0N0pw2D65YCcAaZ0/c9Jn/Rhz6KV",
-	"/Q8Hr2vz2jvTEZuZZr+604sUvBW+TrbqGLnkQabt6NqS5WHHMpqL0WLPuz9Lt+4urtxaWK4trqzcXpE3",
-	"6Rty6h8AK6ErP/Wfwtr9L0mfPKMkEREMbMZIt2HouXXtlu3dsDtW49U25Nbtu7Ubt+/dui7vxffAcw/8",
-	"XX+PMq8ecDDygjyn8I105Tkz6do9y+h4m7Zj/jt+xbXeu7Vw7+6vb68s/dtibLn/DRv/zD8gPX/f36Ob",
-	"36XnQWHw90nf/4z0gSt+TilipOv/C0x4AP/uk0MGAiJ9KvpgQ8ghyI0+eU5O/SfkBXr/w7vIsx9iS4AD",
-	"eMhCo2ValJkpxNJ3lKj9r8gRGdABYWkv/a9QiTwHyUmH7tFpj1DI9v4ZkZdkQNcNr/JHngM/kFgEZYlt",
-	"x25jxzMZO6s72PBwo2aoROS3MD/g9IAccQCA0xyGk2t6dJLabGX2ymRldnJ25u5MZb5C//s3TY84e8Pw",
-	"8KRntnCSvesaXl/Hdc/cwjXHZngRA+dPlO1Q4Qcb8xT5u2yv0L+glvFpyWy04U0d0X9r9hZ2HLOB6Zqx",
-	"RYXwR5pBNx7Yv9Gwrea2dl+EPvg1CVnLMJsSzmpG06zj/8k/T9XtlrhM9ryuWZ1m03hAn2dSJjmwRX9W",
-	"SDTyB3JM8ZnyEXKKGI5JEj/tDKSZHth2ExsWnWrddFyvxmSguJAFupAisG44dqftKkD9D3/XPyBn5Mx/",
-	"ghjCJdD3KUPYpcYdEdSPNMPxXNvBk7DzLj0N08MtVyGHQ4AMxzG26WezgCIgIeflyxV8da5SmcSz7z2Y",
-	"nJtpzE0a785cmZybu3Ll8uW5uUqlUlGdfoBXiqV/zxFQXF0hVAu/VczXNJQH9b69aVHWWeCoJPxXgP2f",
-	"IrMgAyWzID1OX6Q/NAHlAkiPJ7lCg6NiUvGL1LWPmP4WDiAcT4KD6CJ3ux+Oaz/4GNc9CkbIh5dN1wuZ",
-	"PzW9JB65abi1lu3I0K4bTVdJaCEGh39kyZ1IFChQvBkYXOGsYADFLQk9MEPEJ5XPebZnyIzsstIwkfYb",
-	"lhG8q4fmWWj6hNuTucX32pT5K5DxL+QZIOEheRnZYjFx5j9WYeiLVHajgel1RzjFmbjgGzGNTK1a5K9M",
-	"Oear6VNg0BqlhbVAbfcPQIi+DFX2AICpVSuHynJoakex99c6joMtL0XR+G/SI8f+gf/7rJ1U6h1Ur3kF",
-	"leMXKOffRNmJSm7nQXjgExctSlEJfEbU4uQE1UPkBJxKZ6RPTsmPpIvYvqJ/fP4N4II7AhwYMV9AJVgQ",
-	"sEH0gd3oNDEifyTfTrwaucsiNE4/5IRiTRo6lUIibjt4HTsObtSC0eRjHrVAVgmI66Zbp9u9gn/Xwa6X",
-	"lL4dJ0aSm57Xduenp1082bLduv3JZGVm6qGz3Xlob001DWv+amWmIpJpxzFzF0FnyQYv0g4S233qf0YG",
-	"/i5FT2Z4MmdidRGVbi7eRdNG25zempk2rXVbYYEZbaNuetvJlRtbhglIUHuw7bGvwjWZlndlTkuV8UO9",
-	"0XFxY4gXVKKmZTNiCVAaN0BHcD6h/9iarhmOsL0RFrue4XVc8U3bapoWZvoF/6uBNxyjgSmatQwKhmVY",
-	"dZwyHvgha4zBRTgj4oqK6rew45oxv4Q2M1WZquRijjAl34dwWdG4enTMKiyTvQ8KXzXXK56Trr/r73P/",
-	"goB1+6ITijKdHnlOuSQ8F/kMmTPe3yN9UFV65MR/ghaYIFEI6sAtE0NYW8kY/0y6AMEpuAb7ADFMQCE4",
-	"JgPyXIJxftWaRGu/XVheur5wd+n2LeboW0P/2P2WeVOO6eLA3XPMV9yjS3hMR4r0jVA1geFCJxkbh76d",
-	"7rOCN0RXE39pfygfE4wSenMF+LOcpfDStdu3biwvXbvL36FbRBnJSzq6v08VL/+APIPIyq5/4H/NNDUA",
-	"SFzYBAxWXazdu7Xw24Wl5YVfLS+yIauLEiSgaATrXrp+J/lCqAKkvya5ZTnkhzk+UabbhmQlejIThBj6",
-	"+hL49f+o+GKKAOCGgGNMDA94tKbLRLA0Z473Mo/G64ysA+CSJBx7nlGOitJvmE28guu2o3L6/J8gZuZ/",
-	"JYT0EDmEA2fRNfpXUoZs4vpDlzLQ+JjVXy9Mzl6+Iov1mQez9UuNOXx5/cq7V9+rGA/qDbw+M3tp7nKh",
-	"z6pz487eGvtB5KJmy9jA0x+38YbyPcn/WMxRKK3wUQEv26dt08Fu1hy5YwQR1Pw4la7ZjrlhWkazRl9K",
-	"ujnam7Zn15qG1XDrRhtPfdxW7oyD6YaatlVr202zvi0KSQ+32rZjQCSyjZ2WYWHLkxXc6GuVmDT/XQbq",
-	"8uzc7NWrFb2IupCU2QboeFqw0w2Q2U3s4UZM6Q6eS5XbmMUPi+60Z2y40jo+0pr2BtU3Go6x7g1nFHle",
-	"s9Ywtt0MnBKVJvBnDIe4nXbTporM+V56sB3TaYyaaW1g18NOjZF0Lh+L8gCSOBqjYY4kesRblKckwycv",
-	"UdCDEsiczRuL++Ne1f8m8OMsB1yWyy3DzTZW11oEeuRby/N6xVhny/h0GVsb3maQTFFAQc8m9lQSLUqH",
-	"O6kr3TApqgt2YjJASX5kmm0QA6eqUiRHS2CVLXGamciUorkCLk8q5W/tK4uU0YiMVOFQSBS8Ets+H29O",
-	"+o/9p+QocHCweBmzeOL78S/iJoRpRJeuXM7NIho3Ey3EH3+Njaa3eY0OlWSKguJcxMp+KNvV64bZlGU1",
-	"PJFn+cKw6aAum1s4nY27LPsp5m9ttExrsgWOMk0vuJRcwHWNSlfXM1rt4oJX6ROYLegT4HIvmlb0BQQr",
-	"T9+5FWw0ttO3jqFT8vuHQUA4R+qJmLSja23b9TYc7P6uOdSLsUULo+gRJKo1ju7kz4HErykuBDxCuWFL",
-	"jXY1XL7KN0TNdGrZnoF35Ji89L/2v4gSw47RUoPyF28b3XHsLbOBHVQKrH2FIGya9NRrkJZYMOGzuoD4",
-	"a6jk74Fr2fwUuUatLPmUL6nESN22LFz3lIkQ38peCJYKMUziQ+jFSuR/DsiAPJMyQMkz0XHRQyW6QGlG",
-	"pWtkoogFGVBELdWhHTwhOrNz/dg8X8A1au62VVfn8XwD7oMgKAAroms4Db2B5NR/DBELuhFHQf4gqi6k",
-	"Je/kpyBgo9lKjU2wXyXTkDkhtZRIx3C4qA59sETkw+Tk+THwCEGDlamodMVu4ts8cpSupspR4mPSjUVS",
-	"g/xeRSxVzMhIRrOzgmrcA1x0olcNo8W2LzX4s2J7hoeruO7gDHuP8ZWkT7+A/asHL7swh2KL/oseA3On",
-	"74GveZfl9VFTISD6KVQuQ2Ie3bsj/wlk+gXOYQhW0w09pps5YH53BH7eo38qlyUsr7s1C39ScwyrYbcC",
-	"mHKdj+Hy46tRbWl1obpt1VewCznoSiPpyD+gADO5kU3/yN8Tma06d1DUOtS76z8OhYOUSzgQs9iofQa5",
-	"IF0Iiv8d/O1PEkgriRNllksAV9OuMzM8FShYoAxPbLY0oCKwJXhmlFbStlUf0unDgngZG/sXLrl6LHCQ",
-	"IY1T8gQvp8cO0/Ytf05ymOQxLyDenTs196cpPNgLiB9BmCPkP0ElMPH3IBz0d+bFBxnu1u02zwMIppvN",
-	"Z+8SwuhJxI7Ak/cocVDiYSupU6r4UGolQqkHi53JaZ8l8oySMGIsIE13q6kyPMif2EZJQax9MvB3QwGR",
-	"GugifVSS4o1rrlFb7VQql+rU8IW/MP+CsTf21ZqcUVCQZ0tBgRGlF8f8M0JsKHfP+e4EniOex1FEDYrL",
-	"rCtXchNnZiSVL8WDEiqTGccNhTns9xgTyAWbqZQi2xqRRimLk/OplilZL7nxwT6FV8LGABWV5iYwklhw",
-	"Yd1sYnf+E8f0cOTJmaeqkRRqCHQn9jj8rGe9G30Mfga9KviRfWA/KV2sMWeZa3ecujqxbi8MRvfJcTKv",
-	"v7rAwvLA3Xg4XHgGAb/fBf4jZjTBOwFK8qBwQmqy4He/IHZI+ZACe2V8V9JBQ1Y8hO/a7bhtbDWKB6jk",
-	"cM9IWJIqjUrU9bhDkSOiEEzh5yvxSQnCfMlzDd5MqtrjYJKCJ/yy0hGemcZWXUAlmDS06cTEjEMU7thE",
-	"GmlL84OnN/g4+2bTfcu0ltjUM4ooiohbMirlo8fFxd9iCtGbFoOTwR9FHC6bRM6Ly68hihbnzwVS3eVz",
-	"+ND0NquhwW80m7fXtfmPhkTEFC9EqiPhB9F7UECdlj0Mq9b5XQyx/Kq6KzsYaltGs4OLuhlSXQv39aRh",
-	"5u9xOwSVqAlIjsgh2GW/TwU+1AEExYP0J7REqbk6PQqUjyjI2wcl4jDMAnyhKETPzamN7NJLV66+W3lv",
-	"ZrZSLBEmSOlUDDVTeffSu3MzV2fnio51jjyouHHx7ru5xsVsEeMCtH+Ico7epRyG4wfk8NwWQGiejBY2",
-	"01q3zw1TwQxooQTwEy2D0UfPfQB5y6i6iNSpy6+YQi0Ey4LXcrKqCzsVqou81qC6mMiBL56afa5Eq/HU",
-	"DMRT5c/JOVSKP9fQKOB6dkp5jOtIMA1pEkgMN80kyMVIOdNFUkpmLu5s1BpvWlmHvPQLVHdlGffGqbsS",
-	"+MXVXYX6OgSq5B58Ek4WAkkrpxguCLKYUCJC2agqjqBYPSy/AgW8ZjQauJFXnRs83DKch7hRC1LvxLcu",
-	"pb9lWzVXpuaZy7MZzwte+eiN2ZSsYGe4ZSvSqgIA5R2JA5OyBRIMunwSyiwUORFrWNJNKQPCoeIZYYmy",
-	"XsDF9Y5jettVOj5DngfYcLCz0KH0EHy6Eezk+x/e1eIq+Psf3o1HKsk3YReSsBiNZYWw6oh+UKHSRQt3",
-	"ltBNw8OfGNtTq5ZconjGNeuev+s/BZum3jTMlguBF4j96EGsZWrVWrXIt2DxsEHuudhx59EahMprRr2O",
-	"XXcKCjTXdLTGijbXwne45YW46UVfhJHXwK6B7QeeC9sRoREVG6xRC+htvOGMUfcihqPx0iZ0FxutRAxA",
-	"k1YMvs6DoECHAhZU3CBwID9n/CKleAptQTIQbEW5LG/lKekh/ykbM7SKjI63OSn1ChqQk6lyGZE/pNca",
-	"IWo2HvpP4Gy/IF30/od3V60weM9yUr7yv0agkr3kplJoIkHckoLjfwnK8Il/kJkXNBVz8nIX3AE0SKMo",
-	"EaKfgEn6ULgDKwKbELjuCRt41QI7+ssogemMdTw7ZJqlxJ+D0vo9sED7/mOErUbbNi3PheN4553MPQXk",
-	"/YaeBO9ZR2H09/wnqATm/ICckB5ieW8TfG2sCM1/SgWH4BGXNuGYbgyUsD0hL8CrTr5L7ItImf5XsJ/C",
-	"gO9/+Juq4BBXjgBJ2NBYCR74G2zPIRjKz0k/2npyyJb3IxwAzzzxHyNyHDtkumHviHSMSr+e/WBi1cpE",
-	"TAHqMJx/e+n6NVRa4D2hAEZ0zW5g9M/ozm+uLVK+AQvegz2gr/8dIF5zOw8on8jkHozpfM+LrMkADqbH",
-	"8B5IlzuI5YrxATmJ4BOKxwMqhllYCEQur1+Dx9bgwxoqBSQXlFeG2WAT0rtbJv4EO8HLQQbNGipJPhH/",
-	"C7atpE96E3RZMvs4gQg3PetyWcrV6VNiL5ehUH7Ai9rOQvUFfp2IeidEJ7RqKVo30JWH3SgCwnknwZtR",
-	"6QNAhtv0WNHsVAVdYwHGaw4GTmI0XbTetD9RYUTqgYc+fHrAjPcDBFVgEuw8Ioclj0sJu4agleM+6UYF",
-	"oRS1wc90DKyyz51EUJovuCCEocGJyYNXQCkQujqiA+iJDAQ2az/W0EM1uOhNVUHej1ePAzrH/ViuNJYA",
-	"qiiywkGp4U0VDx1R4xF5jmG5JqU/jp6hB1cFEN3EE8aPw1IK1okqbMQS7rEwXB5Mae0I6FwIco04s65u",
-	"Gg5uoDssm7j6r8txguijf+1gZzv6LAeHKO1HTtJoGGRarmdYdZzQdSiYXWAdlMoAj1jZ7eekG4AHPPpL",
-	"+sBUbPrngBvdSL6vWjfuVidhB5+DT5gKE4orYUTa3w8l0w9qu0MsXBmAYPuRcgqxCrRLh1grTxme50x9",
-	"7NrWWvgKnY8qeHEkgtMpl8PicJa50wudp1yziQLE/AsoJC+XV63Ar60iNxHeKSqJwk8su06AnUoj4WBY",
-	"EXEg2unkHJpoB/VVi+6CvxcmTzLsYnQdlpn3yREK9wP2eI4B+5hT/xGlsgxrb37VmuHZfj3QMwCPWJoT",
-	"OQZtkBJWucwQ/TN4P+hTA2wjUM1KMMU+V/C65CWaoRjQ9ff0qNa7T370D5gf2z8IljGxas0CDP/JiYeP",
-	"zlIa6PqPSZdDIKPiGdNWQv0sOJLA7T5XmQs6U6xal2CO70GdY3lawsrW7tyu3kXTnOFMcqPGnX5kNnam",
-	"6XNrq9YcHeBGp9kUXwyUNJYlJG5d6PvfTdZpgZkN6n7TrGNutHMd/o5jbhle4MyJ3EUbprfZeTBVt1vT",
-	"huN1HO4xmg4yiSHR32M97kRyX7izJCTgB1n8O7pmt7FltE1tXrs0VZm6RK1+w9sEwyzwXrIaBaq7TzOH",
-	"xoYyFPRNMhSiYPRUTx8g4IOsD1OPnChCRvCoEOYAJSYtw3keaFTRr4mrgYXbNgWpxr2ArMQ9ZMpXTpum",
-	"8GPQ0UdP5gaqm+dMsG5YdhszzXapoc1rN7En9rOKdeWdrVQKtBwt1gpUnEbVCFTd7yVxkCknRDFtrjKT",
-	"BkS4qmmpneqOrl1mS8x+Se7AK7oZIAAqOhg+ur9zX9fcTqtlONvFG4JpQfkeTw0HatDu06lkKoG0/SFp",
-	"5AwsFKZ7xCN7YTfuARVMqRn+U+BXECjgGVhLB4FBIDVOLSUSmhbuLDGjVMDLyJ5QqM5UP5CV5zR6EStZ",
-	"Tpk0HzANKDQsSF8wE1RUsGy6XthSzwUWFTWJT4lwR49Msw7uO3rug7xrOsWQsdGZuvuiiuJ+KHTy56as",
-	"ucql/Jeivt8XQYsFVxynRUZzacQI0puRYhMrezH+lVkzgXeioKgoSfg/EWY/pGj8lELTuhxz39FzmIFZ",
-	"h9C25fdRnjl7nj4rlA74e6H4oWKVS5wUupOM76BPGydBFdFdh+2KOnUOS3W8bb2CmObyGsxlyGfB8vSf",
-	"XCDuzzGos98Ie55fBLFwrGUuj8JYm9E7VE1U+jCSTFLCUhs7npEBivddpOTxTVjCqRrufL2/BY1udPLo",
-	"JvbGQRdjEDJKwfJtgVP6RZPWd6HHnxPX8ETT7ngX1PU3LogoJf059J5KbL9chkEO/ScQz2GO1ECC6Gpn",
-	"KqOaH6TMNbUTMF1i61SNfcZCDKDuCmbWP3a/XbXEiz1EP/ah6EUdhVBjwfsRES/Uuv7KbmyPnm55ksGO",
-	"HG/1nA7e+cnYRqr2IniJ/T+yWzHmRghVsVtako0TX0ihrV80Q4v4zpi1hQwVfJqyqUmxx3DbzikVl7yd",
-	"wrUjzKNJVUCVyl6gPXG+rs4yk7k/n07bnS+XIdjIeGuC+2TyVlQK9AkIisHWTKQwXJhkfF4mGP5/8/4O",
-	"/kGGmnYIDalZIVPAU/VQzggq0qqF+FL6cCwgd/6X/5n/GafGLo/WUdMO/M/d4OgYmxfq9KXQPXRSHw3j",
-	"r2JPbFTwujF+VROF14n5r4gqBvIPpG4OL4O7kN5y/QtbfKowjnd5lYpgL8Qujd8H4X81HXFspQAqdBlL",
-	"rsCBgPa4XK9inI9xXTksqdRP53kvCQjuS8F8PcG09AI+0BuwwrG7P/XE3v2NikD/KxbFPANOHvVf8g9S",
-	"romMSjdDpD9XO8UiAHHM6UPc9phiDpczQnMXFYyJFnQqaIt2ESwCaMqdkYmtU7TLG+LSyAIbJmZ79Mkh",
-	"5J2dpJ6l3O80/eLIcXo1Ujql5vnOpeKbN9hbLlYvZnnOY8VGAd9k7PE+b3yX20mUB+eleHq8I7dQaRTP",
-	"HurzXIxFcOz5TxLVfbGKabV9r2CfLONGxSGDXqkUTbTxqGmqlqyF1LSZMRCBEvH/FpzVUVbZ4FtlrfLe",
-	"BS4+OBR/j+VZHVNDCS1dD4yr/MMaFzPhNcwxbpLXU1jBVeJ62PQj3jA2O/T2XWruDlUOj0Mno//HUC0r",
-	"MbWCZZdypWENvJ5/40Z0kHxDtcqkl5KxJSS7HEO2dPOawLgKanSMJemRkZ4bVeMsajg9jt+FXjCgFjKC",
-	"KD0KrIG0ff1F+ceysL9qr3uI4VU2yg8VGUvkDopZs92fxKK4ib0R42HloqTcnzN385eIykqtMGeblLph",
-	"8bhVZjqs4v4WVBJG1RGdW0eMmU+Mh9myKMqrI/m4FEnhFoMLdvadg76S7QjfapGvtSA7D9Vma3Zmoz0d",
-	"NV4oLv1Eb1FGt+7ATcnSeSVWwGr8dFU+MaShQtWUru7QmRGXZtV9Ua8MuMQrLU8e2nKNKA59E3tRd/Mx",
-	"0nk0idJLIhzLUuPOzyqVUFparNpTwHKz0Vbg+LZVn3SNjBgl3FlGBwd9GjQ8qC0FNHrJi0sO/K/Tkenr",
-	"RJXZqgXv/5089w/SGsoioSn7iFCxum3V5TZQY8VIqVOz2nWXRn3A5VkZKCuS7f6cMPY7Vg8Mllk/iOdA",
-	"M8kclpSFzfyehUkjONkxhUeAMY4s1zuJjj+jiEdmk7dx2lQZzQ7zXOjVhZ9rmnnYjIB3+PQfxzp8xrzo",
-	"CYLKcKgnkuZOharG6sIUIn9ILRtTUHzokY/nxq1apai1nP9H/kDdttZNXp0cNET299Iql5kFJn5bCuuT",
-	"dSR1yZtA5Edgv70gTBAm7EmN/aSXUMFOeXKbv3IZSvgHrGKTMkXmnYt10uuNSBSyZlCxnojjMQCVjWkv",
-	"OJSQ2j5SwQ5idwaE2BGernRK/zTx1jYsFGEI2wsJ906zm6a14KJk4QbeIg2J5RgDz8gC3TSINsilJYyl",
-	"MA46opNgLYyF2AYYVvtkcEHcPeCE+6xxTfaGZfP2DFWqcF1R1KCAsnxZvQU2dSDWHzHenehfPzW6kp4E",
-	"fxtOuZJfLxyQoAxECDq8Ld0JS3deEUXPXaBTXWCaLSu+GVFdzAUg17g04txamQvVgl//qphXxtvisYbq",
-	"AipRE0tHUgiBtdMSgwjkT2GiYVgAlqJJp1SZ8PZsQsZiMFCg6sTb8r8AvXTNgVu9JtlTayOtVxkPUY1b",
-	"qf1pIhv5NB27WultycobUbIyZkVuWiLfDM/vf8imb+D7DRr+rEl20VpQ28BuCRPZDHNQQ5+8kLMkXMih",
-	"30nYCmBZ9MnDsOUldGSgg0bX+uVZ3eXycHb3iPiZePXha64cKG9pVLETvtMxlsJKXuKnobSSf6EU/j3s",
-	"ReDHjq6FLEbEsR5NY2u5cpR3/YL/mGe5/inluh7eVXJJj3JeY93cUrIfshJ6dDk3Quq3l+pWl3r5viZu",
-	"9V0IefWhLSswn2cULfwnKR72oC98wr+ecvPAOTLjX9XTP9RFBBcaAEhv/54bAFh8ozPn5burMsIB8d6B",
-	"Ij+Kc5xzpNILIgFSUKW+yqQ3xXrdpnaLS28zxzr48bA0feFLoWx27ebiXekqirUgxxV67imC2eXyetDh",
-	"TionYPERRQpJuTxSD7zcdHxMxorqHoiL9sDHu6sntYvFt6n8P4WjPcYIYm71eyvLRXP3R+dXry6eH4rz",
-	"MVX61uzFYlaUsD+A5uBC93VeOaruKAntPYdWQFVlDskCvQwJkKGRTjdMt25vYSc3jSjOr9OaZlJecMYC",
-	"BnBfWtQZ0z8AZCiBbBEqrVatdN7PGv4dqtL1duM3hbCL2uB4pEAzp3nWyD1oD5u4+YwatJwvkBPQ7nZH",
-	"14vpOt/lC5EZwWQ/UYl+NH1m9E2ZIcmRh9OSkD31OoiOeyvLr7l+WZQVZsiZ6mLt3q2F3y4sLS/8anlR",
-	"Duumcb4oTxa4H3P+JNTUEUsZ8C6JCbhQOMR7r7BrO04pd6Bsl/KtZ+TYPzhHtpuCL4yO+w4fnV1UVuGg",
-	"RFmZ2PA+7Dm+yG88CWO5QXhhlIHbOIsb0n0mvV48cLsoBW5Vm/Q2mBsGc4fC3/OHbhczQrejdRzdxN4F",
-	"4F3lAk0qOaa7+MuqF8n0hMQjvEMi8xC1Y88l72kQ3O04zYmpVesDu4GDoK6OgpsfZQ971CRQaGbtblv1",
-	"qRGGXceC9+P2ZPxEYddCnoy3Ydc3Lew6Un1sOrw9Um0Of8e7P+WVPCwyd6fCtv3H59+IWMbW0FLyE+b7",
-	"/I6zil3eby64hkUaG0rh2ODqbBKhPze0PXCgR4iznbysJtAW4daQc91Ik2U6R1yNXS6kEPTp/VOgEOiN",
-	"EvXifadpDrP04/r5JSH+YozUoUFZLKoXqStp02vShuaPm3Cn4nTT3Eq/Suc3nQfYsbCHXUSfs7DrorZj",
-	"P8Bw0WnSNpitVHQE1unL4Mahgf852Gd7CKKrh3G1CEz6Uyb0/KfkBIoVyTFq2w0dsZvQ/AP/aey21ATP",
-	"YDdELrPKprERejRLTsAyzBDiqx6NyJRwYVk6EOG82cHKp0y17O0ix0wfNIVzXrWSt17OC7eH6VErfHWp",
-	"9FjPewWWNfYDh2mKn/iP7O5RcohK9sPAXxVE3idehade+mkXBi09hdWtG2ZzIhNJV2R0SsPSFvYcs56R",
-	"OwN9IKAuFTq6HSLBs7xPeuiOY7ewt4k7LnCm2PUNJ/LbrHoXbmMGdx8Le79YtTy7bTftjW0ODioZ7Xat",
-	"gaFK0qpv1xjMOop93TQ8+L+L67bVYOm/Y0D5m9j7gO9TLsZ7+FNvut00zBhKxLM8UjpuhHsV7esYOFg0",
-	"OGqFC0viB4zgbAWaX+wS6WjPUEm4Xrkfvxk2cYHdo03b9XY0XdsyHNN4wFu2boYGwbrRaXravMYvtJvi",
-	"F9xNNQ0LNu5R1nXWwWWXqBQcOqucFyDSEefNfO0yhCGA84/atlMEzqZdN5rwNaSDOLGfr1YqFU3Vvdff",
-	"ZZG+IwRGQBdS0o5Av+gi+tbk1UrlPbrk++HpJOiz2OVlqKS+Ha/wJSoTUd6TcPuZIpfqr0NegcoMMEVz",
-	"jj6K+oQr0/ST97aHIQvxVlIQrJPi+UPU8zx3x8T3gHUgLrgJiezlE5bOIxYad8Md6cL7L+H9qEGy6oLZ",
-	"VYscovhFseL9UJQPKq70HLA8R/V9oPH1JjIxFYv+XrjnVHkBqyqlyX+qoyBKroP3LtEBN1KfcyaVGjSX",
-	"qBWOAit8oshdq8q0pggc1g1HAUNmoxHhWnmkLuqW2jjwucxGWzGToC2CUHeRJChi4jYajXP0nfs7/z8A",
-	"AP//KLbsUWvNAAA=",
+	"H4sIAAAAAAAC/+z9fXMbx7Unjr+VWdzdKlJ3SELUg22qUrWMBNu09cAQVJx7Q/+AITCkEIEDZGYgmXGp",
+	"SiRjy155zSuvd+PKjZ+S/OpWffePL0QJEkSRVNV9BTNvIa/kW+ec7p7umZ4BQAIUZasq5QggZqan+/Tp",
+	"8/A5n/NxrtJYazYc2/G93MzHuablWmu2b7v4abZuu/5Cq27PVeFj1fYqbq3p1xpObiZ3/frcJSN4Ed4N",
+	"2sFO0A2eB20jOAheBAfBTtAJPw86wX7QDbdzZq4Gv29a/o2cmXOsNTs3k6tVc2bOtX/fqrl2NTfjuy3b",
+	"zHmVG/aaBY9aabhrlp+bybVa+Et/vQlXeb5bc1Zzd+6YuYuNet2uwFjSB7cbHATPg+dBJ9gNPw26QXdE",
+	"Y3m7Vrd/afmVG+lDeRTeDbeCF2x6DoJnNFcdmD4amxHsB+3gsRH+MWgHz2A6g72Rjjh1sNEI9E9fqdXt",
+	"0pGHcLm2VvOTIwj+jIvWDe8FnXAj3ITZMoKnQTt4EXTDjaADU7cftA34IwofSNmnQYeP9fct212PBlvH",
+	"x8hDq9orVqvu52ZO5/Nmbs36qLbWWsNP8LHmsI9izDXHt1dtFwd9bWXFs3Wj/jHYi4Q+6BjBQbiJ4wzv",
+	"BW3cG+EGe4PdoJ0y1gbdXTtYeWx57dgWbM92b1mZWyK8G3SCpyh2O0E3vIvSSFO4bQR7bMrbNMPFgn79",
+	"3ehBRxeDhUbdvor3TczpN8FeuG3gIJ+n7QT8v6wRJJ9YtN1btYo9W6k0Wo6fPlUbYpo2gv3gIHgEgtgO",
+	"dmEBw61gHyZqRNuz6Ddca9Uu1O01O2OI7GcG+92oBrPuVN5rLKfrNticj1HSuzBr3WA//ARXDbbCU67f",
+	"RjS6657t6ob2vr1eqTesm0bLs11j7pIxBoMdP9Qokk/9wF6+0WjcTJ2T2/R3w3aqzUZtREtzBy72mg3H",
+	"s/G4frvhLteqVduBD5WG44NMzHycs5rNeq2C+3Xqd14D/2x/ZK0167jrbNdtuHRJFe7/9rWFX85dulS4",
+	"mjNza7bnWavwbfBt0AkeBwekIMLN4CC8B9tCmABG8Dh4DlpkJ7yPx9zzYJ/bAInDDicxetP/6toruZnc",
+	"P01F1sgU/dWbKsDwFth70lvH9ESvkeXumLk5x7ddx6oXopc97PzMXV0sLFydvVwqLCxcW1An6atgP9zC",
+	"cwnefD/cxncPPwu6wUPQG5FWwckY6jQM/Gwzd7Xhv91oOdWjTcjVa4ult69dv3pJnYsf8DTZCu+GG3Ce",
+	"dMjAeRY8hvEN9c17PMnMXXesln+j4db+YB/xXa9fnb2++O61hbl/LcRe96848Q/DraATboYbMPltWA8Y",
+	"Q7gZdMM/Bl08Oj5Fq3iY7/8dPnAL/7sZ7NAQDLTJ0azs4CH/POgGj4P98H7wzHjvg0XDb9y0HWkcZPJX",
+	"12oO6FTNefw9bOrwi+ApWQ34as/DL4wx0P4ocvdh7rvBU0No3382gufBAbw3Xsp+8jg4iKsI0MxNt9G0",
+	"Xb9G6qzi2pZvV0uWzt76Gp+PMn0QPGUDQE2zIx6eM6OVzE3np89P5Kcnpk8vns7P5OF//5ozIx1btXx7",
+	"wq+t2UlFa+bslRXwNW7ZJbdR15kqP9DUGCCAe2Ak4Dm4J731XtAlhdRl3lIneAQDpj/AnMGtPWXMrm1V",
+	"G059XTukNatWV4Q1Z9VrFfu/s8+Tlcaa/H70ezPntOp1a7lu8+MmeWMH/qw52IJ/C3ZBkEGBBPsGCZdi",
+	"D6VNvvKk5UajblsOPGql5np+yWHWX/Qis/Ai/Yx11W20mp5mqP+Lu1vhfSN4oZXbbZr1ueq8PNTf5izX",
+	"9/yGa09YsBW83Idmrubba57GEBAjslzXWofPtT4sEWWJz53L22+ezecn7Om3lifOnq6enbDeOH1+4uzZ",
+	"8+fPnTt7Np/P53XLX6s2e8kif71DCmXfkli3tIv4XuOGA/q0j2Vs2u5azfNqDcfTvk87eArKNfwssifS",
+	"1vQfd78GY+Nh+D/QKOhyBRN0jPBu/EbBM+5ddIJnqhSAj+vNwGvnzJxHdnb8o7fuVAYTD1ixUuOW7bq1",
+	"qm7p/l1WlcGBVlXSm3CXKJpvNqoJUKGW33D7mXhSOclxfBVuSI9Jn+0xlLEouIHiFnRRlRn8PcfVmZVE",
+	"KTHkgSYTtlNS7CymO5I2e2Ro/5Ysb3EDaTsldL0pn0Mfivs2ln9nV3wYhjgxmUN5vQlHiWZS/xbeCx+E",
+	"mxgU2JZCGuEX6RMc7LAwxwEZdcwNhWkeE2IhbreHP2onj1JxWCSOgyz130thZ6jj/ldR0R7Zi8aHJh6U",
+	"uRwXcdm0oRrJYoAtNvjkp0/wgKdx9unLQj/9HZ+HOhxN9MzQcn1Mbx1+GX4uKRk078CaplMCIkR3TSN2",
+	"Ro4P8QTNkgcz17Q873bDrSqyLL7EaN5l21n1b+Rm3pjGiBn/+KZmsmUNoo09ZSi+5/ipy6KPbdNAu/6P",
+	"4V2Y1PJk6b9PlC8YGAchy5S5BDtgj3eDJ6jN9yge9yjoMkHrKDMpdJn0VtPnzvXSbZJaEzOTuVMu1zxf",
+	"+BczH8dE+4blldYarqpmV6y6p5VJsdDiH1mujRiCVhJ4gFg8FQO28cinycOm8i+1v/MbvqVu0nPaQKpy",
+	"UOBr8GtNEU4WoVoxPZlTPM8WYsH+fcv2dA7NtyBh6JsFL4K22HzDUE7D3DSx6elPwFKPxO+ChzjyHTTB",
+	"mDqO+YvhJzoj6FnqzOTwDealGTgdn5Ahm2GgDcIvg4d4EEcrt0suKr7Po6A9PrnkBH+jIBV76S6M2SiD",
+	"mVbm4bNwC4+m58LU5eOcXOrDlr6jWwbI4unOQdRNDyFTAVEyNIo7mhRe0CGrWk71HYCVJ+zs3XDT5OOH",
+	"pBvG4O7ykHk8HnhghFvhBk4L3KyTkNeVGrxMyas5FVuR2UwfXYRi5EM4+FPQjj38rTOTZ/8bWU80RuOt",
+	"/H8b193RbVGeq3colv029djCv9K3PRQiz7guwo8hNNOidUxJABRMg/4xK8I93WCP24OUu4BTm4KjXd3I",
+	"2RM0HpxnT6w1vErj9kT+dE+Lmk+WPBXyi0evEntktHKmuvIfpsly9nk14AkEN0yePtpTIHVAsGC6eJm6",
+	"YfS58ayYV5/BqSyLnScgSrWqxhT8IJam8EyuVrcgbxnZghu41+9jHHM7lvXPSad+z32iD5j0vCwpnMUC",
+	"d6HE5tZKN7mYJZtSZCVdgCb4DjxYZqfdiw6iBLbhMf5lzygWjDFQxKQXd9BZLhbGc2biRXp64P4N1/Zu",
+	"NOpVfbyVNNQFA5/GNKxnlzzf8lse6OCq3bxhW3XMKonJeSsvBzYbreV6RjTNaa0tywbYgAqq1awOKLE6",
+	"V9yxol+akqOniK/iiitPztyZc06z5Sf1xCCeVnwXHV7euSBLNtdphjsQnw8rxn9lBtFucJCQFdOoWE2r",
+	"UvPXo3+VVhquXbE83zQglFXCXIc3Qimm3AxLFwf7tH2eMpRER3LHwQraw7e5x7bll8I+AqfrRdCWhzlS",
+	"EY+JqyypmWI39FMKbjqMk2qRzUEiPCXZZICuIeuwHW6G92WQiKIVZ5acCaMspKyMS1ossJXeMXhOFi2+",
+	"jtFw6jXHxmu4EJa5FLQT9nWxYPzj8/+/IYTLYGabTjreyo8rtxWyXY6MV7BHwfB+qnlcuE2P+7v0ONT3",
+	"gDcaO32W7i5tE3bf/aBDFnt4jzkrqQiIZzgx8NTH4d1wO3gce7uxM+whVsmzK67tl+yPmjWXzRDMX7CL",
+	"0etNMvdwbjvoqHQIbMRiWU+DJ7CC9yjCcA/TvZ3gqfbN3qBnimOETxdYjOiEPOaZ5BcMLQR3g2yy3sJk",
+	"M4JgJ4iz7wdt9FtsB4BLv80JUQFtztZK+qdYtpyZU3VSfFZyZk4MOvehRifNtqo1v3CLpXk1MUBmz8Cs",
+	"PYHkMYyVTvo2LmkXE4xjVrNpO9UJCFknA6tWhe6oms2ILypZFA+epHNKdzhYFb/hanW511qm/M17Hyxe",
+	"MMoYSJtYa1RbdTvyFDeEDOxxRxmkjaLAzE1Of6w+8NV07RXbde1qiYeRMvNn4HFU6jU6kozibPrjuObl",
+	"ggC3z5nxycJl93x7Tbuk1opPiWmrWq3BgK267OeTzk8E8tiMhA+iFDW90bYk50k4Jll4FPeMeeVBdzz9",
+	"sIk07rK9wuJmQxvv4+Cg10iVhHifI41Z4TXHP382pw21VSotlI5BHBSXgl5M0HsaE16j5VbsUq3Z1699",
+	"y121+b3T/tq/8Kl2FrqkdZv9X2kZML45M9eqlTzb9+EZZs6Cc7UEjm7OzDHcF9yptuqAL3vTBlXVtF0P",
+	"lr9EoAvKvGlkXGcby3Mu6QxlYymb2uRqSX1/ea60BoJQmP0HhI8cABbPzIwAZ8V8M+K8I43tRgD0VBCz",
+	"QJ5DxgCWxmtaFXs8AlgfoIkEMbMdFoYjNAic7qDNww229YXyJdMo2BtK5IBfs7yu3TzKOylRtb8g7gNw",
+	"TvcJZb+HI3sMHx+TyRM8g/NUD13oy3Nas8FoL9HO1K5zMihQtWHb6e72+1bDt0rL67423/1nPv8CWg4W",
+	"5h7G4QkwReAByn8h6oUhYZjNJy+oFBp4iHYXGJ7x+AIBnqTMxhtn3jh7+s3ps/m8mVTEKVpQmgt6P0Qt",
+	"9H4/VhgRw9gP66UOMfrBgwiYvKumLuiPR1hA3StohgyPT5/vAee3n2dmxE3kxyszo4wztqeU/T9YbEUq",
+	"vuHBlYTX38YZ30MtcZfrCaUaxxhjNkvCbO0KL6RPgENMVUnRlXP5vEZ4srO+mpF69daqsmMjXdO0fN92",
+	"4fr/32+tiT98CP/JT7w18eHHp82zb935r32oo8Tay2UoA2/+I91NF+7IFoFhBjqiux4h0hHd5ArKux6L",
+	"E7Rxa4K47eqWHP7XoQwWD3m0Wf6e0GIxT7BaTT9JD3M4Z4PivleGlBg9xWUAaiXHP8INdNQ3AcKBmwvL",
+	"kQzyGnapgiMyMhQFhfe77dZ8WwrYEO7rKYHLpR8buj3dofqiTR79Iy3Iog91m9845mnFR5HEkoHVDeNS",
+	"YCbc0Fd+YOboQVrfMm578hyVzju/fkjXuCdKLXpsnx5LTw9CuZ/yVqqEmZEA90S8xfdXSoA9JsBHWJu1",
+	"mjNHV5/utVA9oDIvb4L7mcjRaFK691H0Kbifjp9SFfBX1Dpb4edZqAxtkQAUIRyhPuAlgfIxFnesiPxX",
+	"EuVujMkxzPFXBPROYEcDj0rC6HToNlC5DWC/RxDPpgUx/vHpVyQf4xfghlss2bFtCGQWHIx4Wj6SV2qf",
+	"RBzS65sSAH3J6U/OTjpanj4kz+TjRsczdDqi0IwrGDs3ggfB1+MnFRlPK35oDPygCFaugpMh//F+bJVD",
+	"I+p1R8ylmleBWZHwkaqyb7kx1XrD95vezNSUDFKavOmut242bk3WLWfmzfzpvJLLdms9XwKekj286HBO",
+	"TPd++EdMde/xGj8iAQCwyDuFRWPKatambp2eqjkrDU2xG8+FJd7cumXVUFYz3MWUGOhAV6jxlH6iEYlp",
+	"WmtUFZvKrmI81b0N/2nkzJzlau07lhGUrqREMcVi2b+q9qprVW0CrMIwHEtFiSXRErWqKjPZgDYzd8t2",
+	"vUSw8/RkfjLfU3KkR7J5EK8V3VdKeeqkTC301AW1CDoKR8fdcJMHtiKp25TrfUH/MaWOv4vKs4lEQ04f",
+	"hveNWWYQaOwsXgIbk9iGVkn/JWjjEPaxDBtzqPSE4Bn5qY+VQZKj+uvZy3OXZhfnrl2louoouQ6XhHcx",
+	"gb3LXhmABeEnBMfi5qKwLPF2oiCZ7gNXp9cH4xVyWS+7aHOgel68i6icl8afVZiOF128dvXty3MXF9k1",
+	"MEWgSaBadzfcBLs53Aoewmc4Sxgk7xkOSH4xyuP/6vq1xdlS4TcXC4VL/E1YfcdOeJ+d5G0p4aCJHeCN",
+	"ioXS9auzv56duzz7y8sFBdfBXwkPNj6Bc5fmkxcImzD9MqWWno13p0che8xQksvPs1DBMUH9DzgIycRD",
+	"IZOElWyLA8bXwsIhyjN7lJz30hYVUhB8cEllEPs9bUGdzhDUQ2/X6r7WP/s72LjhFzCfhMV+iPoiFrEh",
+	"kUcsBNg3EkA73DaN8B5IrxFuGWX5KENLrwy49u+Z9XtACinxxG54l8NUCC7JHx7eRymmODn8iGDucVXD",
+	"fdl+4diswF94+T3DAvAqGmye/sdr1kclr/YHOzvYqq1BWas5h73UteGVYBKajXqtsi6fl7691my4lsty",
+	"zWuWY2uDF7qz1kLbDMW7iQLHYzHVzMNVhSL2XBHfWh2wMrDVrDfgwC8J2EefiSF+XYS/GPTCdX0xYvru",
+	"u4YVrPpc8N8RB0bW93acCaydIEcJt0mXaJjAUrFHbCVp2SiWV8IJB6lZa9yy+SfP9jn+6kPttgH91iNS",
+	"3dN3Oky0u9qi+Sut6c3P3kkQYaZo41MbSeOI7KEVq1a3q+CaVcCerNftal8OIl2nmIrTumH1uT2abqNi",
+	"e17sjikFbwxQIyS1N4QrGY42GQiSKe4uFgAh6kCVxi/7mQzvZq3Z1HJG/F1GOmzh4YI5FPw37Io9RCzR",
+	"4RFuU/ZPPmrP6CbA8y33qFKaVIS/b9ktmypYHMDuYDi3UrFt8jrYips5ISi5D6WBSlclnyVuo3Mc5TzJ",
+	"QSztHuwEu1BdFt7jquJJ0GXBI6rNkLjxupy3TXHg3zqndRAH1scMPRTNW2oFo4z6jb8P7bpypIjKfW03",
+	"genpI9OuPDHYiUm0Kl7T5/L9ZdoFpEp4dRw2FG1dVV74ppAlJzuxIo6T9DJRTV69N7XkpBF8yzcc7MJd",
+	"hELAAn2BNC5gEhplRuvolU31S992yzqrzEoDP30N/iVbjW7QmUnk+LzGim/QF8kkIz+7yqZRlg4vuhID",
+	"fg8Z8Hgz/IJrNpE1pG/RS3sUdI0yXspA6r6CUufx2qgWE1MFkuSW+c/H5K+xaH4XI4B7UL3JbKWyueQY",
+	"ERfeA4j8qPR83MYG6FSX5UDkDGlUtdcJ74af4fPAyyuT+JTHFSD1kc96vtyaBfx/CaNCQQJSLQfBbhyw",
+	"0n8RzJr1Ec/f5fP5fFK1rAjnJSulFfd1FCXenzLSqB9pTge0hLkK1RgcIHv8WUKgwchQRFqexHhBUMYk",
+	"nsv3SOWxndlDxXhY8vRxWrSntwVU6788VujIIR3ZfYkJvWKRLorPUURim9gjqbN1fLjY+DK9auBY/Rro",
+	"slWd4Gm4ReECFsEMHkqF6WCqR34QHSVkp7EDoRN+Hj5gIRj10jaLMYkzmV2hAaYIchP25QFeCHUfNWd1",
+	"gMuw5EkOZ3TMGNEP2WeYGUBF+gQvbNNAyWBgxxN76f6tZrwHOytSh0xz+jw4YF8eiLpx+eiBrw5owJHP",
+	"dBA8YyVWzPwt8/xhzHuFZ0SPbYuqhfR1VU42YYbLxhRbixSzSjbIE1oDZHGeLv8Ak5K9bHAFddQ2VRmT",
+	"1pwqNChYGMsa0xpB6gcW+2F4P3iuDYIiKcT3LFOsW68dzUJHkmmmVptRFEEseFTF1wk3lxxFJnvH3ywf",
+	"4ku+p9coyNjT/7Hh2B/5JXbHgUIEjfQYy4RRJsSqEMkkr4h0BRzEcABnGaaKSIoSrgyQUpopklAkWn3w",
+	"EC1YDJCiHc8oNCLlIG/sYkHdyF2QroTwoHBgCR9LG7RFPphharOEhwr99tGQTYgg1GYqExRtT7Ype+Km",
+	"otWUXCohaEkxSTtlFuwKo7aJ7ek/ySIudnNSQyejzTfsyk2vtZa8Z/Hd2Ynpc+fV7Pjp5enKmepZ+9zK",
+	"+TfefCtvLVeq9srp6TNnz/X1WR+9jsW7e1aXSC84hgjPJB1suBVzjEEhfy5DXA8iSn26kpDWA1eDx4Pv",
+	"0WTV1qxVe+p3TXs1N6y4oQr87jk2suy9I5mhgxi+Dbe2WoPCLznBEE1I80bDb5TqllP1KlbTnvxdc1UP",
+	"+cHtVbrNz69etqNy3uHOQ5bjtFqFF3isyLuEn9tCX3Q5ko9VL8DZxuD7s1dKC4X5y3MXKYP79uzFxWsL",
+	"xlIrnz9jG6fxiPtOOhnbHGL/AkUX9W4ip0DHUN+28gK9nxZVdJi0ibRC4mudzmeZnIhQbPrs9Jtv5vuq",
+	"IBk0EyPpHP67YSdnJDBSvbHagOe71oo/GCbJ9+ulqrXuZezHo5b+iMyQf+jsTjSbnlWqOau259tuibR5",
+	"z/xt5Dwm93dM/zEhMaNjRbtK6vjUV5QOyIQwZx+Lx+uw0jNfSV+Vhh7x1PVikEuvNzqd10Zvjpx2HTRe",
+	"fyf1TVdrIOrpIeYfFErKdoqFYcyxPTOeaUAN17bhEVREKQhYAvM/OwwHRAY1qyxTy1Q6S86YlC+hXwc7",
+	"huX77iSw/49DqFyJzYbbEf4nYgsI7yYnKejSoTUwOKGXUdNbuo5skfjJmv2hgA3SkA660/CYYAXy8ZSk",
+	"owy3KTOC4CCkuacobnw+fiFPgughdeb8uZ4tpEZ9jgxwRJDNpNcALwiBFrT7Ngsp5JFoSaTqhgGDBcxu",
+	"HV6uN8kDE3Nsw7vSyzN4ouq/c9P1rlyRz9365/SF9McDwRxkV2N32OZ0QJvM3X5C+LGoAVcypqdj5oHb",
+	"IZnNbtCO7h5r7hWP77W18T2tT0+DV5x7yXzifxyOMZqCq1VEPCNi/y4SAl2EPZI0eCQwYD8Y5Jsq6hhe",
+	"Xn11/EWv8fcY6uXaLTvdRGPlaLGqIokNKGf2+So9B27mYDd5vrXW7N+o1iKmp/tETPM8unhsdD9RiJcx",
+	"cwu2VV1PnzrSkymlsxGRVVdPZNUJnk1SzYbEexWzBQDKzMEZoPvKNxnetbzksKh6ud6oWPVSrdosA5ZD",
+	"pWzG9ORcdX4cg/spdFpgas1eKQG+dn7h2q/nLhUWdCFa/uhedrq8P8Aw58Mb8Lpmw/NXXdv7fX2gC2Mi",
+	"IN1Ft8zDE/5D7OMTuh34+a+dsLlqMzXT9qOcBUfhFbIr0Ae7xlwVbAd/3Zh3G7dqVds1xiIQN0sUpUlx",
+	"0g9AuJkXUbj0gd0pzjKUmgeoC6xNqn1keFbp1HhPVFil4Th2xdeirL5W8y7UvShWFZnK/pmCLAQSOR5E",
+	"5em14KF8pHagBgOP6242GL4vDBTf4yVWDBUrSl+4bMTXquZ5LdvN5opPllTx58jlVD0rqVgeCGj6gLdP",
+	"27TrKzSu9sLtmBkj6lH0nSOpeP5wll+TyXHGOcBg++FdgASxFi3Epqd2nR2LqeFxyVa6GXW9QpWq6hfp",
+	"rxoL16qvpZbv4V8x0Mrw9XIZj35JY8V7rKwnhUHHHWx36qsJcXaCHRqs/PTe7RXE8si7l0+KTsXNi+LX",
+	"i5Zv1Rur2pFLxPv6QtZ4WRDg6BCxIyHXIiA2XqSDYLs2kdWpLqgOJhcPr8bIDwZvJaWhaydTuedJo3DE",
+	"6Q+STHRR9NIpC4RMd4tIdJdYHP5nw6oAIIiaEBpjSRZgIpBFLsMdjLB8HrS59TU+HN50FnfTd0YAwFtS",
+	"Hijg8yDYzWkPit7JpcOyoaFybR0VQBXdpU92xaSUVWoT9kfNhqvPh1QaTbv/oLEiK0W4Vhuwgb+WyBLQ",
+	"Iv14j+0DAyuQdiPYyXMqkmszHj0UssgnVhXlWrP0m5tnbr31K+uXacpSG7GEDsg76PdTB6UvoB0QqEM6",
+	"XjObDvWs9s/iSpenJRqfUoTN1iNG7iWJabQLeu7lqJ+WuvVUoe93J43RBn9I5wloZvBy5mcXS1dmf1Na",
+	"XLw83ndzzpT6928R5CwCNCYAqfYppCUxCIRbWY1k9GI/KHe72BR6xoQPzaPslgxuHL3eFzIhLVzPxR8m",
+	"M41y4yPQ0mjmQ99hiGJdAO+enZ+bMXDq0QJQ6hhNnoVW/sS+m2DxJw88++iP605l4neNZc80cP8y8BHD",
+	"lUd8NqK9xiTYSFX82TuFRXPJwfM/7TLGlwUBwvuThkz5leBehDJKQpY/kVq3hV9qVNOkEuRLZe5I7XrJ",
+	"/4xvzP9IHwTnR2IPKGv1Qc2/UUQub7SP6vVrK7mZ3w4mNHER9PXmRvCnuF3BQNmzrB80QpBmjF/alktl",
+	"DqdOcTxe8DSCzFG59abcYOGAauuZfflfTp2K87dIB8rvL+ed6fXFNyqn1z4421x40/vXc63C+eq7b/3u",
+	"/fzKO2esX073VP30gsl98KGpawOBZyDithINFoI9rCrSGlaxV0xjlL5j5haYEfiOazl+3y1VUPcgez3S",
+	"+EsGOJS0TBrgpgfPWaEKEbSHX0gVK/g91P6Hm2CNPQzv0zpglF3HSBp0LhjlWEakZjMofuO2Q6SJJSB3",
+	"52X/iSYaS0452iV0pbRRypPqun+cSz5OTZp9yHRwbia+4exS3Vq26yTQNdvNzeTsKlr0f2g48Ptq5TQK",
+	"hir76nv0aAwSFV6bcnb0iQjmYLcfrDgN9sjzThq6ulfMYiJKcJlj3qhLKGDeI5GHodLKTKK8hITIkWe1",
+	"N6df6iEhCw5RIHLOChLZseAxk0wMHkWSaRRnx4egTnWj99LKc/QTWywcqRGTInxZmphl+FiC7zJdk4hm",
+	"4kzrTu2FbP4uxptBMPG7eucdPHWZUGuuOm9KfedIzDqA2GYkWwl3cblVq/s1R8/vpIRVlJZ+zGjVYQOk",
+	"Nhuz83NKDCa1V2Y6v/Z3AlK/SdMg+oji1vk8d1iWOJyq8B7ltqOwzo4yfdRxkWrYpHfXB57w5xEvGCwg",
+	"Zj+eIizw2uVCafbSlbmrpXcWrl2fLxpT4uuFwuyla1cv/wv7y3ic2zNqDu0NKTIit8YeAqtaGRGOFfkU",
+	"w2/sGfqD6vMEHfpjGWcbmw9CDxgMEsdbRQ7eijyuZAaNJ+ldBZVWWqULZeJmir2UttmP2of5C+SmPYjY",
+	"57pHZn+Otsow5U0bwI3I6JIM0hqxPAyXdExye0lOb9EY2KXsxXMKYjBMDxLudwTHES6/xij40mFwaqtW",
+	"RFY8jxEQPiIbMcFVmi6pvdgrY4pARPv7fXIv+erRwzONng9mLLWJ7zeawrg+d/JATewPv8v738tpnMVH",
+	"2CO9N4dv+Tb5w6n0h6uuVbFLTdutNaqlG42Wqwcz7cqGPjKcBwf8uBHARayN5CTn0hnF60SoXvouWb84",
+	"LFakpuu7BvZaefZKqThbKhYuLhQWSwvXFqkO4J2F2YuF8gUjz/qXJW675LBUqBwajJB3rAXd03Ar5mJN",
+	"n5Ugd29M57OJk+70nPNUBAmnSxkcL27yiz0R58goUJc6u8HqiDTupHH4aIQavfRKjn275FpOtbHGx6TN",
+	"ltq3ao2Wp7RaS4vtfg0S8T/DTa6Odii7S1qgT8kyxlj3a0b9/oSlftKEYvzQieB+3ig977PPuDXgRTUj",
+	"Z36B2pHvsGONc8UJKYwLlU6bFGeL604lKu7vWW+dmXYHFuX3o+S1PucmQ4/0Qh5+IkAdSjAJPQ9+f2OM",
+	"lW22o4BEeD9x9CkmlJYdiY+LEvFZgyrOJsYTe1raoKJhq218dOMhgORASUFib82Y2O8Y7KSj7+UjoWik",
+	"ofZIzfPHps1b72cGO4kJBHf9QfB1z0ezMiBNzeVsopw3vG+MUVYPaUA5aFYEGD1lTaZ7ghBUgTGTgh0N",
+	"T52jxELJi63dnYTimq2kgS5+RLefYICMthWC+rvYknIf6UCp/RKpgDTMlb604huaKIW8lOIL3MxMJTgF",
+	"U00hmoUupeTmgg+A/7LZF3TKMG835vH0d3QqyXwJSJefPj+Rn56YPr14Oj+Th//9a99ZwvRgS885Z7PD",
+	"C14Yl3g/h07cdDh/vifx/el+KkgEEixjua9fn7vEtEBMCfQHE1DU1pCAXOpxcriDPMXd7knn2oXxKtLI",
+	"RXHUltAg1jcj630c3seyfWqqIQy2Q5o+6QlobXBa10jnmPKEyaj0Eaw2YaixuyAaEiS6bPxzzGm5PPd2",
+	"YXHuSqEM1cqSYcduobXvICUrVpb/UYLTFmdZBk2kdgl98jzoyoZ8zLlhWu7cxPR0by3Xr9Ur3jx7L4M/",
+	"Qq2su0ShRn1dOorZG0sHdvA9GQubJN6xBodHU96Ua9QdaeGG4NWGPl7q4HARqIQHD2zRSVv8RonbS40i",
+	"8BoB55dJRSRDUEqQ9qHwlLR7Nl5UWBcDVJF6LQ8KdvovFVcLr4eyUDq0kuy+xBEoAvPO1lcjr4P1JVTt",
+	"qzTE0ihMgaN1HCzOQj8OudurTDu/ExG3jqcdYMrzFUjS9Kt9IhwW39RbPBC64Gljbez7/oLRChJCIeqb",
+	"7kXUxx7Ve6zHV8ivPveVK+ZXhz+Mgv7s7XzYfXcCt1P/x0sfzOjqOhwG8ZUQxJTwbGqE9Uc5rNqHg6uG",
+	"XoeIBKt4auS1dMuqt3ofn72CfQnkF8XruJ12dIxXkVrYv2+va4sssHiM15JxAmpoKJVem2IQ+8IuogxY",
+	"r7R7Ap05IOYfZdPy09wCGRceG6QEE0de8v8J0hDsKdWcfdumVn214db8G2vyvlkoEk9YAf//wyGRXd0c",
+	"NKzD36dtjL33wfvGzbgBcWblLet0Jb/8RnXaPmudP6cvUPLTfa+/xZq1Sk9k/cXeLxpjGlCdgbetOatH",
+	"iOj72nwkK1sX3JS8ew48nODgYFHBwOLMjpGMRNv9wpJDOjDqSJ34DUsPEHZOiFZ4/8KSw98yYv4TzJGm",
+	"gPyGD0jE+biIMDOa95SqfKGb+UN6k+7dJCpzIbF8EmNWtrKztKes0AyUSRuErxy7GN1jseF4QEQSH2XL",
+	"JjZ/bW3NrtaEAKxYmO9gUKvEGGKLxjnCxUpFK6gqASkHaYrG97JEiYE/Ze8JIbCOBjN5J3MWvWL/BBVS",
+	"2e4zrfbFiFNy1uWJTupSWYnFhvBvwXPQ33BOALGrmKvwE3UsUB/5fuFfiqXZy+9cW5hbfPeKjIzsrRFv",
+	"2ute+kETdFnP6qeUJIy66WNfLIbX7HAyoB1q9Nkvh1y0FFqED3BRuiDpAHntEUWJkW6yVXoukCQasR8D",
+	"XcerADaVuuz24fUjNCysW00wIBpOtTdgQFVP3P/d5ed8irKSz5M3z5/VNyxptpbrNe9GqW5b1Yzx/AnY",
+	"ZjbUQe2LPHk0FGkHyn7yY/o9P/Y1OiSqH9ePU6wxfOfesuoZg/0e5axLNBC9FjifBJcLPdiOhcqmz701",
+	"nc/3bkshq/H0gSflIGU52AbUqnsF8qtfuBgjlkw/Q5LUixlI0+cyShmeOf/mG/m3Tk/n+6NW5G0WNbc6",
+	"nX/jzBtnT785fbbfex3CUIvnfd54o2feZ7qfvM9RENosrYOUU8Mv0Y+B9o9Qnjr8sUHH1UOPqc+eptFa",
+	"41/6KKS9gp1IgatO34z0iE1RoweJy3r0Se3brUDqV7Dui4VEV9v+m60eivhzNF2A481vD6l3Mop1YeBm",
+	"dpPYmM5SxjRgFFzZ/xctp1rTQzeLBZMzkGE/1XCbd/Fn4RKVPTFpNq64tqSuE9bFDuZMWIEWL2hkNBZP",
+	"sUg6vI8JmE0ytjEPI0LwMr/as1xfuhr1Y2kNGk9oh/QfaAlgpTgiI8FQjhqfUT+0u8jfEtU2iCrM3Zy+",
+	"FxtQ88jSk2iIy97Z5F6C/iXZFEV2Le9Vnz4t1LM+Zx6CX3GwM6QXLVvOlEUhMSnxhelDaFNSN0c7AHsq",
+	"4QEru4+nKbmkQnpP3GUxQVa1WgMZtOrzyhRmI2NIXqk/B+p5ZnT/Il5GOj5jQFGiafg12zWNxm3Hdg2M",
+	"rU0GLyYNyMzK2azwy8hKx5pKuUssP6lNtUFtscB+nVBEBm0L5HVgzK8dHoVifZE5hyNgl3mskuOQcY/f",
+	"4wWJz/FgA+Nhg3fmeoglpztQIwkjC/8IPrRplCehKVkJ/jMB/5kqU2Ggcf7MksOI20DnoRU0biZKb4WL",
+	"Z5wmb2X63Dkjfp1pSNQICLc7My0pIeq2i9y2yS4XXaK+VehwmR8HRFX/g7poy57ettyUlz1llzPZSkWt",
+	"rBz1RrUaq0btLZHHl7qK6axXLXWlDL9o1+2KP1AjwD73TsTnqmFnPar2+J5JHhjo99B2lNoGKCLGOggo",
+	"1cBto0zdS1ktqgof/LiX4EVUxZoIoPwUCurhWD4XWmMseKGvcQCo0Pzl2YuFK4Wri6X5a5fnLv5LWY5t",
+	"rTXAsXJtDMY2Wk615DaWkc7ltl1bvUHAUeXFtAEwJkqlo69CjMg4ZfZ5KxkW19wnK2ifcGqQlNkgsCeG",
+	"OXaCNtfhWs3geVXtmuiIpHutTmpFOHKPyyqf6S/84glVrBfklRlGg4dUy+4HIUR31VJ6jjp8JDcjRU4g",
+	"7F35DCNDnyjA8fzZN8+9cd4crCd4gpgrNtXK+PtVOfqenppGbf2pmyRCmfsjXoooqM5IB++dLJl9EVM0",
+	"TFYuyAFg4nmjgTKRwtOw76hwiiOlOVkyEYpS4CIJE8wy7/tOQqbvrUS7MqkLVLZCHIWCA7fASiX/n7uU",
+	"ORuR/8iZyJWVZS3X+omjDdcZinZbwiuKvXGMe0vaCr13Z/9YluE4SYfzhXp6Nsm3XHcq7zWWezfO5611",
+	"71GWoJ9IJNfBSoc7AEmgmMT00uve9333vkeyGatajbWr11b20I/XLPemXS3xDiczH/fg8uV8NiVP9dhP",
+	"n5vO+L3SRV9jEynNQeOdwosF0ioS8hljYXvU6RlCoeqRnc+nj0SqZ4qumNb9vk8CSB6H1pzLZPikbwlC",
+	"QK9E1Yrk7UVMQIYIBEJ4bFd00w4fRAwf8kzxooZNRoou0YAIvxufWXMqLqoXgb3eTKMiikhVHkhERAYu",
+	"xF1sQHgXM3qx8ojs0L9Kddaq13NmThpTqhVue75oFKVOOB1yrl0tcZpFDn4XGF2jOMu7vjLW3q4aY0Ql",
+	"Fm6pbGGM/7Ef1Is7vHYYiZ6rbstxWNMHqfuqvtNqNProqqF1JHNrq6u22wfkP5ppsGR4ww4olK9VRM8M",
+	"karlRYvw28wOmHifNctpCdGVcSvyQ42x2fk5LgVURHB9bhyvd7HZEmn3coQg6qf/JsPlafsMJbtv0uti",
+	"BBNGjJZH9OjewKBaNa2dDF+IZMJCVtFJBayeEnG1mHIsKOfnh+nGwjHGlOiBr1ww6QN7+UajcfOSXa/d",
+	"st31dEZ8LEnaJZPqgIjNBPW1cZvuIggsB+wMfLiGnjjiI2o4PuB+1Y19i7kBAvhct11/coVwdSb76Npe",
+	"o34L5ZTNzKRve/rWV7VqP2yU6CzzyC8uw28mZhll+4RYPLNPfuhjaMLctNahMWF6cIoeqLFDn3OIEWs1",
+	"G7Sj+8vhItrVpbSWTe8uLs5PhBuxbhYKGABrMA9YU6VNfE5vE9ptpbZQO8R5GqE0hTwP0BsZnyqLMBfQ",
+	"/lol99SiMeVwfNo09uBXVasWuDpMiWWioSBirwajadqJgivQXAjaFCB3QZth1xRtDLYCiLoxf624CIbw",
+	"e8VrVyfoluCPIA9xUn1gwrgs6ZACyE3ZVL7j0182lxz5+0XeeSb2ewBHWn7LtctLzljZu2FNnzv/C6ih",
+	"vWF/ZLx7ZfbiRPHd2elz5xX4LmS5GEOe6GiDH+1J+pa/CycK0HU1OswBYjuwV6sp4trfcZBMFDea3kTl",
+	"hqUPEqdVoKTUSCR5ZndkfdVhbmisznbkGBqQbG+SfT9ZaaxNSc1DBspYJ5EvfFEGA7LEtlsqA3204gIJ",
+	"ntbL55DRrQGX+ALL4TJvL9gn2/8RsaQRPDJCrubUx58/QnRtANxAbHKHyc8Xu/URqPpid4pioKkSMPol",
+	"/1aHSI4BjYe1nrEJoWG13Jq/XoS5Zmy2SF4OjObRp7f5nd/7YDEXt8ewTEAlGgu+Qsx0N3gsuaAJrYWK",
+	"Cbzddyzfvm2tTy45ctW8plmeUalbtTUPCXsw5WByjp7JJWfJCb7GQCTzmj3b9WbAa7bqayVqEDPpNuq2",
+	"BwcS0fmVxTWsPtBgBYJwId65jOcIiiIKAk5HNLmg7XJ3YCYRFoqBX8e3KrjSJCo5fvIZi7a1lkyvKq/M",
+	"iMteMHL+5+F2+KUcOXxMSTP09Rl/xF543+CPwFk4dUqdRUzBbtPdBLzdavk3JsINlhrp4HzuTZ46ZQT/",
+	"llbUiAknmdahDRUiS44IQlAPMuwTwBE0j+SsC6IKUGV9Bv8N9sKtrBZx45MxEoXgRWQOoTQIyZOEyBxI",
+	"bCj4iOEc6sqEN15yYuS88GQA0+0QZlXJEPDGMBuYIICwXNSgAZbjn/4pc07hJ8ATLZq+kj8DrFRYb4rR",
+	"YoP6Ho6zdxMpzc0YU7Q0CTKB9TOMYwXfJ+ZFqUD7AudTuiFWj0WEE9o7YAYKw0L4g7/j9OwgmgSiY2Lq",
+	"gx2NfYk1P7FFhgn7J3kLG2PvTl8ZX3IyBVMaNR+wcW3u0kVjTGnNYFxsVG3jn4359y8WQGXgC2/gHFDD",
+	"3C6SUbWWy2YPxUH65gcSJyMOI4jwaSBRL2CCg318971ofHQn4x+ffiX2r4GPoagjN5cmMJjrlfGHZfxQ",
+	"jphO6eSQ+v+Nqxffqtm3bZdfDWXd1J1AraKMct5BB2+gMKJnErB2VcLusfIUTdU4PpGKYfkPutGk9UnO",
+	"flhW7DGlqQJ4JjJhcdk0Jicnxy9EOzWmNGD9NAMKt02DssXEAMVaQ0WvP4mvl7gqtSmQoIJBMB3WfYto",
+	"jnY+KNkC+6bDc5HRo1W1v4dkdrBHT52KN9kOvzh1CvnnD6RqVQoZ41/HDXEEtaW9teSohHyYVoHlNhqM",
+	"AJmrvH9KHKjG2BXcxtdgQxrTk3njIrGJXXRtPAOsumes1Bu3dXs5dauKNAlsTTqwcQTYwIdtJLndBp6v",
+	"krBzDFM7gjfC5HYNFtPn/RvYkkhpK+nWJGRRUW6EV2mbOlxlBx+wFSt2TtxcJmrQjRyC/RGJHmcdiFdG",
+	"ecq9pKHKZoa4KaDoYIeYBsTnDd+1HA/DckytCHII3YBgEvfoJBUZBgE+Z20A+BxLt+s1prTNA88ykFiU",
+	"HbPFG5ZrV4156gNc/NXl+JboGr9q2e569DmJKo74F6LbGDXH8yFVldhjUTskxI1tojIDJMynLHhCuc1u",
+	"+BlRfKmPf4yy0Y4ssyXn7cXiBM7gY5Zrx06SEf1cuClsih9Tsz09MQtwi/KpScv33cnfeZBMkrK31Cs+",
+	"JkS4OqdOcSXFFG7UsTfoxvNoXZar3A/vA4UFx3Pptps83kmwIcQnvLc8drAjpIVh+WdmlIX3xGiiGTSX",
+	"HJgFwB/qWNNYXxaYP0PMB87xWRrsJ2z3Qz65nZ0WP80YlnXpQSTeW3cqp06RoP8xHY0yho/YZKZ5O3hu",
+	"nGa8FmTW4bbvBo/gOFTKlZ+NLznTOIZ/Z5uH3Z34CzeopT8bgSqKL8jOFJY1XxKeOTybP0uWNuQKz+Az",
+	"fpASmNKblTHEmOh0NvVxrXoHW5yVl5yzcIO3oUpZupCb14QSlaeuj/TlkpOxHxDyF94XJpPUZyVaBpGH",
+	"fcxyKs9IExq/ayyPUwWCkDN5wQCqz62HR8xOgWNqz4TiAGwAjKfIrqQRIvQM+07u37yvHLlGOeoLx5pD",
+	"1WsVm4VVmIc57yLBAgvPRDG41Zp/o7WMwbfl2upNy5pabYgoHPYk9zFmpyi12fk5qVf4TC4/eXoyD79u",
+	"NG3HataA6GMyP3mG+kvcwJgBr9ujdurgW05RlGRVG/P4KknKojnOwI88UJkD9zScO/hTtfBpL7W18Axq",
+	"onbUP4poRRa5m/LPhsbCyWrK0OHKQ55Dcg6+4RSenMxDY08av9B/Hb87sN3847P/R/vjuFWW0dCBBIg6",
+	"ONQazlw1N5N7x/YvtlwXMIEehjZ40gxXdjqf51ENltO0ms16rYLXT4GyhO8oVNcrkCc/BmMmiRrRuARs",
+	"6yQgZWlBRM/mT6cNQrzV1HXHYj6hjSDzc/l874vmHN92HatewKSoHDpD6ik5aPbbD4FMyWutrVnuOs9X",
+	"SgiwFG8qZ+Z8axW7u0XbKPchPErdXthoe8DN9QJdbzLN4qX0aAR1UWntZvTknsRYmbR1HqKvtMU93a7s",
+	"e48lmDChjxXrin0QaWLuKGs8C1CMqm+RttH+ig96KJE3aHynjlHGiSPrVbcRIGaNN8ewA6o311qzffiQ",
+	"Ri8W/WTqMub+7pg9f3iNkoMgJCPbauI9lEC8btP92NfiH3pznc2f6X3R2w13uVat2s6xbMc+3zi+HWnb",
+	"fYjweM/P6kBFrc6i7v9b4ZdkMPA4W2o4gPVPSxCe7cEBAOnS2SuluUvzpfmFa7+eu1RY+AUR0Y4jeXBb",
+	"dFeLGrzxzQkba9dYrrjrTX8i/AT2hbnkiAt0ZTB0oYgDMVNlrMw7rsmN2MD7TunEVmaheaoyFAoiZeK7",
+	"yei34IcR1wpqG8xwGmfzbx1GD8j9NlVFQJlBsYVyAsX5y0Z1ffhblB5HmzPKY0HK8U5CQ5we/uO1WuF7",
+	"/RmlpJFpew9PZ+FeztRV3zI29ru40LvhJjvB5MA5uk97GTE3EyP2eBa2ObcV/HB7/BiV3Nn8W8c4b99k",
+	"TghNHxzGSKVAyFMwOGUiOxF1EzR12liNpPXoBJf0HtELHI+G55p4M0vbjiVsjmfw3uOpql9viqFrS+cB",
+	"4E7TeQ150qVPD2NMsX7GRUlcyuuwtlrabcv8U951tkvtk9Ci5h1XBJOdrGnDDaX7N/NEhqttL+Gsydp2",
+	"MLsLLpqr6syps5ql+Pc+vTspOhveP1bFcLb3FVcb/ttQN3csm4kJLyUG+hbeNCnNMKsGcGcUFz5VrUHS",
+	"VogyPMeYuwS75KsIWaa5neLHHCYeMFSn5B3bH8XWyB+THfF1Hwv1s95dEcKzm3lYZbkjLX3LfE3aKSbL",
+	"4ScaWU4PUSSOJNhMfxFpRiWjfOoU3gQomTZExpGfJaY+6zjJ4rixRr6aXFm62wZR1+AhMwUj605qJq1E",
+	"gnX+xXCPNwKZDWkPj9ALoXH254XkX7IXIudUocDvZHoiP2u9FqmfEdsNGTb5lCV3xuvLNs+M2oBlkMFG",
+	"H+wIRgEsNlV0JSLsr88R5G4DdSJLYKeb88moiSbyYYzKEOetEkZrj/+Nz3eUB+XzrWj99kneTcfqzb/S",
+	"nrnqTGTttoGd8zRD6E8xdjJ7zarVTdbBz4wh3ah3RYwjPRqmVK3LcMyZEVuwj3rFXhiW7Kk8RmzkZghi",
+	"thiJN9JSpA9pyQk64QNV1/DlJnjbZxH50vFrmZg9NBQtM0KzSG39c6KsowzVGQmUrDxfG0ivVfrwVfo3",
+	"QtRGoNSzrLum5Xm3Gy7W6ehV/9/SOmUqjQBeSJmzjMxcmt2Hlh10+IvqB4zgIXtRnm3fDbfgFojbZ60E",
+	"eKtPqIfYYqgj5pO/wHzcfUHRecwquijFnOb5JJ9UBc0HyLkt+1LRZ1MpMFkCdUtIjoigHL8OlUdEVTyQ",
+	"fROrrnT6RdnsIM2rROf8WsP+JIxmRRhFnDASjqGqVXBbJ7jbirpVD3fIUK6UQoxbtclu/roYAOtrTXfo",
+	"mfGivnps3eCx7RlAG08YapXJ8/BLQFqLMD1MIHUgwI5uwaOgLVWuzCS1fZsQTs+DbnY1TJuofq6x6UvE",
+	"RBOQ71OnNEo63J4xYJ37gA8uOcbREYRSlGLCYBFdVhvSFqzT3ajPVdR0RsRzGQmY7uZRKRr5KgTl/gJH",
+	"vqU+o82aHMHROkYpenB6jLP5M+NDjcoWbX+hUbf5Op20000e20AH2zH5HgtyaOuEnJYn0+M4NlMhJVK+",
+	"zwgggmfUgEVOdLysw2tKck910eHeJ0NfBxuQLE0AEU8GWPZrBXh/MJOBS9vQqppgzyh7tu/XnFWWxTUN",
+	"1jkVStCUkqlU0CmMdAEHOsoNzZ8yICQ0OlxfZRCo3Nc4GxAava+WakcWPZjRTEjo/0Ynr2uU/Ruu7d1o",
+	"1KvlOJeHaaS3ao83fEijlOcl6JtBF+xCzoUwTNFmhbQ62e4JpOSCNyogJb//nANhgOPGUYq30+rlSJp4",
+	"myCe2z44KUdkR9fhU94Hx5x8OU5kZHx9qIZzl/o3d0UIdc9AJv5ODDVHe3WU+oq1Xk/DNwq9IC+WRm2p",
+	"+VOutvSHZW844wnQLCxXKWmWwez3SGf0namMiYqcnDz4WaX6M0Uz0cL7cKJpnlR7DfB4o5K6/Ms4lH6O",
+	"oqu1AtX93b+g6lMP3yc5kRJtsnqdulEKWE3nYQKY2Xoma2VCQSxzyYFiZxao0iWO4/uR0YhsKGT2yC8e",
+	"dLSTwPF1bdH+PCrho9Y/Merfssp2Wz4x1inLRA9xN58M2/alqJEkz8lr+/YVzJO8ogaxwPjwpg5HN4Yz",
+	"gkbfJe9oYhCUUa6g6pT41yClq+efoAFLLUE0vaiB9y7ipor4MNgrEo0TyxFI3ar2gu74KFTtUWJco49v",
+	"DRTb0p164ScaiXm1658zQ199n/u99kyrWvMnkFd8ZNwEsmEBgf02OmHIjNRjFwQ/RleqWCnFhOFEMxp7",
+	"C7qwqGwEJvS5wc6z2WRLWOzQlvKJu8b1ObN3oNEIurxjgsdLFyj99swg1bvJqrzhbXZ5z9vwHivjTL5F",
+	"Z1LQnWpYyZ4Z5WV7peHa5amyteLbblnlW2vHSzyk+3e56LBZDA5EfnY46gfF69C6By4ukGiOnNXB1DQe",
+	"E62JWQR3I9yK+ueGW8aYVfEbLjT7YaY7fQZ2n3HsMZSbgZ462LuBUf7gL3KmpBsTdL+9B6KyJ4Zf4i6i",
+	"ZFmXOlGahkc8fiWGpZ8kku2MYcHDjjgucm4gOSz1GQ7aKc/0LXfV9kv4HPnBvHtCi8puYi+ibbAHfH7s",
+	"/0rL0F4cKJVqJXb48c4dJZfcBbY74U61VehYVLpps9agHjSyKPmNm7aDTQ7x95VGnbfE/NA8zLwgoXKc",
+	"AJWMjMHmqlZVZqr3UL6l9DxZ+nIDJNC8O8LLlPrzBgdpMrLiNtaUx/fDNK8Z05+xeqwTfpocEaZYBhyW",
+	"3xh8UCON4QitNaBRk3pInqCcvLRgxhgIhEHMrb8w/Mb4T9XY+j/I2Aca9rm6LpJpBYuuWlaR1sgwrP6M",
+	"rsXzoMMNATgh1cRihAzEIxU60iOjX9CFf1MD13sSH8CewRqPwBf7qGyAn3EAEI408uwD+qL0iqPkHxOP",
+	"GWhHBbuxyf3psiEl3zQSTVkOM1LehxEOFqNLyR9HyzaiBHL0gJeSQZbeTyeKsa1NEdgo/3cytDpBdnhJ",
+	"1QGTqaiEmwLSTOUcM6HGcYbPdIt1YkJoPWhyYps//DJ18+tPp4F5cBIPxLMIite0J4+hn110fgXNNKK0",
+	"oy758HHJIfD8lvx01ExjZ/NvjQ9QOKHRWam5aUVrDeZzSvqo7+y0Zmpel89qt+hXyWOuK0DJcWmS+xq/",
+	"DLad/vdkWsb88Maalil2dFKdf2kH6msCGpmAZhCR66/iOsMukGyCSSP4G7vsC0kwox8Y+A1FPiVG4EfB",
+	"wZKT5uqICuuneMrdZaHmnRjNOfYM35dbinai+LQUWFa7O3QP6wz1ykkPd5edEIP55e3vk1kOfZJN5hN9",
+	"gL9SNna8Ono4VvbUmr22nMkCPsRjX43QXGFPfhWOfxprz2DP32LORldjIf68KSD7maBBrYRUiuEtrAR6",
+	"SqlZIb6YAIFUDoTrMQeIlZ3wrWgCNfzzuGgnRP+kH8k0ypd8MLOp6mO3EeEOIyw/WWRu8ShEVAHMe6P9",
+	"rLXC16KWOSL+UNREO1kJzn7K2+pHE3mEQ3DqY6+FHW0xBXwn+tgrHDXMUPWCvda4ZQ9ZVyRznn+FxLhm",
+	"pnlOEzryRClNeV5ycUUwQKq8n2z1daaaMyhUSBiEtjaKs9nDrlUzB907GZtCOKeqHx4WFKrnNc9zymbu",
+	"/8yXdi22SBwZIkwCXxHNgYq9mhS9dcM/RmAKDv/t8iiFzAIbPAi+No2y3/CtetkQgZH7csxAuhM84e9R",
+	"77xEAs0A9QhQzvB+jDshMcHgrWxEOhEysGWeNtXhqBQYlNTk0jwUwkq6QVqa9u0alSifCATVJrwWMg9s",
+	"pQA6PN/yW54WEgQwpVugErF9sV3NmeyUqB4WmfOCZdA3cS07UQuWCD6pG6Nrg4FTazilZqNeq6xrR+vb",
+	"a82Ga7kMW7RmwdQdcqCxxo6pU6cgo+J4oajRfAv/cpgJ0ykT3VAixTKKUcgBPQIxYlvylLG0mvWGVbWr",
+	"peX1AfFT32PDbo4BZaYRs4ikdJUxxmtoOPFQO9H1MBVWVavb+E95ZGvWR5dtZxUU/vS5c/3MERQAdAhc",
+	"Fm4YV+auFCZ4rdGM0g7IGCvX1qxVuzzOT3b6Hf9+qumslsfNJaf3Ky05Ke/EHIAktm/w94ImbI8uCITv",
+	"PmsuyRUyAr+VevtwW2pNaojeGcih0WWVJF8I4ptNeiNSKfVG1eb2ih6Ft6q8Tc231zyNIIn3slzXWofP",
+	"nr+OHRtB9nWa8i9SI/uIC4p0PiE6JWkzDbTfn4WbKdO/VnNKXu0Ptn7b1Rz//NmcCb+qrYGmyovx1hzf",
+	"XkX/TzdESLFtDG+Q1kfDH+TfpbxApCOeiI63HUbIRl4r5itE4EK0oUQXRyruCNq99AriroeBjexz/Hhs",
+	"PQ2eDGf0BB8/WShKsFkW7ErDrQ6GopQMy5NTx6aanq80TDIyNzPpWJR1iLwNvDoLhfaDcsCwJslKX+Md",
+	"1VXoKJycsTwf64ldIHfifiI2Oees2p5vu0pXyr/zJyXRBmNlxaopj8dPRGnAiRhU4nZLTsJtoCjFBSV5",
+	"mhyGXFcmO0GPg4P4gNr8UWR+S6o63JLtFzZRxlj5V9evLc6WCr+5WChcKlwqm8jhNmnE3hzb8UYHK676",
+	"Dvp/HJYUbiSHjqR2O1G/bs0ItD6TzmVKj/0u2Ks1WNW3ayOjriHtRI8ZiOft9AgUpFYVCSl+GrRjMiH5",
+	"5ieT7Y3azGDwcYc2Omdy3NWliDE4JUsnB44pQkm7MkUok3K45BxVTfeYUPsja61Zx/CmjYoXLwH7M6du",
+	"QTCCbM+zVuFPrN0ntOf5jNfNMl2hjfQYS7AAtVVnKTcj2pVDE3pkeUZqT7DnoLHc6Xw+Hzs/75hDXXf+",
+	"eKp9wR70Qv8pMfoBXvD409h8Z6nJ67lLPGXde8cd5lSGq6aPd59GSMQDufttBDmKDmXaM/3ZDvpK8B+S",
+	"x1a4LZ6gJBq4EREPVk5R5Vc68+2fUMVsEeErNysOGE4IOc8PWIGL6GO/D8qD/u8ho+vYUzbJTKLJlWnI",
+	"bLH82yWHCXj859wFPQh2TE3ppxIyQ2qRb/lYBBld+IBZACLGCttkb8nBo7JUq3pl4epLliiZHCu1OtaM",
+	"jjGPmIiWifE83Mbe5zKYctzgd0FfkNs7jEiEnHQF544GizKvQfcCBmxjXzLP5iGjv+2gQlI1EhkHfw7a",
+	"wZPgMTmfQgQ1rTjY/sSqW5P3Ui7PX18sT5UvFS4XFguGKkAfs/m6U54B44yT20bnAlqaKC+sWUixYOKh",
+	"IpHlg9jsGMGTlKKhSQNlHfgCcR1gbXHSlxwc4ROUnj3JPAu3MGVefqewaGgEnrE/216r7nvlSUMOa3eD",
+	"p7IUmAl6g33cBgmj2uQ19k/DLZKI58yg/TLcpN+qL1BesWp1iSQG8kbF2eQyh1/S7bil+GW8OFlG9skh",
+	"b0jzMG9q1bUc30vYgT3C+bGnmggdZjsyVtkLCy1wxcI+59b5kjPGeRuVrRxu0zJRQBqiazua86r/nADn",
+	"xjl8UkCMN6FzYKDs8IIji/2ejzydEhKMzl+y+tpR2dV4/4GM6unhP/8af3nt4fidItLbzAUlKgYO3CfZ",
+	"x8o8bNAefnFC+27zkwE3Q/gZnR0QQeLBpGRM5ZWOXejtj/8lAuS0ybbjimubGwNqkUrfdomostHnU3+M",
+	"d68yuZv/CLf3Bq6Uhl1+g6LzYJRRyOOR8ibIOhE7aY9TB2UR8snaZLDcpLhy1JjEPhVCb+n5edH1Ze20",
+	"hKT3I7KDbbOpiuVU7HqGK/BduKmWOiSslJ1kR7muUf59y27ZVWFOl92WAwQSZSyCeKK2hBMWS0Q2A7YJ",
+	"94FF2C55xV7QZTGQA+LsE0w/sZ7M2TYZzULdHgSJMCyrQ2tD4Hh+Ors+YQYccKl6XbuXPVNSqET0NhRT",
+	"drzqSGiCoD0CRcTcsvRzX+MLqnueoba5Y6gYZG2NE73DiEXD7eAxIlqS9zsR5z8HJjFzn6bpSBrBfDmQ",
+	"poTiHQTY1KczBLNTpKvvHI/mo2f2zL0mxDe8z6ZFspJf2z5ylDMxXb2VjmZK+1BDIpqVCab+npACGOdS",
+	"M60UL5Nxtsx9HCNBNv7x6Vc83AG50LQIGfotO0uOGmLCQBWYPoy9Bv3OTjI8yjOTKUyc71yUk7y8bwf8",
+	"fj/KOOD0doJ9M+P2OPssNBbdPtjhMeLOZHZuOKIpIHtsK1F7k8j2snjLcarj6JEp9AcsaTq4Gu6f8kBM",
+	"4gshegjOSpO312YUm7kYBlQQhaN8Ah1lQro1vIBsa4iY7xP6k2FXa/74sWvEYmPFN0gos5M95iA4dHjV",
+	"Td6oJ8YHEbS1gHMja2+zcKmIUi85A6DAYw3xzo6fBFg4i74McafnjwvZ8JfMxf052hpaNFaPadJislp6",
+	"1uw442xHs8MkzC1AjKV7mAY8yTTIaGDS/40mz6mm25RCjxjxtsbGkB8e4YuIfrcbbgjEAZUD4hddGPe4",
+	"yTgmcNskEmGTRh82hXTFkiMz9EoDj/JpphG0yfMVXd/7tkHi/aMVot9uuLnklJu2UwVKUxaGMYIfo/xx",
+	"ZK1pzKlU28hUctBLTpkVX5TlBpLxXrJKUq9YyNavcvI2xXpKBcudjNAW8X8cXaWOCqoG2pTGeNy1xYfQ",
+	"5nGSaw6Zfd2VdNiGaQYI7eK1q29fnru4qMDPelqgSQDLjNH/CZLQr4gQixmqbmOYwLRDvNFLiFEOfgRn",
+	"Bwhq1eYUi0YNVOEpHwS8JbvSqQhBmqJR7QzBhOXlxH9/gUinfTjVwrtQTiIC6IZrW/U1U8tsm6a4O8Ez",
+	"kwDOUQOOcBvPXHwKVPTRaj4VTyrOTg7Ul7pWbWYa03PVZpFH90amSqOHaMsgpKWZq87/pLhulVeDwkt/",
+	"3Zh3G7dqVduVJL1WbWrkfN2pTHjWQLhAIiFCUXrOam8AH5gqUF8aRSq9N2ap9N5bcvB6wKltJdskP8Ny",
+	"ZQiM8a0yuDhmkJ+sOxU2Hj6cUYplcRYeSAFjvWSmbkN9AuinIrbYBC7YF9g/TmXf7qWbskT6pr3uTbkN",
+	"3/LtDJGOKGIfyFR4zwyurrn2JrxoF0la6Q8K8g9gcWjX70oyGewY733wftFgBTYIPdmnnbPkyPdlXVoQ",
+	"K7yJW6BDBfUcFfXUKDdby/Wad6NUt61qybMrDafqUcyETHJ5MHfBDyjX1tbsas3y7RkDjNcyNWqRo1zs",
+	"t3SK7OGHA35ecpeDH1vtcdaur4OQ0sfIN9bF9k1iqnjK/kF8Ajrh5+EDowy9ZepWMxq+XJMUEZNKQFfR",
+	"dIb2PUqK0AVxL+9s/q0ByG5jzfW0FTIoPkVqfvE+9r4YhesRPYAeKIH6RulxRI/1Mo7LSNwjsLKZxHx0",
+	"DMnAeSYvLPoAQqBODjUhs4oOFFflJ9wK+s98IyOFtKrWIKbBYwaCvnoHUc+fseZJzFQa+WHwQyQtVMnE",
+	"Rh0f8XsfLErqH7S9Rv8fxnYXj0xMUrBDwavggGwdBjOYq86zbhSfaPYF1BeMsWATU1jyccHrXUyuJKO6",
+	"ZeVwgKFdMIjXQzDDJX+y5IgzrKOcJRcM1/ZrrhhE1Kz1ARb8ZyhvKJcs49W2V7L88jiECvU9JzHpui/y",
+	"s+rWH6EKz3I5kmrupevUH3srz0jsXiukl6uQBlqsnmoJ+G1qnpfd7eYHdOa3wruwtY1Z6LjDSq9xpz4m",
+	"FcKC83uc7oBVYHVpOtXWZ1RzIRG0dA2KFVP8OXgYfgobeI+xSHfxo2bTPRtgd0JXsB7ApnlpNka4K6PH",
+	"XLR8q95YTZHHNgaKwAl9pH35Y9uKAwloH+OOJBIXJSGS2M5tAtu5DRrpUvyVGG95KleeKXcvQxg4VTQ+",
+	"CHZ5i86nQVuyIoPHvDzwDYM3jgV/JI1jNT1DARSATCqJ/uMJT+owehBww5Yc/B1U8BvBY8QdPWKnfS/6",
+	"v7JVr/8C/S2Dlz+JKQqQxg3rB8NPUu6Eb4Yn5beaIG88fIL6QLFfgz1CQfFVNaxKxfY8Axc3Yx/ijxdJ",
+	"AhKpGVUQ5i4ZGGJto5IBjNanVMcDltCYNF/P0mbrC1JHOrURrU8aERRbmQEZqv4qyWkfS2CMHXJ0Vr2u",
+	"jKxqr1gQ8JlZseqeLchhlhuNum05I4YpKus6GEGMIrQ/1YZg2k3iSeoyrhszKFmAOCVWNS3msH/NOGkE",
+	"f5JZuhQzHndZUglTUbHau3bHCP8nfrMnmddQLaVYE4LFr8s4DsLPIvba4ruzE9PnzmN7YbIa9FaFVI/M",
+	"mKJmr5TmZxdLV2Z/U1pcvGwql0GAOPycnCVVMYZbLCqm0649HzRfWChdLxYWjlV3UrWnsstGFCNSnkGP",
+	"PW4mFWUIH9T8G0W74tr6UPZfI8mX4kYntLKT0H9Po/aRJgEiPFberZN8U6YEUQV5/CfrscmrqnSkiM3f",
+	"iev9pt3QmWo+3T7u3QYOSmWeyoy6QjwyTNbgIH7VBur6rmJim6kWFQJ/so1eCnSTKn0efglddBG59ado",
+	"L4ARv0FjORAriqV2qpDvwePSmQ/O5k/rGaduNW4m1GWmrXn9+twl+dEpTOA9qLR7Uan2B8iOxF+doJ81",
+	"rfZ30UwcfauRdzpYuJYRlN4lIMRMIkqL4ZEx6qNfNgls3HDq61CJQdUjO6yUdMmRIY4p+wztu27QGW4g",
+	"ZKFB1NMj8wTgAYM5AHcZwcxPthuw9IbJ+IjZX8aY7sFo/GLsR5pAjKESD1Dkc646b0KqlzB8AsSzEyOh",
+	"WXIST500gr9gBIPwX5CW5Wlkth1ipDGJIaEhIz0m6ESULmCPR9YbWu3kGKhA/TPjA2+FjNJntGxBWEdk",
+	"R8OtX475jC+lrw3kIvRqtj3WSdVP1gSOFutVaXuccY4RWIspk+wgMX419TGYW4P0QJY01VeaY1moqaAr",
+	"/m1ydyKKgihcDonYAEy5AMosOTBSA5Ambq1qm0asN3L45SF7I/fSXFQWyDTXYMh2uOgq2LF92qFCBl93",
+	"QNZ3QFYljXBk/KCOlBb7HBe2tgy7UoTpZTRIPsLuPWrHZNls1SXWhy7r+WM7a193RJbpSbNEqM8OyDHT",
+	"wNQaBar1G94XKr/n6bDPiEGfJjoacTX+jfK3z8nY7tHTQjdE8UxWEseJdp/wFxO4RPIrk6ZwsmRPDX9z",
+	"4HTb4KlNHhJHMKVCOZeengo3eID/mVjA8MshnmdUBTWMPT4aI/7lVGn1NOJPcCvmk2q+v7K2xMtpsDwc",
+	"e561QJyweOHDiDrYYQFR8CNg9SBZVMKaWKy7rfk3ak6paq17ZQZOki77z/9LTCdwc16HDGKN4eX/fD4j",
+	"w8XpsCjOmhiuAJG/y4GcCoevdBfO5stQmE+wAQ8BT64KfIkxFgOpMMcujlUZZ1VVLDeLE8XLfbtYDcOb",
+	"Yl1QeKWNMsFTyxyGH2VsCQOqq+wepAVwrM1ljxBkshLmJ9QHz2t5gMHtt//dX+VUukbSjKEKWtp7pe+Y",
+	"eK8wav105vy5Ho2gRmlzq/IzUKy5OPuTjTFvUIYBv2DFuxA02kWq8/1wM9b4J6GW+4lERzQEolwG9K4R",
+	"/BuxJBCsUyKQ6urKqhSOepBZjtEGYuwNOeqNP6g0nJUa1hhaddbzFozSi/Svi67N/uYZK/XGbaKzkL8d",
+	"E31yTd4ylwR+3Agekf3EGwUJY18qAjx1SrkoFRejBsARqtsN9pnCPHWKsU8LfIwwzJWpHazoMKF2e4W7",
+	"1Z0zqiIj5SEvJwSujiEbQlKcVZbBGIuvt7Ju/2X8NfXBSJgMgh9VBRY8iymwQ0fCh9g05xtIUChkqRAG",
+	"2AwOXkK8PdzoMWHZ2j7DRO8NPPlbjJgGDwG1qngfWzPLMXrS5qS2Ja0/ORqNR2HyhMYbzNRUL++bUa84",
+	"G736627oSjf0owntQKRzMoNNcZbs/LlLo/FroPxr9KI2Khtaq+u+VqbvdSBbDmQfVYr7J3Yrzhpj4KaZ",
+	"hsLgxmGjEYdbPByspzRQzdyYFv6al5fEb8RNoS6GTlhUKNyCXsIU4YVi8gn6FWwP4vqKhS/2FF9WhB/Y",
+	"zbZl91eEenC2edtrpcYT4vH58ZHZyxT0Hc2WHrXJ/XIC1r01SnFWIRKLTsbXNGInCWYpVqh7HGbmFDUP",
+	"GywqTNeg1VlEQkGmItj3aWkwBGwSaRALnO2FW0dUIf1bBO/Qi75KdgEbsmYfvcNX4Ge+Vx6JsqaIb43O",
+	"ZmFyhnejIusYU/wAtkEiEU6pZbETLhiiUvsgnhqBjYvucpvn0DXDnlxycFHRiQ6esN/iuxjF2ZkYvlPp",
+	"cU8mYsEYYya2EXSnePdYIvmBH+/x9AU5A5yDnReGRal7fm1qG8KURvw0vWhpUDLoW0Fjz+/CFcQBRsQp",
+	"gEmvGBywdoMYtpObeMM7/ef/pTH953MzZfqWHAzbb1FVOz1I0Gfg9f/5HFUN2zkMEd7h7Q/j9hhnX6/U",
+	"rdqaUbZc3/Mbrl2iNygb1EJc23ZcLrwzjQSfYtf4FYT/2Q+SOAJ8l3gd3aa+xtgUYOCIMlJiWjqIG7Th",
+	"9hHVbVaT8Wbdqtij1LmjNtxkdfuyDLc+VP4JpoPlCvFnfSqJo4In8g+Gf0z1NOsUnzCDF+9/qRkYbqxx",
+	"dryyEowXTAfFWVlXUuEwuIZ38SLurpJ65NgsERUSmk32MXewcloqhmYKuot3aMe8zyVnrLzqWhW71LTd",
+	"WqNautFouR6jsobTcQ8eipQzlJovz14pFWdLxcLFhcJiaeHa4uzi3LWrpXcWZi8WyuMXRKfmvKBPEfxG",
+	"3YhpvQPjxfzuY1Yrl+TFDA4y66iV3B2fqAN8BEU3RF0gPfSA2jccMONjMzEVdNDy9eqVJDt1arA02eiO",
+	"C2LcI/E8seeDPMpjIutTH5nB08xlR/XoscY5IQ0nN4Um7XItgeDP9AxRyfE8vk36PBHI5JywyeQcGeQL",
+	"iyjvYq1Yl6HnYleEn7A+VN/EIpcdxVa+Pmcac86q7fm2mzCQ9e0MDtOzgM0L7yKjdERQ/paKnKIfFfi8",
+	"ngjklKCCD7fozbGtYXg/BWy0BjlgHYQK+h3lzJx7G/7TyJk5y+0PQzV0MFfDqdccGGRjZYX9q2qvuhag",
+	"uszcmgUwJ8dyKvYhxyc7xagcd/BE7xhljgL8hVIo0g06ZaUCU+WQQiNAdXSxt1s7eIrWBDQy6QFQT7rz",
+	"YoxdEkaRzv9t7g8Nx/5FtXIa3t7+qFnHpD4Vwusmum4t2ypTUc231zwNmZKYTMt1rXX47Pnr8Ewsqx9x",
+	"4EvZWoOBygqvdBN2WfPksiBmsUiCzF6U0PgZaLIf4go7afVjd0ElTotl8MToGdP4gsO5nYZBm1lyTkvk",
+	"E23MRnHyi/I7hUVDsNM7K40y77Kz5EyLq2SypVOnVlr1ugEs9konfop+aJpAnDo1mBHL1qMnmEuR2FGB",
+	"uZSHvCQwl/qiOlu00MMWeJ1nOpbuMypC6/rCZRkqlbU6Q2wfUzj8KA6nXeGq6eOVrKgg94D6lb2IARQ0",
+	"zVy+JF++WKDZHsATSGhbCMCoK599FGS4BlPVmleBstee3UDiijvlFXnbtphNA+dCuIXCMIaHzFOZyjr9",
+	"EBinXrk6PtJEm2kEC9PybKjdHqTecqdOJZpX8sq/x1wvQLwFZnvyUOdGqgdxiU31sRwc/GFS1OI4g9rR",
+	"4zPRnNqOR8WCvKFOTnEdnh/XFy6fcGuzX32YcdgUC6XrV2d/PTt3efaXlwvKkZOq/qK+V6gCcaMkjdYh",
+	"HzW6/pO83JHyctht5jnoXlBeD6F07hBNazTKYXgq2LUhnIOL4019LH0q9eafI+q4h+iZPpa7/WIi2oyO",
+	"3KcCRSIFaLBctDx/rbhopI7Os+t2xS8rDcWSbYpg6/IWNTA/LFx9f1xOFj9l7uyw4jlqF8vUBpf6bGXd",
+	"tryYDb8QTf3gpdDRtf03/v4hZWEw/3EQW1xM+f4cu/mmtADUTE+Xiv1TJjXcjrZGmxsYh9+2tDEy7Cbg",
+	"CX6IQ6B9WSzw9Fl8T6i9uBOvoGCooI/HnrTHaT/9GV/xMcaxICh1n0eewo1YQI5axVKIrRzvJUn9fqJk",
+	"gcLkix1OoD8IVHY1nFKzUa9V1svGWNm315oN13LXy8Y/Pv3KKEMoERj4oAeCBRLAvndvlxGEQmgxDJvh",
+	"SYKNiA+UbkKiNlcGWeBcVe3mDduq+zdwquj1hCSIvJyYHxg0ldmSSiI+4bJX+4NdWl73ba+MKmYrvBc+",
+	"oN/HCkHDT2iK9Bv1Wdr8s4zvkzjkVwXgMFaHNkFAcJKZMi2z6U3NaS45kNScvzx7sXClcHWxNH/t8tzF",
+	"fymPzyw5E0Z5reH5pRXX5jSgMpmyOl+c7l5sDbzehV1dchvLNacc4WiwZzTHzXTxh7ft2uoN7KDMarsZ",
+	"PWjwDG5t8mP4AOt0RL9Lvqwv0MT6FBNA+6IfYDs5RPAe+BCBr27CKDdde8V2Sxjf9PSvCev0gtHX42rB",
+	"WpfZFUqja2nCGK90W7BGipbaPPmxG7QTMiH5B4pwwevEl2qhUCws/JpS0IuLl8vEFiufrbJyAMMKj7x9",
+	"BI/pzvwlJz4c5SyR28tdKlwuLBaMw1kj5ZNxehdR9b6EABw9+CX5VLqhwFGTYhqDl/2QtJAp6cNsk/Dg",
+	"daRuNNWV3woTIN69+XbKGU1HNDvkss4343T+7Jvn3jgP2g9YB4ZbcvktTwofBI/DT/C/24IgtFg4fsOP",
+	"zKqDpAeWZl0d3sQbvA6zgOU7tMIdHrPDXv9U/SOSIkFHGH3Qp4SM0TQOw8mhpi9YZWZcew4IwVEu778y",
+	"s6BUZupm63W1pqjWHCjEcPjazIJcm/nykRZQuTF64cwfY35MreUs/Lyc+Mz8dryyc0CJ77+OU8HIQ60E",
+	"FXW23DoUMV5pAIMsQVJMo2I1rQp0VFfAdRHAXzKnIQs9XO3MKh9HsgFGbR6/pMrHvvLTrysfX7XKx6EF",
+	"2BEQDzu1Z5JTaer1R4xJHFD8i5YJY1WiDwoLWqV1rg+6RrFA4BdNghMCYsFB7JXXtHqIkDDfs4M5qiQg",
+	"+VHujYYz3VxffS5h7uG3JdderXm+uw5+ffnUpOX77iRIvsjAUlyF12URkL0j8KAMIQRhIXjELv8Cfnuf",
+	"ggVa+0OezFh/GR4GecEbFbMZwlDIjhKCCr+4oGnEHLG5UuEFWJYb+BrKVIFATPyusUzyAR320W/AhSWI",
+	"/q5RvtwgbVAelwr05bBZsRB93sFR7fE6AHLBmaRIFCxSdzOwe6Prn0nzMtRECT914JXTzLGMaMu6UzkG",
+	"g2x4GA4Y8HuNZa0W/pOY4HbWxpWkj1sR7aT05czcDduq4iR8nOOyktQuAHuQlzbjyZnNLu+88myth2Cl",
+	"0k4UQGAKAlek3WdDjYIcZRijNKr1IZKM0R72TOXKMr2W4E99ybcC2WQ6VPX/pQqWTqxxE+Q2lLowJaAC",
+	"XOToSjAEdVfQreoRq8XCCFTs4WsLSGmdjKKCpJjoIfz4qxITmXir3l692PqqJFAPd8UOC7qp40otLfh9",
+	"y25hFYHbcqB/P/yoVanYNtUWrFi1Ov6jAuUF9Xq/NLKJgcftonCLBi5MzJSh+25tFRhcdWOnUsdaBWsg",
+	"nJYFoH5mvtG582E//e7ywz5mB8LqR6uXdQb+ZOH83zCpuIvKuL/JkFS0UMJ63Szi1XoF/WPcUDaTVjJ2",
+	"6IHfBI/6tVhOihKFsCEz/AY2T+m6kQcKj2yXvo4cymScqjQPYGEPtKGm6CzIiB18J/Bu20nvlgpp1LF2",
+	"jTIdRGUOViyz86icincOOuED8GxpczLrqZO8N7TAFKdXGfqU/I2s0wOWDX5IpaUSbYsUVPg0vK9YaEsO",
+	"94NkkhTFYOtcoL0uiuqUDA9H0Ej3ZA6w1lo+MU7vRZzCn4hCiRCZP5UOYgP7kX36/TK1sIxvbQ/Vm1QG",
+	"k/7E43cchSYL2jSiYajT2/byjUbjZobr+GeOHOdQcjIYuzrS9k5CPYZfDl932L5fc1Y9rSWCDYaznbkP",
+	"+CuPcPOzZxScarNRG7Bkli2JYbOLvVfa5I4WJLN+NvnSkehaddvNLpv93xiX7vL+NmUmi095rSm210Ux",
+	"TgjxoyTHDsqrwQU/la9lyVELlfrvYTDcbaBF6dG09yqTZWI6InhebBO8nALZ2CB0u4/9RFmsk0PaBRhZ",
+	"lAHiJbgb3ueb5afa3jdajxPT3Tcn7adcausBZuPHdZlOlWmO4L4RbcyT4bcHBcURhzAlRvAEmMoIsk2Y",
+	"+cdSwgQjPV2DYaVZXy3mxYDvQi0e5So/ueMhFZsiKcfzE6PMCDQXKbPB/AF2Xd8wOSGeP9cuBpmbIY66",
+	"7GczpKHjXrq5+I7tj0Sq8i/jeBMr8DOUVZ39+UFfkqlHsf0H6y7YZdVROj7XoDNDNbBPZcqTqIU6BxvE",
+	"mumeGK1KgLFhyf/IzcuXg28bYP+dVJTbiTExT3IL2lfNJtXBbI9il05V7Xrtlu3W7IxQ0deSmQmIMP6g",
+	"LNQAo5Fi0TUelSZQsHo3zoYebpOipMK9Dwq/fPfatfdLlwqX535dWPiX0kJhsXAVqvfKJy/mdCmaxCPo",
+	"U/MldYFNpvtjSzR4yh/awVKmn8mXnOk/5pS5ukbrPWN2/yfyshIe1msbi+mhrEkSh8wgesi3vay6/h/Z",
+	"7bus468CCUQZDh6G9xEE2zHK7NaTcFMAme4YrGoPax4Y1pUVrh2Em9xfJi1Fqb4lh2s5UGU/xHmPCKG7",
+	"o/rkncRUnBijb9H2RuPyTI9qn2r35l9FBeYONg2Paap2vxjS124928e9JjRtDxMbxBQsVard8H5r2XYd",
+	"27c9A37n2J5nNN3Gsj1paAHq0/m8acB4cCuw8oxPOUgGand34sVySD20T7Uc4TYQFsDxtWs0G1Wsv+5i",
+	"e5JtY3Z+znjH8u3b1rpud7yLb3OZupmP7CCKntIjZyR6T7G3Ho5YKAt/WVkQaZVpYdVVhhNgvZ9lhh/W",
+	"pHVecpDJSbRrgWWeMeYbnr/q2sVfXTYNhUgqYqqYq84bY1FbGE4uxdAPB0EnUtTjI5WJBXz1kQsFPqZ/",
+	"qXhELfGDHWOsIaaH0zWPH4XE7MzLfTEMnEhvBxbjeKYgL6gilybJa7bv1ioZPs5fyEVmHZrAYohY8iD+",
+	"Pu821mz/ht3yUHvFIux76tVPma2ygY7kASP0fbbk+I1mo95YXWfDMcasZrNUtcFctp3KeonGbBqxr+uW",
+	"j//v2ZWGU/VGJfLv2P4VNk89Jd63P/KnmnWrFhOJRPmEmT3T0byOQMtFNzfWxIsl5QPv4N7i5pA6XmnO",
+	"wJLEpPJnjNhG1l7jOTPXcuu5mdwN3296M1NTH99oeP6dnJm7Zbk1a7lOU3lDGLgrVqvuI/M39bmavOmu",
+	"t242bk3WLSIYSQxFanJl1BzPB7yUMcZXPTiIDck0mAJnL68OUYxw5uNmw+1noPVGxarj15iyd2N/fjOf",
+	"z+cSy/09BqY2iXUAK9raSA/0lNiHDLhq4s18/i145Q/F8nysMf0A0h1+TjOvtaDDL4wxNSQLD33vg0Xj",
+	"nw3Mme1yuiMRihBVhYwEajzyb9FumgADUOdI/02kzJ7L/XN0o4K16VI1od7qj0VAYn1+lGUXvKmSmcsS",
+	"h3D6Tsjrj/nA/l4b+4BQLpA0gzIHLQ/2RnIS5m3XazhW3bAqFVDBfuOm7Xgp00B5RtZRbQN74naDF0zT",
+	"B7usLcVCobgIegoLvHnbNbVz3AM+S9QtrjzLTHRUZDPGL9H4Nay1ZmlycpIxTvFi3xQI5JJDAUAuYh2i",
+	"CMO3AFUKi0yMVvDFp0GbdyOWe8O1412nkLiXGslBjPg5Ty6w94tmucnmcYImUCduP+CQu7ikeCbB9MFI",
+	"dA7jMzijVGMJV54tcpf5iwh8LYPYNJz6ehlwp0K8OrzNGQij2l0vbe9FJCv8MTrBDh4EXysv7zbqtvaV",
+	"/4x3eY6LwvofwyVe06rY3rgCb0W2F/xkBLuJyxJspSrAB0G+LCO+gYRpXdanYix9Q8PUFWfHMS+/jyFi",
+	"RhLEtcIB/Sb9DiRzKd5+pVFnVFPc4b+gcNcwNr/ku/K4BMRDGH0gl30OOeYFxYiFUq4ntkbgONP0zOxG",
+	"jcOwbyRxInb5IFhZGN76QF0cebWlF+tXqya68e4F3Vg/3qAtVCxlPOilUOcRZHsreeMlJyba8DbC6Qg3",
+	"uNaJl71l1p/HFWiia5B2bwtkd6LhBC6orpgu3DYNTiRuIidGokgsqivUBoIP5Oj902FVEoYbxPDJhyLw",
+	"qT1eXL6zMQZ18gavkx9nUi3KeO6JSngkgATp3dC2oYjGATfUjuHHiALMmKvajg8kJPNu41ataruREzpu",
+	"ZEwMdueLnlWrNvX6LAVxCwaK3rklR3j2Smnu0nxpfuHar+cuFRZ+gXbY+ExaGb7c0gsRj4Aw3oX0H7a6",
+	"6xgRazQ20MWjCevl5HqD8tTkbbten7jpNG47U7+7fdMjjgJFum/a69pJVcLDbUTUdIkBNtZoP3hmKkdy",
+	"0InKFyLGF3mTRg+3WtWaNsfxfYRlokP5BQtBfM4fakingogdKlHkZwT01OFX+eMpHnbHzAiIoE/qGYqf",
+	"E/MWoxsyh+TOh3f+vwEAX1cUxwtdAgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
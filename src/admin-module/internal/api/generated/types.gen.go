@@ -13,46 +13,62 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for AdminUserEffectiveRole.
+// Defines values for AlertRuleType.
 const (
-	AdminUserEffectiveRoleAdmin    AdminUserEffectiveRole = "admin"
-	AdminUserEffectiveRoleReadonly AdminUserEffectiveRole = "readonly"
+	Capacity         AlertRuleType = "capacity"
+	CapacityForecast AlertRuleType = "capacity_forecast"
+	Dephealth        AlertRuleType = "dephealth"
+	SaSecretExpiry   AlertRuleType = "sa_secret_expiry"
+	SeStatus         AlertRuleType = "se_status"
+	SyncErrors       AlertRuleType = "sync_errors"
 )
 
-// Defines values for AdminUserIdpRole.
+// Defines values for AuditEventActorType.
 const (
-	AdminUserIdpRoleAdmin    AdminUserIdpRole = "admin"
-	AdminUserIdpRoleReadonly AdminUserIdpRole = "readonly"
+	AuditEventActorTypeServiceAccount AuditEventActorType = "service_account"
+	AuditEventActorTypeSystem         AuditEventActorType = "system"
+	AuditEventActorTypeUser           AuditEventActorType = "user"
 )
 
-// Defines values for AdminUserRoleOverride.
+// Defines values for AuditEventTargetType.
 const (
-	AdminUserRoleOverrideAdmin    AdminUserRoleOverride = "admin"
-	AdminUserRoleOverrideReadonly AdminUserRoleOverride = "readonly"
+	AuditEventTargetTypeAlertRule      AuditEventTargetType = "alert_rule"
+	AuditEventTargetTypeFile           AuditEventTargetType = "file"
+	AuditEventTargetTypeFileBatch      AuditEventTargetType = "file_batch"
+	AuditEventTargetTypePersonalToken  AuditEventTargetType = "personal_token"
+	AuditEventTargetTypeRole           AuditEventTargetType = "role"
+	AuditEventTargetTypeServiceAccount AuditEventTargetType = "service_account"
+	AuditEventTargetTypeSigningKey     AuditEventTargetType = "signing_key"
+	AuditEventTargetTypeStorageElement AuditEventTargetType = "storage_element"
+	AuditEventTargetTypeUiSetting      AuditEventTargetType = "ui_setting"
+	AuditEventTargetTypeUser           AuditEventTargetType = "user"
+	AuditEventTargetTypeWebhook        AuditEventTargetType = "webhook"
 )
 
-// Defines values for AdminUserUpdateRoleOverride.
+// Defines values for CollectionMemberPermissions.
 const (
-	AdminUserUpdateRoleOverrideAdmin    AdminUserUpdateRoleOverride = "admin"
-	AdminUserUpdateRoleOverrideReadonly AdminUserUpdateRoleOverride = "readonly"
+	CollectionMemberPermissionsDelete CollectionMemberPermissions = "delete"
+	CollectionMemberPermissionsRead   CollectionMemberPermissions = "read"
+	CollectionMemberPermissionsWrite  CollectionMemberPermissions = "write"
 )
 
-// Defines values for CurrentUserEffectiveRole.
+// Defines values for CollectionMemberSubjectType.
 const (
-	CurrentUserEffectiveRoleAdmin    CurrentUserEffectiveRole = "admin"
-	CurrentUserEffectiveRoleReadonly CurrentUserEffectiveRole = "readonly"
+	CollectionMemberSubjectTypeServiceAccount CollectionMemberSubjectType = "service_account"
+	CollectionMemberSubjectTypeUser           CollectionMemberSubjectType = "user"
 )
 
-// Defines values for CurrentUserIdpRole.
+// Defines values for CollectionMemberInputPermissions.
 const (
-	CurrentUserIdpRoleAdmin    CurrentUserIdpRole = "admin"
-	CurrentUserIdpRoleReadonly CurrentUserIdpRole = "readonly"
+	CollectionMemberInputPermissionsDelete CollectionMemberInputPermissions = "delete"
+	CollectionMemberInputPermissionsRead   CollectionMemberInputPermissions = "read"
+	CollectionMemberInputPermissionsWrite  CollectionMemberInputPermissions = "write"
 )
 
-// Defines values for CurrentUserRoleOverride.
+// Defines values for CollectionMemberInputSubjectType.
 const (
-	CurrentUserRoleOverrideAdmin    CurrentUserRoleOverride = "admin"
-	CurrentUserRoleOverrideReadonly CurrentUserRoleOverride = "readonly"
+	CollectionMemberInputSubjectTypeServiceAccount CollectionMemberInputSubjectType = "service_account"
+	CollectionMemberInputSubjectTypeUser           CollectionMemberInputSubjectType = "user"
 )
 
 // Defines values for DiscoverResponseMode.
@@ -71,6 +87,73 @@ const (
 	DiscoverResponseStatusOnline      DiscoverResponseStatus = "online"
 )
 
+// Defines values for FileBatchFilterRetentionPolicy.
+const (
+	FileBatchFilterRetentionPolicyPermanent FileBatchFilterRetentionPolicy = "permanent"
+	FileBatchFilterRetentionPolicyTemporary FileBatchFilterRetentionPolicy = "temporary"
+)
+
+// Defines values for FileBatchFilterStatus.
+const (
+	FileBatchFilterStatusActive  FileBatchFilterStatus = "active"
+	FileBatchFilterStatusDeleted FileBatchFilterStatus = "deleted"
+	FileBatchFilterStatusExpired FileBatchFilterStatus = "expired"
+)
+
+// Defines values for FileBatchOperationAction.
+const (
+	FileBatchOperationActionAddTags    FileBatchOperationAction = "add_tags"
+	FileBatchOperationActionDelete     FileBatchOperationAction = "delete"
+	FileBatchOperationActionRemoveTags FileBatchOperationAction = "remove_tags"
+	FileBatchOperationActionSetStatus  FileBatchOperationAction = "set_status"
+)
+
+// Defines values for FileBatchOperationStatus.
+const (
+	FileBatchOperationStatusCancelled FileBatchOperationStatus = "cancelled"
+	FileBatchOperationStatusFailed    FileBatchOperationStatus = "failed"
+	FileBatchOperationStatusQueued    FileBatchOperationStatus = "queued"
+	FileBatchOperationStatusRunning   FileBatchOperationStatus = "running"
+	FileBatchOperationStatusSucceeded FileBatchOperationStatus = "succeeded"
+)
+
+// Defines values for FileBatchRequestAction.
+const (
+	FileBatchRequestActionAddTags    FileBatchRequestAction = "add_tags"
+	FileBatchRequestActionDelete     FileBatchRequestAction = "delete"
+	FileBatchRequestActionRemoveTags FileBatchRequestAction = "remove_tags"
+	FileBatchRequestActionSetStatus  FileBatchRequestAction = "set_status"
+)
+
+// Defines values for FileBatchRequestStatus.
+const (
+	FileBatchRequestStatusActive  FileBatchRequestStatus = "active"
+	FileBatchRequestStatusDeleted FileBatchRequestStatus = "deleted"
+	FileBatchRequestStatusExpired FileBatchRequestStatus = "expired"
+)
+
+// Defines values for FileBatchResultStatus.
+const (
+	FileBatchResultStatusCancelled FileBatchResultStatus = "cancelled"
+	FileBatchResultStatusFailed    FileBatchResultStatus = "failed"
+	FileBatchResultStatusPending   FileBatchResultStatus = "pending"
+	FileBatchResultStatusQueued    FileBatchResultStatus = "queued"
+	FileBatchResultStatusSkipped   FileBatchResultStatus = "skipped"
+	FileBatchResultStatusSucceeded FileBatchResultStatus = "succeeded"
+)
+
+// Defines values for FilePendingWriteOperation.
+const (
+	Delete FilePendingWriteOperation = "delete"
+	Update FilePendingWriteOperation = "update"
+)
+
+// Defines values for FilePendingWriteStatus.
+const (
+	FilePendingWriteStatusFailed  FilePendingWriteStatus = "failed"
+	FilePendingWriteStatusPending FilePendingWriteStatus = "pending"
+)
+
 // Defines values for FileRecordRetentionPolicy.
 const (
 	FileRecordRetentionPolicyPermanent FileRecordRetentionPolicy = "permanent"
@@ -97,6 +180,13 @@ const (
 	FileRegisterRequestRetentionPolicyTemporary FileRegisterRequestRetentionPolicy = "temporary"
 )
 
+// Defines values for FileReplicaStatus.
+const (
+	FileReplicaStatusFailed  FileReplicaStatus = "failed"
+	FileReplicaStatusPending FileReplicaStatus = "pending"
+	FileReplicaStatusSynced  FileReplicaStatus = "synced"
+)
+
 // Defines values for HealthCheckStatus.
 const (
 	HealthCheckStatusDegraded HealthCheckStatus = "degraded"
@@ -116,10 +206,34 @@ const (
 	HealthReadyResponseStatusOk       HealthReadyResponseStatus = "ok"
 )
 
-// Defines values for RoleOverrideRequestRole.
+// Defines values for IdpStatusProvider.
+const (
+	IdpStatusProviderKeycloak IdpStatusProvider = "keycloak"
+	IdpStatusProviderLocal    IdpStatusProvider = "local"
+)
+
+// Defines values for PersonalTokenScope.
+const (
+	PersonalTokenScopeAdminRead    PersonalTokenScope = "admin:read"
+	PersonalTokenScopeAdminWrite   PersonalTokenScope = "admin:write"
+	PersonalTokenScopeFilesRead    PersonalTokenScope = "files:read"
+	PersonalTokenScopeFilesWrite   PersonalTokenScope = "files:write"
+	PersonalTokenScopeStorageRead  PersonalTokenScope = "storage:read"
+	PersonalTokenScopeStorageWrite PersonalTokenScope = "storage:write"
+)
+
+// Defines values for ResourceGrantRetentionPolicies.
+const (
+	ResourceGrantRetentionPoliciesPermanent ResourceGrantRetentionPolicies = "permanent"
+	ResourceGrantRetentionPoliciesTemporary ResourceGrantRetentionPolicies = "temporary"
+)
+
+// Defines values for ResourceGrantScope.
 const (
-	RoleOverrideRequestRoleAdmin    RoleOverrideRequestRole = "admin"
-	RoleOverrideRequestRoleReadonly RoleOverrideRequestRole = "readonly"
+	ResourceGrantScopeFilesRead    ResourceGrantScope = "files:read"
+	ResourceGrantScopeFilesWrite   ResourceGrantScope = "files:write"
+	ResourceGrantScopeStorageRead  ResourceGrantScope = "storage:read"
+	ResourceGrantScopeStorageWrite ResourceGrantScope = "storage:write"
 )
 
 // Defines values for ServiceAccountScopes.
@@ -182,8 +296,8 @@ const (
 
 // Defines values for ServiceAccountWithSecretSource.
 const (
-	ServiceAccountWithSecretSourceKeycloak ServiceAccountWithSecretSource = "keycloak"
-	ServiceAccountWithSecretSourceLocal    ServiceAccountWithSecretSource = "local"
+	Keycloak ServiceAccountWithSecretSource = "keycloak"
+	Local    ServiceAccountWithSecretSource = "local"
 )
 
 // Defines values for ServiceAccountWithSecretStatus.
@@ -192,6 +306,25 @@ const (
 	ServiceAccountWithSecretStatusSuspended ServiceAccountWithSecretStatus = "suspended"
 )
 
+// Defines values for SigningKeyAlgorithm.
+const (
+	SigningKeyAlgorithmES256 SigningKeyAlgorithm = "ES256"
+	SigningKeyAlgorithmRS256 SigningKeyAlgorithm = "RS256"
+)
+
+// Defines values for SigningKeyState.
+const (
+	SigningKeyStateActive   SigningKeyState = "active"
+	SigningKeyStatePending  SigningKeyState = "pending"
+	SigningKeyStateRetiring SigningKeyState = "retiring"
+)
+
+// Defines values for SigningKeysStatusAlgorithm.
+const (
+	SigningKeysStatusAlgorithmES256 SigningKeysStatusAlgorithm = "ES256"
+	SigningKeysStatusAlgorithmRS256 SigningKeysStatusAlgorithm = "RS256"
+)
+
 // Defines values for StorageElementMode.
 const (
 	StorageElementModeAr   StorageElementMode = "ar"
@@ -208,6 +341,86 @@ const (
 	StorageElementStatusOnline      StorageElementStatus = "online"
 )
 
+// Defines values for StorageElementSelectRequestPolicy.
+const (
+	StorageElementSelectRequestPolicyMostFree     StorageElementSelectRequestPolicy = "most_free"
+	StorageElementSelectRequestPolicyPreferLabels StorageElementSelectRequestPolicy = "prefer_labels"
+	StorageElementSelectRequestPolicyRoundRobin   StorageElementSelectRequestPolicy = "round_robin"
+	StorageElementSelectRequestPolicyWeighted     StorageElementSelectRequestPolicy = "weighted"
+)
+
+// Defines values for StorageElementSelectRequestRetentionPolicy.
+const (
+	StorageElementSelectRequestRetentionPolicyPermanent StorageElementSelectRequestRetentionPolicy = "permanent"
+	StorageElementSelectRequestRetentionPolicyTemporary StorageElementSelectRequestRetentionPolicy = "temporary"
+)
+
+// Defines values for StorageElementSelectionPolicy.
+const (
+	StorageElementSelectionPolicyMostFree     StorageElementSelectionPolicy = "most_free"
+	StorageElementSelectionPolicyPreferLabels StorageElementSelectionPolicy = "prefer_labels"
+	StorageElementSelectionPolicyRoundRobin   StorageElementSelectionPolicy = "round_robin"
+	StorageElementSelectionPolicyWeighted     StorageElementSelectionPolicy = "weighted"
+)
+
+// Defines values for SyncJobMode.
+const (
+	Full        SyncJobMode = "full"
+	Incremental SyncJobMode = "incremental"
+)
+
+// Defines values for SyncJobStatus.
+const (
+	SyncJobStatusCancelled SyncJobStatus = "cancelled"
+	SyncJobStatusFailed    SyncJobStatus = "failed"
+	SyncJobStatusQueued    SyncJobStatus = "queued"
+	SyncJobStatusRunning   SyncJobStatus = "running"
+	SyncJobStatusSucceeded SyncJobStatus = "succeeded"
+)
+
+// Defines values for SyncJobTrigger.
+const (
+	SyncJobTriggerManual       SyncJobTrigger = "manual"
+	SyncJobTriggerPeriodic     SyncJobTrigger = "periodic"
+	SyncJobTriggerRegistration SyncJobTrigger = "registration"
+)
+
+// Defines values for WebhookDeliveryEvent.
+const (
+	AlertFiring   WebhookDeliveryEvent = "alert.firing"
+	AlertResolved WebhookDeliveryEvent = "alert.resolved"
+	WebhookTest   WebhookDeliveryEvent = "webhook.test"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for ListAuditEventsParamsTargetType.
+const (
+	ListAuditEventsParamsTargetTypeAlertRule      ListAuditEventsParamsTargetType = "alert_rule"
+	ListAuditEventsParamsTargetTypeCollection     ListAuditEventsParamsTargetType = "collection"
+	ListAuditEventsParamsTargetTypeFile           ListAuditEventsParamsTargetType = "file"
+	ListAuditEventsParamsTargetTypeFileBatch      ListAuditEventsParamsTargetType = "file_batch"
+	ListAuditEventsParamsTargetTypePersonalToken  ListAuditEventsParamsTargetType = "personal_token"
+	ListAuditEventsParamsTargetTypeRole           ListAuditEventsParamsTargetType = "role"
+	ListAuditEventsParamsTargetTypeServiceAccount ListAuditEventsParamsTargetType = "service_account"
+	ListAuditEventsParamsTargetTypeSigningKey     ListAuditEventsParamsTargetType = "signing_key"
+	ListAuditEventsParamsTargetTypeStorageElement ListAuditEventsParamsTargetType = "storage_element"
+	ListAuditEventsParamsTargetTypeUiSetting      ListAuditEventsParamsTargetType = "ui_setting"
+	ListAuditEventsParamsTargetTypeUser           ListAuditEventsParamsTargetType = "user"
+	ListAuditEventsParamsTargetTypeWebhook        ListAuditEventsParamsTargetType = "webhook"
+)
+
+// Defines values for RemoveCollectionMemberParamsSubjectType.
+const (
+	RemoveCollectionMemberParamsSubjectTypeServiceAccount RemoveCollectionMemberParamsSubjectType = "service_account"
+	RemoveCollectionMemberParamsSubjectTypeUser           RemoveCollectionMemberParamsSubjectType = "user"
+)
+
 // Defines values for ListFilesParamsStatus.
 const (
 	ListFilesParamsStatusActive  ListFilesParamsStatus = "active"
@@ -217,14 +430,14 @@ const (
 
 // Defines values for ListFilesParamsRetentionPolicy.
 const (
-	Permanent ListFilesParamsRetentionPolicy = "permanent"
-	Temporary ListFilesParamsRetentionPolicy = "temporary"
+	ListFilesParamsRetentionPolicyPermanent ListFilesParamsRetentionPolicy = "permanent"
+	ListFilesParamsRetentionPolicyTemporary ListFilesParamsRetentionPolicy = "temporary"
 )
 
 // Defines values for ListServiceAccountsParamsStatus.
 const (
-	Active    ListServiceAccountsParamsStatus = "active"
-	Suspended ListServiceAccountsParamsStatus = "suspended"
+	ListServiceAccountsParamsStatusActive    ListServiceAccountsParamsStatus = "active"
+	ListServiceAccountsParamsStatusSuspended ListServiceAccountsParamsStatus = "suspended"
 )
 
 // Defines values for ListStorageElementsParamsMode.
@@ -243,14 +456,37 @@ const (
 	ListStorageElementsParamsStatusOnline      ListStorageElementsParamsStatus = "online"
 )
 
+// Defines values for ListSyncJobsParamsStatus.
+const (
+	ListSyncJobsParamsStatusCancelled ListSyncJobsParamsStatus = "cancelled"
+	ListSyncJobsParamsStatusFailed    ListSyncJobsParamsStatus = "failed"
+	ListSyncJobsParamsStatusQueued    ListSyncJobsParamsStatus = "queued"
+	ListSyncJobsParamsStatusRunning   ListSyncJobsParamsStatus = "running"
+	ListSyncJobsParamsStatusSucceeded ListSyncJobsParamsStatus = "succeeded"
+)
+
+// Defines values for ListSyncJobsParamsTrigger.
+const (
+	ListSyncJobsParamsTriggerManual       ListSyncJobsParamsTrigger = "manual"
+	ListSyncJobsParamsTriggerPeriodic     ListSyncJobsParamsTrigger = "periodic"
+	ListSyncJobsParamsTriggerRegistration ListSyncJobsParamsTrigger = "registration"
+)
+
+// Defines values for ListWebhookDeliveriesParamsStatus.
+const (
+	ListWebhookDeliveriesParamsStatusDelivered ListWebhookDeliveriesParamsStatus = "delivered"
+	ListWebhookDeliveriesParamsStatusFailed    ListWebhookDeliveriesParamsStatus = "failed"
+	ListWebhookDeliveriesParamsStatusPending   ListWebhookDeliveriesParamsStatus = "pending"
+)
+
 // AdminUser Пользователь (данные из Keycloak + локальные дополнения)
 type AdminUser struct {
 	// CreatedAt Дата создания в Keycloak
 	CreatedAt time.Time `json:"created_at"`
 
-	// EffectiveRole Итоговая роль = max(idp_role, role_override)
-	EffectiveRole AdminUserEffectiveRole `json:"effective_role"`
-	Email         *openapi_types.Email   `json:"email"`
+	// EffectiveRole Роль с максимальными привилегиями из roles
+	EffectiveRole string               `json:"effective_role"`
+	Email         *openapi_types.Email `json:"email"`
 
 	// Enabled Активен ли аккаунт в Keycloak
 	Enabled   *bool   `json:"enabled,omitempty"`
@@ -262,23 +498,44 @@ type AdminUser struct {
 	// Id Keycloak user ID
 	Id string `json:"id"`
 
-	// IdpRole Роль из IdP
-	IdpRole  AdminUserIdpRole `json:"idp_role"`
-	LastName *string          `json:"last_name"`
+	// IdpRole Роль из IdP с максимальными привилегиями
+	IdpRole  string  `json:"idp_role"`
+	LastName *string `json:"last_name"`
+
+	// Permissions Разрешения пользователя — объединение разрешений ролей
+	Permissions *[]string `json:"permissions,omitempty"`
 
 	// RoleOverride Локальное дополнение роли
-	RoleOverride *AdminUserRoleOverride `json:"role_override"`
-	Username     string                 `json:"username"`
+	RoleOverride *string `json:"role_override"`
+
+	// Roles Все роли пользователя (из групп IdP и role override)
+	Roles    *[]string `json:"roles,omitempty"`
+	Username string    `json:"username"`
 }
 
-// AdminUserEffectiveRole Итоговая роль = max(idp_role, role_override)
-type AdminUserEffectiveRole string
+// AdminUserAccountUpdate Учётная запись пользователя встроенного IdP (полная замена)
+type AdminUserAccountUpdate struct {
+	Email     *openapi_types.Email `json:"email,omitempty"`
+	Enabled   bool                 `json:"enabled"`
+	FirstName *string              `json:"first_name,omitempty"`
+	Groups    []string             `json:"groups"`
+	LastName  *string              `json:"last_name,omitempty"`
+}
+
+// AdminUserCreate Создание пользователя встроенного IdP
+type AdminUserCreate struct {
+	Email     *openapi_types.Email `json:"email,omitempty"`
+	Enabled   *bool                `json:"enabled,omitempty"`
+	FirstName *string              `json:"first_name,omitempty"`
 
-// AdminUserIdpRole Роль из IdP
-type AdminUserIdpRole string
+	// Groups Группы, определяющие роль (например, artstore-admins)
+	Groups   *[]string `json:"groups,omitempty"`
+	LastName *string   `json:"last_name,omitempty"`
+	Password string    `json:"password"`
 
-// AdminUserRoleOverride Локальное дополнение роли
-type AdminUserRoleOverride string
+	// Username Имя пользователя (латиница, цифры, `._@-`; хранится в нижнем регистре)
+	Username string `json:"username"`
+}
 
 // AdminUserListResponse defines model for AdminUserListResponse.
 type AdminUserListResponse struct {
@@ -289,22 +546,229 @@ type AdminUserListResponse struct {
 	Total   int         `json:"total"`
 }
 
+// AdminUserPasswordRequest Новый пароль пользователя встроенного IdP
+type AdminUserPasswordRequest struct {
+	Password string `json:"password"`
+}
+
 // AdminUserUpdate Обновление локальных дополнений пользователя
 type AdminUserUpdate struct {
-	// RoleOverride Локальное дополнение роли.
+	// RoleOverride Локальное дополнение роли (любая роль каталога).
 	// Установите `null` для удаления override.
-	RoleOverride *AdminUserUpdateRoleOverride `json:"role_override"`
+	RoleOverride *string `json:"role_override"`
+}
+
+// Alert Сработавшее оповещение — правило и объект, для которого выполнено условие
+type Alert struct {
+	FiringSince time.Time          `json:"firing_since"`
+	Message     string             `json:"message"`
+	RuleId      openapi_types.UUID `json:"rule_id"`
+	RuleName    string             `json:"rule_name"`
+
+	// RuleType Условие срабатывания правила:
+	// - `se_status` — SE не в статусе online
+	// - `capacity` — заполнение SE ≥ threshold % (по умолчанию 90)
+	// - `capacity_forecast` — прогноз заполнения SE ≤ threshold дней (14)
+	// - `sync_errors` — неудачных синхронизаций SE подряд ≥ threshold (3)
+	// - `sa_secret_expiry` — секрет SA истекает не позже чем через threshold дней (7)
+	// - `dephealth` — последняя проверка зависимости неуспешна
+	RuleType AlertRuleType `json:"rule_type"`
+
+	// Subject UUID SE, UUID SA или имя зависимости
+	Subject     string `json:"subject"`
+	SubjectName string `json:"subject_name"`
+}
+
+// AlertListResponse defines model for AlertListResponse.
+type AlertListResponse struct {
+	Items []Alert `json:"items"`
+}
+
+// AlertRule Правило оповещения
+type AlertRule struct {
+	CreatedAt time.Time `json:"created_at"`
+	Enabled   bool      `json:"enabled"`
+
+	// EndpointIds Webhook endpoints, получающие события правила
+	EndpointIds []openapi_types.UUID `json:"endpoint_ids"`
+	Id          openapi_types.UUID   `json:"id"`
+	Name        string               `json:"name"`
+
+	// StorageElementId Ограничение правила одним SE (null — все SE)
+	StorageElementId *openapi_types.UUID `json:"storage_element_id"`
+
+	// Threshold Порог; null для se_status и dephealth
+	Threshold *float64 `json:"threshold"`
+
+	// Type Условие срабатывания правила:
+	// - `se_status` — SE не в статусе online
+	// - `capacity` — заполнение SE ≥ threshold % (по умолчанию 90)
+	// - `capacity_forecast` — прогноз заполнения SE ≤ threshold дней (14)
+	// - `sync_errors` — неудачных синхронизаций SE подряд ≥ threshold (3)
+	// - `sa_secret_expiry` — секрет SA истекает не позже чем через threshold дней (7)
+	// - `dephealth` — последняя проверка зависимости неуспешна
+	Type      AlertRuleType `json:"type"`
+	UpdatedAt time.Time     `json:"updated_at"`
 }
 
-// AdminUserUpdateRoleOverride Локальное дополнение роли.
-// Установите `null` для удаления override.
-type AdminUserUpdateRoleOverride string
+// AlertRuleInput defines model for AlertRuleInput.
+type AlertRuleInput struct {
+	Enabled     *bool                 `json:"enabled,omitempty"`
+	EndpointIds *[]openapi_types.UUID `json:"endpoint_ids,omitempty"`
+	Name        string                `json:"name"`
+
+	// StorageElementId Только для se_status, capacity, capacity_forecast, sync_errors
+	StorageElementId *openapi_types.UUID `json:"storage_element_id"`
+
+	// Threshold Порог; не задан — значение по умолчанию для типа
+	Threshold *float64 `json:"threshold"`
+
+	// Type Условие срабатывания правила:
+	// - `se_status` — SE не в статусе online
+	// - `capacity` — заполнение SE ≥ threshold % (по умолчанию 90)
+	// - `capacity_forecast` — прогноз заполнения SE ≤ threshold дней (14)
+	// - `sync_errors` — неудачных синхронизаций SE подряд ≥ threshold (3)
+	// - `sa_secret_expiry` — секрет SA истекает не позже чем через threshold дней (7)
+	// - `dephealth` — последняя проверка зависимости неуспешна
+	Type AlertRuleType `json:"type"`
+}
+
+// AlertRuleListResponse defines model for AlertRuleListResponse.
+type AlertRuleListResponse struct {
+	Items []AlertRule `json:"items"`
+}
+
+// AlertRuleType Условие срабатывания правила:
+// - `se_status` — SE не в статусе online
+// - `capacity` — заполнение SE ≥ threshold % (по умолчанию 90)
+// - `capacity_forecast` — прогноз заполнения SE ≤ threshold дней (14)
+// - `sync_errors` — неудачных синхронизаций SE подряд ≥ threshold (3)
+// - `sa_secret_expiry` — секрет SA истекает не позже чем через threshold дней (7)
+// - `dephealth` — последняя проверка зависимости неуспешна
+type AlertRuleType string
+
+// AuditEvent Событие журнала аудита (append-only)
+type AuditEvent struct {
+	Action string `json:"action"`
+
+	// ActorId sub из JWT; `admin-module` для системных изменений
+	ActorId string `json:"actor_id"`
+
+	// ActorName preferred_username пользователя или client_id SA
+	ActorName string              `json:"actor_name"`
+	ActorType AuditEventActorType `json:"actor_type"`
+
+	// After Изменённые поля после операции (null при удалении)
+	After *map[string]interface{} `json:"after"`
+
+	// Before Изменённые поля до операции (null при создании)
+	Before     *map[string]interface{} `json:"before"`
+	Id         int64                   `json:"id"`
+	OccurredAt time.Time               `json:"occurred_at"`
+	RequestId  *string                 `json:"request_id"`
+	SourceIp   *string                 `json:"source_ip"`
+	TargetId   string                  `json:"target_id"`
+	TargetType AuditEventTargetType    `json:"target_type"`
+}
+
+// AuditEventActorType defines model for AuditEvent.ActorType.
+type AuditEventActorType string
+
+// AuditEventTargetType defines model for AuditEvent.TargetType.
+type AuditEventTargetType string
+
+// AuditEventListResponse defines model for AuditEventListResponse.
+type AuditEventListResponse struct {
+	HasMore bool         `json:"has_more"`
+	Items   []AuditEvent `json:"items"`
+	Limit   int          `json:"limit"`
+	Offset  int          `json:"offset"`
+	Total   int          `json:"total"`
+}
+
+// Collection Коллекция (namespace) файлов с квотами и их использованием
+type Collection struct {
+	CreatedAt   time.Time          `json:"created_at"`
+	CreatedBy   string             `json:"created_by"`
+	Description string             `json:"description"`
+	Id          openapi_types.UUID `json:"id"`
+	MemberCount int                `json:"member_count"`
+	Name        string             `json:"name"`
+
+	// QuotaBytes Квота на суммарный размер активных файлов (null — без ограничения)
+	QuotaBytes *int64 `json:"quota_bytes"`
+
+	// QuotaFiles Квота на количество активных файлов (null — без ограничения)
+	QuotaFiles *int64    `json:"quota_files"`
+	UpdatedAt  time.Time `json:"updated_at"`
+
+	// UsedBytes Суммарный размер активных файлов
+	UsedBytes int64 `json:"used_bytes"`
+
+	// UsedFiles Количество активных файлов
+	UsedFiles int64 `json:"used_files"`
+}
+
+// CollectionInput Параметры коллекции (при изменении — полная замена)
+type CollectionInput struct {
+	Description *string `json:"description,omitempty"`
+
+	// Name Имя коллекции (slug)
+	Name       string `json:"name"`
+	QuotaBytes *int64 `json:"quota_bytes"`
+	QuotaFiles *int64 `json:"quota_files"`
+}
+
+// CollectionListResponse defines model for CollectionListResponse.
+type CollectionListResponse struct {
+	Items []Collection `json:"items"`
+}
+
+// CollectionMember Участник коллекции и его права в ней
+type CollectionMember struct {
+	AddedBy   string    `json:"added_by"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Permissions Права в коллекции:
+	// - `read` — просмотр, поиск и скачивание файлов
+	// - `write` — загрузка файлов и изменение метаданных
+	// - `delete` — удаление файлов
+	Permissions []CollectionMemberPermissions `json:"permissions"`
+
+	// SubjectId Username пользователя или client_id SA
+	SubjectId   string                      `json:"subject_id"`
+	SubjectType CollectionMemberSubjectType `json:"subject_type"`
+}
+
+// CollectionMemberPermissions defines model for CollectionMember.Permissions.
+type CollectionMemberPermissions string
+
+// CollectionMemberSubjectType defines model for CollectionMember.SubjectType.
+type CollectionMemberSubjectType string
+
+// CollectionMemberInput defines model for CollectionMemberInput.
+type CollectionMemberInput struct {
+	Permissions []CollectionMemberInputPermissions `json:"permissions"`
+	SubjectId   string                             `json:"subject_id"`
+	SubjectType CollectionMemberInputSubjectType   `json:"subject_type"`
+}
+
+// CollectionMemberInputPermissions defines model for CollectionMemberInput.Permissions.
+type CollectionMemberInputPermissions string
+
+// CollectionMemberInputSubjectType defines model for CollectionMemberInput.SubjectType.
+type CollectionMemberInputSubjectType string
+
+// CollectionMemberListResponse defines model for CollectionMemberListResponse.
+type CollectionMemberListResponse struct {
+	Items []CollectionMember `json:"items"`
+}
 
 // CurrentUser Текущий пользователь (данные из JWT + локальные дополнения)
 type CurrentUser struct {
-	// EffectiveRole Итоговая роль = max(idp_role, role_override)
-	EffectiveRole CurrentUserEffectiveRole `json:"effective_role"`
-	Email         *openapi_types.Email     `json:"email"`
+	// EffectiveRole Роль с максимальными привилегиями из roles
+	EffectiveRole string               `json:"effective_role"`
+	Email         *openapi_types.Email `json:"email"`
 
 	// Groups Группы пользователя из IdP
 	Groups *[]string `json:"groups,omitempty"`
@@ -312,25 +776,23 @@ type CurrentUser struct {
 	// Id Keycloak user ID (sub из JWT)
 	Id string `json:"id"`
 
-	// IdpRole Роль из IdP (на основе маппинга groups → roles)
-	IdpRole CurrentUserIdpRole `json:"idp_role"`
+	// IdpRole Роль из IdP с максимальными привилегиями (на основе маппинга
+	// groups → roles); пустая строка — группы не дают ролей
+	IdpRole string `json:"idp_role"`
+
+	// Permissions Разрешения пользователя — объединение разрешений ролей
+	Permissions *[]string `json:"permissions,omitempty"`
 
 	// RoleOverride Локальное дополнение роли (из Admin Module БД)
-	RoleOverride *CurrentUserRoleOverride `json:"role_override"`
+	RoleOverride *string `json:"role_override"`
+
+	// Roles Все роли пользователя (из групп IdP и role override)
+	Roles *[]string `json:"roles,omitempty"`
 
 	// Username Имя пользователя (из JWT preferred_username)
 	Username string `json:"username"`
 }
 
-// CurrentUserEffectiveRole Итоговая роль = max(idp_role, role_override)
-type CurrentUserEffectiveRole string
-
-// CurrentUserIdpRole Роль из IdP (на основе маппинга groups → roles)
-type CurrentUserIdpRole string
-
-// CurrentUserRoleOverride Локальное дополнение роли (из Admin Module БД)
-type CurrentUserRoleOverride string
-
 // DiscoverRequest defines model for DiscoverRequest.
 type DiscoverRequest struct {
 	Url string `json:"url"`
@@ -364,6 +826,7 @@ type ErrorResponse struct {
 		// - `UNAUTHORIZED` — требуется аутентификация
 		// - `FORBIDDEN` — недостаточно прав
 		// - `CONFLICT` — конфликт (дублирующийся ресурс)
+		// - `QUOTA_EXCEEDED` — превышена квота коллекции
 		// - `SE_UNAVAILABLE` — SE недоступен
 		// - `IDP_UNAVAILABLE` — Keycloak недоступен
 		// - `INTERNAL_ERROR` — внутренняя ошибка
@@ -374,16 +837,181 @@ type ErrorResponse struct {
 	} `json:"error"`
 }
 
+// FileBatchFilter Фильтр выбора файлов — те же условия, что у `GET /api/v1/files`.
+// Пустой фильтр выбирает все файлы реестра.
+type FileBatchFilter struct {
+	CollectionId     *openapi_types.UUID             `json:"collection_id,omitempty"`
+	ContentType      *string                         `json:"content_type,omitempty"`
+	Filename         *string                         `json:"filename,omitempty"`
+	MaxSize          *int64                          `json:"max_size,omitempty"`
+	MinSize          *int64                          `json:"min_size,omitempty"`
+	RetentionPolicy  *FileBatchFilterRetentionPolicy `json:"retention_policy,omitempty"`
+	Status           *FileBatchFilterStatus          `json:"status,omitempty"`
+	StorageElementId *openapi_types.UUID             `json:"storage_element_id,omitempty"`
+	Tags             *[]string                       `json:"tags,omitempty"`
+	UploadedAfter    *time.Time                      `json:"uploaded_after,omitempty"`
+	UploadedBefore   *time.Time                      `json:"uploaded_before,omitempty"`
+	UploadedBy       *string                         `json:"uploaded_by,omitempty"`
+}
+
+// FileBatchFilterRetentionPolicy defines model for FileBatchFilter.RetentionPolicy.
+type FileBatchFilterRetentionPolicy string
+
+// FileBatchFilterStatus defines model for FileBatchFilter.Status.
+type FileBatchFilterStatus string
+
+// FileBatchOperation Фоновая групповая операция над файлами
+type FileBatchOperation struct {
+	Action      FileBatchOperationAction `json:"action"`
+	CompletedAt *time.Time               `json:"completed_at"`
+	CreatedAt   time.Time                `json:"created_at"`
+	DurationMs  *int64                   `json:"duration_ms"`
+
+	// Error Текст ошибки (для failed и cancelled)
+	Error     *string            `json:"error"`
+	Failed    int                `json:"failed"`
+	Id        openapi_types.UUID `json:"id"`
+	Processed int                `json:"processed"`
+
+	// RequestedBy preferred_username или client_id SA, запустивших операцию
+	RequestedBy *string `json:"requested_by"`
+
+	// Skipped Файлов уже в нужном состоянии
+	Skipped   int                      `json:"skipped"`
+	StartedAt *time.Time               `json:"started_at"`
+	Status    FileBatchOperationStatus `json:"status"`
+
+	// Succeeded Изменено файлов (включая ожидающие записи на SE)
+	Succeeded int       `json:"succeeded"`
+	Tags      *[]string `json:"tags,omitempty"`
+
+	// TargetStatus Новый статус файлов (для `set_status`)
+	TargetStatus *string `json:"target_status"`
+
+	// Total Количество файлов в операции
+	Total int `json:"total"`
+}
+
+// FileBatchOperationAction defines model for FileBatchOperation.Action.
+type FileBatchOperationAction string
+
+// FileBatchOperationStatus defines model for FileBatchOperation.Status.
+type FileBatchOperationStatus string
+
+// FileBatchRequest Параметры групповой операции. Нужно указать либо `file_ids`, либо `filter`.
+type FileBatchRequest struct {
+	// Action Действие:
+	// - `delete` — soft delete файлов
+	// - `add_tags`, `remove_tags` — добавить или удалить теги `tags`
+	// - `set_status` — установить статус `status` (статусы, кроме `deleted`,
+	//   задаёт Storage Element — такие изменения завершатся `failed`)
+	Action FileBatchRequestAction `json:"action"`
+
+	// FileIds Явный список файлов
+	FileIds *[]openapi_types.UUID `json:"file_ids,omitempty"`
+
+	// Filter Фильтр выбора файлов — те же условия, что у `GET /api/v1/files`.
+	// Пустой фильтр выбирает все файлы реестра.
+	Filter *FileBatchFilter `json:"filter,omitempty"`
+
+	// Status Новый статус для `set_status`
+	Status *FileBatchRequestStatus `json:"status,omitempty"`
+
+	// Tags Теги для `add_tags` и `remove_tags`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// FileBatchRequestAction Действие:
+//   - `delete` — soft delete файлов
+//   - `add_tags`, `remove_tags` — добавить или удалить теги `tags`
+//   - `set_status` — установить статус `status` (статусы, кроме `deleted`,
+//     задаёт Storage Element — такие изменения завершатся `failed`)
+type FileBatchRequestAction string
+
+// FileBatchRequestStatus Новый статус для `set_status`
+type FileBatchRequestStatus string
+
+// FileBatchResult defines model for FileBatchResult.
+type FileBatchResult struct {
+	Error       *string            `json:"error"`
+	FileId      openapi_types.UUID `json:"file_id"`
+	ProcessedAt *time.Time         `json:"processed_at"`
+
+	// Status Результат обработки файла:
+	// - `queued` — ещё не обработан
+	// - `succeeded` — изменение применено
+	// - `pending` — изменение применено в реестре, запись на SE отложена
+	// - `skipped` — файл уже в нужном состоянии
+	// - `failed` — изменение отклонено или завершилось ошибкой
+	// - `cancelled` — операция отменена до обработки файла
+	Status FileBatchResultStatus `json:"status"`
+}
+
+// FileBatchResultListResponse defines model for FileBatchResultListResponse.
+type FileBatchResultListResponse struct {
+	HasMore bool              `json:"has_more"`
+	Items   []FileBatchResult `json:"items"`
+	Limit   int               `json:"limit"`
+	Offset  int               `json:"offset"`
+	Total   int               `json:"total"`
+}
+
+// FileBatchResultStatus Результат обработки файла:
+// - `queued` — ещё не обработан
+// - `succeeded` — изменение применено
+// - `pending` — изменение применено в реестре, запись на SE отложена
+// - `skipped` — файл уже в нужном состоянии
+// - `failed` — изменение отклонено или завершилось ошибкой
+// - `cancelled` — операция отменена до обработки файла
+type FileBatchResultStatus string
+
+// FilePendingWrite Изменение файла, ещё не применённое на основном SE (SE был недоступен).
+// Пока изменение в состоянии `pending`, синхронизация не откатывает
+// запись реестра.
+type FilePendingWrite struct {
+	Attempts      int       `json:"attempts"`
+	LastError     *string   `json:"last_error"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// Operation - `update` — обновление description и tags
+	// - `delete` — soft delete
+	Operation FilePendingWriteOperation `json:"operation"`
+
+	// Status - `pending` — запись на SE будет повторена
+	// - `failed` — SE отклонил изменение, следующая полная синхронизация вернёт состояние SE
+	Status FilePendingWriteStatus `json:"status"`
+}
+
+// FilePendingWriteOperation - `update` — обновление description и tags
+// - `delete` — soft delete
+type FilePendingWriteOperation string
+
+// FilePendingWriteStatus - `pending` — запись на SE будет повторена
+// - `failed` — SE отклонил изменение, следующая полная синхронизация вернёт состояние SE
+type FilePendingWriteStatus string
+
 // FileRecord Запись файла в реестре
 type FileRecord struct {
 	// Checksum SHA-256
-	Checksum         string                    `json:"checksum"`
-	ContentType      string                    `json:"content_type"`
-	CreatedAt        *time.Time                `json:"created_at,omitempty"`
-	Description      *string                   `json:"description"`
-	ExpiresAt        *time.Time                `json:"expires_at"`
-	FileId           openapi_types.UUID        `json:"file_id"`
-	OriginalFilename string                    `json:"original_filename"`
+	Checksum string `json:"checksum"`
+
+	// CollectionId Коллекция файла (отсутствует у файлов общего пространства)
+	CollectionId     *openapi_types.UUID `json:"collection_id"`
+	ContentType      string              `json:"content_type"`
+	CreatedAt        *time.Time          `json:"created_at,omitempty"`
+	Description      *string             `json:"description"`
+	ExpiresAt        *time.Time          `json:"expires_at"`
+	FileId           openapi_types.UUID  `json:"file_id"`
+	OriginalFilename string              `json:"original_filename"`
+
+	// PendingWrite Изменение файла, ещё не применённое на основном SE (SE был недоступен).
+	// Пока изменение в состоянии `pending`, синхронизация не откатывает
+	// запись реестра.
+	PendingWrite *FilePendingWrite `json:"pending_write,omitempty"`
+
+	// Replicas Копии файла на дополнительных SE (при AM_REPLICATION_FACTOR > 1).
+	// Основная копия — storage_element_id.
+	Replicas         *[]FileReplica            `json:"replicas,omitempty"`
 	RetentionPolicy  FileRecordRetentionPolicy `json:"retention_policy"`
 	Size             int64                     `json:"size"`
 	Status           FileRecordStatus          `json:"status"`
@@ -422,7 +1050,11 @@ type FileRecordUpdateStatus string
 
 // FileRegisterRequest Регистрация файла (от Ingester)
 type FileRegisterRequest struct {
-	Checksum         string                             `json:"checksum"`
+	Checksum string `json:"checksum"`
+
+	// CollectionId Коллекция файла — та же, что передана SE при загрузке
+	// (записана в attr.json). Не изменяется после регистрации.
+	CollectionId     *openapi_types.UUID                `json:"collection_id,omitempty"`
 	ContentType      string                             `json:"content_type"`
 	Description      *string                            `json:"description,omitempty"`
 	FileId           openapi_types.UUID                 `json:"file_id"`
@@ -439,6 +1071,25 @@ type FileRegisterRequest struct {
 // FileRegisterRequestRetentionPolicy defines model for FileRegisterRequest.RetentionPolicy.
 type FileRegisterRequestRetentionPolicy string
 
+// FileReplica Реплика файла на дополнительном Storage Element
+type FileReplica struct {
+	LastError    *string    `json:"last_error"`
+	ReplicatedAt *time.Time `json:"replicated_at"`
+
+	// Status Состояние реплики:
+	// - `pending` — копирование запланировано
+	// - `synced` — копия подтверждена на SE
+	// - `failed` — последняя попытка копирования завершилась ошибкой
+	Status           FileReplicaStatus  `json:"status"`
+	StorageElementId openapi_types.UUID `json:"storage_element_id"`
+}
+
+// FileReplicaStatus Состояние реплики:
+// - `pending` — копирование запланировано
+// - `synced` — копия подтверждена на SE
+// - `failed` — последняя попытка копирования завершилась ошибкой
+type FileReplicaStatus string
+
 // HealthCheck defines model for HealthCheck.
 type HealthCheck struct {
 	Message *string           `json:"message,omitempty"`
@@ -461,9 +1112,12 @@ type HealthLiveResponseStatus string
 
 // HealthReadyResponse defines model for HealthReadyResponse.
 type HealthReadyResponse struct {
+	// Checks Проверки зависимостей. IdP проверяется под ключом `keycloak`
+	// или `local_idp` (встроенный IdP) в зависимости от AM_IDP_PROVIDER.
 	Checks struct {
-		Keycloak   HealthCheck `json:"keycloak"`
-		Postgresql HealthCheck `json:"postgresql"`
+		Keycloak   *HealthCheck `json:"keycloak,omitempty"`
+		LocalIdp   *HealthCheck `json:"local_idp,omitempty"`
+		Postgresql HealthCheck  `json:"postgresql"`
 	} `json:"checks"`
 	Service   string                    `json:"service"`
 	Status    HealthReadyResponseStatus `json:"status"`
@@ -474,36 +1128,190 @@ type HealthReadyResponse struct {
 // HealthReadyResponseStatus defines model for HealthReadyResponse.Status.
 type HealthReadyResponseStatus string
 
-// IdpStatus Статус подключения к Identity Provider (Keycloak)
+// IdpStatus Статус подключения к Identity Provider (Keycloak или встроенный IdP)
 type IdpStatus struct {
 	// ClientsCount Количество SA clients (с prefix sa_*)
 	ClientsCount *int `json:"clients_count,omitempty"`
 
-	// Connected Доступен ли Keycloak
+	// Connected Доступен ли IdP
 	Connected bool `json:"connected"`
 
 	// Error Сообщение об ошибке (если Keycloak недоступен)
-	Error       *string `json:"error"`
+	Error *string `json:"error"`
+
+	// KeycloakUrl URL Keycloak или issuer встроенного IdP
 	KeycloakUrl *string `json:"keycloak_url,omitempty"`
 
 	// LastSaSyncAt Время последней синхронизации SA
 	LastSaSyncAt *time.Time `json:"last_sa_sync_at"`
 
-	// Realm Имя realm
+	// Provider Провайдер учётных записей (AM_IDP_PROVIDER)
+	Provider IdpStatusProvider `json:"provider"`
+
+	// Realm Имя realm (пусто для встроенного IdP)
 	Realm string `json:"realm"`
 
 	// UsersCount Количество пользователей в realm
 	UsersCount *int `json:"users_count,omitempty"`
 }
 
+// IdpStatusProvider Провайдер учётных записей (AM_IDP_PROVIDER)
+type IdpStatusProvider string
+
+// PermissionCatalog Каталог разрешений — ресурсы и действия над ними
+type PermissionCatalog struct {
+	Resources []struct {
+		Actions []string `json:"actions"`
+		Name    string   `json:"name"`
+	} `json:"resources"`
+}
+
+// PersonalToken Personal access token (значение не возвращается)
+type PersonalToken struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Expired Срок действия истёк
+	Expired    bool                 `json:"expired"`
+	ExpiresAt  time.Time            `json:"expires_at"`
+	Id         openapi_types.UUID   `json:"id"`
+	LastUsedAt *time.Time           `json:"last_used_at"`
+	LastUsedIp *string              `json:"last_used_ip"`
+	Name       string               `json:"name"`
+	Scopes     []PersonalTokenScope `json:"scopes"`
+
+	// TokenPrefix Начало токена для распознавания
+	TokenPrefix string `json:"token_prefix"`
+
+	// UserId ID владельца в IdP
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// PersonalTokenCreate defines model for PersonalTokenCreate.
+type PersonalTokenCreate struct {
+	// ExpiresAt Срок действия (не больше AM_PAT_MAX_TTL)
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Name Название, уникальное у пользователя
+	Name   string               `json:"name"`
+	Scopes []PersonalTokenScope `json:"scopes"`
+}
+
+// PersonalTokenListResponse defines model for PersonalTokenListResponse.
+type PersonalTokenListResponse struct {
+	Items []PersonalToken `json:"items"`
+}
+
+// PersonalTokenScope Область API: files — /api/v1/files, storage — /api/v1/storage-elements
+// и /api/v1/sync-jobs, admin — остальные endpoints. read — GET,
+// write — остальные методы. Права ограничены также ролью владельца.
+type PersonalTokenScope string
+
+// PersonalTokenWithSecret defines model for PersonalTokenWithSecret.
+type PersonalTokenWithSecret struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Expired Срок действия истёк
+	Expired    bool                 `json:"expired"`
+	ExpiresAt  time.Time            `json:"expires_at"`
+	Id         openapi_types.UUID   `json:"id"`
+	LastUsedAt *time.Time           `json:"last_used_at"`
+	LastUsedIp *string              `json:"last_used_ip"`
+	Name       string               `json:"name"`
+	Scopes     []PersonalTokenScope `json:"scopes"`
+
+	// Token Значение для `Authorization: Bearer`.
+	// **Показывается только один раз!**
+	Token string `json:"token"`
+
+	// TokenPrefix Начало токена для распознавания
+	TokenPrefix string `json:"token_prefix"`
+
+	// UserId ID владельца в IdP
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// ResourceGrant Ограничение scope SA по ресурсам. Должно быть указано хотя бы одно
+// ограничение; `retention_policies` и `own_files_only` — только для
+// `files:read` и `files:write`.
+type ResourceGrant struct {
+	// OwnFilesOnly Только файлы, загруженные самим SA
+	OwnFilesOnly *bool `json:"own_files_only,omitempty"`
+
+	// RetentionPolicies Разрешённые политики хранения файлов
+	RetentionPolicies *[]ResourceGrantRetentionPolicies `json:"retention_policies,omitempty"`
+
+	// Scope Ограничиваемый scope (должен быть у SA)
+	Scope ResourceGrantScope `json:"scope"`
+
+	// SeIds Разрешённые SE
+	SeIds *[]openapi_types.UUID `json:"se_ids,omitempty"`
+
+	// SeLabels Метки SE (ключ=значение): zone, tier, owner и т.п. Используются для
+	// фильтрации, выбора SE для загрузки и распределения реплик.
+	// Ключ — строчные латинские буквы, цифры, `.`, `_`, `-`, `/` (до 63
+	// символов), значение — от 1 до 255 символов, не более 32 меток.
+	// При обновлении SE переданный объект заменяет все метки.
+	SeLabels *StorageElementLabels `json:"se_labels,omitempty"`
+}
+
+// ResourceGrantRetentionPolicies defines model for ResourceGrant.RetentionPolicies.
+type ResourceGrantRetentionPolicies string
+
+// ResourceGrantScope Ограничиваемый scope (должен быть у SA)
+type ResourceGrantScope string
+
+// Role Роль — набор разрешений и группы IdP, которые её дают
+type Role struct {
+	// Builtin Встроенная роль (не изменяется через API)
+	Builtin     bool   `json:"builtin"`
+	Description string `json:"description"`
+
+	// Groups Группы IdP, членство в которых даёт роль (для встроенных ролей —
+	// из AM_ROLE_ADMIN_GROUPS / AM_ROLE_READONLY_GROUPS)
+	Groups []string `json:"groups"`
+	Name   string   `json:"name"`
+
+	// Permissions Разрешения `<ресурс>:<действие>` в порядке каталога
+	Permissions []string `json:"permissions"`
+}
+
+// RoleCreate Создание пользовательской роли
+type RoleCreate struct {
+	Description *string   `json:"description,omitempty"`
+	Groups      *[]string `json:"groups,omitempty"`
+
+	// Name Имя роли (slug)
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// RoleListResponse defines model for RoleListResponse.
+type RoleListResponse struct {
+	Items []Role `json:"items"`
+}
+
 // RoleOverrideRequest Установка локального дополнения роли
 type RoleOverrideRequest struct {
-	// Role Роль для локального дополнения
-	Role RoleOverrideRequestRole `json:"role"`
+	// Role Роль каталога для локального дополнения
+	Role string `json:"role"`
+}
+
+// RoleUpdate Изменение пользовательской роли (полная замена)
+type RoleUpdate struct {
+	Description *string   `json:"description,omitempty"`
+	Groups      *[]string `json:"groups,omitempty"`
+	Permissions []string  `json:"permissions"`
 }
 
-// RoleOverrideRequestRole Роль для локального дополнения
-type RoleOverrideRequestRole string
+// RotateSecretRequest defines model for RotateSecretRequest.
+type RotateSecretRequest struct {
+	// GracePeriodHours Сколько часов после ротации действует старый secret.
+	// По умолчанию — `AM_SA_SECRET_ROTATION_GRACE`; 0 — старый secret
+	// недействителен сразу.
+	GracePeriodHours *int `json:"grace_period_hours,omitempty"`
+}
 
 // RotateSecretResponse defines model for RotateSecretResponse.
 type RotateSecretResponse struct {
@@ -511,6 +1319,12 @@ type RotateSecretResponse struct {
 
 	// ClientSecret Новый секрет от Keycloak. **Показывается только один раз!**
 	ClientSecret string `json:"client_secret"`
+
+	// PreviousSecretExpiresAt До этого времени действует старый secret (`null` — уже недействителен)
+	PreviousSecretExpiresAt *time.Time `json:"previous_secret_expires_at"`
+
+	// SecretExpiresAt Срок действия нового secret (`null` — не истекает)
+	SecretExpiresAt *time.Time `json:"secret_expires_at"`
 }
 
 // SASyncResult Результат синхронизации SA с Keycloak
@@ -547,8 +1361,18 @@ type ServiceAccount struct {
 	LastSyncedAt *time.Time `json:"last_synced_at"`
 
 	// Name Человекочитаемое имя
-	Name   string                 `json:"name"`
-	Scopes []ServiceAccountScopes `json:"scopes"`
+	Name string `json:"name"`
+
+	// PreviousSecretExpiresAt До этого времени после ротации действует предыдущий secret
+	PreviousSecretExpiresAt *time.Time             `json:"previous_secret_expires_at"`
+	Scopes                  []ServiceAccountScopes `json:"scopes"`
+
+	// SecretExpiresAt Срок действия secret (`secret_issued_at` + `AM_SA_SECRET_LIFETIME`).
+	// `null` — secret не истекает. После истечения SA приостанавливается.
+	SecretExpiresAt *time.Time `json:"secret_expires_at"`
+
+	// SecretIssuedAt Время выдачи текущего secret (создание SA или ротация)
+	SecretIssuedAt time.Time `json:"secret_issued_at"`
 
 	// Source Источник создания SA:
 	// - `local` — создан через Admin Module
@@ -581,6 +1405,11 @@ type ServiceAccountCreate struct {
 // ServiceAccountCreateScopes defines model for ServiceAccountCreate.Scopes.
 type ServiceAccountCreateScopes string
 
+// ServiceAccountGrants defines model for ServiceAccountGrants.
+type ServiceAccountGrants struct {
+	Grants []ResourceGrant `json:"grants"`
+}
+
 // ServiceAccountListResponse defines model for ServiceAccountListResponse.
 type ServiceAccountListResponse struct {
 	HasMore bool             `json:"has_more"`
@@ -623,8 +1452,18 @@ type ServiceAccountWithSecret struct {
 	LastSyncedAt *time.Time `json:"last_synced_at"`
 
 	// Name Человекочитаемое имя
-	Name   string                           `json:"name"`
-	Scopes []ServiceAccountWithSecretScopes `json:"scopes"`
+	Name string `json:"name"`
+
+	// PreviousSecretExpiresAt До этого времени после ротации действует предыдущий secret
+	PreviousSecretExpiresAt *time.Time                       `json:"previous_secret_expires_at"`
+	Scopes                  []ServiceAccountWithSecretScopes `json:"scopes"`
+
+	// SecretExpiresAt Срок действия secret (`secret_issued_at` + `AM_SA_SECRET_LIFETIME`).
+	// `null` — secret не истекает. После истечения SA приостанавливается.
+	SecretExpiresAt *time.Time `json:"secret_expires_at"`
+
+	// SecretIssuedAt Время выдачи текущего secret (создание SA или ротация)
+	SecretIssuedAt time.Time `json:"secret_issued_at"`
 
 	// Source Источник создания SA:
 	// - `local` — создан через Admin Module
@@ -645,6 +1484,63 @@ type ServiceAccountWithSecretSource string
 // ServiceAccountWithSecretStatus defines model for ServiceAccountWithSecret.Status.
 type ServiceAccountWithSecretStatus string
 
+// SigningKey Ключ подписи JWT встроенного IdP (закрытая часть не возвращается)
+type SigningKey struct {
+	// ActivatesAt Начало подписи токенов этим ключом
+	ActivatesAt time.Time           `json:"activates_at"`
+	Algorithm   SigningKeyAlgorithm `json:"algorithm"`
+	CreatedAt   time.Time           `json:"created_at"`
+
+	// Kid Идентификатор ключа (JWK kid)
+	Kid string `json:"kid"`
+
+	// RetiresAt Удаление ключа из JWKS (только для retiring)
+	RetiresAt *time.Time `json:"retires_at"`
+
+	// State pending — опубликован в JWKS, ещё не подписывает;
+	// active — подписывает новые токены;
+	// retiring — заменён, остаётся в JWKS до retires_at
+	State SigningKeyState `json:"state"`
+}
+
+// SigningKeyAlgorithm defines model for SigningKey.Algorithm.
+type SigningKeyAlgorithm string
+
+// SigningKeyState pending — опубликован в JWKS, ещё не подписывает;
+// active — подписывает новые токены;
+// retiring — заменён, остаётся в JWKS до retires_at
+type SigningKeyState string
+
+// SigningKeyRotateRequest Параметры ручной ротации ключа подписи
+type SigningKeyRotateRequest struct {
+	// Immediate Подписывать токены новым ключом сразу, без публикации заранее
+	Immediate *bool `json:"immediate,omitempty"`
+}
+
+// SigningKeysStatus Состояние ключей подписи JWT и параметры ротации
+type SigningKeysStatus struct {
+	// Algorithm Алгоритм новых ключей (AM_KEYS_ALGORITHM)
+	Algorithm SigningKeysStatusAlgorithm `json:"algorithm"`
+
+	// Keys Ключи, позже активируемые первыми
+	Keys []SigningKey `json:"keys"`
+
+	// NextRotationAt Время следующей плановой ротации (null — отключена)
+	NextRotationAt *time.Time `json:"next_rotation_at"`
+
+	// OverlapSeconds Сколько заменённый ключ остаётся в JWKS
+	OverlapSeconds int `json:"overlap_seconds"`
+
+	// PublishLeadSeconds За сколько новый ключ публикуется до начала подписи
+	PublishLeadSeconds int `json:"publish_lead_seconds"`
+
+	// RotationIntervalSeconds Период плановой ротации (0 — только ручная)
+	RotationIntervalSeconds int `json:"rotation_interval_seconds"`
+}
+
+// SigningKeysStatusAlgorithm Алгоритм новых ключей (AM_KEYS_ALGORITHM)
+type SigningKeysStatusAlgorithm string
+
 // StorageElement Зарегистрированный Storage Element
 type StorageElement struct {
 	AvailableBytes *int64             `json:"available_bytes,omitempty"`
@@ -652,6 +1548,13 @@ type StorageElement struct {
 	CreatedAt      time.Time          `json:"created_at"`
 	Id             openapi_types.UUID `json:"id"`
 
+	// Labels Метки SE (ключ=значение): zone, tier, owner и т.п. Используются для
+	// фильтрации, выбора SE для загрузки и распределения реплик.
+	// Ключ — строчные латинские буквы, цифры, `.`, `_`, `-`, `/` (до 63
+	// символов), значение — от 1 до 255 символов, не более 32 меток.
+	// При обновлении SE переданный объект заменяет все метки.
+	Labels *StorageElementLabels `json:"labels,omitempty"`
+
 	// LastFileSyncAt Время последней синхронизации файлов
 	LastFileSyncAt *time.Time `json:"last_file_sync_at"`
 
@@ -674,12 +1577,40 @@ type StorageElementMode string
 // StorageElementStatus defines model for StorageElement.Status.
 type StorageElementStatus string
 
+// StorageElementCandidate SE, подходящий для загрузки
+type StorageElementCandidate struct {
+	// FreeBytes Свободное место за вычетом резервирований
+	FreeBytes int64 `json:"free_bytes"`
+
+	// LabelMatches Число совпавших запрошенных меток
+	LabelMatches int `json:"label_matches"`
+
+	// ReservedBytes Место, зарезервированное активными резервированиями
+	ReservedBytes int64 `json:"reserved_bytes"`
+
+	// StorageElement Зарегистрированный Storage Element
+	StorageElement StorageElement `json:"storage_element"`
+}
+
 // StorageElementCreate defines model for StorageElementCreate.
 type StorageElementCreate struct {
-	Name string `json:"name"`
-	Url  string `json:"url"`
+	// Labels Метки SE (ключ=значение): zone, tier, owner и т.п. Используются для
+	// фильтрации, выбора SE для загрузки и распределения реплик.
+	// Ключ — строчные латинские буквы, цифры, `.`, `_`, `-`, `/` (до 63
+	// символов), значение — от 1 до 255 символов, не более 32 меток.
+	// При обновлении SE переданный объект заменяет все метки.
+	Labels *StorageElementLabels `json:"labels,omitempty"`
+	Name   string                `json:"name"`
+	Url    string                `json:"url"`
 }
 
+// StorageElementLabels Метки SE (ключ=значение): zone, tier, owner и т.п. Используются для
+// фильтрации, выбора SE для загрузки и распределения реплик.
+// Ключ — строчные латинские буквы, цифры, `.`, `_`, `-`, `/` (до 63
+// символов), значение — от 1 до 255 символов, не более 32 меток.
+// При обновлении SE переданный объект заменяет все метки.
+type StorageElementLabels map[string]string
+
 // StorageElementListResponse defines model for StorageElementListResponse.
 type StorageElementListResponse struct {
 	HasMore bool             `json:"has_more"`
@@ -689,27 +1620,208 @@ type StorageElementListResponse struct {
 	Total   int              `json:"total"`
 }
 
-// StorageElementUpdate defines model for StorageElementUpdate.
-type StorageElementUpdate struct {
-	Name *string `json:"name,omitempty"`
-	Url  *string `json:"url,omitempty"`
+// StorageElementSelectRequest Параметры выбора SE для загрузки файла
+type StorageElementSelectRequest struct {
+	// Labels Предпочтительные метки SE (политика `prefer_labels`)
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Policy Политика размещения (по умолчанию `AM_PLACEMENT_POLICY`)
+	Policy *StorageElementSelectRequestPolicy `json:"policy,omitempty"`
+
+	// RequireLabels Обязательные метки SE — SE без них не рассматриваются
+	RequireLabels *map[string]string `json:"require_labels,omitempty"`
+
+	// RetentionPolicy Политика хранения файла (определяет режим SE)
+	RetentionPolicy StorageElementSelectRequestRetentionPolicy `json:"retention_policy"`
+
+	// SizeBytes Размер загружаемого файла в байтах
+	SizeBytes int64 `json:"size_bytes"`
 }
 
-// SyncResponse Результат синхронизации SE
-type SyncResponse struct {
-	FileSync struct {
-		CompletedAt        time.Time `json:"completed_at"`
-		FilesAdded         int       `json:"files_added"`
-		FilesMarkedDeleted int       `json:"files_marked_deleted"`
-		FilesOnSe          int       `json:"files_on_se"`
-		FilesUpdated       int       `json:"files_updated"`
-		StartedAt          time.Time `json:"started_at"`
-	} `json:"file_sync"`
+// StorageElementSelectRequestPolicy Политика размещения (по умолчанию `AM_PLACEMENT_POLICY`)
+type StorageElementSelectRequestPolicy string
+
+// StorageElementSelectRequestRetentionPolicy Политика хранения файла (определяет режим SE)
+type StorageElementSelectRequestRetentionPolicy string
+
+// StorageElementSelection Результат выбора SE для загрузки
+type StorageElementSelection struct {
+	// Candidates Подходящие SE в порядке предпочтения; первый — выбранный
+	Candidates []StorageElementCandidate `json:"candidates"`
+
+	// ExpiresAt Время истечения резервирования
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Policy Применённая политика размещения
+	Policy StorageElementSelectionPolicy `json:"policy"`
+
+	// ReservationId ID резервирования места на выбранном SE
+	ReservationId openapi_types.UUID `json:"reservation_id"`
 
 	// StorageElement Зарегистрированный Storage Element
 	StorageElement StorageElement `json:"storage_element"`
 }
 
+// StorageElementSelectionPolicy Применённая политика размещения
+type StorageElementSelectionPolicy string
+
+// StorageElementUpdate defines model for StorageElementUpdate.
+type StorageElementUpdate struct {
+	// Labels Метки SE (ключ=значение): zone, tier, owner и т.п. Используются для
+	// фильтрации, выбора SE для загрузки и распределения реплик.
+	// Ключ — строчные латинские буквы, цифры, `.`, `_`, `-`, `/` (до 63
+	// символов), значение — от 1 до 255 символов, не более 32 меток.
+	// При обновлении SE переданный объект заменяет все метки.
+	Labels *StorageElementLabels `json:"labels,omitempty"`
+	Name   *string               `json:"name,omitempty"`
+	Url    *string               `json:"url,omitempty"`
+}
+
+// SyncJob Фоновая задача синхронизации файлового реестра с SE
+type SyncJob struct {
+	CompletedAt *time.Time `json:"completed_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DurationMs  *int64     `json:"duration_ms"`
+
+	// Error Текст ошибки (для failed и cancelled)
+	Error              *string `json:"error"`
+	FilesAdded         int     `json:"files_added"`
+	FilesMarkedDeleted int     `json:"files_marked_deleted"`
+	FilesOnSe          int     `json:"files_on_se"`
+
+	// FilesProcessed Обработано файлов SE на текущий момент
+	FilesProcessed int                `json:"files_processed"`
+	FilesUpdated   int                `json:"files_updated"`
+	Id             openapi_types.UUID `json:"id"`
+
+	// Mode Режим синхронизации:
+	// - `full` — все файлы SE, поиск удалённых файлов и потерянных реплик
+	// - `incremental` — только файлы, изменённые с курсора предыдущей синхронизации
+	Mode SyncJobMode `json:"mode"`
+
+	// RequestedBy preferred_username или client_id SA, запустивших задачу
+	RequestedBy      *string            `json:"requested_by"`
+	StartedAt        *time.Time         `json:"started_at"`
+	Status           SyncJobStatus      `json:"status"`
+	StorageElementId openapi_types.UUID `json:"storage_element_id"`
+
+	// Trigger Источник запуска:
+	// - `periodic` — периодическая синхронизация
+	// - `manual` — ручной запуск (API или Admin UI)
+	// - `registration` — полная синхронизация при регистрации SE
+	Trigger SyncJobTrigger `json:"trigger"`
+}
+
+// SyncJobMode Режим синхронизации:
+// - `full` — все файлы SE, поиск удалённых файлов и потерянных реплик
+// - `incremental` — только файлы, изменённые с курсора предыдущей синхронизации
+type SyncJobMode string
+
+// SyncJobStatus defines model for SyncJob.Status.
+type SyncJobStatus string
+
+// SyncJobTrigger Источник запуска:
+// - `periodic` — периодическая синхронизация
+// - `manual` — ручной запуск (API или Admin UI)
+// - `registration` — полная синхронизация при регистрации SE
+type SyncJobTrigger string
+
+// SyncJobListResponse defines model for SyncJobListResponse.
+type SyncJobListResponse struct {
+	HasMore bool      `json:"has_more"`
+	Items   []SyncJob `json:"items"`
+	Limit   int       `json:"limit"`
+	Offset  int       `json:"offset"`
+	Total   int       `json:"total"`
+}
+
+// WebhookDelivery Доставка события на webhook endpoint
+type WebhookDelivery struct {
+	Attempts    int                  `json:"attempts"`
+	CreatedAt   time.Time            `json:"created_at"`
+	DeliveredAt *time.Time           `json:"delivered_at"`
+	EndpointId  openapi_types.UUID   `json:"endpoint_id"`
+	Event       WebhookDeliveryEvent `json:"event"`
+
+	// Id Значение заголовка X-Artstore-Delivery
+	Id            openapi_types.UUID `json:"id"`
+	LastError     *string            `json:"last_error"`
+	NextAttemptAt time.Time          `json:"next_attempt_at"`
+
+	// Payload Тело запроса
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// ResponseStatus HTTP-статус последнего ответа
+	ResponseStatus *int                  `json:"response_status"`
+	RuleId         *openapi_types.UUID   `json:"rule_id"`
+	Status         WebhookDeliveryStatus `json:"status"`
+}
+
+// WebhookDeliveryEvent defines model for WebhookDelivery.Event.
+type WebhookDeliveryEvent string
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookDeliveryListResponse defines model for WebhookDeliveryListResponse.
+type WebhookDeliveryListResponse struct {
+	HasMore bool              `json:"has_more"`
+	Items   []WebhookDelivery `json:"items"`
+	Limit   int               `json:"limit"`
+	Offset  int               `json:"offset"`
+	Total   int               `json:"total"`
+}
+
+// WebhookEndpoint Получатель оповещений. Каждая доставка — HTTP POST с JSON-телом
+// и заголовками `X-Artstore-Event`, `X-Artstore-Delivery`,
+// `X-Artstore-Timestamp`, `X-Artstore-Signature`
+// (`sha256=` + hex HMAC-SHA256 ключа от `<timestamp>.<тело>`).
+type WebhookEndpoint struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Enabled   bool               `json:"enabled"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// Secret Ключ подписи — только в ответе на создание
+	Secret    *string   `json:"secret,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Url       string    `json:"url"`
+}
+
+// WebhookEndpointCreate defines model for WebhookEndpointCreate.
+type WebhookEndpointCreate struct {
+	Enabled *bool  `json:"enabled,omitempty"`
+	Name    string `json:"name"`
+
+	// Secret Ключ подписи; не задан — генерируется
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// WebhookEndpointListResponse defines model for WebhookEndpointListResponse.
+type WebhookEndpointListResponse struct {
+	Items []WebhookEndpoint `json:"items"`
+}
+
+// WebhookEndpointUpdate defines model for WebhookEndpointUpdate.
+type WebhookEndpointUpdate struct {
+	Enabled *bool   `json:"enabled,omitempty"`
+	Name    *string `json:"name,omitempty"`
+
+	// Secret Новый ключ подписи
+	Secret *string `json:"secret,omitempty"`
+	Url    *string `json:"url,omitempty"`
+}
+
+// AlertRuleId defines model for AlertRuleId.
+type AlertRuleId = openapi_types.UUID
+
+// CollectionId defines model for CollectionId.
+type CollectionId = openapi_types.UUID
+
+// FileBatchId defines model for FileBatchId.
+type FileBatchId = openapi_types.UUID
+
 // FileId defines model for FileId.
 type FileId = openapi_types.UUID
 
@@ -719,15 +1831,27 @@ type Limit = int
 // Offset defines model for Offset.
 type Offset = int
 
+// ReservationId defines model for ReservationId.
+type ReservationId = openapi_types.UUID
+
+// RoleName defines model for RoleName.
+type RoleName = string
+
 // ServiceAccountId defines model for ServiceAccountId.
 type ServiceAccountId = openapi_types.UUID
 
 // StorageElementId defines model for StorageElementId.
 type StorageElementId = openapi_types.UUID
 
+// SyncJobId defines model for SyncJobId.
+type SyncJobId = openapi_types.UUID
+
 // UserId defines model for UserId.
 type UserId = string
 
+// WebhookId defines model for WebhookId.
+type WebhookId = openapi_types.UUID
+
 // Forbidden Стандартный формат ошибки (единый для всей системы Artstore)
 type Forbidden = ErrorResponse
 
@@ -749,6 +1873,39 @@ type ListAdminUsersParams struct {
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// ListAuditEventsParams defines parameters for ListAuditEvents.
+type ListAuditEventsParams struct {
+	// Limit Количество записей на странице
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение от начала списка
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Actor Фильтр по субъекту (actor_id или actor_name)
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Фильтр по действию (например, service_account.update)
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetType Фильтр по типу объекта
+	TargetType *ListAuditEventsParamsTargetType `form:"target_type,omitempty" json:"target_type,omitempty"`
+
+	// TargetId Фильтр по идентификатору объекта
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
+
+	// From Начало периода (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListAuditEventsParamsTargetType defines parameters for ListAuditEvents.
+type ListAuditEventsParamsTargetType string
+
+// RemoveCollectionMemberParamsSubjectType defines parameters for RemoveCollectionMember.
+type RemoveCollectionMemberParamsSubjectType string
+
 // ListFilesParams defines parameters for ListFiles.
 type ListFilesParams struct {
 	// Limit Количество записей на странице
@@ -766,8 +1923,33 @@ type ListFilesParams struct {
 	// StorageElementId Фильтр по Storage Element
 	StorageElementId *openapi_types.UUID `form:"storage_element_id,omitempty" json:"storage_element_id,omitempty"`
 
+	// CollectionId Фильтр по коллекции
+	CollectionId *openapi_types.UUID `form:"collection_id,omitempty" json:"collection_id,omitempty"`
+
 	// UploadedBy Фильтр по загрузившему
 	UploadedBy *string `form:"uploaded_by,omitempty" json:"uploaded_by,omitempty"`
+
+	// Filename Подстрока имени файла (без учёта регистра)
+	Filename *string `form:"filename,omitempty" json:"filename,omitempty"`
+
+	// ContentType Префикс MIME-типа: группа (`image`) или тип (`image/png`),
+	// без учёта регистра
+	ContentType *string `form:"content_type,omitempty" json:"content_type,omitempty"`
+
+	// Tag Тег; при нескольких значениях файл должен иметь все теги
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// MinSize Минимальный размер файла, байт
+	MinSize *int64 `form:"min_size,omitempty" json:"min_size,omitempty"`
+
+	// MaxSize Максимальный размер файла, байт
+	MaxSize *int64 `form:"max_size,omitempty" json:"max_size,omitempty"`
+
+	// UploadedAfter Файлы, загруженные не раньше указанного момента
+	UploadedAfter *time.Time `form:"uploaded_after,omitempty" json:"uploaded_after,omitempty"`
+
+	// UploadedBefore Файлы, загруженные не позже указанного момента
+	UploadedBefore *time.Time `form:"uploaded_before,omitempty" json:"uploaded_before,omitempty"`
 }
 
 // ListFilesParamsStatus defines parameters for ListFiles.
@@ -776,6 +1958,27 @@ type ListFilesParamsStatus string
 // ListFilesParamsRetentionPolicy defines parameters for ListFiles.
 type ListFilesParamsRetentionPolicy string
 
+// ListFileBatchResultsParams defines parameters for ListFileBatchResults.
+type ListFileBatchResultsParams struct {
+	// Limit Количество записей на странице
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение от начала списка
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Status Фильтр по результату
+	Status *FileBatchResultStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListPersonalTokensParams defines parameters for ListPersonalTokens.
+type ListPersonalTokensParams struct {
+	// UserId ID владельца в IdP (другой пользователь — разрешение users:read)
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// All Токены всех пользователей (разрешение users:read)
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
+}
+
 // ListServiceAccountsParams defines parameters for ListServiceAccounts.
 type ListServiceAccountsParams struct {
 	// Limit Количество записей на странице
@@ -786,6 +1989,9 @@ type ListServiceAccountsParams struct {
 
 	// Status Фильтр по статусу
 	Status *ListServiceAccountsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// SecretExpiringWithinDays Только активные SA, секрет которых истекает в ближайшие N дней
+	SecretExpiringWithinDays *int `form:"secret_expiring_within_days,omitempty" json:"secret_expiring_within_days,omitempty"`
 }
 
 // ListServiceAccountsParamsStatus defines parameters for ListServiceAccounts.
@@ -804,6 +2010,10 @@ type ListStorageElementsParams struct {
 
 	// Status Фильтр по статусу
 	Status *ListStorageElementsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Label Фильтр по меткам в виде `ключ=значение`. Можно указать несколько
+	// раз — SE должен иметь все указанные метки.
+	Label *[]string `form:"label,omitempty" json:"label,omitempty"`
 }
 
 // ListStorageElementsParamsMode defines parameters for ListStorageElements.
@@ -812,29 +2022,122 @@ type ListStorageElementsParamsMode string
 // ListStorageElementsParamsStatus defines parameters for ListStorageElements.
 type ListStorageElementsParamsStatus string
 
+// ListSyncJobsParams defines parameters for ListSyncJobs.
+type ListSyncJobsParams struct {
+	// Limit Количество записей на странице
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение от начала списка
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// StorageElementId Фильтр по Storage Element
+	StorageElementId *openapi_types.UUID `form:"storage_element_id,omitempty" json:"storage_element_id,omitempty"`
+
+	// Status Фильтр по состоянию задачи
+	Status *ListSyncJobsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Trigger Фильтр по источнику запуска
+	Trigger *ListSyncJobsParamsTrigger `form:"trigger,omitempty" json:"trigger,omitempty"`
+}
+
+// ListSyncJobsParamsStatus defines parameters for ListSyncJobs.
+type ListSyncJobsParamsStatus string
+
+// ListSyncJobsParamsTrigger defines parameters for ListSyncJobs.
+type ListSyncJobsParamsTrigger string
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Limit Количество записей на странице
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение от начала списка
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Status Фильтр по состоянию доставки
+	Status *ListWebhookDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListWebhookDeliveriesParamsStatus defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParamsStatus string
+
+// CreateAdminUserJSONRequestBody defines body for CreateAdminUser for application/json ContentType.
+type CreateAdminUserJSONRequestBody = AdminUserCreate
+
 // UpdateAdminUserJSONRequestBody defines body for UpdateAdminUser for application/json ContentType.
 type UpdateAdminUserJSONRequestBody = AdminUserUpdate
 
+// UpdateAdminUserAccountJSONRequestBody defines body for UpdateAdminUserAccount for application/json ContentType.
+type UpdateAdminUserAccountJSONRequestBody = AdminUserAccountUpdate
+
+// SetAdminUserPasswordJSONRequestBody defines body for SetAdminUserPassword for application/json ContentType.
+type SetAdminUserPasswordJSONRequestBody = AdminUserPasswordRequest
+
 // SetRoleOverrideJSONRequestBody defines body for SetRoleOverride for application/json ContentType.
 type SetRoleOverrideJSONRequestBody = RoleOverrideRequest
 
+// CreateAlertRuleJSONRequestBody defines body for CreateAlertRule for application/json ContentType.
+type CreateAlertRuleJSONRequestBody = AlertRuleInput
+
+// UpdateAlertRuleJSONRequestBody defines body for UpdateAlertRule for application/json ContentType.
+type UpdateAlertRuleJSONRequestBody = AlertRuleInput
+
+// CreateCollectionJSONRequestBody defines body for CreateCollection for application/json ContentType.
+type CreateCollectionJSONRequestBody = CollectionInput
+
+// UpdateCollectionJSONRequestBody defines body for UpdateCollection for application/json ContentType.
+type UpdateCollectionJSONRequestBody = CollectionInput
+
+// SetCollectionMemberJSONRequestBody defines body for SetCollectionMember for application/json ContentType.
+type SetCollectionMemberJSONRequestBody = CollectionMemberInput
+
 // RegisterFileJSONRequestBody defines body for RegisterFile for application/json ContentType.
 type RegisterFileJSONRequestBody = FileRegisterRequest
 
+// CreateFileBatchJSONRequestBody defines body for CreateFileBatch for application/json ContentType.
+type CreateFileBatchJSONRequestBody = FileBatchRequest
+
 // UpdateFileJSONRequestBody defines body for UpdateFile for application/json ContentType.
 type UpdateFileJSONRequestBody = FileRecordUpdate
 
+// RotateSigningKeyJSONRequestBody defines body for RotateSigningKey for application/json ContentType.
+type RotateSigningKeyJSONRequestBody = SigningKeyRotateRequest
+
+// CreatePersonalTokenJSONRequestBody defines body for CreatePersonalToken for application/json ContentType.
+type CreatePersonalTokenJSONRequestBody = PersonalTokenCreate
+
+// CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
+type CreateRoleJSONRequestBody = RoleCreate
+
+// UpdateRoleJSONRequestBody defines body for UpdateRole for application/json ContentType.
+type UpdateRoleJSONRequestBody = RoleUpdate
+
 // CreateServiceAccountJSONRequestBody defines body for CreateServiceAccount for application/json ContentType.
 type CreateServiceAccountJSONRequestBody = ServiceAccountCreate
 
 // UpdateServiceAccountJSONRequestBody defines body for UpdateServiceAccount for application/json ContentType.
 type UpdateServiceAccountJSONRequestBody = ServiceAccountUpdate
 
+// ReplaceServiceAccountGrantsJSONRequestBody defines body for ReplaceServiceAccountGrants for application/json ContentType.
+type ReplaceServiceAccountGrantsJSONRequestBody = ServiceAccountGrants
+
+// RotateSecretJSONRequestBody defines body for RotateSecret for application/json ContentType.
+type RotateSecretJSONRequestBody = RotateSecretRequest
+
 // CreateStorageElementJSONRequestBody defines body for CreateStorageElement for application/json ContentType.
 type CreateStorageElementJSONRequestBody = StorageElementCreate
 
 // DiscoverStorageElementJSONRequestBody defines body for DiscoverStorageElement for application/json ContentType.
 type DiscoverStorageElementJSONRequestBody = DiscoverRequest
 
+// SelectStorageElementJSONRequestBody defines body for SelectStorageElement for application/json ContentType.
+type SelectStorageElementJSONRequestBody = StorageElementSelectRequest
+
 // UpdateStorageElementJSONRequestBody defines body for UpdateStorageElement for application/json ContentType.
 type UpdateStorageElementJSONRequestBody = StorageElementUpdate
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookEndpointCreate
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = WebhookEndpointUpdate
@@ -14,7 +14,7 @@ import (
 
 // GetCurrentUser — GET /api/v1/admin-auth/me.
 // Возвращает данные текущего пользователя из JWT claims.
-// Доступ: любой аутентифицированный пользователь (admin или readonly).
+// Доступ: любой аутентифицированный пользователь.
 func (h *APIHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 	claims := middleware.ClaimsFromContext(r.Context())
 	if claims == nil {
@@ -34,8 +34,10 @@ func (h *APIHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 	resp := generated.CurrentUser{
 		Id:            user.ID,
 		Username:      user.Username,
-		EffectiveRole: generated.CurrentUserEffectiveRole(user.EffectiveRole),
-		IdpRole:       generated.CurrentUserIdpRole(user.IdpRole),
+		EffectiveRole: user.EffectiveRole,
+		IdpRole:       user.IdpRole,
+		Roles:         &user.Roles,
+		Permissions:   &user.Permissions,
 	}
 
 	if user.Email != "" {
@@ -48,10 +50,7 @@ func (h *APIHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 		resp.Groups = &groups
 	}
 
-	if user.RoleOverride != nil {
-		override := generated.CurrentUserRoleOverride(*user.RoleOverride)
-		resp.RoleOverride = &override
-	}
+	resp.RoleOverride = user.RoleOverride
 
 	writeJSON(w, http.StatusOK, resp)
 }
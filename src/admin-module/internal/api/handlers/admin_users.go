@@ -1,5 +1,6 @@
 // admin_users.go — обработчики /api/v1/admin-users endpoints.
-// Управление пользователями: список, получение, обновление role override, удаление.
+// Управление пользователями: список, получение, обновление role override, удаление;
+// при локальном IdP — создание, изменение и удаление учётных записей, смена пароля.
 package handlers
 
 import (
@@ -13,16 +14,18 @@ import (
 	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
 	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
 )
 
 // ListAdminUsers — GET /api/v1/admin-users.
-// Возвращает список пользователей из Keycloak с role overrides.
-// Доступ: admin или readonly.
+// Возвращает список пользователей из IdP с role overrides.
+// Доступ: разрешение users:read.
 func (h *APIHandler) ListAdminUsers(w http.ResponseWriter, r *http.Request, params generated.ListAdminUsersParams) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasAnyRole("admin", "readonly") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin или readonly")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermUsersRead) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение users:read")
 		return
 	}
 
@@ -31,7 +34,7 @@ func (h *APIHandler) ListAdminUsers(w http.ResponseWriter, r *http.Request, para
 	users, total, err := h.adminUsers.ListUsers(r.Context(), limit, offset)
 	if err != nil {
 		h.logger.Error("Ошибка получения списка пользователей", "error", err)
-		apierrors.IDPUnavailable(w, "Ошибка получения пользователей из Keycloak")
+		apierrors.IDPUnavailable(w, "Ошибка получения пользователей из IdP")
 		return
 	}
 
@@ -52,19 +55,23 @@ func (h *APIHandler) ListAdminUsers(w http.ResponseWriter, r *http.Request, para
 }
 
 // GetAdminUser — GET /api/v1/admin-users/{id}.
-// Возвращает пользователя по Keycloak ID.
-// Доступ: admin или readonly.
+// Возвращает пользователя по ID в IdP.
+// Доступ: разрешение users:read.
 func (h *APIHandler) GetAdminUser(w http.ResponseWriter, r *http.Request, id generated.UserId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasAnyRole("admin", "readonly") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin или readonly")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermUsersRead) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение users:read")
 		return
 	}
 
 	user, err := h.adminUsers.GetUser(r.Context(), id)
 	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Пользователь не найден")
+			return
+		}
 		h.logger.Error("Ошибка получения пользователя", "user_id", id, "error", err)
-		apierrors.IDPUnavailable(w, "Ошибка получения пользователя из Keycloak")
+		apierrors.IDPUnavailable(w, "Ошибка получения пользователя из IdP")
 		return
 	}
 
@@ -73,11 +80,11 @@ func (h *APIHandler) GetAdminUser(w http.ResponseWriter, r *http.Request, id gen
 
 // UpdateAdminUser — PUT /api/v1/admin-users/{id}.
 // Обновляет role override пользователя. null удаляет override.
-// Доступ: admin.
+// Доступ: разрешение users:write.
 func (h *APIHandler) UpdateAdminUser(w http.ResponseWriter, r *http.Request, id generated.UserId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermUsersWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение users:write")
 		return
 	}
 
@@ -88,18 +95,16 @@ func (h *APIHandler) UpdateAdminUser(w http.ResponseWriter, r *http.Request, id
 	}
 
 	// Маппинг: если RoleOverride nil — удаляем override, иначе устанавливаем
-	var roleOverride *string
-	if req.RoleOverride != nil {
-		s := string(*req.RoleOverride)
-		roleOverride = &s
-	}
-
-	user, err := h.adminUsers.UpdateUser(r.Context(), id, roleOverride, claims.PreferredUsername)
+	user, err := h.adminUsers.UpdateUser(r.Context(), id, req.RoleOverride, claims)
 	if err != nil {
 		if errors.Is(err, service.ErrInvalidRole) {
 			apierrors.ValidationError(w, err.Error())
 			return
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			apierrors.Forbidden(w, "Нельзя назначить роль с разрешениями, которых нет у вас")
+			return
+		}
 		h.logger.Error("Ошибка обновления пользователя", "user_id", id, "error", err)
 		apierrors.InternalError(w, "Ошибка обновления пользователя")
 		return
@@ -110,11 +115,11 @@ func (h *APIHandler) UpdateAdminUser(w http.ResponseWriter, r *http.Request, id
 
 // DeleteAdminUser — DELETE /api/v1/admin-users/{id}.
 // Удаляет role override пользователя.
-// Доступ: admin.
+// Доступ: разрешение users:write.
 func (h *APIHandler) DeleteAdminUser(w http.ResponseWriter, r *http.Request, id generated.UserId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermUsersWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение users:write")
 		return
 	}
 
@@ -133,11 +138,11 @@ func (h *APIHandler) DeleteAdminUser(w http.ResponseWriter, r *http.Request, id
 
 // SetRoleOverride — POST /api/v1/admin-users/{id}/role-override.
 // Устанавливает role override для пользователя.
-// Доступ: admin.
+// Доступ: разрешение users:write.
 func (h *APIHandler) SetRoleOverride(w http.ResponseWriter, r *http.Request, id generated.UserId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermUsersWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение users:write")
 		return
 	}
 
@@ -147,12 +152,16 @@ func (h *APIHandler) SetRoleOverride(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
-	user, err := h.adminUsers.SetRoleOverride(r.Context(), id, string(req.Role), claims.PreferredUsername)
+	user, err := h.adminUsers.SetRoleOverride(r.Context(), id, req.Role, claims)
 	if err != nil {
 		if errors.Is(err, service.ErrInvalidRole) {
 			apierrors.ValidationError(w, err.Error())
 			return
 		}
+		if errors.Is(err, service.ErrForbidden) {
+			apierrors.Forbidden(w, "Нельзя назначить роль с разрешениями, которых нет у вас")
+			return
+		}
 		h.logger.Error("Ошибка установки role override", "user_id", id, "error", err)
 		apierrors.InternalError(w, "Ошибка установки role override")
 		return
@@ -161,6 +170,148 @@ func (h *APIHandler) SetRoleOverride(w http.ResponseWriter, r *http.Request, id
 	writeJSON(w, http.StatusOK, mapAdminUser(user))
 }
 
+// CreateAdminUser — POST /api/v1/admin-users.
+// Создаёт пользователя локального IdP.
+// Доступ: разрешение users:write.
+func (h *APIHandler) CreateAdminUser(w http.ResponseWriter, r *http.Request) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermUsersWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение users:write")
+		return
+	}
+
+	var req generated.AdminUserCreate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	account := idp.UserAccount{
+		Username:  req.Username,
+		Email:     emailValue(req.Email),
+		FirstName: derefString(req.FirstName),
+		LastName:  derefString(req.LastName),
+		Enabled:   req.Enabled == nil || *req.Enabled,
+	}
+	if req.Groups != nil {
+		account.Groups = *req.Groups
+	}
+
+	user, err := h.adminUsers.CreateAccount(r.Context(), account, req.Password)
+	if err != nil {
+		h.writeAccountError(w, err, "Ошибка создания пользователя", "")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapAdminUser(user))
+}
+
+// UpdateAdminUserAccount — PUT /api/v1/admin-users/{id}/account.
+// Заменяет email, имя, группы и состояние учётной записи локального IdP.
+// Доступ: разрешение users:write.
+func (h *APIHandler) UpdateAdminUserAccount(w http.ResponseWriter, r *http.Request, id generated.UserId) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermUsersWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение users:write")
+		return
+	}
+
+	var req generated.AdminUserAccountUpdate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	user, err := h.adminUsers.UpdateAccount(r.Context(), id, idp.UserAccount{
+		Email:     emailValue(req.Email),
+		FirstName: derefString(req.FirstName),
+		LastName:  derefString(req.LastName),
+		Enabled:   req.Enabled,
+		Groups:    req.Groups,
+	})
+	if err != nil {
+		h.writeAccountError(w, err, "Ошибка изменения пользователя", id)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapAdminUser(user))
+}
+
+// DeleteAdminUserAccount — DELETE /api/v1/admin-users/{id}/account.
+// Удаляет учётную запись локального IdP.
+// Доступ: разрешение users:write.
+func (h *APIHandler) DeleteAdminUserAccount(w http.ResponseWriter, r *http.Request, id generated.UserId) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermUsersWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение users:write")
+		return
+	}
+
+	if err := h.adminUsers.DeleteAccount(r.Context(), id); err != nil {
+		h.writeAccountError(w, err, "Ошибка удаления пользователя", id)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetAdminUserPassword — PUT /api/v1/admin-users/{id}/password.
+// Устанавливает пароль пользователя локального IdP и снимает блокировку входа.
+// Доступ: разрешение users:write.
+func (h *APIHandler) SetAdminUserPassword(w http.ResponseWriter, r *http.Request, id generated.UserId) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermUsersWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение users:write")
+		return
+	}
+
+	var req generated.AdminUserPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	if err := h.adminUsers.ResetPassword(r.Context(), id, req.Password); err != nil {
+		h.writeAccountError(w, err, "Ошибка установки пароля", id)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeAccountError отвечает ошибкой операции с учётной записью локального IdP.
+func (h *APIHandler) writeAccountError(w http.ResponseWriter, err error, message, userID string) {
+	switch {
+	case errors.Is(err, service.ErrUnsupported):
+		apierrors.Conflict(w, "Учётные записи управляются во внешнем IdP (Keycloak)")
+	case errors.Is(err, service.ErrValidation):
+		apierrors.ValidationError(w, err.Error())
+	case errors.Is(err, service.ErrConflict):
+		apierrors.Conflict(w, "Пользователь с таким именем уже существует")
+	case errors.Is(err, service.ErrNotFound):
+		apierrors.NotFound(w, "Пользователь не найден")
+	default:
+		h.logger.Error(message, "user_id", userID, "error", err)
+		apierrors.InternalError(w, message)
+	}
+}
+
+// emailValue возвращает email из запроса ("" если не задан).
+func emailValue(email *openapi_types.Email) string {
+	if email == nil {
+		return ""
+	}
+	return string(*email)
+}
+
+// derefString возвращает значение строки ("" для nil).
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // --- Маппинг domain → API ---
 
 // mapAdminUser конвертирует domain model в generated API type.
@@ -168,11 +319,22 @@ func mapAdminUser(u *model.AdminUser) generated.AdminUser {
 	result := generated.AdminUser{
 		Id:            u.ID,
 		Username:      u.Username,
-		EffectiveRole: generated.AdminUserEffectiveRole(u.EffectiveRole),
-		IdpRole:       generated.AdminUserIdpRole(u.IdpRole),
+		EffectiveRole: u.EffectiveRole,
+		IdpRole:       u.IdpRole,
+		RoleOverride:  u.RoleOverride,
 		CreatedAt:     u.CreatedAt,
 	}
 
+	if len(u.Roles) > 0 {
+		roles := u.Roles
+		result.Roles = &roles
+	}
+
+	if len(u.Permissions) > 0 {
+		perms := u.Permissions
+		result.Permissions = &perms
+	}
+
 	if u.Email != "" {
 		email := openapi_types.Email(u.Email)
 		result.Email = &email
@@ -194,10 +356,5 @@ func mapAdminUser(u *model.AdminUser) generated.AdminUser {
 		result.Groups = &groups
 	}
 
-	if u.RoleOverride != nil {
-		override := generated.AdminUserRoleOverride(*u.RoleOverride)
-		result.RoleOverride = &override
-	}
-
 	return result
 }
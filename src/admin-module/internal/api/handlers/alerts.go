@@ -0,0 +1,476 @@
+// alerts.go — обработчики /api/v1/alerts, /api/v1/alert-rules и /api/v1/webhooks.
+// Сработавшие оповещения, CRUD правил, CRUD webhook endpoints,
+// тестовая доставка и журнал доставок.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+)
+
+// ListAlerts — GET /api/v1/alerts.
+// Возвращает сработавшие оповещения.
+// Доступ: разрешение settings:read или SA admin:read.
+func (h *APIHandler) ListAlerts(w http.ResponseWriter, r *http.Request) {
+	if !h.requireAdminRead(w, r) {
+		return
+	}
+
+	alerts, err := h.alerts.ListAlerts(r.Context())
+	if err != nil {
+		h.logger.Error("Ошибка получения оповещений", "error", err)
+		apierrors.InternalError(w, "Ошибка получения оповещений")
+		return
+	}
+
+	items := make([]generated.Alert, len(alerts))
+	for i, a := range alerts {
+		items[i] = mapAlert(a)
+	}
+	writeJSON(w, http.StatusOK, generated.AlertListResponse{Items: items})
+}
+
+// ListAlertRules — GET /api/v1/alert-rules.
+// Доступ: разрешение settings:read или SA admin:read.
+func (h *APIHandler) ListAlertRules(w http.ResponseWriter, r *http.Request) {
+	if !h.requireAdminRead(w, r) {
+		return
+	}
+
+	rules, err := h.alerts.List(r.Context())
+	if err != nil {
+		h.logger.Error("Ошибка получения правил оповещений", "error", err)
+		apierrors.InternalError(w, "Ошибка получения правил оповещений")
+		return
+	}
+
+	items := make([]generated.AlertRule, len(rules))
+	for i, rule := range rules {
+		items[i] = mapAlertRule(rule)
+	}
+	writeJSON(w, http.StatusOK, generated.AlertRuleListResponse{Items: items})
+}
+
+// CreateAlertRule — POST /api/v1/alert-rules.
+// Доступ: разрешение settings:write или SA admin:write.
+func (h *APIHandler) CreateAlertRule(w http.ResponseWriter, r *http.Request) {
+	if !h.requireAdminWrite(w, r) {
+		return
+	}
+
+	var req generated.AlertRuleInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	rule, err := h.alerts.Create(r.Context(), alertRuleInput(req))
+	if err != nil {
+		h.writeAlertError(w, err, "Ошибка создания правила оповещения")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapAlertRule(rule))
+}
+
+// GetAlertRule — GET /api/v1/alert-rules/{id}.
+// Доступ: разрешение settings:read или SA admin:read.
+func (h *APIHandler) GetAlertRule(w http.ResponseWriter, r *http.Request, id generated.AlertRuleId) {
+	if !h.requireAdminRead(w, r) {
+		return
+	}
+
+	rule, err := h.alerts.Get(r.Context(), id.String())
+	if err != nil {
+		h.writeAlertError(w, err, "Ошибка получения правила оповещения")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapAlertRule(rule))
+}
+
+// UpdateAlertRule — PUT /api/v1/alert-rules/{id}.
+// Полностью заменяет параметры правила.
+// Доступ: разрешение settings:write или SA admin:write.
+func (h *APIHandler) UpdateAlertRule(w http.ResponseWriter, r *http.Request, id generated.AlertRuleId) {
+	if !h.requireAdminWrite(w, r) {
+		return
+	}
+
+	var req generated.AlertRuleInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	rule, err := h.alerts.Update(r.Context(), id.String(), alertRuleInput(req))
+	if err != nil {
+		h.writeAlertError(w, err, "Ошибка обновления правила оповещения")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapAlertRule(rule))
+}
+
+// DeleteAlertRule — DELETE /api/v1/alert-rules/{id}.
+// Доступ: разрешение settings:write или SA admin:write.
+func (h *APIHandler) DeleteAlertRule(w http.ResponseWriter, r *http.Request, id generated.AlertRuleId) {
+	if !h.requireAdminWrite(w, r) {
+		return
+	}
+
+	if err := h.alerts.Delete(r.Context(), id.String()); err != nil {
+		h.writeAlertError(w, err, "Ошибка удаления правила оповещения")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWebhooks — GET /api/v1/webhooks.
+// Доступ: разрешение settings:read или SA admin:read.
+func (h *APIHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	if !h.requireAdminRead(w, r) {
+		return
+	}
+
+	eps, err := h.webhooks.ListEndpoints(r.Context())
+	if err != nil {
+		h.logger.Error("Ошибка получения списка webhooks", "error", err)
+		apierrors.InternalError(w, "Ошибка получения списка webhooks")
+		return
+	}
+
+	items := make([]generated.WebhookEndpoint, len(eps))
+	for i, ep := range eps {
+		items[i] = mapWebhookEndpoint(ep, false)
+	}
+	writeJSON(w, http.StatusOK, generated.WebhookEndpointListResponse{Items: items})
+}
+
+// CreateWebhook — POST /api/v1/webhooks.
+// Ключ подписи возвращается только в этом ответе.
+// Доступ: разрешение settings:write или SA admin:write.
+func (h *APIHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	if !h.requireAdminWrite(w, r) {
+		return
+	}
+
+	var req generated.WebhookEndpointCreate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	var secret string
+	if req.Secret != nil {
+		secret = *req.Secret
+	}
+	enabled := req.Enabled == nil || *req.Enabled
+
+	ep, err := h.webhooks.CreateEndpoint(r.Context(), req.Name, req.Url, secret, enabled)
+	if err != nil {
+		h.writeAlertError(w, err, "Ошибка создания webhook")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapWebhookEndpoint(ep, true))
+}
+
+// GetWebhook — GET /api/v1/webhooks/{id}.
+// Доступ: разрешение settings:read или SA admin:read.
+func (h *APIHandler) GetWebhook(w http.ResponseWriter, r *http.Request, id generated.WebhookId) {
+	if !h.requireAdminRead(w, r) {
+		return
+	}
+
+	ep, err := h.webhooks.GetEndpoint(r.Context(), id.String())
+	if err != nil {
+		h.writeAlertError(w, err, "Ошибка получения webhook")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapWebhookEndpoint(ep, false))
+}
+
+// UpdateWebhook — PUT /api/v1/webhooks/{id}.
+// Частичное обновление: незаданные поля не меняются.
+// Доступ: разрешение settings:write или SA admin:write.
+func (h *APIHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request, id generated.WebhookId) {
+	if !h.requireAdminWrite(w, r) {
+		return
+	}
+
+	var req generated.WebhookEndpointUpdate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	ep, err := h.webhooks.UpdateEndpoint(r.Context(), id.String(), req.Name, req.Url, req.Secret, req.Enabled)
+	if err != nil {
+		h.writeAlertError(w, err, "Ошибка обновления webhook")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapWebhookEndpoint(ep, false))
+}
+
+// DeleteWebhook — DELETE /api/v1/webhooks/{id}.
+// Доступ: разрешение settings:write или SA admin:write.
+func (h *APIHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, id generated.WebhookId) {
+	if !h.requireAdminWrite(w, r) {
+		return
+	}
+
+	if err := h.webhooks.DeleteEndpoint(r.Context(), id.String()); err != nil {
+		h.writeAlertError(w, err, "Ошибка удаления webhook")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// TestWebhook — POST /api/v1/webhooks/{id}/test.
+// Ставит в очередь тестовую доставку.
+// Доступ: разрешение settings:write или SA admin:write.
+func (h *APIHandler) TestWebhook(w http.ResponseWriter, r *http.Request, id generated.WebhookId) {
+	if !h.requireAdminWrite(w, r) {
+		return
+	}
+
+	d, err := h.webhooks.TestEndpoint(r.Context(), id.String())
+	if err != nil {
+		h.writeAlertError(w, err, "Ошибка тестовой доставки webhook")
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, mapWebhookDelivery(d))
+}
+
+// ListWebhookDeliveries — GET /api/v1/webhooks/{id}/deliveries.
+// Доступ: разрешение settings:read или SA admin:read.
+func (h *APIHandler) ListWebhookDeliveries(
+	w http.ResponseWriter,
+	r *http.Request,
+	id generated.WebhookId,
+	params generated.ListWebhookDeliveriesParams,
+) {
+	if !h.requireAdminRead(w, r) {
+		return
+	}
+
+	if _, err := h.webhooks.GetEndpoint(r.Context(), id.String()); err != nil {
+		h.writeAlertError(w, err, "Ошибка получения webhook")
+		return
+	}
+
+	limit, offset := paginationDefaults(params.Limit, params.Offset)
+
+	endpointID := id.String()
+	filters := repository.WebhookDeliveryFilters{EndpointID: &endpointID}
+	if params.Status != nil {
+		s := string(*params.Status)
+		filters.Status = &s
+	}
+
+	deliveries, total, err := h.webhooks.ListDeliveries(r.Context(), filters, limit, offset)
+	if err != nil {
+		h.logger.Error("Ошибка получения журнала доставок", "webhook_id", id, "error", err)
+		apierrors.InternalError(w, "Ошибка получения журнала доставок")
+		return
+	}
+
+	items := make([]generated.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		items[i] = mapWebhookDelivery(d)
+	}
+
+	writeJSON(w, http.StatusOK, generated.WebhookDeliveryListResponse{
+		Items:   items,
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: offset+limit < total,
+	})
+}
+
+// writeAlertError записывает ошибку сервиса оповещений или webhooks.
+func (h *APIHandler) writeAlertError(w http.ResponseWriter, err error, msg string) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		apierrors.NotFound(w, "Объект не найден")
+	case errors.Is(err, service.ErrValidation):
+		apierrors.ValidationError(w, err.Error())
+	case errors.Is(err, service.ErrConflict):
+		apierrors.Conflict(w, err.Error())
+	default:
+		h.logger.Error(msg, "error", err)
+		apierrors.InternalError(w, msg)
+	}
+}
+
+// requireAdminRead проверяет доступ на чтение оповещений: разрешение settings:read или SA admin:read.
+// При отказе записывает ошибку в ответ и возвращает false.
+func (h *APIHandler) requireAdminRead(w http.ResponseWriter, r *http.Request) bool {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil {
+		apierrors.Unauthorized(w, "Отсутствуют claims")
+		return false
+	}
+
+	switch claims.SubjectType {
+	case middleware.SubjectTypeUser:
+		if !claims.HasPermission(rbac.PermSettingsRead) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение settings:read")
+			return false
+		}
+	case middleware.SubjectTypeSA:
+		if !claims.HasScope("admin:read") {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется scope admin:read")
+			return false
+		}
+	default:
+		apierrors.Forbidden(w, "Неизвестный тип субъекта")
+		return false
+	}
+	return true
+}
+
+// requireAdminWrite проверяет доступ на управление оповещениями: разрешение settings:write или SA admin:write.
+// При отказе записывает ошибку в ответ и возвращает false.
+func (h *APIHandler) requireAdminWrite(w http.ResponseWriter, r *http.Request) bool {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil {
+		apierrors.Unauthorized(w, "Отсутствуют claims")
+		return false
+	}
+
+	switch claims.SubjectType {
+	case middleware.SubjectTypeUser:
+		if !claims.HasPermission(rbac.PermSettingsWrite) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение settings:write")
+			return false
+		}
+	case middleware.SubjectTypeSA:
+		if !claims.HasScope("admin:write") {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется scope admin:write")
+			return false
+		}
+	default:
+		apierrors.Forbidden(w, "Неизвестный тип субъекта")
+		return false
+	}
+	return true
+}
+
+// --- Маппинг ---
+
+// alertRuleInput конвертирует тело запроса в параметры сервиса.
+func alertRuleInput(req generated.AlertRuleInput) service.AlertRuleInput {
+	in := service.AlertRuleInput{
+		Name:      req.Name,
+		Type:      string(req.Type),
+		Threshold: req.Threshold,
+		Enabled:   req.Enabled == nil || *req.Enabled,
+	}
+	if req.StorageElementId != nil {
+		seID := req.StorageElementId.String()
+		in.StorageElementID = &seID
+	}
+	if req.EndpointIds != nil {
+		for _, id := range *req.EndpointIds {
+			in.EndpointIDs = append(in.EndpointIDs, id.String())
+		}
+	}
+	return in
+}
+
+// mapAlert конвертирует сработавшее оповещение в generated тип.
+func mapAlert(a *model.Alert) generated.Alert {
+	return generated.Alert{
+		RuleId:      uuid.MustParse(a.RuleID),
+		RuleName:    a.RuleName,
+		RuleType:    generated.AlertRuleType(a.RuleType),
+		Subject:     a.Subject,
+		SubjectName: a.SubjectName,
+		Message:     a.Message,
+		FiringSince: a.FiringSince,
+	}
+}
+
+// mapAlertRule конвертирует правило оповещения в generated тип.
+func mapAlertRule(rule *model.AlertRule) generated.AlertRule {
+	result := generated.AlertRule{
+		Id:          uuid.MustParse(rule.ID),
+		Name:        rule.Name,
+		Type:        generated.AlertRuleType(rule.Type),
+		Threshold:   rule.Threshold,
+		Enabled:     rule.Enabled,
+		EndpointIds: make([]uuid.UUID, len(rule.EndpointIDs)),
+		CreatedAt:   rule.CreatedAt,
+		UpdatedAt:   rule.UpdatedAt,
+	}
+	if rule.StorageElementID != nil {
+		seID := uuid.MustParse(*rule.StorageElementID)
+		result.StorageElementId = &seID
+	}
+	for i, id := range rule.EndpointIDs {
+		result.EndpointIds[i] = uuid.MustParse(id)
+	}
+	return result
+}
+
+// mapWebhookEndpoint конвертирует webhook endpoint в generated тип.
+// withSecret — включить ключ подписи (только ответ на создание).
+func mapWebhookEndpoint(ep *model.WebhookEndpoint, withSecret bool) generated.WebhookEndpoint {
+	result := generated.WebhookEndpoint{
+		Id:        uuid.MustParse(ep.ID),
+		Name:      ep.Name,
+		Url:       ep.URL,
+		Enabled:   ep.Enabled,
+		CreatedAt: ep.CreatedAt,
+		UpdatedAt: ep.UpdatedAt,
+	}
+	if withSecret {
+		secret := ep.Secret
+		result.Secret = &secret
+	}
+	return result
+}
+
+// mapWebhookDelivery конвертирует доставку webhook в generated тип.
+func mapWebhookDelivery(d *model.WebhookDelivery) generated.WebhookDelivery {
+	result := generated.WebhookDelivery{
+		Id:             uuid.MustParse(d.ID),
+		EndpointId:     uuid.MustParse(d.EndpointID),
+		Event:          generated.WebhookDeliveryEvent(d.Event),
+		Status:         generated.WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+	if d.RuleID != nil {
+		ruleID := uuid.MustParse(*d.RuleID)
+		result.RuleId = &ruleID
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(d.Payload, &payload); err == nil {
+		result.Payload = &payload
+	}
+	return result
+}
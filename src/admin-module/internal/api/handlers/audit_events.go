@@ -0,0 +1,113 @@
+// audit_events.go — обработчик GET /api/v1/audit-events.
+// Просмотр журнала аудита с фильтрацией и пагинацией.
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// ListAuditEvents — GET /api/v1/audit-events.
+// Возвращает события журнала аудита (новые первыми).
+// Доступ: разрешение audit:read или SA с scope admin:read.
+func (h *APIHandler) ListAuditEvents(w http.ResponseWriter, r *http.Request, params generated.ListAuditEventsParams) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil {
+		apierrors.Unauthorized(w, "Отсутствуют claims")
+		return
+	}
+
+	switch claims.SubjectType {
+	case middleware.SubjectTypeUser:
+		if !claims.HasPermission(rbac.PermAuditRead) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение audit:read")
+			return
+		}
+	case middleware.SubjectTypeSA:
+		if !claims.HasScope("admin:read") {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется scope admin:read")
+			return
+		}
+	default:
+		apierrors.Forbidden(w, "Неизвестный тип субъекта")
+		return
+	}
+
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		apierrors.ValidationError(w, "Параметр from должен быть раньше to")
+		return
+	}
+
+	limit, offset := paginationDefaults(params.Limit, params.Offset)
+
+	filters := repository.AuditEventFilters{
+		Actor:    params.Actor,
+		Action:   params.Action,
+		TargetID: params.TargetId,
+		From:     params.From,
+		To:       params.To,
+	}
+	if params.TargetType != nil {
+		s := string(*params.TargetType)
+		filters.TargetType = &s
+	}
+
+	events, total, err := h.audit.List(r.Context(), filters, limit, offset)
+	if err != nil {
+		h.logger.Error("Ошибка получения журнала аудита", "error", err)
+		apierrors.InternalError(w, "Ошибка получения журнала аудита")
+		return
+	}
+
+	items := make([]generated.AuditEvent, len(events))
+	for i, e := range events {
+		items[i] = mapAuditEvent(e)
+	}
+
+	resp := generated.AuditEventListResponse{
+		Items:   items,
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: offset+limit < total,
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// mapAuditEvent конвертирует domain-модель в generated тип.
+func mapAuditEvent(e *model.AuditEvent) generated.AuditEvent {
+	return generated.AuditEvent{
+		Id:         e.ID,
+		OccurredAt: e.OccurredAt,
+		ActorId:    e.ActorID,
+		ActorType:  generated.AuditEventActorType(e.ActorType),
+		ActorName:  e.ActorName,
+		Action:     e.Action,
+		TargetType: generated.AuditEventTargetType(e.TargetType),
+		TargetId:   e.TargetID,
+		Before:     auditState(e.Before),
+		After:      auditState(e.After),
+		SourceIp:   e.SourceIP,
+		RequestId:  e.RequestID,
+	}
+}
+
+// auditState декодирует JSONB-состояние объекта; nil для отсутствующего.
+func auditState(data json.RawMessage) *map[string]interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil
+	}
+	return &state
+}
@@ -0,0 +1,267 @@
+// collections.go — обработчики /api/v1/collections endpoints.
+// Коллекции (namespaces) файлов: CRUD, участники и квоты.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+)
+
+// ListCollections — GET /api/v1/collections.
+// Доступ: разрешение collections:read.
+func (h *APIHandler) ListCollections(w http.ResponseWriter, r *http.Request) {
+	if _, ok := requireUserPermission(w, r, rbac.PermCollectionsRead); !ok {
+		return
+	}
+
+	collections, err := h.collections.List(r.Context(), nil)
+	if err != nil {
+		h.writeCollectionError(w, err, "Ошибка получения списка коллекций")
+		return
+	}
+
+	items := make([]generated.Collection, 0, len(collections))
+	for _, c := range collections {
+		items = append(items, mapCollection(c))
+	}
+	writeJSON(w, http.StatusOK, generated.CollectionListResponse{Items: items})
+}
+
+// CreateCollection — POST /api/v1/collections.
+// Доступ: разрешение collections:write.
+func (h *APIHandler) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireUserPermission(w, r, rbac.PermCollectionsWrite)
+	if !ok {
+		return
+	}
+
+	var req generated.CollectionInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	c, err := h.collections.Create(r.Context(), claims, mapCollectionInput(req))
+	if err != nil {
+		h.writeCollectionError(w, err, "Ошибка создания коллекции")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapCollection(c))
+}
+
+// GetCollection — GET /api/v1/collections/{id}.
+// Доступ: разрешение collections:read.
+func (h *APIHandler) GetCollection(w http.ResponseWriter, r *http.Request, id generated.CollectionId) {
+	if _, ok := requireUserPermission(w, r, rbac.PermCollectionsRead); !ok {
+		return
+	}
+
+	c, err := h.collections.Get(r.Context(), id.String())
+	if err != nil {
+		h.writeCollectionError(w, err, "Ошибка получения коллекции")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapCollection(c))
+}
+
+// UpdateCollection — PUT /api/v1/collections/{id}.
+// Доступ: разрешение collections:write.
+func (h *APIHandler) UpdateCollection(w http.ResponseWriter, r *http.Request, id generated.CollectionId) {
+	claims, ok := requireUserPermission(w, r, rbac.PermCollectionsWrite)
+	if !ok {
+		return
+	}
+
+	var req generated.CollectionInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	c, err := h.collections.Update(r.Context(), claims, id.String(), mapCollectionInput(req))
+	if err != nil {
+		h.writeCollectionError(w, err, "Ошибка изменения коллекции")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapCollection(c))
+}
+
+// DeleteCollection — DELETE /api/v1/collections/{id}.
+// Доступ: разрешение collections:write.
+func (h *APIHandler) DeleteCollection(w http.ResponseWriter, r *http.Request, id generated.CollectionId) {
+	claims, ok := requireUserPermission(w, r, rbac.PermCollectionsWrite)
+	if !ok {
+		return
+	}
+
+	if err := h.collections.Delete(r.Context(), claims, id.String()); err != nil {
+		h.writeCollectionError(w, err, "Ошибка удаления коллекции")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListCollectionMembers — GET /api/v1/collections/{id}/members.
+// Доступ: разрешение collections:read.
+func (h *APIHandler) ListCollectionMembers(w http.ResponseWriter, r *http.Request, id generated.CollectionId) {
+	if _, ok := requireUserPermission(w, r, rbac.PermCollectionsRead); !ok {
+		return
+	}
+
+	members, err := h.collections.Members(r.Context(), id.String())
+	if err != nil {
+		h.writeCollectionError(w, err, "Ошибка получения участников коллекции")
+		return
+	}
+
+	items := make([]generated.CollectionMember, 0, len(members))
+	for _, m := range members {
+		items = append(items, mapCollectionMember(m))
+	}
+	writeJSON(w, http.StatusOK, generated.CollectionMemberListResponse{Items: items})
+}
+
+// SetCollectionMember — PUT /api/v1/collections/{id}/members.
+// Доступ: разрешение collections:write.
+func (h *APIHandler) SetCollectionMember(w http.ResponseWriter, r *http.Request, id generated.CollectionId) {
+	claims, ok := requireUserPermission(w, r, rbac.PermCollectionsWrite)
+	if !ok {
+		return
+	}
+
+	var req generated.CollectionMemberInput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	perms := make([]string, len(req.Permissions))
+	for i, p := range req.Permissions {
+		perms[i] = string(p)
+	}
+	m, err := h.collections.SetMember(r.Context(), claims, id.String(), service.CollectionMemberInput{
+		SubjectType: string(req.SubjectType),
+		SubjectID:   req.SubjectId,
+		Permissions: perms,
+	})
+	if err != nil {
+		h.writeCollectionError(w, err, "Ошибка сохранения участника коллекции")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapCollectionMember(m))
+}
+
+// RemoveCollectionMember — DELETE /api/v1/collections/{id}/members/{subject_type}/{subject_id}.
+// Доступ: разрешение collections:write.
+func (h *APIHandler) RemoveCollectionMember(
+	w http.ResponseWriter, r *http.Request, id generated.CollectionId,
+	subjectType generated.RemoveCollectionMemberParamsSubjectType, subjectId string, //nolint:revive // имя из сгенерированного интерфейса oapi-codegen
+) {
+	claims, ok := requireUserPermission(w, r, rbac.PermCollectionsWrite)
+	if !ok {
+		return
+	}
+
+	if err := h.collections.RemoveMember(r.Context(), claims, id.String(), string(subjectType), subjectId); err != nil {
+		h.writeCollectionError(w, err, "Ошибка удаления участника коллекции")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeCollectionError отвечает ошибкой операции с коллекцией.
+func (h *APIHandler) writeCollectionError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrValidation):
+		apierrors.ValidationError(w, err.Error())
+	case errors.Is(err, service.ErrConflict):
+		apierrors.Conflict(w, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		apierrors.NotFound(w, "Коллекция или участник не найдены")
+	default:
+		h.logger.Error(message, "error", err)
+		apierrors.InternalError(w, message)
+	}
+}
+
+// writeCollectionAccessError отвечает ошибкой проверки доступа к коллекции
+// файла (CollectionService.CheckAccess, CheckUpload).
+func (h *APIHandler) writeCollectionAccessError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrValidation):
+		apierrors.ValidationError(w, err.Error())
+	case errors.Is(err, service.ErrForbidden):
+		apierrors.Forbidden(w, "Недостаточно прав: "+err.Error())
+	case errors.Is(err, service.ErrQuotaExceeded):
+		apierrors.QuotaExceeded(w, err.Error())
+	default:
+		h.logger.Error("Ошибка проверки доступа к коллекции", "error", err)
+		apierrors.InternalError(w, "Ошибка проверки доступа к коллекции")
+	}
+}
+
+// sameCollection сообщает, совпадают ли коллекции файла (nil — вне коллекций).
+func sameCollection(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return strings.EqualFold(*a, *b)
+}
+
+// mapCollectionInput — маппинг параметров коллекции из API.
+func mapCollectionInput(req generated.CollectionInput) service.CollectionInput {
+	return service.CollectionInput{
+		Name:        req.Name,
+		Description: derefString(req.Description),
+		QuotaBytes:  req.QuotaBytes,
+		QuotaFiles:  req.QuotaFiles,
+	}
+}
+
+// mapCollection — маппинг коллекции в API.
+func mapCollection(c *model.Collection) generated.Collection {
+	return generated.Collection{
+		Id:          uuid.MustParse(c.ID),
+		Name:        c.Name,
+		Description: c.Description,
+		QuotaBytes:  c.QuotaBytes,
+		QuotaFiles:  c.QuotaFiles,
+		UsedBytes:   c.UsedBytes,
+		UsedFiles:   c.UsedFiles,
+		MemberCount: c.MemberCount,
+		CreatedBy:   c.CreatedBy,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// mapCollectionMember — маппинг участника коллекции в API.
+func mapCollectionMember(m *model.CollectionMember) generated.CollectionMember {
+	perms := make([]generated.CollectionMemberPermissions, len(m.Permissions))
+	for i, p := range m.Permissions {
+		perms[i] = generated.CollectionMemberPermissions(p)
+	}
+	return generated.CollectionMember{
+		SubjectType: generated.CollectionMemberSubjectType(m.SubjectType),
+		SubjectId:   m.SubjectID,
+		Permissions: perms,
+		AddedBy:     m.AddedBy,
+		CreatedAt:   m.CreatedAt,
+	}
+}
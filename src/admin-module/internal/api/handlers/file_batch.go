@@ -0,0 +1,267 @@
+// file_batch.go — обработчики /api/v1/files/batch.
+// Групповые операции над файлами: удаление, теги, статус.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+)
+
+// CreateFileBatch — POST /api/v1/files/batch.
+// Ставит групповую операцию в очередь и возвращает её (202).
+// Доступ: разрешение files:write (удаление — также files:delete) или SA files:write.
+func (h *APIHandler) CreateFileBatch(w http.ResponseWriter, r *http.Request) {
+	if !h.requireFilesWrite(w, r) {
+		return
+	}
+	claims := middleware.ClaimsFromContext(r.Context())
+
+	var body generated.FileBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	// Групповое удаление пользователем дополнительно требует files:delete
+	if claims.SubjectType == middleware.SubjectTypeUser &&
+		body.Action == generated.FileBatchRequestActionDelete &&
+		!claims.HasPermission(rbac.PermFilesDelete) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение files:delete")
+		return
+	}
+
+	req := &service.FileBatchRequest{Action: string(body.Action)}
+	if body.Tags != nil {
+		req.Tags = *body.Tags
+	}
+	if body.Status != nil {
+		status := string(*body.Status)
+		req.Status = &status
+	}
+	if body.FileIds != nil {
+		req.FileIDs = make([]string, len(*body.FileIds))
+		for i, id := range *body.FileIds {
+			req.FileIDs[i] = id.String()
+		}
+	}
+	if body.Filter != nil {
+		filters := mapFileBatchFilter(body.Filter)
+		if err := validateFileListFilters(filters); err != nil {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
+		req.Filter = &filters
+	}
+	if claims.SubjectType == middleware.SubjectTypeSA {
+		req.Grants = claims.Grants.For(grants.ScopeFilesWrite)
+		req.GrantSubjects = []string{claims.Subject, claims.ClientID}
+	}
+	collectionPerm := model.CollectionPermWrite
+	if body.Action == generated.FileBatchRequestActionDelete {
+		collectionPerm = model.CollectionPermDelete
+	}
+	req.Collections = claims.CollectionScope(collectionPerm)
+
+	op, err := h.fileBatch.StartOperation(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, service.ErrValidation) {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
+		h.logger.Error("Ошибка запуска групповой операции", "error", err)
+		apierrors.InternalError(w, "Ошибка запуска групповой операции")
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, mapFileBatchOperation(op))
+}
+
+// GetFileBatch — GET /api/v1/files/batch/{id}.
+// Возвращает состояние групповой операции.
+// Доступ: разрешение files:read или SA files:write.
+func (h *APIHandler) GetFileBatch(w http.ResponseWriter, r *http.Request, id generated.FileBatchId) {
+	if !h.requireFileBatchRead(w, r) {
+		return
+	}
+
+	op, err := h.fileBatch.Get(r.Context(), id.String())
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Групповая операция не найдена")
+			return
+		}
+		h.logger.Error("Ошибка получения групповой операции", "id", id, "error", err)
+		apierrors.InternalError(w, "Ошибка получения групповой операции")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapFileBatchOperation(op))
+}
+
+// ListFileBatchResults — GET /api/v1/files/batch/{id}/results.
+// Возвращает результаты операции по файлам.
+// Доступ: разрешение files:read или SA files:write.
+func (h *APIHandler) ListFileBatchResults(w http.ResponseWriter, r *http.Request, id generated.FileBatchId, params generated.ListFileBatchResultsParams) {
+	if !h.requireFileBatchRead(w, r) {
+		return
+	}
+
+	limit, offset := paginationDefaults(params.Limit, params.Offset)
+	var status *string
+	if params.Status != nil {
+		s := string(*params.Status)
+		status = &s
+	}
+
+	results, total, err := h.fileBatch.ListResults(r.Context(), id.String(), status, limit, offset)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Групповая операция не найдена")
+			return
+		}
+		h.logger.Error("Ошибка получения результатов групповой операции", "id", id, "error", err)
+		apierrors.InternalError(w, "Ошибка получения результатов групповой операции")
+		return
+	}
+
+	items := make([]generated.FileBatchResult, len(results))
+	for i, res := range results {
+		items[i] = generated.FileBatchResult{
+			FileId:      uuid.MustParse(res.FileID),
+			Status:      generated.FileBatchResultStatus(res.Status),
+			Error:       res.Error,
+			ProcessedAt: res.ProcessedAt,
+		}
+	}
+
+	writeJSON(w, http.StatusOK, generated.FileBatchResultListResponse{
+		Items:   items,
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: offset+limit < total,
+	})
+}
+
+// CancelFileBatch — POST /api/v1/files/batch/{id}/cancel.
+// Отменяет выполняющуюся групповую операцию.
+// Доступ: разрешение files:write или SA files:write.
+func (h *APIHandler) CancelFileBatch(w http.ResponseWriter, r *http.Request, id generated.FileBatchId) {
+	if !h.requireFilesWrite(w, r) {
+		return
+	}
+
+	op, err := h.fileBatch.Cancel(r.Context(), id.String())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			apierrors.NotFound(w, "Групповая операция не найдена")
+		case errors.Is(err, service.ErrConflict):
+			apierrors.Conflict(w, "Групповая операция уже завершена")
+		default:
+			h.logger.Error("Ошибка отмены групповой операции", "id", id, "error", err)
+			apierrors.InternalError(w, "Ошибка отмены групповой операции")
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapFileBatchOperation(op))
+}
+
+// requireFileBatchRead проверяет доступ к состоянию групповых операций:
+// разрешение files:read или SA files:write.
+// При отказе записывает ошибку в ответ и возвращает false.
+func (h *APIHandler) requireFileBatchRead(w http.ResponseWriter, r *http.Request) bool {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil {
+		apierrors.Unauthorized(w, "Отсутствуют claims")
+		return false
+	}
+
+	switch claims.SubjectType {
+	case middleware.SubjectTypeUser:
+		if !claims.HasPermission(rbac.PermFilesRead) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение files:read")
+			return false
+		}
+	case middleware.SubjectTypeSA:
+		if !claims.HasScope("files:write") {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется scope files:write")
+			return false
+		}
+	default:
+		apierrors.Forbidden(w, "Неизвестный тип субъекта")
+		return false
+	}
+	return true
+}
+
+// mapFileBatchFilter конвертирует фильтр выбора файлов в фильтр репозитория.
+func mapFileBatchFilter(f *generated.FileBatchFilter) repository.FileListFilters {
+	var filters repository.FileListFilters
+	if f.Status != nil {
+		s := string(*f.Status)
+		filters.Status = &s
+	}
+	if f.RetentionPolicy != nil {
+		rp := string(*f.RetentionPolicy)
+		filters.RetentionPolicy = &rp
+	}
+	if f.StorageElementId != nil {
+		seID := f.StorageElementId.String()
+		filters.StorageElementID = &seID
+	}
+	if f.CollectionId != nil {
+		collectionID := f.CollectionId.String()
+		filters.CollectionID = &collectionID
+	}
+	filters.UploadedBy = f.UploadedBy
+	filters.Filename = f.Filename
+	filters.ContentType = f.ContentType
+	if f.Tags != nil {
+		filters.Tags = *f.Tags
+	}
+	filters.MinSize = f.MinSize
+	filters.MaxSize = f.MaxSize
+	filters.UploadedAfter = f.UploadedAfter
+	filters.UploadedBefore = f.UploadedBefore
+	return filters
+}
+
+// mapFileBatchOperation конвертирует групповую операцию в generated API type.
+func mapFileBatchOperation(op *model.FileBatchOperation) generated.FileBatchOperation {
+	result := generated.FileBatchOperation{
+		Id:           uuid.MustParse(op.ID),
+		Action:       generated.FileBatchOperationAction(op.Action),
+		TargetStatus: op.TargetStatus,
+		Status:       generated.FileBatchOperationStatus(op.Status),
+		RequestedBy:  op.RequestedBy,
+		Total:        op.Total,
+		Processed:    op.Processed,
+		Succeeded:    op.Succeeded,
+		Skipped:      op.Skipped,
+		Failed:       op.Failed,
+		Error:        op.Error,
+		CreatedAt:    op.CreatedAt,
+		StartedAt:    op.StartedAt,
+		CompletedAt:  op.CompletedAt,
+		DurationMs:   op.DurationMs,
+	}
+	if len(op.Tags) > 0 {
+		tags := op.Tags
+		result.Tags = &tags
+	}
+	return result
+}
@@ -5,6 +5,7 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -13,7 +14,9 @@ import (
 	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
 	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
 	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
 )
@@ -30,7 +33,7 @@ func (h *APIHandler) RegisterFile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Доступ: SA с files:write или admin user
+	// Доступ: SA с files:write или пользователь с разрешением files:write
 	switch claims.SubjectType {
 	case middleware.SubjectTypeSA:
 		if !claims.HasScope("files:write") {
@@ -38,8 +41,8 @@ func (h *APIHandler) RegisterFile(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	case middleware.SubjectTypeUser:
-		if !claims.HasRole("admin") {
-			apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+		if !claims.HasPermission(rbac.PermFilesWrite) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение files:write")
 			return
 		}
 	default:
@@ -83,6 +86,48 @@ func (h *APIHandler) RegisterFile(w http.ResponseWriter, r *http.Request) {
 		uploadedBy = claims.ClientID
 	}
 
+	if !claims.AllowsGrant(grants.ScopeFilesWrite, grants.Access{
+		SE:         []string{req.StorageElementId.String()},
+		Retention:  string(req.RetentionPolicy),
+		UploadedBy: uploadedBy,
+	}) {
+		apierrors.Forbidden(w, "Недостаточно прав: SE или политика хранения вне grants SA")
+		return
+	}
+
+	// Коллекция файла берётся синхронизацией из attr.json, поэтому
+	// регистрация проверяет коллекцию и размер файла на SE
+	stored, err := h.storageElems.GetFile(r.Context(), req.StorageElementId.String(), req.FileId.String())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			apierrors.ValidationError(w, fmt.Sprintf("Storage Element '%s' не найден", req.StorageElementId))
+		case errors.Is(err, service.ErrSEUnavailable):
+			apierrors.SEUnavailable(w, "Не удалось получить файл на SE: "+err.Error())
+		default:
+			h.logger.Error("Ошибка получения файла на SE", "file_id", req.FileId, "error", err)
+			apierrors.InternalError(w, "Ошибка получения файла на SE")
+		}
+		return
+	}
+
+	// Файл коллекции: право write участника и квоты коллекции
+	var collectionID *string
+	if req.CollectionId != nil {
+		id := req.CollectionId.String()
+		collectionID = &id
+	}
+	if !sameCollection(collectionID, stored.CollectionID) {
+		apierrors.ValidationError(w, "collection_id не совпадает с коллекцией файла на SE")
+		return
+	}
+	if collectionID != nil {
+		if err := h.collections.CheckUpload(r.Context(), claims, *collectionID, stored.Size); err != nil {
+			h.writeCollectionAccessError(w, err)
+			return
+		}
+	}
+
 	// Маппинг в domain model
 	f := &model.FileRecord{
 		FileID:           req.FileId.String(),
@@ -96,6 +141,7 @@ func (h *APIHandler) RegisterFile(w http.ResponseWriter, r *http.Request) {
 		Status:           "active",
 		RetentionPolicy:  string(req.RetentionPolicy),
 		TTLDays:          req.TtlDays,
+		CollectionID:     collectionID,
 	}
 
 	if req.Description != nil {
@@ -124,7 +170,7 @@ func (h *APIHandler) RegisterFile(w http.ResponseWriter, r *http.Request) {
 
 // ListFiles — GET /api/v1/files.
 // Возвращает список файлов с фильтрацией и пагинацией.
-// Доступ: admin, readonly или SA с scope files:read.
+// Доступ: разрешение files:read или SA с scope files:read.
 func (h *APIHandler) ListFiles(w http.ResponseWriter, r *http.Request, params generated.ListFilesParams) {
 	claims := middleware.ClaimsFromContext(r.Context())
 	if claims == nil {
@@ -134,8 +180,8 @@ func (h *APIHandler) ListFiles(w http.ResponseWriter, r *http.Request, params ge
 
 	switch claims.SubjectType {
 	case middleware.SubjectTypeUser:
-		if !claims.HasAnyRole("admin", "readonly") {
-			apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin или readonly")
+		if !claims.HasPermission(rbac.PermFilesRead) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение files:read")
 			return
 		}
 	case middleware.SubjectTypeSA:
@@ -164,9 +210,31 @@ func (h *APIHandler) ListFiles(w http.ResponseWriter, r *http.Request, params ge
 		s := params.StorageElementId.String()
 		filters.StorageElementID = &s
 	}
+	if params.CollectionId != nil {
+		s := params.CollectionId.String()
+		filters.CollectionID = &s
+	}
 	if params.UploadedBy != nil {
 		filters.UploadedBy = params.UploadedBy
 	}
+	filters.Filename = params.Filename
+	filters.ContentType = params.ContentType
+	if params.Tag != nil {
+		filters.Tags = *params.Tag
+	}
+	filters.MinSize = params.MinSize
+	filters.MaxSize = params.MaxSize
+	filters.UploadedAfter = params.UploadedAfter
+	filters.UploadedBefore = params.UploadedBefore
+	if err := validateFileListFilters(filters); err != nil {
+		apierrors.ValidationError(w, err.Error())
+		return
+	}
+	if claims.SubjectType == middleware.SubjectTypeSA {
+		filters.Grants = claims.Grants.For(grants.ScopeFilesRead)
+		filters.GrantSubjects = []string{claims.Subject, claims.ClientID}
+	}
+	filters.Collections = claims.CollectionScope(model.CollectionPermRead)
 
 	files, total, err := h.files.List(r.Context(), filters, limit, offset)
 	if err != nil {
@@ -193,7 +261,7 @@ func (h *APIHandler) ListFiles(w http.ResponseWriter, r *http.Request, params ge
 
 // GetFile — GET /api/v1/files/{file_id}.
 // Возвращает метаданные файла.
-// Доступ: admin, readonly или SA с scope files:read.
+// Доступ: разрешение files:read или SA с scope files:read.
 //
 //nolint:dupl // TODO: вынести общую логику проверки прав
 func (h *APIHandler) GetFile(w http.ResponseWriter, r *http.Request, fileId generated.FileId) { //nolint:revive // имя из сгенерированного интерфейса oapi-codegen
@@ -205,8 +273,8 @@ func (h *APIHandler) GetFile(w http.ResponseWriter, r *http.Request, fileId gene
 
 	switch claims.SubjectType {
 	case middleware.SubjectTypeUser:
-		if !claims.HasAnyRole("admin", "readonly") {
-			apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin или readonly")
+		if !claims.HasPermission(rbac.PermFilesRead) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение files:read")
 			return
 		}
 	case middleware.SubjectTypeSA:
@@ -229,13 +297,27 @@ func (h *APIHandler) GetFile(w http.ResponseWriter, r *http.Request, fileId gene
 		apierrors.InternalError(w, "Ошибка получения файла")
 		return
 	}
+	if !claims.AllowsGrant(grants.ScopeFilesRead, fileGrantAccess(f)) {
+		apierrors.Forbidden(w, "Недостаточно прав: файл вне grants SA")
+		return
+	}
+	// Файл чужой коллекции не раскрывается
+	if err := h.collections.CheckAccess(r.Context(), claims, f.CollectionID, model.CollectionPermRead); err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			apierrors.NotFound(w, "Файл не найден")
+			return
+		}
+		h.logger.Error("Ошибка проверки доступа к коллекции", "file_id", fileId, "error", err)
+		apierrors.InternalError(w, "Ошибка получения файла")
+		return
+	}
 
 	writeJSON(w, http.StatusOK, mapFileRecord(f))
 }
 
 // UpdateFile — PUT /api/v1/files/{file_id}.
 // Обновляет метаданные файла (description, tags, status).
-// Доступ: admin или SA с scope files:write.
+// Доступ: разрешение files:write или SA с scope files:write.
 func (h *APIHandler) UpdateFile(w http.ResponseWriter, r *http.Request, fileId generated.FileId) { //nolint:revive // имя из сгенерированного интерфейса oapi-codegen
 	claims := middleware.ClaimsFromContext(r.Context())
 	if claims == nil {
@@ -245,8 +327,8 @@ func (h *APIHandler) UpdateFile(w http.ResponseWriter, r *http.Request, fileId g
 
 	switch claims.SubjectType {
 	case middleware.SubjectTypeUser:
-		if !claims.HasRole("admin") {
-			apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+		if !claims.HasPermission(rbac.PermFilesWrite) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение files:write")
 			return
 		}
 	case middleware.SubjectTypeSA:
@@ -265,6 +347,10 @@ func (h *APIHandler) UpdateFile(w http.ResponseWriter, r *http.Request, fileId g
 		return
 	}
 
+	if !h.checkFileWriteAccess(w, r, claims, fileId.String(), model.CollectionPermWrite) {
+		return
+	}
+
 	var status *string
 	if req.Status != nil {
 		s := string(*req.Status)
@@ -277,6 +363,14 @@ func (h *APIHandler) UpdateFile(w http.ResponseWriter, r *http.Request, fileId g
 			apierrors.NotFound(w, "Файл не найден")
 			return
 		}
+		if errors.Is(err, service.ErrValidation) {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
+		if errors.Is(err, service.ErrConflict) {
+			apierrors.Conflict(w, err.Error())
+			return
+		}
 		h.logger.Error("Ошибка обновления файла", "file_id", fileId, "error", err)
 		apierrors.InternalError(w, "Ошибка обновления файла")
 		return
@@ -287,7 +381,7 @@ func (h *APIHandler) UpdateFile(w http.ResponseWriter, r *http.Request, fileId g
 
 // DeleteFile — DELETE /api/v1/files/{file_id}.
 // Soft delete файла (status → deleted).
-// Доступ: admin или SA с scope files:write.
+// Доступ: разрешение files:delete или SA с scope files:write.
 func (h *APIHandler) DeleteFile(w http.ResponseWriter, r *http.Request, fileId generated.FileId) { //nolint:revive // имя из сгенерированного интерфейса oapi-codegen
 	claims := middleware.ClaimsFromContext(r.Context())
 	if claims == nil {
@@ -297,8 +391,8 @@ func (h *APIHandler) DeleteFile(w http.ResponseWriter, r *http.Request, fileId g
 
 	switch claims.SubjectType {
 	case middleware.SubjectTypeUser:
-		if !claims.HasRole("admin") {
-			apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+		if !claims.HasPermission(rbac.PermFilesDelete) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение files:delete")
 			return
 		}
 	case middleware.SubjectTypeSA:
@@ -311,11 +405,19 @@ func (h *APIHandler) DeleteFile(w http.ResponseWriter, r *http.Request, fileId g
 		return
 	}
 
-	if err := h.files.Delete(r.Context(), fileId.String()); err != nil {
+	if !h.checkFileWriteAccess(w, r, claims, fileId.String(), model.CollectionPermDelete) {
+		return
+	}
+
+	if _, err := h.files.Delete(r.Context(), fileId.String()); err != nil {
 		if errors.Is(err, service.ErrNotFound) {
 			apierrors.NotFound(w, "Файл не найден")
 			return
 		}
+		if errors.Is(err, service.ErrConflict) {
+			apierrors.Conflict(w, err.Error())
+			return
+		}
 		h.logger.Error("Ошибка удаления файла", "file_id", fileId, "error", err)
 		apierrors.InternalError(w, "Ошибка удаления файла")
 		return
@@ -324,6 +426,60 @@ func (h *APIHandler) DeleteFile(w http.ResponseWriter, r *http.Request, fileId g
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// validateFileListFilters проверяет диапазоны фильтров списка файлов.
+func validateFileListFilters(f repository.FileListFilters) error {
+	if (f.MinSize != nil && *f.MinSize < 0) || (f.MaxSize != nil && *f.MaxSize < 0) {
+		return errors.New("min_size и max_size не могут быть отрицательными")
+	}
+	if f.MinSize != nil && f.MaxSize != nil && *f.MinSize > *f.MaxSize {
+		return errors.New("min_size не может быть больше max_size")
+	}
+	if f.UploadedAfter != nil && f.UploadedBefore != nil && f.UploadedAfter.After(*f.UploadedBefore) {
+		return errors.New("uploaded_after не может быть позже uploaded_before")
+	}
+	return nil
+}
+
+// checkFileWriteAccess проверяет grants files:write SA и право collectionPerm
+// в коллекции файла для его изменения. При запрете пишет ответ и возвращает false.
+func (h *APIHandler) checkFileWriteAccess(
+	w http.ResponseWriter, r *http.Request, claims *middleware.AuthClaims, fileID, collectionPerm string,
+) bool {
+	grantRestricted := claims.SubjectType == middleware.SubjectTypeSA && claims.Grants.Restricted(grants.ScopeFilesWrite)
+	if !grantRestricted && claims.CollectionScope(collectionPerm) == nil {
+		return true
+	}
+
+	f, err := h.files.Get(r.Context(), fileID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Файл не найден")
+			return false
+		}
+		h.logger.Error("Ошибка получения файла", "file_id", fileID, "error", err)
+		apierrors.InternalError(w, "Ошибка получения файла")
+		return false
+	}
+	if !claims.AllowsGrant(grants.ScopeFilesWrite, fileGrantAccess(f)) {
+		apierrors.Forbidden(w, "Недостаточно прав: файл вне grants SA")
+		return false
+	}
+	if err := h.collections.CheckAccess(r.Context(), claims, f.CollectionID, collectionPerm); err != nil {
+		h.writeCollectionAccessError(w, err)
+		return false
+	}
+	return true
+}
+
+// fileGrantAccess — параметры доступа к файлу для проверки grants.
+func fileGrantAccess(f *model.FileRecord) grants.Access {
+	return grants.Access{
+		SE:         []string{f.StorageElementID},
+		Retention:  f.RetentionPolicy,
+		UploadedBy: f.UploadedBy,
+	}
+}
+
 // --- Маппинг domain → API ---
 
 // mapFileRecord конвертирует domain model в generated API type.
@@ -344,6 +500,10 @@ func mapFileRecord(f *model.FileRecord) generated.FileRecord {
 	result.Description = f.Description
 	result.TtlDays = f.TTLDays
 	result.ExpiresAt = f.ExpiresAt
+	if f.CollectionID != nil {
+		id := uuid.MustParse(*f.CollectionID)
+		result.CollectionId = &id
+	}
 
 	if !f.CreatedAt.IsZero() {
 		result.CreatedAt = &f.CreatedAt
@@ -357,5 +517,28 @@ func mapFileRecord(f *model.FileRecord) generated.FileRecord {
 		result.Tags = &tags
 	}
 
+	if len(f.Replicas) > 0 {
+		replicas := make([]generated.FileReplica, 0, len(f.Replicas))
+		for _, rep := range f.Replicas {
+			replicas = append(replicas, generated.FileReplica{
+				StorageElementId: uuid.MustParse(rep.StorageElementID),
+				Status:           generated.FileReplicaStatus(rep.Status),
+				ReplicatedAt:     rep.ReplicatedAt,
+				LastError:        rep.LastError,
+			})
+		}
+		result.Replicas = &replicas
+	}
+
+	if pw := f.PendingWrite; pw != nil {
+		result.PendingWrite = &generated.FilePendingWrite{
+			Operation:     generated.FilePendingWriteOperation(pw.Operation),
+			Status:        generated.FilePendingWriteStatus(pw.Status),
+			Attempts:      pw.Attempts,
+			LastError:     pw.LastError,
+			NextAttemptAt: pw.NextAttemptAt,
+		}
+	}
+
 	return result
 }
@@ -13,13 +13,23 @@ import (
 // APIHandler — основной обработчик API Admin Module.
 // Реализует generated.ServerInterface, делегируя запросы в сервисный слой.
 type APIHandler struct {
-	health       *HealthHandler
-	adminUsers   *service.AdminUserService
-	serviceAccts *service.ServiceAccountService
-	storageElems *service.StorageElementService
-	files        *service.FileRegistryService
-	idp          *service.IDPService
-	logger       *slog.Logger
+	health         *HealthHandler
+	adminUsers     *service.AdminUserService
+	serviceAccts   *service.ServiceAccountService
+	saGrants       *service.SAGrantService
+	storageElems   *service.StorageElementService
+	placement      *service.PlacementService
+	files          *service.FileRegistryService
+	fileBatch      *service.FileBatchService
+	idp            *service.IDPService
+	signingKeys    *service.SigningKeyService
+	personalTokens *service.PersonalTokenService
+	roles          *service.RoleService
+	collections    *service.CollectionService
+	audit          *service.AuditService
+	alerts         *service.AlertService
+	webhooks       *service.WebhookService
+	logger         *slog.Logger
 }
 
 // NewAPIHandler создаёт основной обработчик API.
@@ -27,19 +37,39 @@ func NewAPIHandler(
 	health *HealthHandler,
 	adminUsers *service.AdminUserService,
 	serviceAccts *service.ServiceAccountService,
+	saGrants *service.SAGrantService,
 	storageElems *service.StorageElementService,
+	placement *service.PlacementService,
 	files *service.FileRegistryService,
+	fileBatch *service.FileBatchService,
 	idp *service.IDPService,
+	signingKeys *service.SigningKeyService,
+	personalTokens *service.PersonalTokenService,
+	roles *service.RoleService,
+	collections *service.CollectionService,
+	audit *service.AuditService,
+	alerts *service.AlertService,
+	webhooks *service.WebhookService,
 	logger *slog.Logger,
 ) *APIHandler {
 	return &APIHandler{
-		health:       health,
-		adminUsers:   adminUsers,
-		serviceAccts: serviceAccts,
-		storageElems: storageElems,
-		files:        files,
-		idp:          idp,
-		logger:       logger.With(slog.String("component", "api_handler")),
+		health:         health,
+		adminUsers:     adminUsers,
+		serviceAccts:   serviceAccts,
+		saGrants:       saGrants,
+		storageElems:   storageElems,
+		placement:      placement,
+		files:          files,
+		fileBatch:      fileBatch,
+		idp:            idp,
+		signingKeys:    signingKeys,
+		personalTokens: personalTokens,
+		roles:          roles,
+		collections:    collections,
+		audit:          audit,
+		alerts:         alerts,
+		webhooks:       webhooks,
+		logger:         logger.With(slog.String("component", "api_handler")),
 	}
 }
 
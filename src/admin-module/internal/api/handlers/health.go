@@ -1,6 +1,6 @@
 // health.go — обработчики health endpoints Admin Module.
 // /health/live — liveness probe (процесс жив)
-// /health/ready — readiness probe (PostgreSQL + Keycloak доступны)
+// /health/ready — readiness probe (PostgreSQL + IdP доступны)
 // /metrics — Prometheus метрики
 package handlers
 
@@ -12,6 +12,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/bigkaa/goartstore/admin-module/internal/config"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
 )
 
 // ReadinessChecker — интерфейс проверки готовности зависимости.
@@ -23,17 +24,21 @@ type ReadinessChecker interface {
 // HealthHandler — обработчик health endpoints.
 type HealthHandler struct {
 	pgChecker   ReadinessChecker
-	kcChecker   ReadinessChecker
+	idpChecker  ReadinessChecker
+	idpName     string
 	promHandler http.Handler
 }
 
 // NewHealthHandler создаёт обработчик health endpoints.
-// pgChecker — проверка PostgreSQL, kcChecker — проверка Keycloak.
-// Оба могут быть nil (readiness вернёт "fail" для nil зависимостей).
-func NewHealthHandler(pgChecker, kcChecker ReadinessChecker) *HealthHandler {
+// pgChecker — проверка PostgreSQL, idpChecker — проверка IdP,
+// idpName — провайдер (idp.ProviderKeycloak или idp.ProviderLocal),
+// определяющий ключ проверки в ответе (keycloak или local_idp).
+// Проверки могут быть nil (readiness вернёт "fail" для nil зависимостей).
+func NewHealthHandler(pgChecker, idpChecker ReadinessChecker, idpName string) *HealthHandler {
 	return &HealthHandler{
 		pgChecker:   pgChecker,
-		kcChecker:   kcChecker,
+		idpChecker:  idpChecker,
+		idpName:     idpName,
 		promHandler: promhttp.Handler(),
 	}
 }
@@ -59,8 +64,9 @@ type healthReadyResponse struct {
 	Version   string `json:"version"`
 	Service   string `json:"service"`
 	Checks    struct {
-		PostgreSQL healthCheckResult `json:"postgresql"`
-		Keycloak   healthCheckResult `json:"keycloak"`
+		PostgreSQL healthCheckResult  `json:"postgresql"`
+		Keycloak   *healthCheckResult `json:"keycloak,omitempty"`
+		LocalIdP   *healthCheckResult `json:"local_idp,omitempty"`
 	} `json:"checks"`
 }
 
@@ -78,7 +84,7 @@ func (h *HealthHandler) HealthLive(w http.ResponseWriter, _ *http.Request) {
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
-// HealthReady — readiness probe. Проверяет PostgreSQL и Keycloak.
+// HealthReady — readiness probe. Проверяет PostgreSQL и IdP.
 // Возвращает 200 (ok/degraded) или 503 (fail).
 func (h *HealthHandler) HealthReady(w http.ResponseWriter, _ *http.Request) {
 	resp := healthReadyResponse{
@@ -95,16 +101,20 @@ func (h *HealthHandler) HealthReady(w http.ResponseWriter, _ *http.Request) {
 		resp.Checks.PostgreSQL = healthCheckResult{Status: statusFail, Message: "не инициализирован"}
 	}
 
-	// Проверяем Keycloak
-	if h.kcChecker != nil {
-		kcStatus, kcMsg := h.kcChecker.CheckReady()
-		resp.Checks.Keycloak = healthCheckResult{Status: kcStatus, Message: kcMsg}
+	// Проверяем IdP
+	idpResult := &healthCheckResult{Status: statusFail, Message: "не инициализирован"}
+	if h.idpChecker != nil {
+		idpStatus, idpMsg := h.idpChecker.CheckReady()
+		idpResult = &healthCheckResult{Status: idpStatus, Message: idpMsg}
+	}
+	if h.idpName == idp.ProviderLocal {
+		resp.Checks.LocalIdP = idpResult
 	} else {
-		resp.Checks.Keycloak = healthCheckResult{Status: statusFail, Message: "не инициализирован"}
+		resp.Checks.Keycloak = idpResult
 	}
 
 	// Определяем итоговый статус
-	resp.Status = overallStatus(resp.Checks.PostgreSQL.Status, resp.Checks.Keycloak.Status)
+	resp.Status = overallStatus(resp.Checks.PostgreSQL.Status, idpResult.Status)
 
 	w.Header().Set("Content-Type", "application/json")
 	if resp.Status == statusFail {
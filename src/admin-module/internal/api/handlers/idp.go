@@ -10,22 +10,24 @@ import (
 	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
 	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
 	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
 )
 
 // GetIdpStatus — GET /api/v1/idp/status.
-// Статус подключения к Keycloak.
-// Доступ: admin.
+// Статус подключения к IdP (Keycloak или локальному).
+// Доступ: разрешение idp:read.
 func (h *APIHandler) GetIdpStatus(w http.ResponseWriter, r *http.Request) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermIdpRead) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение idp:read")
 		return
 	}
 
 	status := h.idp.GetStatus(r.Context())
 
 	resp := generated.IdpStatus{
+		Provider:     generated.IdpStatusProvider(status.Provider),
 		Connected:    status.Connected,
 		Realm:        status.Realm,
 		UsersCount:   status.UsersCount,
@@ -43,11 +45,11 @@ func (h *APIHandler) GetIdpStatus(w http.ResponseWriter, r *http.Request) {
 
 // SyncServiceAccounts — POST /api/v1/idp/sync-sa.
 // Принудительная синхронизация SA с Keycloak.
-// Доступ: admin.
+// Доступ: разрешение idp:write.
 func (h *APIHandler) SyncServiceAccounts(w http.ResponseWriter, r *http.Request) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermIdpWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение idp:write")
 		return
 	}
 
@@ -0,0 +1,97 @@
+// keys.go — обработчики /api/v1/keys endpoints.
+// Состояние и ротация ключей подписи JWT встроенного IdP.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+)
+
+// GetSigningKeysStatus — GET /api/v1/keys/status.
+// Ключи подписи JWT и параметры ротации.
+// Доступ: разрешение settings:read.
+func (h *APIHandler) GetSigningKeysStatus(w http.ResponseWriter, r *http.Request) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermSettingsRead) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение settings:read")
+		return
+	}
+
+	status, err := h.signingKeys.Status(r.Context())
+	if err != nil {
+		h.writeSigningKeyError(w, err, "Ошибка получения ключей подписи")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapSigningKeysStatus(status))
+}
+
+// RotateSigningKey — POST /api/v1/keys/rotate.
+// Создаёт новый ключ подписи JWT.
+// Доступ: разрешение settings:write.
+func (h *APIHandler) RotateSigningKey(w http.ResponseWriter, r *http.Request) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermSettingsWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение settings:write")
+		return
+	}
+
+	// Тело запроса необязательно: без него ключ публикуется заранее
+	var req generated.SigningKeyRotateRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+			return
+		}
+	}
+
+	status, err := h.signingKeys.Rotate(r.Context(), req.Immediate != nil && *req.Immediate)
+	if err != nil {
+		h.writeSigningKeyError(w, err, "Ошибка ротации ключа подписи")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapSigningKeysStatus(status))
+}
+
+// writeSigningKeyError отвечает ошибкой операции с ключами подписи.
+func (h *APIHandler) writeSigningKeyError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, service.ErrUnsupported) {
+		apierrors.Conflict(w, "Ключами подписи JWT управляет внешний IdP (Keycloak)")
+		return
+	}
+	h.logger.Error(message, "error", err)
+	apierrors.InternalError(w, message)
+}
+
+// mapSigningKeysStatus — маппинг состояния ключей подписи в API.
+func mapSigningKeysStatus(status *idp.SigningKeyStatus) generated.SigningKeysStatus {
+	keys := make([]generated.SigningKey, 0, len(status.Keys))
+	for _, k := range status.Keys {
+		keys = append(keys, generated.SigningKey{
+			Kid:         k.KID,
+			Algorithm:   generated.SigningKeyAlgorithm(k.Algorithm),
+			State:       generated.SigningKeyState(k.State),
+			CreatedAt:   k.CreatedAt,
+			ActivatesAt: k.ActivatesAt,
+			RetiresAt:   k.RetiresAt,
+		})
+	}
+	return generated.SigningKeysStatus{
+		Algorithm:               generated.SigningKeysStatusAlgorithm(status.Algorithm),
+		RotationIntervalSeconds: int(status.RotationInterval.Seconds()),
+		OverlapSeconds:          int(status.Overlap.Seconds()),
+		PublishLeadSeconds:      int(status.PublishLead.Seconds()),
+		NextRotationAt:          status.NextRotationAt,
+		Keys:                    keys,
+	}
+}
@@ -0,0 +1,165 @@
+// personal_tokens.go — обработчики /api/v1/personal-tokens endpoints.
+// Personal access tokens пользователей: список, создание и отзыв.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+)
+
+// ListPersonalTokens — GET /api/v1/personal-tokens.
+// Токены текущего пользователя; с разрешением users:read — любого пользователя или всех.
+// Доступ: пользователи (не SA).
+func (h *APIHandler) ListPersonalTokens(w http.ResponseWriter, r *http.Request, params generated.ListPersonalTokensParams) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser {
+		apierrors.Forbidden(w, "Доступ разрешён только для пользователей")
+		return
+	}
+
+	subject := &claims.Subject
+	switch {
+	case params.All != nil && *params.All:
+		subject = nil
+	case params.UserId != nil && *params.UserId != "":
+		subject = params.UserId
+	}
+	if (subject == nil || *subject != claims.Subject) && !claims.HasPermission(rbac.PermUsersRead) {
+		apierrors.Forbidden(w, "Недостаточно прав: токены других пользователей требуют разрешения users:read")
+		return
+	}
+
+	tokens, err := h.personalTokens.List(r.Context(), subject)
+	if err != nil {
+		h.logger.Error("Ошибка получения personal access tokens", "error", err)
+		apierrors.InternalError(w, "Ошибка получения personal access tokens")
+		return
+	}
+
+	now := time.Now()
+	items := make([]generated.PersonalToken, 0, len(tokens))
+	for _, t := range tokens {
+		items = append(items, mapPersonalToken(t, now))
+	}
+	writeJSON(w, http.StatusOK, generated.PersonalTokenListResponse{Items: items})
+}
+
+// CreatePersonalToken — POST /api/v1/personal-tokens.
+// Выпускает токен текущего пользователя.
+// Доступ: пользователи (не SA).
+func (h *APIHandler) CreatePersonalToken(w http.ResponseWriter, r *http.Request) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser {
+		apierrors.Forbidden(w, "Доступ разрешён только для пользователей")
+		return
+	}
+
+	var req generated.PersonalTokenCreate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	scopes := make([]string, len(req.Scopes))
+	for i, s := range req.Scopes {
+		scopes[i] = string(s)
+	}
+
+	token, value, err := h.personalTokens.Create(r.Context(), claims, service.PersonalTokenRequest{
+		Name:      req.Name,
+		Scopes:    scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrValidation):
+			apierrors.ValidationError(w, err.Error())
+		case errors.Is(err, service.ErrConflict):
+			apierrors.Conflict(w, err.Error())
+		default:
+			h.logger.Error("Ошибка создания personal access token", "error", err)
+			apierrors.InternalError(w, "Ошибка создания personal access token")
+		}
+		return
+	}
+
+	t := mapPersonalToken(token, time.Now())
+	writeJSON(w, http.StatusCreated, generated.PersonalTokenWithSecret{
+		Id:          t.Id,
+		Name:        t.Name,
+		TokenPrefix: t.TokenPrefix,
+		UserId:      t.UserId,
+		Username:    t.Username,
+		Scopes:      t.Scopes,
+		CreatedAt:   t.CreatedAt,
+		ExpiresAt:   t.ExpiresAt,
+		Expired:     t.Expired,
+		Token:       value,
+	})
+}
+
+// RevokePersonalToken — DELETE /api/v1/personal-tokens/{id}.
+// Отзывает токен: свой — любой пользователь, чужой — с разрешением users:write.
+// Доступ: пользователи (не SA).
+func (h *APIHandler) RevokePersonalToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser {
+		apierrors.Forbidden(w, "Доступ разрешён только для пользователей")
+		return
+	}
+
+	// Без users:write отзываются только свои токены; чужой токен — 404
+	var owner *string
+	if !claims.HasPermission(rbac.PermUsersWrite) {
+		owner = &claims.Subject
+	}
+
+	if err := h.personalTokens.Revoke(r.Context(), id.String(), owner); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Personal access token не найден")
+			return
+		}
+		h.logger.Error("Ошибка отзыва personal access token", "error", err)
+		apierrors.InternalError(w, "Ошибка отзыва personal access token")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// mapPersonalToken — маппинг personal access token в API (без хеша).
+func mapPersonalToken(t *model.PersonalToken, now time.Time) generated.PersonalToken {
+	scopes := make([]generated.PersonalTokenScope, len(t.Scopes))
+	for i, s := range t.Scopes {
+		scopes[i] = generated.PersonalTokenScope(s)
+	}
+	result := generated.PersonalToken{
+		Id:          uuid.MustParse(t.ID),
+		Name:        t.Name,
+		TokenPrefix: t.TokenPrefix,
+		UserId:      t.Subject,
+		Username:    t.Username,
+		Scopes:      scopes,
+		CreatedAt:   t.CreatedAt,
+		ExpiresAt:   t.ExpiresAt,
+		Expired:     t.Expired(now),
+		LastUsedAt:  t.LastUsedAt,
+	}
+	if t.LastUsedIP != "" {
+		ip := t.LastUsedIP
+		result.LastUsedIp = &ip
+	}
+	return result
+}
@@ -0,0 +1,194 @@
+// roles.go — обработчики /api/v1/roles и /api/v1/permissions endpoints.
+// Каталог ролей (встроенные и пользовательские) и матрица разрешений.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+)
+
+// ListRoles — GET /api/v1/roles.
+// Доступ: разрешение roles:read.
+func (h *APIHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	if _, ok := requireUserPermission(w, r, rbac.PermRolesRead); !ok {
+		return
+	}
+
+	roles, err := h.roles.List(r.Context())
+	if err != nil {
+		h.writeRoleError(w, err, "Ошибка получения списка ролей")
+		return
+	}
+
+	items := make([]generated.Role, 0, len(roles))
+	for _, role := range roles {
+		items = append(items, mapRole(role))
+	}
+	writeJSON(w, http.StatusOK, generated.RoleListResponse{Items: items})
+}
+
+// CreateRole — POST /api/v1/roles.
+// Доступ: разрешение roles:write.
+func (h *APIHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	claims, ok := requireUserPermission(w, r, rbac.PermRolesWrite)
+	if !ok {
+		return
+	}
+
+	var req generated.RoleCreate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	role, err := h.roles.Create(r.Context(), claims, service.RoleInput{
+		Name:        req.Name,
+		Description: derefString(req.Description),
+		Permissions: req.Permissions,
+		Groups:      derefStrings(req.Groups),
+	})
+	if err != nil {
+		h.writeRoleError(w, err, "Ошибка создания роли")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapRole(role))
+}
+
+// GetRole — GET /api/v1/roles/{name}.
+// Доступ: разрешение roles:read.
+func (h *APIHandler) GetRole(w http.ResponseWriter, r *http.Request, name generated.RoleName) {
+	if _, ok := requireUserPermission(w, r, rbac.PermRolesRead); !ok {
+		return
+	}
+
+	role, err := h.roles.Get(r.Context(), name)
+	if err != nil {
+		h.writeRoleError(w, err, "Ошибка получения роли")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapRole(role))
+}
+
+// UpdateRole — PUT /api/v1/roles/{name}.
+// Доступ: разрешение roles:write.
+func (h *APIHandler) UpdateRole(w http.ResponseWriter, r *http.Request, name generated.RoleName) {
+	claims, ok := requireUserPermission(w, r, rbac.PermRolesWrite)
+	if !ok {
+		return
+	}
+
+	var req generated.RoleUpdate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	role, err := h.roles.Update(r.Context(), claims, name, service.RoleInput{
+		Description: derefString(req.Description),
+		Permissions: req.Permissions,
+		Groups:      derefStrings(req.Groups),
+	})
+	if err != nil {
+		h.writeRoleError(w, err, "Ошибка изменения роли")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapRole(role))
+}
+
+// DeleteRole — DELETE /api/v1/roles/{name}.
+// Доступ: разрешение roles:write.
+func (h *APIHandler) DeleteRole(w http.ResponseWriter, r *http.Request, name generated.RoleName) {
+	claims, ok := requireUserPermission(w, r, rbac.PermRolesWrite)
+	if !ok {
+		return
+	}
+
+	if err := h.roles.Delete(r.Context(), claims, name); err != nil {
+		h.writeRoleError(w, err, "Ошибка удаления роли")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListPermissions — GET /api/v1/permissions.
+// Доступ: разрешение roles:read.
+func (h *APIHandler) ListPermissions(w http.ResponseWriter, r *http.Request) {
+	if _, ok := requireUserPermission(w, r, rbac.PermRolesRead); !ok {
+		return
+	}
+
+	var resp generated.PermissionCatalog
+	for _, res := range rbac.Resources {
+		resp.Resources = append(resp.Resources, struct {
+			Actions []string `json:"actions"`
+			Name    string   `json:"name"`
+		}{Actions: res.Actions, Name: res.Name})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// requireUserPermission проверяет, что запрос выполняет пользователь
+// с разрешением perm. При отказе записывает 403 и возвращает false.
+func requireUserPermission(w http.ResponseWriter, r *http.Request, perm rbac.Permission) (*middleware.AuthClaims, bool) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(perm) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение "+string(perm))
+		return nil, false
+	}
+	return claims, true
+}
+
+// writeRoleError отвечает ошибкой операции с ролью.
+func (h *APIHandler) writeRoleError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrValidation):
+		apierrors.ValidationError(w, err.Error())
+	case errors.Is(err, service.ErrForbidden):
+		apierrors.Forbidden(w, "Нельзя выдать роли разрешения, которых нет у вас")
+	case errors.Is(err, service.ErrConflict):
+		apierrors.Conflict(w, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		apierrors.NotFound(w, "Роль не найдена")
+	default:
+		h.logger.Error(message, "error", err)
+		apierrors.InternalError(w, message)
+	}
+}
+
+// derefStrings возвращает значение списка строк (nil для nil).
+func derefStrings(s *[]string) []string {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+// mapRole — маппинг роли каталога в API.
+func mapRole(role rbac.Role) generated.Role {
+	perms := make([]string, len(role.Permissions))
+	for i, p := range role.Permissions {
+		perms[i] = string(p)
+	}
+	groups := role.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+	return generated.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: perms,
+		Groups:      groups,
+		Builtin:     role.Builtin,
+	}
+}
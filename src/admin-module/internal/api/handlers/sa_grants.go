@@ -0,0 +1,131 @@
+// sa_grants.go — обработчики /api/v1/service-accounts/{id}/grants.
+// Ограничения scopes SA по ресурсам: SE (ID и метки), политики хранения,
+// только свои файлы.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+)
+
+// GetServiceAccountGrants — GET /api/v1/service-accounts/{id}/grants.
+// Возвращает grants SA.
+// Доступ: разрешение service_accounts:read.
+func (h *APIHandler) GetServiceAccountGrants(w http.ResponseWriter, r *http.Request, id generated.ServiceAccountId) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermServiceAccountsRead) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение service_accounts:read")
+		return
+	}
+
+	list, err := h.saGrants.List(r.Context(), id.String())
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Сервисный аккаунт не найден")
+			return
+		}
+		h.logger.Error("Ошибка получения grants SA", "sa_id", id, "error", err)
+		apierrors.InternalError(w, "Ошибка получения grants сервисного аккаунта")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapServiceAccountGrants(list))
+}
+
+// ReplaceServiceAccountGrants — PUT /api/v1/service-accounts/{id}/grants.
+// Заменяет grants SA и обновляет claim в Keycloak.
+// Доступ: разрешение service_accounts:write.
+func (h *APIHandler) ReplaceServiceAccountGrants(w http.ResponseWriter, r *http.Request, id generated.ServiceAccountId) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermServiceAccountsWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение service_accounts:write")
+		return
+	}
+
+	var req generated.ServiceAccountGrants
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	list := make([]*model.ResourceGrant, 0, len(req.Grants))
+	for _, g := range req.Grants {
+		grant := &model.ResourceGrant{Scope: string(g.Scope)}
+		if g.SeIds != nil {
+			for _, seID := range *g.SeIds {
+				grant.SEIDs = append(grant.SEIDs, seID.String())
+			}
+		}
+		if g.SeLabels != nil {
+			grant.SELabels = map[string]string(*g.SeLabels)
+		}
+		if g.RetentionPolicies != nil {
+			for _, p := range *g.RetentionPolicies {
+				grant.RetentionPolicies = append(grant.RetentionPolicies, string(p))
+			}
+		}
+		if g.OwnFilesOnly != nil {
+			grant.OwnFilesOnly = *g.OwnFilesOnly
+		}
+		list = append(list, grant)
+	}
+
+	updated, err := h.saGrants.Replace(r.Context(), id.String(), list)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Сервисный аккаунт не найден")
+			return
+		}
+		if errors.Is(err, service.ErrValidation) {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
+		h.logger.Error("Ошибка обновления grants SA", "sa_id", id, "error", err)
+		apierrors.InternalError(w, "Ошибка обновления grants сервисного аккаунта")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapServiceAccountGrants(updated))
+}
+
+// mapServiceAccountGrants конвертирует grants SA в API-ответ.
+func mapServiceAccountGrants(list []*model.ResourceGrant) generated.ServiceAccountGrants {
+	resp := generated.ServiceAccountGrants{Grants: make([]generated.ResourceGrant, 0, len(list))}
+	for _, g := range list {
+		item := generated.ResourceGrant{Scope: generated.ResourceGrantScope(g.Scope)}
+		if len(g.SEIDs) > 0 {
+			ids := make([]uuid.UUID, len(g.SEIDs))
+			for i, seID := range g.SEIDs {
+				ids[i] = uuid.MustParse(seID)
+			}
+			item.SeIds = &ids
+		}
+		if len(g.SELabels) > 0 {
+			labels := generated.StorageElementLabels(g.SELabels)
+			item.SeLabels = &labels
+		}
+		if len(g.RetentionPolicies) > 0 {
+			policies := make([]generated.ResourceGrantRetentionPolicies, 0, len(g.RetentionPolicies))
+			for _, p := range g.RetentionPolicies {
+				policies = append(policies, generated.ResourceGrantRetentionPolicies(p))
+			}
+			item.RetentionPolicies = &policies
+		}
+		if g.OwnFilesOnly {
+			own := true
+			item.OwnFilesOnly = &own
+		}
+		resp.Grants = append(resp.Grants, item)
+	}
+	return resp
+}
@@ -1,11 +1,14 @@
 // service_accounts.go — обработчики /api/v1/service-accounts endpoints.
-// CRUD SA: создание, список, получение, обновление, удаление, ротация секрета.
+// CRUD SA: создание, список (в т.ч. «скоро истекают»), получение, обновление,
+// удаление, ротация секрета с периодом действия старого секрета.
 package handlers
 
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -13,16 +16,17 @@ import (
 	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
 	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
 )
 
 // CreateServiceAccount — POST /api/v1/service-accounts.
 // Создаёт SA в Keycloak + локальной БД.
-// Доступ: admin.
+// Доступ: разрешение service_accounts:write.
 func (h *APIHandler) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermServiceAccountsWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение service_accounts:write")
 		return
 	}
 
@@ -69,11 +73,11 @@ func (h *APIHandler) CreateServiceAccount(w http.ResponseWriter, r *http.Request
 
 // ListServiceAccounts — GET /api/v1/service-accounts.
 // Возвращает список SA.
-// Доступ: admin или readonly.
+// Доступ: разрешение service_accounts:read.
 func (h *APIHandler) ListServiceAccounts(w http.ResponseWriter, r *http.Request, params generated.ListServiceAccountsParams) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasAnyRole("admin", "readonly") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin или readonly")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermServiceAccountsRead) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение service_accounts:read")
 		return
 	}
 
@@ -85,7 +89,21 @@ func (h *APIHandler) ListServiceAccounts(w http.ResponseWriter, r *http.Request,
 		status = &s
 	}
 
-	sas, total, err := h.serviceAccts.List(r.Context(), status, limit, offset)
+	var (
+		sas   []*model.ServiceAccount
+		total int
+		err   error
+	)
+	if params.SecretExpiringWithinDays != nil {
+		days := *params.SecretExpiringWithinDays
+		if days < 0 || days > 365 {
+			apierrors.ValidationError(w, "secret_expiring_within_days должен быть от 0 до 365")
+			return
+		}
+		sas, total, err = h.listExpiringSecrets(r, days, limit, offset)
+	} else {
+		sas, total, err = h.serviceAccts.List(r.Context(), status, limit, offset)
+	}
 	if err != nil {
 		h.logger.Error("Ошибка получения списка SA", "error", err)
 		apierrors.InternalError(w, "Ошибка получения списка сервисных аккаунтов")
@@ -110,11 +128,11 @@ func (h *APIHandler) ListServiceAccounts(w http.ResponseWriter, r *http.Request,
 
 // GetServiceAccount — GET /api/v1/service-accounts/{id}.
 // Возвращает SA по ID.
-// Доступ: admin или readonly.
+// Доступ: разрешение service_accounts:read.
 func (h *APIHandler) GetServiceAccount(w http.ResponseWriter, r *http.Request, id generated.ServiceAccountId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasAnyRole("admin", "readonly") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin или readonly")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermServiceAccountsRead) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение service_accounts:read")
 		return
 	}
 
@@ -134,11 +152,11 @@ func (h *APIHandler) GetServiceAccount(w http.ResponseWriter, r *http.Request, i
 
 // UpdateServiceAccount — PUT /api/v1/service-accounts/{id}.
 // Обновляет SA.
-// Доступ: admin.
+// Доступ: разрешение service_accounts:write.
 func (h *APIHandler) UpdateServiceAccount(w http.ResponseWriter, r *http.Request, id generated.ServiceAccountId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermServiceAccountsWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение service_accounts:write")
 		return
 	}
 
@@ -169,6 +187,10 @@ func (h *APIHandler) UpdateServiceAccount(w http.ResponseWriter, r *http.Request
 			apierrors.NotFound(w, "Сервисный аккаунт не найден")
 			return
 		}
+		if errors.Is(err, service.ErrValidation) {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
 		h.logger.Error("Ошибка обновления SA", "sa_id", id, "error", err)
 		apierrors.InternalError(w, "Ошибка обновления сервисного аккаунта")
 		return
@@ -179,13 +201,13 @@ func (h *APIHandler) UpdateServiceAccount(w http.ResponseWriter, r *http.Request
 
 // DeleteServiceAccount — DELETE /api/v1/service-accounts/{id}.
 // Удаляет SA из БД и Keycloak.
-// Доступ: admin.
+// Доступ: разрешение service_accounts:write.
 //
 //nolint:dupl // TODO: вынести общую логику удаления
 func (h *APIHandler) DeleteServiceAccount(w http.ResponseWriter, r *http.Request, id generated.ServiceAccountId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermServiceAccountsWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение service_accounts:write")
 		return
 	}
 
@@ -204,33 +226,70 @@ func (h *APIHandler) DeleteServiceAccount(w http.ResponseWriter, r *http.Request
 
 // RotateSecret — POST /api/v1/service-accounts/{id}/rotate-secret.
 // Ротация секрета SA в Keycloak.
-// Доступ: admin.
+// Доступ: разрешение service_accounts:write.
 func (h *APIHandler) RotateSecret(w http.ResponseWriter, r *http.Request, id generated.ServiceAccountId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermServiceAccountsWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение service_accounts:write")
 		return
 	}
 
-	clientID, newSecret, err := h.serviceAccts.RotateSecret(r.Context(), id.String())
+	// Тело запроса необязательно: без него применяется период ротации по умолчанию
+	var grace *time.Duration
+	if r.ContentLength != 0 {
+		var req generated.RotateSecretRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+			return
+		}
+		if req.GracePeriodHours != nil {
+			if *req.GracePeriodHours < 0 || *req.GracePeriodHours > 720 {
+				apierrors.ValidationError(w, "grace_period_hours должен быть от 0 до 720")
+				return
+			}
+			d := time.Duration(*req.GracePeriodHours) * time.Hour
+			grace = &d
+		}
+	}
+
+	rotation, err := h.serviceAccts.RotateSecret(r.Context(), id.String(), grace)
 	if err != nil {
 		if errors.Is(err, service.ErrNotFound) {
 			apierrors.NotFound(w, "Сервисный аккаунт не найден")
 			return
 		}
+		if errors.Is(err, service.ErrValidation) {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
 		h.logger.Error("Ошибка ротации секрета", "sa_id", id, "error", err)
 		apierrors.InternalError(w, "Ошибка ротации секрета")
 		return
 	}
 
 	resp := generated.RotateSecretResponse{
-		ClientId:     clientID,
-		ClientSecret: newSecret,
+		ClientId:                rotation.ClientID,
+		ClientSecret:            rotation.ClientSecret,
+		SecretExpiresAt:         rotation.SecretExpiresAt,
+		PreviousSecretExpiresAt: rotation.PreviousSecretExpiresAt,
 	}
 
 	writeJSON(w, http.StatusOK, resp)
 }
 
+// listExpiringSecrets возвращает страницу списка «скоро истекают».
+func (h *APIHandler) listExpiringSecrets(r *http.Request, days, limit, offset int) ([]*model.ServiceAccount, int, error) {
+	sas, err := h.serviceAccts.ListExpiringSecrets(r.Context(), time.Duration(days)*24*time.Hour)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := len(sas)
+	start := min(offset, total)
+	end := min(offset+limit, total)
+	return sas[start:end], total, nil
+}
+
 // --- Маппинг domain → API ---
 
 // mapServiceAccount конвертирует domain model в generated API type.
@@ -254,6 +313,9 @@ func mapServiceAccount(sa *model.ServiceAccount) generated.ServiceAccount {
 	result.Description = sa.Description
 	result.KeycloakClientId = sa.KeycloakClientID
 	result.LastSyncedAt = sa.LastSyncedAt
+	result.SecretIssuedAt = sa.SecretIssuedAt
+	result.SecretExpiresAt = sa.SecretExpiresAt
+	result.PreviousSecretExpiresAt = sa.PreviousSecretExpiresAt
 
 	return result
 }
@@ -282,6 +344,9 @@ func mapServiceAccountWithSecret(saws *service.ServiceAccountWithSecret) generat
 	result.Description = sa.Description
 	result.KeycloakClientId = sa.KeycloakClientID
 	result.LastSyncedAt = sa.LastSyncedAt
+	result.SecretIssuedAt = sa.SecretIssuedAt
+	result.SecretExpiresAt = sa.SecretExpiresAt
+	result.PreviousSecretExpiresAt = sa.PreviousSecretExpiresAt
 
 	return result
 }
@@ -12,17 +12,20 @@ import (
 	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
 	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
 	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
 )
 
 // DiscoverStorageElement — POST /api/v1/storage-elements/discover.
 // Предпросмотр SE: запрос GET /api/v1/info к SE.
-// Доступ: admin или readonly.
+// Доступ: разрешение storage:read.
 func (h *APIHandler) DiscoverStorageElement(w http.ResponseWriter, r *http.Request) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasAnyRole("admin", "readonly") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin или readonly")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermStorageRead) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение storage:read")
 		return
 	}
 
@@ -69,11 +72,11 @@ func (h *APIHandler) DiscoverStorageElement(w http.ResponseWriter, r *http.Reque
 
 // CreateStorageElement — POST /api/v1/storage-elements.
 // Регистрация SE: discover + сохранение в БД + полная синхронизация файлов (Phase 5).
-// Доступ: admin.
+// Доступ: разрешение storage:write.
 func (h *APIHandler) CreateStorageElement(w http.ResponseWriter, r *http.Request) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermStorageWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение storage:write")
 		return
 	}
 
@@ -93,8 +96,17 @@ func (h *APIHandler) CreateStorageElement(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	se, err := h.storageElems.Create(r.Context(), req.Name, req.Url)
+	var labels map[string]string
+	if req.Labels != nil {
+		labels = *req.Labels
+	}
+
+	se, err := h.storageElems.Create(r.Context(), req.Name, req.Url, labels)
 	if err != nil {
+		if errors.Is(err, service.ErrValidation) {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
 		if errors.Is(err, service.ErrConflict) {
 			apierrors.Conflict(w, err.Error())
 			return
@@ -113,7 +125,7 @@ func (h *APIHandler) CreateStorageElement(w http.ResponseWriter, r *http.Request
 
 // ListStorageElements — GET /api/v1/storage-elements.
 // Возвращает список зарегистрированных SE.
-// Доступ: admin, readonly или SA с scope storage:read.
+// Доступ: разрешение storage:read или SA с scope storage:read.
 func (h *APIHandler) ListStorageElements(w http.ResponseWriter, r *http.Request, params generated.ListStorageElementsParams) {
 	claims := middleware.ClaimsFromContext(r.Context())
 	if claims == nil {
@@ -121,11 +133,11 @@ func (h *APIHandler) ListStorageElements(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	// Проверка RBAC: admin/readonly или SA с storage:read
+	// Проверка RBAC: разрешение storage:read или SA с storage:read
 	switch claims.SubjectType {
 	case middleware.SubjectTypeUser:
-		if !claims.HasAnyRole("admin", "readonly") {
-			apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin или readonly")
+		if !claims.HasPermission(rbac.PermStorageRead) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение storage:read")
 			return
 		}
 	case middleware.SubjectTypeSA:
@@ -140,17 +152,28 @@ func (h *APIHandler) ListStorageElements(w http.ResponseWriter, r *http.Request,
 
 	limit, offset := paginationDefaults(params.Limit, params.Offset)
 
-	var mode, status *string
+	var filters repository.StorageElementFilters
 	if params.Mode != nil {
 		s := string(*params.Mode)
-		mode = &s
+		filters.Mode = &s
 	}
 	if params.Status != nil {
 		s := string(*params.Status)
-		status = &s
+		filters.Status = &s
+	}
+	if params.Label != nil {
+		labels, err := service.ParseLabels(*params.Label)
+		if err != nil {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
+		filters.Labels = labels
+	}
+	if claims.SubjectType == middleware.SubjectTypeSA {
+		filters.IDs = claims.Grants.SEs(grants.ScopeStorageRead)
 	}
 
-	ses, total, err := h.storageElems.List(r.Context(), mode, status, limit, offset)
+	ses, total, err := h.storageElems.List(r.Context(), filters, limit, offset)
 	if err != nil {
 		h.logger.Error("Ошибка получения списка SE", "error", err)
 		apierrors.InternalError(w, "Ошибка получения списка Storage Elements")
@@ -175,7 +198,7 @@ func (h *APIHandler) ListStorageElements(w http.ResponseWriter, r *http.Request,
 
 // GetStorageElement — GET /api/v1/storage-elements/{id}.
 // Возвращает SE по ID.
-// Доступ: admin, readonly или SA с scope storage:read.
+// Доступ: разрешение storage:read или SA с scope storage:read.
 //
 //nolint:dupl // TODO: вынести общую логику проверки прав
 func (h *APIHandler) GetStorageElement(w http.ResponseWriter, r *http.Request, id generated.StorageElementId) {
@@ -187,8 +210,8 @@ func (h *APIHandler) GetStorageElement(w http.ResponseWriter, r *http.Request, i
 
 	switch claims.SubjectType {
 	case middleware.SubjectTypeUser:
-		if !claims.HasAnyRole("admin", "readonly") {
-			apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin или readonly")
+		if !claims.HasPermission(rbac.PermStorageRead) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение storage:read")
 			return
 		}
 	case middleware.SubjectTypeSA:
@@ -211,17 +234,21 @@ func (h *APIHandler) GetStorageElement(w http.ResponseWriter, r *http.Request, i
 		apierrors.InternalError(w, "Ошибка получения Storage Element")
 		return
 	}
+	if !claims.AllowsGrant(grants.ScopeStorageRead, grants.Access{SE: []string{se.ID, se.StorageID}}) {
+		apierrors.Forbidden(w, "Недостаточно прав: SE вне grants SA")
+		return
+	}
 
 	writeJSON(w, http.StatusOK, mapStorageElement(se))
 }
 
 // UpdateStorageElement — PUT /api/v1/storage-elements/{id}.
-// Обновляет SE (только name и url).
-// Доступ: admin.
+// Обновляет SE (name, url и метки). Переданные labels полностью заменяют текущие.
+// Доступ: разрешение storage:write.
 func (h *APIHandler) UpdateStorageElement(w http.ResponseWriter, r *http.Request, id generated.StorageElementId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermStorageWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение storage:write")
 		return
 	}
 
@@ -231,8 +258,21 @@ func (h *APIHandler) UpdateStorageElement(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	se, err := h.storageElems.Update(r.Context(), id.String(), req.Name, req.Url)
+	// nil — метки не меняются, пустой объект — метки удаляются
+	var labels map[string]string
+	if req.Labels != nil {
+		labels = map[string]string(*req.Labels)
+		if labels == nil {
+			labels = map[string]string{}
+		}
+	}
+
+	se, err := h.storageElems.Update(r.Context(), id.String(), req.Name, req.Url, labels)
 	if err != nil {
+		if errors.Is(err, service.ErrValidation) {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
 		if errors.Is(err, service.ErrNotFound) {
 			apierrors.NotFound(w, "Storage Element не найден")
 			return
@@ -251,13 +291,13 @@ func (h *APIHandler) UpdateStorageElement(w http.ResponseWriter, r *http.Request
 
 // DeleteStorageElement — DELETE /api/v1/storage-elements/{id}.
 // Удаляет SE из реестра. Физические файлы не удаляются.
-// Доступ: admin.
+// Доступ: разрешение storage:write.
 //
 //nolint:dupl // TODO: вынести общую логику удаления
 func (h *APIHandler) DeleteStorageElement(w http.ResponseWriter, r *http.Request, id generated.StorageElementId) {
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasRole("admin") {
-		apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	if claims == nil || claims.SubjectType != middleware.SubjectTypeUser || !claims.HasPermission(rbac.PermStorageWrite) {
+		apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение storage:write")
 		return
 	}
 
@@ -275,65 +315,307 @@ func (h *APIHandler) DeleteStorageElement(w http.ResponseWriter, r *http.Request
 }
 
 // SyncStorageElement — POST /api/v1/storage-elements/{id}/sync.
-// Полная синхронизация SE (info + файлы).
-// Доступ: admin или SA с scope storage:write.
+// Запускает фоновую синхронизацию SE (info + файлы), возвращает задачу.
+// Доступ: разрешение storage:sync или SA с scope storage:write.
 func (h *APIHandler) SyncStorageElement(w http.ResponseWriter, r *http.Request, id generated.StorageElementId) {
+	if !h.requireStorageSync(w, r) {
+		return
+	}
 	claims := middleware.ClaimsFromContext(r.Context())
-	if claims == nil {
-		apierrors.Unauthorized(w, "Отсутствуют claims")
+	if !claims.AllowsGrant(grants.ScopeStorageWrite, grants.Access{SE: []string{id.String()}}) {
+		apierrors.Forbidden(w, "Недостаточно прав: SE вне grants SA")
 		return
 	}
 
-	switch claims.SubjectType {
-	case middleware.SubjectTypeUser:
-		if !claims.HasRole("admin") {
-			apierrors.Forbidden(w, "Недостаточно прав: требуется роль admin")
+	run, err := h.storageElems.Sync(r.Context(), id.String())
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Storage Element не найден")
 			return
 		}
-	case middleware.SubjectTypeSA:
-		if !claims.HasScope("storage:write") {
-			apierrors.Forbidden(w, "Недостаточно прав: требуется scope storage:write")
+		if errors.Is(err, service.ErrConflict) {
+			apierrors.Conflict(w, err.Error())
 			return
 		}
-	default:
-		apierrors.Forbidden(w, "Неизвестный тип субъекта")
+		h.logger.Error("Ошибка запуска sync SE", "se_id", id, "error", err)
+		apierrors.InternalError(w, "Ошибка запуска синхронизации Storage Element")
+		return
+	}
+
+	w.Header().Set("Location", "/api/v1/sync-jobs/"+run.ID)
+	writeJSON(w, http.StatusAccepted, mapSyncJob(run))
+}
+
+// ListSyncJobs — GET /api/v1/sync-jobs.
+// История задач синхронизации (новые первыми).
+// Доступ: разрешение storage:read или SA с scope storage:read.
+func (h *APIHandler) ListSyncJobs(w http.ResponseWriter, r *http.Request, params generated.ListSyncJobsParams) {
+	if !h.requireStorageRead(w, r) {
+		return
+	}
+
+	limit, offset := paginationDefaults(params.Limit, params.Offset)
+
+	filters := repository.SyncRunFilters{}
+	if params.StorageElementId != nil {
+		s := params.StorageElementId.String()
+		filters.StorageElementID = &s
+	}
+	if params.Status != nil {
+		s := string(*params.Status)
+		filters.Status = &s
+	}
+	if params.Trigger != nil {
+		s := string(*params.Trigger)
+		filters.Trigger = &s
+	}
+
+	runs, total, err := h.storageElems.ListSyncRuns(r.Context(), filters, limit, offset)
+	if err != nil {
+		h.logger.Error("Ошибка получения задач синхронизации", "error", err)
+		apierrors.InternalError(w, "Ошибка получения задач синхронизации")
+		return
+	}
+
+	items := make([]generated.SyncJob, len(runs))
+	for i, run := range runs {
+		items[i] = mapSyncJob(run)
+	}
+
+	writeJSON(w, http.StatusOK, generated.SyncJobListResponse{
+		Items:   items,
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: offset+limit < total,
+	})
+}
+
+// GetSyncJob — GET /api/v1/sync-jobs/{id}.
+// Состояние и прогресс задачи синхронизации.
+// Доступ: разрешение storage:read или SA с scope storage:read.
+func (h *APIHandler) GetSyncJob(w http.ResponseWriter, r *http.Request, id generated.SyncJobId) {
+	if !h.requireStorageRead(w, r) {
 		return
 	}
 
-	syncResult, err := h.storageElems.Sync(r.Context(), id.String())
+	run, err := h.storageElems.GetSyncRun(r.Context(), id.String())
 	if err != nil {
 		if errors.Is(err, service.ErrNotFound) {
-			apierrors.NotFound(w, "Storage Element не найден")
+			apierrors.NotFound(w, "Задача синхронизации не найдена")
 			return
 		}
-		if errors.Is(err, service.ErrSEUnavailable) {
-			apierrors.SEUnavailable(w, err.Error())
+		h.logger.Error("Ошибка получения задачи синхронизации", "run_id", id, "error", err)
+		apierrors.InternalError(w, "Ошибка получения задачи синхронизации")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapSyncJob(run))
+}
+
+// CancelSyncJob — POST /api/v1/sync-jobs/{id}/cancel.
+// Отменяет выполняющуюся задачу синхронизации.
+// Доступ: разрешение storage:sync или SA с scope storage:write.
+func (h *APIHandler) CancelSyncJob(w http.ResponseWriter, r *http.Request, id generated.SyncJobId) {
+	if !h.requireStorageSync(w, r) {
+		return
+	}
+
+	run, err := h.storageElems.CancelSyncRun(r.Context(), id.String())
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Задача синхронизации не найдена")
+			return
+		}
+		if errors.Is(err, service.ErrConflict) {
+			apierrors.Conflict(w, "Задача синхронизации уже завершена")
 			return
 		}
-		h.logger.Error("Ошибка sync SE", "se_id", id, "error", err)
-		apierrors.InternalError(w, "Ошибка синхронизации Storage Element")
+		h.logger.Error("Ошибка отмены задачи синхронизации", "run_id", id, "error", err)
+		apierrors.InternalError(w, "Ошибка отмены задачи синхронизации")
 		return
 	}
 
-	// Получаем обновлённый SE
-	se, err := h.storageElems.Get(r.Context(), id.String())
+	writeJSON(w, http.StatusOK, mapSyncJob(run))
+}
+
+// SelectStorageElement — POST /api/v1/storage-elements/select.
+// Выбирает SE для загрузки файла по политике размещения и резервирует место.
+// Доступ: разрешение files:write или SA с scope files:write.
+func (h *APIHandler) SelectStorageElement(w http.ResponseWriter, r *http.Request) {
+	if !h.requireFilesWrite(w, r) {
+		return
+	}
+
+	var req generated.StorageElementSelectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+
+	placementReq := service.PlacementRequest{
+		RetentionPolicy: string(req.RetentionPolicy),
+		SizeBytes:       req.SizeBytes,
+	}
+	if req.Labels != nil {
+		placementReq.Labels = *req.Labels
+	}
+	if req.RequireLabels != nil {
+		placementReq.RequireLabels = *req.RequireLabels
+	}
+	if req.Policy != nil {
+		placementReq.Policy = string(*req.Policy)
+	}
+
+	// Grants SA: только разрешённые SE и политики хранения
+	claims := middleware.ClaimsFromContext(r.Context())
+	if !claims.AllowsGrant(grants.ScopeFilesWrite, grants.Access{Retention: placementReq.RetentionPolicy}) {
+		apierrors.Forbidden(w, "Недостаточно прав: политика хранения вне grants SA")
+		return
+	}
+	if claims.SubjectType == middleware.SubjectTypeSA && claims.Grants.Restricted(grants.ScopeFilesWrite) {
+		placementReq.Allow = func(se *model.StorageElement) bool {
+			return claims.AllowsGrant(grants.ScopeFilesWrite, grants.Access{
+				SE:        []string{se.ID, se.StorageID},
+				Retention: placementReq.RetentionPolicy,
+			})
+		}
+	}
+
+	result, err := h.placement.Select(r.Context(), placementReq)
 	if err != nil {
-		h.logger.Error("Ошибка получения SE после sync", "se_id", id, "error", err)
-		apierrors.InternalError(w, "Ошибка получения SE после синхронизации")
+		if errors.Is(err, service.ErrValidation) {
+			apierrors.ValidationError(w, err.Error())
+			return
+		}
+		if errors.Is(err, service.ErrConflict) {
+			apierrors.Conflict(w, err.Error())
+			return
+		}
+		h.logger.Error("Ошибка выбора SE", "error", err)
+		apierrors.InternalError(w, "Ошибка выбора Storage Element")
 		return
 	}
 
-	resp := generated.SyncResponse{
-		StorageElement: mapStorageElement(se),
+	candidates := make([]generated.StorageElementCandidate, 0, len(result.Candidates))
+	for _, c := range result.Candidates {
+		candidates = append(candidates, generated.StorageElementCandidate{
+			StorageElement: mapStorageElement(c.StorageElement),
+			FreeBytes:      c.FreeBytes,
+			ReservedBytes:  c.ReservedBytes,
+			LabelMatches:   c.LabelMatches,
+		})
 	}
-	resp.FileSync.FilesOnSe = syncResult.FilesOnSE
-	resp.FileSync.FilesAdded = syncResult.FilesAdded
-	resp.FileSync.FilesUpdated = syncResult.FilesUpdated
-	resp.FileSync.FilesMarkedDeleted = syncResult.FilesMarkedDeleted
-	resp.FileSync.StartedAt = syncResult.StartedAt
-	resp.FileSync.CompletedAt = syncResult.CompletedAt
 
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, generated.StorageElementSelection{
+		Policy:         generated.StorageElementSelectionPolicy(result.Policy),
+		StorageElement: candidates[0].StorageElement,
+		ReservationId:  uuid.MustParse(result.Reservation.ID),
+		ExpiresAt:      result.Reservation.ExpiresAt,
+		Candidates:     candidates,
+	})
+}
+
+// ReleaseStorageElementReservation — DELETE /api/v1/storage-elements/reservations/{reservation_id}.
+// Освобождает место, зарезервированное при выборе SE.
+// Доступ: разрешение files:write или SA с scope files:write.
+func (h *APIHandler) ReleaseStorageElementReservation(w http.ResponseWriter, r *http.Request, reservationID generated.ReservationId) {
+	if !h.requireFilesWrite(w, r) {
+		return
+	}
+
+	if err := h.placement.Release(reservationID.String()); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			apierrors.NotFound(w, "Резервирование не найдено или истекло")
+			return
+		}
+		h.logger.Error("Ошибка освобождения резервирования", "reservation_id", reservationID, "error", err)
+		apierrors.InternalError(w, "Ошибка освобождения резервирования")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// requireStorageRead проверяет доступ на чтение SE: разрешение storage:read или SA storage:read.
+// При отказе записывает ошибку в ответ и возвращает false.
+func (h *APIHandler) requireStorageRead(w http.ResponseWriter, r *http.Request) bool {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil {
+		apierrors.Unauthorized(w, "Отсутствуют claims")
+		return false
+	}
+
+	switch claims.SubjectType {
+	case middleware.SubjectTypeUser:
+		if !claims.HasPermission(rbac.PermStorageRead) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение storage:read")
+			return false
+		}
+	case middleware.SubjectTypeSA:
+		if !claims.HasScope("storage:read") {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется scope storage:read")
+			return false
+		}
+	default:
+		apierrors.Forbidden(w, "Неизвестный тип субъекта")
+		return false
+	}
+	return true
+}
+
+// requireStorageSync проверяет доступ на синхронизацию SE: разрешение storage:sync или SA storage:write.
+// При отказе записывает ошибку в ответ и возвращает false.
+func (h *APIHandler) requireStorageSync(w http.ResponseWriter, r *http.Request) bool {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil {
+		apierrors.Unauthorized(w, "Отсутствуют claims")
+		return false
+	}
+
+	switch claims.SubjectType {
+	case middleware.SubjectTypeUser:
+		if !claims.HasPermission(rbac.PermStorageSync) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение storage:sync")
+			return false
+		}
+	case middleware.SubjectTypeSA:
+		if !claims.HasScope("storage:write") {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется scope storage:write")
+			return false
+		}
+	default:
+		apierrors.Forbidden(w, "Неизвестный тип субъекта")
+		return false
+	}
+	return true
+}
+
+// requireFilesWrite проверяет доступ на загрузку файлов: разрешение files:write или SA files:write.
+// При отказе записывает ошибку в ответ и возвращает false.
+func (h *APIHandler) requireFilesWrite(w http.ResponseWriter, r *http.Request) bool {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil {
+		apierrors.Unauthorized(w, "Отсутствуют claims")
+		return false
+	}
+
+	switch claims.SubjectType {
+	case middleware.SubjectTypeUser:
+		if !claims.HasPermission(rbac.PermFilesWrite) {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется разрешение files:write")
+			return false
+		}
+	case middleware.SubjectTypeSA:
+		if !claims.HasScope("files:write") {
+			apierrors.Forbidden(w, "Недостаточно прав: требуется scope files:write")
+			return false
+		}
+	default:
+		apierrors.Forbidden(w, "Неизвестный тип субъекта")
+		return false
+	}
+	return true
 }
 
 // --- Маппинг domain → API ---
@@ -357,5 +639,33 @@ func mapStorageElement(se *model.StorageElement) generated.StorageElement {
 	result.LastSyncAt = se.LastSyncAt
 	result.LastFileSyncAt = se.LastFileSyncAt
 
+	labels := generated.StorageElementLabels(se.Labels)
+	if labels == nil {
+		labels = generated.StorageElementLabels{}
+	}
+	result.Labels = &labels
+
 	return result
 }
+
+// mapSyncJob конвертирует задачу синхронизации в generated API type.
+func mapSyncJob(run *model.SyncRun) generated.SyncJob {
+	return generated.SyncJob{
+		Id:                 uuid.MustParse(run.ID),
+		StorageElementId:   uuid.MustParse(run.StorageElementID),
+		Trigger:            generated.SyncJobTrigger(run.Trigger),
+		Mode:               generated.SyncJobMode(run.Mode),
+		Status:             generated.SyncJobStatus(run.Status),
+		RequestedBy:        run.RequestedBy,
+		FilesOnSe:          run.FilesOnSE,
+		FilesProcessed:     run.FilesProcessed,
+		FilesAdded:         run.FilesAdded,
+		FilesUpdated:       run.FilesUpdated,
+		FilesMarkedDeleted: run.FilesMarkedDeleted,
+		Error:              run.Error,
+		CreatedAt:          run.CreatedAt,
+		StartedAt:          run.StartedAt,
+		CompletedAt:        run.CompletedAt,
+		DurationMs:         run.DurationMs,
+	}
+}
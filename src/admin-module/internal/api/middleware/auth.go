@@ -1,7 +1,9 @@
 // auth.go — JWT middleware для аутентификации и авторизации Admin Module.
 // Извлекает claims из Keycloak JWT, определяет тип субъекта (Admin User / Service Account),
-// маппит группы в роли, применяет role overrides из БД.
+// маппит группы в роли по каталогу ролей, применяет role overrides из БД
+// и вычисляет разрешения пользователя.
 // Fallback-валидация подписи через JWKS Keycloak (основная — на API Gateway).
+// Personal access tokens пользователей обрабатываются в personal_token.go.
 package middleware
 
 import (
@@ -21,6 +23,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 
 	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 )
 
@@ -60,12 +64,19 @@ type AuthClaims struct {
 	Roles []string
 	// Groups — группы из JWT.
 	Groups []string
-	// IdpRole — роль, вычисленная из групп IdP (admin, readonly, "").
+	// IdpRole — роль с максимальными привилегиями из групп IdP ("" — нет ролей).
 	IdpRole string
 	// RoleOverride — локальное дополнение роли из БД (может быть nil).
 	RoleOverride *string
-	// EffectiveRole — итоговая роль = max(IdpRole, RoleOverride).
+	// EffectiveRole — роль с максимальными привилегиями из AssignedRoles.
 	EffectiveRole string
+	// AssignedRoles — все роли пользователя: из групп IdP и RoleOverride.
+	AssignedRoles []string
+	// Permissions — объединение разрешений AssignedRoles.
+	Permissions rbac.PermissionSet
+	// TokenID — ID personal access token, если запрос аутентифицирован им
+	// (пусто для JWT). Scopes при этом содержат scopes токена.
+	TokenID string
 
 	// --- Для Service Account ---
 
@@ -73,6 +84,8 @@ type AuthClaims struct {
 	Scopes []string
 	// ClientID — client_id из JWT (для Service Account).
 	ClientID string
+	// Grants — ограничения scopes по ресурсам из claim "artstore_grants".
+	Grants grants.Set
 }
 
 // HasRole проверяет, есть ли у субъекта указанная роль (effective).
@@ -90,6 +103,19 @@ func (c *AuthClaims) HasAnyRole(roles ...string) bool {
 	return false
 }
 
+// ApplyAssignment заполняет роли и разрешения пользователя.
+func (c *AuthClaims) ApplyAssignment(a rbac.Assignment) {
+	c.IdpRole = a.IdpRole
+	c.AssignedRoles = a.Roles
+	c.EffectiveRole = a.EffectiveRole
+	c.Permissions = a.Permissions
+}
+
+// HasPermission проверяет наличие у пользователя указанного разрешения.
+func (c *AuthClaims) HasPermission(p rbac.Permission) bool {
+	return c.Permissions.Has(p)
+}
+
 // HasScope проверяет наличие указанного scope.
 func (c *AuthClaims) HasScope(scope string) bool {
 	for _, s := range c.Scopes {
@@ -110,6 +136,34 @@ func (c *AuthClaims) HasAnyScope(scopes ...string) bool {
 	return false
 }
 
+// AllowsGrant проверяет доступ к ресурсу с учётом grants SA.
+// Для Admin User grants не применяются.
+func (c *AuthClaims) AllowsGrant(scope string, access grants.Access) bool {
+	if c.SubjectType != SubjectTypeSA {
+		return true
+	}
+	return c.Grants.Allows(scope, access, c.Subject, c.ClientID)
+}
+
+// CollectionSubject возвращает субъект для проверки членства в коллекциях:
+// username пользователя или client_id SA.
+func (c *AuthClaims) CollectionSubject() model.CollectionSubject {
+	if c.SubjectType == SubjectTypeSA {
+		return model.CollectionSubject{Type: model.CollectionMemberServiceAccount, ID: c.ClientID}
+	}
+	return model.CollectionSubject{Type: model.CollectionMemberUser, ID: c.PreferredUsername}
+}
+
+// CollectionScope возвращает ограничение доступа к файлам коллекций с
+// правом permission. Пользователю с разрешением collections:write доступны
+// все коллекции (nil).
+func (c *AuthClaims) CollectionScope(permission string) *model.CollectionScope {
+	if c.SubjectType == SubjectTypeUser && c.HasPermission(rbac.PermCollectionsWrite) {
+		return nil
+	}
+	return &model.CollectionScope{Subject: c.CollectionSubject(), Permission: permission}
+}
+
 // RoleOverrideProvider — интерфейс для получения role override из БД.
 // Реализуется repository.RoleOverrideRepository.
 type RoleOverrideProvider interface {
@@ -135,6 +189,8 @@ type keycloakClaims struct {
 	ClientID string `json:"client_id,omitempty"`
 	// Azp — authorized party (ID клиента в Keycloak).
 	Azp string `json:"azp,omitempty"`
+	// Grants — ограничения scopes SA по ресурсам.
+	Grants grants.Set `json:"artstore_grants,omitempty"`
 }
 
 // realmAccess — вложенная структура realm_access в Keycloak JWT.
@@ -142,15 +198,17 @@ type realmAccess struct {
 	Roles []string `json:"roles"`
 }
 
-// JWTAuth — middleware для JWT-аутентификации через JWKS Keycloak.
+// JWTAuth — middleware для JWT-аутентификации через JWKS IdP (Keycloak или локального).
 type JWTAuth struct {
-	jwks           keyfunc.Keyfunc
-	logger         *slog.Logger
-	roleProvider   RoleOverrideProvider
-	adminGroups    []string
-	readonlyGroups []string
-	issuer         string
-	jwtLeeway      time.Duration
+	jwks         keyfunc.Keyfunc
+	logger       *slog.Logger
+	roleProvider RoleOverrideProvider
+	// roles — каталог ролей (по умолчанию — встроенные роли с группами из конфигурации)
+	roles     rbac.CatalogProvider
+	issuer    string
+	jwtLeeway time.Duration
+	// personalTokens — проверка personal access tokens (nil — не принимаются)
+	personalTokens PersonalTokenResolver
 }
 
 // NewJWTAuth создаёт JWT middleware с JWKS из Keycloak.
@@ -158,7 +216,8 @@ type JWTAuth struct {
 // caCertPath — опциональный путь к CA-сертификату для TLS.
 // issuer — ожидаемый issuer JWT (обычно https://keycloak/realms/artstore).
 // roleProvider — провайдер role overrides из БД (может быть nil).
-// adminGroups, readonlyGroups — группы для маппинга в роли.
+// adminGroups, readonlyGroups — группы встроенных ролей admin и readonly
+// (каталог с пользовательскими ролями подключается через SetRoleCatalog).
 // jwksClientTimeout — таймаут HTTP-клиента JWKS (AM_JWKS_CLIENT_TIMEOUT).
 // jwksRefreshInterval — интервал обновления JWKS-ключей (AM_JWKS_REFRESH_INTERVAL).
 // jwtLeeway — допустимое отклонение времени при проверке JWT (AM_JWT_LEEWAY).
@@ -203,6 +262,20 @@ func NewJWTAuth(
 		return nil, fmt.Errorf("создание JWKS storage: %w", err)
 	}
 
+	return NewJWTAuthFromStorage(storage, issuer, roleProvider, adminGroups, readonlyGroups, jwtLeeway, logger)
+}
+
+// NewJWTAuthFromStorage создаёт JWT middleware с готовым хранилищем JWKS.
+// Используется локальным IdP (AM_IDP_PROVIDER=local), ключи которого
+// находятся в памяти процесса и не требуют загрузки по HTTP.
+func NewJWTAuthFromStorage(
+	storage jwkset.Storage,
+	issuer string,
+	roleProvider RoleOverrideProvider,
+	adminGroups, readonlyGroups []string,
+	jwtLeeway time.Duration,
+	logger *slog.Logger,
+) (*JWTAuth, error) {
 	k, err := keyfunc.New(keyfunc.Options{
 		Storage: storage,
 	})
@@ -211,13 +284,12 @@ func NewJWTAuth(
 	}
 
 	return &JWTAuth{
-		jwks:           k,
-		logger:         logger.With(slog.String("component", "jwt_auth")),
-		roleProvider:   roleProvider,
-		adminGroups:    adminGroups,
-		readonlyGroups: readonlyGroups,
-		issuer:         issuer,
-		jwtLeeway:      jwtLeeway,
+		jwks:         k,
+		logger:       logger.With(slog.String("component", "jwt_auth")),
+		roleProvider: roleProvider,
+		roles:        rbac.NewCatalog(rbac.BuiltinRoles(adminGroups, readonlyGroups)...),
+		issuer:       issuer,
+		jwtLeeway:    jwtLeeway,
 	}, nil
 }
 
@@ -255,18 +327,23 @@ func NewJWTAuthWithKeyfunc(
 	logger *slog.Logger,
 ) *JWTAuth {
 	return &JWTAuth{
-		jwks:           kf,
-		logger:         logger.With(slog.String("component", "jwt_auth")),
-		roleProvider:   roleProvider,
-		adminGroups:    adminGroups,
-		readonlyGroups: readonlyGroups,
-		issuer:         issuer,
+		jwks:         kf,
+		logger:       logger.With(slog.String("component", "jwt_auth")),
+		roleProvider: roleProvider,
+		roles:        rbac.NewCatalog(rbac.BuiltinRoles(adminGroups, readonlyGroups)...),
+		issuer:       issuer,
 	}
 }
 
+// SetRoleCatalog подключает каталог ролей (встроенные и пользовательские
+// роли из БД) вместо статического каталога встроенных ролей.
+func (j *JWTAuth) SetRoleCatalog(roles rbac.CatalogProvider) {
+	j.roles = roles
+}
+
 // Middleware возвращает HTTP middleware для JWT-аутентификации.
-// Извлекает Bearer token, валидирует подпись (RS256), извлекает claims,
-// определяет тип субъекта, вычисляет effective role и помещает в контекст.
+// Извлекает Bearer token, валидирует подпись (RS256 или ES256), извлекает claims,
+// определяет тип субъекта, вычисляет роли и разрешения и помещает в контекст.
 func (j *JWTAuth) Middleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -289,10 +366,15 @@ func (j *JWTAuth) Middleware() func(http.Handler) http.Handler {
 				return
 			}
 
+			if strings.HasPrefix(tokenString, PersonalTokenPrefix) {
+				j.servePersonalToken(w, r, next, tokenString)
+				return
+			}
+
 			// Парсинг и валидация JWT через JWKS
 			rawClaims := &keycloakClaims{}
 			parserOpts := []jwt.ParserOption{
-				jwt.WithValidMethods([]string{"RS256"}),
+				jwt.WithValidMethods([]string{"RS256", "ES256"}),
 				jwt.WithExpirationRequired(),
 				jwt.WithLeeway(j.jwtLeeway),
 			}
@@ -350,6 +432,7 @@ func (j *JWTAuth) buildAuthClaims(ctx context.Context, raw *keycloakClaims) *Aut
 		claims.SubjectType = SubjectTypeSA
 		claims.ClientID = raw.ClientID
 		claims.Scopes = parseScopeString(raw.Scope)
+		claims.Grants = raw.Grants
 	} else {
 		// Admin User (Authorization Code flow)
 		claims.SubjectType = SubjectTypeUser
@@ -362,38 +445,30 @@ func (j *JWTAuth) buildAuthClaims(ctx context.Context, raw *keycloakClaims) *Aut
 		// Группы
 		claims.Groups = raw.Groups
 
-		// Маппинг групп → роль через RBAC
-		claims.IdpRole = rbac.MapGroupsToRole(claims.Groups, j.adminGroups, j.readonlyGroups)
+		// Маппинг групп → роли по каталогу (если группы не дали ролей —
+		// через realm_access.roles) и role override
+		j.resolveRoles(ctx, claims)
+	}
 
-		// Если роль не определена через группы, пробуем через realm_access.roles
-		if claims.IdpRole == "" && len(claims.Roles) > 0 {
-			var mappedRoles []string
-			for _, r := range claims.Roles {
-				if rbac.IsValidRole(r) {
-					mappedRoles = append(mappedRoles, r)
-				}
-			}
-			claims.IdpRole = rbac.HighestRole(mappedRoles)
-		}
+	return claims
+}
 
-		// Применяем role override из БД
-		if j.roleProvider != nil {
-			override, err := j.roleProvider.GetRoleOverride(ctx, claims.Subject)
-			if err != nil {
-				j.logger.Warn("Ошибка получения role override",
-					slog.String("user_id", claims.Subject),
-					slog.String("error", err.Error()),
-				)
-			} else {
-				claims.RoleOverride = override
-			}
+// resolveRoles применяет role override из БД и вычисляет по каталогу
+// роли и разрешения пользователя.
+func (j *JWTAuth) resolveRoles(ctx context.Context, claims *AuthClaims) {
+	if j.roleProvider != nil {
+		override, err := j.roleProvider.GetRoleOverride(ctx, claims.Subject)
+		if err != nil {
+			j.logger.Warn("Ошибка получения role override",
+				slog.String("user_id", claims.Subject),
+				slog.String("error", err.Error()),
+			)
+		} else {
+			claims.RoleOverride = override
 		}
-
-		// Вычисляем effective role
-		claims.EffectiveRole = rbac.EffectiveRole(claims.IdpRole, claims.RoleOverride)
 	}
 
-	return claims
+	claims.ApplyAssignment(j.roles.Catalog().Resolve(claims.Groups, claims.Roles, claims.RoleOverride))
 }
 
 // parseScopeString разбирает строку scopes из JWT (space-separated).
@@ -510,6 +585,13 @@ func ClaimsFromContext(ctx context.Context) *AuthClaims {
 	return claims
 }
 
+// WithClaims возвращает контекст с AuthClaims.
+// Используется Admin UI, чтобы сервисный слой получал субъекта
+// одинаково для API (JWT) и UI (сессия).
+func WithClaims(ctx context.Context, claims *AuthClaims) context.Context {
+	return context.WithValue(ctx, ContextKeyClaims, claims)
+}
+
 // SubjectFromContext извлекает sub из контекста запроса.
 // Возвращает пустую строку, если claims не найдены.
 func SubjectFromContext(ctx context.Context) string {
@@ -17,6 +17,9 @@ import (
 
 	"github.com/MicahParks/keyfunc/v3"
 	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 )
 
 // testKeyID — идентификатор ключа для тестов.
@@ -255,6 +258,69 @@ func TestJWTAuth_ValidSAToken(t *testing.T) {
 	}
 }
 
+// TestJWTAuth_SAGrants — claim artstore_grants ограничивает scopes SA по ресурсам.
+func TestJWTAuth_SAGrants(t *testing.T) {
+	key := generateTestKey(t)
+	auth := newTestJWTAuth(t, key, nil)
+
+	var got *AuthClaims
+	handler := auth.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = ClaimsFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"sub":       "sa-uuid-456",
+		"client_id": "sa_ingester_abc123",
+		"scope":     "files:read files:write",
+		"iss":       "https://keycloak.test/realms/artstore",
+		"exp":       jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		"iat":       jwt.NewNumericDate(time.Now()),
+		"artstore_grants": []map[string]any{
+			{"scope": "files:write", "se": []string{"se-edge-1"}, "retention": []string{"temporary"}},
+			{"scope": "files:read", "se": nil, "own": true},
+		},
+	})
+	token.Header["kid"] = testKeyID
+	tokenStr, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/files", nil)
+	req.Header.Set("Authorization", "Bearer "+tokenStr)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || got == nil {
+		t.Fatalf("ожидался статус 200, получен %d, тело: %s", rec.Code, rec.Body.String())
+	}
+	if len(got.Grants) != 2 {
+		t.Fatalf("ожидалось 2 grants, получено %d", len(got.Grants))
+	}
+
+	if !got.AllowsGrant("files:write", grants.Access{SE: []string{"se-edge-1"}, Retention: "temporary"}) {
+		t.Error("загрузка на разрешённый SE должна разрешаться")
+	}
+	if got.AllowsGrant("files:write", grants.Access{SE: []string{"se-core-1"}, Retention: "temporary"}) {
+		t.Error("загрузка на SE вне grants должна запрещаться")
+	}
+	if got.AllowsGrant("files:write", grants.Access{SE: []string{"se-edge-1"}, Retention: "permanent"}) {
+		t.Error("permanent-файл при grant только на temporary должен запрещаться")
+	}
+	if !got.AllowsGrant("files:read", grants.Access{UploadedBy: "sa_ingester_abc123"}) {
+		t.Error("чтение своего файла должно разрешаться")
+	}
+	if got.AllowsGrant("files:read", grants.Access{UploadedBy: "sa_other"}) {
+		t.Error("чтение чужого файла должно запрещаться")
+	}
+
+	user := &AuthClaims{SubjectType: SubjectTypeUser, Grants: got.Grants}
+	if !user.AllowsGrant("files:read", grants.Access{UploadedBy: "sa_other"}) {
+		t.Error("grants не должны применяться к Admin User")
+	}
+}
+
 // TestJWTAuth_MissingToken — отсутствие Authorization header.
 func TestJWTAuth_MissingToken(t *testing.T) {
 	key := generateTestKey(t)
@@ -460,6 +526,65 @@ func TestJWTAuth_GroupMapping(t *testing.T) {
 	}
 }
 
+// TestJWTAuth_CustomRoleCatalog — пользовательские роли из каталога:
+// группы дают несколько ролей, override добавляет разрешения.
+func TestJWTAuth_CustomRoleCatalog(t *testing.T) {
+	key := generateTestKey(t)
+	curator := "file-curator"
+	auth := newTestJWTAuth(t, key, &mockRoleProvider{
+		overrides: map[string]*string{"user-ops": &curator},
+	})
+
+	roles := rbac.BuiltinRoles([]string{"artstore-admins"}, []string{"artstore-viewers"})
+	roles = append(roles,
+		rbac.Role{
+			Name:        "storage-operator",
+			Permissions: []rbac.Permission{rbac.PermStorageRead, rbac.PermStorageSync},
+			Groups:      []string{"storage-ops"},
+		},
+		rbac.Role{
+			Name:        curator,
+			Permissions: []rbac.Permission{rbac.PermFilesRead, rbac.PermFilesWrite},
+		},
+	)
+	auth.SetRoleCatalog(rbac.NewCatalog(roles...))
+
+	handler := auth.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := ClaimsFromContext(r.Context())
+		if claims == nil {
+			t.Fatal("claims не найдены")
+		}
+		if claims.IdpRole != "storage-operator" {
+			t.Errorf("ожидался IdpRole=storage-operator, получен %q", claims.IdpRole)
+		}
+		if len(claims.AssignedRoles) != 2 {
+			t.Errorf("ожидались 2 роли, получены %v", claims.AssignedRoles)
+		}
+		for _, p := range []rbac.Permission{rbac.PermStorageSync, rbac.PermFilesWrite} {
+			if !claims.HasPermission(p) {
+				t.Errorf("ожидалось разрешение %s", p)
+			}
+		}
+		if claims.HasPermission(rbac.PermUsersRead) || claims.HasPermission(rbac.PermStorageWrite) {
+			t.Error("лишние разрешения у пользовательских ролей")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tokenStr := generateUserToken(t, key, "user-ops", "ops", "ops@test.com",
+		nil, []string{"storage-ops"}, false)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin-auth/me", nil)
+	req.Header.Set("Authorization", "Bearer "+tokenStr)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("ожидался статус 200, получен %d", rec.Code)
+	}
+}
+
 // --- Тесты RBAC middleware ---
 
 // TestRequireRole_HasRole — пользователь с нужной ролью.
@@ -62,6 +62,7 @@ func RequestLogger(logger *slog.Logger) func(http.Handler) http.Handler {
 				slog.Duration("duration", duration),
 				slog.Int64("bytes", wrapped.written),
 				slog.String("remote_addr", r.RemoteAddr),
+				slog.String("request_id", RequestIDFromContext(r.Context())),
 			)
 		})
 	}
@@ -86,11 +86,16 @@ func normalizePath(path string) string {
 		"/api/v1/admin-auth/me",
 		"/api/v1/admin-users",
 		"/api/v1/service-accounts",
+		"/api/v1/collections",
 		"/api/v1/storage-elements",
 		"/api/v1/storage-elements/discover",
 		"/api/v1/files",
+		"/api/v1/sync-jobs",
 		"/api/v1/idp/status",
-		"/api/v1/idp/sync-sa":
+		"/api/v1/idp/sync-sa",
+		"/api/v1/keys/status",
+		"/api/v1/keys/rotate",
+		"/api/v1/personal-tokens":
 		return path
 	}
 
@@ -101,8 +106,11 @@ func normalizePath(path string) string {
 	}{
 		{"/api/v1/admin-users/", "/api/v1/admin-users/{id}"},
 		{"/api/v1/service-accounts/", "/api/v1/service-accounts/{id}"},
+		{"/api/v1/collections/", "/api/v1/collections/{id}"},
 		{"/api/v1/storage-elements/", "/api/v1/storage-elements/{id}"},
 		{"/api/v1/files/", "/api/v1/files/{id}"},
+		{"/api/v1/sync-jobs/", "/api/v1/sync-jobs/{id}"},
+		{"/api/v1/personal-tokens/", "/api/v1/personal-tokens/{id}"},
 	}
 
 	for _, p := range prefixes {
@@ -119,6 +127,10 @@ func normalizePath(path string) string {
 				return p.result + "/rotate-secret"
 			case "/sync":
 				return p.result + "/sync"
+			case "/cancel":
+				return p.result + "/cancel"
+			case "/members":
+				return p.result + "/members"
 			default:
 				return p.result
 			}
@@ -0,0 +1,114 @@
+// personal_token.go — аутентификация по personal access token пользователя.
+// Токен с префиксом PersonalTokenPrefix принимается в Authorization: Bearer
+// наравне с JWT: запрос выполняется от имени владельца с его текущей
+// effective ролью, а scopes токена дополнительно ограничивают области API.
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	apierrors "github.com/bigkaa/goartstore/admin-module/internal/api/errors"
+)
+
+// PersonalTokenPrefix — префикс personal access token Admin Module.
+// По нему middleware отличает токен от JWT.
+const PersonalTokenPrefix = "amp_"
+
+// PersonalTokensPath — endpoints управления personal access tokens.
+// Они недоступны по самому personal access token, иначе токен с узкими
+// scopes мог бы выпустить себе токен с более широкими.
+const PersonalTokensPath = "/api/v1/personal-tokens"
+
+// PersonalTokenOwner — владелец действующего personal access token.
+type PersonalTokenOwner struct {
+	// TokenID — UUID токена
+	TokenID string
+	// Subject — ID пользователя в IdP
+	Subject string
+	// Username — имя пользователя
+	Username string
+	// Email — email пользователя
+	Email string
+	// Groups — текущие группы пользователя в IdP
+	Groups []string
+	// Scopes — scopes токена
+	Scopes []string
+}
+
+// PersonalTokenResolver — проверка personal access tokens.
+// Реализуется service.PersonalTokenService.
+type PersonalTokenResolver interface {
+	// ResolvePersonalToken возвращает владельца токена. Ошибка — токен
+	// неизвестен, истёк, отозван или пользователь отключён в IdP.
+	ResolvePersonalToken(ctx context.Context, token string) (*PersonalTokenOwner, error)
+}
+
+// SetPersonalTokenResolver включает приём personal access tokens.
+func (j *JWTAuth) SetPersonalTokenResolver(resolver PersonalTokenResolver) {
+	j.personalTokens = resolver
+}
+
+// servePersonalToken аутентифицирует запрос по personal access token:
+// проверяет токен, вычисляет роль владельца и scope области API.
+func (j *JWTAuth) servePersonalToken(w http.ResponseWriter, r *http.Request, next http.Handler, token string) {
+	if j.personalTokens == nil {
+		apierrors.Unauthorized(w, "Personal access tokens не поддерживаются")
+		return
+	}
+
+	owner, err := j.personalTokens.ResolvePersonalToken(r.Context(), token)
+	if err != nil {
+		j.logger.Debug("Personal access token отклонён",
+			slog.String("error", err.Error()),
+			slog.String("remote_addr", r.RemoteAddr),
+		)
+		apierrors.Unauthorized(w, "Невалидный, истёкший или отозванный personal access token")
+		return
+	}
+
+	claims := &AuthClaims{
+		Subject:           owner.Subject,
+		SubjectType:       SubjectTypeUser,
+		PreferredUsername: owner.Username,
+		Email:             owner.Email,
+		Groups:            owner.Groups,
+		Scopes:            owner.Scopes,
+		TokenID:           owner.TokenID,
+	}
+	j.resolveRoles(r.Context(), claims)
+
+	if strings.HasPrefix(r.URL.Path, PersonalTokensPath) {
+		apierrors.Forbidden(w, "Управление personal access tokens недоступно по personal access token")
+		return
+	}
+	if scope := PersonalTokenScope(r.Method, r.URL.Path); !claims.HasScope(scope) {
+		apierrors.Forbidden(w, fmt.Sprintf("Недостаточно прав: personal access token без scope %s", scope))
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), ContextKeyClaims, claims)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PersonalTokenScope возвращает scope, который нужен personal access token
+// для запроса. Область определяется путём (файлы, Storage Elements и
+// задачи синхронизации, остальное — администрирование), уровень — методом:
+// GET и HEAD требуют :read, остальные методы — :write.
+func PersonalTokenScope(method, path string) string {
+	area := "admin"
+	switch {
+	case strings.HasPrefix(path, "/api/v1/files"):
+		area = "files"
+	case strings.HasPrefix(path, "/api/v1/storage-elements"), strings.HasPrefix(path, "/api/v1/sync-jobs"):
+		area = "storage"
+	}
+
+	if method == http.MethodGet || method == http.MethodHead {
+		return area + ":read"
+	}
+	return area + ":write"
+}
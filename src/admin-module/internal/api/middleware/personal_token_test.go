@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// mockPersonalTokens — мок PersonalTokenResolver.
+type mockPersonalTokens struct {
+	owners map[string]*PersonalTokenOwner
+}
+
+func (m *mockPersonalTokens) ResolvePersonalToken(_ context.Context, token string) (*PersonalTokenOwner, error) {
+	owner, ok := m.owners[token]
+	if !ok {
+		return nil, errors.New("токен не найден")
+	}
+	return owner, nil
+}
+
+// newPersonalTokenAuth создаёт JWTAuth, принимающий токены amp_viewer
+// (группа readonly, override admin) и amp_admin.
+func newPersonalTokenAuth(t *testing.T) *JWTAuth {
+	t.Helper()
+	adminRole := "admin"
+	auth := newTestJWTAuth(t, generateTestKey(t), &mockRoleProvider{
+		overrides: map[string]*string{"user-viewer": &adminRole},
+	})
+	auth.SetPersonalTokenResolver(&mockPersonalTokens{owners: map[string]*PersonalTokenOwner{
+		"amp_viewer": {
+			TokenID: "token-1", Subject: "user-viewer", Username: "viewer",
+			Groups: []string{"artstore-viewers"}, Scopes: []string{"files:read", "admin:read"},
+		},
+		"amp_admin": {
+			TokenID: "token-2", Subject: "user-admin", Username: "admin",
+			Groups: []string{"artstore-admins"}, Scopes: []string{"files:write"},
+		},
+	}})
+	return auth
+}
+
+// servePersonalTokenRequest выполняет запрос с Bearer token и возвращает
+// код ответа и claims, переданные handler.
+func servePersonalTokenRequest(auth *JWTAuth, method, path, token string) (int, *AuthClaims) {
+	var claims *AuthClaims
+	handler := auth.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims = ClaimsFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code, claims
+}
+
+func TestJWTAuth_PersonalToken(t *testing.T) {
+	auth := newPersonalTokenAuth(t)
+
+	code, claims := servePersonalTokenRequest(auth, http.MethodGet, "/api/v1/files", "amp_viewer")
+	if code != http.StatusOK || claims == nil {
+		t.Fatalf("статус = %d, ожидался 200", code)
+	}
+	if claims.SubjectType != SubjectTypeUser || claims.Subject != "user-viewer" || claims.TokenID != "token-1" {
+		t.Errorf("claims = %+v, ожидался пользователь user-viewer с token-1", claims)
+	}
+	// Роль владельца: readonly из групп + override admin
+	if claims.IdpRole != "readonly" || claims.EffectiveRole != "admin" {
+		t.Errorf("IdpRole = %s, EffectiveRole = %s, ожидались readonly и admin", claims.IdpRole, claims.EffectiveRole)
+	}
+}
+
+func TestJWTAuth_PersonalTokenDenied(t *testing.T) {
+	auth := newPersonalTokenAuth(t)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		want   int
+	}{
+		{"нет scope files:write", http.MethodDelete, "/api/v1/files/f1", "amp_viewer", http.StatusForbidden},
+		{"нет scope storage:read", http.MethodGet, "/api/v1/storage-elements", "amp_viewer", http.StatusForbidden},
+		{"write не даёт read", http.MethodGet, "/api/v1/files", "amp_admin", http.StatusForbidden},
+		{"управление токенами", http.MethodPost, PersonalTokensPath, "amp_admin", http.StatusForbidden},
+		{"неизвестный токен", http.MethodGet, "/api/v1/files", "amp_unknown", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code, _ := servePersonalTokenRequest(auth, tt.method, tt.path, tt.token); code != tt.want {
+				t.Errorf("статус = %d, ожидался %d", code, tt.want)
+			}
+		})
+	}
+
+	t.Run("приём не включён", func(t *testing.T) {
+		plain := newTestJWTAuth(t, generateTestKey(t), nil)
+		if code, _ := servePersonalTokenRequest(plain, http.MethodGet, "/api/v1/files", "amp_viewer"); code != http.StatusUnauthorized {
+			t.Errorf("статус = %d, ожидался 401", code)
+		}
+	})
+}
+
+func TestPersonalTokenScope(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{http.MethodGet, "/api/v1/files/f1", "files:read"},
+		{http.MethodPost, "/api/v1/files/batch", "files:write"},
+		{http.MethodHead, "/api/v1/storage-elements/se1", "storage:read"},
+		{http.MethodPost, "/api/v1/sync-jobs/j1/cancel", "storage:write"},
+		{http.MethodGet, "/api/v1/audit-events", "admin:read"},
+		{http.MethodPut, "/api/v1/admin-users/u1/role-override", "admin:write"},
+	}
+	for _, tt := range tests {
+		if got := PersonalTokenScope(tt.method, tt.path); got != tt.want {
+			t.Errorf("PersonalTokenScope(%s, %s) = %s, ожидался %s", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// request_id.go — middleware идентификации запроса: X-Request-ID и IP-адрес клиента.
+// Значения используются в логах и журнале аудита.
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// HeaderRequestID — заголовок с идентификатором запроса.
+	HeaderRequestID = "X-Request-ID"
+
+	// contextKeyRequestID — идентификатор запроса в контексте.
+	contextKeyRequestID contextKey = "request_id"
+	// contextKeySourceIP — IP-адрес клиента в контексте.
+	contextKeySourceIP contextKey = "source_ip"
+)
+
+// maxRequestIDLength — максимальная длина X-Request-ID, принимаемого от клиента.
+const maxRequestIDLength = 128
+
+// RequestID возвращает middleware, помещающий в контекст идентификатор
+// запроса и IP-адрес клиента. X-Request-ID берётся из входящего заголовка
+// (проставляется API Gateway) или генерируется, и возвращается в ответе.
+// trustedProxies — сети прокси, которым доверяется X-Forwarded-For
+// (AM_TRUSTED_PROXIES); пустой список — только адрес соединения.
+func RequestID(trustedProxies []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(HeaderRequestID)
+			if requestID == "" || len(requestID) > maxRequestIDLength {
+				requestID = uuid.New().String()
+			}
+			w.Header().Set(HeaderRequestID, requestID)
+
+			ctx := context.WithValue(r.Context(), contextKeyRequestID, requestID)
+			ctx = context.WithValue(ctx, contextKeySourceIP, clientIP(r, trustedProxies))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequestIDFromContext возвращает идентификатор запроса из контекста.
+// Возвращает пустую строку, если запрос не прошёл через RequestID.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKeyRequestID).(string)
+	return id
+}
+
+// SourceIPFromContext возвращает IP-адрес клиента из контекста.
+// Возвращает пустую строку, если запрос не прошёл через RequestID.
+func SourceIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(contextKeySourceIP).(string)
+	return ip
+}
+
+// clientIP определяет IP-адрес клиента. По умолчанию это адрес соединения.
+// Если соединение пришло от доверенного прокси, X-Forwarded-For
+// просматривается справа налево: каждый адрес добавлен предыдущим прокси,
+// и первый недоверенный адрес — клиент. Левые элементы, которые клиент
+// может подставить сам, без цепочки доверенных прокси не учитываются.
+func clientIP(r *http.Request, trustedProxies []netip.Prefix) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return host
+	}
+	addr = addr.Unmap()
+
+	var hops []string
+	for _, xff := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(xff, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0 && isTrustedProxy(addr, trustedProxies); i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		addr = hop.Unmap()
+	}
+	return addr.String()
+}
+
+// isTrustedProxy проверяет, входит ли адрес в сети доверенных прокси.
+func isTrustedProxy(addr netip.Addr, trustedProxies []netip.Prefix) bool {
+	for _, p := range trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+// TestRequestID проверяет проброс X-Request-ID и определение IP клиента.
+func TestRequestID(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	tests := []struct {
+		name       string
+		requestID  string
+		forwarded  string
+		remoteAddr string
+		wantIP     string
+	}{
+		{name: "заголовки от gateway", requestID: "req-1", forwarded: "203.0.113.7, 10.0.0.2", remoteAddr: "10.0.0.1:5000", wantIP: "203.0.113.7"},
+		{name: "подделанный XFF через gateway", forwarded: "198.51.100.1, 203.0.113.7", remoteAddr: "10.0.0.1:5000", wantIP: "203.0.113.7"},
+		{name: "XFF от недоверенного адреса", forwarded: "198.51.100.1", remoteAddr: "192.0.2.10:41000", wantIP: "192.0.2.10"},
+		{name: "некорректный XFF", forwarded: "unknown", remoteAddr: "10.0.0.1:5000", wantIP: "10.0.0.1"},
+		{name: "прямое соединение", remoteAddr: "192.0.2.10:41000", wantIP: "192.0.2.10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotID, gotIP string
+			handler := RequestID(trusted)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				gotID = RequestIDFromContext(r.Context())
+				gotIP = SourceIPFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/files", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.requestID != "" {
+				req.Header.Set(HeaderRequestID, tt.requestID)
+			}
+			if tt.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if gotID == "" || (tt.requestID != "" && gotID != tt.requestID) {
+				t.Errorf("request_id = %q, ожидался %q", gotID, tt.requestID)
+			}
+			if rec.Header().Get(HeaderRequestID) != gotID {
+				t.Errorf("заголовок ответа = %q, ожидался %q", rec.Header().Get(HeaderRequestID), gotID)
+			}
+			if gotIP != tt.wantIP {
+				t.Errorf("source_ip = %q, ожидался %q", gotIP, tt.wantIP)
+			}
+		})
+	}
+}
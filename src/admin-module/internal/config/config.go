@@ -3,8 +3,10 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
 	"log/slog"
+	"net/netip"
 	"net/url"
 	"os"
 	"strconv"
@@ -15,6 +17,12 @@ import (
 // Версия приложения, задаётся при сборке через -ldflags.
 var Version = "dev"
 
+// Значения AM_IDP_PROVIDER.
+const (
+	IdPProviderKeycloak = "keycloak"
+	IdPProviderLocal    = "local"
+)
+
 // Config содержит все параметры конфигурации Admin Module.
 type Config struct {
 	// --- Сервер ---
@@ -25,6 +33,9 @@ type Config struct {
 	LogLevel slog.Level
 	// Формат логов (json, text)
 	LogFormat string
+	// Сети прокси (API Gateway), которым доверяется X-Forwarded-For.
+	// Пустой список — IP клиента берётся только из адреса соединения.
+	TrustedProxies []netip.Prefix
 
 	// --- PostgreSQL ---
 
@@ -74,6 +85,38 @@ type Config struct {
 	// Таймаут простоя HTTP-сервера (по умолчанию 120s)
 	HTTPIdleTimeout time.Duration
 
+	// --- Identity Provider ---
+
+	// Провайдер учётных записей: keycloak (по умолчанию) или local (встроенный IdP на PostgreSQL)
+	IdPProvider string
+	// Issuer токенов локального IdP — внешний URL Admin Module (обязателен при local)
+	LocalIdPIssuer string
+	// Срок действия access token локального IdP (по умолчанию 5m)
+	LocalIdPTokenTTL time.Duration
+	// Срок действия refresh token пользователей Admin UI (по умолчанию 8h)
+	LocalIdPRefreshTokenTTL time.Duration
+	// Имя первичного администратора локального IdP (по умолчанию admin)
+	LocalIdPAdminUsername string
+	// Пароль первичного администратора; создаётся при пустой таблице пользователей
+	LocalIdPAdminPassword string //nolint:gosec // G117: пароль первичного администратора
+	// Неудачных попыток входа подряд до блокировки (по умолчанию 5)
+	LocalIdPMaxFailedLogins int
+	// Длительность блокировки входа (по умолчанию 15m)
+	LocalIdPLockoutDuration time.Duration
+
+	// --- Ключи подписи JWT (локальный IdP) ---
+
+	// Мастер-ключ шифрования ключей подписи в БД (обязателен при local)
+	KeysMasterKey string //nolint:gosec // G117: мастер-ключ шифрования
+	// Алгоритм новых ключей подписи: RS256 (по умолчанию) или ES256
+	KeysAlgorithm string
+	// Период плановой ротации ключей (по умолчанию 720h, 0 — только ручная)
+	KeysRotationInterval time.Duration
+	// Сколько заменённый ключ остаётся в JWKS (по умолчанию 24h)
+	KeysOverlap time.Duration
+	// За сколько новый ключ публикуется до начала подписи (по умолчанию 5m)
+	KeysPublishLead time.Duration
+
 	// --- Keycloak ---
 
 	// URL Keycloak (например, https://keycloak.kryukov.lan)
@@ -120,8 +163,66 @@ type Config struct {
 	SyncInterval time.Duration
 	// Размер страницы при постраничной синхронизации файлов
 	SyncPageSize int
+	// Интервал полной синхронизации (периодические между ними — инкрементальные)
+	SyncFullInterval time.Duration
 	// Интервал синхронизации SA с Keycloak
 	SASyncInterval time.Duration
+	// Интервал повтора записи изменений файлов на недоступный SE
+	SEWriteRetryInterval time.Duration
+
+	// --- Репликация ---
+
+	// Требуемое число копий permanent-файла (1 — репликация отключена)
+	ReplicationFactor int
+	// Политика выбора SE для реплик: most_free, round_robin
+	ReplicationPolicy string
+	// Ключ метки SE, по которому распределяются копии файла (пустой — не учитывается)
+	ReplicationSpreadLabel string
+	// Интервал поиска файлов без достаточного числа реплик
+	ReplicationInterval time.Duration
+
+	// --- Размещение файлов ---
+
+	// Политика выбора SE по умолчанию: most_free, round_robin, weighted, prefer_labels
+	PlacementPolicy string
+	// Время жизни резервирования места на выбранном SE
+	PlacementReservationTTL time.Duration
+
+	// --- История ёмкости ---
+
+	// Срок хранения снимков ёмкости SE
+	CapacityHistoryRetention time.Duration
+	// Период истории, по которому строится прогноз заполнения
+	CapacityForecastWindow time.Duration
+
+	// --- Оповещения и webhooks ---
+
+	// Интервал проверки правил оповещений
+	AlertEvalInterval time.Duration
+	// Таймаут HTTP-запроса доставки webhook
+	WebhookTimeout time.Duration
+	// Максимальное число попыток доставки webhook
+	WebhookMaxAttempts int
+	// Срок хранения журнала доставок webhook
+	WebhookDeliveryRetention time.Duration
+
+	// --- Секреты Service Accounts ---
+
+	// Срок действия секрета SA (0 — без истечения)
+	SASecretLifetime time.Duration
+	// Период, в течение которого старый секрет действует после ротации (0 — сразу недействителен)
+	SASecretRotationGrace time.Duration
+	// За сколько до истечения секрет считается «скоро истекающим»
+	SASecretExpiryWarning time.Duration
+	// Интервал проверки истёкших секретов
+	SASecretCheckInterval time.Duration
+
+	// --- Personal access tokens ---
+
+	// Максимальный срок действия personal access token
+	PATMaxTTL time.Duration
+	// Максимальное количество действующих токенов одного пользователя
+	PATMaxPerUser int
 
 	// --- Маппинг групп → ролей ---
 
@@ -137,6 +238,10 @@ type Config struct {
 	// Секрет для шифрования UI-сессий (AES-256-GCM).
 	// Если пустой — генерируется автоматически при старте (непостоянный между рестартами).
 	UISessionSecret string
+	// Таймаут неактивности UI-сессии (по умолчанию 1h)
+	UISessionIdleTimeout time.Duration
+	// Максимальное время жизни UI-сессии с момента входа (по умолчанию 24h)
+	UISessionAbsoluteTimeout time.Duration
 	// Client ID для OIDC-аутентификации Admin UI через Keycloak
 	UIOIDCClientID string
 	// Внешний URL Keycloak, доступный из браузера (для OAuth2 redirects).
@@ -183,6 +288,12 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("AM_LOG_FORMAT: недопустимое значение %q, допустимые: json, text", cfg.LogFormat)
 	}
 
+	// AM_TRUSTED_PROXIES — CIDR доверенных прокси через запятую (по умолчанию пусто)
+	cfg.TrustedProxies, err = parsePrefixes(getEnvDefault("AM_TRUSTED_PROXIES", ""))
+	if err != nil {
+		return nil, fmt.Errorf("AM_TRUSTED_PROXIES: %w", err)
+	}
+
 	// --- TLS ---
 
 	// AM_TLS_SKIP_VERIFY — пропускать проверку TLS-сертификатов (по умолчанию false)
@@ -301,10 +412,120 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("AM_DB_SSL_MODE: недопустимое значение %q, допустимые: disable, require, verify-ca, verify-full", cfg.DBSSLMode)
 	}
 
+	// --- Identity Provider ---
+
+	// AM_IDP_PROVIDER — провайдер учётных записей (по умолчанию keycloak)
+	cfg.IdPProvider = getEnvDefault("AM_IDP_PROVIDER", IdPProviderKeycloak)
+	if cfg.IdPProvider != IdPProviderKeycloak && cfg.IdPProvider != IdPProviderLocal {
+		return nil, fmt.Errorf("AM_IDP_PROVIDER: недопустимое значение %q, допустимые: keycloak, local", cfg.IdPProvider)
+	}
+	localIdP := cfg.IdPProvider == IdPProviderLocal
+
+	if localIdP {
+		// AM_LOCAL_IDP_ISSUER — обязательный при local
+		cfg.LocalIdPIssuer, err = getEnvRequired("AM_LOCAL_IDP_ISSUER")
+		if err != nil {
+			return nil, err
+		}
+		cfg.LocalIdPIssuer = strings.TrimRight(cfg.LocalIdPIssuer, "/")
+	}
+
+	// AM_LOCAL_IDP_TOKEN_TTL — срок действия access token (по умолчанию 5m)
+	cfg.LocalIdPTokenTTL, err = getEnvDuration("AM_LOCAL_IDP_TOKEN_TTL", 5*time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("AM_LOCAL_IDP_TOKEN_TTL: %w", err)
+	}
+	if cfg.LocalIdPTokenTTL < time.Minute {
+		return nil, fmt.Errorf("AM_LOCAL_IDP_TOKEN_TTL: значение должно быть >= 1m")
+	}
+
+	// AM_LOCAL_IDP_REFRESH_TOKEN_TTL — срок действия refresh token UI (по умолчанию 8h)
+	cfg.LocalIdPRefreshTokenTTL, err = getEnvDuration("AM_LOCAL_IDP_REFRESH_TOKEN_TTL", 8*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_LOCAL_IDP_REFRESH_TOKEN_TTL: %w", err)
+	}
+	if cfg.LocalIdPRefreshTokenTTL < cfg.LocalIdPTokenTTL {
+		return nil, fmt.Errorf("AM_LOCAL_IDP_REFRESH_TOKEN_TTL: значение должно быть >= AM_LOCAL_IDP_TOKEN_TTL")
+	}
+
+	// AM_LOCAL_IDP_ADMIN_USERNAME, AM_LOCAL_IDP_ADMIN_PASSWORD — первичный администратор
+	cfg.LocalIdPAdminUsername = getEnvDefault("AM_LOCAL_IDP_ADMIN_USERNAME", "admin")
+	cfg.LocalIdPAdminPassword = getEnvDefault("AM_LOCAL_IDP_ADMIN_PASSWORD", "")
+
+	// AM_LOCAL_IDP_MAX_FAILED_LOGINS — попыток входа до блокировки (по умолчанию 5)
+	cfg.LocalIdPMaxFailedLogins, err = getEnvInt("AM_LOCAL_IDP_MAX_FAILED_LOGINS", 5)
+	if err != nil {
+		return nil, fmt.Errorf("AM_LOCAL_IDP_MAX_FAILED_LOGINS: %w", err)
+	}
+	if cfg.LocalIdPMaxFailedLogins <= 0 {
+		return nil, fmt.Errorf("AM_LOCAL_IDP_MAX_FAILED_LOGINS: значение должно быть > 0")
+	}
+
+	// AM_LOCAL_IDP_LOCKOUT_DURATION — длительность блокировки входа (по умолчанию 15m)
+	cfg.LocalIdPLockoutDuration, err = getEnvDuration("AM_LOCAL_IDP_LOCKOUT_DURATION", 15*time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("AM_LOCAL_IDP_LOCKOUT_DURATION: %w", err)
+	}
+	if cfg.LocalIdPLockoutDuration <= 0 {
+		return nil, fmt.Errorf("AM_LOCAL_IDP_LOCKOUT_DURATION: значение должно быть > 0")
+	}
+
+	// --- Ключи подписи JWT ---
+
+	// AM_KEYS_MASTER_KEY — мастер-ключ шифрования (обязательный при local):
+	// ровно 32 случайных байта в base64 (openssl rand -base64 32)
+	cfg.KeysMasterKey, err = getEnvRequiredUnless(!localIdP, "AM_KEYS_MASTER_KEY")
+	if err != nil {
+		return nil, err
+	}
+	if localIdP {
+		if key, err := base64.StdEncoding.DecodeString(cfg.KeysMasterKey); err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("AM_KEYS_MASTER_KEY: ожидается 32 байта в base64 (openssl rand -base64 32)")
+		}
+	}
+
+	// AM_KEYS_ALGORITHM — алгоритм новых ключей (по умолчанию RS256)
+	cfg.KeysAlgorithm = getEnvDefault("AM_KEYS_ALGORITHM", "RS256")
+	if cfg.KeysAlgorithm != "RS256" && cfg.KeysAlgorithm != "ES256" {
+		return nil, fmt.Errorf("AM_KEYS_ALGORITHM: недопустимое значение %q, допустимые: RS256, ES256", cfg.KeysAlgorithm)
+	}
+
+	// AM_KEYS_ROTATION_INTERVAL — период плановой ротации (по умолчанию 720h, 0 — отключена)
+	cfg.KeysRotationInterval, err = getEnvDuration("AM_KEYS_ROTATION_INTERVAL", 720*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_KEYS_ROTATION_INTERVAL: %w", err)
+	}
+	if cfg.KeysRotationInterval != 0 && cfg.KeysRotationInterval < time.Hour {
+		return nil, fmt.Errorf("AM_KEYS_ROTATION_INTERVAL: значение должно быть 0 или >= 1h")
+	}
+
+	// AM_KEYS_OVERLAP — период перекрытия ключей (по умолчанию 24h).
+	// Должен покрывать срок действия refresh token, подписанных заменённым ключом
+	cfg.KeysOverlap, err = getEnvDuration("AM_KEYS_OVERLAP", 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_KEYS_OVERLAP: %w", err)
+	}
+	if cfg.KeysOverlap < cfg.LocalIdPRefreshTokenTTL {
+		return nil, fmt.Errorf("AM_KEYS_OVERLAP: значение должно быть >= AM_LOCAL_IDP_REFRESH_TOKEN_TTL")
+	}
+
+	// AM_KEYS_PUBLISH_LEAD — публикация нового ключа до начала подписи (по умолчанию 5m)
+	cfg.KeysPublishLead, err = getEnvDuration("AM_KEYS_PUBLISH_LEAD", 5*time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("AM_KEYS_PUBLISH_LEAD: %w", err)
+	}
+	if cfg.KeysPublishLead < 0 {
+		return nil, fmt.Errorf("AM_KEYS_PUBLISH_LEAD: значение должно быть >= 0")
+	}
+	if cfg.KeysRotationInterval != 0 && cfg.KeysPublishLead >= cfg.KeysRotationInterval {
+		return nil, fmt.Errorf("AM_KEYS_PUBLISH_LEAD: значение должно быть < AM_KEYS_ROTATION_INTERVAL")
+	}
+
 	// --- Keycloak ---
 
-	// AM_KEYCLOAK_URL — обязательный
-	cfg.KeycloakURL, err = getEnvRequired("AM_KEYCLOAK_URL")
+	// AM_KEYCLOAK_URL, AM_KEYCLOAK_CLIENT_ID, AM_KEYCLOAK_CLIENT_SECRET —
+	// обязательные при AM_IDP_PROVIDER=keycloak
+	cfg.KeycloakURL, err = getEnvRequiredUnless(localIdP, "AM_KEYCLOAK_URL")
 	if err != nil {
 		return nil, err
 	}
@@ -314,14 +535,12 @@ func Load() (*Config, error) {
 	// AM_KEYCLOAK_REALM — realm (по умолчанию artstore)
 	cfg.KeycloakRealm = getEnvDefault("AM_KEYCLOAK_REALM", "artstore")
 
-	// AM_KEYCLOAK_CLIENT_ID — обязательный
-	cfg.KeycloakClientID, err = getEnvRequired("AM_KEYCLOAK_CLIENT_ID")
+	cfg.KeycloakClientID, err = getEnvRequiredUnless(localIdP, "AM_KEYCLOAK_CLIENT_ID")
 	if err != nil {
 		return nil, err
 	}
 
-	// AM_KEYCLOAK_CLIENT_SECRET — обязательный
-	cfg.KeycloakClientSecret, err = getEnvRequired("AM_KEYCLOAK_CLIENT_SECRET")
+	cfg.KeycloakClientSecret, err = getEnvRequiredUnless(localIdP, "AM_KEYCLOAK_CLIENT_SECRET")
 	if err != nil {
 		return nil, err
 	}
@@ -349,13 +568,20 @@ func Load() (*Config, error) {
 
 	// --- JWT/JWKS ---
 
-	// AM_JWT_ISSUER — авто-вычисляется из KeycloakURL, если не задан
-	cfg.JWTIssuer = getEnvDefault("AM_JWT_ISSUER",
-		fmt.Sprintf("%s/realms/%s", cfg.KeycloakURL, cfg.KeycloakRealm))
+	if localIdP {
+		// Локальный IdP: issuer и JWKS Admin Module (AM_JWT_ISSUER и
+		// AM_JWT_JWKS_URL не используются — ключи проверяются в памяти)
+		cfg.JWTIssuer = cfg.LocalIdPIssuer
+		cfg.JWTJWKSURL = cfg.LocalIdPIssuer + "/.well-known/jwks.json"
+	} else {
+		// AM_JWT_ISSUER — авто-вычисляется из KeycloakURL, если не задан
+		cfg.JWTIssuer = getEnvDefault("AM_JWT_ISSUER",
+			fmt.Sprintf("%s/realms/%s", cfg.KeycloakURL, cfg.KeycloakRealm))
 
-	// AM_JWT_JWKS_URL — авто-вычисляется из KeycloakURL, если не задан
-	cfg.JWTJWKSURL = getEnvDefault("AM_JWT_JWKS_URL",
-		fmt.Sprintf("%s/realms/%s/protocol/openid-connect/certs", cfg.KeycloakURL, cfg.KeycloakRealm))
+		// AM_JWT_JWKS_URL — авто-вычисляется из KeycloakURL, если не задан
+		cfg.JWTJWKSURL = getEnvDefault("AM_JWT_JWKS_URL",
+			fmt.Sprintf("%s/realms/%s/protocol/openid-connect/certs", cfg.KeycloakURL, cfg.KeycloakRealm))
+	}
 
 	// AM_JWT_ROLES_CLAIM — claim для ролей (по умолчанию realm_access.roles)
 	cfg.JWTRolesClaim = getEnvDefault("AM_JWT_ROLES_CLAIM", "realm_access.roles")
@@ -416,12 +642,193 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("AM_SYNC_PAGE_SIZE: значение %d вне допустимого диапазона 1-10000", cfg.SyncPageSize)
 	}
 
+	// AM_SYNC_FULL_INTERVAL — интервал полной синхронизации с поиском удалённых
+	// файлов (по умолчанию 24h); периодические синхронизации между полными —
+	// инкрементальные
+	cfg.SyncFullInterval, err = getEnvDuration("AM_SYNC_FULL_INTERVAL", 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_SYNC_FULL_INTERVAL: %w", err)
+	}
+
 	// AM_SA_SYNC_INTERVAL — интервал синхронизации SA (по умолчанию 15m)
 	cfg.SASyncInterval, err = getEnvDuration("AM_SA_SYNC_INTERVAL", 15*time.Minute)
 	if err != nil {
 		return nil, fmt.Errorf("AM_SA_SYNC_INTERVAL: %w", err)
 	}
 
+	// AM_SE_WRITE_RETRY_INTERVAL — базовый интервал повтора записи изменений
+	// файлов на SE из outbox (по умолчанию 30s, задержка удваивается до 1h)
+	cfg.SEWriteRetryInterval, err = getEnvDuration("AM_SE_WRITE_RETRY_INTERVAL", 30*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("AM_SE_WRITE_RETRY_INTERVAL: %w", err)
+	}
+	if cfg.SEWriteRetryInterval <= 0 {
+		return nil, fmt.Errorf("AM_SE_WRITE_RETRY_INTERVAL: значение должно быть > 0")
+	}
+
+	// --- Репликация ---
+
+	// AM_REPLICATION_FACTOR — требуемое число копий файла (по умолчанию 1 — без репликации)
+	cfg.ReplicationFactor, err = getEnvInt("AM_REPLICATION_FACTOR", 1)
+	if err != nil {
+		return nil, fmt.Errorf("AM_REPLICATION_FACTOR: %w", err)
+	}
+	if cfg.ReplicationFactor < 1 || cfg.ReplicationFactor > 5 {
+		return nil, fmt.Errorf("AM_REPLICATION_FACTOR: значение %d вне допустимого диапазона 1-5", cfg.ReplicationFactor)
+	}
+
+	// AM_REPLICATION_POLICY — политика выбора SE для реплик (по умолчанию most_free)
+	cfg.ReplicationPolicy = getEnvDefault("AM_REPLICATION_POLICY", "most_free")
+	if cfg.ReplicationPolicy != "most_free" && cfg.ReplicationPolicy != "round_robin" {
+		return nil, fmt.Errorf("AM_REPLICATION_POLICY: недопустимое значение %q, допустимые: most_free, round_robin", cfg.ReplicationPolicy)
+	}
+
+	// AM_REPLICATION_SPREAD_LABEL — ключ метки SE для распределения копий (например, zone)
+	cfg.ReplicationSpreadLabel = getEnvDefault("AM_REPLICATION_SPREAD_LABEL", "")
+
+	// AM_REPLICATION_INTERVAL — интервал прохода репликации (по умолчанию 5m)
+	cfg.ReplicationInterval, err = getEnvDuration("AM_REPLICATION_INTERVAL", 5*time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("AM_REPLICATION_INTERVAL: %w", err)
+	}
+	if cfg.ReplicationInterval <= 0 {
+		return nil, fmt.Errorf("AM_REPLICATION_INTERVAL: значение должно быть > 0")
+	}
+
+	// --- Размещение файлов ---
+
+	// AM_PLACEMENT_POLICY — политика выбора SE по умолчанию (по умолчанию most_free)
+	cfg.PlacementPolicy = getEnvDefault("AM_PLACEMENT_POLICY", "most_free")
+	switch cfg.PlacementPolicy {
+	case "most_free", "round_robin", "weighted", "prefer_labels":
+	default:
+		return nil, fmt.Errorf("AM_PLACEMENT_POLICY: недопустимое значение %q, допустимые: most_free, round_robin, weighted, prefer_labels", cfg.PlacementPolicy)
+	}
+
+	// AM_PLACEMENT_RESERVATION_TTL — время жизни резервирования места (по умолчанию 5m)
+	cfg.PlacementReservationTTL, err = getEnvDuration("AM_PLACEMENT_RESERVATION_TTL", 5*time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("AM_PLACEMENT_RESERVATION_TTL: %w", err)
+	}
+	if cfg.PlacementReservationTTL <= 0 {
+		return nil, fmt.Errorf("AM_PLACEMENT_RESERVATION_TTL: значение должно быть > 0")
+	}
+
+	// --- История ёмкости ---
+
+	// AM_CAPACITY_HISTORY_RETENTION — срок хранения снимков ёмкости SE (по умолчанию 2160h = 90 дней)
+	cfg.CapacityHistoryRetention, err = getEnvDuration("AM_CAPACITY_HISTORY_RETENTION", 2160*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_CAPACITY_HISTORY_RETENTION: %w", err)
+	}
+	if cfg.CapacityHistoryRetention <= 0 {
+		return nil, fmt.Errorf("AM_CAPACITY_HISTORY_RETENTION: значение должно быть > 0")
+	}
+
+	// AM_CAPACITY_FORECAST_WINDOW — период истории для прогноза заполнения (по умолчанию 720h = 30 дней)
+	cfg.CapacityForecastWindow, err = getEnvDuration("AM_CAPACITY_FORECAST_WINDOW", 720*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_CAPACITY_FORECAST_WINDOW: %w", err)
+	}
+	if cfg.CapacityForecastWindow <= 0 || cfg.CapacityForecastWindow > cfg.CapacityHistoryRetention {
+		return nil, fmt.Errorf("AM_CAPACITY_FORECAST_WINDOW: значение должно быть > 0 и не больше AM_CAPACITY_HISTORY_RETENTION")
+	}
+
+	// --- Оповещения и webhooks ---
+
+	// AM_ALERT_EVAL_INTERVAL — интервал проверки правил оповещений (по умолчанию 1m)
+	cfg.AlertEvalInterval, err = getEnvDuration("AM_ALERT_EVAL_INTERVAL", time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("AM_ALERT_EVAL_INTERVAL: %w", err)
+	}
+	if cfg.AlertEvalInterval <= 0 {
+		return nil, fmt.Errorf("AM_ALERT_EVAL_INTERVAL: значение должно быть > 0")
+	}
+
+	// AM_WEBHOOK_TIMEOUT — таймаут доставки webhook (по умолчанию 10s)
+	cfg.WebhookTimeout, err = getEnvDuration("AM_WEBHOOK_TIMEOUT", 10*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("AM_WEBHOOK_TIMEOUT: %w", err)
+	}
+	if cfg.WebhookTimeout <= 0 {
+		return nil, fmt.Errorf("AM_WEBHOOK_TIMEOUT: значение должно быть > 0")
+	}
+
+	// AM_WEBHOOK_MAX_ATTEMPTS — максимальное число попыток доставки (по умолчанию 5, 1-20)
+	cfg.WebhookMaxAttempts, err = getEnvInt("AM_WEBHOOK_MAX_ATTEMPTS", 5)
+	if err != nil {
+		return nil, fmt.Errorf("AM_WEBHOOK_MAX_ATTEMPTS: %w", err)
+	}
+	if cfg.WebhookMaxAttempts < 1 || cfg.WebhookMaxAttempts > 20 {
+		return nil, fmt.Errorf("AM_WEBHOOK_MAX_ATTEMPTS: значение должно быть от 1 до 20")
+	}
+
+	// AM_WEBHOOK_DELIVERY_RETENTION — срок хранения журнала доставок (по умолчанию 720h = 30 дней)
+	cfg.WebhookDeliveryRetention, err = getEnvDuration("AM_WEBHOOK_DELIVERY_RETENTION", 720*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_WEBHOOK_DELIVERY_RETENTION: %w", err)
+	}
+	if cfg.WebhookDeliveryRetention <= 0 {
+		return nil, fmt.Errorf("AM_WEBHOOK_DELIVERY_RETENTION: значение должно быть > 0")
+	}
+
+	// --- Секреты Service Accounts ---
+
+	// AM_SA_SECRET_LIFETIME — срок действия секрета SA (по умолчанию 2160h = 90 дней, 0 — без истечения)
+	cfg.SASecretLifetime, err = getEnvDuration("AM_SA_SECRET_LIFETIME", 2160*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_SA_SECRET_LIFETIME: %w", err)
+	}
+	if cfg.SASecretLifetime < 0 {
+		return nil, fmt.Errorf("AM_SA_SECRET_LIFETIME: значение должно быть >= 0")
+	}
+
+	// AM_SA_SECRET_ROTATION_GRACE — период действия старого секрета после ротации
+	// (по умолчанию 24h, 0 — старый секрет недействителен сразу, не больше 720h)
+	cfg.SASecretRotationGrace, err = getEnvDuration("AM_SA_SECRET_ROTATION_GRACE", 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_SA_SECRET_ROTATION_GRACE: %w", err)
+	}
+	if cfg.SASecretRotationGrace < 0 || cfg.SASecretRotationGrace > 720*time.Hour {
+		return nil, fmt.Errorf("AM_SA_SECRET_ROTATION_GRACE: значение должно быть от 0 до 720h")
+	}
+
+	// AM_SA_SECRET_EXPIRY_WARNING — окно «скоро истекает» (по умолчанию 336h = 14 дней)
+	cfg.SASecretExpiryWarning, err = getEnvDuration("AM_SA_SECRET_EXPIRY_WARNING", 336*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_SA_SECRET_EXPIRY_WARNING: %w", err)
+	}
+	if cfg.SASecretExpiryWarning <= 0 {
+		return nil, fmt.Errorf("AM_SA_SECRET_EXPIRY_WARNING: значение должно быть > 0")
+	}
+
+	// AM_SA_SECRET_CHECK_INTERVAL — интервал проверки истёкших секретов (по умолчанию 1h)
+	cfg.SASecretCheckInterval, err = getEnvDuration("AM_SA_SECRET_CHECK_INTERVAL", time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_SA_SECRET_CHECK_INTERVAL: %w", err)
+	}
+	if cfg.SASecretCheckInterval <= 0 {
+		return nil, fmt.Errorf("AM_SA_SECRET_CHECK_INTERVAL: значение должно быть > 0")
+	}
+
+	// AM_PAT_MAX_TTL — максимальный срок действия personal access token (по умолчанию 8760h = 365 дней)
+	cfg.PATMaxTTL, err = getEnvDuration("AM_PAT_MAX_TTL", 8760*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_PAT_MAX_TTL: %w", err)
+	}
+	if cfg.PATMaxTTL < 24*time.Hour {
+		return nil, fmt.Errorf("AM_PAT_MAX_TTL: значение должно быть >= 24h")
+	}
+
+	// AM_PAT_MAX_PER_USER — лимит действующих personal access tokens пользователя (по умолчанию 20)
+	cfg.PATMaxPerUser, err = getEnvInt("AM_PAT_MAX_PER_USER", 20)
+	if err != nil {
+		return nil, fmt.Errorf("AM_PAT_MAX_PER_USER: %w", err)
+	}
+	if cfg.PATMaxPerUser < 1 {
+		return nil, fmt.Errorf("AM_PAT_MAX_PER_USER: значение должно быть >= 1")
+	}
+
 	// AM_SSE_INTERVAL — интервал отправки SSE-обновлений в Admin UI (по умолчанию 15s)
 	cfg.SSEInterval, err = getEnvDuration("AM_SSE_INTERVAL", 15*time.Second)
 	if err != nil {
@@ -450,6 +857,24 @@ func Load() (*Config, error) {
 	// AM_UI_SESSION_SECRET — секрет шифрования сессий (опционально, автогенерация)
 	cfg.UISessionSecret = getEnvDefault("AM_UI_SESSION_SECRET", "")
 
+	// AM_UI_SESSION_IDLE_TIMEOUT — таймаут неактивности UI-сессии (по умолчанию 1h)
+	cfg.UISessionIdleTimeout, err = getEnvDuration("AM_UI_SESSION_IDLE_TIMEOUT", time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_UI_SESSION_IDLE_TIMEOUT: %w", err)
+	}
+	if cfg.UISessionIdleTimeout <= 0 {
+		return nil, fmt.Errorf("AM_UI_SESSION_IDLE_TIMEOUT: значение должно быть > 0")
+	}
+
+	// AM_UI_SESSION_ABSOLUTE_TIMEOUT — время жизни UI-сессии с момента входа (по умолчанию 24h)
+	cfg.UISessionAbsoluteTimeout, err = getEnvDuration("AM_UI_SESSION_ABSOLUTE_TIMEOUT", 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("AM_UI_SESSION_ABSOLUTE_TIMEOUT: %w", err)
+	}
+	if cfg.UISessionAbsoluteTimeout < cfg.UISessionIdleTimeout {
+		return nil, fmt.Errorf("AM_UI_SESSION_ABSOLUTE_TIMEOUT: значение должно быть >= AM_UI_SESSION_IDLE_TIMEOUT")
+	}
+
 	// AM_UI_OIDC_CLIENT_ID — OIDC Client ID для UI (по умолчанию artstore-admin-ui)
 	cfg.UIOIDCClientID = getEnvDefault("AM_UI_OIDC_CLIENT_ID", "artstore-admin-ui")
 
@@ -517,6 +942,15 @@ func getEnvRequired(key string) (string, error) {
 	return val, nil
 }
 
+// getEnvRequiredUnless возвращает значение переменной окружения; если optional,
+// незаданная переменная не является ошибкой.
+func getEnvRequiredUnless(optional bool, key string) (string, error) {
+	if optional {
+		return os.Getenv(key), nil
+	}
+	return getEnvRequired(key)
+}
+
 // getEnvDefault возвращает значение переменной окружения или значение по умолчанию.
 func getEnvDefault(key, defaultVal string) string {
 	val := os.Getenv(key)
@@ -599,6 +1033,28 @@ func parseLogLevel(level string) (slog.Level, error) {
 	}
 }
 
+// parsePrefixes разбирает список CIDR через запятую.
+// Отдельный IP-адрес трактуется как сеть из одного адреса (/32, /128).
+func parsePrefixes(s string) ([]netip.Prefix, error) {
+	var result []netip.Prefix
+	for _, item := range parseCSV(s) {
+		if !strings.Contains(item, "/") {
+			addr, err := netip.ParseAddr(item)
+			if err != nil {
+				return nil, fmt.Errorf("некорректный адрес %q", item)
+			}
+			result = append(result, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(item)
+		if err != nil {
+			return nil, fmt.Errorf("некорректный CIDR %q", item)
+		}
+		result = append(result, prefix.Masked())
+	}
+	return result, nil
+}
+
 // parseCSV разбирает строку, разделённую запятыми, на срез строк.
 // Пробелы вокруг элементов убираются, пустые элементы игнорируются.
 func parseCSV(s string) []string {
@@ -46,6 +46,9 @@ func TestLoad_MinimalConfig(t *testing.T) {
 	if cfg.LogFormat != "json" {
 		t.Errorf("LogFormat = %q, ожидается json", cfg.LogFormat)
 	}
+	if len(cfg.TrustedProxies) != 0 {
+		t.Errorf("TrustedProxies = %v, ожидается пустой список", cfg.TrustedProxies)
+	}
 	if cfg.DBHost != "localhost" {
 		t.Errorf("DBHost = %q, ожидается localhost", cfg.DBHost)
 	}
@@ -67,9 +70,15 @@ func TestLoad_MinimalConfig(t *testing.T) {
 	if cfg.SyncPageSize != 1000 {
 		t.Errorf("SyncPageSize = %d, ожидается 1000", cfg.SyncPageSize)
 	}
+	if cfg.SyncFullInterval != 24*time.Hour {
+		t.Errorf("SyncFullInterval = %v, ожидается 24h", cfg.SyncFullInterval)
+	}
 	if cfg.SASyncInterval != 15*time.Minute {
 		t.Errorf("SASyncInterval = %v, ожидается 15m", cfg.SASyncInterval)
 	}
+	if cfg.SEWriteRetryInterval != 30*time.Second {
+		t.Errorf("SEWriteRetryInterval = %v, ожидается 30s", cfg.SEWriteRetryInterval)
+	}
 	if cfg.DephealthCheckInterval != 15*time.Second {
 		t.Errorf("DephealthCheckInterval = %v, ожидается 15s", cfg.DephealthCheckInterval)
 	}
@@ -159,6 +168,99 @@ func TestLoad_JWTAutoDerive(t *testing.T) {
 	}
 }
 
+// testMasterKey — мастер-ключ шифрования ключей подписи для тестов.
+const testMasterKey = "dGVzdC1tYXN0ZXIta2V5LTAxMjM0NTY3ODlhYmNkZWY="
+
+func TestLoad_LocalIdP(t *testing.T) {
+	envs := minimalEnvs()
+	delete(envs, "AM_KEYCLOAK_URL")
+	delete(envs, "AM_KEYCLOAK_CLIENT_ID")
+	delete(envs, "AM_KEYCLOAK_CLIENT_SECRET")
+	envs["AM_IDP_PROVIDER"] = "local"
+	envs["AM_LOCAL_IDP_ISSUER"] = "https://artstore.kryukov.lan/"
+	envs["AM_LOCAL_IDP_ADMIN_PASSWORD"] = "bootstrap-pass"
+	envs["AM_LOCAL_IDP_LOCKOUT_DURATION"] = "30m"
+	envs["AM_KEYS_MASTER_KEY"] = testMasterKey
+	envs["AM_KEYS_ALGORITHM"] = "ES256"
+	setEnvs(t, envs)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+
+	if cfg.IdPProvider != IdPProviderLocal {
+		t.Errorf("IdPProvider = %q, ожидается local", cfg.IdPProvider)
+	}
+	if cfg.JWTIssuer != "https://artstore.kryukov.lan" {
+		t.Errorf("JWTIssuer = %q, ожидается issuer локального IdP", cfg.JWTIssuer)
+	}
+	if cfg.JWTJWKSURL != "https://artstore.kryukov.lan/.well-known/jwks.json" {
+		t.Errorf("JWTJWKSURL = %q", cfg.JWTJWKSURL)
+	}
+	if cfg.LocalIdPTokenTTL != 5*time.Minute || cfg.LocalIdPRefreshTokenTTL != 8*time.Hour {
+		t.Errorf("TTL = %v/%v, ожидается 5m/8h", cfg.LocalIdPTokenTTL, cfg.LocalIdPRefreshTokenTTL)
+	}
+	if cfg.LocalIdPAdminUsername != "admin" || cfg.LocalIdPMaxFailedLogins != 5 {
+		t.Errorf("AdminUsername = %q, MaxFailedLogins = %d", cfg.LocalIdPAdminUsername, cfg.LocalIdPMaxFailedLogins)
+	}
+	if cfg.LocalIdPLockoutDuration != 30*time.Minute {
+		t.Errorf("LockoutDuration = %v, ожидается 30m", cfg.LocalIdPLockoutDuration)
+	}
+	if cfg.KeysMasterKey != testMasterKey || cfg.KeysAlgorithm != "ES256" {
+		t.Errorf("KeysMasterKey = %q, KeysAlgorithm = %q", cfg.KeysMasterKey, cfg.KeysAlgorithm)
+	}
+	if cfg.KeysRotationInterval != 720*time.Hour || cfg.KeysOverlap != 24*time.Hour || cfg.KeysPublishLead != 5*time.Minute {
+		t.Errorf("Keys: rotation = %v, overlap = %v, publish lead = %v, ожидается 720h/24h/5m",
+			cfg.KeysRotationInterval, cfg.KeysOverlap, cfg.KeysPublishLead)
+	}
+}
+
+func TestLoad_LocalIdPInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		envs map[string]string
+	}{
+		{"неизвестный провайдер", map[string]string{"AM_IDP_PROVIDER": "ldap"}},
+		{"нет issuer", map[string]string{"AM_IDP_PROVIDER": "local"}},
+		{"короткий TTL", map[string]string{
+			"AM_IDP_PROVIDER": "local", "AM_LOCAL_IDP_ISSUER": "http://am:8000", "AM_KEYS_MASTER_KEY": testMasterKey,
+			"AM_LOCAL_IDP_TOKEN_TTL": "30s",
+		}},
+		{"нулевой лимит попыток", map[string]string{
+			"AM_IDP_PROVIDER": "local", "AM_LOCAL_IDP_ISSUER": "http://am:8000", "AM_KEYS_MASTER_KEY": testMasterKey,
+			"AM_LOCAL_IDP_MAX_FAILED_LOGINS": "0",
+		}},
+		{"нет мастер-ключа", map[string]string{
+			"AM_IDP_PROVIDER": "local", "AM_LOCAL_IDP_ISSUER": "http://am:8000",
+		}},
+		{"короткий мастер-ключ", map[string]string{
+			"AM_IDP_PROVIDER": "local", "AM_LOCAL_IDP_ISSUER": "http://am:8000", "AM_KEYS_MASTER_KEY": "c2hvcnQ=",
+		}},
+		{"мастер-ключ не в base64", map[string]string{
+			"AM_IDP_PROVIDER": "local", "AM_LOCAL_IDP_ISSUER": "http://am:8000",
+			"AM_KEYS_MASTER_KEY": "test-master-key-0123456789abcdef",
+		}},
+		{"неизвестный алгоритм ключей", map[string]string{"AM_KEYS_ALGORITHM": "HS256"}},
+		{"короткий интервал ротации", map[string]string{"AM_KEYS_ROTATION_INTERVAL": "30m"}},
+		{"перекрытие меньше refresh TTL", map[string]string{"AM_KEYS_OVERLAP": "1h"}},
+		{"публикация дольше интервала ротации", map[string]string{
+			"AM_KEYS_ROTATION_INTERVAL": "1h", "AM_KEYS_PUBLISH_LEAD": "2h",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnvs(t, minimalEnvs())
+			setEnvs(t, tt.envs)
+
+			if _, err := Load(); err == nil {
+				t.Error("Load() не вернул ошибку")
+			}
+		})
+	}
+}
+
 func TestLoad_CustomValues(t *testing.T) {
 	envs := minimalEnvs()
 	envs["AM_PORT"] = "8005"
@@ -168,6 +270,8 @@ func TestLoad_CustomValues(t *testing.T) {
 	envs["AM_DB_SSL_MODE"] = "require"
 	envs["AM_SYNC_INTERVAL"] = "30m"
 	envs["AM_SYNC_PAGE_SIZE"] = "500"
+	envs["AM_SYNC_FULL_INTERVAL"] = "6h"
+	envs["AM_SE_WRITE_RETRY_INTERVAL"] = "10s"
 	envs["AM_SA_SYNC_INTERVAL"] = "5m"
 	envs["AM_CA_CERT_PATH"] = "/certs/ca.pem"
 	envs["AM_ROLE_ADMIN_GROUPS"] = "admins, super-admins"
@@ -201,9 +305,15 @@ func TestLoad_CustomValues(t *testing.T) {
 	if cfg.SyncPageSize != 500 {
 		t.Errorf("SyncPageSize = %d, ожидается 500", cfg.SyncPageSize)
 	}
+	if cfg.SyncFullInterval != 6*time.Hour {
+		t.Errorf("SyncFullInterval = %v, ожидается 6h", cfg.SyncFullInterval)
+	}
 	if cfg.SASyncInterval != 5*time.Minute {
 		t.Errorf("SASyncInterval = %v, ожидается 5m", cfg.SASyncInterval)
 	}
+	if cfg.SEWriteRetryInterval != 10*time.Second {
+		t.Errorf("SEWriteRetryInterval = %v, ожидается 10s", cfg.SEWriteRetryInterval)
+	}
 	if cfg.CACertPath != "/certs/ca.pem" {
 		t.Errorf("CACertPath = %q, ожидается /certs/ca.pem", cfg.CACertPath)
 	}
@@ -438,6 +548,284 @@ func TestLoad_SSEInterval(t *testing.T) {
 	}
 }
 
+// TestLoad_Replication проверяет параметры репликации файлов.
+func TestLoad_Replication(t *testing.T) {
+	setEnvs(t, minimalEnvs())
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	if cfg.ReplicationFactor != 1 {
+		t.Errorf("ReplicationFactor = %d, ожидается 1", cfg.ReplicationFactor)
+	}
+	if cfg.ReplicationPolicy != "most_free" {
+		t.Errorf("ReplicationPolicy = %q, ожидается most_free", cfg.ReplicationPolicy)
+	}
+	if cfg.ReplicationSpreadLabel != "" {
+		t.Errorf("ReplicationSpreadLabel = %q, ожидается пустая строка", cfg.ReplicationSpreadLabel)
+	}
+	if cfg.ReplicationInterval != 5*time.Minute {
+		t.Errorf("ReplicationInterval = %v, ожидается 5m", cfg.ReplicationInterval)
+	}
+
+	envs := minimalEnvs()
+	envs["AM_REPLICATION_FACTOR"] = "3"
+	envs["AM_REPLICATION_POLICY"] = "round_robin"
+	envs["AM_REPLICATION_SPREAD_LABEL"] = "zone"
+	envs["AM_REPLICATION_INTERVAL"] = "1m"
+	setEnvs(t, envs)
+
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	if cfg.ReplicationFactor != 3 {
+		t.Errorf("ReplicationFactor = %d, ожидается 3", cfg.ReplicationFactor)
+	}
+	if cfg.ReplicationPolicy != "round_robin" {
+		t.Errorf("ReplicationPolicy = %q, ожидается round_robin", cfg.ReplicationPolicy)
+	}
+	if cfg.ReplicationSpreadLabel != "zone" {
+		t.Errorf("ReplicationSpreadLabel = %q, ожидается zone", cfg.ReplicationSpreadLabel)
+	}
+	if cfg.ReplicationInterval != time.Minute {
+		t.Errorf("ReplicationInterval = %v, ожидается 1m", cfg.ReplicationInterval)
+	}
+
+	invalid := map[string]string{
+		"AM_REPLICATION_FACTOR":   "0",
+		"AM_REPLICATION_POLICY":   "random",
+		"AM_REPLICATION_INTERVAL": "0s",
+	}
+	for key, value := range invalid {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			if _, err := Load(); err == nil {
+				t.Errorf("Load() не вернул ошибку при %s=%q", key, value)
+			}
+		})
+	}
+}
+
+// TestLoad_Placement проверяет параметры выбора SE для загрузки.
+func TestLoad_Placement(t *testing.T) {
+	setEnvs(t, minimalEnvs())
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	if cfg.PlacementPolicy != "most_free" {
+		t.Errorf("PlacementPolicy = %q, ожидается most_free", cfg.PlacementPolicy)
+	}
+	if cfg.PlacementReservationTTL != 5*time.Minute {
+		t.Errorf("PlacementReservationTTL = %v, ожидается 5m", cfg.PlacementReservationTTL)
+	}
+
+	envs := minimalEnvs()
+	envs["AM_PLACEMENT_POLICY"] = "weighted"
+	envs["AM_PLACEMENT_RESERVATION_TTL"] = "30s"
+	setEnvs(t, envs)
+
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	if cfg.PlacementPolicy != "weighted" {
+		t.Errorf("PlacementPolicy = %q, ожидается weighted", cfg.PlacementPolicy)
+	}
+	if cfg.PlacementReservationTTL != 30*time.Second {
+		t.Errorf("PlacementReservationTTL = %v, ожидается 30s", cfg.PlacementReservationTTL)
+	}
+
+	invalid := map[string]string{
+		"AM_PLACEMENT_POLICY":          "random",
+		"AM_PLACEMENT_RESERVATION_TTL": "0s",
+	}
+	for key, value := range invalid {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			if _, err := Load(); err == nil {
+				t.Errorf("Load() не вернул ошибку при %s=%q", key, value)
+			}
+		})
+	}
+}
+
+// TestLoad_CapacityHistory проверяет параметры истории ёмкости и прогноза.
+func TestLoad_CapacityHistory(t *testing.T) {
+	setEnvs(t, minimalEnvs())
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	if cfg.CapacityHistoryRetention != 2160*time.Hour {
+		t.Errorf("CapacityHistoryRetention = %v, ожидается 2160h", cfg.CapacityHistoryRetention)
+	}
+	if cfg.CapacityForecastWindow != 720*time.Hour {
+		t.Errorf("CapacityForecastWindow = %v, ожидается 720h", cfg.CapacityForecastWindow)
+	}
+
+	invalid := map[string]string{
+		"AM_CAPACITY_HISTORY_RETENTION": "0s",
+		"AM_CAPACITY_FORECAST_WINDOW":   "4000h",
+	}
+	for key, value := range invalid {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			if _, err := Load(); err == nil {
+				t.Errorf("Load() не вернул ошибку при %s=%q", key, value)
+			}
+		})
+	}
+}
+
+// TestLoad_Alerting проверяет параметры оповещений и доставки webhooks.
+func TestLoad_Alerting(t *testing.T) {
+	setEnvs(t, minimalEnvs())
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	if cfg.AlertEvalInterval != time.Minute {
+		t.Errorf("AlertEvalInterval = %v, ожидается 1m", cfg.AlertEvalInterval)
+	}
+	if cfg.WebhookTimeout != 10*time.Second {
+		t.Errorf("WebhookTimeout = %v, ожидается 10s", cfg.WebhookTimeout)
+	}
+	if cfg.WebhookMaxAttempts != 5 {
+		t.Errorf("WebhookMaxAttempts = %d, ожидается 5", cfg.WebhookMaxAttempts)
+	}
+	if cfg.WebhookDeliveryRetention != 720*time.Hour {
+		t.Errorf("WebhookDeliveryRetention = %v, ожидается 720h", cfg.WebhookDeliveryRetention)
+	}
+
+	invalid := map[string]string{
+		"AM_ALERT_EVAL_INTERVAL":        "0s",
+		"AM_WEBHOOK_TIMEOUT":            "abc",
+		"AM_WEBHOOK_MAX_ATTEMPTS":       "0",
+		"AM_WEBHOOK_DELIVERY_RETENTION": "-1h",
+	}
+	for key, value := range invalid {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			if _, err := Load(); err == nil {
+				t.Errorf("Load() не вернул ошибку при %s=%q", key, value)
+			}
+		})
+	}
+}
+
+// TestLoad_SASecrets проверяет параметры срока действия секретов SA.
+func TestLoad_SASecrets(t *testing.T) {
+	setEnvs(t, minimalEnvs())
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	if cfg.SASecretLifetime != 2160*time.Hour {
+		t.Errorf("SASecretLifetime = %v, ожидается 2160h", cfg.SASecretLifetime)
+	}
+	if cfg.SASecretRotationGrace != 24*time.Hour {
+		t.Errorf("SASecretRotationGrace = %v, ожидается 24h", cfg.SASecretRotationGrace)
+	}
+	if cfg.SASecretExpiryWarning != 336*time.Hour {
+		t.Errorf("SASecretExpiryWarning = %v, ожидается 336h", cfg.SASecretExpiryWarning)
+	}
+	if cfg.SASecretCheckInterval != time.Hour {
+		t.Errorf("SASecretCheckInterval = %v, ожидается 1h", cfg.SASecretCheckInterval)
+	}
+
+	t.Run("без истечения", func(t *testing.T) {
+		t.Setenv("AM_SA_SECRET_LIFETIME", "0s")
+		t.Setenv("AM_SA_SECRET_ROTATION_GRACE", "0s")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() вернул ошибку: %v", err)
+		}
+		if cfg.SASecretLifetime != 0 || cfg.SASecretRotationGrace != 0 {
+			t.Errorf("lifetime = %v, grace = %v, ожидается 0", cfg.SASecretLifetime, cfg.SASecretRotationGrace)
+		}
+	})
+
+	invalid := map[string]string{
+		"AM_SA_SECRET_LIFETIME":       "-1h",
+		"AM_SA_SECRET_ROTATION_GRACE": "721h",
+		"AM_SA_SECRET_EXPIRY_WARNING": "0s",
+		"AM_SA_SECRET_CHECK_INTERVAL": "abc",
+	}
+	for key, value := range invalid {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			if _, err := Load(); err == nil {
+				t.Errorf("Load() не вернул ошибку при %s=%q", key, value)
+			}
+		})
+	}
+}
+
+// TestLoad_PersonalTokens проверяет ограничения personal access tokens.
+func TestLoad_PersonalTokens(t *testing.T) {
+	setEnvs(t, minimalEnvs())
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	if cfg.PATMaxTTL != 8760*time.Hour {
+		t.Errorf("PATMaxTTL = %v, ожидается 8760h", cfg.PATMaxTTL)
+	}
+	if cfg.PATMaxPerUser != 20 {
+		t.Errorf("PATMaxPerUser = %d, ожидается 20", cfg.PATMaxPerUser)
+	}
+
+	invalid := map[string]string{
+		"AM_PAT_MAX_TTL":      "1h",
+		"AM_PAT_MAX_PER_USER": "0",
+	}
+	for key, value := range invalid {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			if _, err := Load(); err == nil {
+				t.Errorf("Load() не вернул ошибку при %s=%q", key, value)
+			}
+		})
+	}
+}
+
+// TestLoad_UISessionTimeouts проверяет таймауты UI-сессий.
+func TestLoad_UISessionTimeouts(t *testing.T) {
+	setEnvs(t, minimalEnvs())
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	if cfg.UISessionIdleTimeout != time.Hour {
+		t.Errorf("UISessionIdleTimeout = %v, ожидается 1h", cfg.UISessionIdleTimeout)
+	}
+	if cfg.UISessionAbsoluteTimeout != 24*time.Hour {
+		t.Errorf("UISessionAbsoluteTimeout = %v, ожидается 24h", cfg.UISessionAbsoluteTimeout)
+	}
+
+	invalid := map[string]string{
+		"AM_UI_SESSION_IDLE_TIMEOUT":     "0s",
+		"AM_UI_SESSION_ABSOLUTE_TIMEOUT": "30m",
+	}
+	for key, value := range invalid {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			if _, err := Load(); err == nil {
+				t.Errorf("Load() не вернул ошибку при %s=%q", key, value)
+			}
+		})
+	}
+}
+
 // TestLoad_JWTLeewayZero проверяет, что JWTLeeway допускает значение 0.
 func TestLoad_JWTLeewayZero(t *testing.T) {
 	envs := minimalEnvs()
@@ -576,6 +964,30 @@ func TestLoad_InvalidLogFormat(t *testing.T) {
 	}
 }
 
+func TestLoad_TrustedProxies(t *testing.T) {
+	setEnvs(t, minimalEnvs())
+	t.Setenv("AM_TRUSTED_PROXIES", "10.0.0.0/8, 192.0.2.5, fd00::/8")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() вернул ошибку: %v", err)
+	}
+	want := []string{"10.0.0.0/8", "192.0.2.5/32", "fd00::/8"}
+	if len(cfg.TrustedProxies) != len(want) {
+		t.Fatalf("TrustedProxies = %v, ожидается %v", cfg.TrustedProxies, want)
+	}
+	for i, p := range cfg.TrustedProxies {
+		if p.String() != want[i] {
+			t.Errorf("TrustedProxies[%d] = %s, ожидается %s", i, p, want[i])
+		}
+	}
+
+	t.Setenv("AM_TRUSTED_PROXIES", "10.0.0.0/33")
+	if _, err := Load(); err == nil {
+		t.Error("Load() не вернул ошибку при некорректном CIDR")
+	}
+}
+
 func TestLoad_InvalidSSLMode(t *testing.T) {
 	envs := minimalEnvs()
 	envs["AM_DB_SSL_MODE"] = "prefer"
@@ -120,6 +120,30 @@ func TestMigrate(t *testing.T) {
 		"service_accounts",
 		"role_overrides",
 		"sync_state",
+		"file_replicas",
+		"audit_events",
+		"sync_runs",
+		"sync_checkpoints",
+		"sync_seen_files",
+		"se_write_outbox",
+		"storage_element_labels",
+		"se_capacity_snapshots",
+		"webhook_endpoints",
+		"alert_rules",
+		"alert_rule_endpoints",
+		"alert_states",
+		"webhook_deliveries",
+		"sa_resource_grants",
+		"file_batch_operations",
+		"file_batch_results",
+		"se_reconcile_reports",
+		"se_reconcile_issues",
+		"ui_sessions",
+		"local_idp_users",
+		"local_idp_clients",
+		"jwt_signing_keys",
+		"collections",
+		"collection_members",
 	}
 
 	for _, table := range tables {
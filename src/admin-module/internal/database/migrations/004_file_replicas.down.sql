@@ -0,0 +1,4 @@
+-- Откат миграции 004: удаление таблицы file_replicas
+
+DROP TRIGGER IF EXISTS trg_file_replicas_updated_at ON file_replicas;
+DROP TABLE IF EXISTS file_replicas;
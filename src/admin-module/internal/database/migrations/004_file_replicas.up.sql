@@ -0,0 +1,30 @@
+-- Миграция 004: таблица file_replicas
+-- Копии файлов на дополнительных Storage Elements (репликация).
+-- Основная копия по-прежнему задаётся file_registry.storage_element_id.
+
+CREATE TABLE IF NOT EXISTS file_replicas (
+    file_id            UUID NOT NULL REFERENCES file_registry(file_id) ON DELETE CASCADE,
+    storage_element_id UUID NOT NULL REFERENCES storage_elements(id) ON DELETE CASCADE,
+    status             TEXT NOT NULL DEFAULT 'pending'
+                       CHECK (status IN ('pending', 'synced', 'failed')),
+    last_error         TEXT,
+    attempts           INTEGER NOT NULL DEFAULT 0,
+    replicated_at      TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (file_id, storage_element_id)
+);
+
+-- Функция update_updated_at() уже создана в миграции 001
+CREATE TRIGGER trg_file_replicas_updated_at
+    BEFORE UPDATE ON file_replicas
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX idx_file_replicas_storage_element_id ON file_replicas(storage_element_id);
+CREATE INDEX idx_file_replicas_status ON file_replicas(status);
+
+COMMENT ON TABLE file_replicas IS 'Реплики файлов на дополнительных Storage Elements';
+COMMENT ON COLUMN file_replicas.status IS 'Состояние реплики: pending, synced, failed';
+COMMENT ON COLUMN file_replicas.last_error IS 'Текст последней ошибки репликации';
+COMMENT ON COLUMN file_replicas.attempts IS 'Количество неудачных попыток подряд';
+COMMENT ON COLUMN file_replicas.replicated_at IS 'Время успешного копирования на SE';
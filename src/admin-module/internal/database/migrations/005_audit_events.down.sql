@@ -0,0 +1,5 @@
+-- Откат миграции 005: удаление таблицы audit_events
+
+DROP TRIGGER IF EXISTS trg_audit_events_immutable ON audit_events;
+DROP FUNCTION IF EXISTS audit_events_immutable();
+DROP TABLE IF EXISTS audit_events;
@@ -0,0 +1,47 @@
+-- Миграция 005: таблица audit_events
+-- Журнал изменений, выполненных через Admin Module (append-only).
+-- Событие пишется в той же транзакции, что и само изменение.
+
+CREATE TABLE IF NOT EXISTS audit_events (
+    id          BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor_id    TEXT NOT NULL,
+    actor_type  TEXT NOT NULL
+                CHECK (actor_type IN ('user', 'service_account', 'system')),
+    actor_name  TEXT NOT NULL DEFAULT '',
+    action      TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id   TEXT NOT NULL,
+    before      JSONB,
+    after       JSONB,
+    source_ip   TEXT,
+    request_id  TEXT
+);
+
+-- Запрет изменения и удаления записей журнала
+CREATE OR REPLACE FUNCTION audit_events_immutable()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events является append-only: % запрещён', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_events_immutable
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_immutable();
+
+CREATE INDEX idx_audit_events_occurred_at ON audit_events(occurred_at DESC);
+CREATE INDEX idx_audit_events_actor_id ON audit_events(actor_id);
+CREATE INDEX idx_audit_events_action ON audit_events(action);
+CREATE INDEX idx_audit_events_target ON audit_events(target_type, target_id);
+
+COMMENT ON TABLE audit_events IS 'Журнал аудита изменений Admin Module (append-only)';
+COMMENT ON COLUMN audit_events.actor_id IS 'sub из JWT (пользователь или SA), admin-module для системных действий';
+COMMENT ON COLUMN audit_events.actor_type IS 'Тип субъекта: user, service_account, system';
+COMMENT ON COLUMN audit_events.action IS 'Действие в формате <объект>.<операция>, например service_account.update';
+COMMENT ON COLUMN audit_events.target_type IS 'Тип изменённого объекта';
+COMMENT ON COLUMN audit_events.target_id IS 'Идентификатор изменённого объекта';
+COMMENT ON COLUMN audit_events.before IS 'Изменённые поля до операции (NULL при создании)';
+COMMENT ON COLUMN audit_events.after IS 'Изменённые поля после операции (NULL при удалении)';
+COMMENT ON COLUMN audit_events.source_ip IS 'IP-адрес клиента (X-Forwarded-For или адрес соединения)';
+COMMENT ON COLUMN audit_events.request_id IS 'Идентификатор HTTP-запроса (X-Request-ID)';
@@ -0,0 +1,3 @@
+-- Откат миграции 006: удаление таблицы sync_runs
+
+DROP TABLE IF EXISTS sync_runs;
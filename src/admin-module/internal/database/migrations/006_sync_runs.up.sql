@@ -0,0 +1,34 @@
+-- Миграция 006: таблица sync_runs
+-- История фоновых задач синхронизации файлового реестра с Storage Elements.
+
+CREATE TABLE IF NOT EXISTS sync_runs (
+    id                   UUID PRIMARY KEY,
+    storage_element_id   UUID NOT NULL REFERENCES storage_elements(id) ON DELETE CASCADE,
+    trigger              TEXT NOT NULL
+                         CHECK (trigger IN ('periodic', 'manual', 'registration')),
+    status               TEXT NOT NULL DEFAULT 'queued'
+                         CHECK (status IN ('queued', 'running', 'succeeded', 'failed', 'cancelled')),
+    requested_by         TEXT,
+    files_on_se          INTEGER NOT NULL DEFAULT 0,
+    files_processed      INTEGER NOT NULL DEFAULT 0,
+    files_added          INTEGER NOT NULL DEFAULT 0,
+    files_updated        INTEGER NOT NULL DEFAULT 0,
+    files_marked_deleted INTEGER NOT NULL DEFAULT 0,
+    error                TEXT,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at           TIMESTAMPTZ,
+    completed_at         TIMESTAMPTZ,
+    duration_ms          BIGINT
+);
+
+CREATE INDEX idx_sync_runs_storage_element_id ON sync_runs(storage_element_id, created_at DESC);
+CREATE INDEX idx_sync_runs_created_at ON sync_runs(created_at DESC);
+CREATE INDEX idx_sync_runs_active ON sync_runs(status) WHERE status IN ('queued', 'running');
+
+COMMENT ON TABLE sync_runs IS 'История задач синхронизации файлового реестра с SE';
+COMMENT ON COLUMN sync_runs.trigger IS 'Источник запуска: periodic, manual, registration';
+COMMENT ON COLUMN sync_runs.status IS 'Состояние задачи: queued, running, succeeded, failed, cancelled';
+COMMENT ON COLUMN sync_runs.requested_by IS 'Субъект, запустивший ручную синхронизацию';
+COMMENT ON COLUMN sync_runs.files_processed IS 'Количество файлов SE, обработанных на текущий момент';
+COMMENT ON COLUMN sync_runs.error IS 'Текст ошибки (для failed и cancelled)';
+COMMENT ON COLUMN sync_runs.duration_ms IS 'Длительность выполнения в миллисекундах';
@@ -0,0 +1,3 @@
+ALTER TABLE sync_runs DROP COLUMN IF EXISTS mode;
+DROP TABLE IF EXISTS sync_seen_files;
+DROP TABLE IF EXISTS sync_checkpoints;
@@ -0,0 +1,29 @@
+-- Миграция 007: инкрементальная синхронизация файлового реестра
+-- sync_checkpoints — per-SE курсор изменений (updated_at метаданных на SE).
+-- sync_seen_files — staging-таблица ID файлов, найденных на SE при полной
+-- синхронизации; удалённые файлы определяются разностью множеств в SQL.
+
+CREATE TABLE IF NOT EXISTS sync_checkpoints (
+    storage_element_id UUID PRIMARY KEY REFERENCES storage_elements(id) ON DELETE CASCADE,
+    files_cursor       TIMESTAMPTZ,
+    last_full_sync_at  TIMESTAMPTZ,
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE sync_checkpoints IS 'Курсоры инкрементальной синхронизации файлового реестра с SE';
+COMMENT ON COLUMN sync_checkpoints.files_cursor IS 'Файлы с updated_at на SE не раньше курсора запрашиваются при следующей синхронизации';
+COMMENT ON COLUMN sync_checkpoints.last_full_sync_at IS 'Время последней полной синхронизации (с поиском удалённых файлов)';
+
+-- UNLOGGED: данные временные, при сбое задача помечается failed и очищается
+CREATE UNLOGGED TABLE IF NOT EXISTS sync_seen_files (
+    run_id  UUID NOT NULL,
+    file_id UUID NOT NULL,
+    PRIMARY KEY (run_id, file_id)
+);
+
+COMMENT ON TABLE sync_seen_files IS 'Staging: ID файлов, найденных на SE в ходе полной синхронизации';
+
+ALTER TABLE sync_runs ADD COLUMN IF NOT EXISTS mode TEXT NOT NULL DEFAULT 'full'
+    CHECK (mode IN ('full', 'incremental'));
+
+COMMENT ON COLUMN sync_runs.mode IS 'Режим: full (все файлы + поиск удалённых), incremental (изменения с курсора)';
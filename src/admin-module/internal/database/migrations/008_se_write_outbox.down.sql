@@ -0,0 +1,4 @@
+-- Откат миграции 008: удаление таблицы se_write_outbox
+
+DROP TRIGGER IF EXISTS trg_se_write_outbox_updated_at ON se_write_outbox;
+DROP TABLE IF EXISTS se_write_outbox;
@@ -0,0 +1,34 @@
+-- Миграция 008: outbox записи изменений реестра на Storage Elements
+-- Изменения метаданных и удаления файлов применяются сначала на SE
+-- (attr.json — источник истины), затем в реестре. Если SE недоступен,
+-- изменение сохраняется в se_write_outbox и повторяется фоновой задачей.
+
+CREATE TABLE IF NOT EXISTS se_write_outbox (
+    id                 UUID PRIMARY KEY,
+    file_id            UUID NOT NULL UNIQUE REFERENCES file_registry(file_id) ON DELETE CASCADE,
+    storage_element_id UUID NOT NULL REFERENCES storage_elements(id) ON DELETE CASCADE,
+    operation          TEXT NOT NULL CHECK (operation IN ('update', 'delete')),
+    description        TEXT,
+    tags               TEXT[],
+    status             TEXT NOT NULL DEFAULT 'pending'
+                       CHECK (status IN ('pending', 'failed')),
+    attempts           INTEGER NOT NULL DEFAULT 0,
+    last_error         TEXT,
+    next_attempt_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Функция update_updated_at() уже создана в миграции 001
+CREATE TRIGGER trg_se_write_outbox_updated_at
+    BEFORE UPDATE ON se_write_outbox
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX idx_se_write_outbox_due ON se_write_outbox(next_attempt_at)
+    WHERE status = 'pending';
+
+COMMENT ON TABLE se_write_outbox IS 'Изменения файлов, ожидающие применения на Storage Element';
+COMMENT ON COLUMN se_write_outbox.id IS 'ID изменения; новое изменение файла заменяет запись и получает новый ID';
+COMMENT ON COLUMN se_write_outbox.operation IS 'Операция на SE: update (PATCH description/tags), delete (DELETE)';
+COMMENT ON COLUMN se_write_outbox.status IS 'pending — ожидает повтора, failed — отклонено SE';
+COMMENT ON COLUMN se_write_outbox.next_attempt_at IS 'Время следующей попытки (экспоненциальная задержка)';
@@ -0,0 +1,3 @@
+-- Откат миграции 009: удаление таблицы storage_element_labels
+
+DROP TABLE IF EXISTS storage_element_labels;
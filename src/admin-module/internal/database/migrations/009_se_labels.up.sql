@@ -0,0 +1,16 @@
+-- Миграция 009: метки Storage Elements
+-- Произвольные пары ключ=значение (zone, tier, owner), задаваемые
+-- администратором. Используются для фильтрации SE, выбора SE для загрузки
+-- и распределения реплик.
+
+CREATE TABLE IF NOT EXISTS storage_element_labels (
+    storage_element_id UUID NOT NULL REFERENCES storage_elements(id) ON DELETE CASCADE,
+    key                VARCHAR(63) NOT NULL,
+    value              VARCHAR(255) NOT NULL,
+    PRIMARY KEY (storage_element_id, key)
+);
+
+CREATE INDEX idx_storage_element_labels_key_value ON storage_element_labels(key, value);
+
+COMMENT ON TABLE storage_element_labels IS 'Метки Storage Elements (ключ=значение)';
+COMMENT ON COLUMN storage_element_labels.key IS 'Ключ метки: строчные латинские буквы, цифры, ., _, -, /';
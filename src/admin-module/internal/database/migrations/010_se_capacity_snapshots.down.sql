@@ -0,0 +1,3 @@
+-- Откат миграции 010: удаление таблицы se_capacity_snapshots
+
+DROP TABLE IF EXISTS se_capacity_snapshots;
@@ -0,0 +1,19 @@
+-- Миграция 010: история ёмкости Storage Elements
+-- Снимок capacity/used записывается при каждой синхронизации SE.
+-- Используется для графиков тренда и прогноза заполнения без Prometheus.
+-- Снимки старше AM_CAPACITY_HISTORY_RETENTION удаляются фоновой задачей.
+
+CREATE TABLE IF NOT EXISTS se_capacity_snapshots (
+    id                 BIGSERIAL PRIMARY KEY,
+    storage_element_id UUID NOT NULL REFERENCES storage_elements(id) ON DELETE CASCADE,
+    captured_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    capacity_bytes     BIGINT NOT NULL,
+    used_bytes         BIGINT NOT NULL,
+    available_bytes    BIGINT
+);
+
+CREATE INDEX idx_se_capacity_snapshots_se_captured ON se_capacity_snapshots(storage_element_id, captured_at);
+CREATE INDEX idx_se_capacity_snapshots_captured ON se_capacity_snapshots(captured_at);
+
+COMMENT ON TABLE se_capacity_snapshots IS 'Снимки ёмкости SE для тренда и прогноза заполнения';
+COMMENT ON COLUMN se_capacity_snapshots.captured_at IS 'Время снимка (синхронизации SE)';
@@ -0,0 +1,9 @@
+-- Откат миграции 011: удаление таблиц оповещений и webhooks
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS alert_states;
+DROP TABLE IF EXISTS alert_rule_endpoints;
+DROP TRIGGER IF EXISTS trg_alert_rules_updated_at ON alert_rules;
+DROP TABLE IF EXISTS alert_rules;
+DROP TRIGGER IF EXISTS trg_webhook_endpoints_updated_at ON webhook_endpoints;
+DROP TABLE IF EXISTS webhook_endpoints;
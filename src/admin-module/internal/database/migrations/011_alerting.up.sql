@@ -0,0 +1,83 @@
+-- Миграция 011: правила оповещений и доставка webhooks
+-- Правила проверяются фоновой задачей; при срабатывании и восстановлении
+-- оповещение отправляется на webhook endpoints правила. Доставки хранятся
+-- в webhook_deliveries (журнал и очередь повторов).
+
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id         UUID PRIMARY KEY,
+    name       TEXT NOT NULL UNIQUE,
+    url        TEXT NOT NULL,
+    secret     TEXT NOT NULL,
+    enabled    BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id                 UUID PRIMARY KEY,
+    name               TEXT NOT NULL UNIQUE,
+    type               TEXT NOT NULL
+                       CHECK (type IN ('se_status', 'capacity', 'capacity_forecast',
+                                       'sync_errors', 'sa_secret_expiry', 'dephealth')),
+    threshold          DOUBLE PRECISION,
+    storage_element_id UUID REFERENCES storage_elements(id) ON DELETE CASCADE,
+    enabled            BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS alert_rule_endpoints (
+    rule_id     UUID NOT NULL REFERENCES alert_rules(id) ON DELETE CASCADE,
+    endpoint_id UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    PRIMARY KEY (rule_id, endpoint_id)
+);
+
+CREATE TABLE IF NOT EXISTS alert_states (
+    rule_id      UUID NOT NULL REFERENCES alert_rules(id) ON DELETE CASCADE,
+    subject      TEXT NOT NULL,
+    subject_name TEXT NOT NULL,
+    message      TEXT NOT NULL,
+    firing_since TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (rule_id, subject)
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              UUID PRIMARY KEY,
+    endpoint_id     UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    rule_id         UUID REFERENCES alert_rules(id) ON DELETE SET NULL,
+    event           TEXT NOT NULL,
+    payload         JSONB NOT NULL,
+    status          TEXT NOT NULL DEFAULT 'pending'
+                    CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    response_status INTEGER,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at    TIMESTAMPTZ
+);
+
+-- Функция update_updated_at() уже создана в миграции 001
+CREATE TRIGGER trg_webhook_endpoints_updated_at
+    BEFORE UPDATE ON webhook_endpoints
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE TRIGGER trg_alert_rules_updated_at
+    BEFORE UPDATE ON alert_rules
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at)
+    WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_endpoint ON webhook_deliveries(endpoint_id, created_at DESC);
+CREATE INDEX idx_webhook_deliveries_created ON webhook_deliveries(created_at);
+
+COMMENT ON TABLE webhook_endpoints IS 'Получатели оповещений (HTTP POST с HMAC-подписью)';
+COMMENT ON COLUMN webhook_endpoints.secret IS 'Ключ HMAC-SHA256 подписи запросов';
+COMMENT ON TABLE alert_rules IS 'Правила оповещений о состоянии SE, синхронизации, SA и зависимостей';
+COMMENT ON COLUMN alert_rules.threshold IS 'Порог: % заполнения, дней до заполнения, неудачных синхронизаций подряд или дней до истечения секрета';
+COMMENT ON COLUMN alert_rules.storage_element_id IS 'Ограничение правила одним SE (NULL — все SE)';
+COMMENT ON TABLE alert_states IS 'Сработавшие оповещения (одна запись на правило и объект)';
+COMMENT ON COLUMN alert_states.subject IS 'Объект оповещения: UUID SE, UUID SA или имя зависимости';
+COMMENT ON TABLE webhook_deliveries IS 'Журнал и очередь доставки webhooks';
+COMMENT ON COLUMN webhook_deliveries.event IS 'Событие: alert.firing, alert.resolved, webhook.test';
+COMMENT ON COLUMN webhook_deliveries.status IS 'pending — ожидает попытки, delivered — доставлено, failed — попытки исчерпаны';
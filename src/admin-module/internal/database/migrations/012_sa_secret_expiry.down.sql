@@ -0,0 +1,6 @@
+-- Откат миграции 012: удаление полей срока действия секретов SA
+
+DROP INDEX IF EXISTS idx_service_accounts_secret_issued_at;
+ALTER TABLE service_accounts
+    DROP COLUMN IF EXISTS previous_secret_expires_at,
+    DROP COLUMN IF EXISTS secret_issued_at;
@@ -0,0 +1,15 @@
+-- Миграция 012: срок действия секретов Service Accounts
+-- secret_issued_at — время выдачи текущего секрета; срок истечения
+-- вычисляется как secret_issued_at + AM_SA_SECRET_LIFETIME.
+-- Существующим SA время выдачи устанавливается на момент миграции,
+-- чтобы обновление не приостановило давно созданные аккаунты.
+
+ALTER TABLE service_accounts
+    ADD COLUMN IF NOT EXISTS secret_issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS previous_secret_expires_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_service_accounts_secret_issued_at
+    ON service_accounts (secret_issued_at) WHERE status = 'active';
+
+COMMENT ON COLUMN service_accounts.secret_issued_at IS 'Время выдачи текущего секрета (создание SA или ротация)';
+COMMENT ON COLUMN service_accounts.previous_secret_expires_at IS 'До этого времени после ротации действует предыдущий секрет';
@@ -0,0 +1,3 @@
+-- Откат миграции 013: удаление таблицы sa_resource_grants
+
+DROP TABLE IF EXISTS sa_resource_grants;
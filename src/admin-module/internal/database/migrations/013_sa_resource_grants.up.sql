@@ -0,0 +1,26 @@
+-- Миграция 013: ограничения scopes Service Accounts по ресурсам
+-- Grant сужает scope SA: только указанные SE (по ID или меткам), политики
+-- хранения и/или только свои файлы. Grants SA передаются в Keycloak и
+-- попадают в токен claim artstore_grants.
+
+CREATE TABLE IF NOT EXISTS sa_resource_grants (
+    id                 UUID PRIMARY KEY,
+    service_account_id UUID NOT NULL REFERENCES service_accounts(id) ON DELETE CASCADE,
+    scope              VARCHAR(50) NOT NULL
+        CHECK (scope IN ('files:read', 'files:write', 'storage:read', 'storage:write')),
+    se_ids             UUID[] NOT NULL DEFAULT '{}',
+    se_labels          JSONB NOT NULL DEFAULT '{}',
+    retention_policies TEXT[] NOT NULL DEFAULT '{}',
+    own_files_only     BOOLEAN NOT NULL DEFAULT false,
+    position           INTEGER NOT NULL DEFAULT 0,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_sa_resource_grants_sa ON sa_resource_grants(service_account_id, position);
+
+COMMENT ON TABLE sa_resource_grants IS 'Ограничения scopes Service Accounts по ресурсам';
+COMMENT ON COLUMN sa_resource_grants.se_ids IS 'Разрешённые SE (пусто — без ограничения по ID)';
+COMMENT ON COLUMN sa_resource_grants.se_labels IS 'Метки, которые должны быть у разрешённого SE (пусто — без ограничения)';
+COMMENT ON COLUMN sa_resource_grants.retention_policies IS 'Разрешённые политики хранения файлов (пусто — любые)';
+COMMENT ON COLUMN sa_resource_grants.own_files_only IS 'Только файлы, загруженные самим SA';
+COMMENT ON COLUMN sa_resource_grants.position IS 'Порядок grant в списке SA';
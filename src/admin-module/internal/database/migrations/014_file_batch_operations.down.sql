@@ -0,0 +1,4 @@
+-- Откат миграции 014: удаление таблиц групповых операций над файлами
+
+DROP TABLE IF EXISTS file_batch_results;
+DROP TABLE IF EXISTS file_batch_operations;
@@ -0,0 +1,51 @@
+-- Миграция 014: групповые операции над файлами
+-- Фоновая операция (удаление, изменение тегов или статуса) над набором
+-- файлов реестра. Набор фиксируется при создании операции в
+-- file_batch_results — по одной строке на файл с результатом обработки.
+
+CREATE TABLE IF NOT EXISTS file_batch_operations (
+    id            UUID PRIMARY KEY,
+    action        TEXT NOT NULL
+                  CHECK (action IN ('delete', 'add_tags', 'remove_tags', 'set_status')),
+    tags          TEXT[] NOT NULL DEFAULT '{}',
+    target_status TEXT,
+    status        TEXT NOT NULL DEFAULT 'queued'
+                  CHECK (status IN ('queued', 'running', 'succeeded', 'failed', 'cancelled')),
+    requested_by  TEXT,
+    total         INTEGER NOT NULL DEFAULT 0,
+    processed     INTEGER NOT NULL DEFAULT 0,
+    succeeded     INTEGER NOT NULL DEFAULT 0,
+    skipped       INTEGER NOT NULL DEFAULT 0,
+    failed        INTEGER NOT NULL DEFAULT 0,
+    error         TEXT,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at    TIMESTAMPTZ,
+    completed_at  TIMESTAMPTZ,
+    duration_ms   BIGINT
+);
+
+CREATE INDEX idx_file_batch_operations_created_at ON file_batch_operations(created_at DESC);
+
+CREATE TABLE IF NOT EXISTS file_batch_results (
+    operation_id UUID NOT NULL REFERENCES file_batch_operations(id) ON DELETE CASCADE,
+    file_id      UUID NOT NULL,
+    position     INTEGER NOT NULL,
+    status       TEXT NOT NULL DEFAULT 'queued'
+                 CHECK (status IN ('queued', 'succeeded', 'pending', 'skipped', 'failed', 'cancelled')),
+    error        TEXT,
+    processed_at TIMESTAMPTZ,
+    PRIMARY KEY (operation_id, file_id)
+);
+
+CREATE INDEX idx_file_batch_results_status ON file_batch_results(operation_id, status, position);
+
+COMMENT ON TABLE file_batch_operations IS 'Групповые операции над файлами реестра';
+COMMENT ON COLUMN file_batch_operations.action IS 'Действие: delete, add_tags, remove_tags, set_status';
+COMMENT ON COLUMN file_batch_operations.tags IS 'Теги для add_tags и remove_tags';
+COMMENT ON COLUMN file_batch_operations.target_status IS 'Новый статус файлов для set_status';
+COMMENT ON COLUMN file_batch_operations.status IS 'Состояние операции: queued, running, succeeded, failed, cancelled';
+COMMENT ON COLUMN file_batch_operations.requested_by IS 'Субъект, запустивший операцию';
+COMMENT ON COLUMN file_batch_operations.error IS 'Текст ошибки (для failed и cancelled)';
+COMMENT ON TABLE file_batch_results IS 'Результаты групповой операции по файлам';
+COMMENT ON COLUMN file_batch_results.position IS 'Порядок обработки файла в операции';
+COMMENT ON COLUMN file_batch_results.status IS 'Результат: queued, succeeded, pending (ожидает записи на SE), skipped, failed, cancelled';
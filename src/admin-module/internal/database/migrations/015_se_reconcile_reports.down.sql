@@ -0,0 +1,4 @@
+-- Откат миграции 015: удаление отчётов сверки SE
+
+DROP TABLE IF EXISTS se_reconcile_issues;
+DROP TABLE IF EXISTS se_reconcile_reports;
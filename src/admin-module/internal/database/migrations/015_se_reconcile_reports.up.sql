@@ -0,0 +1,45 @@
+-- Миграция 015: отчёты сверки Storage Elements
+-- Сверка attr.json с файловой системой SE (POST /api/v1/maintenance/reconcile),
+-- запущенная из Admin Module. Отчёт хранит сводку, проблемы — в
+-- se_reconcile_issues (не более лимита на отчёт, полное число — issues_total).
+
+CREATE TABLE IF NOT EXISTS se_reconcile_reports (
+    id                  UUID PRIMARY KEY,
+    storage_element_id  UUID NOT NULL REFERENCES storage_elements(id) ON DELETE CASCADE,
+    status              TEXT NOT NULL DEFAULT 'running'
+                        CHECK (status IN ('running', 'succeeded', 'failed')),
+    requested_by        TEXT,
+    files_checked       INTEGER NOT NULL DEFAULT 0,
+    files_ok            INTEGER NOT NULL DEFAULT 0,
+    orphaned_files      INTEGER NOT NULL DEFAULT 0,
+    missing_files       INTEGER NOT NULL DEFAULT 0,
+    checksum_mismatches INTEGER NOT NULL DEFAULT 0,
+    size_mismatches     INTEGER NOT NULL DEFAULT 0,
+    issues_total        INTEGER NOT NULL DEFAULT 0,
+    error               TEXT,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at        TIMESTAMPTZ,
+    duration_ms         BIGINT
+);
+
+CREATE INDEX idx_se_reconcile_reports_se ON se_reconcile_reports(storage_element_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS se_reconcile_issues (
+    report_id   UUID NOT NULL REFERENCES se_reconcile_reports(id) ON DELETE CASCADE,
+    position    INTEGER NOT NULL,
+    type        TEXT NOT NULL,
+    file_id     UUID,
+    path        TEXT,
+    description TEXT NOT NULL,
+    PRIMARY KEY (report_id, position)
+);
+
+CREATE INDEX idx_se_reconcile_issues_file_id ON se_reconcile_issues(file_id) WHERE file_id IS NOT NULL;
+
+COMMENT ON TABLE se_reconcile_reports IS 'Отчёты сверки attr.json с файловой системой SE';
+COMMENT ON COLUMN se_reconcile_reports.status IS 'Состояние: running, succeeded, failed';
+COMMENT ON COLUMN se_reconcile_reports.requested_by IS 'Субъект, запустивший сверку';
+COMMENT ON COLUMN se_reconcile_reports.issues_total IS 'Количество проблем в ответе SE (сохраняются не все)';
+COMMENT ON COLUMN se_reconcile_reports.error IS 'Текст ошибки (для failed)';
+COMMENT ON TABLE se_reconcile_issues IS 'Проблемы, обнаруженные сверкой SE';
+COMMENT ON COLUMN se_reconcile_issues.type IS 'Тип: orphaned_file, missing_file, checksum_mismatch, size_mismatch, orphaned_attr';
@@ -0,0 +1,3 @@
+-- Откат миграции 016: удаление таблицы ui_sessions
+
+DROP TABLE IF EXISTS ui_sessions;
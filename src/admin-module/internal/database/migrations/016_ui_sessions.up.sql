@@ -0,0 +1,35 @@
+-- Миграция 016: серверные сессии Admin UI
+-- В cookie artstore_session хранится только непрозрачный токен; в БД —
+-- его SHA-256, данные пользователя и зашифрованные (AES-256-GCM) токены
+-- Keycloak. Удаление строки завершает сессию.
+
+CREATE TABLE IF NOT EXISTS ui_sessions (
+    id           UUID PRIMARY KEY,
+    token_hash   BYTEA NOT NULL UNIQUE,
+    key_id       VARCHAR(16) NOT NULL,
+    subject      TEXT NOT NULL,
+    username     VARCHAR(255) NOT NULL,
+    email        VARCHAR(255) NOT NULL DEFAULT '',
+    idp_role     VARCHAR(50) NOT NULL DEFAULT '',
+    role         VARCHAR(50) NOT NULL DEFAULT '',
+    groups       TEXT[] NOT NULL DEFAULT '{}',
+    tokens       TEXT NOT NULL,
+    remote_addr  VARCHAR(255) NOT NULL DEFAULT '',
+    user_agent   TEXT NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_ui_sessions_subject ON ui_sessions(subject, last_seen_at DESC);
+CREATE INDEX idx_ui_sessions_expires ON ui_sessions(expires_at);
+
+COMMENT ON TABLE ui_sessions IS 'Серверные сессии Admin UI';
+COMMENT ON COLUMN ui_sessions.token_hash IS 'SHA-256 токена из cookie artstore_session';
+COMMENT ON COLUMN ui_sessions.key_id IS 'Отпечаток ключа AM_UI_SESSION_SECRET, которым зашифрованы токены';
+COMMENT ON COLUMN ui_sessions.subject IS 'sub пользователя (Keycloak user ID)';
+COMMENT ON COLUMN ui_sessions.idp_role IS 'Роль из групп IdP на момент входа';
+COMMENT ON COLUMN ui_sessions.role IS 'Effective роль сессии (роль IdP + role override)';
+COMMENT ON COLUMN ui_sessions.tokens IS 'Access и refresh token Keycloak, зашифрованные AES-256-GCM';
+COMMENT ON COLUMN ui_sessions.last_seen_at IS 'Последняя активность (для таймаута неактивности)';
+COMMENT ON COLUMN ui_sessions.expires_at IS 'Абсолютный срок действия сессии';
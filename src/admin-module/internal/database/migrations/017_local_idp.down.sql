@@ -0,0 +1,5 @@
+-- Откат миграции 017: удаление таблиц локального IdP
+
+DROP TABLE IF EXISTS jwt_signing_keys;
+DROP TABLE IF EXISTS local_idp_clients;
+DROP TABLE IF EXISTS local_idp_users;
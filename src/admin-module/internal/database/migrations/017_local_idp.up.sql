@@ -0,0 +1,59 @@
+-- Миграция 017: встроенный локальный Identity Provider (AM_IDP_PROVIDER=local)
+-- Пользователи Admin UI (пароли bcrypt, блокировка после неудачных входов),
+-- OAuth2-клиенты Service Accounts (SHA-256 секретов) и ключи подписи JWT,
+-- публикуемые через /.well-known/jwks.json.
+
+CREATE TABLE IF NOT EXISTS local_idp_users (
+    id            UUID PRIMARY KEY,
+    username      VARCHAR(255) NOT NULL UNIQUE,
+    email         VARCHAR(255) NOT NULL DEFAULT '',
+    first_name    VARCHAR(255) NOT NULL DEFAULT '',
+    last_name     VARCHAR(255) NOT NULL DEFAULT '',
+    password_hash TEXT NOT NULL,
+    enabled       BOOLEAN NOT NULL DEFAULT TRUE,
+    groups        TEXT[] NOT NULL DEFAULT '{}',
+    failed_logins INTEGER NOT NULL DEFAULT 0,
+    locked_until  TIMESTAMPTZ,
+    last_login_at TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE local_idp_users IS 'Пользователи локального IdP';
+COMMENT ON COLUMN local_idp_users.username IS 'Имя пользователя (в нижнем регистре)';
+COMMENT ON COLUMN local_idp_users.password_hash IS 'bcrypt-хеш пароля';
+COMMENT ON COLUMN local_idp_users.groups IS 'Группы пользователя (роль через AM_ROLE_ADMIN_GROUPS / AM_ROLE_READONLY_GROUPS)';
+COMMENT ON COLUMN local_idp_users.failed_logins IS 'Неудачные попытки входа подряд';
+COMMENT ON COLUMN local_idp_users.locked_until IS 'Вход заблокирован до этого момента';
+
+CREATE TABLE IF NOT EXISTS local_idp_clients (
+    id                         UUID PRIMARY KEY,
+    client_id                  VARCHAR(255) NOT NULL UNIQUE,
+    name                       VARCHAR(255) NOT NULL DEFAULT '',
+    description                TEXT NOT NULL DEFAULT '',
+    enabled                    BOOLEAN NOT NULL DEFAULT TRUE,
+    scopes                     TEXT[] NOT NULL DEFAULT '{}',
+    secret_hash                BYTEA NOT NULL,
+    previous_secret_hash       BYTEA,
+    previous_secret_expires_at TIMESTAMPTZ,
+    claims                     JSONB NOT NULL DEFAULT '{}',
+    created_at                 TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at                 TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE local_idp_clients IS 'OAuth2-клиенты локального IdP (Client Credentials grant)';
+COMMENT ON COLUMN local_idp_clients.secret_hash IS 'SHA-256 текущего секрета клиента';
+COMMENT ON COLUMN local_idp_clients.previous_secret_hash IS 'SHA-256 предыдущего секрета, действующего после ротации';
+COMMENT ON COLUMN local_idp_clients.claims IS 'Дополнительные claims access token (например, artstore_grants)';
+
+CREATE TABLE IF NOT EXISTS jwt_signing_keys (
+    kid         VARCHAR(64) PRIMARY KEY,
+    algorithm   VARCHAR(16) NOT NULL,
+    private_key BYTEA NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_jwt_signing_keys_created ON jwt_signing_keys(created_at DESC);
+
+COMMENT ON TABLE jwt_signing_keys IS 'Ключи подписи JWT локального IdP';
+COMMENT ON COLUMN jwt_signing_keys.private_key IS 'Закрытый ключ (PKCS#8 DER)';
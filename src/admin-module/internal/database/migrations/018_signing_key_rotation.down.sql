@@ -0,0 +1,14 @@
+-- Откат миграции 018: удаление столбцов ротации ключей подписи.
+-- Зашифрованные ключи без мастер-ключа непригодны — они удаляются,
+-- локальный IdP создаст новый ключ при старте.
+
+DELETE FROM jwt_signing_keys WHERE master_key_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_jwt_signing_keys_activates;
+CREATE INDEX idx_jwt_signing_keys_created ON jwt_signing_keys(created_at DESC);
+
+ALTER TABLE jwt_signing_keys
+    DROP COLUMN IF EXISTS master_key_id,
+    DROP COLUMN IF EXISTS activates_at;
+
+COMMENT ON COLUMN jwt_signing_keys.private_key IS 'Закрытый ключ (PKCS#8 DER)';
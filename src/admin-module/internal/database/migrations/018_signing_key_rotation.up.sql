@@ -0,0 +1,23 @@
+-- Миграция 018: ротация и шифрование ключей подписи JWT локального IdP
+-- activates_at — момент, с которого ключ подписывает токены (новый ключ
+-- публикуется в JWKS заранее); заменённый ключ остаётся в JWKS на время
+-- AM_KEYS_OVERLAP после активации следующего.
+-- master_key_id — отпечаток мастер-ключа (AM_KEYS_MASTER_KEY), которым
+-- зашифрован private_key; NULL — ключ ещё не зашифрован (создан до миграции).
+
+ALTER TABLE jwt_signing_keys
+    ADD COLUMN activates_at  TIMESTAMPTZ,
+    ADD COLUMN master_key_id VARCHAR(16);
+
+UPDATE jwt_signing_keys SET activates_at = created_at;
+
+ALTER TABLE jwt_signing_keys
+    ALTER COLUMN activates_at SET NOT NULL,
+    ALTER COLUMN activates_at SET DEFAULT NOW();
+
+DROP INDEX IF EXISTS idx_jwt_signing_keys_created;
+CREATE INDEX idx_jwt_signing_keys_activates ON jwt_signing_keys(activates_at DESC);
+
+COMMENT ON COLUMN jwt_signing_keys.private_key IS 'Закрытый ключ (PKCS#8 DER), зашифрованный AES-256-GCM мастер-ключом';
+COMMENT ON COLUMN jwt_signing_keys.activates_at IS 'Начало подписи токенов этим ключом';
+COMMENT ON COLUMN jwt_signing_keys.master_key_id IS 'Отпечаток мастер-ключа шифрования (NULL — не зашифрован)';
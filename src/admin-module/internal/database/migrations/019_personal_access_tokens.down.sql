@@ -0,0 +1,3 @@
+-- Откат миграции 019: удаление таблицы personal_access_tokens
+
+DROP TABLE IF EXISTS personal_access_tokens;
@@ -0,0 +1,29 @@
+-- Миграция 019: personal access tokens пользователей Admin Module
+-- Токен показывается пользователю один раз при создании; в БД хранится
+-- только его SHA-256 и первые символы для распознавания в списке.
+-- Удаление строки отзывает токен.
+
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id           UUID PRIMARY KEY,
+    token_hash   BYTEA NOT NULL UNIQUE,
+    token_prefix VARCHAR(16) NOT NULL,
+    subject      TEXT NOT NULL,
+    username     VARCHAR(255) NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    scopes       TEXT[] NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    last_used_ip VARCHAR(255) NOT NULL DEFAULT '',
+    UNIQUE (subject, name)
+);
+
+CREATE INDEX idx_personal_access_tokens_subject ON personal_access_tokens(subject, created_at DESC);
+CREATE INDEX idx_personal_access_tokens_expires ON personal_access_tokens(expires_at);
+
+COMMENT ON TABLE personal_access_tokens IS 'Personal access tokens пользователей для доступа к Admin REST API';
+COMMENT ON COLUMN personal_access_tokens.token_hash IS 'SHA-256 токена';
+COMMENT ON COLUMN personal_access_tokens.token_prefix IS 'Начало токена для распознавания в списке';
+COMMENT ON COLUMN personal_access_tokens.subject IS 'sub владельца (ID пользователя в IdP)';
+COMMENT ON COLUMN personal_access_tokens.scopes IS 'Области API, доступные токену (files, storage, admin × read, write)';
+COMMENT ON COLUMN personal_access_tokens.last_used_at IS 'Последнее использование (обновляется не чаще раза в минуту)';
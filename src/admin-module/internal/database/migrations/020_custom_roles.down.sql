@@ -0,0 +1,11 @@
+-- Откат миграции 020: удаление пользовательских ролей
+-- Overrides на пользовательские роли удаляются: их нельзя выразить
+-- ограничением на admin/readonly.
+
+DELETE FROM role_overrides WHERE additional_role NOT IN ('admin', 'readonly');
+
+ALTER TABLE role_overrides
+    ADD CONSTRAINT role_overrides_additional_role_check
+    CHECK (additional_role IN ('admin', 'readonly'));
+
+DROP TABLE IF EXISTS roles;
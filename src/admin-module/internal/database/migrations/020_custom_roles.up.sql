@@ -0,0 +1,35 @@
+-- Миграция 020: пользовательские роли с матрицей разрешений
+-- Встроенные роли admin и readonly определены в коде (группы — из
+-- AM_ROLE_ADMIN_GROUPS / AM_ROLE_READONLY_GROUPS); здесь хранятся
+-- дополнительные роли: набор разрешений "<ресурс>:<действие>" и группы
+-- IdP, членство в которых даёт роль. Role override может ссылаться на
+-- любую роль каталога, поэтому ограничение на admin/readonly снимается.
+
+CREATE TABLE IF NOT EXISTS roles (
+    name        VARCHAR(50) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    groups      TEXT[] NOT NULL DEFAULT '{}',
+    created_by  TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_roles_updated_at
+    BEFORE UPDATE ON roles
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+COMMENT ON TABLE roles IS 'Пользовательские роли Admin Module (встроенные admin и readonly — в коде)';
+COMMENT ON COLUMN roles.name IS 'Имя роли (slug: строчные латинские буквы, цифры, дефис)';
+COMMENT ON COLUMN roles.permissions IS 'Разрешения вида <ресурс>:<действие> (files:write, storage:sync, ...)';
+COMMENT ON COLUMN roles.groups IS 'Группы IdP, членство в которых даёт роль';
+
+-- Типовые роли: оператор хранилищ и куратор файлов (группы назначаются в UI)
+INSERT INTO roles (name, description, permissions, created_by) VALUES
+    ('storage-operator', 'Оператор хранилищ: регистрация, синхронизация и сверка Storage Elements',
+     ARRAY['files:read', 'storage:read', 'storage:write', 'storage:sync'], 'migration'),
+    ('file-curator', 'Куратор файлов: изменение метаданных файлов',
+     ARRAY['files:read', 'files:write'], 'migration')
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE role_overrides DROP CONSTRAINT IF EXISTS role_overrides_additional_role_check;
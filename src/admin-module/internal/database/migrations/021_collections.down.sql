@@ -0,0 +1,7 @@
+-- Откат миграции 021: удаление коллекций файлов
+
+DROP INDEX IF EXISTS idx_file_registry_collection_id;
+ALTER TABLE file_registry DROP COLUMN IF EXISTS collection_id;
+
+DROP TABLE IF EXISTS collection_members;
+DROP TABLE IF EXISTS collections;
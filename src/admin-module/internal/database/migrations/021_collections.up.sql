@@ -0,0 +1,50 @@
+-- Миграция 021: коллекции (namespaces) файлов
+-- Коллекция изолирует файлы команды: файл коллекции виден и изменяется
+-- только участниками с соответствующим правом (read, write, delete) и
+-- пользователями с разрешением collections:write. Файлы без коллекции
+-- остаются в общем пространстве. collection_id файла хранится в attr.json
+-- на SE и попадает в реестр при синхронизации, поэтому внешний ключ не
+-- используется: файл неизвестной коллекции не становится общим.
+
+CREATE TABLE IF NOT EXISTS collections (
+    id          UUID PRIMARY KEY,
+    name        VARCHAR(50) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    quota_bytes BIGINT CHECK (quota_bytes > 0),
+    quota_files BIGINT CHECK (quota_files > 0),
+    created_by  TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_collections_updated_at
+    BEFORE UPDATE ON collections
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+COMMENT ON TABLE collections IS 'Коллекции (namespaces) файлов для изоляции команд';
+COMMENT ON COLUMN collections.name IS 'Имя коллекции (slug: строчные латинские буквы, цифры, дефис)';
+COMMENT ON COLUMN collections.quota_bytes IS 'Квота на суммарный размер активных файлов (NULL — без ограничения)';
+COMMENT ON COLUMN collections.quota_files IS 'Квота на количество активных файлов (NULL — без ограничения)';
+
+CREATE TABLE IF NOT EXISTS collection_members (
+    collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    subject_type  VARCHAR(20) NOT NULL CHECK (subject_type IN ('user', 'service_account')),
+    subject_id    VARCHAR(255) NOT NULL,
+    permissions   TEXT[] NOT NULL,
+    added_by      TEXT NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (collection_id, subject_type, subject_id)
+);
+
+CREATE INDEX idx_collection_members_subject ON collection_members(subject_id);
+
+COMMENT ON TABLE collection_members IS 'Участники коллекций: пользователи и Service Accounts';
+COMMENT ON COLUMN collection_members.subject_id IS 'username пользователя или client_id Service Account';
+COMMENT ON COLUMN collection_members.permissions IS 'Права в коллекции: read, write, delete';
+
+ALTER TABLE file_registry ADD COLUMN IF NOT EXISTS collection_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_file_registry_collection_id
+    ON file_registry(collection_id) WHERE collection_id IS NOT NULL;
+
+COMMENT ON COLUMN file_registry.collection_id IS 'Коллекция файла из attr.json (NULL — общее пространство)';
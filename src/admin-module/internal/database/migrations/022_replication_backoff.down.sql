@@ -0,0 +1,6 @@
+-- Откат миграции 022: удаление задержки повторов репликации
+
+DROP TABLE IF EXISTS file_replication_deferrals;
+
+DROP INDEX IF EXISTS idx_file_replicas_next_attempt_at;
+ALTER TABLE file_replicas DROP COLUMN IF EXISTS next_attempt_at;
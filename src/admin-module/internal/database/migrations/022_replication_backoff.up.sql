@@ -0,0 +1,34 @@
+-- Миграция 022: экспоненциальная задержка повторов репликации
+-- Файлы, копирование которых завершилось ошибкой или было пропущено
+-- (нет доступного источника или целевых SE), исключаются из выборки
+-- ListUnderReplicated до next_attempt_at и не вытесняют новые файлы.
+
+ALTER TABLE file_replicas
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_file_replicas_next_attempt_at
+    ON file_replicas (next_attempt_at) WHERE status = 'failed';
+
+COMMENT ON COLUMN file_replicas.next_attempt_at IS 'Время следующей попытки копирования failed-реплики (экспоненциальная задержка)';
+
+CREATE TABLE IF NOT EXISTS file_replication_deferrals (
+    file_id         UUID PRIMARY KEY REFERENCES file_registry(file_id) ON DELETE CASCADE,
+    reason          TEXT NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Функция update_updated_at() уже создана в миграции 001
+CREATE TRIGGER trg_file_replication_deferrals_updated_at
+    BEFORE UPDATE ON file_replication_deferrals
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX idx_file_replication_deferrals_next_attempt_at
+    ON file_replication_deferrals (next_attempt_at);
+
+COMMENT ON TABLE file_replication_deferrals IS 'Файлы, репликация которых отложена: нет источника или целевых SE';
+COMMENT ON COLUMN file_replication_deferrals.reason IS 'Причина пропуска: no_source, no_targets';
+COMMENT ON COLUMN file_replication_deferrals.attempts IS 'Количество пропусков подряд';
+COMMENT ON COLUMN file_replication_deferrals.next_attempt_at IS 'Время следующей попытки (экспоненциальная задержка)';
@@ -0,0 +1,124 @@
+package grants
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSet_Allows(t *testing.T) {
+	set := Set{
+		{Scope: ScopeFilesWrite, SE: []string{"se-edge-1", "edge-1"}, Retention: []string{"temporary"}},
+		{Scope: ScopeFilesRead, Own: true},
+	}
+
+	tests := []struct {
+		name   string
+		scope  string
+		access Access
+		want   bool
+	}{
+		{
+			name:   "разрешённый SE и политика",
+			scope:  ScopeFilesWrite,
+			access: Access{SE: []string{"se-edge-1"}, Retention: "temporary"},
+			want:   true,
+		},
+		{
+			name:   "совпадение по storage_id",
+			scope:  ScopeFilesWrite,
+			access: Access{SE: []string{"other-uuid", "edge-1"}},
+			want:   true,
+		},
+		{
+			name:   "запрет: чужой SE",
+			scope:  ScopeFilesWrite,
+			access: Access{SE: []string{"se-core-1"}, Retention: "temporary"},
+			want:   false,
+		},
+		{
+			name:   "запрет: политика хранения",
+			scope:  ScopeFilesWrite,
+			access: Access{SE: []string{"se-edge-1"}, Retention: "permanent"},
+			want:   false,
+		},
+		{
+			name:   "свой файл",
+			scope:  ScopeFilesRead,
+			access: Access{SE: []string{"se-core-1"}, UploadedBy: "sa_ingest"},
+			want:   true,
+		},
+		{
+			name:   "запрет: чужой файл",
+			scope:  ScopeFilesRead,
+			access: Access{SE: []string{"se-core-1"}, UploadedBy: "sa_other"},
+			want:   false,
+		},
+		{
+			name:   "scope без grants не ограничен",
+			scope:  ScopeStorageRead,
+			access: Access{SE: []string{"se-core-1"}},
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := set.Allows(tt.scope, tt.access, "kc-sub-1", "sa_ingest"); got != tt.want {
+				t.Errorf("Allows() = %v, хотели %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_AllowsAnyGrant(t *testing.T) {
+	set := Set{
+		{Scope: ScopeFilesWrite, SE: []string{"se-a"}},
+		{Scope: ScopeFilesWrite, SE: []string{"se-b"}},
+	}
+	if !set.Allows(ScopeFilesWrite, Access{SE: []string{"se-b"}}) {
+		t.Error("доступ должен разрешаться, если подходит хотя бы один grant")
+	}
+	if set.Allows(ScopeFilesWrite, Access{SE: []string{"se-c"}}) {
+		t.Error("доступ к SE вне grants должен запрещаться")
+	}
+}
+
+func TestSet_SEs(t *testing.T) {
+	set := Set{
+		{Scope: ScopeStorageRead, SE: []string{"se-a", "edge-a"}},
+		{Scope: ScopeStorageRead, SE: []string{"se-b", "se-a"}},
+		{Scope: ScopeFilesRead, SE: nil, Own: true},
+		{Scope: ScopeFilesRead, SE: []string{"se-a"}},
+		{Scope: ScopeStorageWrite, SE: []string{}},
+	}
+	if got := set.SEs(ScopeStorageRead); len(got) != 3 {
+		t.Errorf("SEs(storage:read) = %v, хотели объединение без повторов", got)
+	}
+	if got := set.SEs(ScopeFilesRead); got != nil {
+		t.Errorf("SEs(files:read) = %v, хотели nil (есть grant без ограничения SE)", got)
+	}
+	if got := set.SEs(ScopeStorageWrite); got == nil || len(got) != 0 {
+		t.Errorf("SEs(storage:write) = %v, хотели пустой список", got)
+	}
+	if got := set.SEs(ScopeFilesWrite); got != nil {
+		t.Errorf("SEs(files:write) = %v, хотели nil (scope не ограничен)", got)
+	}
+}
+
+func TestGrant_EmptySEDeniesAll(t *testing.T) {
+	var set Set
+	// Метка, которой нет ни у одного SE, разрешается в пустой список
+	if err := json.Unmarshal([]byte(`[{"scope":"files:write","se":[]}]`), &set); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if set.Allows(ScopeFilesWrite, Access{SE: []string{"se-a"}}) {
+		t.Error("пустой список SE должен запрещать доступ к любому SE")
+	}
+
+	if err := json.Unmarshal([]byte(`[{"scope":"files:write","se":null,"own":true}]`), &set); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !set.Allows(ScopeFilesWrite, Access{SE: []string{"se-a"}}) {
+		t.Error("se: null не должен ограничивать SE")
+	}
+}
@@ -0,0 +1,128 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Типы правил оповещений.
+const (
+	// AlertRuleSEStatus — SE не в статусе online
+	AlertRuleSEStatus = "se_status"
+	// AlertRuleCapacity — заполнение SE не меньше порога (%)
+	AlertRuleCapacity = "capacity"
+	// AlertRuleCapacityForecast — прогноз заполнения SE не больше порога (дней)
+	AlertRuleCapacityForecast = "capacity_forecast"
+	// AlertRuleSyncErrors — неудачные синхронизации SE подряд не меньше порога
+	AlertRuleSyncErrors = "sync_errors"
+	// AlertRuleSASecretExpiry — секрет SA истекает не позже чем через порог (дней)
+	AlertRuleSASecretExpiry = "sa_secret_expiry"
+	// AlertRuleDephealth — последняя проверка зависимости dephealth неуспешна
+	AlertRuleDephealth = "dephealth"
+)
+
+// События webhook.
+const (
+	// WebhookEventFiring — оповещение сработало
+	WebhookEventFiring = "alert.firing"
+	// WebhookEventResolved — условие оповещения больше не выполняется
+	WebhookEventResolved = "alert.resolved"
+	// WebhookEventTest — тестовая доставка
+	WebhookEventTest = "webhook.test"
+)
+
+// Статусы доставки webhook.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// AlertRule — правило оповещения.
+// Хранится в таблице alert_rules, получатели — в alert_rule_endpoints.
+type AlertRule struct {
+	// ID — UUID правила
+	ID string
+	// Name — уникальное имя правила
+	Name string
+	// Type — тип правила (AlertRule*)
+	Type string
+	// Threshold — порог срабатывания; nil для se_status и dephealth
+	Threshold *float64
+	// StorageElementID — ограничение правила одним SE (nil — все SE)
+	StorageElementID *string
+	// Enabled — правило проверяется
+	Enabled bool
+	// EndpointIDs — webhook endpoints, получающие оповещения правила
+	EndpointIDs []string
+	// CreatedAt — время создания
+	CreatedAt time.Time
+	// UpdatedAt — время последнего обновления
+	UpdatedAt time.Time
+}
+
+// Alert — сработавшее оповещение: правило и объект, для которого выполнено условие.
+// Хранится в таблице alert_states до восстановления.
+type Alert struct {
+	// RuleID — UUID правила
+	RuleID string
+	// RuleName, RuleType — имя и тип правила (только при чтении)
+	RuleName string
+	RuleType string
+	// Subject — объект: UUID SE, UUID SA или имя зависимости
+	Subject string
+	// SubjectName — человекочитаемое имя объекта
+	SubjectName string
+	// Message — описание условия
+	Message string
+	// FiringSince — время срабатывания
+	FiringSince time.Time
+}
+
+// WebhookEndpoint — получатель оповещений.
+// Хранится в таблице webhook_endpoints.
+type WebhookEndpoint struct {
+	// ID — UUID endpoint
+	ID string
+	// Name — уникальное имя
+	Name string
+	// URL — адрес для HTTP POST
+	URL string
+	// Secret — ключ HMAC-SHA256 подписи
+	Secret string
+	// Enabled — доставка включена
+	Enabled bool
+	// CreatedAt — время создания
+	CreatedAt time.Time
+	// UpdatedAt — время последнего обновления
+	UpdatedAt time.Time
+}
+
+// WebhookDelivery — доставка события на webhook endpoint.
+// Хранится в таблице webhook_deliveries.
+type WebhookDelivery struct {
+	// ID — UUID доставки (заголовок X-Artstore-Delivery)
+	ID string
+	// EndpointID — UUID получателя
+	EndpointID string
+	// RuleID — UUID правила (nil для тестовой доставки или удалённого правила)
+	RuleID *string
+	// Event — событие (WebhookEvent*)
+	Event string
+	// Payload — тело запроса
+	Payload json.RawMessage
+	// Status — pending, delivered, failed
+	Status string
+	// Attempts — количество выполненных попыток
+	Attempts int
+	// ResponseStatus — HTTP-статус последнего ответа
+	ResponseStatus *int
+	// LastError — ошибка последней попытки
+	LastError *string
+	// NextAttemptAt — время следующей попытки
+	NextAttemptAt time.Time
+	// CreatedAt — время создания
+	CreatedAt time.Time
+	// DeliveredAt — время успешной доставки
+	DeliveredAt *time.Time
+}
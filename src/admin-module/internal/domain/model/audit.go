@@ -0,0 +1,123 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Типы субъектов событий аудита.
+const (
+	// AuditActorUser — Admin User (API или Admin UI).
+	AuditActorUser = "user"
+	// AuditActorServiceAccount — Service Account.
+	AuditActorServiceAccount = "service_account"
+	// AuditActorSystem — изменение без пользовательского контекста (фоновые задачи).
+	AuditActorSystem = "system"
+)
+
+// Типы объектов событий аудита.
+const (
+	AuditTargetUser           = "user"
+	AuditTargetServiceAccount = "service_account"
+	AuditTargetStorageElement = "storage_element"
+	AuditTargetFile           = "file"
+	AuditTargetUISetting      = "ui_setting"
+	AuditTargetAlertRule      = "alert_rule"
+	AuditTargetWebhook        = "webhook"
+	AuditTargetFileBatch      = "file_batch"
+	AuditTargetSigningKey     = "signing_key"
+	AuditTargetPersonalToken  = "personal_token"
+	AuditTargetRole           = "role"
+	AuditTargetCollection     = "collection"
+)
+
+// Действия событий аудита (<объект>.<операция>).
+const (
+	AuditActionRoleOverrideSet    = "role_override.set"
+	AuditActionRoleOverrideDelete = "role_override.delete"
+	// AuditActionUISessionRevoke — администратор завершил UI-сессии пользователя
+	AuditActionUISessionRevoke = "ui_session.revoke"
+	// Учётные записи пользователей локального IdP
+	AuditActionUserCreate        = "user.create"
+	AuditActionUserUpdate        = "user.update"
+	AuditActionUserDelete        = "user.delete"
+	AuditActionUserPasswordReset = "user.password_reset"
+
+	AuditActionSACreate       = "service_account.create"
+	AuditActionSAUpdate       = "service_account.update"
+	AuditActionSADelete       = "service_account.delete"
+	AuditActionSARotateSecret = "service_account.rotate_secret"
+	// AuditActionSASecretExpired — SA приостановлен из-за истечения секрета
+	AuditActionSASecretExpired = "service_account.secret_expired"
+	// AuditActionSAGrants — изменены ограничения scopes SA по ресурсам
+	AuditActionSAGrants = "service_account.grants"
+
+	AuditActionSECreate = "storage_element.create"
+	AuditActionSEUpdate = "storage_element.update"
+	AuditActionSEDelete = "storage_element.delete"
+
+	AuditActionFileRegister = "file.register"
+	AuditActionFileUpdate   = "file.update"
+	AuditActionFileDelete   = "file.delete"
+	// AuditActionFileBatch — запущена групповая операция над файлами
+	AuditActionFileBatch = "file.batch"
+
+	AuditActionUISettingSet    = "ui_setting.set"
+	AuditActionUISettingDelete = "ui_setting.delete"
+
+	AuditActionAlertRuleCreate = "alert_rule.create"
+	AuditActionAlertRuleUpdate = "alert_rule.update"
+	AuditActionAlertRuleDelete = "alert_rule.delete"
+
+	AuditActionWebhookCreate = "webhook.create"
+	AuditActionWebhookUpdate = "webhook.update"
+	AuditActionWebhookDelete = "webhook.delete"
+
+	// AuditActionSigningKeyRotate — ручная ротация ключа подписи JWT
+	AuditActionSigningKeyRotate = "signing_key.rotate"
+
+	// Personal access tokens пользователей
+	AuditActionPersonalTokenCreate = "personal_token.create"
+	AuditActionPersonalTokenRevoke = "personal_token.revoke"
+
+	// Пользовательские роли
+	AuditActionRoleCreate = "role.create"
+	AuditActionRoleUpdate = "role.update"
+	AuditActionRoleDelete = "role.delete"
+
+	// Коллекции файлов и их участники
+	AuditActionCollectionCreate       = "collection.create"
+	AuditActionCollectionUpdate       = "collection.update"
+	AuditActionCollectionDelete       = "collection.delete"
+	AuditActionCollectionMemberSet    = "collection.member_set"
+	AuditActionCollectionMemberRemove = "collection.member_remove"
+)
+
+// AuditEvent — запись журнала аудита.
+// Хранится в таблице audit_events (append-only).
+type AuditEvent struct {
+	// ID — последовательный номер события
+	ID int64
+	// OccurredAt — время события
+	OccurredAt time.Time
+	// ActorID — sub субъекта из JWT (или admin-module для system)
+	ActorID string
+	// ActorType — тип субъекта (user, service_account, system)
+	ActorType string
+	// ActorName — preferred_username пользователя или client_id SA
+	ActorName string
+	// Action — действие (service_account.update, file.delete, ...)
+	Action string
+	// TargetType — тип изменённого объекта
+	TargetType string
+	// TargetID — идентификатор изменённого объекта
+	TargetID string
+	// Before — изменённые поля до операции (nil при создании)
+	Before json.RawMessage
+	// After — изменённые поля после операции (nil при удалении)
+	After json.RawMessage
+	// SourceIP — IP-адрес клиента
+	SourceIP *string
+	// RequestID — идентификатор HTTP-запроса
+	RequestID *string
+}
@@ -0,0 +1,90 @@
+package model
+
+import "time"
+
+// Права участника коллекции.
+const (
+	// CollectionPermRead — просмотр и скачивание файлов коллекции
+	CollectionPermRead = "read"
+	// CollectionPermWrite — загрузка файлов в коллекцию и изменение метаданных
+	CollectionPermWrite = "write"
+	// CollectionPermDelete — удаление файлов коллекции
+	CollectionPermDelete = "delete"
+)
+
+// CollectionPermissions — права участника коллекции в порядке отображения.
+var CollectionPermissions = []string{CollectionPermRead, CollectionPermWrite, CollectionPermDelete}
+
+// CollectionsClaimName — claim access token Service Account со списком UUID
+// коллекций, где SA — участник с правом write. Storage Element допускает
+// загрузку в коллекцию только из этого списка (или при scope storage:write).
+const CollectionsClaimName = "artstore_collections"
+
+// Типы участников коллекции.
+const (
+	// CollectionMemberUser — пользователь (по username)
+	CollectionMemberUser = "user"
+	// CollectionMemberServiceAccount — Service Account (по client_id)
+	CollectionMemberServiceAccount = "service_account"
+)
+
+// Collection — коллекция (namespace) файлов.
+// Хранится в таблице collections.
+type Collection struct {
+	// ID — UUID коллекции (записывается в attr.json файлов)
+	ID string
+	// Name — имя коллекции (slug)
+	Name string
+	// Description — описание коллекции
+	Description string
+	// QuotaBytes — квота на суммарный размер активных файлов (nil — без ограничения)
+	QuotaBytes *int64
+	// QuotaFiles — квота на количество активных файлов (nil — без ограничения)
+	QuotaFiles *int64
+	// CreatedBy — кто создал коллекцию
+	CreatedBy string
+	// CreatedAt — время создания
+	CreatedAt time.Time
+	// UpdatedAt — время последнего изменения
+	UpdatedAt time.Time
+	// UsedBytes — суммарный размер активных файлов (заполняется при чтении)
+	UsedBytes int64
+	// UsedFiles — количество активных файлов (заполняется при чтении)
+	UsedFiles int64
+	// MemberCount — количество участников (заполняется при чтении)
+	MemberCount int
+}
+
+// CollectionMember — участник коллекции с правами в ней.
+// Хранится в таблице collection_members.
+type CollectionMember struct {
+	// CollectionID — UUID коллекции
+	CollectionID string
+	// SubjectType — тип участника (user, service_account)
+	SubjectType string
+	// SubjectID — username пользователя или client_id Service Account
+	SubjectID string
+	// Permissions — права в коллекции (read, write, delete)
+	Permissions []string
+	// AddedBy — кто добавил участника
+	AddedBy string
+	// CreatedAt — время добавления
+	CreatedAt time.Time
+}
+
+// CollectionSubject — субъект, для которого проверяется членство в
+// коллекциях: пользователь по username или Service Account по client_id.
+type CollectionSubject struct {
+	// Type — тип субъекта (user, service_account)
+	Type string
+	// ID — username пользователя или client_id Service Account
+	ID string
+}
+
+// CollectionScope — ограничение видимости файлов по коллекциям: доступны
+// файлы вне коллекций и файлы коллекций, где Subject — участник с правом
+// Permission.
+type CollectionScope struct {
+	Subject    CollectionSubject
+	Permission string
+}
@@ -33,6 +33,115 @@ type FileRecord struct {
 	TTLDays *int
 	// ExpiresAt — время истечения (для temporary)
 	ExpiresAt *time.Time
+	// CollectionID — UUID коллекции файла (nil — общее пространство)
+	CollectionID *string
+	// CreatedAt — время создания записи
+	CreatedAt time.Time
+	// UpdatedAt — время последнего обновления
+	UpdatedAt time.Time
+	// Replicas — копии файла на дополнительных SE (заполняется при чтении)
+	Replicas []FileReplica
+	// PendingWrite — изменение, ещё не применённое на SE (заполняется при чтении)
+	PendingWrite *SEWrite
+}
+
+// Статусы реплики файла.
+const (
+	// ReplicaStatusPending — копирование запланировано
+	ReplicaStatusPending = "pending"
+	// ReplicaStatusSynced — копия подтверждена на SE
+	ReplicaStatusSynced = "synced"
+	// ReplicaStatusFailed — последняя попытка копирования завершилась ошибкой
+	ReplicaStatusFailed = "failed"
+)
+
+// FileReplica — копия файла на дополнительном Storage Element.
+// Хранится в таблице file_replicas.
+type FileReplica struct {
+	// FileID — UUID файла
+	FileID string
+	// StorageElementID — UUID SE, на котором хранится копия
+	StorageElementID string
+	// Status — состояние реплики (pending, synced, failed)
+	Status string
+	// LastError — текст последней ошибки копирования
+	LastError *string
+	// Attempts — количество неудачных попыток подряд
+	Attempts int
+	// NextAttemptAt — время следующей попытки копирования failed-реплики
+	NextAttemptAt *time.Time
+	// ReplicatedAt — время успешного копирования
+	ReplicatedAt *time.Time
+	// CreatedAt — время создания записи
+	CreatedAt time.Time
+	// UpdatedAt — время последнего обновления
+	UpdatedAt time.Time
+}
+
+// Причины отложенной репликации файла.
+const (
+	// ReplicationDeferNoSource — нет доступного SE с копией файла
+	ReplicationDeferNoSource = "no_source"
+	// ReplicationDeferNoTargets — нет подходящих SE для реплики
+	ReplicationDeferNoTargets = "no_targets"
+)
+
+// ReplicationDeferral — файл, репликация которого пропущена и отложена
+// до NextAttemptAt. Хранится в таблице file_replication_deferrals.
+type ReplicationDeferral struct {
+	// FileID — UUID файла
+	FileID string
+	// Reason — причина пропуска (no_source, no_targets)
+	Reason string
+	// Attempts — количество пропусков подряд
+	Attempts int
+	// NextAttemptAt — время следующей попытки
+	NextAttemptAt time.Time
+	// CreatedAt — время создания записи
+	CreatedAt time.Time
+	// UpdatedAt — время последнего обновления
+	UpdatedAt time.Time
+}
+
+// Операции записи изменений файла на SE.
+const (
+	// SEWriteOpUpdate — обновление description и tags (PATCH)
+	SEWriteOpUpdate = "update"
+	// SEWriteOpDelete — soft delete файла (DELETE)
+	SEWriteOpDelete = "delete"
+)
+
+// Статусы записи в outbox.
+const (
+	// SEWriteStatusPending — изменение ожидает повторной попытки
+	SEWriteStatusPending = "pending"
+	// SEWriteStatusFailed — SE отклонил изменение, повторов не будет
+	SEWriteStatusFailed = "failed"
+)
+
+// SEWrite — изменение файла, которое нужно применить на основном SE.
+// Хранится в таблице se_write_outbox (не более одной записи на файл).
+type SEWrite struct {
+	// ID — идентификатор изменения (новое изменение файла получает новый ID)
+	ID string
+	// FileID — UUID файла
+	FileID string
+	// StorageElementID — UUID SE, на котором хранится файл
+	StorageElementID string
+	// Operation — операция на SE (update, delete)
+	Operation string
+	// Description — новое описание (для update)
+	Description *string
+	// Tags — новые теги (для update)
+	Tags []string
+	// Status — состояние (pending, failed)
+	Status string
+	// Attempts — количество неудачных попыток
+	Attempts int
+	// LastError — текст последней ошибки
+	LastError *string
+	// NextAttemptAt — время следующей попытки
+	NextAttemptAt time.Time
 	// CreatedAt — время создания записи
 	CreatedAt time.Time
 	// UpdatedAt — время последнего обновления
@@ -0,0 +1,102 @@
+package model
+
+import "time"
+
+// Действия групповой операции над файлами.
+const (
+	// FileBatchActionDelete — soft delete файлов
+	FileBatchActionDelete = "delete"
+	// FileBatchActionAddTags — добавление тегов
+	FileBatchActionAddTags = "add_tags"
+	// FileBatchActionRemoveTags — удаление тегов
+	FileBatchActionRemoveTags = "remove_tags"
+	// FileBatchActionSetStatus — изменение статуса файлов
+	FileBatchActionSetStatus = "set_status"
+)
+
+// Состояния групповой операции (совпадают с состояниями задачи синхронизации).
+const (
+	FileBatchQueued    = "queued"
+	FileBatchRunning   = "running"
+	FileBatchSucceeded = "succeeded"
+	FileBatchFailed    = "failed"
+	FileBatchCancelled = "cancelled"
+)
+
+// Результаты обработки файла групповой операцией.
+const (
+	// FileBatchResultQueued — файл ещё не обработан
+	FileBatchResultQueued = "queued"
+	// FileBatchResultSucceeded — изменение применено
+	FileBatchResultSucceeded = "succeeded"
+	// FileBatchResultPending — изменение применено в реестре, запись на SE отложена
+	FileBatchResultPending = "pending"
+	// FileBatchResultSkipped — файл уже в нужном состоянии
+	FileBatchResultSkipped = "skipped"
+	// FileBatchResultFailed — изменение отклонено или завершилось ошибкой
+	FileBatchResultFailed = "failed"
+	// FileBatchResultCancelled — операция отменена до обработки файла
+	FileBatchResultCancelled = "cancelled"
+)
+
+// FileBatchOperation — фоновая групповая операция над файлами реестра.
+// Хранится в таблице file_batch_operations.
+type FileBatchOperation struct {
+	// ID — UUID операции
+	ID string
+	// Action — действие (delete, add_tags, remove_tags, set_status)
+	Action string
+	// Tags — теги для add_tags и remove_tags
+	Tags []string
+	// TargetStatus — новый статус файлов для set_status
+	TargetStatus *string
+	// Status — состояние (queued, running, succeeded, failed, cancelled)
+	Status string
+	// RequestedBy — субъект, запустивший операцию
+	RequestedBy *string
+	// Total — количество файлов в операции
+	Total int
+	// Processed — обработано файлов на текущий момент
+	Processed int
+	// Succeeded — файлов изменено (включая ожидающие записи на SE)
+	Succeeded int
+	// Skipped — файлов уже в нужном состоянии
+	Skipped int
+	// Failed — файлов с ошибкой
+	Failed int
+	// Error — текст ошибки (для failed и cancelled)
+	Error *string
+	// CreatedAt — время постановки операции
+	CreatedAt time.Time
+	// StartedAt — время начала выполнения
+	StartedAt *time.Time
+	// CompletedAt — время завершения
+	CompletedAt *time.Time
+	// DurationMs — длительность выполнения в миллисекундах
+	DurationMs *int64
+}
+
+// Finished возвращает true для завершённой операции.
+func (op *FileBatchOperation) Finished() bool {
+	switch op.Status {
+	case FileBatchSucceeded, FileBatchFailed, FileBatchCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// FileBatchResult — результат обработки одного файла групповой операцией.
+// Хранится в таблице file_batch_results.
+type FileBatchResult struct {
+	// OperationID — UUID операции
+	OperationID string
+	// FileID — UUID файла
+	FileID string
+	// Status — результат (queued, succeeded, pending, skipped, failed, cancelled)
+	Status string
+	// Error — текст ошибки (для failed)
+	Error *string
+	// ProcessedAt — время обработки файла
+	ProcessedAt *time.Time
+}
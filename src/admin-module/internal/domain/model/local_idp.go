@@ -0,0 +1,61 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// LocalUser — пользователь локального IdP (таблица local_idp_users).
+type LocalUser struct {
+	ID        string
+	Username  string
+	Email     string
+	FirstName string
+	LastName  string
+	// PasswordHash — bcrypt-хеш пароля
+	PasswordHash string
+	Enabled      bool
+	Groups       []string
+	// FailedLogins — неудачные попытки входа подряд
+	FailedLogins int
+	// LockedUntil — вход заблокирован до этого момента (nil — не заблокирован)
+	LockedUntil *time.Time
+	LastLoginAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// LocalClient — OAuth2-клиент локального IdP (таблица local_idp_clients).
+type LocalClient struct {
+	ID          string
+	ClientID    string
+	Name        string
+	Description string
+	Enabled     bool
+	Scopes      []string
+	// SecretHash — SHA-256 текущего секрета
+	SecretHash []byte
+	// PreviousSecretHash — SHA-256 предыдущего секрета (действует до PreviousSecretExpiresAt)
+	PreviousSecretHash      []byte
+	PreviousSecretExpiresAt *time.Time
+	// Claims — дополнительные claims access token
+	Claims    map[string]json.RawMessage
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SigningKey — ключ подписи JWT (таблица jwt_signing_keys).
+type SigningKey struct {
+	// KID — идентификатор ключа (JWK kid)
+	KID string
+	// Algorithm — алгоритм подписи (RS256 или ES256)
+	Algorithm string
+	// PrivateKey — закрытый ключ в PKCS#8 DER, зашифрованный мастер-ключом
+	// (открытый, если MasterKeyID пуст)
+	PrivateKey []byte
+	// MasterKeyID — отпечаток мастер-ключа шифрования ("" — не зашифрован)
+	MasterKeyID string
+	CreatedAt   time.Time
+	// ActivatesAt — начало подписи токенов этим ключом
+	ActivatesAt time.Time
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// PersonalToken — personal access token пользователя.
+// Хранится в таблице personal_access_tokens; сам токен — только в виде SHA-256.
+type PersonalToken struct {
+	// ID — UUID токена (для списка и отзыва)
+	ID string
+	// TokenHash — SHA-256 токена
+	TokenHash []byte
+	// TokenPrefix — начало токена для распознавания в списке
+	TokenPrefix string
+	// Subject — sub владельца (ID пользователя в IdP)
+	Subject string
+	// Username — имя владельца на момент создания
+	Username string
+	// Name — название токена, уникальное у пользователя
+	Name string
+	// Scopes — области API, доступные токену
+	Scopes []string
+	// CreatedAt — время создания
+	CreatedAt time.Time
+	// ExpiresAt — срок действия
+	ExpiresAt time.Time
+	// LastUsedAt — последнее использование (nil — не использовался)
+	LastUsedAt *time.Time
+	// LastUsedIP — IP-адрес клиента при последнем использовании
+	LastUsedIP string
+}
+
+// Expired сообщает, истёк ли токен к моменту now.
+func (t *PersonalToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
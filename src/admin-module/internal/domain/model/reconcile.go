@@ -0,0 +1,82 @@
+package model
+
+import "time"
+
+// Состояния отчёта сверки SE.
+const (
+	ReconcileRunning   = "running"
+	ReconcileSucceeded = "succeeded"
+	ReconcileFailed    = "failed"
+)
+
+// Типы проблем сверки (совпадают с ReconcileIssue.type SE).
+const (
+	// ReconcileIssueOrphanedFile — файл на диске без attr.json
+	ReconcileIssueOrphanedFile = "orphaned_file"
+	// ReconcileIssueMissingFile — attr.json без файла на диске
+	ReconcileIssueMissingFile = "missing_file"
+	// ReconcileIssueChecksumMismatch — checksum файла не совпадает с attr.json
+	ReconcileIssueChecksumMismatch = "checksum_mismatch"
+	// ReconcileIssueSizeMismatch — размер файла не совпадает с attr.json
+	ReconcileIssueSizeMismatch = "size_mismatch"
+	// ReconcileIssueOrphanedAttr — attr.json без файла данных
+	ReconcileIssueOrphanedAttr = "orphaned_attr"
+)
+
+// ReconcileReport — отчёт сверки attr.json с файловой системой SE.
+// Хранится в таблице se_reconcile_reports.
+type ReconcileReport struct {
+	// ID — UUID отчёта
+	ID string
+	// StorageElementID — UUID SE
+	StorageElementID string
+	// Status — состояние (running, succeeded, failed)
+	Status string
+	// RequestedBy — субъект, запустивший сверку
+	RequestedBy *string
+	// FilesChecked — количество проверенных файлов
+	FilesChecked int
+	// FilesOK — файлов без проблем
+	FilesOK int
+	// OrphanedFiles, MissingFiles, ChecksumMismatches, SizeMismatches — сводка SE
+	OrphanedFiles      int
+	MissingFiles       int
+	ChecksumMismatches int
+	SizeMismatches     int
+	// IssuesTotal — количество проблем в ответе SE (сохраняется не больше лимита)
+	IssuesTotal int
+	// Error — текст ошибки (для failed)
+	Error *string
+	// CreatedAt — время запуска сверки
+	CreatedAt time.Time
+	// CompletedAt — время завершения
+	CompletedAt *time.Time
+	// DurationMs — длительность сверки в миллисекундах
+	DurationMs *int64
+}
+
+// Finished возвращает true для завершённой сверки.
+func (r *ReconcileReport) Finished() bool {
+	return r.Status != ReconcileRunning
+}
+
+// ReconcileIssue — проблема, обнаруженная сверкой SE.
+// Хранится в таблице se_reconcile_issues.
+type ReconcileIssue struct {
+	// ReportID — UUID отчёта
+	ReportID string
+	// Position — порядковый номер проблемы в отчёте
+	Position int
+	// Type — тип проблемы (orphaned_file, missing_file, ...)
+	Type string
+	// FileID — UUID файла (если известен SE)
+	FileID *string
+	// Path — путь на диске SE (относительный)
+	Path *string
+	// Description — описание проблемы от SE
+	Description string
+	// RegistryFilename — имя файла в реестре (nil, если файла в реестре нет)
+	RegistryFilename *string
+	// RegistryStatus — статус файла в реестре
+	RegistryStatus *string
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Role — пользовательская роль Admin Module.
+// Хранится в таблице roles; встроенные роли admin и readonly — в коде.
+type Role struct {
+	// Name — имя роли (slug)
+	Name string
+	// Description — описание роли
+	Description string
+	// Permissions — разрешения вида <ресурс>:<действие>
+	Permissions []string
+	// Groups — группы IdP, членство в которых даёт роль
+	Groups []string
+	// CreatedBy — кто создал роль
+	CreatedBy string
+	// CreatedAt — время создания
+	CreatedAt time.Time
+	// UpdatedAt — время последнего изменения
+	UpdatedAt time.Time
+}
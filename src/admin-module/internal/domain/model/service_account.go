@@ -23,8 +23,42 @@ type ServiceAccount struct {
 	Source string
 	// LastSyncedAt — время последней синхронизации с Keycloak
 	LastSyncedAt *time.Time
+	// SecretIssuedAt — время выдачи текущего секрета (создание или ротация)
+	SecretIssuedAt time.Time
+	// SecretExpiresAt — срок действия текущего секрета.
+	// Не хранится: вычисляется сервисом по AM_SA_SECRET_LIFETIME, nil — без истечения.
+	SecretExpiresAt *time.Time
+	// PreviousSecretExpiresAt — до этого времени после ротации действует предыдущий секрет
+	PreviousSecretExpiresAt *time.Time
 	// CreatedAt — время создания записи
 	CreatedAt time.Time
 	// UpdatedAt — время последнего обновления
 	UpdatedAt time.Time
 }
+
+// ResourceGrant — ограничение scope SA по ресурсам.
+// Хранится в таблице sa_resource_grants. Несколько grants одного scope
+// объединяются по «или», ограничения внутри grant — по «и».
+type ResourceGrant struct {
+	// ID — UUID записи
+	ID string
+	// ServiceAccountID — UUID SA
+	ServiceAccountID string
+	// Scope — ограничиваемый scope (files:read, files:write, storage:read, storage:write)
+	Scope string
+	// SEIDs — разрешённые SE (пусто — без ограничения по ID)
+	SEIDs []string
+	// SELabels — метки, которые должны быть у разрешённого SE (пусто — без ограничения)
+	SELabels map[string]string
+	// RetentionPolicies — разрешённые политики хранения (пусто — любые)
+	RetentionPolicies []string
+	// OwnFilesOnly — только файлы, загруженные самим SA
+	OwnFilesOnly bool
+	// CreatedAt — время создания
+	CreatedAt time.Time
+}
+
+// RestrictsSE проверяет, ограничивает ли grant набор SE.
+func (g *ResourceGrant) RestrictsSE() bool {
+	return len(g.SEIDs) > 0 || len(g.SELabels) > 0
+}
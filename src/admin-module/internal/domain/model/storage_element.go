@@ -23,6 +23,8 @@ type StorageElement struct {
 	UsedBytes int64
 	// AvailableBytes — доступное пространство (может быть nil)
 	AvailableBytes *int64
+	// Labels — метки SE для правил размещения (zone, tier, owner)
+	Labels map[string]string
 	// LastSyncAt — время последней синхронизации info
 	LastSyncAt *time.Time
 	// LastFileSyncAt — время последней синхронизации файлов
@@ -32,3 +34,18 @@ type StorageElement struct {
 	// UpdatedAt — время последнего обновления
 	UpdatedAt time.Time
 }
+
+// CapacitySnapshot — снимок ёмкости SE на момент синхронизации.
+// Хранится в таблице se_capacity_snapshots.
+type CapacitySnapshot struct {
+	// StorageElementID — UUID SE
+	StorageElementID string
+	// CapturedAt — время снимка
+	CapturedAt time.Time
+	// CapacityBytes — общая ёмкость в байтах
+	CapacityBytes int64
+	// UsedBytes — использованное пространство в байтах
+	UsedBytes int64
+	// AvailableBytes — доступное пространство (может быть nil)
+	AvailableBytes *int64
+}
@@ -50,3 +50,91 @@ type SASyncResult struct {
 	// SyncedAt — время синхронизации
 	SyncedAt time.Time
 }
+
+// Источники запуска синхронизации SE.
+const (
+	// SyncTriggerPeriodic — периодическая синхронизация (AM_SYNC_INTERVAL).
+	SyncTriggerPeriodic = "periodic"
+	// SyncTriggerManual — ручной запуск через API или Admin UI.
+	SyncTriggerManual = "manual"
+	// SyncTriggerRegistration — полная синхронизация при регистрации SE.
+	SyncTriggerRegistration = "registration"
+)
+
+// Режимы синхронизации SE.
+const (
+	// SyncModeFull — все файлы SE + поиск удалённых (разность множеств).
+	SyncModeFull = "full"
+	// SyncModeIncremental — только файлы, изменённые с курсора SyncCheckpoint.
+	SyncModeIncremental = "incremental"
+)
+
+// Состояния задачи синхронизации.
+const (
+	SyncRunQueued    = "queued"
+	SyncRunRunning   = "running"
+	SyncRunSucceeded = "succeeded"
+	SyncRunFailed    = "failed"
+	SyncRunCancelled = "cancelled"
+)
+
+// SyncRun — фоновая задача синхронизации файлового реестра с одним SE.
+// Хранится в таблице sync_runs.
+type SyncRun struct {
+	// ID — UUID задачи
+	ID string
+	// StorageElementID — UUID синхронизируемого SE
+	StorageElementID string
+	// Trigger — источник запуска (periodic, manual, registration)
+	Trigger string
+	// Mode — режим синхронизации (full, incremental)
+	Mode string
+	// Status — состояние (queued, running, succeeded, failed, cancelled)
+	Status string
+	// RequestedBy — субъект, запустивший синхронизацию (nil для фоновых)
+	RequestedBy *string
+	// FilesOnSE — количество файлов на SE (для incremental — изменённых с курсора)
+	FilesOnSE int
+	// FilesProcessed — обработано файлов на текущий момент
+	FilesProcessed int
+	// FilesAdded — новых файлов добавлено в реестр
+	FilesAdded int
+	// FilesUpdated — файлов обновлено
+	FilesUpdated int
+	// FilesMarkedDeleted — файлов помечено как deleted
+	FilesMarkedDeleted int
+	// Error — текст ошибки (для failed и cancelled)
+	Error *string
+	// CreatedAt — время постановки задачи
+	CreatedAt time.Time
+	// StartedAt — время начала выполнения
+	StartedAt *time.Time
+	// CompletedAt — время завершения
+	CompletedAt *time.Time
+	// DurationMs — длительность выполнения в миллисекундах
+	DurationMs *int64
+}
+
+// Finished возвращает true для завершённой задачи.
+func (r *SyncRun) Finished() bool {
+	switch r.Status {
+	case SyncRunSucceeded, SyncRunFailed, SyncRunCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// SyncCheckpoint — курсор инкрементальной синхронизации файлового реестра с SE.
+// Хранится в таблице sync_checkpoints (одна запись на SE).
+type SyncCheckpoint struct {
+	// StorageElementID — UUID SE
+	StorageElementID string
+	// FilesCursor — при следующей синхронизации запрашиваются файлы
+	// с updated_at (время SE) не раньше курсора; nil — курсора ещё нет
+	FilesCursor *time.Time
+	// LastFullSyncAt — время последней полной синхронизации
+	LastFullSyncAt *time.Time
+	// UpdatedAt — время последнего обновления
+	UpdatedAt time.Time
+}
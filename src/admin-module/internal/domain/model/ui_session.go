@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// UISession — серверная сессия Admin UI.
+// Хранится в таблице ui_sessions; в cookie — только непрозрачный токен.
+type UISession struct {
+	// ID — UUID сессии (для списка сессий и отзыва)
+	ID string
+	// TokenHash — SHA-256 токена из cookie
+	TokenHash []byte
+	// KeyID — отпечаток ключа, которым зашифрованы Tokens
+	KeyID string
+	// Subject — sub пользователя (Keycloak user ID)
+	Subject string
+	// Username — preferred_username пользователя
+	Username string
+	// Email — email пользователя
+	Email string
+	// IdpRole — роль из групп IdP на момент входа
+	IdpRole string
+	// Role — effective роль сессии (IdpRole + role override)
+	Role string
+	// Groups — группы пользователя из IdP
+	Groups []string
+	// Tokens — зашифрованные access и refresh token Keycloak
+	Tokens string
+	// RemoteAddr — IP-адрес клиента при входе
+	RemoteAddr string
+	// UserAgent — User-Agent браузера при входе
+	UserAgent string
+	// CreatedAt — время входа
+	CreatedAt time.Time
+	// LastSeenAt — последняя активность
+	LastSeenAt time.Time
+	// ExpiresAt — абсолютный срок действия сессии
+	ExpiresAt time.Time
+}
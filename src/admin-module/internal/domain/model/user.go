@@ -20,12 +20,16 @@ type AdminUser struct {
 	Enabled bool
 	// Groups — группы пользователя из IdP
 	Groups []string
-	// IdpRole — роль из IdP (admin, readonly)
+	// IdpRole — роль из IdP с максимальными привилегиями
 	IdpRole string
-	// RoleOverride — локальное дополнение роли (admin, readonly, nil если нет)
+	// RoleOverride — локальное дополнение роли (любая роль каталога, nil если нет)
 	RoleOverride *string
-	// EffectiveRole — итоговая роль = max(IdpRole, RoleOverride)
+	// EffectiveRole — роль с максимальными привилегиями из ролей IdP и RoleOverride
 	EffectiveRole string
+	// Roles — все роли пользователя: роли из групп IdP и RoleOverride
+	Roles []string
+	// Permissions — объединение разрешений всех ролей
+	Permissions []string
 	// CreatedAt — дата создания в Keycloak
 	CreatedAt time.Time
 }
@@ -39,7 +43,7 @@ type RoleOverride struct {
 	KeycloakUserID string
 	// Username — кэшированное имя пользователя
 	Username string
-	// AdditionalRole — дополнительная роль (admin, readonly)
+	// AdditionalRole — дополнительная роль (любая роль каталога)
 	AdditionalRole string
 	// CreatedBy — кто установил override (username администратора)
 	CreatedBy string
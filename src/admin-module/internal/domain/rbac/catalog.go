@@ -0,0 +1,207 @@
+// catalog.go — каталог ролей: встроенные admin и readonly плюс
+// пользовательские роли из БД. Каталог сопоставляет группы IdP ролям
+// и вычисляет итоговый набор разрешений пользователя.
+package rbac
+
+import (
+	"slices"
+)
+
+// Role — роль: именованный набор разрешений и группы IdP, которые её дают.
+type Role struct {
+	Name        string
+	Description string
+	Permissions []Permission
+	// Groups — группы IdP, членство в которых даёт роль
+	Groups []string
+	// Builtin — встроенная роль (admin, readonly), не изменяется через API
+	Builtin bool
+}
+
+// PermissionSet возвращает разрешения роли набором.
+func (r Role) PermissionSet() PermissionSet {
+	return NewPermissionSet(r.Permissions...)
+}
+
+// readonlyPermissions — разрешения встроенной роли readonly: просмотр
+// всего, кроме журнала аудита и статуса IdP.
+var readonlyPermissions = []Permission{
+	PermFilesRead,
+	PermStorageRead,
+	PermUsersRead,
+	PermServiceAccountsRead,
+	PermRolesRead,
+	PermCollectionsRead,
+	PermSettingsRead,
+}
+
+// BuiltinRoles возвращает встроенные роли с группами из конфигурации
+// (AM_ROLE_ADMIN_GROUPS, AM_ROLE_READONLY_GROUPS).
+func BuiltinRoles(adminGroups, readonlyGroups []string) []Role {
+	return []Role{
+		{
+			Name:        RoleAdmin,
+			Description: "Полный доступ",
+			Permissions: AllPermissions(),
+			Groups:      adminGroups,
+			Builtin:     true,
+		},
+		{
+			Name:        RoleReadonly,
+			Description: "Просмотр без изменений",
+			Permissions: slices.Clone(readonlyPermissions),
+			Groups:      readonlyGroups,
+			Builtin:     true,
+		},
+	}
+}
+
+// IsBuiltinRole проверяет, является ли роль встроенной.
+func IsBuiltinRole(name string) bool {
+	return name == RoleAdmin || name == RoleReadonly
+}
+
+// Assignment — роли и разрешения пользователя.
+type Assignment struct {
+	// IdpRoles — роли из групп IdP (или realm roles, если группы не совпали)
+	IdpRoles []string
+	// IdpRole — роль IdP с максимальными привилегиями
+	IdpRole string
+	// Roles — все роли пользователя: IdpRoles и role override
+	Roles []string
+	// EffectiveRole — роль с максимальными привилегиями из Roles
+	EffectiveRole string
+	// Permissions — объединение разрешений всех ролей
+	Permissions PermissionSet
+}
+
+// Catalog — неизменяемый каталог ролей.
+type Catalog struct {
+	roles []Role
+	index map[string]int
+}
+
+// NewCatalog создаёт каталог. При совпадении имён побеждает первая роль,
+// поэтому встроенные роли передаются первыми.
+func NewCatalog(roles ...Role) *Catalog {
+	c := &Catalog{index: make(map[string]int, len(roles))}
+	for _, r := range roles {
+		if _, dup := c.index[r.Name]; dup {
+			continue
+		}
+		c.index[r.Name] = len(c.roles)
+		c.roles = append(c.roles, r)
+	}
+	return c
+}
+
+// Catalog реализует CatalogProvider для статического каталога.
+func (c *Catalog) Catalog() *Catalog {
+	return c
+}
+
+// Role возвращает роль по имени.
+func (c *Catalog) Role(name string) (Role, bool) {
+	i, ok := c.index[name]
+	if !ok {
+		return Role{}, false
+	}
+	return c.roles[i], true
+}
+
+// Roles возвращает все роли каталога (встроенные первыми).
+func (c *Catalog) Roles() []Role {
+	return slices.Clone(c.roles)
+}
+
+// Has проверяет, есть ли роль в каталоге.
+func (c *Catalog) Has(name string) bool {
+	_, ok := c.index[name]
+	return ok
+}
+
+// GroupMapping возвращает маппинг роль → группы IdP.
+func (c *Catalog) GroupMapping() map[string][]string {
+	m := make(map[string][]string, len(c.roles))
+	for _, r := range c.roles {
+		if len(r.Groups) > 0 {
+			m[r.Name] = r.Groups
+		}
+	}
+	return m
+}
+
+// MapGroupsToRoles возвращает роли каталога, которые дают группы пользователя.
+func (c *Catalog) MapGroupsToRoles(groups []string) []string {
+	return c.ordered(MapGroupsToRoles(groups, c.GroupMapping()))
+}
+
+// Permissions возвращает объединение разрешений ролей. Неизвестные
+// роли (например, удалённые) игнорируются.
+func (c *Catalog) Permissions(roles ...string) PermissionSet {
+	set := make(PermissionSet)
+	for _, name := range roles {
+		if r, ok := c.Role(name); ok {
+			for _, p := range r.Permissions {
+				set[p] = true
+			}
+		}
+	}
+	return set
+}
+
+// HighestRole возвращает роль с наибольшим числом разрешений.
+// При равенстве побеждает роль, стоящая в каталоге раньше (admin — первой).
+// Если ни одна роль не известна каталогу — возвращает пустую строку.
+func (c *Catalog) HighestRole(roles ...string) string {
+	best, bestCount := "", -1
+	for _, name := range c.ordered(roles) {
+		r, _ := c.Role(name)
+		if n := len(r.PermissionSet()); n > bestCount {
+			best, bestCount = name, n
+		}
+	}
+	return best
+}
+
+// Resolve вычисляет роли и разрешения пользователя по группам IdP,
+// realm roles (используются, если группы не дали ролей) и role override.
+// Override только добавляет разрешения: итоговый набор — объединение
+// разрешений ролей IdP и override.
+func (c *Catalog) Resolve(groups, realmRoles []string, override *string) Assignment {
+	idpRoles := c.MapGroupsToRoles(groups)
+	if len(idpRoles) == 0 {
+		idpRoles = c.ordered(realmRoles)
+	}
+
+	roles := slices.Clone(idpRoles)
+	if override != nil && c.Has(*override) && !slices.Contains(roles, *override) {
+		roles = append(roles, *override)
+	}
+
+	return Assignment{
+		IdpRoles:      idpRoles,
+		IdpRole:       c.HighestRole(idpRoles...),
+		Roles:         roles,
+		EffectiveRole: c.HighestRole(roles...),
+		Permissions:   c.Permissions(roles...),
+	}
+}
+
+// ordered оставляет известные каталогу роли без повторов в порядке каталога.
+func (c *Catalog) ordered(names []string) []string {
+	var out []string
+	for _, r := range c.roles {
+		if slices.Contains(names, r.Name) {
+			out = append(out, r.Name)
+		}
+	}
+	return out
+}
+
+// CatalogProvider — источник актуального каталога ролей.
+// Реализуется сервисом ролей (каталог перечитывается из БД) и самим
+// Catalog (статический каталог из встроенных ролей).
+type CatalogProvider interface {
+	Catalog() *Catalog
+}
@@ -0,0 +1,179 @@
+package rbac
+
+import (
+	"slices"
+	"testing"
+)
+
+// testCatalog — встроенные роли и две пользовательские роли.
+func testCatalog() *Catalog {
+	roles := BuiltinRoles([]string{"artstore-admins"}, []string{"artstore-viewers"})
+	roles = append(roles,
+		Role{
+			Name:        "storage-operator",
+			Permissions: []Permission{PermStorageRead, PermStorageWrite, PermStorageSync, PermFilesRead},
+			Groups:      []string{"storage-ops"},
+		},
+		Role{
+			Name:        "file-curator",
+			Permissions: []Permission{PermFilesRead, PermFilesWrite},
+			Groups:      []string{"curators", "storage-ops"},
+		},
+	)
+	return NewCatalog(roles...)
+}
+
+func TestMapGroupsToRoles(t *testing.T) {
+	mapping := map[string][]string{
+		RoleAdmin:      {"admins"},
+		"file-curator": {"curators", "editors"},
+	}
+
+	tests := []struct {
+		name   string
+		groups []string
+		want   []string
+	}{
+		{name: "нет групп", groups: nil, want: nil},
+		{name: "одна роль", groups: []string{"editors"}, want: []string{"file-curator"}},
+		{name: "две группы одной роли", groups: []string{"curators", "editors"}, want: []string{"file-curator"}},
+		{name: "несколько ролей", groups: []string{"editors", "admins"}, want: []string{RoleAdmin, "file-curator"}},
+		{name: "нет совпадений", groups: []string{"other"}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MapGroupsToRoles(tt.groups, mapping)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("MapGroupsToRoles(%v) = %v, хотели %v", tt.groups, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogResolve(t *testing.T) {
+	c := testCatalog()
+
+	tests := []struct {
+		name          string
+		groups        []string
+		realmRoles    []string
+		override      *string
+		wantIdpRole   string
+		wantEffective string
+		wantHas       []Permission
+		wantNot       []Permission
+	}{
+		{
+			name:          "группа storage-ops даёт две пользовательские роли",
+			groups:        []string{"storage-ops"},
+			wantIdpRole:   "storage-operator",
+			wantEffective: "storage-operator",
+			wantHas:       []Permission{PermStorageSync, PermFilesWrite},
+			wantNot:       []Permission{PermUsersRead, PermFilesDelete},
+		},
+		{
+			name:          "curator без управления SE",
+			groups:        []string{"curators"},
+			wantIdpRole:   "file-curator",
+			wantEffective: "file-curator",
+			wantHas:       []Permission{PermFilesWrite},
+			wantNot:       []Permission{PermStorageRead},
+		},
+		{
+			name:          "override добавляет разрешения",
+			groups:        []string{"curators"},
+			override:      strPtr(RoleReadonly),
+			wantIdpRole:   "file-curator",
+			wantEffective: RoleReadonly,
+			wantHas:       []Permission{PermFilesWrite, PermUsersRead},
+			wantNot:       []Permission{PermUsersWrite},
+		},
+		{
+			name:          "override не понижает admin",
+			groups:        []string{"artstore-admins"},
+			override:      strPtr("file-curator"),
+			wantIdpRole:   RoleAdmin,
+			wantEffective: RoleAdmin,
+			wantHas:       []Permission{PermRolesWrite, PermAuditRead},
+		},
+		{
+			name:          "realm roles, если группы не совпали",
+			groups:        []string{"other"},
+			realmRoles:    []string{"offline_access", RoleReadonly},
+			wantIdpRole:   RoleReadonly,
+			wantEffective: RoleReadonly,
+			wantHas:       []Permission{PermFilesRead},
+			wantNot:       []Permission{PermAuditRead},
+		},
+		{
+			name:          "неизвестный override игнорируется",
+			override:      strPtr("deleted-role"),
+			wantIdpRole:   "",
+			wantEffective: "",
+			wantNot:       []Permission{PermFilesRead},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := c.Resolve(tt.groups, tt.realmRoles, tt.override)
+			if a.IdpRole != tt.wantIdpRole {
+				t.Errorf("IdpRole = %q, хотели %q", a.IdpRole, tt.wantIdpRole)
+			}
+			if a.EffectiveRole != tt.wantEffective {
+				t.Errorf("EffectiveRole = %q, хотели %q", a.EffectiveRole, tt.wantEffective)
+			}
+			for _, p := range tt.wantHas {
+				if !a.Permissions.Has(p) {
+					t.Errorf("нет разрешения %s", p)
+				}
+			}
+			for _, p := range tt.wantNot {
+				if a.Permissions.Has(p) {
+					t.Errorf("лишнее разрешение %s", p)
+				}
+			}
+		})
+	}
+}
+
+func TestBuiltinRoles(t *testing.T) {
+	c := testCatalog()
+
+	admin, _ := c.Role(RoleAdmin)
+	if !admin.PermissionSet().Contains(NewPermissionSet(AllPermissions()...)) {
+		t.Error("admin должен иметь все разрешения")
+	}
+
+	// readonly — только чтение, без журнала аудита и статуса IdP (как до появления матрицы)
+	readonly, _ := c.Role(RoleReadonly)
+	for _, p := range readonly.Permissions {
+		if p.Action() != "read" {
+			t.Errorf("readonly имеет разрешение на изменение %s", p)
+		}
+	}
+	if readonly.PermissionSet().Has(PermAuditRead) {
+		t.Error("readonly не должен видеть журнал аудита")
+	}
+}
+
+func TestIsValidPermission(t *testing.T) {
+	tests := []struct {
+		perm Permission
+		want bool
+	}{
+		{PermFilesDelete, true},
+		{PermStorageSync, true},
+		{"audit:write", false},
+		{"unknown:read", false},
+		{"files", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidPermission(tt.perm); got != tt.want {
+			t.Errorf("IsValidPermission(%q) = %v, хотели %v", tt.perm, got, tt.want)
+		}
+	}
+}
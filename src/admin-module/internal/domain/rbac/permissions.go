@@ -0,0 +1,158 @@
+// permissions.go — модель разрешений: действия над ресурсами Admin Module.
+// Разрешение имеет вид "<ресурс>:<действие>" (например, "storage:sync").
+// Роли (встроенные и пользовательские) — именованные наборы разрешений,
+// проверки доступа в REST API и Admin UI выполняются по разрешениям.
+package rbac
+
+import (
+	"slices"
+	"strings"
+)
+
+// Permission — разрешение вида "<ресурс>:<действие>".
+type Permission string
+
+// Разрешения Admin Module.
+const (
+	// PermFilesRead — просмотр файлового реестра.
+	PermFilesRead Permission = "files:read"
+	// PermFilesWrite — регистрация и изменение метаданных файлов.
+	PermFilesWrite Permission = "files:write"
+	// PermFilesDelete — удаление файлов.
+	PermFilesDelete Permission = "files:delete"
+
+	// PermStorageRead — просмотр Storage Elements, задач синхронизации и отчётов.
+	PermStorageRead Permission = "storage:read"
+	// PermStorageWrite — регистрация, изменение и удаление Storage Elements.
+	PermStorageWrite Permission = "storage:write"
+	// PermStorageSync — синхронизация и сверка Storage Elements.
+	PermStorageSync Permission = "storage:sync"
+
+	// PermUsersRead — просмотр пользователей и их токенов.
+	PermUsersRead Permission = "users:read"
+	// PermUsersWrite — role overrides, учётные записи, отзыв чужих токенов.
+	PermUsersWrite Permission = "users:write"
+
+	// PermServiceAccountsRead — просмотр Service Accounts и их grants.
+	PermServiceAccountsRead Permission = "service_accounts:read"
+	// PermServiceAccountsWrite — управление Service Accounts и их grants.
+	PermServiceAccountsWrite Permission = "service_accounts:write"
+
+	// PermRolesRead — просмотр ролей и матрицы разрешений.
+	PermRolesRead Permission = "roles:read"
+	// PermRolesWrite — управление пользовательскими ролями.
+	PermRolesWrite Permission = "roles:write"
+
+	// PermCollectionsRead — просмотр коллекций, их участников и квот.
+	PermCollectionsRead Permission = "collections:read"
+	// PermCollectionsWrite — управление коллекциями и доступ к файлам всех коллекций.
+	PermCollectionsWrite Permission = "collections:write"
+
+	// PermSettingsRead — просмотр настроек, алертов и ключей подписи.
+	PermSettingsRead Permission = "settings:read"
+	// PermSettingsWrite — изменение настроек, алертов, ротация ключей.
+	PermSettingsWrite Permission = "settings:write"
+
+	// PermAuditRead — просмотр журнала аудита.
+	PermAuditRead Permission = "audit:read"
+
+	// PermIdpRead — просмотр статуса Identity Provider.
+	PermIdpRead Permission = "idp:read"
+	// PermIdpWrite — синхронизация Service Accounts с Identity Provider.
+	PermIdpWrite Permission = "idp:write"
+)
+
+// Resource — ресурс Admin Module и допустимые над ним действия.
+type Resource struct {
+	Name    string
+	Actions []string
+}
+
+// Resources — каталог ресурсов и действий (строки матрицы разрешений).
+var Resources = []Resource{
+	{Name: "files", Actions: []string{"read", "write", "delete"}},
+	{Name: "storage", Actions: []string{"read", "write", "sync"}},
+	{Name: "users", Actions: []string{"read", "write"}},
+	{Name: "service_accounts", Actions: []string{"read", "write"}},
+	{Name: "roles", Actions: []string{"read", "write"}},
+	{Name: "collections", Actions: []string{"read", "write"}},
+	{Name: "settings", Actions: []string{"read", "write"}},
+	{Name: "audit", Actions: []string{"read"}},
+	{Name: "idp", Actions: []string{"read", "write"}},
+}
+
+// NewPermission собирает разрешение из ресурса и действия.
+func NewPermission(resource, action string) Permission {
+	return Permission(resource + ":" + action)
+}
+
+// Resource возвращает ресурс разрешения.
+func (p Permission) Resource() string {
+	resource, _, _ := strings.Cut(string(p), ":")
+	return resource
+}
+
+// Action возвращает действие разрешения.
+func (p Permission) Action() string {
+	_, action, _ := strings.Cut(string(p), ":")
+	return action
+}
+
+// AllPermissions возвращает все разрешения каталога в порядке Resources.
+func AllPermissions() []Permission {
+	var perms []Permission
+	for _, r := range Resources {
+		for _, a := range r.Actions {
+			perms = append(perms, NewPermission(r.Name, a))
+		}
+	}
+	return perms
+}
+
+// IsValidPermission проверяет, есть ли разрешение в каталоге.
+func IsValidPermission(p Permission) bool {
+	for _, r := range Resources {
+		if r.Name == p.Resource() {
+			return slices.Contains(r.Actions, p.Action())
+		}
+	}
+	return false
+}
+
+// PermissionSet — набор разрешений.
+type PermissionSet map[Permission]bool
+
+// NewPermissionSet создаёт набор из списка разрешений.
+func NewPermissionSet(perms ...Permission) PermissionSet {
+	s := make(PermissionSet, len(perms))
+	for _, p := range perms {
+		s[p] = true
+	}
+	return s
+}
+
+// Has проверяет наличие разрешения в наборе.
+func (s PermissionSet) Has(p Permission) bool {
+	return s[p]
+}
+
+// Contains проверяет, что набор содержит все разрешения other.
+func (s PermissionSet) Contains(other PermissionSet) bool {
+	for p := range other {
+		if !s[p] {
+			return false
+		}
+	}
+	return true
+}
+
+// List возвращает разрешения набора в порядке каталога.
+func (s PermissionSet) List() []Permission {
+	perms := make([]Permission, 0, len(s))
+	for _, p := range AllPermissions() {
+		if s[p] {
+			perms = append(perms, p)
+		}
+	}
+	return perms
+}
@@ -1,16 +1,22 @@
-// Пакет rbac — логика определения эффективной роли пользователя.
+// Пакет rbac — логика определения ролей и разрешений пользователя.
 // Реализует двухуровневую авторизацию: роли из IdP + локальные дополнения.
-// Правила: итоговая роль = max(роль из IdP, локальное дополнение).
-// Роль можно только повысить, не понизить.
+// Правила: итоговые разрешения = разрешения ролей из IdP ∪ разрешения
+// локального дополнения. Роль можно только повысить, не понизить.
+//
+// Встроенные роли admin и readonly определены в коде (catalog.go),
+// пользовательские роли хранятся в БД; проверки доступа выполняются
+// по разрешениям (permissions.go), а не по именам ролей.
 package rbac
 
-// Роли в порядке возрастания привилегий.
+import "slices"
+
+// Встроенные роли в порядке возрастания привилегий.
 const (
 	RoleReadonly = "readonly"
 	RoleAdmin    = "admin"
 )
 
-// roleWeight — вес роли для сравнения.
+// roleWeight — вес встроенной роли для сравнения.
 // Чем выше вес, тем больше привилегий.
 var roleWeight = map[string]int{
 	RoleReadonly: 1,
@@ -37,6 +43,11 @@ func maxRole(a, b string) string {
 	return b
 }
 
+// IsDowngrade сообщает, даёт ли роль to меньше привилегий, чем from.
+func IsDowngrade(from, to string) bool {
+	return roleWeight[to] < roleWeight[from]
+}
+
 // HighestRole возвращает максимальную роль из набора.
 // Если набор пуст — возвращает пустую строку.
 func HighestRole(roles []string) string {
@@ -50,28 +61,39 @@ func HighestRole(roles []string) string {
 	return highest
 }
 
-// MapGroupsToRole определяет роль пользователя на основе его групп IdP.
-// Проверяет принадлежность к adminGroups и readonlyGroups.
-// Возвращает максимальную роль из всех совпадений.
-// Если ни одна группа не совпала — возвращает пустую строку.
-func MapGroupsToRole(groups, adminGroups, readonlyGroups []string) string {
-	adminSet := toSet(adminGroups)
-	readonlySet := toSet(readonlyGroups)
+// MapGroupsToRoles определяет роли пользователя на основе его групп IdP.
+// mapping — группы IdP для каждой роли (роль → группы).
+// Возвращает отсортированный список ролей без повторов; если ни одна
+// группа не совпала — пустой список.
+func MapGroupsToRoles(groups []string, mapping map[string][]string) []string {
+	userGroups := toSet(groups)
 
 	var roles []string
-	for _, g := range groups {
-		if adminSet[g] {
-			roles = append(roles, RoleAdmin)
-		}
-		if readonlySet[g] {
-			roles = append(roles, RoleReadonly)
+	for role, roleGroups := range mapping {
+		for _, g := range roleGroups {
+			if userGroups[g] {
+				roles = append(roles, role)
+				break
+			}
 		}
 	}
 
-	return HighestRole(roles)
+	slices.Sort(roles)
+	return roles
+}
+
+// MapGroupsToRole определяет встроенную роль пользователя на основе его
+// групп IdP. Проверяет принадлежность к adminGroups и readonlyGroups.
+// Возвращает максимальную роль из всех совпадений.
+// Если ни одна группа не совпала — возвращает пустую строку.
+func MapGroupsToRole(groups, adminGroups, readonlyGroups []string) string {
+	return HighestRole(MapGroupsToRoles(groups, map[string][]string{
+		RoleAdmin:    adminGroups,
+		RoleReadonly: readonlyGroups,
+	}))
 }
 
-// IsValidRole проверяет, является ли строка допустимой ролью.
+// IsValidRole проверяет, является ли строка встроенной ролью.
 func IsValidRole(role string) bool {
 	_, ok := roleWeight[role]
 	return ok
@@ -169,6 +169,27 @@ func TestMapGroupsToRole_CustomGroups(t *testing.T) {
 	}
 }
 
+func TestIsDowngrade(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{RoleAdmin, RoleReadonly, true},
+		{RoleAdmin, "", true},
+		{RoleReadonly, "", true},
+		{RoleReadonly, RoleAdmin, false},
+		{RoleAdmin, RoleAdmin, false},
+		{"", RoleReadonly, false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsDowngrade(tt.from, tt.to); got != tt.want {
+			t.Errorf("IsDowngrade(%q, %q) = %v, хотели %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
 func TestIsValidRole(t *testing.T) {
 	tests := []struct {
 		role string
@@ -0,0 +1,180 @@
+// Пакет idp — абстракция Identity Provider Admin Module.
+// Provider скрывает конкретный IdP (Keycloak или встроенный локальный
+// провайдер на PostgreSQL): пользователи, группы, клиенты Service Accounts,
+// их секреты и claims токенов. Сервисы Admin Module работают только через
+// этот интерфейс.
+package idp
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Имена провайдеров (AM_IDP_PROVIDER).
+const (
+	ProviderKeycloak = "keycloak"
+	ProviderLocal    = "local"
+)
+
+var (
+	// ErrNotFound — пользователь или клиент не найден в IdP.
+	ErrNotFound = errors.New("не найдено в IdP")
+	// ErrConflict — пользователь или клиент с таким именем уже существует.
+	ErrConflict = errors.New("конфликт в IdP")
+	// ErrValidation — некорректные данные пользователя или клиента.
+	ErrValidation = errors.New("некорректные данные")
+	// ErrUnsupported — операция не поддерживается провайдером
+	// (например, управление пользователями при внешнем IdP).
+	ErrUnsupported = errors.New("операция не поддерживается IdP")
+	// ErrInvalidCredentials — неверные имя пользователя, пароль или секрет.
+	ErrInvalidCredentials = errors.New("неверные учётные данные")
+	// ErrLocked — учётная запись временно заблокирована после неудачных попыток входа.
+	ErrLocked = errors.New("учётная запись временно заблокирована")
+)
+
+// User — пользователь IdP.
+type User struct {
+	ID        string
+	Username  string
+	Email     string
+	FirstName string
+	LastName  string
+	Enabled   bool
+	CreatedAt time.Time
+}
+
+// Group — группа пользователей IdP.
+type Group struct {
+	ID   string
+	Name string
+	Path string
+}
+
+// Client — OAuth2-клиент IdP (Service Account, Client Credentials grant).
+type Client struct {
+	// ID — внутренний идентификатор клиента в IdP
+	ID          string
+	ClientID    string
+	Name        string
+	Description string
+	Enabled     bool
+	Scopes      []string
+}
+
+// Provider — Identity Provider Admin Module.
+type Provider interface {
+	// Name возвращает имя провайдера (ProviderKeycloak или ProviderLocal).
+	Name() string
+	// Ping проверяет доступность IdP.
+	Ping(ctx context.Context) error
+	// CheckReady проверяет готовность IdP (для /health/ready).
+	CheckReady() (status, message string)
+	// TokenProvider возвращает функцию получения access token Admin Module
+	// (для запросов к Storage Elements).
+	TokenProvider() func(ctx context.Context) (string, error)
+
+	// ListUsers возвращает пользователей с поиском по username, email и имени.
+	ListUsers(ctx context.Context, query string, first, maxResults int) ([]User, error)
+	// CountUsers возвращает количество пользователей.
+	CountUsers(ctx context.Context) (int, error)
+	// GetUser возвращает пользователя по ID (ErrNotFound, если нет).
+	GetUser(ctx context.Context, id string) (*User, error)
+	// GetUserGroups возвращает группы пользователя.
+	GetUserGroups(ctx context.Context, userID string) ([]Group, error)
+
+	// ListClients возвращает клиентов, client_id которых начинается с prefix.
+	ListClients(ctx context.Context, prefix string, first, maxResults int) ([]Client, error)
+	// GetClient возвращает клиента по внутреннему ID (ErrNotFound, если нет).
+	GetClient(ctx context.Context, id string) (*Client, error)
+	// CreateClient регистрирует клиента и возвращает его внутренний ID и секрет.
+	CreateClient(ctx context.Context, client *Client) (id, secret string, err error)
+	// UpdateClient обновляет имя, описание, scopes и состояние клиента.
+	UpdateClient(ctx context.Context, client *Client) error
+	// DeleteClient удаляет клиента.
+	DeleteClient(ctx context.Context, id string) error
+	// RotateClientSecret выпускает новый секрет клиента. Если keepPreviousUntil
+	// задан, старый секрет действует до этого момента; previousExpiresAt —
+	// фактический срок старого секрета (nil, если его не удалось сохранить).
+	RotateClientSecret(ctx context.Context, id string, keepPreviousUntil *time.Time) (secret string, previousExpiresAt *time.Time, err error)
+	// SetClientClaim добавляет в access token клиента claim name с JSON-значением
+	// value. Пустой value удаляет claim.
+	SetClientClaim(ctx context.Context, id, name, value string) error
+}
+
+// UserAccount — данные учётной записи пользователя при создании и изменении.
+type UserAccount struct {
+	Username  string
+	Email     string
+	FirstName string
+	LastName  string
+	Enabled   bool
+	// Groups — группы пользователя (определяют роль через AM_ROLE_ADMIN_GROUPS и AM_ROLE_READONLY_GROUPS)
+	Groups []string
+}
+
+// UserManager — управление учётными записями пользователей. Реализуется
+// провайдерами, которые сами хранят пользователей (локальный IdP); при
+// внешнем IdP пользователи управляются в нём.
+type UserManager interface {
+	// CreateUser создаёт пользователя с паролем.
+	CreateUser(ctx context.Context, account UserAccount, password string) (*User, error)
+	// UpdateUser обновляет email, имя, группы и состояние пользователя
+	// (username не меняется).
+	UpdateUser(ctx context.Context, id string, account UserAccount) (*User, error)
+	// DeleteUser удаляет пользователя.
+	DeleteUser(ctx context.Context, id string) error
+	// SetPassword устанавливает пароль и снимает блокировку входа.
+	SetPassword(ctx context.Context, id, password string) error
+}
+
+// Состояния ключа подписи JWT.
+const (
+	// SigningKeyPending — ключ опубликован в JWKS, но ещё не подписывает токены.
+	SigningKeyPending = "pending"
+	// SigningKeyActive — ключ подписывает новые токены.
+	SigningKeyActive = "active"
+	// SigningKeyRetiring — ключ заменён новым и остаётся в JWKS до RetiresAt,
+	// пока не истекут подписанные им токены.
+	SigningKeyRetiring = "retiring"
+)
+
+// SigningKey — ключ подписи JWT.
+type SigningKey struct {
+	KID       string
+	Algorithm string
+	// State — SigningKeyPending, SigningKeyActive или SigningKeyRetiring
+	State       string
+	CreatedAt   time.Time
+	ActivatesAt time.Time
+	// RetiresAt — момент удаления ключа из JWKS (только для SigningKeyRetiring)
+	RetiresAt *time.Time
+}
+
+// SigningKeyStatus — состояние набора ключей подписи JWT.
+type SigningKeyStatus struct {
+	// Algorithm — алгоритм новых ключей (RS256 или ES256)
+	Algorithm string
+	// RotationInterval — период плановой ротации (0 — только ручная)
+	RotationInterval time.Duration
+	// Overlap — сколько заменённый ключ остаётся в JWKS
+	Overlap time.Duration
+	// PublishLead — за сколько новый ключ публикуется до начала подписи
+	PublishLead time.Duration
+	// NextRotationAt — время следующей плановой ротации (nil — отключена)
+	NextRotationAt *time.Time
+	// Keys — ключи, новые первыми
+	Keys []SigningKey
+}
+
+// KeyManager — управление ключами подписи JWT. Реализуется провайдерами,
+// которые сами выпускают токены (локальный IdP); ключами внешнего IdP
+// управляет он сам.
+type KeyManager interface {
+	// SigningKeyStatus возвращает состояние ключей подписи.
+	SigningKeyStatus(ctx context.Context) (*SigningKeyStatus, error)
+	// RotateSigningKey создаёт новый ключ подписи. Без immediate ключ начинает
+	// подписывать токены через PublishLead, чтобы потребители JWKS успели его
+	// получить; immediate — сразу (например, при компрометации ключа).
+	RotateSigningKey(ctx context.Context, immediate bool) (*SigningKey, error)
+}
@@ -0,0 +1,159 @@
+// handler.go — HTTP endpoints локального IdP (без JWT-аутентификации):
+//   - POST /oauth2/token — Client Credentials grant (RFC 6749 §4.4),
+//     аутентификация клиента через HTTP Basic или client_id/client_secret в форме;
+//   - GET /.well-known/jwks.json — открытые ключи подписи;
+//   - GET /.well-known/openid-configuration — метаданные issuer.
+package local
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+)
+
+// Пути endpoints локального IdP.
+const (
+	TokenPath     = "/oauth2/token"
+	JWKSPath      = "/.well-known/jwks.json"
+	DiscoveryPath = "/.well-known/openid-configuration"
+)
+
+// maxTokenRequestBytes — ограничение размера тела запроса токена.
+const maxTokenRequestBytes = 16 << 10
+
+// tokenResponse — успешный ответ token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"` //nolint:gosec // G117: структура токена OAuth2
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+// oauthError — ошибка token endpoint (RFC 6749 §5.2).
+type oauthError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+// HandleToken выдаёт access token по Client Credentials grant.
+func (p *Provider) HandleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeOAuthError(w, http.StatusMethodNotAllowed, "invalid_request", "ожидается POST")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxTokenRequestBytes)
+	if err := r.ParseForm(); err != nil {
+		writeOAuthError(w, http.StatusBadRequest, "invalid_request", "некорректное тело запроса")
+		return
+	}
+
+	if grantType := r.PostForm.Get("grant_type"); grantType != "client_credentials" {
+		writeOAuthError(w, http.StatusBadRequest, "unsupported_grant_type",
+			"поддерживается только grant_type=client_credentials")
+		return
+	}
+
+	clientID, secret, basic := clientCredentials(r)
+	if clientID == "" || secret == "" {
+		writeInvalidClient(w, basic)
+		return
+	}
+
+	token, err := p.ClientCredentials(r.Context(), clientID, secret, r.PostForm.Get("scope"))
+	switch {
+	case errors.Is(err, idp.ErrInvalidCredentials):
+		p.logger.Info("Отказ в выдаче токена: неверные учётные данные клиента",
+			slog.String("client_id", clientID),
+			slog.String("remote_addr", r.RemoteAddr),
+		)
+		writeInvalidClient(w, basic)
+		return
+	case errors.Is(err, errInvalidScope):
+		writeOAuthError(w, http.StatusBadRequest, "invalid_scope", err.Error())
+		return
+	case err != nil:
+		p.logger.Error("Ошибка выдачи токена",
+			slog.String("client_id", clientID),
+			slog.String("error", err.Error()),
+		)
+		writeOAuthError(w, http.StatusInternalServerError, "server_error", "")
+		return
+	}
+
+	writeNoStoreJSON(w, http.StatusOK, tokenResponse{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		ExpiresIn:   token.ExpiresIn,
+		Scope:       token.Scope,
+	})
+}
+
+// HandleJWKS возвращает открытые ключи подписи.
+func (p *Provider) HandleJWKS(w http.ResponseWriter, r *http.Request) {
+	body, err := p.jwks.JSONPublic(r.Context())
+	if err != nil {
+		p.logger.Error("Ошибка формирования JWKS", slog.String("error", err.Error()))
+		http.Error(w, "JWKS недоступен", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	_, _ = w.Write(body)
+}
+
+// HandleDiscovery возвращает метаданные issuer (подмножество OpenID Connect Discovery).
+func (p *Provider) HandleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	issuer := strings.TrimRight(p.cfg.Issuer, "/")
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                                issuer,
+		"token_endpoint":                        issuer + TokenPath,
+		"jwks_uri":                              issuer + JWKSPath,
+		"grant_types_supported":                 []string{"client_credentials"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+		"id_token_signing_alg_values_supported": []string{AlgorithmRS256, AlgorithmES256},
+	})
+}
+
+// clientCredentials извлекает client_id и секрет из HTTP Basic
+// (значения URL-кодированы, RFC 6749 §2.3.1) или из формы.
+func clientCredentials(r *http.Request) (clientID, secret string, basic bool) {
+	if user, pass, ok := r.BasicAuth(); ok {
+		clientID, errID := url.QueryUnescape(user)
+		secret, errSecret := url.QueryUnescape(pass)
+		if errID != nil || errSecret != nil {
+			return "", "", true
+		}
+		return clientID, secret, true
+	}
+	return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret"), false
+}
+
+// writeInvalidClient отвечает invalid_client (401 с WWW-Authenticate для Basic).
+func writeInvalidClient(w http.ResponseWriter, basic bool) {
+	if basic {
+		w.Header().Set("WWW-Authenticate", `Basic realm="artstore"`)
+	}
+	writeOAuthError(w, http.StatusUnauthorized, "invalid_client", "неверные учётные данные клиента")
+}
+
+// writeOAuthError отвечает ошибкой в формате RFC 6749.
+func writeOAuthError(w http.ResponseWriter, status int, code, description string) {
+	writeNoStoreJSON(w, status, oauthError{Error: code, Description: description})
+}
+
+// writeNoStoreJSON записывает JSON-ответ, запрещая кэширование.
+func writeNoStoreJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,472 @@
+// keys.go — ключи подписи JWT локального IdP.
+// Ключи RS256 или ES256 хранятся в jwt_signing_keys, закрытые ключи
+// зашифрованы AES-256-GCM мастер-ключом (AM_KEYS_MASTER_KEY). Все ключи
+// публикуются в JWKS; токены подписывает последний активированный ключ.
+//
+// Ротация (плановая каждые AM_KEYS_ROTATION_INTERVAL или ручная) создаёт
+// ключ, который публикуется сразу, а подписывает токены через
+// AM_KEYS_PUBLISH_LEAD — потребители JWKS успевают его получить. Заменённый
+// ключ остаётся в JWKS ещё AM_KEYS_OVERLAP, пока не истекут подписанные им
+// токены, затем удаляется. Первый ключ создаётся при старте, если таблица
+// пуста. Набор ключей периодически перечитывается из БД, чтобы реплики
+// Admin Module публиковали одинаковый JWKS.
+package local
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+)
+
+// Алгоритмы подписи access token.
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+)
+
+// rsaKeyBits — размер генерируемых RSA-ключей.
+const rsaKeyBits = 2048
+
+// errNoSigningKey — ключи подписи ещё не загружены.
+var errNoSigningKey = errors.New("ключ подписи JWT не загружен")
+
+// signingKey — загруженный ключ подписи.
+type signingKey struct {
+	kid         string
+	alg         string
+	private     crypto.Signer
+	der         []byte
+	activatesAt time.Time
+}
+
+// method возвращает метод подписи JWT ключа.
+func (k *signingKey) method() jwt.SigningMethod {
+	if k.alg == AlgorithmES256 {
+		return jwt.SigningMethodES256
+	}
+	return jwt.SigningMethodRS256
+}
+
+// refreshSecret возвращает HMAC-ключ refresh token, производный от закрытого
+// ключа. Refresh token подписывается HS256 и поэтому не принимается
+// как access token (JWT middleware, SE и QM допускают только RS256 и ES256).
+func (k *signingKey) refreshSecret() []byte {
+	mac := hmac.New(sha256.New, k.der)
+	mac.Write([]byte("artstore-refresh-token"))
+	return mac.Sum(nil)
+}
+
+// masterKey — мастер-ключ шифрования закрытых ключей подписи.
+type masterKey struct {
+	gcm cipher.AEAD
+	// id — отпечаток мастер-ключа (первые 8 байт SHA-256)
+	id string
+}
+
+// newMasterKey создаёт мастер-ключ из AM_KEYS_MASTER_KEY: ровно 32 байта
+// в base64. Другие значения отклоняются — строка-пароль без KDF не даёт
+// ключу AES-256 достаточной энтропии.
+func newMasterKey(secret string) (*masterKey, error) {
+	if secret == "" {
+		return nil, errors.New("мастер-ключ шифрования ключей подписи не задан")
+	}
+	keyBytes, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil || len(keyBytes) != 32 {
+		return nil, errors.New("мастер-ключ шифрования ключей подписи должен быть 32 байтами в base64")
+	}
+
+	block, err := aes.NewCipher(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка создания AES cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка создания GCM: %w", err)
+	}
+	sum := sha256.Sum256(keyBytes)
+	return &masterKey{gcm: gcm, id: hex.EncodeToString(sum[:8])}, nil
+}
+
+// encrypt шифрует закрытый ключ; kid связывается с шифротекстом
+// как дополнительные данные, поэтому ключи нельзя переставить между строками.
+func (m *masterKey) encrypt(kid string, der []byte) ([]byte, error) {
+	nonce := make([]byte, m.gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("ошибка генерации nonce: %w", err)
+	}
+	return m.gcm.Seal(nonce, nonce, der, []byte(kid)), nil
+}
+
+// decrypt расшифровывает закрытый ключ.
+func (m *masterKey) decrypt(kid string, ciphertext []byte) ([]byte, error) {
+	nonceSize := m.gcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, errors.New("зашифрованный ключ слишком короткий")
+	}
+	der, err := m.gcm.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], []byte(kid))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка расшифровки ключа: %w", err)
+	}
+	return der, nil
+}
+
+// generateSigningKey создаёт новый ключ подписи с алгоритмом alg,
+// зашифрованный мастер-ключом.
+func (p *Provider) generateSigningKey(alg string, activatesAt time.Time) (*model.SigningKey, error) {
+	var private crypto.Signer
+	var err error
+	switch alg {
+	case AlgorithmES256:
+		private, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		private, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("генерация ключа %s: %w", alg, err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, fmt.Errorf("кодирование ключа %s: %w", alg, err)
+	}
+	kid, err := keyID(private.Public())
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := p.master.encrypt(kid, der)
+	if err != nil {
+		return nil, err
+	}
+	return &model.SigningKey{
+		KID:         kid,
+		Algorithm:   alg,
+		PrivateKey:  encrypted,
+		MasterKeyID: p.master.id,
+		ActivatesAt: activatesAt,
+	}, nil
+}
+
+// keyID — идентификатор ключа: первые 8 байт SHA-256 открытого ключа.
+func keyID(public crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return "", fmt.Errorf("кодирование открытого ключа: %w", err)
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// parseSigningKey разбирает расшифрованный ключ из jwt_signing_keys.
+func parseSigningKey(k *model.SigningKey, der []byte) (*signingKey, error) {
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("ключ %s: %w", k.KID, err)
+	}
+	var private crypto.Signer
+	switch k.Algorithm {
+	case AlgorithmRS256:
+		rsaKey, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("ключ %s: ожидался RSA-ключ", k.KID)
+		}
+		private = rsaKey
+	case AlgorithmES256:
+		ecKey, ok := parsed.(*ecdsa.PrivateKey)
+		if !ok || ecKey.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("ключ %s: ожидался ECDSA-ключ P-256", k.KID)
+		}
+		private = ecKey
+	default:
+		return nil, fmt.Errorf("ключ %s: неподдерживаемый алгоритм %s", k.KID, k.Algorithm)
+	}
+	return &signingKey{
+		kid:         k.KID,
+		alg:         k.Algorithm,
+		private:     private,
+		der:         der,
+		activatesAt: k.ActivatesAt,
+	}, nil
+}
+
+// decryptSigningKey возвращает закрытый ключ в PKCS#8 DER. Ключи, созданные
+// до шифрования, шифруются мастер-ключом и перезаписываются в БД.
+func (p *Provider) decryptSigningKey(ctx context.Context, k *model.SigningKey) ([]byte, error) {
+	switch k.MasterKeyID {
+	case p.master.id:
+		return p.master.decrypt(k.KID, k.PrivateKey)
+	case "":
+		der := k.PrivateKey
+		encrypted, err := p.master.encrypt(k.KID, der)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.keys.UpdatePrivateKey(ctx, k.KID, encrypted, p.master.id); err != nil {
+			return nil, err
+		}
+		p.logger.Info("Ключ подписи JWT зашифрован мастер-ключом", slog.String("kid", k.KID))
+		return der, nil
+	default:
+		return nil, fmt.Errorf("ключ %s зашифрован другим мастер-ключом (%s)", k.KID, k.MasterKeyID)
+	}
+}
+
+// loadKeys загружает ключи из БД (создавая первый при необходимости)
+// и обновляет JWKS.
+func (p *Provider) loadKeys(ctx context.Context) error {
+	stored, err := p.keys.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(stored) == 0 {
+		k, err := p.generateSigningKey(p.cfg.KeyAlgorithm, p.now())
+		if err != nil {
+			return err
+		}
+		created, err := p.keys.CreateIfNoneSince(ctx, k, time.Time{})
+		if err != nil {
+			return err
+		}
+		if created {
+			p.logger.Info("Создан ключ подписи JWT",
+				slog.String("kid", k.KID),
+				slog.String("algorithm", k.Algorithm),
+			)
+		}
+		if stored, err = p.keys.List(ctx); err != nil {
+			return err
+		}
+	}
+
+	ordered := make([]*signingKey, 0, len(stored))
+	loaded := make(map[string]*signingKey, len(stored))
+	jwks := make([]jwkset.JWK, 0, len(stored))
+	var newest time.Time
+	for _, sk := range stored {
+		der, err := p.decryptSigningKey(ctx, sk)
+		var k *signingKey
+		if err == nil {
+			k, err = parseSigningKey(sk, der)
+		}
+		if err != nil {
+			p.logger.Error("Ключ подписи JWT пропущен",
+				slog.String("kid", sk.KID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		jwk, err := jwkset.NewJWKFromKey(k.private.Public(), jwkset.JWKOptions{
+			Metadata: jwkset.JWKMetadataOptions{
+				KID: k.kid,
+				ALG: jwkset.ALG(k.alg),
+				USE: jwkset.UseSig,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("формирование JWK %s: %w", k.kid, err)
+		}
+		if sk.CreatedAt.After(newest) {
+			newest = sk.CreatedAt
+		}
+		ordered = append(ordered, k)
+		loaded[k.kid] = k
+		jwks = append(jwks, jwk)
+	}
+	if len(ordered) == 0 {
+		return errNoSigningKey
+	}
+
+	if err := p.jwks.KeyReplaceAll(ctx, jwks); err != nil {
+		return fmt.Errorf("обновление JWKS: %w", err)
+	}
+
+	p.mu.Lock()
+	p.ordered = ordered
+	p.loaded = loaded
+	p.newestKeyAt = newest
+	p.mu.Unlock()
+	return nil
+}
+
+// maintainKeys выполняет плановую ротацию, удаляет ключи, чей период
+// перекрытия истёк, и перечитывает набор ключей.
+func (p *Provider) maintainKeys(ctx context.Context) error {
+	now := p.now()
+
+	p.mu.RLock()
+	newest := p.newestKeyAt
+	p.mu.RUnlock()
+
+	if interval := p.cfg.KeyRotationInterval; interval > 0 && !newest.IsZero() && !now.Before(newest.Add(interval)) {
+		k, err := p.generateSigningKey(p.cfg.KeyAlgorithm, now.Add(p.cfg.KeyPublishLead))
+		if err != nil {
+			return err
+		}
+		// Условная вставка: при одновременной проверке на нескольких репликах
+		// ключ создаёт только одна
+		created, err := p.keys.CreateIfNoneSince(ctx, k, now.Add(-interval))
+		if err != nil {
+			return err
+		}
+		if created {
+			p.logger.Info("Плановая ротация ключа подписи JWT",
+				slog.String("kid", k.KID),
+				slog.String("algorithm", k.Algorithm),
+				slog.Time("activates_at", k.ActivatesAt),
+			)
+		}
+	}
+
+	deleted, err := p.keys.DeleteRetired(ctx, now.Add(-p.cfg.KeyOverlap))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		p.logger.Info("Удалены заменённые ключи подписи JWT", slog.Int("count", deleted))
+	}
+
+	return p.loadKeys(ctx)
+}
+
+// currentKey возвращает ключ, которым подписываются новые токены:
+// последний активированный (или, если активированных нет, ближайший к активации).
+func (p *Provider) currentKey() (*signingKey, error) {
+	now := p.now()
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.ordered) == 0 {
+		return nil, errNoSigningKey
+	}
+	for _, k := range p.ordered {
+		if !k.activatesAt.After(now) {
+			return k, nil
+		}
+	}
+	return p.ordered[len(p.ordered)-1], nil
+}
+
+// keyByID возвращает загруженный ключ по kid.
+func (p *Provider) keyByID(kid string) (*signingKey, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	k, ok := p.loaded[kid]
+	return k, ok
+}
+
+// SigningKeyStatus возвращает состояние ключей подписи из БД.
+func (p *Provider) SigningKeyStatus(ctx context.Context) (*idp.SigningKeyStatus, error) {
+	stored, err := p.keys.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := p.now()
+	status := &idp.SigningKeyStatus{
+		Algorithm:        p.cfg.KeyAlgorithm,
+		RotationInterval: p.cfg.KeyRotationInterval,
+		Overlap:          p.cfg.KeyOverlap,
+		PublishLead:      p.cfg.KeyPublishLead,
+		Keys:             signingKeyStates(stored, now, p.cfg.KeyOverlap),
+	}
+	if p.cfg.KeyRotationInterval > 0 {
+		var newest time.Time
+		for _, k := range stored {
+			if k.CreatedAt.After(newest) {
+				newest = k.CreatedAt
+			}
+		}
+		if !newest.IsZero() {
+			next := newest.Add(p.cfg.KeyRotationInterval)
+			status.NextRotationAt = &next
+		}
+	}
+	return status, nil
+}
+
+// RotateSigningKey создаёт новый ключ подписи и перечитывает набор ключей.
+func (p *Provider) RotateSigningKey(ctx context.Context, immediate bool) (*idp.SigningKey, error) {
+	now := p.now()
+	activatesAt := now.Add(p.cfg.KeyPublishLead)
+	if immediate {
+		activatesAt = now
+	}
+	k, err := p.generateSigningKey(p.cfg.KeyAlgorithm, activatesAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.keys.Create(ctx, k); err != nil {
+		return nil, err
+	}
+	p.logger.Info("Ротация ключа подписи JWT",
+		slog.String("kid", k.KID),
+		slog.String("algorithm", k.Algorithm),
+		slog.Time("activates_at", k.ActivatesAt),
+	)
+
+	if err := p.loadKeys(ctx); err != nil {
+		return nil, err
+	}
+	state := idp.SigningKeyPending
+	if immediate {
+		state = idp.SigningKeyActive
+	}
+	return &idp.SigningKey{
+		KID:         k.KID,
+		Algorithm:   k.Algorithm,
+		State:       state,
+		CreatedAt:   now,
+		ActivatesAt: k.ActivatesAt,
+	}, nil
+}
+
+// signingKeyStates вычисляет состояния ключей (stored — позже активируемые
+// первыми): ещё не активированные — pending, последний активированный —
+// active, более старые — retiring до активации следующего ключа + overlap.
+func signingKeyStates(stored []*model.SigningKey, now time.Time, overlap time.Duration) []idp.SigningKey {
+	result := make([]idp.SigningKey, 0, len(stored))
+	var successor *time.Time
+	for _, k := range stored {
+		key := idp.SigningKey{
+			KID:         k.KID,
+			Algorithm:   k.Algorithm,
+			CreatedAt:   k.CreatedAt,
+			ActivatesAt: k.ActivatesAt,
+		}
+		switch {
+		case k.ActivatesAt.After(now):
+			key.State = idp.SigningKeyPending
+		case successor == nil:
+			key.State = idp.SigningKeyActive
+		default:
+			key.State = idp.SigningKeyRetiring
+			retiresAt := successor.Add(overlap)
+			key.RetiresAt = &retiresAt
+		}
+		if key.State != idp.SigningKeyPending {
+			activatesAt := k.ActivatesAt
+			successor = &activatesAt
+		}
+		result = append(result, key)
+	}
+	return result
+}
@@ -0,0 +1,290 @@
+// keys_test.go — unit-тесты ключей подписи локального IdP: ротация
+// с перекрытием, плановая ротация, ES256 и шифрование мастер-ключом.
+package local
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+)
+
+// tokenKID возвращает kid из заголовка токена.
+func tokenKID(t *testing.T, token string) string {
+	t.Helper()
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("ParseUnverified() ошибка: %v", err)
+	}
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
+
+// loginTokens создаёт пользователя и возвращает его токены.
+func loginTokens(t *testing.T, p *Provider) *UserTokens {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := p.users.GetByUsername(ctx, "alice"); err != nil {
+		if _, err := p.CreateUser(ctx, idp.UserAccount{Username: "alice", Enabled: true}, testPassword); err != nil {
+			t.Fatalf("CreateUser() ошибка: %v", err)
+		}
+	}
+	tokens, err := p.PasswordLogin(ctx, "alice", testPassword)
+	if err != nil {
+		t.Fatalf("PasswordLogin() ошибка: %v", err)
+	}
+	return tokens
+}
+
+// keyStates возвращает состояния ключей по kid.
+func keyStates(t *testing.T, p *Provider) map[string]idp.SigningKey {
+	t.Helper()
+	status, err := p.SigningKeyStatus(context.Background())
+	if err != nil {
+		t.Fatalf("SigningKeyStatus() ошибка: %v", err)
+	}
+	result := make(map[string]idp.SigningKey, len(status.Keys))
+	for _, k := range status.Keys {
+		result[k.KID] = k
+	}
+	return result
+}
+
+// TestRotateSigningKey проверяет ротацию с публикацией заранее и перекрытием:
+// новый ключ сразу в JWKS, подписывает после PublishLead, старый удаляется
+// через Overlap после активации нового.
+func TestRotateSigningKey(t *testing.T) {
+	p, clock := newTestProviderWith(t, func(cfg *Config) {
+		cfg.KeyPublishLead = time.Minute
+	})
+	ctx := context.Background()
+
+	oldToken := loginTokens(t, p)
+	oldKID := tokenKID(t, oldToken.AccessToken)
+
+	rotated, err := p.RotateSigningKey(ctx, false)
+	if err != nil {
+		t.Fatalf("RotateSigningKey() ошибка: %v", err)
+	}
+	if rotated.State != idp.SigningKeyPending || rotated.KID == oldKID {
+		t.Fatalf("новый ключ = %+v, ожидался pending с новым kid", rotated)
+	}
+	if _, ok := p.keyByID(rotated.KID); !ok {
+		t.Fatal("новый ключ не загружен до активации")
+	}
+
+	// До активации подписывает старый ключ
+	token := loginTokens(t, p)
+	if kid := tokenKID(t, token.AccessToken); kid != oldKID {
+		t.Errorf("до активации kid = %s, ожидался %s", kid, oldKID)
+	}
+
+	clock.Advance(p.cfg.KeyPublishLead)
+	token = loginTokens(t, p)
+	if kid := tokenKID(t, token.AccessToken); kid != rotated.KID {
+		t.Errorf("после активации kid = %s, ожидался %s", kid, rotated.KID)
+	}
+
+	states := keyStates(t, p)
+	if states[rotated.KID].State != idp.SigningKeyActive {
+		t.Errorf("состояние нового ключа = %s, ожидалось active", states[rotated.KID].State)
+	}
+	old := states[oldKID]
+	if old.State != idp.SigningKeyRetiring || old.RetiresAt == nil ||
+		!old.RetiresAt.Equal(rotated.ActivatesAt.Add(p.cfg.KeyOverlap)) {
+		t.Errorf("старый ключ = %+v, ожидался retiring до активации нового + overlap", old)
+	}
+
+	// Токены старого ключа и refresh token действуют в период перекрытия
+	if _, err := parseAccessToken(t, p, oldToken.AccessToken, clock); err != nil {
+		t.Errorf("токен старого ключа отклонён в период перекрытия: %v", err)
+	}
+	if _, err := p.Refresh(ctx, oldToken.RefreshToken); err != nil {
+		t.Errorf("Refresh() старым ключом в период перекрытия: %v", err)
+	}
+
+	clock.Advance(p.cfg.KeyOverlap)
+	if err := p.maintainKeys(ctx); err != nil {
+		t.Fatalf("maintainKeys() ошибка: %v", err)
+	}
+	if _, ok := p.keyByID(oldKID); ok {
+		t.Error("старый ключ не удалён после перекрытия")
+	}
+	if _, ok := keyStates(t, p)[oldKID]; ok {
+		t.Error("старый ключ остался в статусе после перекрытия")
+	}
+}
+
+// TestRotateSigningKey_Immediate проверяет немедленную ротацию.
+func TestRotateSigningKey_Immediate(t *testing.T) {
+	p, clock := newTestProvider(t)
+	clock.Advance(time.Second)
+
+	rotated, err := p.RotateSigningKey(context.Background(), true)
+	if err != nil {
+		t.Fatalf("RotateSigningKey() ошибка: %v", err)
+	}
+	if rotated.State != idp.SigningKeyActive {
+		t.Errorf("State = %s, ожидалось active", rotated.State)
+	}
+	token := loginTokens(t, p)
+	if kid := tokenKID(t, token.AccessToken); kid != rotated.KID {
+		t.Errorf("kid = %s, ожидался %s", kid, rotated.KID)
+	}
+}
+
+// TestMaintainKeys_ScheduledRotation проверяет плановую ротацию по интервалу.
+func TestMaintainKeys_ScheduledRotation(t *testing.T) {
+	p, clock := newTestProviderWith(t, func(cfg *Config) {
+		cfg.KeyRotationInterval = 24 * time.Hour
+		cfg.KeyOverlap = 12 * time.Hour
+	})
+	ctx := context.Background()
+
+	status, err := p.SigningKeyStatus(ctx)
+	if err != nil {
+		t.Fatalf("SigningKeyStatus() ошибка: %v", err)
+	}
+	if len(status.Keys) != 1 || status.NextRotationAt == nil ||
+		!status.NextRotationAt.Equal(status.Keys[0].CreatedAt.Add(24*time.Hour)) {
+		t.Fatalf("статус = %+v, ожидался 1 ключ и ротация через 24h", status)
+	}
+
+	// До истечения интервала ротации нет
+	clock.Advance(23 * time.Hour)
+	if err := p.maintainKeys(ctx); err != nil {
+		t.Fatalf("maintainKeys() ошибка: %v", err)
+	}
+	if n := len(keyStates(t, p)); n != 1 {
+		t.Fatalf("ключей = %d до интервала, ожидался 1", n)
+	}
+
+	clock.Advance(time.Hour)
+	if err := p.maintainKeys(ctx); err != nil {
+		t.Fatalf("maintainKeys() ошибка: %v", err)
+	}
+	states := keyStates(t, p)
+	pending := 0
+	for _, k := range states {
+		if k.State == idp.SigningKeyPending {
+			pending++
+		}
+	}
+	if len(states) != 2 || pending != 1 {
+		t.Fatalf("состояния = %+v, ожидался новый pending-ключ", states)
+	}
+
+	// Повторная проверка не создаёт ещё один ключ
+	if err := p.maintainKeys(ctx); err != nil {
+		t.Fatalf("maintainKeys() ошибка: %v", err)
+	}
+	if n := len(keyStates(t, p)); n != 2 {
+		t.Errorf("ключей = %d после повторной проверки, ожидалось 2", n)
+	}
+}
+
+// TestSigningKeys_ES256 проверяет выпуск и проверку токенов ES256.
+func TestSigningKeys_ES256(t *testing.T) {
+	p, clock := newTestProviderWith(t, func(cfg *Config) {
+		cfg.KeyAlgorithm = AlgorithmES256
+	})
+
+	token := loginTokens(t, p)
+	parsed, _, err := jwt.NewParser().ParseUnverified(token.AccessToken, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("ParseUnverified() ошибка: %v", err)
+	}
+	if parsed.Method.Alg() != AlgorithmES256 {
+		t.Errorf("alg = %s, ожидался ES256", parsed.Method.Alg())
+	}
+	if _, err := parseAccessToken(t, p, token.AccessToken, clock); err != nil {
+		t.Errorf("токен ES256 не прошёл проверку по JWKS: %v", err)
+	}
+	if _, err := p.Refresh(context.Background(), token.RefreshToken); err != nil {
+		t.Errorf("Refresh() ошибка: %v", err)
+	}
+}
+
+// TestSigningKeys_Encryption проверяет шифрование закрытых ключей:
+// ключ в БД не хранится открытым, открытый ключ из старой версии
+// шифруется при загрузке, ключ другого мастер-ключа пропускается.
+func TestSigningKeys_Encryption(t *testing.T) {
+	p, _ := newTestProvider(t)
+	ctx := context.Background()
+	repo := p.keys.(*fakeKeys)
+
+	stored := repo.keys[0]
+	if stored.MasterKeyID != p.master.id {
+		t.Errorf("MasterKeyID = %q, ожидался %q", stored.MasterKeyID, p.master.id)
+	}
+	if _, err := x509.ParsePKCS8PrivateKey(stored.PrivateKey); err == nil {
+		t.Fatal("закрытый ключ хранится открытым")
+	}
+
+	// Ключ, созданный до шифрования
+	legacy, err := p.generateSigningKey(AlgorithmRS256, stored.ActivatesAt.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("generateSigningKey() ошибка: %v", err)
+	}
+	der, err := p.master.decrypt(legacy.KID, legacy.PrivateKey)
+	if err != nil {
+		t.Fatalf("decrypt() ошибка: %v", err)
+	}
+	legacy.PrivateKey, legacy.MasterKeyID = der, ""
+	repo.keys = append(repo.keys, legacy)
+
+	// Ключ, зашифрованный другим мастер-ключом
+	other, err := New(p.users, p.clients, repo, Config{
+		KeyAlgorithm: AlgorithmRS256,
+		MasterKey:    "YW5vdGhlci1tYXN0ZXIta2V5LTAxMjM0NTY3ODlhYmM=",
+	}, slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatalf("New() ошибка: %v", err)
+	}
+	foreign, err := other.generateSigningKey(AlgorithmRS256, stored.ActivatesAt.Add(-2*time.Hour))
+	if err != nil {
+		t.Fatalf("generateSigningKey() ошибка: %v", err)
+	}
+	repo.keys = append(repo.keys, foreign)
+
+	if err := p.loadKeys(ctx); err != nil {
+		t.Fatalf("loadKeys() ошибка: %v", err)
+	}
+	if legacy.MasterKeyID != p.master.id || bytes.Equal(legacy.PrivateKey, der) {
+		t.Error("открытый ключ не зашифрован при загрузке")
+	}
+	if _, ok := p.keyByID(legacy.KID); !ok {
+		t.Error("ключ старой версии не загружен")
+	}
+	if _, ok := p.keyByID(foreign.KID); ok {
+		t.Error("загружен ключ другого мастер-ключа")
+	}
+}
+
+// TestNewMasterKey проверяет, что принимаются только 32 байта в base64.
+func TestNewMasterKey(t *testing.T) {
+	for _, secret := range []string{
+		"",
+		"test-master-key-0123456789abcdef", // не base64
+		"c2hvcnQ=",                         // 5 байт
+		"dGVzdC1tYXN0ZXIta2V5LTAxMjM0NTY3ODlhYmNkZWYx", // 33 байта
+	} {
+		if _, err := newMasterKey(secret); err == nil {
+			t.Errorf("newMasterKey(%q) не вернул ошибку", secret)
+		}
+	}
+
+	m, err := newMasterKey("dGVzdC1tYXN0ZXIta2V5LTAxMjM0NTY3ODlhYmNkZWY=")
+	if err != nil {
+		t.Fatalf("newMasterKey() ошибка: %v", err)
+	}
+	if m.id == "" {
+		t.Error("отпечаток мастер-ключа пуст")
+	}
+}
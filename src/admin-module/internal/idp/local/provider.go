@@ -0,0 +1,611 @@
+// Пакет local — встроенный Identity Provider Admin Module на PostgreSQL
+// (AM_IDP_PROVIDER=local). Позволяет развернуть и тестировать ArtStore
+// без Keycloak:
+//   - пользователи Admin UI с паролями bcrypt и блокировкой входа после
+//     AM_LOCAL_IDP_MAX_FAILED_LOGINS неудачных попыток подряд;
+//   - OAuth2-клиенты Service Accounts (Client Credentials grant) с секретами,
+//     хранящимися как SHA-256, и периодом действия старого секрета при ротации;
+//   - access token RS256/ES256 и JWKS (/.well-known/jwks.json) для Admin Module,
+//     Storage Elements и Query Module; ключи подписи зашифрованы мастер-ключом
+//     и ротируются с перекрытием (keys.go).
+package local
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+const (
+	// minPasswordLength — минимальная длина пароля пользователя.
+	minPasswordLength = 8
+	// maxPasswordLength — ограничение bcrypt на длину пароля в байтах.
+	maxPasswordLength = 72
+	// clientSecretBytes — длина секрета клиента в байтах (hex — 64 символа).
+	clientSecretBytes = 32
+	// keyReloadInterval — период перечитывания ключей подписи из БД
+	// и проверки плановой ротации.
+	keyReloadInterval = time.Minute
+	// readinessTimeout — таймаут проверки готовности.
+	readinessTimeout = 5 * time.Second
+)
+
+// usernamePattern — допустимые имена пользователей.
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._@-]{0,254}$`)
+
+// Config — параметры локального IdP.
+type Config struct {
+	// Issuer — issuer выдаваемых токенов (внешний URL Admin Module)
+	Issuer string
+	// AccessTokenTTL — срок действия access token
+	AccessTokenTTL time.Duration
+	// RefreshTokenTTL — срок действия refresh token пользователей Admin UI
+	RefreshTokenTTL time.Duration
+	// MaxFailedLogins — неудачных попыток входа подряд до блокировки
+	MaxFailedLogins int
+	// LockoutDuration — длительность блокировки входа
+	LockoutDuration time.Duration
+	// SelfClientID — client_id токенов самого Admin Module (запросы к SE)
+	SelfClientID string
+	// SelfScopes — scopes токенов самого Admin Module
+	SelfScopes []string
+	// UserScopes — scopes access token пользователей Admin UI
+	UserScopes []string
+	// BootstrapUsername, BootstrapPassword, BootstrapGroups — администратор,
+	// создаваемый при старте, если пользователей ещё нет (пустой пароль — не создавать)
+	BootstrapUsername string
+	BootstrapPassword string //nolint:gosec // G117: пароль первичного администратора
+	BootstrapGroups   []string
+
+	// KeyAlgorithm — алгоритм новых ключей подписи (AlgorithmRS256 или AlgorithmES256)
+	KeyAlgorithm string
+	// MasterKey — мастер-ключ шифрования закрытых ключей в БД
+	MasterKey string //nolint:gosec // G117: мастер-ключ шифрования
+	// KeyRotationInterval — период плановой ротации ключей (0 — только ручная)
+	KeyRotationInterval time.Duration
+	// KeyOverlap — сколько заменённый ключ остаётся в JWKS
+	KeyOverlap time.Duration
+	// KeyPublishLead — за сколько новый ключ публикуется до начала подписи
+	KeyPublishLead time.Duration
+}
+
+// Provider — локальный IdP.
+type Provider struct {
+	users   repository.LocalUserRepository
+	clients repository.LocalClientRepository
+	keys    repository.SigningKeyRepository
+	cfg     Config
+	logger  *slog.Logger
+
+	// jwks — открытые ключи для /.well-known/jwks.json и проверки токенов
+	jwks *jwkset.MemoryJWKSet
+	// master — мастер-ключ шифрования закрытых ключей
+	master *masterKey
+	// now — текущее время (подменяется в тестах)
+	now func() time.Time
+
+	mu sync.RWMutex
+	// ordered — ключи, позже активируемые первыми
+	ordered []*signingKey
+	loaded  map[string]*signingKey
+	// newestKeyAt — время создания новейшего ключа (для плановой ротации)
+	newestKeyAt time.Time
+
+	// Кэш собственного токена Admin Module
+	selfMu     sync.Mutex
+	selfToken  string
+	selfExpiry time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+var (
+	_ idp.Provider    = (*Provider)(nil)
+	_ idp.UserManager = (*Provider)(nil)
+	_ idp.KeyManager  = (*Provider)(nil)
+)
+
+// New создаёт локальный IdP. Ключи подписи загружаются в Start.
+func New(
+	users repository.LocalUserRepository,
+	clients repository.LocalClientRepository,
+	keys repository.SigningKeyRepository,
+	cfg Config,
+	logger *slog.Logger,
+) (*Provider, error) {
+	if cfg.KeyAlgorithm != AlgorithmRS256 && cfg.KeyAlgorithm != AlgorithmES256 {
+		return nil, fmt.Errorf("неподдерживаемый алгоритм ключей подписи %q", cfg.KeyAlgorithm)
+	}
+	master, err := newMasterKey(cfg.MasterKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		users:   users,
+		clients: clients,
+		keys:    keys,
+		cfg:     cfg,
+		logger:  logger.With(slog.String("component", "local_idp")),
+		jwks:    jwkset.NewMemoryStorage(),
+		master:  master,
+		now:     time.Now,
+	}, nil
+}
+
+// Start загружает ключи подписи, создаёт первичного администратора
+// и запускает периодическое обслуживание ключей (ротация, удаление
+// заменённых, перечитывание).
+func (p *Provider) Start(ctx context.Context) error {
+	if err := p.loadKeys(ctx); err != nil {
+		return fmt.Errorf("загрузка ключей подписи: %w", err)
+	}
+	if err := p.bootstrapAdmin(ctx); err != nil {
+		return fmt.Errorf("создание первичного администратора: %w", err)
+	}
+
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+	go func() {
+		defer close(p.done)
+		ticker := time.NewTicker(keyReloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := p.maintainKeys(ctx); err != nil && ctx.Err() == nil {
+					p.logger.Warn("Ошибка обслуживания ключей подписи",
+						slog.String("error", err.Error()),
+					)
+				}
+			}
+		}
+	}()
+
+	p.logger.Info("Локальный IdP запущен",
+		slog.String("issuer", p.cfg.Issuer),
+		slog.String("key_algorithm", p.cfg.KeyAlgorithm),
+		slog.String("master_key_id", p.master.id),
+	)
+	return nil
+}
+
+// Stop останавливает обслуживание ключей.
+func (p *Provider) Stop() {
+	if p.cancel != nil {
+		p.cancel()
+		<-p.done
+	}
+}
+
+// JWKS возвращает хранилище открытых ключей (для JWT middleware).
+func (p *Provider) JWKS() jwkset.Storage {
+	return p.jwks
+}
+
+// Issuer возвращает issuer токенов.
+func (p *Provider) Issuer() string {
+	return p.cfg.Issuer
+}
+
+// bootstrapAdmin создаёт первичного администратора, если пользователей нет.
+func (p *Provider) bootstrapAdmin(ctx context.Context) error {
+	count, err := p.users.Count(ctx)
+	if err != nil || count > 0 {
+		return err
+	}
+	if p.cfg.BootstrapPassword == "" {
+		p.logger.Warn("Пользователей локального IdP нет, а пароль первичного администратора не задан: вход в Admin UI невозможен")
+		return nil
+	}
+
+	user, err := p.CreateUser(ctx, idp.UserAccount{
+		Username: p.cfg.BootstrapUsername,
+		Enabled:  true,
+		Groups:   p.cfg.BootstrapGroups,
+	}, p.cfg.BootstrapPassword)
+	if errors.Is(err, idp.ErrConflict) {
+		// Создан другой репликой
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	p.logger.Info("Создан первичный администратор локального IdP",
+		slog.String("username", user.Username),
+	)
+	return nil
+}
+
+// --- idp.Provider ---
+
+// Name возвращает имя провайдера.
+func (p *Provider) Name() string {
+	return idp.ProviderLocal
+}
+
+// Ping проверяет доступность хранилища пользователей.
+func (p *Provider) Ping(ctx context.Context) error {
+	_, err := p.users.Count(ctx)
+	return err
+}
+
+// CheckReady проверяет готовность локального IdP.
+func (p *Provider) CheckReady() (status, message string) {
+	ctx, cancel := context.WithTimeout(context.Background(), readinessTimeout)
+	defer cancel()
+
+	if _, err := p.currentKey(); err != nil {
+		return "fail", err.Error()
+	}
+	if err := p.Ping(ctx); err != nil {
+		return "fail", fmt.Sprintf("Локальный IdP недоступен: %v", err)
+	}
+	return "ok", "Локальный IdP готов"
+}
+
+// TokenProvider возвращает функцию получения access token Admin Module.
+// Токен подписывается локально и кэшируется до последней трети срока действия.
+func (p *Provider) TokenProvider() func(ctx context.Context) (string, error) {
+	return func(_ context.Context) (string, error) {
+		p.selfMu.Lock()
+		defer p.selfMu.Unlock()
+
+		now := p.now()
+		if p.selfToken != "" && now.Add(p.cfg.AccessTokenTTL/3).Before(p.selfExpiry) {
+			return p.selfToken, nil
+		}
+
+		token, err := p.signClientToken(&model.LocalClient{
+			ID:       p.cfg.SelfClientID,
+			ClientID: p.cfg.SelfClientID,
+		}, p.cfg.SelfScopes)
+		if err != nil {
+			return "", err
+		}
+		p.selfToken = token.AccessToken
+		p.selfExpiry = now.Add(p.cfg.AccessTokenTTL)
+		return p.selfToken, nil
+	}
+}
+
+// ListUsers возвращает пользователей с поиском по username, email и имени.
+func (p *Provider) ListUsers(ctx context.Context, query string, first, maxResults int) ([]idp.User, error) {
+	users, err := p.users.List(ctx, query, maxResults, first)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]idp.User, 0, len(users))
+	for _, u := range users {
+		result = append(result, toIdPUser(u))
+	}
+	return result, nil
+}
+
+// CountUsers возвращает количество пользователей.
+func (p *Provider) CountUsers(ctx context.Context) (int, error) {
+	return p.users.Count(ctx)
+}
+
+// GetUser возвращает пользователя по ID.
+func (p *Provider) GetUser(ctx context.Context, id string) (*idp.User, error) {
+	u, err := p.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	user := toIdPUser(u)
+	return &user, nil
+}
+
+// GetUserGroups возвращает группы пользователя.
+func (p *Provider) GetUserGroups(ctx context.Context, userID string) ([]idp.Group, error) {
+	u, err := p.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]idp.Group, 0, len(u.Groups))
+	for _, g := range u.Groups {
+		groups = append(groups, idp.Group{ID: g, Name: g, Path: "/" + g})
+	}
+	return groups, nil
+}
+
+// ListClients возвращает клиентов с префиксом client_id.
+func (p *Provider) ListClients(ctx context.Context, prefix string, first, maxResults int) ([]idp.Client, error) {
+	clients, err := p.clients.List(ctx, prefix, maxResults, first)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]idp.Client, 0, len(clients))
+	for _, c := range clients {
+		result = append(result, toIdPClient(c))
+	}
+	return result, nil
+}
+
+// GetClient возвращает клиента по ID.
+func (p *Provider) GetClient(ctx context.Context, id string) (*idp.Client, error) {
+	c, err := p.getClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	client := toIdPClient(c)
+	return &client, nil
+}
+
+// CreateClient создаёт клиента и возвращает его ID и секрет.
+func (p *Provider) CreateClient(ctx context.Context, client *idp.Client) (id, secret string, err error) {
+	if client.ClientID == "" {
+		return "", "", fmt.Errorf("%w: пустой client_id", idp.ErrValidation)
+	}
+	secret, err = generateSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	c := &model.LocalClient{
+		ID:          uuid.New().String(),
+		ClientID:    client.ClientID,
+		Name:        client.Name,
+		Description: client.Description,
+		Enabled:     client.Enabled,
+		Scopes:      client.Scopes,
+		SecretHash:  hashSecret(secret),
+	}
+	if err := p.clients.Create(ctx, c); err != nil {
+		return "", "", mapRepoError(err)
+	}
+	return c.ID, secret, nil
+}
+
+// UpdateClient обновляет имя, описание, scopes и состояние клиента.
+func (p *Provider) UpdateClient(ctx context.Context, client *idp.Client) error {
+	c, err := p.getClient(ctx, client.ID)
+	if err != nil {
+		return err
+	}
+	c.Name = client.Name
+	c.Description = client.Description
+	c.Enabled = client.Enabled
+	c.Scopes = client.Scopes
+	return mapRepoError(p.clients.Update(ctx, c))
+}
+
+// DeleteClient удаляет клиента.
+func (p *Provider) DeleteClient(ctx context.Context, id string) error {
+	return mapRepoError(p.clients.Delete(ctx, id))
+}
+
+// RotateClientSecret выпускает новый секрет; старый действует до keepPreviousUntil.
+func (p *Provider) RotateClientSecret(
+	ctx context.Context, id string, keepPreviousUntil *time.Time,
+) (secret string, previousExpiresAt *time.Time, err error) {
+	c, err := p.getClient(ctx, id)
+	if err != nil {
+		return "", nil, err
+	}
+	secret, err = generateSecret()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var previousHash []byte
+	if keepPreviousUntil != nil {
+		previousHash = c.SecretHash
+		until := *keepPreviousUntil
+		previousExpiresAt = &until
+	}
+	if err := p.clients.SetSecret(ctx, id, hashSecret(secret), previousHash, previousExpiresAt); err != nil {
+		return "", nil, mapRepoError(err)
+	}
+	return secret, previousExpiresAt, nil
+}
+
+// SetClientClaim задаёт claim access token клиента (пустой value — удалить).
+func (p *Provider) SetClientClaim(ctx context.Context, id, name, value string) error {
+	var raw json.RawMessage
+	if value != "" {
+		if !json.Valid([]byte(value)) {
+			return fmt.Errorf("%w: значение claim %s не является JSON", idp.ErrValidation, name)
+		}
+		raw = json.RawMessage(value)
+	}
+	return mapRepoError(p.clients.SetClaim(ctx, id, name, raw))
+}
+
+// --- idp.UserManager ---
+
+// CreateUser создаёт пользователя с паролем.
+func (p *Provider) CreateUser(ctx context.Context, account idp.UserAccount, password string) (*idp.User, error) {
+	if !usernamePattern.MatchString(account.Username) {
+		return nil, fmt.Errorf("%w: недопустимое имя пользователя", idp.ErrValidation)
+	}
+	hash, err := hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &model.LocalUser{
+		ID:           uuid.New().String(),
+		Username:     account.Username,
+		Email:        account.Email,
+		FirstName:    account.FirstName,
+		LastName:     account.LastName,
+		PasswordHash: hash,
+		Enabled:      account.Enabled,
+		Groups:       normalizeGroups(account.Groups),
+	}
+	if err := p.users.Create(ctx, u); err != nil {
+		return nil, mapRepoError(err)
+	}
+	user := toIdPUser(u)
+	return &user, nil
+}
+
+// UpdateUser обновляет email, имя, группы и состояние пользователя.
+func (p *Provider) UpdateUser(ctx context.Context, id string, account idp.UserAccount) (*idp.User, error) {
+	u, err := p.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.Email = account.Email
+	u.FirstName = account.FirstName
+	u.LastName = account.LastName
+	u.Enabled = account.Enabled
+	u.Groups = normalizeGroups(account.Groups)
+	if err := p.users.Update(ctx, u); err != nil {
+		return nil, mapRepoError(err)
+	}
+	user := toIdPUser(u)
+	return &user, nil
+}
+
+// DeleteUser удаляет пользователя.
+func (p *Provider) DeleteUser(ctx context.Context, id string) error {
+	return mapRepoError(p.users.Delete(ctx, id))
+}
+
+// SetPassword устанавливает пароль и снимает блокировку входа.
+func (p *Provider) SetPassword(ctx context.Context, id, password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	return mapRepoError(p.users.SetPassword(ctx, id, hash))
+}
+
+// --- Вспомогательные функции ---
+
+// getUser возвращает пользователя из БД (idp.ErrNotFound, если нет).
+func (p *Provider) getUser(ctx context.Context, id string) (*model.LocalUser, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, idp.ErrNotFound
+	}
+	u, err := p.users.GetByID(ctx, id)
+	if err != nil {
+		return nil, mapRepoError(err)
+	}
+	return u, nil
+}
+
+// getClient возвращает клиента из БД (idp.ErrNotFound, если нет).
+func (p *Provider) getClient(ctx context.Context, id string) (*model.LocalClient, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, idp.ErrNotFound
+	}
+	c, err := p.clients.GetByID(ctx, id)
+	if err != nil {
+		return nil, mapRepoError(err)
+	}
+	return c, nil
+}
+
+// hashPassword проверяет требования к паролю и возвращает bcrypt-хеш.
+func hashPassword(password string) (string, error) {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return "", fmt.Errorf("%w: длина пароля должна быть от %d до %d байт",
+			idp.ErrValidation, minPasswordLength, maxPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("хеширование пароля: %w", err)
+	}
+	return string(hash), nil
+}
+
+// generateSecret создаёт случайный секрет клиента.
+func generateSecret() (string, error) {
+	b := make([]byte, clientSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("генерация секрета: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashSecret возвращает SHA-256 секрета клиента. Секреты случайные
+// (256 бит), поэтому медленное хеширование не требуется.
+func hashSecret(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+// secretMatches проверяет секрет клиента: текущий или предыдущий в период ротации.
+func secretMatches(c *model.LocalClient, secret string, now time.Time) bool {
+	hash := hashSecret(secret)
+	if subtle.ConstantTimeCompare(hash, c.SecretHash) == 1 {
+		return true
+	}
+	return c.PreviousSecretHash != nil && c.PreviousSecretExpiresAt != nil &&
+		now.Before(*c.PreviousSecretExpiresAt) &&
+		subtle.ConstantTimeCompare(hash, c.PreviousSecretHash) == 1
+}
+
+// normalizeGroups удаляет пустые и повторяющиеся группы.
+func normalizeGroups(groups []string) []string {
+	result := make([]string, 0, len(groups))
+	for _, g := range groups {
+		if g != "" && !slices.Contains(result, g) {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// mapRepoError преобразует ошибки репозитория в ошибки idp.
+func mapRepoError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, repository.ErrNotFound):
+		return idp.ErrNotFound
+	case errors.Is(err, repository.ErrConflict):
+		return idp.ErrConflict
+	default:
+		return err
+	}
+}
+
+// toIdPUser преобразует пользователя БД в idp.User.
+func toIdPUser(u *model.LocalUser) idp.User {
+	return idp.User{
+		ID:        u.ID,
+		Username:  u.Username,
+		Email:     u.Email,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Enabled:   u.Enabled,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+// toIdPClient преобразует клиента БД в idp.Client.
+func toIdPClient(c *model.LocalClient) idp.Client {
+	return idp.Client{
+		ID:          c.ID,
+		ClientID:    c.ClientID,
+		Name:        c.Name,
+		Description: c.Description,
+		Enabled:     c.Enabled,
+		Scopes:      c.Scopes,
+	}
+}
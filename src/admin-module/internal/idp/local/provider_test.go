@@ -0,0 +1,582 @@
+// provider_test.go — unit-тесты локального IdP: блокировка входа,
+// Client Credentials с ротацией секрета, refresh token и token endpoint.
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+const (
+	testIssuer   = "http://admin-module:8000"
+	testPassword = "correct-horse-battery"
+)
+
+// testClock — управляемое время для provider и fake-репозиториев.
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
+
+// fakeUsers — LocalUserRepository в памяти.
+type fakeUsers struct {
+	clock *testClock
+	users map[string]*model.LocalUser
+}
+
+func (f *fakeUsers) Create(_ context.Context, u *model.LocalUser) error {
+	for _, existing := range f.users {
+		if strings.EqualFold(existing.Username, u.Username) {
+			return repository.ErrConflict
+		}
+	}
+	u.Username = strings.ToLower(u.Username)
+	u.CreatedAt = f.clock.Now()
+	saved := *u
+	f.users[u.ID] = &saved
+	return nil
+}
+
+func (f *fakeUsers) GetByID(_ context.Context, id string) (*model.LocalUser, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (f *fakeUsers) GetByUsername(ctx context.Context, username string) (*model.LocalUser, error) {
+	for id, u := range f.users {
+		if strings.EqualFold(u.Username, username) {
+			return f.GetByID(ctx, id)
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (f *fakeUsers) List(_ context.Context, _ string, _, _ int) ([]*model.LocalUser, error) {
+	result := make([]*model.LocalUser, 0, len(f.users))
+	for _, u := range f.users {
+		result = append(result, u)
+	}
+	return result, nil
+}
+
+func (f *fakeUsers) Count(_ context.Context) (int, error) {
+	return len(f.users), nil
+}
+
+func (f *fakeUsers) Update(_ context.Context, u *model.LocalUser) error {
+	saved, ok := f.users[u.ID]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	saved.Email, saved.FirstName, saved.LastName = u.Email, u.FirstName, u.LastName
+	saved.Enabled, saved.Groups = u.Enabled, u.Groups
+	return nil
+}
+
+func (f *fakeUsers) SetPassword(_ context.Context, id, passwordHash string) error {
+	u, ok := f.users[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	u.PasswordHash, u.FailedLogins, u.LockedUntil = passwordHash, 0, nil
+	return nil
+}
+
+func (f *fakeUsers) RecordLoginFailure(_ context.Context, id string, maxFailures int, lockout time.Duration) (*time.Time, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	u.FailedLogins++
+	if u.FailedLogins >= maxFailures {
+		until := f.clock.Now().Add(lockout)
+		u.FailedLogins, u.LockedUntil = 0, &until
+	}
+	return u.LockedUntil, nil
+}
+
+func (f *fakeUsers) RecordLoginSuccess(_ context.Context, id string) error {
+	u := f.users[id]
+	now := f.clock.Now()
+	u.FailedLogins, u.LockedUntil, u.LastLoginAt = 0, nil, &now
+	return nil
+}
+
+func (f *fakeUsers) Delete(_ context.Context, id string) error {
+	if _, ok := f.users[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(f.users, id)
+	return nil
+}
+
+// fakeClients — LocalClientRepository в памяти.
+type fakeClients struct {
+	clients map[string]*model.LocalClient
+}
+
+func (f *fakeClients) Create(_ context.Context, c *model.LocalClient) error {
+	for _, existing := range f.clients {
+		if existing.ClientID == c.ClientID {
+			return repository.ErrConflict
+		}
+	}
+	saved := *c
+	f.clients[c.ID] = &saved
+	return nil
+}
+
+func (f *fakeClients) GetByID(_ context.Context, id string) (*model.LocalClient, error) {
+	c, ok := f.clients[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (f *fakeClients) GetByClientID(ctx context.Context, clientID string) (*model.LocalClient, error) {
+	for id, c := range f.clients {
+		if c.ClientID == clientID {
+			return f.GetByID(ctx, id)
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (f *fakeClients) List(_ context.Context, prefix string, _, _ int) ([]*model.LocalClient, error) {
+	var result []*model.LocalClient
+	for _, c := range f.clients {
+		if strings.HasPrefix(c.ClientID, prefix) {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeClients) Update(_ context.Context, c *model.LocalClient) error {
+	saved, ok := f.clients[c.ID]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	saved.Name, saved.Description, saved.Enabled, saved.Scopes = c.Name, c.Description, c.Enabled, c.Scopes
+	return nil
+}
+
+func (f *fakeClients) SetSecret(_ context.Context, id string, secretHash, previousHash []byte, previousExpiresAt *time.Time) error {
+	c, ok := f.clients[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	c.SecretHash, c.PreviousSecretHash, c.PreviousSecretExpiresAt = secretHash, previousHash, previousExpiresAt
+	return nil
+}
+
+func (f *fakeClients) SetClaim(_ context.Context, id, name string, value json.RawMessage) error {
+	c, ok := f.clients[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	if c.Claims == nil {
+		c.Claims = map[string]json.RawMessage{}
+	}
+	if value == nil {
+		delete(c.Claims, name)
+	} else {
+		c.Claims[name] = value
+	}
+	return nil
+}
+
+func (f *fakeClients) Delete(_ context.Context, id string) error {
+	if _, ok := f.clients[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(f.clients, id)
+	return nil
+}
+
+// fakeKeys — SigningKeyRepository в памяти.
+type fakeKeys struct {
+	clock *testClock
+	keys  []*model.SigningKey
+}
+
+func (f *fakeKeys) List(_ context.Context) ([]*model.SigningKey, error) {
+	result := slices.Clone(f.keys)
+	slices.SortStableFunc(result, func(a, b *model.SigningKey) int {
+		if c := b.ActivatesAt.Compare(a.ActivatesAt); c != 0 {
+			return c
+		}
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return result, nil
+}
+
+func (f *fakeKeys) Create(_ context.Context, k *model.SigningKey) error {
+	saved := *k
+	saved.CreatedAt = f.clock.Now()
+	f.keys = append(f.keys, &saved)
+	return nil
+}
+
+func (f *fakeKeys) CreateIfNoneSince(ctx context.Context, k *model.SigningKey, since time.Time) (bool, error) {
+	for _, existing := range f.keys {
+		if existing.CreatedAt.After(since) {
+			return false, nil
+		}
+	}
+	return true, f.Create(ctx, k)
+}
+
+func (f *fakeKeys) UpdatePrivateKey(_ context.Context, kid string, privateKey []byte, masterKeyID string) error {
+	for _, k := range f.keys {
+		if k.KID == kid {
+			k.PrivateKey, k.MasterKeyID = privateKey, masterKeyID
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (f *fakeKeys) DeleteRetired(_ context.Context, cutoff time.Time) (int, error) {
+	kept := f.keys[:0:0]
+	for _, k := range f.keys {
+		retired := slices.ContainsFunc(f.keys, func(n *model.SigningKey) bool {
+			return n.ActivatesAt.After(k.ActivatesAt) && !n.ActivatesAt.After(cutoff)
+		})
+		if !retired {
+			kept = append(kept, k)
+		}
+	}
+	deleted := len(f.keys) - len(kept)
+	f.keys = kept
+	return deleted, nil
+}
+
+// newTestProvider создаёт локальный IdP на fake-репозиториях с загруженным ключом.
+func newTestProvider(t *testing.T) (*Provider, *testClock) {
+	t.Helper()
+	return newTestProviderWith(t, func(*Config) {})
+}
+
+// newTestProviderWith создаёт локальный IdP с изменённой конфигурацией.
+func newTestProviderWith(t *testing.T, configure func(cfg *Config)) (*Provider, *testClock) {
+	t.Helper()
+	clock := &testClock{now: time.Now()}
+	cfg := Config{
+		Issuer:              testIssuer,
+		AccessTokenTTL:      5 * time.Minute,
+		RefreshTokenTTL:     time.Hour,
+		MaxFailedLogins:     5,
+		LockoutDuration:     15 * time.Minute,
+		SelfClientID:        "artstore-admin-module",
+		SelfScopes:          []string{"admin:read"},
+		UserScopes:          []string{"openid", "files:read"},
+		KeyAlgorithm:        AlgorithmRS256,
+		MasterKey:           "dGVzdC1tYXN0ZXIta2V5LTAxMjM0NTY3ODlhYmNkZWY=",
+		KeyRotationInterval: 30 * 24 * time.Hour,
+		KeyOverlap:          24 * time.Hour,
+		KeyPublishLead:      5 * time.Minute,
+	}
+	configure(&cfg)
+	p, err := New(
+		&fakeUsers{clock: clock, users: map[string]*model.LocalUser{}},
+		&fakeClients{clients: map[string]*model.LocalClient{}},
+		&fakeKeys{clock: clock},
+		cfg,
+		slog.New(slog.DiscardHandler),
+	)
+	if err != nil {
+		t.Fatalf("New() ошибка: %v", err)
+	}
+	p.now = clock.Now
+	if err := p.loadKeys(context.Background()); err != nil {
+		t.Fatalf("loadKeys() ошибка: %v", err)
+	}
+	return p, clock
+}
+
+// parseAccessToken проверяет access token по JWKS провайдера (RS256, ES256).
+func parseAccessToken(t *testing.T, p *Provider, token string, clock *testClock) (jwt.MapClaims, error) {
+	t.Helper()
+	kf, err := keyfunc.New(keyfunc.Options{Storage: p.JWKS()})
+	if err != nil {
+		t.Fatalf("keyfunc.New() ошибка: %v", err)
+	}
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, kf.Keyfunc,
+		jwt.WithValidMethods([]string{AlgorithmRS256, AlgorithmES256}),
+		jwt.WithIssuer(testIssuer),
+		jwt.WithTimeFunc(clock.Now),
+	)
+	return claims, err
+}
+
+// TestPasswordLogin_Lockout проверяет блокировку после 5 неудачных попыток.
+func TestPasswordLogin_Lockout(t *testing.T) {
+	p, clock := newTestProvider(t)
+	ctx := context.Background()
+
+	user, err := p.CreateUser(ctx, idp.UserAccount{
+		Username: "Alice", Enabled: true, Groups: []string{"artstore-admins", "", "artstore-admins"},
+	}, testPassword)
+	if err != nil {
+		t.Fatalf("CreateUser() ошибка: %v", err)
+	}
+	if user.Username != "alice" {
+		t.Errorf("Username = %q, ожидался alice", user.Username)
+	}
+
+	for i := 1; i < 5; i++ {
+		if _, err := p.PasswordLogin(ctx, "alice", "wrong-password"); !errors.Is(err, idp.ErrInvalidCredentials) {
+			t.Fatalf("попытка %d: ошибка = %v, ожидалась ErrInvalidCredentials", i, err)
+		}
+	}
+	if _, err := p.PasswordLogin(ctx, "alice", "wrong-password"); !errors.Is(err, idp.ErrLocked) {
+		t.Fatalf("5-я попытка: ошибка = %v, ожидалась ErrLocked", err)
+	}
+	if _, err := p.PasswordLogin(ctx, "alice", testPassword); !errors.Is(err, idp.ErrLocked) {
+		t.Fatalf("верный пароль при блокировке: ошибка = %v, ожидалась ErrLocked", err)
+	}
+
+	clock.Advance(15*time.Minute + time.Second)
+	tokens, err := p.PasswordLogin(ctx, "ALICE", testPassword)
+	if err != nil {
+		t.Fatalf("вход после блокировки: %v", err)
+	}
+	claims, err := parseAccessToken(t, p, tokens.AccessToken, clock)
+	if err != nil {
+		t.Fatalf("access token не прошёл проверку: %v", err)
+	}
+	if claims["preferred_username"] != "alice" {
+		t.Errorf("preferred_username = %v", claims["preferred_username"])
+	}
+	if groups, _ := claims["groups"].([]any); len(groups) != 1 || groups[0] != "artstore-admins" {
+		t.Errorf("groups = %v, ожидалась [artstore-admins]", claims["groups"])
+	}
+	if _, ok := claims["client_id"]; ok {
+		t.Error("токен пользователя не должен содержать client_id")
+	}
+
+	// SetPassword снимает блокировку
+	for range 5 {
+		_, _ = p.PasswordLogin(ctx, "alice", "wrong-password")
+	}
+	if err := p.SetPassword(ctx, user.ID, "new-password-1"); err != nil {
+		t.Fatalf("SetPassword() ошибка: %v", err)
+	}
+	if _, err := p.PasswordLogin(ctx, "alice", "new-password-1"); err != nil {
+		t.Errorf("вход после смены пароля: %v", err)
+	}
+
+	if err := p.SetPassword(ctx, user.ID, "short"); !errors.Is(err, idp.ErrValidation) {
+		t.Errorf("короткий пароль: ошибка = %v, ожидалась ErrValidation", err)
+	}
+	if _, err := p.PasswordLogin(ctx, "nobody", testPassword); !errors.Is(err, idp.ErrInvalidCredentials) {
+		t.Errorf("неизвестный пользователь: ошибка = %v, ожидалась ErrInvalidCredentials", err)
+	}
+}
+
+// TestRefresh проверяет обновление токенов и отказ принимать refresh token как access token.
+func TestRefresh(t *testing.T) {
+	p, clock := newTestProvider(t)
+	ctx := context.Background()
+
+	user, err := p.CreateUser(ctx, idp.UserAccount{Username: "bob", Enabled: true}, testPassword)
+	if err != nil {
+		t.Fatalf("CreateUser() ошибка: %v", err)
+	}
+	tokens, err := p.PasswordLogin(ctx, "bob", testPassword)
+	if err != nil {
+		t.Fatalf("PasswordLogin() ошибка: %v", err)
+	}
+
+	if _, err := parseAccessToken(t, p, tokens.RefreshToken, clock); err == nil {
+		t.Error("refresh token не должен проходить проверку access token")
+	}
+	if _, err := p.Refresh(ctx, tokens.AccessToken); !errors.Is(err, idp.ErrInvalidCredentials) {
+		t.Errorf("Refresh(access token): ошибка = %v, ожидалась ErrInvalidCredentials", err)
+	}
+
+	if _, err := p.Refresh(ctx, tokens.RefreshToken); err != nil {
+		t.Fatalf("Refresh() ошибка: %v", err)
+	}
+
+	if _, err := p.UpdateUser(ctx, user.ID, idp.UserAccount{Enabled: false}); err != nil {
+		t.Fatalf("UpdateUser() ошибка: %v", err)
+	}
+	if _, err := p.Refresh(ctx, tokens.RefreshToken); !errors.Is(err, idp.ErrInvalidCredentials) {
+		t.Errorf("Refresh() отключённого пользователя: ошибка = %v, ожидалась ErrInvalidCredentials", err)
+	}
+}
+
+// TestClientCredentials проверяет выдачу токенов SA, scopes, claims и ротацию секрета.
+func TestClientCredentials(t *testing.T) {
+	p, clock := newTestProvider(t)
+	ctx := context.Background()
+
+	id, secret, err := p.CreateClient(ctx, &idp.Client{
+		ClientID: "sa_backup_abc123", Enabled: true, Scopes: []string{"files:read", "files:write"},
+	})
+	if err != nil {
+		t.Fatalf("CreateClient() ошибка: %v", err)
+	}
+	if err := p.SetClientClaim(ctx, id, "artstore_grants", `[{"scope":"files:read","own":true}]`); err != nil {
+		t.Fatalf("SetClientClaim() ошибка: %v", err)
+	}
+
+	token, err := p.ClientCredentials(ctx, "sa_backup_abc123", secret, "files:read")
+	if err != nil {
+		t.Fatalf("ClientCredentials() ошибка: %v", err)
+	}
+	claims, err := parseAccessToken(t, p, token.AccessToken, clock)
+	if err != nil {
+		t.Fatalf("access token не прошёл проверку: %v", err)
+	}
+	if claims["client_id"] != "sa_backup_abc123" || claims["scope"] != "files:read" || claims["sub"] != id {
+		t.Errorf("claims = %v", claims)
+	}
+	if _, ok := claims["artstore_grants"].([]any); !ok {
+		t.Errorf("artstore_grants = %v, ожидался массив", claims["artstore_grants"])
+	}
+
+	if _, err := p.ClientCredentials(ctx, "sa_backup_abc123", secret, "admin:write"); !errors.Is(err, errInvalidScope) {
+		t.Errorf("недопустимый scope: ошибка = %v, ожидалась errInvalidScope", err)
+	}
+	if _, err := p.ClientCredentials(ctx, "sa_backup_abc123", "wrong", ""); !errors.Is(err, idp.ErrInvalidCredentials) {
+		t.Errorf("неверный секрет: ошибка = %v, ожидалась ErrInvalidCredentials", err)
+	}
+
+	// Ротация: старый секрет действует до keepPreviousUntil
+	until := clock.Now().Add(time.Hour)
+	newSecret, previousExpiresAt, err := p.RotateClientSecret(ctx, id, &until)
+	if err != nil || previousExpiresAt == nil {
+		t.Fatalf("RotateClientSecret() = %v, %v", previousExpiresAt, err)
+	}
+	for _, s := range []string{secret, newSecret} {
+		if _, err := p.ClientCredentials(ctx, "sa_backup_abc123", s, ""); err != nil {
+			t.Errorf("секрет в период ротации отклонён: %v", err)
+		}
+	}
+	clock.Advance(time.Hour + time.Second)
+	if _, err := p.ClientCredentials(ctx, "sa_backup_abc123", secret, ""); !errors.Is(err, idp.ErrInvalidCredentials) {
+		t.Errorf("старый секрет после периода ротации: ошибка = %v", err)
+	}
+
+	if err := p.UpdateClient(ctx, &idp.Client{ID: id, Enabled: false, Scopes: []string{"files:read"}}); err != nil {
+		t.Fatalf("UpdateClient() ошибка: %v", err)
+	}
+	if _, err := p.ClientCredentials(ctx, "sa_backup_abc123", newSecret, ""); !errors.Is(err, idp.ErrInvalidCredentials) {
+		t.Errorf("отключённый клиент: ошибка = %v, ожидалась ErrInvalidCredentials", err)
+	}
+
+	self, err := p.TokenProvider()(ctx)
+	if err != nil {
+		t.Fatalf("TokenProvider() ошибка: %v", err)
+	}
+	if claims, err := parseAccessToken(t, p, self, clock); err != nil || claims["client_id"] != "artstore-admin-module" {
+		t.Errorf("собственный токен: claims = %v, ошибка = %v", claims, err)
+	}
+}
+
+// TestHandleToken проверяет token endpoint: Basic-аутентификацию и коды ошибок RFC 6749.
+func TestHandleToken(t *testing.T) {
+	p, _ := newTestProvider(t)
+	_, secret, err := p.CreateClient(context.Background(), &idp.Client{
+		ClientID: "sa_etl_x1", Enabled: true, Scopes: []string{"files:read"},
+	})
+	if err != nil {
+		t.Fatalf("CreateClient() ошибка: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		form       url.Values
+		basic      []string
+		wantStatus int
+		wantError  string
+	}{
+		{"basic", url.Values{"grant_type": {"client_credentials"}}, []string{"sa_etl_x1", secret}, http.StatusOK, ""},
+		{"form", url.Values{"grant_type": {"client_credentials"}, "client_id": {"sa_etl_x1"}, "client_secret": {secret}}, nil, http.StatusOK, ""},
+		{"wrong secret", url.Values{"grant_type": {"client_credentials"}}, []string{"sa_etl_x1", "bad"}, http.StatusUnauthorized, "invalid_client"},
+		{"password grant", url.Values{"grant_type": {"password"}}, []string{"sa_etl_x1", secret}, http.StatusBadRequest, "unsupported_grant_type"},
+		{"bad scope", url.Values{"grant_type": {"client_credentials"}, "scope": {"admin:write"}}, []string{"sa_etl_x1", secret}, http.StatusBadRequest, "invalid_scope"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, TokenPath, strings.NewReader(tt.form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.basic != nil {
+				req.SetBasicAuth(tt.basic[0], tt.basic[1])
+			}
+			rec := httptest.NewRecorder()
+			p.HandleToken(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				body, _ := io.ReadAll(rec.Body)
+				t.Fatalf("статус = %d, ожидался %d (%s)", rec.Code, tt.wantStatus, body)
+			}
+			var resp map[string]any
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("ответ не JSON: %v", err)
+			}
+			if tt.wantError != "" {
+				if resp["error"] != tt.wantError {
+					t.Errorf("error = %v, ожидался %s", resp["error"], tt.wantError)
+				}
+				return
+			}
+			if resp["access_token"] == "" || resp["token_type"] != "Bearer" {
+				t.Errorf("ответ = %v", resp)
+			}
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	p.HandleJWKS(rec, httptest.NewRequest(http.MethodGet, JWKSPath, nil))
+	var jwks struct {
+		Keys []map[string]any `json:"keys"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&jwks); err != nil || len(jwks.Keys) != 1 {
+		t.Fatalf("JWKS = %+v, ошибка = %v", jwks, err)
+	}
+	if _, ok := jwks.Keys[0]["d"]; ok || jwks.Keys[0]["alg"] != "RS256" {
+		t.Errorf("JWKS должен содержать только открытый RS256-ключ: %v", jwks.Keys[0])
+	}
+}
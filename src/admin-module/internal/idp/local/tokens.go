@@ -0,0 +1,266 @@
+// tokens.go — выдача токенов локального IdP.
+// Access token подписываются RS256 или ES256 и проверяются по JWKS теми же
+// middleware, что и токены Keycloak: токены SA содержат client_id и scope,
+// токены пользователей — preferred_username, email и groups.
+// Refresh token пользователей Admin UI подписываются HS256 ключом,
+// производным от ключа подписи, и не принимаются как access token.
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+)
+
+// Значения claim typ.
+const (
+	tokenTypeBearer  = "Bearer"
+	tokenTypeRefresh = "Refresh"
+)
+
+// errInvalidScope — запрошен scope, не разрешённый клиенту.
+var errInvalidScope = errors.New("запрошенный scope не разрешён клиенту")
+
+// dummyPasswordHash — bcrypt-хеш для выравнивания времени ответа
+// при входе несуществующего пользователя.
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("artstore-dummy-password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// Token — access token клиента (Client Credentials grant).
+type Token struct {
+	AccessToken string //nolint:gosec // G117: структура токена OAuth2
+	TokenType   string
+	ExpiresIn   int
+	Scope       string
+}
+
+// UserTokens — токены пользователя Admin UI.
+type UserTokens struct {
+	AccessToken  string //nolint:gosec // G117: структура токена OAuth2
+	RefreshToken string //nolint:gosec // G117: структура токена OAuth2
+	TokenType    string
+	ExpiresIn    int
+}
+
+// ClientCredentials выдаёт access token клиенту по client_id и секрету.
+// scope — запрошенные scopes через пробел (пусто — все scopes клиента).
+func (p *Provider) ClientCredentials(ctx context.Context, clientID, secret, scope string) (*Token, error) {
+	c, err := p.clients.GetByClientID(ctx, clientID)
+	if err != nil {
+		if errors.Is(mapRepoError(err), idp.ErrNotFound) {
+			return nil, idp.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	if !secretMatches(c, secret, p.now()) || !c.Enabled {
+		return nil, idp.ErrInvalidCredentials
+	}
+
+	scopes := c.Scopes
+	if requested := strings.Fields(scope); len(requested) > 0 {
+		for _, s := range requested {
+			if !slices.Contains(c.Scopes, s) {
+				return nil, fmt.Errorf("%w: %s", errInvalidScope, s)
+			}
+		}
+		scopes = requested
+	}
+	return p.signClientToken(c, scopes)
+}
+
+// PasswordLogin проверяет имя пользователя и пароль и выдаёт токены Admin UI.
+// После MaxFailedLogins неудачных попыток подряд вход блокируется на
+// LockoutDuration (idp.ErrLocked); успешный вход сбрасывает счётчик.
+func (p *Provider) PasswordLogin(ctx context.Context, username, password string) (*UserTokens, error) {
+	u, err := p.users.GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(mapRepoError(err), idp.ErrNotFound) {
+			_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+			return nil, idp.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	now := p.now()
+	if u.LockedUntil != nil && now.Before(*u.LockedUntil) {
+		return nil, idp.ErrLocked
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) != nil {
+		lockedUntil, err := p.users.RecordLoginFailure(ctx, u.ID, p.cfg.MaxFailedLogins, p.cfg.LockoutDuration)
+		if err != nil {
+			return nil, err
+		}
+		if lockedUntil != nil && now.Before(*lockedUntil) {
+			p.logger.Warn("Вход пользователя заблокирован после неудачных попыток",
+				slog.String("username", u.Username),
+				slog.Time("locked_until", *lockedUntil),
+			)
+			return nil, idp.ErrLocked
+		}
+		return nil, idp.ErrInvalidCredentials
+	}
+	if !u.Enabled {
+		return nil, idp.ErrInvalidCredentials
+	}
+
+	if err := p.users.RecordLoginSuccess(ctx, u.ID); err != nil {
+		return nil, err
+	}
+	return p.issueUserTokens(u)
+}
+
+// Refresh выдаёт новые токены пользователя по refresh token. Данные
+// пользователя (группы, состояние) перечитываются из БД.
+func (p *Provider) Refresh(ctx context.Context, refreshToken string) (*UserTokens, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(refreshToken, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		k, ok := p.keyByID(kid)
+		if !ok {
+			return nil, fmt.Errorf("неизвестный ключ %q", kid)
+		}
+		return k.refreshSecret(), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(p.cfg.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(p.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", idp.ErrInvalidCredentials, err)
+	}
+	if typ, _ := claims["typ"].(string); typ != tokenTypeRefresh {
+		return nil, fmt.Errorf("%w: не refresh token", idp.ErrInvalidCredentials)
+	}
+
+	sub, _ := claims.GetSubject()
+	u, err := p.getUser(ctx, sub)
+	if err != nil {
+		if errors.Is(err, idp.ErrNotFound) {
+			return nil, idp.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	if !u.Enabled {
+		return nil, idp.ErrInvalidCredentials
+	}
+	return p.issueUserTokens(u)
+}
+
+// signClientToken подписывает access token клиента. Дополнительные claims
+// клиента (например, artstore_grants) добавляются в токен.
+func (p *Provider) signClientToken(c *model.LocalClient, scopes []string) (*Token, error) {
+	now := p.now()
+	claims := jwt.MapClaims{}
+	for name, raw := range c.Claims {
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("claim %s клиента %s: %w", name, c.ClientID, err)
+		}
+		claims[name] = value
+	}
+
+	scope := strings.Join(scopes, " ")
+	claims["iss"] = p.cfg.Issuer
+	claims["sub"] = c.ID
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(p.cfg.AccessTokenTTL).Unix()
+	claims["jti"] = uuid.New().String()
+	claims["typ"] = tokenTypeBearer
+	claims["azp"] = c.ClientID
+	claims["client_id"] = c.ClientID
+	claims["preferred_username"] = "service-account-" + c.ClientID
+	claims["scope"] = scope
+
+	signed, err := p.sign(claims)
+	if err != nil {
+		return nil, err
+	}
+	return &Token{
+		AccessToken: signed,
+		TokenType:   tokenTypeBearer,
+		ExpiresIn:   int(p.cfg.AccessTokenTTL.Seconds()),
+		Scope:       scope,
+	}, nil
+}
+
+// issueUserTokens выдаёт access и refresh token пользователя.
+func (p *Provider) issueUserTokens(u *model.LocalUser) (*UserTokens, error) {
+	now := p.now()
+	name := strings.TrimSpace(u.FirstName + " " + u.LastName)
+	access := jwt.MapClaims{
+		"iss":                p.cfg.Issuer,
+		"sub":                u.ID,
+		"iat":                now.Unix(),
+		"exp":                now.Add(p.cfg.AccessTokenTTL).Unix(),
+		"jti":                uuid.New().String(),
+		"typ":                tokenTypeBearer,
+		"azp":                p.cfg.SelfClientID,
+		"scope":              strings.Join(p.cfg.UserScopes, " "),
+		"preferred_username": u.Username,
+		"email":              u.Email,
+		"given_name":         u.FirstName,
+		"family_name":        u.LastName,
+		"name":               name,
+		"groups":             u.Groups,
+	}
+	accessToken, err := p.sign(access)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := p.currentKey()
+	if err != nil {
+		return nil, err
+	}
+	refresh := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": p.cfg.Issuer,
+		"sub": u.ID,
+		"iat": now.Unix(),
+		"exp": now.Add(p.cfg.RefreshTokenTTL).Unix(),
+		"jti": uuid.New().String(),
+		"typ": tokenTypeRefresh,
+	})
+	refresh.Header["kid"] = key.kid
+	refreshToken, err := refresh.SignedString(key.refreshSecret())
+	if err != nil {
+		return nil, fmt.Errorf("подпись refresh token: %w", err)
+	}
+
+	return &UserTokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    tokenTypeBearer,
+		ExpiresIn:    int(p.cfg.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+// sign подписывает claims текущим ключом (kid в заголовке).
+func (p *Provider) sign(claims jwt.MapClaims) (string, error) {
+	key, err := p.currentKey()
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.kid
+	signed, err := token.SignedString(key.private)
+	if err != nil {
+		return "", fmt.Errorf("подпись токена: %w", err)
+	}
+	return signed, nil
+}
@@ -2,23 +2,29 @@
 // Реализует автоматическое получение service account token через Client Credentials flow,
 // кэширование токена (обновление за 30s до expiration).
 // Операции: ListUsers, GetUser, GetUserGroups, ListClients, CreateClient,
-// UpdateClient, DeleteClient, GetClientSecret, RegenerateClientSecret, RealmInfo.
+// UpdateClient, DeleteClient, GetClientSecret, RegenerateClientSecret,
+// SetRotatedSecret, SetClientClaim, RealmInfo.
 package keycloak
 
 import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 )
 
+// ErrNotFound — Keycloak вернул 404 (пользователь или клиент не найден).
+var ErrNotFound = errors.New("ресурс Keycloak не найден")
+
 // Client — HTTP-клиент к Keycloak Admin REST API.
 type Client struct {
 	baseURL      string // Базовый URL Keycloak (без trailing slash)
@@ -172,6 +178,10 @@ func (c *Client) doAuthorized(ctx context.Context, method, path string, body any
 func decodeResponse(resp *http.Response, target any) error {
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%w: %s", ErrNotFound, string(body))
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("keycloak API вернул статус %d: %s", resp.StatusCode, string(body))
@@ -190,6 +200,10 @@ func decodeResponse(resp *http.Response, target any) error {
 func checkResponse(resp *http.Response, expectedStatus int) error {
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound && expectedStatus != http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%w: %s", ErrNotFound, string(body))
+	}
 	if resp.StatusCode != expectedStatus {
 		body, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("keycloak API вернул статус %d (ожидался %d): %s",
@@ -401,6 +415,105 @@ func (c *Client) RegenerateClientSecret(ctx context.Context, id string) (string,
 	return secret.Value, nil
 }
 
+// Атрибуты клиента Keycloak для предыдущего секрета после ротации.
+// Пока не наступило client.secret.rotated.expiration.time, Keycloak принимает
+// при аутентификации и текущий, и предыдущий секрет.
+const (
+	attrRotatedSecret        = "client.secret.rotated"
+	attrRotatedSecretCreated = "client.secret.rotated.creation.time"
+	attrRotatedSecretExpiry  = "client.secret.rotated.expiration.time"
+)
+
+// SetRotatedSecret сохраняет предыдущий секрет клиента, который продолжает
+// действовать до expiresAt.
+func (c *Client) SetRotatedSecret(ctx context.Context, id, secret string, expiresAt time.Time) error {
+	client, err := c.GetClient(ctx, id)
+	if err != nil {
+		return fmt.Errorf("SetRotatedSecret: %w", err)
+	}
+
+	if client.Attributes == nil {
+		client.Attributes = make(map[string]string, 3)
+	}
+	client.Attributes[attrRotatedSecret] = secret
+	client.Attributes[attrRotatedSecretCreated] = strconv.FormatInt(time.Now().Unix(), 10)
+	client.Attributes[attrRotatedSecretExpiry] = strconv.FormatInt(expiresAt.Unix(), 10)
+
+	if err := c.UpdateClient(ctx, id, client); err != nil {
+		return fmt.Errorf("SetRotatedSecret: %w", err)
+	}
+	return nil
+}
+
+// hardcodedClaimMapper — тип protocol mapper Keycloak, добавляющего в токен
+// claim с фиксированным значением.
+const hardcodedClaimMapper = "oidc-hardcoded-claim-mapper"
+
+// SetClientClaim добавляет в access token клиента claim name с JSON-значением
+// value (protocol mapper типа hardcoded claim, имя mapper = имя claim).
+// Пустой value удаляет mapper. Новые значения попадают в токены,
+// выданные после вызова.
+func (c *Client) SetClientClaim(ctx context.Context, id, name, value string) error {
+	resp, err := c.doAuthorized(ctx, http.MethodGet, "/clients/"+id+"/protocol-mappers/models", nil)
+	if err != nil {
+		return fmt.Errorf("SetClientClaim: %w", err)
+	}
+
+	var mappers []ProtocolMapper
+	if err := decodeResponse(resp, &mappers); err != nil {
+		return fmt.Errorf("SetClientClaim: %w", err)
+	}
+
+	var existing *ProtocolMapper
+	for i := range mappers {
+		if mappers[i].Name == name {
+			existing = &mappers[i]
+			break
+		}
+	}
+
+	path := "/clients/" + id + "/protocol-mappers/models"
+	switch {
+	case value == "" && existing == nil:
+		return nil
+	case value == "":
+		resp, err = c.doAuthorized(ctx, http.MethodDelete, path+"/"+existing.ID, nil)
+		if err != nil {
+			return fmt.Errorf("SetClientClaim: %w", err)
+		}
+		return checkResponse(resp, http.StatusNoContent)
+	}
+
+	mapper := ProtocolMapper{
+		Name:           name,
+		Protocol:       "openid-connect",
+		ProtocolMapper: hardcodedClaimMapper,
+		Config: map[string]string{
+			"claim.name":           name,
+			"claim.value":          value,
+			"jsonType.label":       "JSON",
+			"access.token.claim":   "true",
+			"id.token.claim":       "false",
+			"userinfo.token.claim": "false",
+		},
+	}
+
+	if existing == nil {
+		resp, err = c.doAuthorized(ctx, http.MethodPost, path, mapper)
+		if err != nil {
+			return fmt.Errorf("SetClientClaim: %w", err)
+		}
+		return checkResponse(resp, http.StatusCreated)
+	}
+
+	mapper.ID = existing.ID
+	resp, err = c.doAuthorized(ctx, http.MethodPut, path+"/"+existing.ID, mapper)
+	if err != nil {
+		return fmt.Errorf("SetClientClaim: %w", err)
+	}
+	return checkResponse(resp, http.StatusNoContent)
+}
+
 // --- Realm API ---
 
 // RealmInfo возвращает информацию о realm.
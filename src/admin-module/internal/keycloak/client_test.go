@@ -425,6 +425,119 @@ func TestClient_RegenerateClientSecret(t *testing.T) {
 	}
 }
 
+// TestClient_SetRotatedSecret проверяет, что предыдущий секрет сохраняется
+// в атрибутах клиента без потери остальных атрибутов.
+func TestClient_SetRotatedSecret(t *testing.T) {
+	var updated KeycloakClient
+	_, client := setupMockKeycloak(t, nil,
+		func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasSuffix(r.URL.Path, "/clients/kc-id") {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			switch r.Method {
+			case http.MethodGet:
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(KeycloakClient{
+					ID: "kc-id", ClientID: "sa_test_1", Enabled: true,
+					Attributes: map[string]string{"managed_by": "admin-module"},
+				})
+			case http.MethodPut:
+				json.NewDecoder(r.Body).Decode(&updated)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		},
+	)
+
+	expiresAt := time.Unix(1_900_000_000, 0)
+	if err := client.SetRotatedSecret(context.Background(), "kc-id", "old-secret", expiresAt); err != nil {
+		t.Fatalf("Ошибка SetRotatedSecret: %v", err)
+	}
+	if updated.Attributes[attrRotatedSecret] != "old-secret" {
+		t.Errorf("%s = %q, ожидался old-secret", attrRotatedSecret, updated.Attributes[attrRotatedSecret])
+	}
+	if updated.Attributes[attrRotatedSecretExpiry] != "1900000000" {
+		t.Errorf("%s = %q, ожидался 1900000000", attrRotatedSecretExpiry, updated.Attributes[attrRotatedSecretExpiry])
+	}
+	if updated.Attributes["managed_by"] != "admin-module" || !updated.Enabled {
+		t.Errorf("остальные поля клиента потеряны: %+v", updated)
+	}
+}
+
+// TestClient_SetClientClaim проверяет создание, обновление и удаление claim.
+func TestClient_SetClientClaim(t *testing.T) {
+	var (
+		mappers []ProtocolMapper
+		calls   []string
+	)
+	_, client := setupMockKeycloak(t, nil,
+		func(w http.ResponseWriter, r *http.Request) {
+			if !strings.Contains(r.URL.Path, "/clients/kc-id/protocol-mappers/models") {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			calls = append(calls, r.Method)
+			switch r.Method {
+			case http.MethodGet:
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(mappers)
+			case http.MethodPost:
+				var m ProtocolMapper
+				json.NewDecoder(r.Body).Decode(&m)
+				m.ID = "mapper-1"
+				mappers = append(mappers, m)
+				w.WriteHeader(http.StatusCreated)
+			case http.MethodPut:
+				var m ProtocolMapper
+				json.NewDecoder(r.Body).Decode(&m)
+				if !strings.HasSuffix(r.URL.Path, "/mapper-1") || m.ID != "mapper-1" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				mappers[0] = m
+				w.WriteHeader(http.StatusNoContent)
+			case http.MethodDelete:
+				mappers = nil
+				w.WriteHeader(http.StatusNoContent)
+			}
+		},
+	)
+	ctx := context.Background()
+
+	if err := client.SetClientClaim(ctx, "kc-id", "artstore_grants", `[{"scope":"files:read"}]`); err != nil {
+		t.Fatalf("Ошибка создания claim: %v", err)
+	}
+	if len(mappers) != 1 || mappers[0].ProtocolMapper != hardcodedClaimMapper ||
+		mappers[0].Config["claim.value"] != `[{"scope":"files:read"}]` || mappers[0].Config["jsonType.label"] != "JSON" {
+		t.Fatalf("mapper создан некорректно: %+v", mappers)
+	}
+
+	if err := client.SetClientClaim(ctx, "kc-id", "artstore_grants", `[]`); err != nil {
+		t.Fatalf("Ошибка обновления claim: %v", err)
+	}
+	if len(mappers) != 1 || mappers[0].Config["claim.value"] != `[]` {
+		t.Fatalf("mapper не обновлён: %+v", mappers)
+	}
+
+	if err := client.SetClientClaim(ctx, "kc-id", "artstore_grants", ""); err != nil {
+		t.Fatalf("Ошибка удаления claim: %v", err)
+	}
+	if len(mappers) != 0 {
+		t.Fatalf("mapper не удалён: %+v", mappers)
+	}
+
+	// Удаление отсутствующего claim — без запросов на изменение
+	calls = nil
+	if err := client.SetClientClaim(ctx, "kc-id", "artstore_grants", ""); err != nil {
+		t.Fatalf("Ошибка удаления отсутствующего claim: %v", err)
+	}
+	if len(calls) != 1 || calls[0] != http.MethodGet {
+		t.Errorf("ожидался только GET, получено %v", calls)
+	}
+}
+
 // TestClient_RealmInfo проверяет RealmInfo.
 func TestClient_RealmInfo(t *testing.T) {
 	_, client := setupMockKeycloak(t, nil,
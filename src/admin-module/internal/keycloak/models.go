@@ -58,6 +58,15 @@ type KeycloakClientSecret struct { //nolint:revive // stuttering допусти
 	Value string `json:"value"`
 }
 
+// ProtocolMapper — protocol mapper клиента (добавляет claims в токены).
+type ProtocolMapper struct {
+	ID             string            `json:"id,omitempty"`
+	Name           string            `json:"name"`
+	Protocol       string            `json:"protocol"`
+	ProtocolMapper string            `json:"protocolMapper"`
+	Config         map[string]string `json:"config,omitempty"`
+}
+
 // RealmRepresentation — краткая информация о realm.
 type RealmRepresentation struct {
 	Realm   string `json:"realm"`
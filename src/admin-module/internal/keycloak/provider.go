@@ -0,0 +1,222 @@
+// provider.go — адаптер Keycloak Admin REST API к idp.Provider.
+// Управление пользователями (idp.UserManager) не поддерживается:
+// пользователи Keycloak управляются в самом Keycloak.
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+)
+
+// Provider — Keycloak как idp.Provider.
+type Provider struct {
+	client *Client
+	logger *slog.Logger
+}
+
+var _ idp.Provider = (*Provider)(nil)
+
+// NewProvider создаёт idp.Provider поверх Keycloak-клиента.
+func NewProvider(client *Client, logger *slog.Logger) *Provider {
+	return &Provider{
+		client: client,
+		logger: logger.With(slog.String("component", "keycloak_idp")),
+	}
+}
+
+// Name возвращает имя провайдера.
+func (p *Provider) Name() string {
+	return idp.ProviderKeycloak
+}
+
+// Ping проверяет доступность realm.
+func (p *Provider) Ping(ctx context.Context) error {
+	_, err := p.client.RealmInfo(ctx)
+	return err
+}
+
+// CheckReady проверяет доступность Keycloak через realm info.
+func (p *Provider) CheckReady() (status, message string) {
+	return p.client.CheckReady()
+}
+
+// TokenProvider возвращает функцию получения service account token Admin Module.
+func (p *Provider) TokenProvider() func(ctx context.Context) (string, error) {
+	return p.client.TokenProvider()
+}
+
+// ListUsers возвращает пользователей realm.
+func (p *Provider) ListUsers(ctx context.Context, query string, first, maxResults int) ([]idp.User, error) {
+	kcUsers, err := p.client.ListUsers(ctx, query, first, maxResults)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]idp.User, 0, len(kcUsers))
+	for i := range kcUsers {
+		users = append(users, userFromKC(&kcUsers[i]))
+	}
+	return users, nil
+}
+
+// CountUsers возвращает количество пользователей realm.
+func (p *Provider) CountUsers(ctx context.Context) (int, error) {
+	return p.client.CountUsers(ctx)
+}
+
+// GetUser возвращает пользователя realm по ID.
+func (p *Provider) GetUser(ctx context.Context, id string) (*idp.User, error) {
+	kcUser, err := p.client.GetUser(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	user := userFromKC(kcUser)
+	return &user, nil
+}
+
+// GetUserGroups возвращает группы пользователя.
+func (p *Provider) GetUserGroups(ctx context.Context, userID string) ([]idp.Group, error) {
+	kcGroups, err := p.client.GetUserGroups(ctx, userID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	groups := make([]idp.Group, 0, len(kcGroups))
+	for _, g := range kcGroups {
+		groups = append(groups, idp.Group{ID: g.ID, Name: g.Name, Path: g.Path})
+	}
+	return groups, nil
+}
+
+// ListClients возвращает клиентов realm с фильтром по префиксу clientId.
+func (p *Provider) ListClients(ctx context.Context, prefix string, first, maxResults int) ([]idp.Client, error) {
+	kcClients, err := p.client.ListClients(ctx, prefix, first, maxResults)
+	if err != nil {
+		return nil, err
+	}
+	clients := make([]idp.Client, 0, len(kcClients))
+	for i := range kcClients {
+		clients = append(clients, clientFromKC(&kcClients[i]))
+	}
+	return clients, nil
+}
+
+// GetClient возвращает клиента по Keycloak internal ID.
+func (p *Provider) GetClient(ctx context.Context, id string) (*idp.Client, error) {
+	kc, err := p.client.GetClient(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	client := clientFromKC(kc)
+	return &client, nil
+}
+
+// CreateClient создаёт клиента Client Credentials и возвращает его секрет.
+// Если секрет получить не удалось, клиент удаляется.
+func (p *Provider) CreateClient(ctx context.Context, client *idp.Client) (id, secret string, err error) {
+	id, err = p.client.CreateClient(ctx, client.ClientID, client.Name, client.Description, client.Scopes)
+	if err != nil {
+		return "", "", err
+	}
+
+	secret, err = p.client.GetClientSecret(ctx, id)
+	if err != nil {
+		_ = p.client.DeleteClient(ctx, id)
+		return "", "", fmt.Errorf("получение секрета клиента: %w", err)
+	}
+	return id, secret, nil
+}
+
+// UpdateClient обновляет клиента в Keycloak.
+func (p *Provider) UpdateClient(ctx context.Context, client *idp.Client) error {
+	kc := &KeycloakClient{
+		ID:                     client.ID,
+		ClientID:               client.ClientID,
+		Name:                   client.Name,
+		Description:            client.Description,
+		Enabled:                client.Enabled,
+		ServiceAccountsEnabled: true,
+		DefaultClientScopes:    client.Scopes,
+	}
+	return mapError(p.client.UpdateClient(ctx, client.ID, kc))
+}
+
+// DeleteClient удаляет клиента в Keycloak.
+func (p *Provider) DeleteClient(ctx context.Context, id string) error {
+	return mapError(p.client.DeleteClient(ctx, id))
+}
+
+// RotateClientSecret регенерирует секрет клиента. Старый секрет сохраняется
+// в атрибутах клиента (client.secret.rotated) до keepPreviousUntil; если
+// сохранить его не удалось, новый секрет всё равно действует, а старый —
+// нет (previousExpiresAt = nil).
+func (p *Provider) RotateClientSecret(
+	ctx context.Context, id string, keepPreviousUntil *time.Time,
+) (secret string, previousExpiresAt *time.Time, err error) {
+	var oldSecret string
+	if keepPreviousUntil != nil {
+		oldSecret, err = p.client.GetClientSecret(ctx, id)
+		if err != nil {
+			return "", nil, fmt.Errorf("получение текущего секрета: %w", mapError(err))
+		}
+	}
+
+	secret, err = p.client.RegenerateClientSecret(ctx, id)
+	if err != nil {
+		return "", nil, fmt.Errorf("регенерация секрета: %w", mapError(err))
+	}
+
+	if keepPreviousUntil != nil {
+		if err := p.client.SetRotatedSecret(ctx, id, oldSecret, *keepPreviousUntil); err != nil {
+			p.logger.Warn("Не удалось сохранить старый секрет клиента на период ротации",
+				slog.String("id", id),
+				slog.String("error", err.Error()),
+			)
+		} else {
+			until := *keepPreviousUntil
+			previousExpiresAt = &until
+		}
+	}
+	return secret, previousExpiresAt, nil
+}
+
+// SetClientClaim задаёт claim access token клиента через protocol mapper.
+func (p *Provider) SetClientClaim(ctx context.Context, id, name, value string) error {
+	return mapError(p.client.SetClientClaim(ctx, id, name, value))
+}
+
+// mapError преобразует 404 Keycloak в idp.ErrNotFound.
+func mapError(err error) error {
+	if err != nil && errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("%w: %w", idp.ErrNotFound, err)
+	}
+	return err
+}
+
+// userFromKC преобразует пользователя Keycloak в idp.User.
+func userFromKC(u *KeycloakUser) idp.User {
+	return idp.User{
+		ID:        u.ID,
+		Username:  u.Username,
+		Email:     u.Email,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Enabled:   u.Enabled,
+		CreatedAt: u.CreatedAtTime(),
+	}
+}
+
+// clientFromKC преобразует клиента Keycloak в idp.Client.
+func clientFromKC(c *KeycloakClient) idp.Client {
+	return idp.Client{
+		ID:          c.ID,
+		ClientID:    c.ClientID,
+		Name:        c.Name,
+		Description: c.Description,
+		Enabled:     c.Enabled,
+		Scopes:      c.DefaultClientScopes,
+	}
+}
@@ -0,0 +1,102 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+)
+
+// TestProvider_GetUserNotFound проверяет преобразование 404 в idp.ErrNotFound.
+func TestProvider_GetUserNotFound(t *testing.T) {
+	_, client := setupMockKeycloak(t, nil, nil)
+	p := NewProvider(client, testLogger())
+
+	_, err := p.GetUser(context.Background(), "missing")
+	if !errors.Is(err, idp.ErrNotFound) {
+		t.Fatalf("ожидалась idp.ErrNotFound, получена: %v", err)
+	}
+}
+
+// TestProvider_CreateClient проверяет создание клиента с получением секрета.
+func TestProvider_CreateClient(t *testing.T) {
+	var created clientCreateRequest
+	_, client := setupMockKeycloak(t, nil,
+		func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/clients"):
+				json.NewDecoder(r.Body).Decode(&created)
+				w.Header().Set("Location", "http://kc/admin/realms/artstore/clients/kc-new")
+				w.WriteHeader(http.StatusCreated)
+			case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/clients/kc-new/client-secret"):
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(KeycloakClientSecret{Type: "secret", Value: "s3cret"})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		},
+	)
+	p := NewProvider(client, testLogger())
+
+	id, secret, err := p.CreateClient(context.Background(), &idp.Client{
+		ClientID: "sa_ingest_1",
+		Name:     "ingest",
+		Scopes:   []string{"files:write"},
+	})
+	if err != nil {
+		t.Fatalf("Ошибка CreateClient: %v", err)
+	}
+	if id != "kc-new" || secret != "s3cret" {
+		t.Errorf("id = %q, secret = %q", id, secret)
+	}
+	if created.ClientID != "sa_ingest_1" || len(created.DefaultClientScopes) != 1 {
+		t.Errorf("неверный запрос создания: %+v", created)
+	}
+}
+
+// TestProvider_RotateClientSecret проверяет ротацию с сохранением старого секрета.
+func TestProvider_RotateClientSecret(t *testing.T) {
+	var updated KeycloakClient
+	_, client := setupMockKeycloak(t, nil,
+		func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/clients/kc-id/client-secret"):
+				value := "old-secret"
+				if r.Method == http.MethodPost {
+					value = "new-secret"
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(KeycloakClientSecret{Type: "secret", Value: value})
+			case strings.HasSuffix(r.URL.Path, "/clients/kc-id") && r.Method == http.MethodGet:
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(KeycloakClient{ID: "kc-id", ClientID: "sa_test_1", Enabled: true})
+			case strings.HasSuffix(r.URL.Path, "/clients/kc-id") && r.Method == http.MethodPut:
+				json.NewDecoder(r.Body).Decode(&updated)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		},
+	)
+	p := NewProvider(client, testLogger())
+
+	until := time.Now().Add(time.Hour).Truncate(time.Second)
+	secret, prevExpires, err := p.RotateClientSecret(context.Background(), "kc-id", &until)
+	if err != nil {
+		t.Fatalf("Ошибка RotateClientSecret: %v", err)
+	}
+	if secret != "new-secret" {
+		t.Errorf("secret = %q, ожидался new-secret", secret)
+	}
+	if prevExpires == nil || !prevExpires.Equal(until) {
+		t.Errorf("previousExpiresAt = %v, ожидался %v", prevExpires, until)
+	}
+	if updated.Attributes[attrRotatedSecret] != "old-secret" {
+		t.Errorf("старый секрет не сохранён: %+v", updated.Attributes)
+	}
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// AlertRuleRepository — интерфейс для таблиц alert_rules и alert_rule_endpoints.
+type AlertRuleRepository interface {
+	// Create создаёт правило (без получателей — см. SetEndpoints).
+	Create(ctx context.Context, rule *model.AlertRule) error
+	// GetByID возвращает правило по UUID.
+	GetByID(ctx context.Context, id string) (*model.AlertRule, error)
+	// List возвращает все правила, отсортированные по имени.
+	List(ctx context.Context) ([]*model.AlertRule, error)
+	// Update обновляет правило (без получателей — см. SetEndpoints).
+	Update(ctx context.Context, rule *model.AlertRule) error
+	// Delete удаляет правило вместе с его оповещениями.
+	Delete(ctx context.Context, id string) error
+	// SetEndpoints заменяет получателей правила.
+	SetEndpoints(ctx context.Context, ruleID string, endpointIDs []string) error
+}
+
+// alertRuleColumns — список колонок alert_rules в порядке scanAlertRule.
+const alertRuleColumns = `id, name, type, threshold, storage_element_id, enabled, created_at, updated_at,
+	COALESCE((SELECT array_agg(e.endpoint_id::text ORDER BY e.endpoint_id)
+		FROM alert_rule_endpoints e WHERE e.rule_id = alert_rules.id), '{}')`
+
+// alertRuleRepo — реализация AlertRuleRepository.
+type alertRuleRepo struct {
+	db DBTX
+}
+
+// NewAlertRuleRepository создаёт репозиторий правил оповещений.
+func NewAlertRuleRepository(db DBTX) AlertRuleRepository {
+	return &alertRuleRepo{db: db}
+}
+
+// scanAlertRule сканирует строку alert_rules.
+func scanAlertRule(row pgx.Row) (*model.AlertRule, error) {
+	rule := &model.AlertRule{}
+	err := row.Scan(
+		&rule.ID, &rule.Name, &rule.Type, &rule.Threshold, &rule.StorageElementID,
+		&rule.Enabled, &rule.CreatedAt, &rule.UpdatedAt, &rule.EndpointIDs,
+	)
+	return rule, err
+}
+
+func (r *alertRuleRepo) Create(ctx context.Context, rule *model.AlertRule) error {
+	query := `
+		INSERT INTO alert_rules (id, name, type, threshold, storage_element_id, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		rule.ID, rule.Name, rule.Type, rule.Threshold, rule.StorageElementID, rule.Enabled,
+	).Scan(&rule.CreatedAt, &rule.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: правило с таким именем уже существует", ErrConflict)
+		}
+		return fmt.Errorf("ошибка создания правила оповещения: %w", err)
+	}
+	return nil
+}
+
+func (r *alertRuleRepo) GetByID(ctx context.Context, id string) (*model.AlertRule, error) {
+	query := `SELECT ` + alertRuleColumns + ` FROM alert_rules WHERE id = $1`
+
+	rule, err := scanAlertRule(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения правила оповещения: %w", err)
+	}
+	return rule, nil
+}
+
+func (r *alertRuleRepo) List(ctx context.Context) ([]*model.AlertRule, error) {
+	query := `SELECT ` + alertRuleColumns + ` FROM alert_rules ORDER BY name`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения списка правил оповещений: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.AlertRule
+	for rows.Next() {
+		rule, err := scanAlertRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования правила оповещения: %w", err)
+		}
+		result = append(result, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка итерации правил оповещений: %w", err)
+	}
+	return result, nil
+}
+
+func (r *alertRuleRepo) Update(ctx context.Context, rule *model.AlertRule) error {
+	query := `
+		UPDATE alert_rules
+		SET name = $2, type = $3, threshold = $4, storage_element_id = $5, enabled = $6
+		WHERE id = $1
+		RETURNING updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		rule.ID, rule.Name, rule.Type, rule.Threshold, rule.StorageElementID, rule.Enabled,
+	).Scan(&rule.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: правило с таким именем уже существует", ErrConflict)
+		}
+		return fmt.Errorf("ошибка обновления правила оповещения: %w", err)
+	}
+	return nil
+}
+
+func (r *alertRuleRepo) Delete(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM alert_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления правила оповещения: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *alertRuleRepo) SetEndpoints(ctx context.Context, ruleID string, endpointIDs []string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM alert_rule_endpoints WHERE rule_id = $1`, ruleID); err != nil {
+		return fmt.Errorf("ошибка удаления получателей правила: %w", err)
+	}
+	if len(endpointIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO alert_rule_endpoints (rule_id, endpoint_id)
+		SELECT $1, e FROM unnest($2::uuid[]) AS e
+		ON CONFLICT DO NOTHING`,
+		ruleID, endpointIDs,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения получателей правила: %w", err)
+	}
+	return nil
+}
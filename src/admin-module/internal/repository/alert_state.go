@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// AlertStateRepository — интерфейс для таблицы alert_states (сработавшие оповещения).
+type AlertStateRepository interface {
+	// List возвращает сработавшие оповещения с именем и типом правила (новые первыми).
+	List(ctx context.Context) ([]*model.Alert, error)
+	// Create сохраняет сработавшее оповещение.
+	Create(ctx context.Context, alert *model.Alert) error
+	// Delete удаляет оповещение правила по объекту.
+	Delete(ctx context.Context, ruleID, subject string) error
+	// DeleteByRule удаляет все оповещения правила.
+	DeleteByRule(ctx context.Context, ruleID string) error
+}
+
+// alertStateRepo — реализация AlertStateRepository.
+type alertStateRepo struct {
+	db DBTX
+}
+
+// NewAlertStateRepository создаёт репозиторий сработавших оповещений.
+func NewAlertStateRepository(db DBTX) AlertStateRepository {
+	return &alertStateRepo{db: db}
+}
+
+func (r *alertStateRepo) List(ctx context.Context) ([]*model.Alert, error) {
+	query := `
+		SELECT s.rule_id, r.name, r.type, s.subject, s.subject_name, s.message, s.firing_since
+		FROM alert_states s
+		JOIN alert_rules r ON r.id = s.rule_id
+		ORDER BY s.firing_since DESC, r.name, s.subject`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения сработавших оповещений: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.Alert
+	for rows.Next() {
+		a := &model.Alert{}
+		if err := rows.Scan(
+			&a.RuleID, &a.RuleName, &a.RuleType, &a.Subject, &a.SubjectName, &a.Message, &a.FiringSince,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования оповещения: %w", err)
+		}
+		result = append(result, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка итерации оповещений: %w", err)
+	}
+	return result, nil
+}
+
+func (r *alertStateRepo) Create(ctx context.Context, alert *model.Alert) error {
+	query := `
+		INSERT INTO alert_states (rule_id, subject, subject_name, message, firing_since)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (rule_id, subject) DO NOTHING`
+
+	_, err := r.db.Exec(ctx, query,
+		alert.RuleID, alert.Subject, alert.SubjectName, alert.Message, alert.FiringSince,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения оповещения: %w", err)
+	}
+	return nil
+}
+
+func (r *alertStateRepo) Delete(ctx context.Context, ruleID, subject string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM alert_states WHERE rule_id = $1 AND subject = $2`, ruleID, subject)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления оповещения: %w", err)
+	}
+	return nil
+}
+
+func (r *alertStateRepo) DeleteByRule(ctx context.Context, ruleID string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM alert_states WHERE rule_id = $1`, ruleID)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления оповещений правила: %w", err)
+	}
+	return nil
+}
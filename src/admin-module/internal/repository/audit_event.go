@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// AuditEventRepository — интерфейс для таблицы audit_events.
+// Таблица append-only: изменение и удаление запрещены триггером.
+type AuditEventRepository interface {
+	// Insert добавляет событие в журнал. Заполняет ID и OccurredAt.
+	Insert(ctx context.Context, e *model.AuditEvent) error
+	// List возвращает события с фильтрацией и пагинацией (новые первыми).
+	List(ctx context.Context, filters AuditEventFilters, limit, offset int) ([]*model.AuditEvent, error)
+	// Count возвращает количество событий с фильтрацией.
+	Count(ctx context.Context, filters AuditEventFilters) (int, error)
+}
+
+// AuditEventFilters — фильтры для списка событий аудита.
+type AuditEventFilters struct {
+	// Actor — совпадение с actor_id или actor_name
+	Actor      *string
+	Action     *string
+	TargetType *string
+	TargetID   *string
+	From       *time.Time
+	To         *time.Time
+}
+
+// auditEventRepo — реализация AuditEventRepository.
+type auditEventRepo struct {
+	db DBTX
+}
+
+// NewAuditEventRepository создаёт репозиторий журнала аудита.
+func NewAuditEventRepository(db DBTX) AuditEventRepository {
+	return &auditEventRepo{db: db}
+}
+
+func (r *auditEventRepo) Insert(ctx context.Context, e *model.AuditEvent) error {
+	query := `
+		INSERT INTO audit_events (actor_id, actor_type, actor_name, action,
+			target_type, target_id, before, after, source_ip, request_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, occurred_at`
+
+	err := r.db.QueryRow(ctx, query,
+		e.ActorID, e.ActorType, e.ActorName, e.Action,
+		e.TargetType, e.TargetID, nullJSON(e.Before), nullJSON(e.After), e.SourceIP, e.RequestID,
+	).Scan(&e.ID, &e.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("ошибка записи события аудита: %w", err)
+	}
+	return nil
+}
+
+// buildAuditWhere формирует WHERE-условие для фильтров событий аудита.
+func buildAuditWhere(filters AuditEventFilters, startArg int) (whereClause string, args []any) {
+	var conditions []string
+	argNum := startArg
+
+	if filters.Actor != nil {
+		conditions = append(conditions, fmt.Sprintf("(actor_id = $%d OR actor_name = $%d)", argNum, argNum))
+		args = append(args, *filters.Actor)
+		argNum++
+	}
+	if filters.Action != nil {
+		conditions = append(conditions, fmt.Sprintf("action = $%d", argNum))
+		args = append(args, *filters.Action)
+		argNum++
+	}
+	if filters.TargetType != nil {
+		conditions = append(conditions, fmt.Sprintf("target_type = $%d", argNum))
+		args = append(args, *filters.TargetType)
+		argNum++
+	}
+	if filters.TargetID != nil {
+		conditions = append(conditions, fmt.Sprintf("target_id = $%d", argNum))
+		args = append(args, *filters.TargetID)
+		argNum++
+	}
+	if filters.From != nil {
+		conditions = append(conditions, fmt.Sprintf("occurred_at >= $%d", argNum))
+		args = append(args, *filters.From)
+		argNum++
+	}
+	if filters.To != nil {
+		conditions = append(conditions, fmt.Sprintf("occurred_at < $%d", argNum))
+		args = append(args, *filters.To)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	return where, args
+}
+
+func (r *auditEventRepo) List(ctx context.Context, filters AuditEventFilters, limit, offset int) ([]*model.AuditEvent, error) {
+	where, args := buildAuditWhere(filters, 1)
+	argNum := len(args) + 1
+
+	query := fmt.Sprintf(`
+		SELECT id, occurred_at, actor_id, actor_type, actor_name, action,
+			target_type, target_id, before, after, source_ip, request_id
+		FROM audit_events
+		%s
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`, where, argNum, argNum+1)
+
+	args = append(args, limit, offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения событий аудита: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.AuditEvent
+	for rows.Next() {
+		e := &model.AuditEvent{}
+		if err := rows.Scan(
+			&e.ID, &e.OccurredAt, &e.ActorID, &e.ActorType, &e.ActorName, &e.Action,
+			&e.TargetType, &e.TargetID, &e.Before, &e.After, &e.SourceIP, &e.RequestID,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования события аудита: %w", err)
+		}
+		result = append(result, e)
+	}
+	return result, rows.Err()
+}
+
+func (r *auditEventRepo) Count(ctx context.Context, filters AuditEventFilters) (int, error) {
+	where, args := buildAuditWhere(filters, 1)
+	query := "SELECT COUNT(*) FROM audit_events " + where
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("ошибка подсчёта событий аудита: %w", err)
+	}
+	return count, nil
+}
+
+// nullJSON возвращает nil для пустого JSON, чтобы в БД записывался NULL.
+func nullJSON(data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// CapacitySnapshotRepository — интерфейс для таблицы se_capacity_snapshots.
+type CapacitySnapshotRepository interface {
+	// Create сохраняет снимок ёмкости SE.
+	Create(ctx context.Context, snapshot *model.CapacitySnapshot) error
+	// ListSince возвращает снимки, сделанные начиная с since, по возрастанию времени.
+	// seID — фильтр по SE; пустая строка — снимки всех SE.
+	ListSince(ctx context.Context, seID string, since time.Time) ([]*model.CapacitySnapshot, error)
+	// DeleteBefore удаляет снимки старше before. Возвращает количество удалённых.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// capacitySnapshotRepo — реализация CapacitySnapshotRepository.
+type capacitySnapshotRepo struct {
+	db DBTX
+}
+
+// NewCapacitySnapshotRepository создаёт репозиторий снимков ёмкости SE.
+func NewCapacitySnapshotRepository(db DBTX) CapacitySnapshotRepository {
+	return &capacitySnapshotRepo{db: db}
+}
+
+func (r *capacitySnapshotRepo) Create(ctx context.Context, snapshot *model.CapacitySnapshot) error {
+	query := `
+		INSERT INTO se_capacity_snapshots
+			(storage_element_id, captured_at, capacity_bytes, used_bytes, available_bytes)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.db.Exec(ctx, query,
+		snapshot.StorageElementID, snapshot.CapturedAt,
+		snapshot.CapacityBytes, snapshot.UsedBytes, snapshot.AvailableBytes,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения снимка ёмкости SE: %w", err)
+	}
+	return nil
+}
+
+func (r *capacitySnapshotRepo) ListSince(
+	ctx context.Context,
+	seID string,
+	since time.Time,
+) ([]*model.CapacitySnapshot, error) {
+	query := `
+		SELECT storage_element_id, captured_at, capacity_bytes, used_bytes, available_bytes
+		FROM se_capacity_snapshots
+		WHERE captured_at >= $1 AND ($2 = '' OR storage_element_id::text = $2)
+		ORDER BY captured_at, id`
+
+	rows, err := r.db.Query(ctx, query, since, seID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения снимков ёмкости SE: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.CapacitySnapshot
+	for rows.Next() {
+		s := &model.CapacitySnapshot{}
+		if err := rows.Scan(
+			&s.StorageElementID, &s.CapturedAt, &s.CapacityBytes, &s.UsedBytes, &s.AvailableBytes,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования снимка ёмкости SE: %w", err)
+		}
+		result = append(result, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка итерации снимков ёмкости SE: %w", err)
+	}
+	return result, nil
+}
+
+func (r *capacitySnapshotRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM se_capacity_snapshots WHERE captured_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления старых снимков ёмкости SE: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
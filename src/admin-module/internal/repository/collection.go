@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// CollectionRepository — интерфейс CRUD для коллекций файлов и их участников
+// (таблицы collections, collection_members).
+type CollectionRepository interface {
+	// Create сохраняет новую коллекцию. Заполняет CreatedAt и UpdatedAt.
+	// ErrConflict — коллекция с таким именем уже существует.
+	Create(ctx context.Context, c *model.Collection) error
+	// GetByID возвращает коллекцию с заполненным использованием квот.
+	GetByID(ctx context.Context, id string) (*model.Collection, error)
+	// List возвращает коллекции, упорядоченные по имени. Если scope
+	// не nil — только коллекции, где субъект — участник с правом scope.Permission.
+	List(ctx context.Context, scope *model.CollectionScope) ([]*model.Collection, error)
+	// Update обновляет имя, описание и квоты коллекции. Заполняет UpdatedAt.
+	Update(ctx context.Context, c *model.Collection) error
+	// Delete удаляет коллекцию вместе с участниками.
+	Delete(ctx context.Context, id string) error
+
+	// ListMembers возвращает участников коллекции.
+	ListMembers(ctx context.Context, collectionID string) ([]*model.CollectionMember, error)
+	// UpsertMember добавляет участника или заменяет его права.
+	UpsertMember(ctx context.Context, m *model.CollectionMember) error
+	// DeleteMember удаляет участника коллекции.
+	DeleteMember(ctx context.Context, collectionID, subjectType, subjectID string) error
+	// MemberPermissions возвращает права субъекта в коллекции
+	// (пустой список — субъект не участник).
+	MemberPermissions(ctx context.Context, collectionID string, subject model.CollectionSubject) ([]string, error)
+	// ServiceAccountCollections возвращает коллекции, где Service Accounts —
+	// участники с правом permission: client_id → UUID коллекций по возрастанию.
+	ServiceAccountCollections(ctx context.Context, permission string) (map[string][]string, error)
+}
+
+// collectionRepo — реализация CollectionRepository.
+type collectionRepo struct {
+	db DBTX
+}
+
+// NewCollectionRepository создаёт репозиторий коллекций.
+func NewCollectionRepository(db DBTX) CollectionRepository {
+	return &collectionRepo{db: db}
+}
+
+// collectionSelect — выборка коллекций с использованием квот (активные
+// файлы реестра) и количеством участников, в порядке scanCollection.
+const collectionSelect = `
+	SELECT c.id, c.name, c.description, c.quota_bytes, c.quota_files,
+		c.created_by, c.created_at, c.updated_at,
+		COALESCE(u.used_bytes, 0), COALESCE(u.used_files, 0),
+		(SELECT COUNT(*) FROM collection_members m WHERE m.collection_id = c.id)
+	FROM collections c
+	LEFT JOIN LATERAL (
+		SELECT SUM(f.size) AS used_bytes, COUNT(*) AS used_files
+		FROM file_registry f
+		WHERE f.collection_id = c.id AND f.status = 'active'
+	) u ON TRUE`
+
+// scanCollection сканирует строку collectionSelect.
+func scanCollection(row pgx.Row) (*model.Collection, error) {
+	c := &model.Collection{}
+	err := row.Scan(
+		&c.ID, &c.Name, &c.Description, &c.QuotaBytes, &c.QuotaFiles,
+		&c.CreatedBy, &c.CreatedAt, &c.UpdatedAt,
+		&c.UsedBytes, &c.UsedFiles, &c.MemberCount,
+	)
+	return c, err
+}
+
+func (r *collectionRepo) Create(ctx context.Context, c *model.Collection) error {
+	query := `
+		INSERT INTO collections (id, name, description, quota_bytes, quota_files, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		c.ID, c.Name, c.Description, c.QuotaBytes, c.QuotaFiles, c.CreatedBy,
+	).Scan(&c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: коллекция с таким именем уже существует", ErrConflict)
+		}
+		return fmt.Errorf("ошибка создания коллекции: %w", err)
+	}
+	return nil
+}
+
+func (r *collectionRepo) GetByID(ctx context.Context, id string) (*model.Collection, error) {
+	c, err := scanCollection(r.db.QueryRow(ctx, collectionSelect+` WHERE c.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения коллекции: %w", err)
+	}
+	return c, nil
+}
+
+func (r *collectionRepo) List(ctx context.Context, scope *model.CollectionScope) ([]*model.Collection, error) {
+	query := collectionSelect
+	var args []any
+	if scope != nil {
+		query += `
+	WHERE EXISTS (
+		SELECT 1 FROM collection_members m
+		WHERE m.collection_id = c.id AND m.subject_type = $1 AND m.subject_id = $2
+			AND $3 = ANY(m.permissions)
+	)`
+		args = append(args, scope.Subject.Type, scope.Subject.ID, scope.Permission)
+	}
+	query += ` ORDER BY c.name`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения списка коллекций: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.Collection
+	for rows.Next() {
+		c, err := scanCollection(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования коллекции: %w", err)
+		}
+		result = append(result, c)
+	}
+	return result, rows.Err()
+}
+
+func (r *collectionRepo) Update(ctx context.Context, c *model.Collection) error {
+	query := `
+		UPDATE collections
+		SET name = $2, description = $3, quota_bytes = $4, quota_files = $5
+		WHERE id = $1
+		RETURNING created_by, created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		c.ID, c.Name, c.Description, c.QuotaBytes, c.QuotaFiles,
+	).Scan(&c.CreatedBy, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: коллекция с таким именем уже существует", ErrConflict)
+		}
+		return fmt.Errorf("ошибка обновления коллекции: %w", err)
+	}
+	return nil
+}
+
+func (r *collectionRepo) Delete(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM collections WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления коллекции: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *collectionRepo) ListMembers(ctx context.Context, collectionID string) ([]*model.CollectionMember, error) {
+	query := `
+		SELECT collection_id, subject_type, subject_id, permissions, added_by, created_at
+		FROM collection_members
+		WHERE collection_id = $1
+		ORDER BY subject_type DESC, subject_id`
+
+	rows, err := r.db.Query(ctx, query, collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения участников коллекции: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.CollectionMember
+	for rows.Next() {
+		m := &model.CollectionMember{}
+		if err := rows.Scan(
+			&m.CollectionID, &m.SubjectType, &m.SubjectID, &m.Permissions, &m.AddedBy, &m.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования участника коллекции: %w", err)
+		}
+		result = append(result, m)
+	}
+	return result, rows.Err()
+}
+
+func (r *collectionRepo) UpsertMember(ctx context.Context, m *model.CollectionMember) error {
+	query := `
+		INSERT INTO collection_members (collection_id, subject_type, subject_id, permissions, added_by)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (collection_id, subject_type, subject_id) DO UPDATE SET
+			permissions = EXCLUDED.permissions,
+			added_by = EXCLUDED.added_by
+		RETURNING created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		m.CollectionID, m.SubjectType, m.SubjectID, m.Permissions, m.AddedBy,
+	).Scan(&m.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения участника коллекции: %w", err)
+	}
+	return nil
+}
+
+func (r *collectionRepo) DeleteMember(ctx context.Context, collectionID, subjectType, subjectID string) error {
+	query := `
+		DELETE FROM collection_members
+		WHERE collection_id = $1 AND subject_type = $2 AND subject_id = $3`
+
+	tag, err := r.db.Exec(ctx, query, collectionID, subjectType, subjectID)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления участника коллекции: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *collectionRepo) MemberPermissions(ctx context.Context, collectionID string, subject model.CollectionSubject) ([]string, error) {
+	query := `
+		SELECT permissions FROM collection_members
+		WHERE collection_id = $1 AND subject_type = $2 AND subject_id = $3`
+
+	var perms []string
+	err := r.db.QueryRow(ctx, query, collectionID, subject.Type, subject.ID).Scan(&perms)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ошибка получения прав в коллекции: %w", err)
+	}
+	return perms, nil
+}
+
+func (r *collectionRepo) ServiceAccountCollections(ctx context.Context, permission string) (map[string][]string, error) {
+	query := `
+		SELECT subject_id, collection_id FROM collection_members
+		WHERE subject_type = $1 AND $2 = ANY(permissions)
+		ORDER BY subject_id, collection_id`
+
+	rows, err := r.db.Query(ctx, query, model.CollectionMemberServiceAccount, permission)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения коллекций SA: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string][]string)
+	for rows.Next() {
+		var clientID, collectionID string
+		if err := rows.Scan(&clientID, &collectionID); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования коллекции SA: %w", err)
+		}
+		result[clientID] = append(result[clientID], collectionID)
+	}
+	return result, rows.Err()
+}
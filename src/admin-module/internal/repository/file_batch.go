@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// FileBatchRepository — интерфейс для таблиц file_batch_operations и file_batch_results.
+type FileBatchRepository interface {
+	// Create сохраняет новую операцию. Заполняет CreatedAt.
+	Create(ctx context.Context, op *model.FileBatchOperation) error
+	// AddFiles фиксирует набор файлов операции: файлы реестра, подходящие
+	// под filters (не более limit, новые первыми). Возвращает количество файлов.
+	AddFiles(ctx context.Context, operationID string, filters FileListFilters, limit int) (int, error)
+	// AddMissing записывает результат failed с текстом reason для файлов из
+	// fileIDs, не попавших в набор операции (не найдены или недоступны).
+	AddMissing(ctx context.Context, operationID string, fileIDs []string, reason string) (int, error)
+	// Update сохраняет состояние, счётчики и времена выполнения операции.
+	Update(ctx context.Context, op *model.FileBatchOperation) error
+	// GetByID возвращает операцию по UUID.
+	GetByID(ctx context.Context, id string) (*model.FileBatchOperation, error)
+	// NextQueued возвращает до limit необработанных файлов операции в порядке набора.
+	NextQueued(ctx context.Context, operationID string, limit int) ([]string, error)
+	// SetResult сохраняет результат обработки файла.
+	SetResult(ctx context.Context, res *model.FileBatchResult) error
+	// CancelQueued помечает необработанные файлы операции как cancelled.
+	CancelQueued(ctx context.Context, operationID string) (int, error)
+	// ListResults возвращает результаты по файлам (status — необязательный фильтр).
+	ListResults(ctx context.Context, operationID string, status *string, limit, offset int) ([]*model.FileBatchResult, error)
+	// CountResults возвращает количество результатов по файлам.
+	CountResults(ctx context.Context, operationID string, status *string) (int, error)
+	// FailUnfinished переводит операции queued/running в failed с указанной ошибкой,
+	// необработанные файлы — в cancelled. Вызывается при старте.
+	FailUnfinished(ctx context.Context, reason string) (int, error)
+}
+
+// fileBatchRepo — реализация FileBatchRepository.
+type fileBatchRepo struct {
+	db DBTX
+}
+
+// NewFileBatchRepository создаёт репозиторий групповых операций над файлами.
+func NewFileBatchRepository(db DBTX) FileBatchRepository {
+	return &fileBatchRepo{db: db}
+}
+
+// fileBatchColumns — список колонок file_batch_operations в порядке scanFileBatch.
+const fileBatchColumns = `id, action, tags, target_status, status, requested_by,
+	total, processed, succeeded, skipped, failed,
+	error, created_at, started_at, completed_at, duration_ms`
+
+// scanFileBatch сканирует строку file_batch_operations.
+func scanFileBatch(row pgx.Row) (*model.FileBatchOperation, error) {
+	op := &model.FileBatchOperation{}
+	err := row.Scan(
+		&op.ID, &op.Action, &op.Tags, &op.TargetStatus, &op.Status, &op.RequestedBy,
+		&op.Total, &op.Processed, &op.Succeeded, &op.Skipped, &op.Failed,
+		&op.Error, &op.CreatedAt, &op.StartedAt, &op.CompletedAt, &op.DurationMs,
+	)
+	return op, err
+}
+
+func (r *fileBatchRepo) Create(ctx context.Context, op *model.FileBatchOperation) error {
+	query := `
+		INSERT INTO file_batch_operations (id, action, tags, target_status, status, requested_by, total)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at`
+
+	tags := op.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	err := r.db.QueryRow(ctx, query,
+		op.ID, op.Action, tags, op.TargetStatus, op.Status, op.RequestedBy, op.Total,
+	).Scan(&op.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка создания групповой операции: %w", err)
+	}
+	return nil
+}
+
+func (r *fileBatchRepo) AddFiles(ctx context.Context, operationID string, filters FileListFilters, limit int) (int, error) {
+	where, args := buildFileWhere(filters, 2)
+	argNum := len(args) + 2
+
+	query := fmt.Sprintf(`
+		INSERT INTO file_batch_results (operation_id, file_id, position)
+		SELECT $1, file_id, ROW_NUMBER() OVER (ORDER BY uploaded_at DESC, file_id)
+		FROM (
+			SELECT file_id, uploaded_at
+			FROM file_registry
+			%s
+			ORDER BY uploaded_at DESC, file_id
+			LIMIT $%d
+		) selected`, where, argNum)
+
+	args = append([]any{operationID}, args...)
+	args = append(args, limit)
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка фиксации файлов групповой операции: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *fileBatchRepo) AddMissing(ctx context.Context, operationID string, fileIDs []string, reason string) (int, error) {
+	query := `
+		INSERT INTO file_batch_results (operation_id, file_id, position, status, error, processed_at)
+		SELECT $1, e, 0, 'failed', $3, NOW()
+		FROM unnest($2::uuid[]) AS e
+		ON CONFLICT (operation_id, file_id) DO NOTHING`
+
+	tag, err := r.db.Exec(ctx, query, operationID, fileIDs, reason)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка записи отсутствующих файлов групповой операции: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *fileBatchRepo) Update(ctx context.Context, op *model.FileBatchOperation) error {
+	query := `
+		UPDATE file_batch_operations SET
+			status = $2, total = $3, processed = $4, succeeded = $5, skipped = $6,
+			failed = $7, error = $8, started_at = $9, completed_at = $10, duration_ms = $11
+		WHERE id = $1`
+
+	tag, err := r.db.Exec(ctx, query,
+		op.ID, op.Status, op.Total, op.Processed, op.Succeeded, op.Skipped,
+		op.Failed, op.Error, op.StartedAt, op.CompletedAt, op.DurationMs,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка обновления групповой операции: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *fileBatchRepo) GetByID(ctx context.Context, id string) (*model.FileBatchOperation, error) {
+	query := "SELECT " + fileBatchColumns + " FROM file_batch_operations WHERE id = $1"
+
+	op, err := scanFileBatch(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения групповой операции: %w", err)
+	}
+	return op, nil
+}
+
+func (r *fileBatchRepo) NextQueued(ctx context.Context, operationID string, limit int) ([]string, error) {
+	query := `
+		SELECT file_id
+		FROM file_batch_results
+		WHERE operation_id = $1 AND status = 'queued'
+		ORDER BY position
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, operationID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения необработанных файлов операции: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования файла операции: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *fileBatchRepo) SetResult(ctx context.Context, res *model.FileBatchResult) error {
+	if res.ProcessedAt == nil {
+		now := time.Now().UTC()
+		res.ProcessedAt = &now
+	}
+
+	query := `
+		UPDATE file_batch_results SET status = $3, error = $4, processed_at = $5
+		WHERE operation_id = $1 AND file_id = $2`
+
+	tag, err := r.db.Exec(ctx, query, res.OperationID, res.FileID, res.Status, res.Error, res.ProcessedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения результата по файлу: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *fileBatchRepo) CancelQueued(ctx context.Context, operationID string) (int, error) {
+	query := `
+		UPDATE file_batch_results SET status = 'cancelled', processed_at = NOW()
+		WHERE operation_id = $1 AND status = 'queued'`
+
+	tag, err := r.db.Exec(ctx, query, operationID)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка отмены необработанных файлов операции: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *fileBatchRepo) ListResults(ctx context.Context, operationID string, status *string, limit, offset int) ([]*model.FileBatchResult, error) {
+	query := `
+		SELECT operation_id, file_id, status, error, processed_at
+		FROM file_batch_results
+		WHERE operation_id = $1 AND ($2::text IS NULL OR status = $2)
+		ORDER BY position, file_id
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.Query(ctx, query, operationID, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения результатов групповой операции: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.FileBatchResult
+	for rows.Next() {
+		res := &model.FileBatchResult{}
+		if err := rows.Scan(&res.OperationID, &res.FileID, &res.Status, &res.Error, &res.ProcessedAt); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования результата групповой операции: %w", err)
+		}
+		result = append(result, res)
+	}
+	return result, rows.Err()
+}
+
+func (r *fileBatchRepo) CountResults(ctx context.Context, operationID string, status *string) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM file_batch_results
+		WHERE operation_id = $1 AND ($2::text IS NULL OR status = $2)`
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, operationID, status).Scan(&count); err != nil {
+		return 0, fmt.Errorf("ошибка подсчёта результатов групповой операции: %w", err)
+	}
+	return count, nil
+}
+
+func (r *fileBatchRepo) FailUnfinished(ctx context.Context, reason string) (int, error) {
+	query := `
+		UPDATE file_batch_operations SET
+			status = 'failed',
+			error = $1,
+			completed_at = NOW(),
+			duration_ms = CASE WHEN started_at IS NULL THEN NULL
+				ELSE (EXTRACT(EPOCH FROM NOW() - started_at) * 1000)::BIGINT END
+		WHERE status IN ('queued', 'running')
+		RETURNING id`
+
+	rows, err := r.db.Query(ctx, query, reason)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка завершения прерванных групповых операций: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return 0, fmt.Errorf("ошибка завершения прерванных групповых операций: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	_, err = r.db.Exec(ctx, `
+		UPDATE file_batch_results SET status = 'cancelled', processed_at = NOW()
+		WHERE operation_id = ANY($1::uuid[]) AND status = 'queued'`, ids)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка отмены файлов прерванных групповых операций: %w", err)
+	}
+	return len(ids), nil
+}
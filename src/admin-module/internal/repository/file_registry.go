@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
 )
 
@@ -25,18 +27,42 @@ type FileRegistryRepository interface {
 	Delete(ctx context.Context, fileID string) error
 	// BatchUpsert вставляет или обновляет массив файлов (для sync).
 	BatchUpsert(ctx context.Context, files []*model.FileRecord) (added, updated int, err error)
-	// MarkDeletedExcept помечает файлы SE как deleted, кроме указанных.
-	MarkDeletedExcept(ctx context.Context, seID string, existingIDs []string) (int, error)
+	// MarkDeletedUnseen помечает файлы SE как deleted, кроме найденных на SE
+	// задачей синхронизации runID (staging-таблица sync_seen_files).
+	MarkDeletedUnseen(ctx context.Context, seID, runID string) (int, error)
 	// Count возвращает количество файлов с фильтрацией.
 	Count(ctx context.Context, filters FileListFilters) (int, error)
 }
 
 // FileListFilters — фильтры для списка файлов.
 type FileListFilters struct {
+	// FileIDs — только файлы с указанными UUID (выбор для групповой операции)
+	FileIDs          []string
 	Status           *string
 	RetentionPolicy  *string
 	StorageElementID *string
 	UploadedBy       *string
+	// Filename — подстрока имени файла (без учёта регистра; использует
+	// trigram-индекс idx_file_registry_filename_trgm Query Module)
+	Filename *string
+	// ContentType — префикс MIME-типа: группа (image) или тип (image/png)
+	ContentType *string
+	// Tags — файл должен иметь все указанные теги
+	Tags []string
+	// MinSize, MaxSize — диапазон размера файла, байт
+	MinSize *int64
+	MaxSize *int64
+	// UploadedAfter, UploadedBefore — диапазон времени загрузки
+	UploadedAfter  *time.Time
+	UploadedBefore *time.Time
+	// Grants — ограничения files:read SA: видны только подходящие файлы.
+	Grants []grants.Grant
+	// GrantSubjects — идентификаторы SA для ограничения «только свои файлы».
+	GrantSubjects []string
+	// CollectionID — только файлы указанной коллекции
+	CollectionID *string
+	// Collections — ограничение по членству в коллекциях (nil — все коллекции)
+	Collections *model.CollectionScope
 }
 
 // fileRegistryRepo — реализация FileRegistryRepository.
@@ -53,14 +79,14 @@ func (r *fileRegistryRepo) Register(ctx context.Context, f *model.FileRecord) er
 	query := `
 		INSERT INTO file_registry (file_id, original_filename, content_type, size, checksum,
 			storage_element_id, uploaded_by, uploaded_at, description, tags, status,
-			retention_policy, ttl_days, expires_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			retention_policy, ttl_days, expires_at, collection_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		RETURNING created_at, updated_at`
 
 	err := r.db.QueryRow(ctx, query,
 		f.FileID, f.OriginalFilename, f.ContentType, f.Size, f.Checksum,
 		f.StorageElementID, f.UploadedBy, f.UploadedAt, f.Description, f.Tags,
-		f.Status, f.RetentionPolicy, f.TTLDays, f.ExpiresAt,
+		f.Status, f.RetentionPolicy, f.TTLDays, f.ExpiresAt, f.CollectionID,
 	).Scan(&f.CreatedAt, &f.UpdatedAt)
 	if err != nil {
 		if isUniqueViolation(err) {
@@ -75,7 +101,7 @@ func (r *fileRegistryRepo) GetByID(ctx context.Context, fileID string) (*model.F
 	query := `
 		SELECT file_id, original_filename, content_type, size, checksum,
 			storage_element_id, uploaded_by, uploaded_at, description, tags,
-			status, retention_policy, ttl_days, expires_at, created_at, updated_at
+			status, retention_policy, ttl_days, expires_at, collection_id, created_at, updated_at
 		FROM file_registry
 		WHERE file_id = $1`
 
@@ -83,7 +109,7 @@ func (r *fileRegistryRepo) GetByID(ctx context.Context, fileID string) (*model.F
 	err := r.db.QueryRow(ctx, query, fileID).Scan(
 		&f.FileID, &f.OriginalFilename, &f.ContentType, &f.Size, &f.Checksum,
 		&f.StorageElementID, &f.UploadedBy, &f.UploadedAt, &f.Description, &f.Tags,
-		&f.Status, &f.RetentionPolicy, &f.TTLDays, &f.ExpiresAt, &f.CreatedAt, &f.UpdatedAt,
+		&f.Status, &f.RetentionPolicy, &f.TTLDays, &f.ExpiresAt, &f.CollectionID, &f.CreatedAt, &f.UpdatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -95,10 +121,18 @@ func (r *fileRegistryRepo) GetByID(ctx context.Context, fileID string) (*model.F
 }
 
 // buildFileWhere строит WHERE-условие и аргументы для фильтрации файлов.
+//
+//nolint:cyclop // сложность обусловлена количеством фильтров
 func buildFileWhere(filters FileListFilters, startArg int) (whereClause string, args []any) {
 	var conditions []string
 	argNum := startArg
 
+	if filters.FileIDs != nil {
+		conditions = append(conditions, fmt.Sprintf("file_id = ANY($%d::uuid[])", argNum))
+		args = append(args, filters.FileIDs)
+		argNum++
+	}
+
 	if filters.Status != nil {
 		conditions = append(conditions, fmt.Sprintf("status = $%d", argNum))
 		args = append(args, *filters.Status)
@@ -117,6 +151,64 @@ func buildFileWhere(filters FileListFilters, startArg int) (whereClause string,
 	if filters.UploadedBy != nil {
 		conditions = append(conditions, fmt.Sprintf("uploaded_by = $%d", argNum))
 		args = append(args, *filters.UploadedBy)
+		argNum++
+	}
+	if filters.Filename != nil && *filters.Filename != "" {
+		conditions = append(conditions, fmt.Sprintf("original_filename ILIKE $%d", argNum))
+		args = append(args, "%"+escapeLike(*filters.Filename)+"%")
+		argNum++
+	}
+	if filters.ContentType != nil && *filters.ContentType != "" {
+		prefix := *filters.ContentType
+		if !strings.Contains(prefix, "/") {
+			prefix += "/"
+		}
+		conditions = append(conditions, fmt.Sprintf("content_type ILIKE $%d", argNum))
+		args = append(args, escapeLike(prefix)+"%")
+		argNum++
+	}
+	if len(filters.Tags) > 0 {
+		conditions = append(conditions, fmt.Sprintf("tags @> $%d", argNum))
+		args = append(args, filters.Tags)
+		argNum++
+	}
+	if filters.MinSize != nil {
+		conditions = append(conditions, fmt.Sprintf("size >= $%d", argNum))
+		args = append(args, *filters.MinSize)
+		argNum++
+	}
+	if filters.MaxSize != nil {
+		conditions = append(conditions, fmt.Sprintf("size <= $%d", argNum))
+		args = append(args, *filters.MaxSize)
+		argNum++
+	}
+	if filters.UploadedAfter != nil {
+		conditions = append(conditions, fmt.Sprintf("uploaded_at >= $%d", argNum))
+		args = append(args, *filters.UploadedAfter)
+		argNum++
+	}
+	if filters.UploadedBefore != nil {
+		conditions = append(conditions, fmt.Sprintf("uploaded_at <= $%d", argNum))
+		args = append(args, *filters.UploadedBefore)
+		argNum++
+	}
+	if filters.CollectionID != nil {
+		conditions = append(conditions, fmt.Sprintf("collection_id = $%d", argNum))
+		args = append(args, *filters.CollectionID)
+		argNum++
+	}
+	if cs := filters.Collections; cs != nil {
+		conditions = append(conditions, fmt.Sprintf(`(collection_id IS NULL OR collection_id IN (
+			SELECT m.collection_id FROM collection_members m
+			WHERE m.subject_type = $%d AND m.subject_id = $%d AND $%d = ANY(m.permissions)))`,
+			argNum, argNum+1, argNum+2))
+		args = append(args, cs.Subject.Type, cs.Subject.ID, cs.Permission)
+		argNum += 3
+	}
+	if len(filters.Grants) > 0 {
+		var cond string
+		cond, args = buildGrantsCondition(filters.Grants, filters.GrantSubjects, args, argNum)
+		conditions = append(conditions, cond)
 	}
 
 	where := ""
@@ -126,6 +218,40 @@ func buildFileWhere(filters FileListFilters, startArg int) (whereClause string,
 	return where, args
 }
 
+// escapeLike экранирует спецсимволы шаблона LIKE (\, %, _).
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// buildGrantsCondition формирует условие «подходит хотя бы один grant»:
+// grants объединяются через OR, ограничения внутри grant — через AND.
+func buildGrantsCondition(list []grants.Grant, subjects []string, args []any, argNum int) (string, []any) {
+	alternatives := make([]string, 0, len(list))
+	for _, g := range list {
+		var conds []string
+		if g.SE != nil {
+			conds = append(conds, fmt.Sprintf("storage_element_id::text = ANY($%d)", argNum))
+			args = append(args, g.SE)
+			argNum++
+		}
+		if len(g.Retention) > 0 {
+			conds = append(conds, fmt.Sprintf("retention_policy = ANY($%d)", argNum))
+			args = append(args, g.Retention)
+			argNum++
+		}
+		if g.Own {
+			conds = append(conds, fmt.Sprintf("uploaded_by = ANY($%d)", argNum))
+			args = append(args, subjects)
+			argNum++
+		}
+		if len(conds) == 0 {
+			conds = append(conds, "TRUE")
+		}
+		alternatives = append(alternatives, "("+strings.Join(conds, " AND ")+")")
+	}
+	return "(" + strings.Join(alternatives, " OR ") + ")", args
+}
+
 func (r *fileRegistryRepo) List(ctx context.Context, filters FileListFilters, limit, offset int) ([]*model.FileRecord, error) {
 	where, args := buildFileWhere(filters, 1)
 	argNum := len(args) + 1
@@ -133,7 +259,7 @@ func (r *fileRegistryRepo) List(ctx context.Context, filters FileListFilters, li
 	query := fmt.Sprintf(`
 		SELECT file_id, original_filename, content_type, size, checksum,
 			storage_element_id, uploaded_by, uploaded_at, description, tags,
-			status, retention_policy, ttl_days, expires_at, created_at, updated_at
+			status, retention_policy, ttl_days, expires_at, collection_id, created_at, updated_at
 		FROM file_registry
 		%s
 		ORDER BY uploaded_at DESC
@@ -153,7 +279,7 @@ func (r *fileRegistryRepo) List(ctx context.Context, filters FileListFilters, li
 		if err := rows.Scan(
 			&f.FileID, &f.OriginalFilename, &f.ContentType, &f.Size, &f.Checksum,
 			&f.StorageElementID, &f.UploadedBy, &f.UploadedAt, &f.Description, &f.Tags,
-			&f.Status, &f.RetentionPolicy, &f.TTLDays, &f.ExpiresAt, &f.CreatedAt, &f.UpdatedAt,
+			&f.Status, &f.RetentionPolicy, &f.TTLDays, &f.ExpiresAt, &f.CollectionID, &f.CreatedAt, &f.UpdatedAt,
 		); err != nil {
 			return nil, fmt.Errorf("ошибка сканирования файла: %w", err)
 		}
@@ -201,7 +327,11 @@ func (r *fileRegistryRepo) Delete(ctx context.Context, fileID string) error {
 
 // BatchUpsert вставляет или обновляет файлы (INSERT ON CONFLICT UPDATE).
 // Используется при синхронизации файлового реестра с SE.
-// Возвращает количество добавленных и обновлённых записей.
+// Существующая запись обновляется только с основного SE файла:
+// копии на SE-репликах не перезаписывают метаданные реестра.
+// Неизменившиеся записи не перезаписываются (IS DISTINCT FROM), файлы с
+// изменением, ожидающим записи на SE (se_write_outbox), не откатываются.
+// Возвращает количество добавленных и фактически обновлённых записей.
 func (r *fileRegistryRepo) BatchUpsert(ctx context.Context, files []*model.FileRecord) (added, updated int, err error) {
 	if len(files) == 0 {
 		return 0, 0, nil
@@ -211,8 +341,8 @@ func (r *fileRegistryRepo) BatchUpsert(ctx context.Context, files []*model.FileR
 		query := `
 			INSERT INTO file_registry (file_id, original_filename, content_type, size, checksum,
 				storage_element_id, uploaded_by, uploaded_at, description, tags, status,
-				retention_policy, ttl_days, expires_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+				retention_policy, ttl_days, expires_at, collection_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 			ON CONFLICT (file_id) DO UPDATE SET
 				original_filename = EXCLUDED.original_filename,
 				content_type = EXCLUDED.content_type,
@@ -220,15 +350,33 @@ func (r *fileRegistryRepo) BatchUpsert(ctx context.Context, files []*model.FileR
 				checksum = EXCLUDED.checksum,
 				description = EXCLUDED.description,
 				tags = EXCLUDED.tags,
-				status = EXCLUDED.status
+				status = EXCLUDED.status,
+				collection_id = EXCLUDED.collection_id
+			WHERE file_registry.storage_element_id = EXCLUDED.storage_element_id
+				AND (file_registry.original_filename, file_registry.content_type,
+					file_registry.size, file_registry.checksum, file_registry.description,
+					file_registry.tags, file_registry.status, file_registry.collection_id)
+				IS DISTINCT FROM
+					(EXCLUDED.original_filename, EXCLUDED.content_type,
+					EXCLUDED.size, EXCLUDED.checksum, EXCLUDED.description,
+					EXCLUDED.tags, EXCLUDED.status, EXCLUDED.collection_id)
+				AND NOT EXISTS (
+					SELECT 1 FROM se_write_outbox o
+					WHERE o.file_id = file_registry.file_id AND o.status = 'pending'
+				)
 			RETURNING (xmax = 0) AS is_insert`
 
 		var isInsert bool
 		err := r.db.QueryRow(ctx, query,
 			f.FileID, f.OriginalFilename, f.ContentType, f.Size, f.Checksum,
 			f.StorageElementID, f.UploadedBy, f.UploadedAt, f.Description, f.Tags,
-			f.Status, f.RetentionPolicy, f.TTLDays, f.ExpiresAt,
+			f.Status, f.RetentionPolicy, f.TTLDays, f.ExpiresAt, f.CollectionID,
 		).Scan(&isInsert)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Файл не изменился, принадлежит другому SE (на этом SE — реплика)
+			// или ожидает записи изменения на SE
+			continue
+		}
 		if err != nil {
 			return added, updated, fmt.Errorf("ошибка upsert файла %s: %w", f.FileID, err)
 		}
@@ -241,17 +389,22 @@ func (r *fileRegistryRepo) BatchUpsert(ctx context.Context, files []*model.FileR
 	return added, updated, nil
 }
 
-// MarkDeletedExcept помечает файлы SE как deleted, кроме указанных в existingIDs.
+// MarkDeletedUnseen помечает файлы SE как deleted, если их нет среди найденных
+// на SE задачей синхронизации runID. Разность множеств вычисляется в PostgreSQL
+// по staging-таблице sync_seen_files — список ID не передаётся параметром.
 // Возвращает количество помеченных файлов.
-func (r *fileRegistryRepo) MarkDeletedExcept(ctx context.Context, seID string, existingIDs []string) (int, error) {
+func (r *fileRegistryRepo) MarkDeletedUnseen(ctx context.Context, seID, runID string) (int, error) {
 	query := `
-		UPDATE file_registry
+		UPDATE file_registry f
 		SET status = 'deleted'
-		WHERE storage_element_id = $1
-			AND status != 'deleted'
-			AND file_id != ALL($2)`
+		WHERE f.storage_element_id = $1
+			AND f.status != 'deleted'
+			AND NOT EXISTS (
+				SELECT 1 FROM sync_seen_files s
+				WHERE s.run_id = $2 AND s.file_id = f.file_id
+			)`
 
-	tag, err := r.db.Exec(ctx, query, seID, existingIDs)
+	tag, err := r.db.Exec(ctx, query, seID, runID)
 	if err != nil {
 		return 0, fmt.Errorf("ошибка пометки удалённых файлов: %w", err)
 	}
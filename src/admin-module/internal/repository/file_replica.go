@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// FileReplicaRepository — интерфейс для таблицы file_replicas.
+type FileReplicaRepository interface {
+	// ListByFiles возвращает реплики указанных файлов.
+	ListByFiles(ctx context.Context, fileIDs []string) ([]*model.FileReplica, error)
+	// ListUnderReplicated возвращает активные permanent-файлы,
+	// у которых число копий (основная + synced реплики) меньше factor.
+	// Файлы с failed-репликой или отложенной репликацией, срок повтора
+	// которых не наступил, пропускаются.
+	ListUnderReplicated(ctx context.Context, factor, limit int) ([]*model.FileRecord, error)
+	// Upsert создаёт или обновляет запись реплики.
+	Upsert(ctx context.Context, r *model.FileReplica) error
+	// ConfirmFound переводит в synced pending/failed-реплики SE для файлов, найденных на SE.
+	ConfirmFound(ctx context.Context, seID string, fileIDs []string) (int, error)
+	// DeleteMissing удаляет synced-реплики SE, не найденные задачей синхронизации runID.
+	DeleteMissing(ctx context.Context, seID, runID string) (int, error)
+	// ListDeferrals возвращает отложенные репликации указанных файлов.
+	ListDeferrals(ctx context.Context, fileIDs []string) ([]*model.ReplicationDeferral, error)
+	// Defer создаёт или обновляет отложенную репликацию файла.
+	Defer(ctx context.Context, d *model.ReplicationDeferral) error
+	// ClearDeferral удаляет отложенную репликацию файла.
+	ClearDeferral(ctx context.Context, fileID string) error
+}
+
+// fileReplicaRepo — реализация FileReplicaRepository.
+type fileReplicaRepo struct {
+	db DBTX
+}
+
+// NewFileReplicaRepository создаёт репозиторий реплик файлов.
+func NewFileReplicaRepository(db DBTX) FileReplicaRepository {
+	return &fileReplicaRepo{db: db}
+}
+
+func (r *fileReplicaRepo) ListByFiles(ctx context.Context, fileIDs []string) ([]*model.FileReplica, error) {
+	if len(fileIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT file_id, storage_element_id, status, last_error, attempts,
+			next_attempt_at, replicated_at, created_at, updated_at
+		FROM file_replicas
+		WHERE file_id = ANY($1)
+		ORDER BY file_id, created_at`
+
+	rows, err := r.db.Query(ctx, query, fileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения реплик файлов: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.FileReplica
+	for rows.Next() {
+		rep := &model.FileReplica{}
+		if err := rows.Scan(
+			&rep.FileID, &rep.StorageElementID, &rep.Status, &rep.LastError, &rep.Attempts,
+			&rep.NextAttemptAt, &rep.ReplicatedAt, &rep.CreatedAt, &rep.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования реплики: %w", err)
+		}
+		result = append(result, rep)
+	}
+	return result, rows.Err()
+}
+
+// ListUnderReplicated возвращает файлы, которым не хватает копий.
+// Первыми идут файлы с наименьшим числом реплик, затем — самые старые.
+// Файлы, копирование которых не удалось или было пропущено, возвращаются
+// только после next_attempt_at и не вытесняют из выборки новые файлы.
+func (r *fileReplicaRepo) ListUnderReplicated(ctx context.Context, factor, limit int) ([]*model.FileRecord, error) {
+	query := `
+		SELECT f.file_id, f.original_filename, f.content_type, f.size, f.checksum,
+			f.storage_element_id, f.uploaded_by, f.uploaded_at, f.description, f.tags,
+			f.status, f.retention_policy, f.ttl_days, f.expires_at, f.created_at, f.updated_at
+		FROM file_registry f
+		LEFT JOIN file_replicas r ON r.file_id = f.file_id AND r.status = 'synced'
+		WHERE f.status = 'active' AND f.retention_policy = 'permanent'
+			AND NOT EXISTS (
+				SELECT 1 FROM file_replicas fr
+				WHERE fr.file_id = f.file_id AND fr.status = 'failed'
+					AND fr.next_attempt_at > NOW()
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM file_replication_deferrals d
+				WHERE d.file_id = f.file_id AND d.next_attempt_at > NOW()
+			)
+		GROUP BY f.file_id
+		HAVING 1 + COUNT(r.file_id) < $1
+		ORDER BY COUNT(r.file_id), f.uploaded_at
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, factor, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка поиска файлов без достаточного числа реплик: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.FileRecord
+	for rows.Next() {
+		f := &model.FileRecord{}
+		if err := rows.Scan(
+			&f.FileID, &f.OriginalFilename, &f.ContentType, &f.Size, &f.Checksum,
+			&f.StorageElementID, &f.UploadedBy, &f.UploadedAt, &f.Description, &f.Tags,
+			&f.Status, &f.RetentionPolicy, &f.TTLDays, &f.ExpiresAt, &f.CreatedAt, &f.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования файла: %w", err)
+		}
+		result = append(result, f)
+	}
+	return result, rows.Err()
+}
+
+func (r *fileReplicaRepo) Upsert(ctx context.Context, rep *model.FileReplica) error {
+	query := `
+		INSERT INTO file_replicas (file_id, storage_element_id, status, last_error, attempts,
+			next_attempt_at, replicated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (file_id, storage_element_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			last_error = EXCLUDED.last_error,
+			attempts = EXCLUDED.attempts,
+			next_attempt_at = EXCLUDED.next_attempt_at,
+			replicated_at = EXCLUDED.replicated_at
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		rep.FileID, rep.StorageElementID, rep.Status, rep.LastError, rep.Attempts,
+		rep.NextAttemptAt, rep.ReplicatedAt,
+	).Scan(&rep.CreatedAt, &rep.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения реплики: %w", err)
+	}
+	return nil
+}
+
+// ConfirmFound переводит в synced pending/failed-реплики SE для файлов,
+// найденных на SE при синхронизации (реплика появилась, например, после
+// повторной попытки копирования). Вызывается для каждой страницы файлов.
+func (r *fileReplicaRepo) ConfirmFound(ctx context.Context, seID string, fileIDs []string) (int, error) {
+	if len(fileIDs) == 0 {
+		return 0, nil
+	}
+
+	tag, err := r.db.Exec(ctx, `
+		UPDATE file_replicas
+		SET status = 'synced', last_error = NULL, attempts = 0, next_attempt_at = NULL,
+			replicated_at = COALESCE(replicated_at, NOW())
+		WHERE storage_element_id = $1
+			AND status != 'synced'
+			AND file_id = ANY($2)`, seID, fileIDs)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка подтверждения реплик: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// DeleteMissing удаляет synced-реплики SE, файлы которых не найдены на SE
+// задачей полной синхронизации runID (staging-таблица sync_seen_files).
+// Файл будет реплицирован заново.
+func (r *fileReplicaRepo) DeleteMissing(ctx context.Context, seID, runID string) (int, error) {
+	tag, err := r.db.Exec(ctx, `
+		DELETE FROM file_replicas fr
+		WHERE fr.storage_element_id = $1
+			AND fr.status = 'synced'
+			AND NOT EXISTS (
+				SELECT 1 FROM sync_seen_files s
+				WHERE s.run_id = $2 AND s.file_id = fr.file_id
+			)`, seID, runID)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления потерянных реплик: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *fileReplicaRepo) ListDeferrals(ctx context.Context, fileIDs []string) ([]*model.ReplicationDeferral, error) {
+	if len(fileIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT file_id, reason, attempts, next_attempt_at, created_at, updated_at
+		FROM file_replication_deferrals
+		WHERE file_id = ANY($1)`
+
+	rows, err := r.db.Query(ctx, query, fileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения отложенных репликаций: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.ReplicationDeferral
+	for rows.Next() {
+		d := &model.ReplicationDeferral{}
+		if err := rows.Scan(
+			&d.FileID, &d.Reason, &d.Attempts, &d.NextAttemptAt, &d.CreatedAt, &d.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования отложенной репликации: %w", err)
+		}
+		result = append(result, d)
+	}
+	return result, rows.Err()
+}
+
+func (r *fileReplicaRepo) Defer(ctx context.Context, d *model.ReplicationDeferral) error {
+	query := `
+		INSERT INTO file_replication_deferrals (file_id, reason, attempts, next_attempt_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (file_id) DO UPDATE SET
+			reason = EXCLUDED.reason,
+			attempts = EXCLUDED.attempts,
+			next_attempt_at = EXCLUDED.next_attempt_at
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query, d.FileID, d.Reason, d.Attempts, d.NextAttemptAt).
+		Scan(&d.CreatedAt, &d.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения отложенной репликации: %w", err)
+	}
+	return nil
+}
+
+func (r *fileReplicaRepo) ClearDeferral(ctx context.Context, fileID string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM file_replication_deferrals WHERE file_id = $1`, fileID); err != nil {
+		return fmt.Errorf("ошибка удаления отложенной репликации: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// LocalClientRepository — интерфейс для таблицы local_idp_clients.
+type LocalClientRepository interface {
+	// Create создаёт клиента. ErrConflict — client_id занят.
+	Create(ctx context.Context, c *model.LocalClient) error
+	// GetByID возвращает клиента по UUID.
+	GetByID(ctx context.Context, id string) (*model.LocalClient, error)
+	// GetByClientID возвращает клиента по client_id.
+	GetByClientID(ctx context.Context, clientID string) (*model.LocalClient, error)
+	// List возвращает клиентов, client_id которых начинается с prefix.
+	List(ctx context.Context, prefix string, limit, offset int) ([]*model.LocalClient, error)
+	// Update обновляет имя, описание, scopes и состояние клиента.
+	Update(ctx context.Context, c *model.LocalClient) error
+	// SetSecret сохраняет хеш нового секрета и (необязательно) предыдущего,
+	// действующего до previousExpiresAt.
+	SetSecret(ctx context.Context, id string, secretHash, previousHash []byte, previousExpiresAt *time.Time) error
+	// SetClaim задаёт claim access token клиента; nil value удаляет claim.
+	SetClaim(ctx context.Context, id, name string, value json.RawMessage) error
+	// Delete удаляет клиента.
+	Delete(ctx context.Context, id string) error
+}
+
+// localClientRepo — реализация LocalClientRepository.
+type localClientRepo struct {
+	db DBTX
+}
+
+// NewLocalClientRepository создаёт репозиторий клиентов локального IdP.
+func NewLocalClientRepository(db DBTX) LocalClientRepository {
+	return &localClientRepo{db: db}
+}
+
+// localClientColumns — список колонок local_idp_clients в порядке scanLocalClient.
+const localClientColumns = `id, client_id, name, description, enabled, scopes, secret_hash,
+	previous_secret_hash, previous_secret_expires_at, claims, created_at, updated_at`
+
+// scanLocalClient сканирует строку local_idp_clients.
+func scanLocalClient(row pgx.Row) (*model.LocalClient, error) {
+	c := &model.LocalClient{}
+	var claims []byte
+	err := row.Scan(
+		&c.ID, &c.ClientID, &c.Name, &c.Description, &c.Enabled, &c.Scopes, &c.SecretHash,
+		&c.PreviousSecretHash, &c.PreviousSecretExpiresAt, &claims, &c.CreatedAt, &c.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(claims, &c.Claims); err != nil {
+		return nil, fmt.Errorf("декодирование claims клиента: %w", err)
+	}
+	return c, nil
+}
+
+func (r *localClientRepo) Create(ctx context.Context, c *model.LocalClient) error {
+	scopes := c.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	query := `
+		INSERT INTO local_idp_clients (id, client_id, name, description, enabled, scopes, secret_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		c.ID, c.ClientID, c.Name, c.Description, c.Enabled, scopes, c.SecretHash,
+	).Scan(&c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrConflict
+		}
+		return fmt.Errorf("ошибка создания клиента: %w", err)
+	}
+	return nil
+}
+
+func (r *localClientRepo) GetByID(ctx context.Context, id string) (*model.LocalClient, error) {
+	query := "SELECT " + localClientColumns + " FROM local_idp_clients WHERE id = $1"
+
+	c, err := scanLocalClient(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения клиента: %w", err)
+	}
+	return c, nil
+}
+
+func (r *localClientRepo) GetByClientID(ctx context.Context, clientID string) (*model.LocalClient, error) {
+	query := "SELECT " + localClientColumns + " FROM local_idp_clients WHERE client_id = $1"
+
+	c, err := scanLocalClient(r.db.QueryRow(ctx, query, clientID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения клиента: %w", err)
+	}
+	return c, nil
+}
+
+func (r *localClientRepo) List(ctx context.Context, prefix string, limit, offset int) ([]*model.LocalClient, error) {
+	query := `
+		SELECT ` + localClientColumns + `
+		FROM local_idp_clients
+		WHERE starts_with(client_id, $1)
+		ORDER BY client_id
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, query, prefix, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения клиентов: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.LocalClient
+	for rows.Next() {
+		c, err := scanLocalClient(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования клиента: %w", err)
+		}
+		result = append(result, c)
+	}
+	return result, rows.Err()
+}
+
+func (r *localClientRepo) Update(ctx context.Context, c *model.LocalClient) error {
+	scopes := c.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	query := `
+		UPDATE local_idp_clients
+		SET name = $2, description = $3, enabled = $4, scopes = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at`
+
+	err := r.db.QueryRow(ctx, query, c.ID, c.Name, c.Description, c.Enabled, scopes).Scan(&c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("ошибка обновления клиента: %w", err)
+	}
+	return nil
+}
+
+func (r *localClientRepo) SetSecret(ctx context.Context, id string, secretHash, previousHash []byte, previousExpiresAt *time.Time) error {
+	query := `
+		UPDATE local_idp_clients
+		SET secret_hash = $2, previous_secret_hash = $3, previous_secret_expires_at = $4, updated_at = NOW()
+		WHERE id = $1`
+
+	tag, err := r.db.Exec(ctx, query, id, secretHash, previousHash, previousExpiresAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения секрета клиента: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *localClientRepo) SetClaim(ctx context.Context, id, name string, value json.RawMessage) error {
+	query := `UPDATE local_idp_clients SET claims = claims - $2::text, updated_at = NOW() WHERE id = $1`
+	args := []any{id, name}
+	if value != nil {
+		query = `
+			UPDATE local_idp_clients
+			SET claims = claims || jsonb_build_object($2::text, $3::jsonb), updated_at = NOW()
+			WHERE id = $1`
+		args = append(args, string(value))
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения claim клиента: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *localClientRepo) Delete(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM local_idp_clients WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления клиента: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// LocalUserRepository — интерфейс для таблицы local_idp_users.
+type LocalUserRepository interface {
+	// Create создаёт пользователя. ErrConflict — username занят.
+	Create(ctx context.Context, u *model.LocalUser) error
+	// GetByID возвращает пользователя по UUID.
+	GetByID(ctx context.Context, id string) (*model.LocalUser, error)
+	// GetByUsername возвращает пользователя по имени (без учёта регистра).
+	GetByUsername(ctx context.Context, username string) (*model.LocalUser, error)
+	// List возвращает пользователей, упорядоченных по username; query —
+	// поиск по username, email и имени (пусто — все).
+	List(ctx context.Context, query string, limit, offset int) ([]*model.LocalUser, error)
+	// Count возвращает количество пользователей.
+	Count(ctx context.Context) (int, error)
+	// Update обновляет email, имя, группы и состояние пользователя.
+	Update(ctx context.Context, u *model.LocalUser) error
+	// SetPassword сохраняет новый хеш пароля и снимает блокировку входа.
+	SetPassword(ctx context.Context, id, passwordHash string) error
+	// RecordLoginFailure увеличивает счётчик неудачных входов; при достижении
+	// maxFailures блокирует вход на lockout и сбрасывает счётчик.
+	// Возвращает срок блокировки (nil — не заблокирован).
+	RecordLoginFailure(ctx context.Context, id string, maxFailures int, lockout time.Duration) (*time.Time, error)
+	// RecordLoginSuccess сбрасывает счётчик неудачных входов и блокировку.
+	RecordLoginSuccess(ctx context.Context, id string) error
+	// Delete удаляет пользователя.
+	Delete(ctx context.Context, id string) error
+}
+
+// localUserRepo — реализация LocalUserRepository.
+type localUserRepo struct {
+	db DBTX
+}
+
+// NewLocalUserRepository создаёт репозиторий пользователей локального IdP.
+func NewLocalUserRepository(db DBTX) LocalUserRepository {
+	return &localUserRepo{db: db}
+}
+
+// localUserColumns — список колонок local_idp_users в порядке scanLocalUser.
+const localUserColumns = `id, username, email, first_name, last_name, password_hash, enabled,
+	groups, failed_logins, locked_until, last_login_at, created_at, updated_at`
+
+// scanLocalUser сканирует строку local_idp_users.
+func scanLocalUser(row pgx.Row) (*model.LocalUser, error) {
+	u := &model.LocalUser{}
+	err := row.Scan(
+		&u.ID, &u.Username, &u.Email, &u.FirstName, &u.LastName, &u.PasswordHash, &u.Enabled,
+		&u.Groups, &u.FailedLogins, &u.LockedUntil, &u.LastLoginAt, &u.CreatedAt, &u.UpdatedAt,
+	)
+	return u, err
+}
+
+func (r *localUserRepo) Create(ctx context.Context, u *model.LocalUser) error {
+	groups := u.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	query := `
+		INSERT INTO local_idp_users (id, username, email, first_name, last_name,
+			password_hash, enabled, groups)
+		VALUES ($1, LOWER($2), $3, $4, $5, $6, $7, $8)
+		RETURNING username, created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		u.ID, u.Username, u.Email, u.FirstName, u.LastName, u.PasswordHash, u.Enabled, groups,
+	).Scan(&u.Username, &u.CreatedAt, &u.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrConflict
+		}
+		return fmt.Errorf("ошибка создания пользователя: %w", err)
+	}
+	return nil
+}
+
+func (r *localUserRepo) GetByID(ctx context.Context, id string) (*model.LocalUser, error) {
+	query := "SELECT " + localUserColumns + " FROM local_idp_users WHERE id = $1"
+
+	u, err := scanLocalUser(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения пользователя: %w", err)
+	}
+	return u, nil
+}
+
+func (r *localUserRepo) GetByUsername(ctx context.Context, username string) (*model.LocalUser, error) {
+	query := "SELECT " + localUserColumns + " FROM local_idp_users WHERE username = LOWER($1)"
+
+	u, err := scanLocalUser(r.db.QueryRow(ctx, query, username))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения пользователя: %w", err)
+	}
+	return u, nil
+}
+
+func (r *localUserRepo) List(ctx context.Context, query string, limit, offset int) ([]*model.LocalUser, error) {
+	sql := `
+		SELECT ` + localUserColumns + `
+		FROM local_idp_users
+		WHERE $1 = '' OR username ILIKE '%' || $1 || '%' OR email ILIKE '%' || $1 || '%'
+			OR (first_name || ' ' || last_name) ILIKE '%' || $1 || '%'
+		ORDER BY username
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, sql, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения пользователей: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.LocalUser
+	for rows.Next() {
+		u, err := scanLocalUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования пользователя: %w", err)
+		}
+		result = append(result, u)
+	}
+	return result, rows.Err()
+}
+
+func (r *localUserRepo) Count(ctx context.Context) (int, error) {
+	var count int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM local_idp_users`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("ошибка подсчёта пользователей: %w", err)
+	}
+	return count, nil
+}
+
+func (r *localUserRepo) Update(ctx context.Context, u *model.LocalUser) error {
+	groups := u.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	query := `
+		UPDATE local_idp_users
+		SET email = $2, first_name = $3, last_name = $4, enabled = $5, groups = $6, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		u.ID, u.Email, u.FirstName, u.LastName, u.Enabled, groups,
+	).Scan(&u.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("ошибка обновления пользователя: %w", err)
+	}
+	return nil
+}
+
+func (r *localUserRepo) SetPassword(ctx context.Context, id, passwordHash string) error {
+	query := `
+		UPDATE local_idp_users
+		SET password_hash = $2, failed_logins = 0, locked_until = NULL, updated_at = NOW()
+		WHERE id = $1`
+
+	tag, err := r.db.Exec(ctx, query, id, passwordHash)
+	if err != nil {
+		return fmt.Errorf("ошибка установки пароля: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *localUserRepo) RecordLoginFailure(ctx context.Context, id string, maxFailures int, lockout time.Duration) (*time.Time, error) {
+	// Счётчик и блокировка меняются одним UPDATE, чтобы параллельные
+	// попытки входа не обходили лимит.
+	query := `
+		UPDATE local_idp_users
+		SET failed_logins = CASE WHEN failed_logins + 1 >= $2 THEN 0 ELSE failed_logins + 1 END,
+			locked_until = CASE WHEN failed_logins + 1 >= $2
+				THEN NOW() + make_interval(secs => $3) ELSE locked_until END
+		WHERE id = $1
+		RETURNING locked_until`
+
+	var lockedUntil *time.Time
+	err := r.db.QueryRow(ctx, query, id, maxFailures, lockout.Seconds()).Scan(&lockedUntil)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка учёта неудачного входа: %w", err)
+	}
+	return lockedUntil, nil
+}
+
+func (r *localUserRepo) RecordLoginSuccess(ctx context.Context, id string) error {
+	query := `
+		UPDATE local_idp_users
+		SET failed_logins = 0, locked_until = NULL, last_login_at = NOW()
+		WHERE id = $1`
+
+	if _, err := r.db.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("ошибка учёта успешного входа: %w", err)
+	}
+	return nil
+}
+
+func (r *localUserRepo) Delete(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM local_idp_users WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления пользователя: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
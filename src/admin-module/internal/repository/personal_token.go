@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// personalTokenTouchInterval — минимальный интервал обновления last_used_at
+// (чтобы не писать в БД на каждый запрос с токеном).
+const personalTokenTouchInterval = time.Minute
+
+// PersonalTokenRepository — интерфейс для таблицы personal_access_tokens.
+type PersonalTokenRepository interface {
+	// Create сохраняет новый токен. Заполняет CreatedAt.
+	// ErrConflict — у пользователя уже есть токен с таким названием.
+	Create(ctx context.Context, t *model.PersonalToken) error
+	// GetByTokenHash возвращает токен по SHA-256.
+	GetByTokenHash(ctx context.Context, tokenHash []byte) (*model.PersonalToken, error)
+	// GetByID возвращает токен по UUID.
+	GetByID(ctx context.Context, id string) (*model.PersonalToken, error)
+	// List возвращает токены (subject — необязательный фильтр по владельцу),
+	// упорядоченные по владельцу и времени создания (новые первыми).
+	List(ctx context.Context, subject *string) ([]*model.PersonalToken, error)
+	// CountActive возвращает количество неистёкших токенов пользователя.
+	CountActive(ctx context.Context, subject string, now time.Time) (int, error)
+	// Touch записывает время и IP последнего использования, если с прошлой
+	// записи прошло не меньше personalTokenTouchInterval.
+	Touch(ctx context.Context, id string, at time.Time, ip string) error
+	// Delete удаляет токен по ID.
+	Delete(ctx context.Context, id string) error
+	// DeleteBySubject удаляет все токены пользователя. Возвращает количество удалённых.
+	DeleteBySubject(ctx context.Context, subject string) (int, error)
+	// DeleteExpired удаляет токены, истёкшие до before. Возвращает количество удалённых.
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
+
+// personalTokenRepo — реализация PersonalTokenRepository.
+type personalTokenRepo struct {
+	db DBTX
+}
+
+// NewPersonalTokenRepository создаёт репозиторий personal access tokens.
+func NewPersonalTokenRepository(db DBTX) PersonalTokenRepository {
+	return &personalTokenRepo{db: db}
+}
+
+// personalTokenColumns — список колонок personal_access_tokens в порядке scanPersonalToken.
+const personalTokenColumns = `id, token_hash, token_prefix, subject, username, name, scopes,
+	created_at, expires_at, last_used_at, last_used_ip`
+
+// scanPersonalToken сканирует строку personal_access_tokens.
+func scanPersonalToken(row pgx.Row) (*model.PersonalToken, error) {
+	t := &model.PersonalToken{}
+	err := row.Scan(
+		&t.ID, &t.TokenHash, &t.TokenPrefix, &t.Subject, &t.Username, &t.Name, &t.Scopes,
+		&t.CreatedAt, &t.ExpiresAt, &t.LastUsedAt, &t.LastUsedIP,
+	)
+	return t, err
+}
+
+func (r *personalTokenRepo) Create(ctx context.Context, t *model.PersonalToken) error {
+	query := `
+		INSERT INTO personal_access_tokens (id, token_hash, token_prefix, subject, username,
+			name, scopes, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		t.ID, t.TokenHash, t.TokenPrefix, t.Subject, t.Username, t.Name, t.Scopes, t.ExpiresAt,
+	).Scan(&t.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: токен с таким названием уже существует", ErrConflict)
+		}
+		return fmt.Errorf("ошибка создания personal access token: %w", err)
+	}
+	return nil
+}
+
+func (r *personalTokenRepo) GetByTokenHash(ctx context.Context, tokenHash []byte) (*model.PersonalToken, error) {
+	query := "SELECT " + personalTokenColumns + " FROM personal_access_tokens WHERE token_hash = $1"
+
+	t, err := scanPersonalToken(r.db.QueryRow(ctx, query, tokenHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения personal access token: %w", err)
+	}
+	return t, nil
+}
+
+func (r *personalTokenRepo) GetByID(ctx context.Context, id string) (*model.PersonalToken, error) {
+	query := "SELECT " + personalTokenColumns + " FROM personal_access_tokens WHERE id = $1"
+
+	t, err := scanPersonalToken(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения personal access token: %w", err)
+	}
+	return t, nil
+}
+
+func (r *personalTokenRepo) List(ctx context.Context, subject *string) ([]*model.PersonalToken, error) {
+	query := `
+		SELECT ` + personalTokenColumns + `
+		FROM personal_access_tokens
+		WHERE $1::text IS NULL OR subject = $1
+		ORDER BY username, created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, subject)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения personal access tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.PersonalToken
+	for rows.Next() {
+		t, err := scanPersonalToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования personal access token: %w", err)
+		}
+		result = append(result, t)
+	}
+	return result, rows.Err()
+}
+
+func (r *personalTokenRepo) CountActive(ctx context.Context, subject string, now time.Time) (int, error) {
+	var n int
+	err := r.db.QueryRow(ctx,
+		`SELECT COUNT(*) FROM personal_access_tokens WHERE subject = $1 AND expires_at > $2`,
+		subject, now,
+	).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка подсчёта personal access tokens: %w", err)
+	}
+	return n, nil
+}
+
+func (r *personalTokenRepo) Touch(ctx context.Context, id string, at time.Time, ip string) error {
+	query := `
+		UPDATE personal_access_tokens SET last_used_at = $2, last_used_ip = $3
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at <= $4)`
+
+	_, err := r.db.Exec(ctx, query, id, at, ip, at.Add(-personalTokenTouchInterval))
+	if err != nil {
+		return fmt.Errorf("ошибка обновления использования personal access token: %w", err)
+	}
+	return nil
+}
+
+func (r *personalTokenRepo) Delete(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM personal_access_tokens WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления personal access token: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *personalTokenRepo) DeleteBySubject(ctx context.Context, subject string) (int, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM personal_access_tokens WHERE subject = $1`, subject)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления personal access tokens пользователя: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *personalTokenRepo) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM personal_access_tokens WHERE expires_at <= $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления истёкших personal access tokens: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
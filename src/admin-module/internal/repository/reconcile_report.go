@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// ReconcileReportRepository — интерфейс для таблиц se_reconcile_reports и se_reconcile_issues.
+type ReconcileReportRepository interface {
+	// Create сохраняет новый отчёт сверки. Заполняет CreatedAt.
+	Create(ctx context.Context, report *model.ReconcileReport) error
+	// Complete сохраняет итог сверки (состояние, сводку, ошибку) вместе с
+	// проблемами одной командой.
+	Complete(ctx context.Context, report *model.ReconcileReport, issues []*model.ReconcileIssue) error
+	// GetByID возвращает отчёт по UUID.
+	GetByID(ctx context.Context, id string) (*model.ReconcileReport, error)
+	// ListBySE возвращает последние отчёты SE (новые первыми).
+	ListBySE(ctx context.Context, seID string, limit int) ([]*model.ReconcileReport, error)
+	// ListIssues возвращает проблемы отчёта в порядке SE (issueType — необязательный
+	// фильтр) с именем и статусом файла в реестре.
+	ListIssues(ctx context.Context, reportID string, issueType *string, limit int) ([]*model.ReconcileIssue, error)
+	// Prune удаляет отчёты SE сверх keep последних. Возвращает количество удалённых.
+	Prune(ctx context.Context, seID string, keep int) (int, error)
+	// FailUnfinished переводит отчёты running в failed с указанной ошибкой.
+	// Вызывается при старте: такие сверки прерваны перезапуском процесса.
+	FailUnfinished(ctx context.Context, reason string) (int, error)
+}
+
+// reconcileReportRepo — реализация ReconcileReportRepository.
+type reconcileReportRepo struct {
+	db DBTX
+}
+
+// NewReconcileReportRepository создаёт репозиторий отчётов сверки SE.
+func NewReconcileReportRepository(db DBTX) ReconcileReportRepository {
+	return &reconcileReportRepo{db: db}
+}
+
+// reconcileReportColumns — список колонок se_reconcile_reports в порядке scanReconcileReport.
+const reconcileReportColumns = `id, storage_element_id, status, requested_by,
+	files_checked, files_ok, orphaned_files, missing_files, checksum_mismatches,
+	size_mismatches, issues_total, error, created_at, completed_at, duration_ms`
+
+// scanReconcileReport сканирует строку se_reconcile_reports.
+func scanReconcileReport(row pgx.Row) (*model.ReconcileReport, error) {
+	rep := &model.ReconcileReport{}
+	err := row.Scan(
+		&rep.ID, &rep.StorageElementID, &rep.Status, &rep.RequestedBy,
+		&rep.FilesChecked, &rep.FilesOK, &rep.OrphanedFiles, &rep.MissingFiles, &rep.ChecksumMismatches,
+		&rep.SizeMismatches, &rep.IssuesTotal, &rep.Error, &rep.CreatedAt, &rep.CompletedAt, &rep.DurationMs,
+	)
+	return rep, err
+}
+
+func (r *reconcileReportRepo) Create(ctx context.Context, report *model.ReconcileReport) error {
+	query := `
+		INSERT INTO se_reconcile_reports (id, storage_element_id, status, requested_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		report.ID, report.StorageElementID, report.Status, report.RequestedBy,
+	).Scan(&report.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка создания отчёта сверки: %w", err)
+	}
+	return nil
+}
+
+func (r *reconcileReportRepo) Complete(ctx context.Context, report *model.ReconcileReport, issues []*model.ReconcileIssue) error {
+	types := make([]string, len(issues))
+	fileIDs := make([]*string, len(issues))
+	paths := make([]*string, len(issues))
+	descriptions := make([]string, len(issues))
+	for i, issue := range issues {
+		types[i] = issue.Type
+		fileIDs[i] = issue.FileID
+		paths[i] = issue.Path
+		descriptions[i] = issue.Description
+	}
+
+	query := `
+		WITH issues AS (
+			INSERT INTO se_reconcile_issues (report_id, position, type, file_id, path, description)
+			SELECT $1, t.ord, t.type, t.file_id, t.path, t.description
+			FROM unnest($12::text[], $13::uuid[], $14::text[], $15::text[])
+				WITH ORDINALITY AS t(type, file_id, path, description, ord)
+		)
+		UPDATE se_reconcile_reports SET
+			status = $2, files_checked = $3, files_ok = $4, orphaned_files = $5,
+			missing_files = $6, checksum_mismatches = $7, size_mismatches = $8,
+			issues_total = $9, error = $10, completed_at = $11,
+			duration_ms = (EXTRACT(EPOCH FROM $11::timestamptz - created_at) * 1000)::BIGINT
+		WHERE id = $1
+		RETURNING duration_ms`
+
+	err := r.db.QueryRow(ctx, query,
+		report.ID, report.Status, report.FilesChecked, report.FilesOK, report.OrphanedFiles,
+		report.MissingFiles, report.ChecksumMismatches, report.SizeMismatches,
+		report.IssuesTotal, report.Error, report.CompletedAt,
+		types, fileIDs, paths, descriptions,
+	).Scan(&report.DurationMs)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("ошибка сохранения итога сверки: %w", err)
+	}
+	return nil
+}
+
+func (r *reconcileReportRepo) GetByID(ctx context.Context, id string) (*model.ReconcileReport, error) {
+	query := "SELECT " + reconcileReportColumns + " FROM se_reconcile_reports WHERE id = $1"
+
+	rep, err := scanReconcileReport(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения отчёта сверки: %w", err)
+	}
+	return rep, nil
+}
+
+func (r *reconcileReportRepo) ListBySE(ctx context.Context, seID string, limit int) ([]*model.ReconcileReport, error) {
+	query := `
+		SELECT ` + reconcileReportColumns + `
+		FROM se_reconcile_reports
+		WHERE storage_element_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, seID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения отчётов сверки: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.ReconcileReport
+	for rows.Next() {
+		rep, err := scanReconcileReport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования отчёта сверки: %w", err)
+		}
+		result = append(result, rep)
+	}
+	return result, rows.Err()
+}
+
+func (r *reconcileReportRepo) ListIssues(ctx context.Context, reportID string, issueType *string, limit int) ([]*model.ReconcileIssue, error) {
+	query := `
+		SELECT i.report_id, i.position, i.type, i.file_id, i.path, i.description,
+			f.original_filename, f.status
+		FROM se_reconcile_issues i
+		LEFT JOIN file_registry f ON f.file_id = i.file_id
+		WHERE i.report_id = $1 AND ($2::text IS NULL OR i.type = $2)
+		ORDER BY i.position
+		LIMIT $3`
+
+	rows, err := r.db.Query(ctx, query, reportID, issueType, limit)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения проблем сверки: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.ReconcileIssue
+	for rows.Next() {
+		issue := &model.ReconcileIssue{}
+		if err := rows.Scan(
+			&issue.ReportID, &issue.Position, &issue.Type, &issue.FileID, &issue.Path, &issue.Description,
+			&issue.RegistryFilename, &issue.RegistryStatus,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования проблемы сверки: %w", err)
+		}
+		result = append(result, issue)
+	}
+	return result, rows.Err()
+}
+
+func (r *reconcileReportRepo) Prune(ctx context.Context, seID string, keep int) (int, error) {
+	query := `
+		DELETE FROM se_reconcile_reports
+		WHERE storage_element_id = $1 AND status != 'running' AND id NOT IN (
+			SELECT id FROM se_reconcile_reports
+			WHERE storage_element_id = $1
+			ORDER BY created_at DESC, id
+			LIMIT $2
+		)`
+
+	tag, err := r.db.Exec(ctx, query, seID, keep)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления старых отчётов сверки: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *reconcileReportRepo) FailUnfinished(ctx context.Context, reason string) (int, error) {
+	query := `
+		UPDATE se_reconcile_reports SET
+			status = 'failed',
+			error = $1,
+			completed_at = NOW(),
+			duration_ms = (EXTRACT(EPOCH FROM NOW() - created_at) * 1000)::BIGINT
+		WHERE status = 'running'`
+
+	tag, err := r.db.Exec(ctx, query, reason)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка завершения прерванных сверок: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
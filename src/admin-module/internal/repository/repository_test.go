@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"os"
 	"testing"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/bigkaa/goartstore/admin-module/internal/config"
 	"github.com/bigkaa/goartstore/admin-module/internal/database"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -133,7 +135,7 @@ func TestStorageElementCRUD(t *testing.T) {
 	}
 
 	// List
-	list, err := repo.List(ctx, nil, nil, 10, 0)
+	list, err := repo.List(ctx, StorageElementFilters{}, 10, 0)
 	if err != nil {
 		t.Fatalf("List() ошибка: %v", err)
 	}
@@ -142,7 +144,7 @@ func TestStorageElementCRUD(t *testing.T) {
 	}
 
 	// Count
-	count, err := repo.Count(ctx, nil, nil)
+	count, err := repo.Count(ctx, StorageElementFilters{})
 	if err != nil {
 		t.Fatalf("Count() ошибка: %v", err)
 	}
@@ -171,6 +173,72 @@ func TestStorageElementCRUD(t *testing.T) {
 	}
 }
 
+func TestStorageElementLabels(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	repo := NewStorageElementRepository(pool)
+
+	ids := make([]string, 2)
+	for i, zone := range []string{"eu-1", "us-1"} {
+		ids[i] = uuid.New().String()
+		se := &model.StorageElement{
+			ID:        ids[i],
+			Name:      "se-" + zone,
+			URL:       "https://" + zone + ".example.com",
+			StorageID: "storage-" + zone,
+			Mode:      "rw",
+			Status:    "online",
+		}
+		if err := repo.Create(ctx, se); err != nil {
+			t.Fatalf("Create() ошибка: %v", err)
+		}
+		if err := repo.SetLabels(ctx, ids[i], map[string]string{"zone": zone, "tier": "hot"}); err != nil {
+			t.Fatalf("SetLabels() ошибка: %v", err)
+		}
+	}
+
+	got, err := repo.GetByID(ctx, ids[0])
+	if err != nil {
+		t.Fatalf("GetByID() ошибка: %v", err)
+	}
+	if got.Labels["zone"] != "eu-1" || got.Labels["tier"] != "hot" {
+		t.Errorf("Labels = %v", got.Labels)
+	}
+
+	// Фильтр по меткам: все метки должны совпасть
+	list, err := repo.List(ctx, StorageElementFilters{Labels: map[string]string{"zone": "us-1", "tier": "hot"}}, 10, 0)
+	if err != nil {
+		t.Fatalf("List() ошибка: %v", err)
+	}
+	if len(list) != 1 || list[0].ID != ids[1] {
+		t.Errorf("List(zone=us-1) вернул %d записей", len(list))
+	}
+	count, err := repo.Count(ctx, StorageElementFilters{Labels: map[string]string{"tier": "hot"}})
+	if err != nil {
+		t.Fatalf("Count() ошибка: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Count(tier=hot) = %d, хотели 2", count)
+	}
+
+	pairs, err := repo.ListLabelPairs(ctx)
+	if err != nil {
+		t.Fatalf("ListLabelPairs() ошибка: %v", err)
+	}
+	if len(pairs) != 3 || pairs[0] != "tier=hot" || pairs[1] != "zone=eu-1" {
+		t.Errorf("ListLabelPairs() = %v", pairs)
+	}
+
+	// Пустой набор удаляет метки
+	if err := repo.SetLabels(ctx, ids[0], map[string]string{}); err != nil {
+		t.Fatalf("SetLabels() ошибка: %v", err)
+	}
+	got, _ = repo.GetByID(ctx, ids[0])
+	if len(got.Labels) != 0 {
+		t.Errorf("После очистки Labels = %v", got.Labels)
+	}
+}
+
 // --- Тесты ServiceAccountRepository ---
 
 func TestServiceAccountCRUD(t *testing.T) {
@@ -237,6 +305,400 @@ func TestServiceAccountCRUD(t *testing.T) {
 	}
 }
 
+func TestServiceAccountSecretIssued(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	repo := NewServiceAccountRepository(pool)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	old := &model.ServiceAccount{
+		ID: uuid.New().String(), ClientID: "sa_old_1", Name: "old",
+		Scopes: []string{"files:read"}, Status: "active", Source: "local",
+		SecretIssuedAt: now.Add(-100 * 24 * time.Hour),
+	}
+	fresh := &model.ServiceAccount{
+		ID: uuid.New().String(), ClientID: "sa_fresh_1", Name: "fresh",
+		Scopes: []string{"files:read"}, Status: "active", Source: "local",
+	}
+	for _, sa := range []*model.ServiceAccount{old, fresh} {
+		if err := repo.Create(ctx, sa); err != nil {
+			t.Fatalf("Create() ошибка: %v", err)
+		}
+	}
+	if fresh.SecretIssuedAt.IsZero() {
+		t.Error("SecretIssuedAt не установлен после Create с нулевым временем")
+	}
+
+	// Только SA со старым секретом
+	list, err := repo.ListActiveSecretIssuedBefore(ctx, now.Add(-90*24*time.Hour))
+	if err != nil {
+		t.Fatalf("ListActiveSecretIssuedBefore() ошибка: %v", err)
+	}
+	if len(list) != 1 || list[0].ID != old.ID {
+		t.Fatalf("ListActiveSecretIssuedBefore() = %d записей, хотели только %q", len(list), old.ClientID)
+	}
+
+	// Ротация: новый secret_issued_at и срок действия старого секрета
+	prevExpires := now.Add(24 * time.Hour)
+	if err := repo.SetSecretIssued(ctx, old.ID, now, &prevExpires); err != nil {
+		t.Fatalf("SetSecretIssued() ошибка: %v", err)
+	}
+	got, err := repo.GetByID(ctx, old.ID)
+	if err != nil {
+		t.Fatalf("GetByID() ошибка: %v", err)
+	}
+	if !got.SecretIssuedAt.Equal(now) {
+		t.Errorf("SecretIssuedAt = %v, хотели %v", got.SecretIssuedAt, now)
+	}
+	if got.PreviousSecretExpiresAt == nil || !got.PreviousSecretExpiresAt.Equal(prevExpires) {
+		t.Errorf("PreviousSecretExpiresAt = %v, хотели %v", got.PreviousSecretExpiresAt, prevExpires)
+	}
+
+	list, err = repo.ListActiveSecretIssuedBefore(ctx, now.Add(-90*24*time.Hour))
+	if err != nil {
+		t.Fatalf("ListActiveSecretIssuedBefore() ошибка: %v", err)
+	}
+	if len(list) != 0 {
+		t.Errorf("После ротации ожидали 0 записей, получили %d", len(list))
+	}
+
+	if err := repo.SetSecretIssued(ctx, uuid.New().String(), now, nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("SetSecretIssued() для несуществующего SA: ожидали ErrNotFound, получили %v", err)
+	}
+}
+
+func TestSAGrants(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	saRepo := NewServiceAccountRepository(pool)
+	repo := NewSAGrantRepository(pool)
+
+	sa := &model.ServiceAccount{
+		ID: uuid.New().String(), ClientID: "sa_grants_1", Name: "grants",
+		Scopes: []string{"files:read", "files:write"}, Status: "active", Source: "local",
+	}
+	if err := saRepo.Create(ctx, sa); err != nil {
+		t.Fatalf("Create() ошибка: %v", err)
+	}
+
+	seID := uuid.New().String()
+	list := []*model.ResourceGrant{
+		{ID: uuid.New().String(), Scope: "files:write", SEIDs: []string{seID}, RetentionPolicies: []string{"temporary"}},
+		{ID: uuid.New().String(), Scope: "files:read", SELabels: map[string]string{"zone": "dc1"}, OwnFilesOnly: true},
+	}
+	if err := repo.Replace(ctx, sa.ID, list); err != nil {
+		t.Fatalf("Replace() ошибка: %v", err)
+	}
+
+	got, err := repo.ListBySA(ctx, sa.ID)
+	if err != nil {
+		t.Fatalf("ListBySA() ошибка: %v", err)
+	}
+	if len(got) != 2 || got[0].Scope != "files:write" || got[1].Scope != "files:read" {
+		t.Fatalf("ListBySA() = %d grants, порядок не сохранён", len(got))
+	}
+	if len(got[0].SEIDs) != 1 || got[0].SEIDs[0] != seID || got[0].RetentionPolicies[0] != "temporary" {
+		t.Errorf("grant[0] = %+v", got[0])
+	}
+	if got[1].SELabels["zone"] != "dc1" || !got[1].OwnFilesOnly || len(got[1].SEIDs) != 0 {
+		t.Errorf("grant[1] = %+v", got[1])
+	}
+
+	all, err := repo.ListAll(ctx)
+	if err != nil {
+		t.Fatalf("ListAll() ошибка: %v", err)
+	}
+	if len(all[sa.ID]) != 2 {
+		t.Errorf("ListAll()[sa] = %d grants, хотели 2", len(all[sa.ID]))
+	}
+
+	// Пустой список снимает ограничения
+	if err := repo.Replace(ctx, sa.ID, nil); err != nil {
+		t.Fatalf("Replace(nil) ошибка: %v", err)
+	}
+	got, err = repo.ListBySA(ctx, sa.ID)
+	if err != nil || len(got) != 0 {
+		t.Errorf("После Replace(nil) ListBySA() = %d, %v; хотели 0", len(got), err)
+	}
+}
+
+func TestFileListGrantsFilter(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	seRepo := NewStorageElementRepository(pool)
+	fileRepo := NewFileRegistryRepository(pool)
+
+	se := &model.StorageElement{
+		ID: uuid.New().String(), Name: "se-grants", URL: "http://se-grants:8010",
+		StorageID: "se-grants", Mode: "edit", Status: "online",
+	}
+	if err := seRepo.Create(ctx, se); err != nil {
+		t.Fatalf("Create SE ошибка: %v", err)
+	}
+
+	for i, f := range []struct{ by, retention string }{
+		{"sa_ingest", "temporary"}, {"sa_ingest", "permanent"}, {"sa_other", "temporary"},
+	} {
+		err := fileRepo.Register(ctx, &model.FileRecord{
+			FileID: uuid.New().String(), OriginalFilename: fmt.Sprintf("f%d.txt", i), ContentType: "text/plain",
+			Size: 1, Checksum: "sha256", StorageElementID: se.ID, UploadedBy: f.by,
+			UploadedAt: time.Now().UTC(), Status: "active", RetentionPolicy: f.retention,
+		})
+		if err != nil {
+			t.Fatalf("Register() ошибка: %v", err)
+		}
+	}
+
+	count := func(filters FileListFilters) int {
+		t.Helper()
+		n, err := fileRepo.Count(ctx, filters)
+		if err != nil {
+			t.Fatalf("Count() ошибка: %v", err)
+		}
+		return n
+	}
+
+	subjects := []string{"kc-sub", "sa_ingest"}
+	own := []grants.Grant{{Scope: grants.ScopeFilesRead, Own: true}}
+	if n := count(FileListFilters{Grants: own, GrantSubjects: subjects}); n != 2 {
+		t.Errorf("own: %d файлов, хотели 2", n)
+	}
+
+	// OR между grants, AND внутри grant
+	mixed := []grants.Grant{
+		{Scope: grants.ScopeFilesRead, Own: true, Retention: []string{"permanent"}},
+		{Scope: grants.ScopeFilesRead, SE: []string{se.ID}, Retention: []string{"temporary"}},
+	}
+	if n := count(FileListFilters{Grants: mixed, GrantSubjects: subjects}); n != 3 {
+		t.Errorf("mixed: %d файлов, хотели 3", n)
+	}
+
+	none := []grants.Grant{{Scope: grants.ScopeFilesRead, SE: []string{}}}
+	if n := count(FileListFilters{Grants: none, GrantSubjects: subjects}); n != 0 {
+		t.Errorf("пустой список SE: %d файлов, хотели 0", n)
+	}
+}
+
+func TestFileListSearchFilters(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	seRepo := NewStorageElementRepository(pool)
+	fileRepo := NewFileRegistryRepository(pool)
+
+	se := &model.StorageElement{
+		ID: uuid.New().String(), Name: "se-search", URL: "http://se-search:8010",
+		StorageID: "se-search", Mode: "edit", Status: "online",
+	}
+	if err := seRepo.Create(ctx, se); err != nil {
+		t.Fatalf("Create SE ошибка: %v", err)
+	}
+
+	base := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	for i, f := range []struct {
+		name, ct, by string
+		size         int64
+		tags         []string
+	}{
+		{"Report_2026.PDF", "application/pdf", "alice", 100, []string{"finance", "q1"}},
+		{"photo.png", "image/png", "bob", 2000, []string{"q1"}},
+		{"photo_100%.jpg", "image/jpeg", "alice", 5000, nil},
+		{"notes.txt", "text/plain", "bob", 10, []string{"finance"}},
+	} {
+		err := fileRepo.Register(ctx, &model.FileRecord{
+			FileID: uuid.New().String(), OriginalFilename: f.name, ContentType: f.ct,
+			Size: f.size, Checksum: "sha256", StorageElementID: se.ID, UploadedBy: f.by,
+			UploadedAt: base.AddDate(0, 0, i), Tags: f.tags, Status: "active", RetentionPolicy: "permanent",
+		})
+		if err != nil {
+			t.Fatalf("Register() ошибка: %v", err)
+		}
+	}
+
+	str := func(s string) *string { return &s }
+	i64 := func(v int64) *int64 { return &v }
+	tm := func(v time.Time) *time.Time { return &v }
+
+	tests := []struct {
+		name    string
+		filters FileListFilters
+		want    int
+	}{
+		{"подстрока имени без учёта регистра", FileListFilters{Filename: str("report")}, 1},
+		{"спецсимволы LIKE экранируются", FileListFilters{Filename: str("100%")}, 1},
+		{"подчёркивание не wildcard", FileListFilters{Filename: str("o_o")}, 0},
+		{"группа content_type", FileListFilters{ContentType: str("image")}, 2},
+		{"точный content_type", FileListFilters{ContentType: str("image/png")}, 1},
+		{"группа не совпадает с префиксом слова", FileListFilters{ContentType: str("imag")}, 0},
+		{"загрузивший", FileListFilters{UploadedBy: str("alice")}, 2},
+		{"все теги", FileListFilters{Tags: []string{"finance", "q1"}}, 1},
+		{"диапазон размера", FileListFilters{MinSize: i64(100), MaxSize: i64(2000)}, 2},
+		{"диапазон дат", FileListFilters{UploadedAfter: tm(base.AddDate(0, 0, 1)), UploadedBefore: tm(base.AddDate(0, 0, 2))}, 2},
+		{"комбинация", FileListFilters{Filename: str("photo"), UploadedBy: str("alice")}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, err := fileRepo.Count(ctx, tt.filters)
+			if err != nil {
+				t.Fatalf("Count() ошибка: %v", err)
+			}
+			if total != tt.want {
+				t.Errorf("Count() = %d, хотели %d", total, tt.want)
+			}
+
+			// Страница содержит только подходящие файлы
+			list, err := fileRepo.List(ctx, tt.filters, 1, 0)
+			if err != nil {
+				t.Fatalf("List() ошибка: %v", err)
+			}
+			if want := min(tt.want, 1); len(list) != want {
+				t.Errorf("List() вернул %d файлов, хотели %d", len(list), want)
+			}
+		})
+	}
+}
+
+// --- Тесты FileBatchRepository ---
+
+func TestFileBatchOperations(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	seRepo := NewStorageElementRepository(pool)
+	fileRepo := NewFileRegistryRepository(pool)
+	repo := NewFileBatchRepository(pool)
+
+	se := &model.StorageElement{
+		ID: uuid.New().String(), Name: "se-batch", URL: "http://se-batch:8010",
+		StorageID: "se-batch", Mode: "edit", Status: "online",
+	}
+	if err := seRepo.Create(ctx, se); err != nil {
+		t.Fatalf("Create SE ошибка: %v", err)
+	}
+
+	base := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	var fileIDs []string
+	for i, ct := range []string{"image/png", "image/jpeg", "text/plain"} {
+		id := uuid.New().String()
+		err := fileRepo.Register(ctx, &model.FileRecord{
+			FileID: id, OriginalFilename: fmt.Sprintf("batch-%d", i), ContentType: ct,
+			Size: 10, Checksum: "sha256", StorageElementID: se.ID, UploadedBy: "alice",
+			UploadedAt: base.AddDate(0, 0, i), Status: "active", RetentionPolicy: "permanent",
+		})
+		if err != nil {
+			t.Fatalf("Register() ошибка: %v", err)
+		}
+		fileIDs = append(fileIDs, id)
+	}
+
+	user := "admin"
+	op := &model.FileBatchOperation{
+		ID: uuid.New().String(), Action: model.FileBatchActionAddTags, Tags: []string{"batch"},
+		Status: model.FileBatchQueued, RequestedBy: &user,
+	}
+	if err := repo.Create(ctx, op); err != nil {
+		t.Fatalf("Create() ошибка: %v", err)
+	}
+
+	// Набор по фильтру: только изображения, новые первыми
+	image := "image"
+	n, err := repo.AddFiles(ctx, op.ID, FileListFilters{ContentType: &image}, 10)
+	if err != nil {
+		t.Fatalf("AddFiles() ошибка: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("AddFiles() = %d, хотели 2", n)
+	}
+
+	// Отсутствующий файл и уже попавший в набор
+	missing := uuid.New().String()
+	n, err = repo.AddMissing(ctx, op.ID, []string{missing, fileIDs[0]}, "не найден")
+	if err != nil {
+		t.Fatalf("AddMissing() ошибка: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("AddMissing() = %d, хотели 1", n)
+	}
+
+	queued, err := repo.NextQueued(ctx, op.ID, 10)
+	if err != nil {
+		t.Fatalf("NextQueued() ошибка: %v", err)
+	}
+	if len(queued) != 2 || queued[0] != fileIDs[1] || queued[1] != fileIDs[0] {
+		t.Errorf("NextQueued() = %v, хотели [%s %s]", queued, fileIDs[1], fileIDs[0])
+	}
+
+	if err := repo.SetResult(ctx, &model.FileBatchResult{
+		OperationID: op.ID, FileID: queued[0], Status: model.FileBatchResultSucceeded,
+	}); err != nil {
+		t.Fatalf("SetResult() ошибка: %v", err)
+	}
+	if err := repo.SetResult(ctx, &model.FileBatchResult{
+		OperationID: op.ID, FileID: uuid.New().String(), Status: model.FileBatchResultSucceeded,
+	}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("SetResult(чужой файл) = %v, хотели ErrNotFound", err)
+	}
+
+	// Update: операция выполняется
+	started := time.Now().UTC()
+	op.Status, op.Total, op.Processed, op.Succeeded, op.Failed = model.FileBatchRunning, 3, 2, 1, 1
+	op.StartedAt = &started
+	if err := repo.Update(ctx, op); err != nil {
+		t.Fatalf("Update() ошибка: %v", err)
+	}
+	got, err := repo.GetByID(ctx, op.ID)
+	if err != nil {
+		t.Fatalf("GetByID() ошибка: %v", err)
+	}
+	if got.Status != model.FileBatchRunning || got.Processed != 2 || len(got.Tags) != 1 || got.Finished() {
+		t.Errorf("GetByID() = %+v", got)
+	}
+	if _, err := repo.GetByID(ctx, uuid.New().String()); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByID(несуществующий) = %v, хотели ErrNotFound", err)
+	}
+
+	// FailUnfinished завершает операцию и отменяет необработанные файлы
+	n, err = repo.FailUnfinished(ctx, "прервано перезапуском")
+	if err != nil {
+		t.Fatalf("FailUnfinished() ошибка: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("FailUnfinished() = %d, хотели 1", n)
+	}
+	got, err = repo.GetByID(ctx, op.ID)
+	if err != nil {
+		t.Fatalf("GetByID() ошибка: %v", err)
+	}
+	if got.Status != model.FileBatchFailed || got.CompletedAt == nil || got.DurationMs == nil {
+		t.Errorf("после FailUnfinished: %+v", got)
+	}
+
+	for status, want := range map[string]int{
+		model.FileBatchResultSucceeded: 1,
+		model.FileBatchResultFailed:    1,
+		model.FileBatchResultCancelled: 1,
+		model.FileBatchResultQueued:    0,
+	} {
+		count, err := repo.CountResults(ctx, op.ID, &status)
+		if err != nil {
+			t.Fatalf("CountResults(%s) ошибка: %v", status, err)
+		}
+		if count != want {
+			t.Errorf("CountResults(%s) = %d, хотели %d", status, count, want)
+		}
+	}
+
+	results, err := repo.ListResults(ctx, op.ID, nil, 10, 0)
+	if err != nil {
+		t.Fatalf("ListResults() ошибка: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("ListResults() = %d результатов, хотели 3", len(results))
+	}
+	// Отсутствующие файлы (position 0) идут первыми
+	if results[0].FileID != missing || results[0].Error == nil {
+		t.Errorf("ListResults()[0] = %+v, хотели отсутствующий файл с ошибкой", results[0])
+	}
+}
+
 // --- Тесты RoleOverrideRepository ---
 
 func TestRoleOverrideCRUD(t *testing.T) {
@@ -375,13 +837,14 @@ func TestFileRegistryCRUD(t *testing.T) {
 	}
 }
 
-// --- Тесты BatchUpsert и MarkDeletedExcept ---
+// --- Тесты BatchUpsert и MarkDeletedUnseen ---
 
 func TestBatchUpsertAndMarkDeleted(t *testing.T) {
 	pool := setupTestDB(t)
 	ctx := context.Background()
 	seRepo := NewStorageElementRepository(pool)
 	fileRepo := NewFileRegistryRepository(pool)
+	cpRepo := NewSyncCheckpointRepository(pool)
 
 	seID := uuid.New().String()
 	se := &model.StorageElement{
@@ -424,14 +887,26 @@ func TestBatchUpsertAndMarkDeleted(t *testing.T) {
 		t.Errorf("Повторный BatchUpsert: added=%d, updated=%d; хотели added=0, updated=1", added2, updated2)
 	}
 
-	// MarkDeletedExcept — оставляем только первые 2 файла
-	existingIDs := []string{files[0].FileID, files[1].FileID}
-	marked, err := fileRepo.MarkDeletedExcept(ctx, seID, existingIDs)
+	// Upsert без изменений не перезаписывает запись
+	_, updated3, err := fileRepo.BatchUpsert(ctx, files)
+	if err != nil {
+		t.Fatalf("BatchUpsert() без изменений ошибка: %v", err)
+	}
+	if updated3 != 0 {
+		t.Errorf("BatchUpsert без изменений: updated=%d, хотели 0", updated3)
+	}
+
+	// MarkDeletedUnseen — на SE найдены только первые 2 файла
+	runID := uuid.New().String()
+	if err := cpRepo.AddSeen(ctx, runID, []string{files[0].FileID, files[1].FileID}); err != nil {
+		t.Fatalf("AddSeen() ошибка: %v", err)
+	}
+	marked, err := fileRepo.MarkDeletedUnseen(ctx, seID, runID)
 	if err != nil {
-		t.Fatalf("MarkDeletedExcept() ошибка: %v", err)
+		t.Fatalf("MarkDeletedUnseen() ошибка: %v", err)
 	}
 	if marked != 1 {
-		t.Errorf("MarkDeletedExcept помечено %d, хотели 1", marked)
+		t.Errorf("MarkDeletedUnseen помечено %d, хотели 1", marked)
 	}
 
 	// Проверяем, что третий файл помечен как deleted
@@ -441,6 +916,157 @@ func TestBatchUpsertAndMarkDeleted(t *testing.T) {
 	}
 }
 
+// --- Тесты FileReplicaRepository ---
+
+func TestFileReplicas(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	seRepo := NewStorageElementRepository(pool)
+	fileRepo := NewFileRegistryRepository(pool)
+	replicaRepo := NewFileReplicaRepository(pool)
+
+	primaryID := uuid.New().String()
+	replicaSEID := uuid.New().String()
+	for i, id := range []string{primaryID, replicaSEID} {
+		se := &model.StorageElement{
+			ID: id, Name: fmt.Sprintf("replica-se-%d", i), URL: fmt.Sprintf("https://replica-se-%d.example.com", i),
+			StorageID: fmt.Sprintf("storage-replica-%d", i), Mode: "rw", Status: "online",
+			CapacityBytes: 1073741824,
+		}
+		if err := seRepo.Create(ctx, se); err != nil {
+			t.Fatalf("Создание SE: %v", err)
+		}
+	}
+
+	f := &model.FileRecord{
+		FileID: uuid.New().String(), OriginalFilename: "replica.txt", ContentType: "text/plain",
+		Size: 100, Checksum: "sha256:r1", StorageElementID: primaryID,
+		UploadedBy: "ingester", UploadedAt: time.Now().UTC(), Status: "active", RetentionPolicy: "permanent",
+	}
+	if err := fileRepo.Register(ctx, f); err != nil {
+		t.Fatalf("Register() ошибка: %v", err)
+	}
+
+	// Файл без реплик — в выборке при factor=2
+	under, err := replicaRepo.ListUnderReplicated(ctx, 2, 100)
+	if err != nil {
+		t.Fatalf("ListUnderReplicated() ошибка: %v", err)
+	}
+	if len(under) != 1 || under[0].FileID != f.FileID {
+		t.Fatalf("ListUnderReplicated = %d файлов, хотели 1", len(under))
+	}
+
+	// Upsert synced-реплики — файл выходит из выборки
+	now := time.Now().UTC()
+	rep := &model.FileReplica{
+		FileID: f.FileID, StorageElementID: replicaSEID,
+		Status: model.ReplicaStatusSynced, ReplicatedAt: &now,
+	}
+	if err := replicaRepo.Upsert(ctx, rep); err != nil {
+		t.Fatalf("Upsert() ошибка: %v", err)
+	}
+	under, _ = replicaRepo.ListUnderReplicated(ctx, 2, 100)
+	if len(under) != 0 {
+		t.Errorf("ListUnderReplicated после реплики = %d, хотели 0", len(under))
+	}
+
+	// Sync replica SE: метаданные реплики не перезаписывают основную запись
+	copyRec := *f
+	copyRec.StorageElementID = replicaSEID
+	copyRec.OriginalFilename = "renamed.txt"
+	added, updated, err := fileRepo.BatchUpsert(ctx, []*model.FileRecord{&copyRec})
+	if err != nil {
+		t.Fatalf("BatchUpsert() реплики ошибка: %v", err)
+	}
+	if added != 0 || updated != 0 {
+		t.Errorf("BatchUpsert реплики: added=%d, updated=%d; хотели 0, 0", added, updated)
+	}
+	got, _ := fileRepo.GetByID(ctx, f.FileID)
+	if got.StorageElementID != primaryID || got.OriginalFilename != "replica.txt" {
+		t.Errorf("Основная запись изменена sync реплики: se=%s, name=%s", got.StorageElementID, got.OriginalFilename)
+	}
+
+	// DeleteMissing без файла на SE (пустой staging) — реплика потеряна
+	lost, err := replicaRepo.DeleteMissing(ctx, replicaSEID, uuid.New().String())
+	if err != nil {
+		t.Fatalf("DeleteMissing() ошибка: %v", err)
+	}
+	if lost != 1 {
+		t.Errorf("DeleteMissing = %d, хотели 1", lost)
+	}
+	replicas, _ := replicaRepo.ListByFiles(ctx, []string{f.FileID})
+	if len(replicas) != 0 {
+		t.Errorf("ListByFiles после потери = %d, хотели 0", len(replicas))
+	}
+
+	// Failed-реплика, найденная на SE, подтверждается
+	msg := "timeout"
+	failed := &model.FileReplica{
+		FileID: f.FileID, StorageElementID: replicaSEID,
+		Status: model.ReplicaStatusFailed, LastError: &msg, Attempts: 1,
+	}
+	if err := replicaRepo.Upsert(ctx, failed); err != nil {
+		t.Fatalf("Upsert() failed-реплики ошибка: %v", err)
+	}
+	confirmed, err := replicaRepo.ConfirmFound(ctx, replicaSEID, []string{f.FileID})
+	if err != nil {
+		t.Fatalf("ConfirmFound() ошибка: %v", err)
+	}
+	if confirmed != 1 {
+		t.Errorf("ConfirmFound = %d, хотели 1", confirmed)
+	}
+	replicas, _ = replicaRepo.ListByFiles(ctx, []string{f.FileID})
+	if len(replicas) != 1 || replicas[0].Status != model.ReplicaStatusSynced || replicas[0].LastError != nil {
+		t.Errorf("Реплика после сверки: %+v", replicas)
+	}
+
+	// Failed-реплика с отложенным повтором исключает файл из выборки до next_attempt_at
+	if _, err := replicaRepo.DeleteMissing(ctx, replicaSEID, uuid.New().String()); err != nil {
+		t.Fatalf("DeleteMissing() ошибка: %v", err)
+	}
+	later := time.Now().UTC().Add(time.Hour)
+	failed.NextAttemptAt = &later
+	if err := replicaRepo.Upsert(ctx, failed); err != nil {
+		t.Fatalf("Upsert() failed-реплики ошибка: %v", err)
+	}
+	under, _ = replicaRepo.ListUnderReplicated(ctx, 2, 100)
+	if len(under) != 0 {
+		t.Errorf("ListUnderReplicated до next_attempt_at = %d, хотели 0", len(under))
+	}
+	earlier := time.Now().UTC().Add(-time.Minute)
+	failed.NextAttemptAt = &earlier
+	if err := replicaRepo.Upsert(ctx, failed); err != nil {
+		t.Fatalf("Upsert() failed-реплики ошибка: %v", err)
+	}
+	under, _ = replicaRepo.ListUnderReplicated(ctx, 2, 100)
+	if len(under) != 1 {
+		t.Errorf("ListUnderReplicated после next_attempt_at = %d, хотели 1", len(under))
+	}
+
+	// Отложенная репликация (нет целевых SE) исключает файл до next_attempt_at
+	d := &model.ReplicationDeferral{
+		FileID: f.FileID, Reason: model.ReplicationDeferNoTargets, Attempts: 1, NextAttemptAt: later,
+	}
+	if err := replicaRepo.Defer(ctx, d); err != nil {
+		t.Fatalf("Defer() ошибка: %v", err)
+	}
+	deferrals, err := replicaRepo.ListDeferrals(ctx, []string{f.FileID})
+	if err != nil || len(deferrals) != 1 || deferrals[0].Attempts != 1 {
+		t.Fatalf("ListDeferrals() = %+v, %v", deferrals, err)
+	}
+	under, _ = replicaRepo.ListUnderReplicated(ctx, 2, 100)
+	if len(under) != 0 {
+		t.Errorf("ListUnderReplicated с отложенной репликацией = %d, хотели 0", len(under))
+	}
+	if err := replicaRepo.ClearDeferral(ctx, f.FileID); err != nil {
+		t.Fatalf("ClearDeferral() ошибка: %v", err)
+	}
+	under, _ = replicaRepo.ListUnderReplicated(ctx, 2, 100)
+	if len(under) != 1 {
+		t.Errorf("ListUnderReplicated после ClearDeferral = %d, хотели 1", len(under))
+	}
+}
+
 // --- Тесты SyncStateRepository ---
 
 func TestSyncState(t *testing.T) {
@@ -481,3 +1107,919 @@ func TestSyncState(t *testing.T) {
 		t.Errorf("LastFileSyncAt = %v, хотели %v", state3.LastFileSyncAt, now)
 	}
 }
+
+// --- AuditEventRepository ---
+
+func TestAuditEvents(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	repo := NewAuditEventRepository(pool)
+
+	ip := "203.0.113.7"
+	events := []*model.AuditEvent{
+		{
+			ActorID: "user-1", ActorType: model.AuditActorUser, ActorName: "alice",
+			Action: model.AuditActionSACreate, TargetType: model.AuditTargetServiceAccount, TargetID: "sa-1",
+			After: []byte(`{"name":"sa_backup"}`), SourceIP: &ip,
+		},
+		{
+			ActorID: "user-1", ActorType: model.AuditActorUser, ActorName: "alice",
+			Action: model.AuditActionSAUpdate, TargetType: model.AuditTargetServiceAccount, TargetID: "sa-1",
+			Before: []byte(`{"status":"active"}`), After: []byte(`{"status":"suspended"}`),
+		},
+		{
+			ActorID: "sa-uuid", ActorType: model.AuditActorServiceAccount, ActorName: "sa_ingest",
+			Action: model.AuditActionFileRegister, TargetType: model.AuditTargetFile, TargetID: "file-1",
+		},
+	}
+	for _, e := range events {
+		if err := repo.Insert(ctx, e); err != nil {
+			t.Fatalf("Insert() ошибка: %v", err)
+		}
+		if e.ID == 0 || e.OccurredAt.IsZero() {
+			t.Errorf("Insert() не заполнил ID/OccurredAt: %+v", e)
+		}
+	}
+
+	// Фильтр по actor_name
+	actor := "alice"
+	list, err := repo.List(ctx, AuditEventFilters{Actor: &actor}, 10, 0)
+	if err != nil {
+		t.Fatalf("List() ошибка: %v", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("List(actor=alice) = %d, хотели 2", len(list))
+	}
+	// Новые первыми
+	if list[0].Action != model.AuditActionSAUpdate {
+		t.Errorf("первое событие = %s, хотели %s", list[0].Action, model.AuditActionSAUpdate)
+	}
+	if string(list[0].Before) != `{"status": "active"}` {
+		t.Errorf("Before = %s", list[0].Before)
+	}
+
+	targetType := model.AuditTargetFile
+	count, err := repo.Count(ctx, AuditEventFilters{TargetType: &targetType})
+	if err != nil {
+		t.Fatalf("Count() ошибка: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Count(target_type=file) = %d, хотели 1", count)
+	}
+
+	// Журнал append-only: UPDATE и DELETE запрещены триггером
+	if _, err := pool.Exec(ctx, "UPDATE audit_events SET action = 'x'"); err == nil {
+		t.Error("UPDATE audit_events должен завершаться ошибкой")
+	}
+	if _, err := pool.Exec(ctx, "DELETE FROM audit_events"); err == nil {
+		t.Error("DELETE FROM audit_events должен завершаться ошибкой")
+	}
+}
+
+// --- Тесты SyncRunRepository ---
+
+func TestSyncRuns(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	seRepo := NewStorageElementRepository(pool)
+	repo := NewSyncRunRepository(pool)
+
+	seID := uuid.New().String()
+	if err := seRepo.Create(ctx, &model.StorageElement{
+		ID: seID, Name: "se-sync", URL: "https://se-sync.example.com",
+		StorageID: "storage-sync", Mode: "rw", Status: "online",
+	}); err != nil {
+		t.Fatalf("Create SE ошибка: %v", err)
+	}
+
+	user := "admin"
+	manual := &model.SyncRun{
+		ID: uuid.New().String(), StorageElementID: seID,
+		Trigger: model.SyncTriggerManual, Status: model.SyncRunQueued, RequestedBy: &user,
+	}
+	periodic := &model.SyncRun{
+		ID: uuid.New().String(), StorageElementID: seID,
+		Trigger: model.SyncTriggerPeriodic, Status: model.SyncRunQueued,
+	}
+	for _, run := range []*model.SyncRun{manual, periodic} {
+		if err := repo.Create(ctx, run); err != nil {
+			t.Fatalf("Create() ошибка: %v", err)
+		}
+		if run.CreatedAt.IsZero() {
+			t.Error("CreatedAt не установлен")
+		}
+	}
+
+	// Update: задача выполнена
+	started := time.Now().UTC().Add(-time.Second)
+	completed := time.Now().UTC()
+	duration := completed.Sub(started).Milliseconds()
+	manual.Status = model.SyncRunSucceeded
+	manual.FilesOnSE, manual.FilesProcessed, manual.FilesAdded = 10, 10, 7
+	manual.StartedAt, manual.CompletedAt, manual.DurationMs = &started, &completed, &duration
+	if err := repo.Update(ctx, manual); err != nil {
+		t.Fatalf("Update() ошибка: %v", err)
+	}
+
+	got, err := repo.GetByID(ctx, manual.ID)
+	if err != nil {
+		t.Fatalf("GetByID() ошибка: %v", err)
+	}
+	if got.Status != model.SyncRunSucceeded || got.FilesAdded != 7 || !got.Finished() {
+		t.Errorf("GetByID() = %+v", got)
+	}
+	if got.RequestedBy == nil || *got.RequestedBy != "admin" {
+		t.Errorf("RequestedBy = %v, хотели admin", got.RequestedBy)
+	}
+
+	if _, err := repo.GetByID(ctx, uuid.New().String()); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByID(несуществующий) = %v, хотели ErrNotFound", err)
+	}
+
+	// Фильтр по trigger
+	trigger := model.SyncTriggerPeriodic
+	list, err := repo.List(ctx, SyncRunFilters{Trigger: &trigger}, 10, 0)
+	if err != nil {
+		t.Fatalf("List() ошибка: %v", err)
+	}
+	if len(list) != 1 || list[0].ID != periodic.ID {
+		t.Errorf("List(trigger=periodic) = %d задач, хотели 1", len(list))
+	}
+
+	// FailUnfinished завершает только незавершённые задачи
+	n, err := repo.FailUnfinished(ctx, "прервано перезапуском")
+	if err != nil {
+		t.Fatalf("FailUnfinished() ошибка: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("FailUnfinished() = %d, хотели 1", n)
+	}
+
+	status := model.SyncRunFailed
+	count, err := repo.Count(ctx, SyncRunFilters{StorageElementID: &seID, Status: &status})
+	if err != nil {
+		t.Fatalf("Count() ошибка: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Count(status=failed) = %d, хотели 1", count)
+	}
+}
+
+// --- Тесты SyncCheckpointRepository ---
+
+func TestSyncCheckpoints(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	seRepo := NewStorageElementRepository(pool)
+	repo := NewSyncCheckpointRepository(pool)
+
+	seID := uuid.New().String()
+	if err := seRepo.Create(ctx, &model.StorageElement{
+		ID: seID, Name: "se-cp", URL: "https://se-cp.example.com",
+		StorageID: "storage-cp", Mode: "rw", Status: "online",
+	}); err != nil {
+		t.Fatalf("Create SE ошибка: %v", err)
+	}
+
+	if _, err := repo.Get(ctx, seID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(без курсора) = %v, хотели ErrNotFound", err)
+	}
+
+	cursor := time.Now().UTC().Add(-time.Hour).Truncate(time.Microsecond)
+	full := time.Now().UTC().Truncate(time.Microsecond)
+	cp := &model.SyncCheckpoint{StorageElementID: seID, FilesCursor: &cursor, LastFullSyncAt: &full}
+	if err := repo.Save(ctx, cp); err != nil {
+		t.Fatalf("Save() ошибка: %v", err)
+	}
+
+	// Повторное сохранение обновляет курсор
+	next := cursor.Add(time.Minute)
+	cp.FilesCursor = &next
+	if err := repo.Save(ctx, cp); err != nil {
+		t.Fatalf("Save() повторный ошибка: %v", err)
+	}
+
+	got, err := repo.Get(ctx, seID)
+	if err != nil {
+		t.Fatalf("Get() ошибка: %v", err)
+	}
+	if got.FilesCursor == nil || !got.FilesCursor.Equal(next) {
+		t.Errorf("FilesCursor = %v, хотели %v", got.FilesCursor, next)
+	}
+	if got.LastFullSyncAt == nil || !got.LastFullSyncAt.Equal(full) {
+		t.Errorf("LastFullSyncAt = %v, хотели %v", got.LastFullSyncAt, full)
+	}
+
+	// Staging: повторные ID не дублируются, ClearSeen удаляет записи задачи
+	runID := uuid.New().String()
+	fileID := uuid.New().String()
+	for range 2 {
+		if err := repo.AddSeen(ctx, runID, []string{fileID}); err != nil {
+			t.Fatalf("AddSeen() ошибка: %v", err)
+		}
+	}
+	var n int
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM sync_seen_files WHERE run_id = $1`, runID).Scan(&n); err != nil {
+		t.Fatalf("COUNT sync_seen_files: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("sync_seen_files = %d, хотели 1", n)
+	}
+
+	if err := repo.ClearSeen(ctx, runID); err != nil {
+		t.Fatalf("ClearSeen() ошибка: %v", err)
+	}
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM sync_seen_files WHERE run_id = $1`, runID).Scan(&n); err != nil {
+		t.Fatalf("COUNT sync_seen_files: %v", err)
+	}
+	if n != 0 {
+		t.Errorf("sync_seen_files после ClearSeen = %d, хотели 0", n)
+	}
+}
+
+// --- Тесты SEWriteRepository ---
+
+func TestSEWriteOutbox(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	seRepo := NewStorageElementRepository(pool)
+	fileRepo := NewFileRegistryRepository(pool)
+	repo := NewSEWriteRepository(pool)
+
+	seID := uuid.New().String()
+	if err := seRepo.Create(ctx, &model.StorageElement{
+		ID: seID, Name: "se-outbox", URL: "https://se-outbox.example.com",
+		StorageID: "storage-outbox", Mode: "edit", Status: "online",
+	}); err != nil {
+		t.Fatalf("Create SE ошибка: %v", err)
+	}
+
+	f := &model.FileRecord{
+		FileID: uuid.New().String(), OriginalFilename: "o.txt", ContentType: "text/plain",
+		Size: 10, Checksum: "sha256:o", StorageElementID: seID,
+		UploadedBy: "ingester", UploadedAt: time.Now().UTC(), Status: "active", RetentionPolicy: "permanent",
+	}
+	if _, _, err := fileRepo.BatchUpsert(ctx, []*model.FileRecord{f}); err != nil {
+		t.Fatalf("BatchUpsert() ошибка: %v", err)
+	}
+
+	desc := "локальная правка"
+	first := &model.SEWrite{
+		ID: uuid.New().String(), FileID: f.FileID, StorageElementID: seID,
+		Operation: model.SEWriteOpUpdate, Description: &desc, Tags: []string{"a"},
+		Status: model.SEWriteStatusPending, NextAttemptAt: time.Now().UTC().Add(-time.Second),
+	}
+	if err := repo.Enqueue(ctx, first); err != nil {
+		t.Fatalf("Enqueue() ошибка: %v", err)
+	}
+
+	// Пока изменение в outbox, синхронизация не перезаписывает запись реестра
+	synced := *f
+	synced.Size = 20
+	if _, updated, err := fileRepo.BatchUpsert(ctx, []*model.FileRecord{&synced}); err != nil || updated != 0 {
+		t.Errorf("BatchUpsert при pending-изменении: updated=%d, err=%v; хотели 0, nil", updated, err)
+	}
+
+	due, err := repo.ListDue(ctx, 10)
+	if err != nil {
+		t.Fatalf("ListDue() ошибка: %v", err)
+	}
+	if len(due) != 1 || due[0].ID != first.ID || len(due[0].Tags) != 1 {
+		t.Fatalf("ListDue() = %+v, хотели одно изменение %s", due, first.ID)
+	}
+
+	// Повторная постановка заменяет изменение; Complete по старому ID его не удаляет
+	second := *first
+	second.ID = uuid.New().String()
+	second.Operation = model.SEWriteOpDelete
+	if err := repo.Enqueue(ctx, &second); err != nil {
+		t.Fatalf("Enqueue() повторный ошибка: %v", err)
+	}
+	if err := repo.Complete(ctx, first.ID); err != nil {
+		t.Fatalf("Complete() ошибка: %v", err)
+	}
+	pending, err := repo.ListByFiles(ctx, []string{f.FileID})
+	if err != nil {
+		t.Fatalf("ListByFiles() ошибка: %v", err)
+	}
+	if len(pending) != 1 || pending[0].ID != second.ID || pending[0].Operation != model.SEWriteOpDelete {
+		t.Fatalf("ListByFiles() = %+v, хотели delete %s", pending, second.ID)
+	}
+
+	// Retry откладывает попытку
+	msg := "connection refused"
+	second.Attempts = 1
+	second.LastError = &msg
+	second.NextAttemptAt = time.Now().UTC().Add(time.Hour)
+	if err := repo.Retry(ctx, &second); err != nil {
+		t.Fatalf("Retry() ошибка: %v", err)
+	}
+	if due, _ := repo.ListDue(ctx, 10); len(due) != 0 {
+		t.Errorf("ListDue() после Retry = %d, хотели 0", len(due))
+	}
+
+	// Fail: изменение остаётся видимым, но не повторяется
+	if err := repo.Fail(ctx, second.ID, "MODE_NOT_ALLOWED"); err != nil {
+		t.Fatalf("Fail() ошибка: %v", err)
+	}
+	pending, _ = repo.ListByFiles(ctx, []string{f.FileID})
+	if len(pending) != 1 || pending[0].Status != model.SEWriteStatusFailed || pending[0].Attempts != 2 {
+		t.Errorf("После Fail() = %+v, хотели failed, attempts=2", pending)
+	}
+
+	if err := repo.DeleteByFile(ctx, f.FileID); err != nil {
+		t.Fatalf("DeleteByFile() ошибка: %v", err)
+	}
+	if pending, _ := repo.ListByFiles(ctx, []string{f.FileID}); len(pending) != 0 {
+		t.Errorf("ListByFiles() после DeleteByFile = %d, хотели 0", len(pending))
+	}
+}
+
+// --- Тесты CapacitySnapshotRepository ---
+
+func TestCapacitySnapshots(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	seRepo := NewStorageElementRepository(pool)
+	repo := NewCapacitySnapshotRepository(pool)
+
+	seIDs := []string{uuid.New().String(), uuid.New().String()}
+	for i, id := range seIDs {
+		if err := seRepo.Create(ctx, &model.StorageElement{
+			ID: id, Name: fmt.Sprintf("se-cap-%d", i), URL: fmt.Sprintf("https://se-cap-%d.example.com", i),
+			StorageID: fmt.Sprintf("storage-cap-%d", i), Mode: "rw", Status: "online",
+		}); err != nil {
+			t.Fatalf("Create SE ошибка: %v", err)
+		}
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	avail := int64(600)
+	for _, snap := range []*model.CapacitySnapshot{
+		{StorageElementID: seIDs[0], CapturedAt: now.Add(-48 * time.Hour), CapacityBytes: 1000, UsedBytes: 200},
+		{StorageElementID: seIDs[0], CapturedAt: now.Add(-time.Hour), CapacityBytes: 1000, UsedBytes: 400, AvailableBytes: &avail},
+		{StorageElementID: seIDs[1], CapturedAt: now, CapacityBytes: 2000, UsedBytes: 100},
+	} {
+		if err := repo.Create(ctx, snap); err != nil {
+			t.Fatalf("Create() ошибка: %v", err)
+		}
+	}
+
+	all, err := repo.ListSince(ctx, "", now.Add(-72*time.Hour))
+	if err != nil {
+		t.Fatalf("ListSince(все) ошибка: %v", err)
+	}
+	if len(all) != 3 || !all[0].CapturedAt.Before(all[2].CapturedAt) {
+		t.Fatalf("ListSince(все) = %d снимков, хотели 3 по возрастанию времени", len(all))
+	}
+
+	bySE, err := repo.ListSince(ctx, seIDs[0], now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("ListSince(SE) ошибка: %v", err)
+	}
+	if len(bySE) != 1 || bySE[0].UsedBytes != 400 {
+		t.Fatalf("ListSince(SE) = %+v, хотели один снимок used=400", bySE)
+	}
+	if bySE[0].AvailableBytes == nil || *bySE[0].AvailableBytes != avail {
+		t.Errorf("AvailableBytes = %v, хотели %d", bySE[0].AvailableBytes, avail)
+	}
+
+	deleted, err := repo.DeleteBefore(ctx, now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("DeleteBefore() ошибка: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("DeleteBefore() = %d, хотели 1", deleted)
+	}
+}
+
+// --- Тесты оповещений и webhooks ---
+
+func TestAlertingRepositories(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	ruleRepo := NewAlertRuleRepository(pool)
+	stateRepo := NewAlertStateRepository(pool)
+	endpointRepo := NewWebhookEndpointRepository(pool)
+	deliveryRepo := NewWebhookDeliveryRepository(pool)
+
+	ep := &model.WebhookEndpoint{
+		ID: uuid.New().String(), Name: "ops", URL: "https://hooks.example.com/ops",
+		Secret: "0123456789abcdef", Enabled: true,
+	}
+	if err := endpointRepo.Create(ctx, ep); err != nil {
+		t.Fatalf("Create webhook ошибка: %v", err)
+	}
+	dup := *ep
+	dup.ID = uuid.New().String()
+	if err := endpointRepo.Create(ctx, &dup); !errors.Is(err, ErrConflict) {
+		t.Errorf("Create дубликата = %v, хотели ErrConflict", err)
+	}
+
+	threshold := 80.0
+	rule := &model.AlertRule{
+		ID: uuid.New().String(), Name: "capacity-80", Type: model.AlertRuleCapacity,
+		Threshold: &threshold, Enabled: true,
+	}
+	if err := ruleRepo.Create(ctx, rule); err != nil {
+		t.Fatalf("Create правила ошибка: %v", err)
+	}
+	if err := ruleRepo.SetEndpoints(ctx, rule.ID, []string{ep.ID}); err != nil {
+		t.Fatalf("SetEndpoints ошибка: %v", err)
+	}
+	got, err := ruleRepo.GetByID(ctx, rule.ID)
+	if err != nil {
+		t.Fatalf("GetByID правила ошибка: %v", err)
+	}
+	if got.Threshold == nil || *got.Threshold != threshold || len(got.EndpointIDs) != 1 || got.EndpointIDs[0] != ep.ID {
+		t.Errorf("GetByID = %+v, хотели порог 80 и один webhook", got)
+	}
+
+	// Состояния оповещений
+	if err := stateRepo.Create(ctx, &model.Alert{
+		RuleID: rule.ID, Subject: "se-1", SubjectName: "SE 1", Message: "занято 85%",
+	}); err != nil {
+		t.Fatalf("Create состояния ошибка: %v", err)
+	}
+	alerts, err := stateRepo.List(ctx)
+	if err != nil {
+		t.Fatalf("List состояний ошибка: %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].RuleName != rule.Name || alerts[0].RuleType != rule.Type {
+		t.Fatalf("List состояний = %+v, хотели одно оповещение правила %s", alerts, rule.Name)
+	}
+	if err := stateRepo.Delete(ctx, rule.ID, "se-1"); err != nil {
+		t.Fatalf("Delete состояния ошибка: %v", err)
+	}
+
+	// Очередь доставок
+	d := &model.WebhookDelivery{
+		ID: uuid.New().String(), EndpointID: ep.ID, RuleID: &rule.ID, Event: model.WebhookEventFiring,
+		Payload: []byte(`{"event":"alert.firing"}`), Status: model.WebhookDeliveryPending,
+		NextAttemptAt: time.Now().UTC().Add(-time.Second),
+	}
+	if err := deliveryRepo.Create(ctx, d); err != nil {
+		t.Fatalf("Create доставки ошибка: %v", err)
+	}
+	due, err := deliveryRepo.ListDue(ctx, 10)
+	if err != nil || len(due) != 1 {
+		t.Fatalf("ListDue = %d, %v; хотели 1 доставку", len(due), err)
+	}
+
+	status := 204
+	now := time.Now().UTC()
+	d.Status = model.WebhookDeliveryDelivered
+	d.Attempts = 1
+	d.ResponseStatus = &status
+	d.DeliveredAt = &now
+	if err := deliveryRepo.Save(ctx, d); err != nil {
+		t.Fatalf("Save доставки ошибка: %v", err)
+	}
+	delivered := model.WebhookDeliveryDelivered
+	filters := WebhookDeliveryFilters{EndpointID: &ep.ID, Status: &delivered}
+	count, err := deliveryRepo.Count(ctx, filters)
+	if err != nil || count != 1 {
+		t.Errorf("Count(delivered) = %d, %v; хотели 1", count, err)
+	}
+	list, err := deliveryRepo.List(ctx, filters, 10, 0)
+	if err != nil || len(list) != 1 || list[0].ResponseStatus == nil || *list[0].ResponseStatus != status {
+		t.Errorf("List(delivered) = %+v, %v", list, err)
+	}
+
+	// Удаление endpoint каскадно удаляет его доставки и связи с правилами
+	if err := endpointRepo.Delete(ctx, ep.ID); err != nil {
+		t.Fatalf("Delete webhook ошибка: %v", err)
+	}
+	if count, _ := deliveryRepo.Count(ctx, WebhookDeliveryFilters{}); count != 0 {
+		t.Errorf("после удаления webhook осталось %d доставок", count)
+	}
+	if got, _ := ruleRepo.GetByID(ctx, rule.ID); got == nil || len(got.EndpointIDs) != 0 {
+		t.Errorf("после удаления webhook у правила остались endpoints: %+v", got)
+	}
+	if err := ruleRepo.Delete(ctx, rule.ID); err != nil {
+		t.Fatalf("Delete правила ошибка: %v", err)
+	}
+	if _, err := ruleRepo.GetByID(ctx, rule.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByID после удаления = %v, хотели ErrNotFound", err)
+	}
+}
+
+// --- Тесты ReconcileReportRepository ---
+
+func TestReconcileReports(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	seRepo := NewStorageElementRepository(pool)
+	fileRepo := NewFileRegistryRepository(pool)
+	repo := NewReconcileReportRepository(pool)
+
+	se := &model.StorageElement{
+		ID: uuid.New().String(), Name: "se-reconcile", URL: "http://se-reconcile:8010",
+		StorageID: "se-reconcile", Mode: "rw", Status: "online",
+	}
+	if err := seRepo.Create(ctx, se); err != nil {
+		t.Fatalf("Create SE ошибка: %v", err)
+	}
+
+	registered := uuid.New().String()
+	err := fileRepo.Register(ctx, &model.FileRecord{
+		FileID: registered, OriginalFilename: "photo.jpg", ContentType: "image/jpeg",
+		Size: 10, Checksum: "sha256", StorageElementID: se.ID, UploadedBy: "alice",
+		UploadedAt: time.Now().UTC(), Status: "active", RetentionPolicy: "permanent",
+	})
+	if err != nil {
+		t.Fatalf("Register() ошибка: %v", err)
+	}
+
+	var ids []string
+	for range 3 {
+		rep := &model.ReconcileReport{
+			ID: uuid.New().String(), StorageElementID: se.ID, Status: model.ReconcileRunning,
+		}
+		if err := repo.Create(ctx, rep); err != nil {
+			t.Fatalf("Create() ошибка: %v", err)
+		}
+		ids = append(ids, rep.ID)
+	}
+
+	// Итог последней сверки с проблемами: файл из реестра и файл без attr.json
+	unknown := uuid.New().String()
+	path := "data/orphan.bin"
+	completed := time.Now().UTC()
+	last := &model.ReconcileReport{
+		ID: ids[2], StorageElementID: se.ID, Status: model.ReconcileSucceeded,
+		FilesChecked: 5, FilesOK: 3, MissingFiles: 1, OrphanedFiles: 1, IssuesTotal: 3,
+		CompletedAt: &completed,
+	}
+	issues := []*model.ReconcileIssue{
+		{Type: model.ReconcileIssueMissingFile, FileID: &registered, Description: "нет файла"},
+		{Type: model.ReconcileIssueOrphanedFile, Path: &path, Description: "нет attr.json"},
+		{Type: model.ReconcileIssueChecksumMismatch, FileID: &unknown, Description: "checksum"},
+	}
+	if err := repo.Complete(ctx, last, issues); err != nil {
+		t.Fatalf("Complete() ошибка: %v", err)
+	}
+	if last.DurationMs == nil {
+		t.Error("Complete() не заполнил DurationMs")
+	}
+
+	got, err := repo.ListIssues(ctx, last.ID, nil, 10)
+	if err != nil {
+		t.Fatalf("ListIssues() ошибка: %v", err)
+	}
+	if len(got) != 3 || got[0].Position != 1 || got[1].Path == nil || *got[1].Path != path {
+		t.Fatalf("ListIssues() = %+v", got)
+	}
+	if got[0].RegistryFilename == nil || *got[0].RegistryFilename != "photo.jpg" || got[2].RegistryFilename != nil {
+		t.Errorf("ListIssues(): неверная связь с реестром: %+v, %+v", got[0], got[2])
+	}
+	orphaned := model.ReconcileIssueOrphanedFile
+	if got, _ := repo.ListIssues(ctx, last.ID, &orphaned, 10); len(got) != 1 {
+		t.Errorf("ListIssues(orphaned_file) = %d, хотели 1", len(got))
+	}
+
+	// FailUnfinished завершает две выполняющиеся сверки
+	n, err := repo.FailUnfinished(ctx, "прервано перезапуском")
+	if err != nil {
+		t.Fatalf("FailUnfinished() ошибка: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("FailUnfinished() = %d, хотели 2", n)
+	}
+
+	// Prune оставляет последний отчёт
+	n, err = repo.Prune(ctx, se.ID, 1)
+	if err != nil {
+		t.Fatalf("Prune() ошибка: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Prune() = %d, хотели 2", n)
+	}
+	list, err := repo.ListBySE(ctx, se.ID, 10)
+	if err != nil {
+		t.Fatalf("ListBySE() ошибка: %v", err)
+	}
+	if len(list) != 1 || list[0].ID != last.ID || list[0].FilesChecked != 5 || !list[0].Finished() {
+		t.Errorf("ListBySE() после Prune = %+v", list)
+	}
+	if _, err := repo.GetByID(ctx, ids[0]); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByID(удалённый) = %v, хотели ErrNotFound", err)
+	}
+}
+
+func TestUISessions(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	repo := NewUISessionRepository(pool)
+
+	newSession := func(subject, keyID string, hash byte) *model.UISession {
+		s := &model.UISession{
+			ID: uuid.New().String(), TokenHash: []byte{hash}, KeyID: keyID,
+			Subject: subject, Username: subject, IdpRole: "readonly", Role: "admin",
+			Tokens: "encrypted", RemoteAddr: "10.0.0.1", UserAgent: "test",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		if err := repo.Create(ctx, s); err != nil {
+			t.Fatalf("Create() ошибка: %v", err)
+		}
+		return s
+	}
+	alice1 := newSession("alice", "key1", 1)
+	alice2 := newSession("alice", "key1", 2)
+	bob := newSession("bob", "key1", 3)
+	stale := newSession("carol", "key0", 4)
+
+	got, err := repo.GetByTokenHash(ctx, []byte{1})
+	if err != nil || got.ID != alice1.ID || got.Groups == nil {
+		t.Fatalf("GetByTokenHash() = %+v, %v", got, err)
+	}
+	if _, err := repo.GetByTokenHash(ctx, []byte{9}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByTokenHash(неизвестный) = %v, хотели ErrNotFound", err)
+	}
+
+	if err := repo.UpdateTokens(ctx, bob.ID, "refreshed"); err != nil {
+		t.Fatalf("UpdateTokens() ошибка: %v", err)
+	}
+	if got, _ := repo.GetByID(ctx, bob.ID); got.Tokens != "refreshed" {
+		t.Errorf("Tokens после UpdateTokens = %q", got.Tokens)
+	}
+
+	subject := "alice"
+	list, err := repo.List(ctx, &subject, time.Time{})
+	if err != nil || len(list) != 2 {
+		t.Fatalf("List(alice) = %d, %v", len(list), err)
+	}
+
+	// Сессия bob неактивна с прошлого часа и удаляется вместе с сессией старого ключа
+	if err := repo.Touch(ctx, bob.ID, time.Now().Add(-2*time.Hour)); err != nil {
+		t.Fatalf("Touch() ошибка: %v", err)
+	}
+	n, err := repo.DeleteStale(ctx, "key1", time.Now().Add(-time.Hour))
+	if err != nil || n != 2 {
+		t.Fatalf("DeleteStale() = %d, %v, хотели 2", n, err)
+	}
+	if _, err := repo.GetByID(ctx, stale.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByID(старый ключ) = %v, хотели ErrNotFound", err)
+	}
+
+	if n, err := repo.DeleteByIDs(ctx, []string{alice2.ID}); err != nil || n != 1 {
+		t.Errorf("DeleteByIDs() = %d, %v", n, err)
+	}
+	if n, err := repo.DeleteBySubject(ctx, "alice"); err != nil || n != 1 {
+		t.Errorf("DeleteBySubject() = %d, %v", n, err)
+	}
+	if err := repo.Delete(ctx, alice1.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Delete(удалённый) = %v, хотели ErrNotFound", err)
+	}
+}
+
+func TestPersonalTokens(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	repo := NewPersonalTokenRepository(pool)
+
+	newToken := func(subject, name string, hash byte, expiresAt time.Time) *model.PersonalToken {
+		pt := &model.PersonalToken{
+			ID: uuid.New().String(), TokenHash: []byte{hash}, TokenPrefix: "amp_abcd",
+			Subject: subject, Username: subject, Name: name,
+			Scopes: []string{"files:read"}, ExpiresAt: expiresAt,
+		}
+		if err := repo.Create(ctx, pt); err != nil {
+			t.Fatalf("Create() ошибка: %v", err)
+		}
+		return pt
+	}
+	now := time.Now()
+	ci := newToken("alice", "ci", 1, now.Add(time.Hour))
+	newToken("alice", "old", 2, now.Add(-time.Hour))
+	bob := newToken("bob", "ci", 3, now.Add(time.Hour))
+
+	dup := &model.PersonalToken{
+		ID: uuid.New().String(), TokenHash: []byte{4}, Subject: "alice", Username: "alice",
+		Name: "ci", Scopes: []string{}, ExpiresAt: now.Add(time.Hour),
+	}
+	if err := repo.Create(ctx, dup); !errors.Is(err, ErrConflict) {
+		t.Errorf("Create(дубликат названия) = %v, хотели ErrConflict", err)
+	}
+
+	got, err := repo.GetByTokenHash(ctx, []byte{1})
+	if err != nil || got.ID != ci.ID || len(got.Scopes) != 1 || got.LastUsedAt != nil {
+		t.Fatalf("GetByTokenHash() = %+v, %v", got, err)
+	}
+	if _, err := repo.GetByTokenHash(ctx, []byte{9}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByTokenHash(неизвестный) = %v, хотели ErrNotFound", err)
+	}
+
+	// Повторное использование в течение минуты не перезаписывает last_used_at
+	if err := repo.Touch(ctx, ci.ID, now, "10.0.0.1"); err != nil {
+		t.Fatalf("Touch() ошибка: %v", err)
+	}
+	if err := repo.Touch(ctx, ci.ID, now.Add(time.Second), "10.0.0.2"); err != nil {
+		t.Fatalf("Touch() ошибка: %v", err)
+	}
+	if got, _ := repo.GetByID(ctx, ci.ID); got.LastUsedAt == nil || got.LastUsedIP != "10.0.0.1" {
+		t.Errorf("после Touch() = %v, %q", got.LastUsedAt, got.LastUsedIP)
+	}
+
+	subject := "alice"
+	if list, err := repo.List(ctx, &subject); err != nil || len(list) != 2 {
+		t.Fatalf("List(alice) = %d, %v", len(list), err)
+	}
+	if n, err := repo.CountActive(ctx, "alice", now); err != nil || n != 1 {
+		t.Errorf("CountActive(alice) = %d, %v, хотели 1", n, err)
+	}
+
+	if n, err := repo.DeleteExpired(ctx, now); err != nil || n != 1 {
+		t.Errorf("DeleteExpired() = %d, %v, хотели 1", n, err)
+	}
+	if err := repo.Delete(ctx, bob.ID); err != nil {
+		t.Errorf("Delete() ошибка: %v", err)
+	}
+	if n, err := repo.DeleteBySubject(ctx, "alice"); err != nil || n != 1 {
+		t.Errorf("DeleteBySubject() = %d, %v", n, err)
+	}
+	if list, err := repo.List(ctx, nil); err != nil || len(list) != 0 {
+		t.Errorf("List() после удаления = %d, %v", len(list), err)
+	}
+}
+
+// --- Тесты RoleRepository ---
+
+func TestRoles(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	repo := NewRoleRepository(pool)
+	roRepo := NewRoleOverrideRepository(pool)
+
+	// Миграция создаёт типовые роли
+	seeded, err := repo.List(ctx)
+	if err != nil || len(seeded) != 2 {
+		t.Fatalf("List() = %d, %v, хотели 2 типовые роли", len(seeded), err)
+	}
+
+	role := &model.Role{
+		Name: "auditor", Description: "Журнал аудита",
+		Permissions: []string{"audit:read"}, CreatedBy: "admin",
+	}
+	if err := repo.Create(ctx, role); err != nil {
+		t.Fatalf("Create() ошибка: %v", err)
+	}
+	if err := repo.Create(ctx, &model.Role{Name: "auditor"}); !errors.Is(err, ErrConflict) {
+		t.Errorf("Create(дубликат) = %v, хотели ErrConflict", err)
+	}
+
+	role.Groups = []string{"auditors"}
+	role.Permissions = append(role.Permissions, "files:read")
+	if err := repo.Update(ctx, role); err != nil {
+		t.Fatalf("Update() ошибка: %v", err)
+	}
+	got, err := repo.GetByName(ctx, "auditor")
+	if err != nil || len(got.Permissions) != 2 || len(got.Groups) != 1 || got.CreatedBy != "admin" {
+		t.Fatalf("GetByName() = %+v, %v", got, err)
+	}
+	if err := repo.Update(ctx, &model.Role{Name: "missing"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Update(неизвестная) = %v, хотели ErrNotFound", err)
+	}
+
+	// Override на пользовательскую роль (ограничение admin/readonly снято)
+	ro := &model.RoleOverride{
+		KeycloakUserID: "kc-user-001", Username: "alice", AdditionalRole: "auditor", CreatedBy: "admin",
+	}
+	if err := roRepo.Upsert(ctx, ro); err != nil {
+		t.Fatalf("Upsert(auditor) ошибка: %v", err)
+	}
+	if n, err := roRepo.CountByRole(ctx, "auditor"); err != nil || n != 1 {
+		t.Errorf("CountByRole(auditor) = %d, %v, хотели 1", n, err)
+	}
+
+	if err := repo.Delete(ctx, "auditor"); err != nil {
+		t.Fatalf("Delete() ошибка: %v", err)
+	}
+	if _, err := repo.GetByName(ctx, "auditor"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByName() после Delete = %v, хотели ErrNotFound", err)
+	}
+}
+
+func TestCollections(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+	repo := NewCollectionRepository(pool)
+	seRepo := NewStorageElementRepository(pool)
+	fileRepo := NewFileRegistryRepository(pool)
+
+	quota := int64(10)
+	c := &model.Collection{
+		ID: uuid.New().String(), Name: "marketing", Description: "Маркетинг",
+		QuotaBytes: &quota, CreatedBy: "admin",
+	}
+	if err := repo.Create(ctx, c); err != nil {
+		t.Fatalf("Create() ошибка: %v", err)
+	}
+	if err := repo.Create(ctx, &model.Collection{ID: uuid.New().String(), Name: "marketing"}); !errors.Is(err, ErrConflict) {
+		t.Errorf("Create(дубликат) = %v, хотели ErrConflict", err)
+	}
+
+	// Участники: права заменяются при повторном UpsertMember
+	alice := model.CollectionSubject{Type: model.CollectionMemberUser, ID: "alice"}
+	for _, perms := range [][]string{{"read"}, {"read", "write"}} {
+		err := repo.UpsertMember(ctx, &model.CollectionMember{
+			CollectionID: c.ID, SubjectType: alice.Type, SubjectID: alice.ID, Permissions: perms, AddedBy: "admin",
+		})
+		if err != nil {
+			t.Fatalf("UpsertMember() ошибка: %v", err)
+		}
+	}
+	if perms, err := repo.MemberPermissions(ctx, c.ID, alice); err != nil || len(perms) != 2 {
+		t.Errorf("MemberPermissions(alice) = %v, %v, хотели [read write]", perms, err)
+	}
+	bob := model.CollectionSubject{Type: model.CollectionMemberUser, ID: "bob"}
+	if perms, err := repo.MemberPermissions(ctx, c.ID, bob); err != nil || len(perms) != 0 {
+		t.Errorf("MemberPermissions(bob) = %v, %v, хотели пустой список", perms, err)
+	}
+
+	// Коллекции SA с правом write (для claim artstore_collections)
+	for clientID, perms := range map[string][]string{"sa_ingest": {"read", "write"}, "sa_reports": {"read"}} {
+		err := repo.UpsertMember(ctx, &model.CollectionMember{
+			CollectionID: c.ID, SubjectType: model.CollectionMemberServiceAccount, SubjectID: clientID,
+			Permissions: perms, AddedBy: "admin",
+		})
+		if err != nil {
+			t.Fatalf("UpsertMember(%s) ошибка: %v", clientID, err)
+		}
+	}
+	saCollections, err := repo.ServiceAccountCollections(ctx, model.CollectionPermWrite)
+	if err != nil {
+		t.Fatalf("ServiceAccountCollections() ошибка: %v", err)
+	}
+	if len(saCollections) != 1 || len(saCollections["sa_ingest"]) != 1 || saCollections["sa_ingest"][0] != c.ID {
+		t.Errorf("ServiceAccountCollections() = %v, хотели только sa_ingest → %s", saCollections, c.ID)
+	}
+	for _, clientID := range []string{"sa_ingest", "sa_reports"} {
+		if err := repo.DeleteMember(ctx, c.ID, model.CollectionMemberServiceAccount, clientID); err != nil {
+			t.Fatalf("DeleteMember(%s) ошибка: %v", clientID, err)
+		}
+	}
+
+	// Файл коллекции и общий файл: использование квоты и видимость
+	se := &model.StorageElement{
+		ID: uuid.New().String(), Name: "se-collections", URL: "http://se-collections:8010",
+		StorageID: "se-collections", Mode: "edit", Status: "online",
+	}
+	if err := seRepo.Create(ctx, se); err != nil {
+		t.Fatalf("Create SE ошибка: %v", err)
+	}
+	for i, collectionID := range []*string{&c.ID, nil} {
+		err := fileRepo.Register(ctx, &model.FileRecord{
+			FileID: uuid.New().String(), OriginalFilename: fmt.Sprintf("c%d.txt", i), ContentType: "text/plain",
+			Size: 4, Checksum: "sha256", StorageElementID: se.ID, UploadedBy: "alice",
+			UploadedAt: time.Now().UTC(), Status: "active", RetentionPolicy: "permanent",
+			CollectionID: collectionID,
+		})
+		if err != nil {
+			t.Fatalf("Register() ошибка: %v", err)
+		}
+	}
+
+	got, err := repo.GetByID(ctx, c.ID)
+	if err != nil || got.UsedBytes != 4 || got.UsedFiles != 1 || got.MemberCount != 1 {
+		t.Fatalf("GetByID() = %+v, %v", got, err)
+	}
+
+	count := func(filters FileListFilters) int {
+		t.Helper()
+		n, err := fileRepo.Count(ctx, filters)
+		if err != nil {
+			t.Fatalf("Count() ошибка: %v", err)
+		}
+		return n
+	}
+	if n := count(FileListFilters{Collections: &model.CollectionScope{Subject: alice, Permission: "read"}}); n != 2 {
+		t.Errorf("Count(alice, read) = %d, хотели 2", n)
+	}
+	if n := count(FileListFilters{Collections: &model.CollectionScope{Subject: alice, Permission: "delete"}}); n != 1 {
+		t.Errorf("Count(alice, delete) = %d, хотели 1 (только общий файл)", n)
+	}
+	if n := count(FileListFilters{Collections: &model.CollectionScope{Subject: bob, Permission: "read"}}); n != 1 {
+		t.Errorf("Count(bob) = %d, хотели 1 (только общий файл)", n)
+	}
+	if n := count(FileListFilters{CollectionID: &c.ID}); n != 1 {
+		t.Errorf("Count(collection_id) = %d, хотели 1", n)
+	}
+
+	if list, err := repo.List(ctx, &model.CollectionScope{Subject: bob, Permission: "read"}); err != nil || len(list) != 0 {
+		t.Errorf("List(bob) = %d, %v, хотели 0", len(list), err)
+	}
+
+	if err := repo.DeleteMember(ctx, c.ID, alice.Type, alice.ID); err != nil {
+		t.Fatalf("DeleteMember() ошибка: %v", err)
+	}
+	if err := repo.DeleteMember(ctx, c.ID, alice.Type, alice.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("DeleteMember(повторно) = %v, хотели ErrNotFound", err)
+	}
+	if err := repo.Delete(ctx, c.ID); err != nil {
+		t.Fatalf("Delete() ошибка: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, c.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByID() после Delete = %v, хотели ErrNotFound", err)
+	}
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// RoleRepository — интерфейс CRUD для таблицы roles (пользовательские роли).
+type RoleRepository interface {
+	// Create сохраняет новую роль. Заполняет CreatedAt и UpdatedAt.
+	// ErrConflict — роль с таким именем уже существует.
+	Create(ctx context.Context, role *model.Role) error
+	// GetByName возвращает роль по имени.
+	GetByName(ctx context.Context, name string) (*model.Role, error)
+	// List возвращает все роли, упорядоченные по имени.
+	List(ctx context.Context) ([]*model.Role, error)
+	// Update обновляет описание, разрешения и группы роли. Заполняет UpdatedAt.
+	Update(ctx context.Context, role *model.Role) error
+	// Delete удаляет роль по имени.
+	Delete(ctx context.Context, name string) error
+}
+
+// roleRepo — реализация RoleRepository.
+type roleRepo struct {
+	db DBTX
+}
+
+// NewRoleRepository создаёт репозиторий пользовательских ролей.
+func NewRoleRepository(db DBTX) RoleRepository {
+	return &roleRepo{db: db}
+}
+
+// roleColumns — список колонок roles в порядке scanRole.
+const roleColumns = `name, description, permissions, groups, created_by, created_at, updated_at`
+
+// scanRole сканирует строку roles.
+func scanRole(row pgx.Row) (*model.Role, error) {
+	role := &model.Role{}
+	err := row.Scan(
+		&role.Name, &role.Description, &role.Permissions, &role.Groups,
+		&role.CreatedBy, &role.CreatedAt, &role.UpdatedAt,
+	)
+	return role, err
+}
+
+// roleArrays возвращает разрешения и группы роли, заменяя nil пустыми
+// массивами (колонки NOT NULL).
+func roleArrays(role *model.Role) ([]string, []string) {
+	permissions, groups := role.Permissions, role.Groups
+	if permissions == nil {
+		permissions = []string{}
+	}
+	if groups == nil {
+		groups = []string{}
+	}
+	return permissions, groups
+}
+
+func (r *roleRepo) Create(ctx context.Context, role *model.Role) error {
+	permissions, groups := roleArrays(role)
+
+	query := `
+		INSERT INTO roles (name, description, permissions, groups, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		role.Name, role.Description, permissions, groups, role.CreatedBy,
+	).Scan(&role.CreatedAt, &role.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: роль с таким именем уже существует", ErrConflict)
+		}
+		return fmt.Errorf("ошибка создания роли: %w", err)
+	}
+	return nil
+}
+
+func (r *roleRepo) GetByName(ctx context.Context, name string) (*model.Role, error) {
+	query := "SELECT " + roleColumns + " FROM roles WHERE name = $1"
+
+	role, err := scanRole(r.db.QueryRow(ctx, query, name))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения роли: %w", err)
+	}
+	return role, nil
+}
+
+func (r *roleRepo) List(ctx context.Context) ([]*model.Role, error) {
+	rows, err := r.db.Query(ctx, "SELECT "+roleColumns+" FROM roles ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения списка ролей: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.Role
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования роли: %w", err)
+		}
+		result = append(result, role)
+	}
+	return result, rows.Err()
+}
+
+func (r *roleRepo) Update(ctx context.Context, role *model.Role) error {
+	permissions, groups := roleArrays(role)
+
+	query := `
+		UPDATE roles SET description = $2, permissions = $3, groups = $4
+		WHERE name = $1
+		RETURNING created_by, created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		role.Name, role.Description, permissions, groups,
+	).Scan(&role.CreatedBy, &role.CreatedAt, &role.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("ошибка обновления роли: %w", err)
+	}
+	return nil
+}
+
+func (r *roleRepo) Delete(ctx context.Context, name string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM roles WHERE name = $1`, name)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления роли: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -22,6 +22,8 @@ type RoleOverrideRepository interface {
 	List(ctx context.Context, limit, offset int) ([]*model.RoleOverride, error)
 	// Count возвращает количество overrides.
 	Count(ctx context.Context) (int, error)
+	// CountByRole возвращает количество overrides на указанную роль.
+	CountByRole(ctx context.Context, role string) (int, error)
 }
 
 // roleOverrideRepo — реализация RoleOverrideRepository.
@@ -118,3 +120,14 @@ func (r *roleOverrideRepo) Count(ctx context.Context) (int, error) {
 	}
 	return count, nil
 }
+
+func (r *roleOverrideRepo) CountByRole(ctx context.Context, role string) (int, error) {
+	var count int
+	err := r.db.QueryRow(ctx,
+		`SELECT COUNT(*) FROM role_overrides WHERE additional_role = $1`, role,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка подсчёта role overrides роли: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// SAGrantRepository — доступ к таблице sa_resource_grants.
+type SAGrantRepository interface {
+	// ListBySA возвращает grants SA в порядке создания.
+	ListBySA(ctx context.Context, saID string) ([]*model.ResourceGrant, error)
+	// ListAll возвращает grants всех SA, сгруппированные по ID SA.
+	ListAll(ctx context.Context) (map[string][]*model.ResourceGrant, error)
+	// Replace заменяет grants SA (вызывать в транзакции).
+	Replace(ctx context.Context, saID string, grants []*model.ResourceGrant) error
+}
+
+// saGrantRepo — реализация SAGrantRepository.
+type saGrantRepo struct {
+	db DBTX
+}
+
+// NewSAGrantRepository создаёт репозиторий grants SA.
+func NewSAGrantRepository(db DBTX) SAGrantRepository {
+	return &saGrantRepo{db: db}
+}
+
+const saGrantColumns = `id, service_account_id, scope, se_ids::text[], se_labels,
+	retention_policies, own_files_only, created_at`
+
+// scanSAGrant сканирует строку результата в модель ResourceGrant.
+func scanSAGrant(row pgx.Row) (*model.ResourceGrant, error) {
+	g := &model.ResourceGrant{}
+	err := row.Scan(
+		&g.ID, &g.ServiceAccountID, &g.Scope, &g.SEIDs, &g.SELabels,
+		&g.RetentionPolicies, &g.OwnFilesOnly, &g.CreatedAt,
+	)
+	return g, err
+}
+
+func (r *saGrantRepo) ListBySA(ctx context.Context, saID string) ([]*model.ResourceGrant, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+saGrantColumns+`
+		FROM sa_resource_grants
+		WHERE service_account_id = $1
+		ORDER BY position`, saID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения grants SA: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.ResourceGrant
+	for rows.Next() {
+		g, err := scanSAGrant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования grant SA: %w", err)
+		}
+		result = append(result, g)
+	}
+	return result, rows.Err()
+}
+
+func (r *saGrantRepo) ListAll(ctx context.Context) (map[string][]*model.ResourceGrant, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+saGrantColumns+`
+		FROM sa_resource_grants
+		ORDER BY service_account_id, position`)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения grants SA: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string][]*model.ResourceGrant)
+	for rows.Next() {
+		g, err := scanSAGrant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования grant SA: %w", err)
+		}
+		result[g.ServiceAccountID] = append(result[g.ServiceAccountID], g)
+	}
+	return result, rows.Err()
+}
+
+func (r *saGrantRepo) Replace(ctx context.Context, saID string, grants []*model.ResourceGrant) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM sa_resource_grants WHERE service_account_id = $1`, saID); err != nil {
+		return fmt.Errorf("ошибка удаления grants SA: %w", err)
+	}
+
+	for i, g := range grants {
+		seIDs := g.SEIDs
+		if seIDs == nil {
+			seIDs = []string{}
+		}
+		labels := g.SELabels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		retention := g.RetentionPolicies
+		if retention == nil {
+			retention = []string{}
+		}
+
+		err := r.db.QueryRow(ctx, `
+			INSERT INTO sa_resource_grants (id, service_account_id, scope, se_ids, se_labels,
+				retention_policies, own_files_only, position)
+			VALUES ($1, $2, $3, $4::uuid[], $5, $6, $7, $8)
+			RETURNING created_at`,
+			g.ID, saID, g.Scope, seIDs, labels, retention, g.OwnFilesOnly, i,
+		).Scan(&g.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("ошибка сохранения grant SA: %w", err)
+		}
+		g.ServiceAccountID = saID
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// SEWriteRepository — интерфейс для таблицы se_write_outbox.
+type SEWriteRepository interface {
+	// Enqueue сохраняет изменение файла; предыдущее изменение того же файла заменяется.
+	Enqueue(ctx context.Context, w *model.SEWrite) error
+	// ListByFiles возвращает изменения указанных файлов.
+	ListByFiles(ctx context.Context, fileIDs []string) ([]*model.SEWrite, error)
+	// ListDue возвращает pending-изменения, время попытки которых наступило.
+	ListDue(ctx context.Context, limit int) ([]*model.SEWrite, error)
+	// Complete удаляет применённое изменение (если его не заменило более новое).
+	Complete(ctx context.Context, id string) error
+	// Retry сохраняет результат неудачной попытки (attempts, last_error, next_attempt_at).
+	Retry(ctx context.Context, w *model.SEWrite) error
+	// Fail помечает изменение как отклонённое SE.
+	Fail(ctx context.Context, id, reason string) error
+	// DeleteByFile удаляет изменение файла (новое изменение применено на SE напрямую).
+	DeleteByFile(ctx context.Context, fileID string) error
+}
+
+// seWriteRepo — реализация SEWriteRepository.
+type seWriteRepo struct {
+	db DBTX
+}
+
+// NewSEWriteRepository создаёт репозиторий outbox записи на SE.
+func NewSEWriteRepository(db DBTX) SEWriteRepository {
+	return &seWriteRepo{db: db}
+}
+
+// seWriteColumns — список колонок для SELECT.
+const seWriteColumns = `id, file_id, storage_element_id, operation, description, tags,
+	status, attempts, last_error, next_attempt_at, created_at, updated_at`
+
+func (r *seWriteRepo) Enqueue(ctx context.Context, w *model.SEWrite) error {
+	query := `
+		INSERT INTO se_write_outbox (id, file_id, storage_element_id, operation, description, tags,
+			status, attempts, last_error, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6, 'pending', $7, $8, $9)
+		ON CONFLICT (file_id) DO UPDATE SET
+			id = EXCLUDED.id,
+			storage_element_id = EXCLUDED.storage_element_id,
+			operation = EXCLUDED.operation,
+			description = EXCLUDED.description,
+			tags = EXCLUDED.tags,
+			status = 'pending',
+			attempts = EXCLUDED.attempts,
+			last_error = EXCLUDED.last_error,
+			next_attempt_at = EXCLUDED.next_attempt_at,
+			created_at = NOW()
+		RETURNING status, created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query,
+		w.ID, w.FileID, w.StorageElementID, w.Operation, w.Description, w.Tags,
+		w.Attempts, w.LastError, w.NextAttemptAt,
+	).Scan(&w.Status, &w.CreatedAt, &w.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения изменения в outbox: %w", err)
+	}
+	return nil
+}
+
+func (r *seWriteRepo) ListByFiles(ctx context.Context, fileIDs []string) ([]*model.SEWrite, error) {
+	if len(fileIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `SELECT ` + seWriteColumns + `
+		FROM se_write_outbox
+		WHERE file_id = ANY($1)`
+
+	return r.query(ctx, query, fileIDs)
+}
+
+func (r *seWriteRepo) ListDue(ctx context.Context, limit int) ([]*model.SEWrite, error) {
+	query := `SELECT ` + seWriteColumns + `
+		FROM se_write_outbox
+		WHERE status = 'pending' AND next_attempt_at <= NOW()
+		ORDER BY next_attempt_at
+		LIMIT $1`
+
+	return r.query(ctx, query, limit)
+}
+
+func (r *seWriteRepo) Complete(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM se_write_outbox WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления применённого изменения: %w", err)
+	}
+	return nil
+}
+
+func (r *seWriteRepo) Retry(ctx context.Context, w *model.SEWrite) error {
+	query := `
+		UPDATE se_write_outbox
+		SET attempts = $2, last_error = $3, next_attempt_at = $4
+		WHERE id = $1`
+
+	_, err := r.db.Exec(ctx, query, w.ID, w.Attempts, w.LastError, w.NextAttemptAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения попытки изменения: %w", err)
+	}
+	return nil
+}
+
+func (r *seWriteRepo) Fail(ctx context.Context, id, reason string) error {
+	query := `
+		UPDATE se_write_outbox
+		SET status = 'failed', attempts = attempts + 1, last_error = $2
+		WHERE id = $1`
+
+	_, err := r.db.Exec(ctx, query, id, reason)
+	if err != nil {
+		return fmt.Errorf("ошибка пометки изменения как отклонённого: %w", err)
+	}
+	return nil
+}
+
+func (r *seWriteRepo) DeleteByFile(ctx context.Context, fileID string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM se_write_outbox WHERE file_id = $1`, fileID)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления изменения файла из outbox: %w", err)
+	}
+	return nil
+}
+
+// query выполняет SELECT и сканирует изменения.
+func (r *seWriteRepo) query(ctx context.Context, query string, args ...any) ([]*model.SEWrite, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения изменений outbox: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.SEWrite
+	for rows.Next() {
+		w := &model.SEWrite{}
+		if err := rows.Scan(
+			&w.ID, &w.FileID, &w.StorageElementID, &w.Operation, &w.Description, &w.Tags,
+			&w.Status, &w.Attempts, &w.LastError, &w.NextAttemptAt, &w.CreatedAt, &w.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования изменения outbox: %w", err)
+		}
+		result = append(result, w)
+	}
+	return result, rows.Err()
+}
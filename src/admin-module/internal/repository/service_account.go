@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -27,6 +28,11 @@ type ServiceAccountRepository interface {
 	Delete(ctx context.Context, id string) error
 	// Count возвращает количество SA.
 	Count(ctx context.Context, status *string) (int, error)
+	// ListActiveSecretIssuedBefore возвращает активные SA, секрет которых
+	// выдан раньше before (по возрастанию времени выдачи).
+	ListActiveSecretIssuedBefore(ctx context.Context, before time.Time) ([]*model.ServiceAccount, error)
+	// SetSecretIssued сохраняет время выдачи секрета и срок действия предыдущего.
+	SetSecretIssued(ctx context.Context, id string, issuedAt time.Time, previousExpiresAt *time.Time) error
 }
 
 // serviceAccountRepo — реализация ServiceAccountRepository.
@@ -45,25 +51,33 @@ func scanServiceAccount(row pgx.Row) (*model.ServiceAccount, error) {
 	err := row.Scan(
 		&sa.ID, &sa.KeycloakClientID, &sa.ClientID, &sa.Name, &sa.Description,
 		&sa.Scopes, &sa.Status, &sa.Source, &sa.LastSyncedAt,
+		&sa.SecretIssuedAt, &sa.PreviousSecretExpiresAt,
 		&sa.CreatedAt, &sa.UpdatedAt,
 	)
 	return sa, err
 }
 
 const saColumns = `id, keycloak_client_id, client_id, name, description,
-	scopes, status, source, last_synced_at, created_at, updated_at`
+	scopes, status, source, last_synced_at, secret_issued_at, previous_secret_expires_at,
+	created_at, updated_at`
 
 func (r *serviceAccountRepo) Create(ctx context.Context, sa *model.ServiceAccount) error {
 	query := `
 		INSERT INTO service_accounts (id, keycloak_client_id, client_id, name, description,
-			scopes, status, source, last_synced_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		RETURNING created_at, updated_at`
+			scopes, status, source, last_synced_at, secret_issued_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, NOW()))
+		RETURNING secret_issued_at, created_at, updated_at`
+
+	// Нулевое время выдачи секрета — момент создания записи
+	var issuedAt *time.Time
+	if !sa.SecretIssuedAt.IsZero() {
+		issuedAt = &sa.SecretIssuedAt
+	}
 
 	err := r.db.QueryRow(ctx, query,
 		sa.ID, sa.KeycloakClientID, sa.ClientID, sa.Name, sa.Description,
-		sa.Scopes, sa.Status, sa.Source, sa.LastSyncedAt,
-	).Scan(&sa.CreatedAt, &sa.UpdatedAt)
+		sa.Scopes, sa.Status, sa.Source, sa.LastSyncedAt, issuedAt,
+	).Scan(&sa.SecretIssuedAt, &sa.CreatedAt, &sa.UpdatedAt)
 	if err != nil {
 		if isUniqueViolation(err) {
 			return fmt.Errorf("%w: сервисный аккаунт с таким именем уже существует", ErrConflict)
@@ -128,14 +142,45 @@ func (r *serviceAccountRepo) List(ctx context.Context, status *string, limit, of
 	}
 	defer rows.Close()
 
+	return collectServiceAccounts(rows)
+}
+
+func (r *serviceAccountRepo) ListActiveSecretIssuedBefore(ctx context.Context, before time.Time) ([]*model.ServiceAccount, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM service_accounts
+		WHERE status = 'active' AND secret_issued_at < $1
+		ORDER BY secret_issued_at`, saColumns)
+
+	rows, err := r.db.Query(ctx, query, before)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения SA по сроку секрета: %w", err)
+	}
+	defer rows.Close()
+
+	return collectServiceAccounts(rows)
+}
+
+func (r *serviceAccountRepo) SetSecretIssued(ctx context.Context, id string, issuedAt time.Time, previousExpiresAt *time.Time) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE service_accounts
+		SET secret_issued_at = $2, previous_secret_expires_at = $3
+		WHERE id = $1`, id, issuedAt, previousExpiresAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения времени выдачи секрета SA: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// collectServiceAccounts сканирует строки результата в список SA.
+func collectServiceAccounts(rows pgx.Rows) ([]*model.ServiceAccount, error) {
 	var result []*model.ServiceAccount
 	for rows.Next() {
-		sa := &model.ServiceAccount{}
-		if err := rows.Scan(
-			&sa.ID, &sa.KeycloakClientID, &sa.ClientID, &sa.Name, &sa.Description,
-			&sa.Scopes, &sa.Status, &sa.Source, &sa.LastSyncedAt,
-			&sa.CreatedAt, &sa.UpdatedAt,
-		); err != nil {
+		sa, err := scanServiceAccount(rows)
+		if err != nil {
 			return nil, fmt.Errorf("ошибка сканирования SA: %w", err)
 		}
 		result = append(result, sa)
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// SigningKeyRepository — интерфейс для таблицы jwt_signing_keys.
+type SigningKeyRepository interface {
+	// List возвращает все ключи, позже активируемые первыми.
+	List(ctx context.Context) ([]*model.SigningKey, error)
+	// Create сохраняет ключ.
+	Create(ctx context.Context, k *model.SigningKey) error
+	// CreateIfNoneSince сохраняет ключ, только если нет ключей, созданных
+	// после since (нулевое since — только если таблица пуста).
+	// Возвращает false, если ключ уже был создан (другой репликой).
+	CreateIfNoneSince(ctx context.Context, k *model.SigningKey, since time.Time) (bool, error)
+	// UpdatePrivateKey заменяет закрытый ключ (при шифровании мастер-ключом).
+	UpdatePrivateKey(ctx context.Context, kid string, privateKey []byte, masterKeyID string) error
+	// DeleteRetired удаляет ключи, заменённые ключом, активированным до cutoff.
+	DeleteRetired(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+// signingKeyRepo — реализация SigningKeyRepository.
+type signingKeyRepo struct {
+	db DBTX
+}
+
+// NewSigningKeyRepository создаёт репозиторий ключей подписи JWT.
+func NewSigningKeyRepository(db DBTX) SigningKeyRepository {
+	return &signingKeyRepo{db: db}
+}
+
+func (r *signingKeyRepo) List(ctx context.Context) ([]*model.SigningKey, error) {
+	query := `
+		SELECT kid, algorithm, private_key, COALESCE(master_key_id, ''), created_at, activates_at
+		FROM jwt_signing_keys
+		ORDER BY activates_at DESC, created_at DESC, kid`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения ключей подписи: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.SigningKey
+	for rows.Next() {
+		k := &model.SigningKey{}
+		if err := rows.Scan(&k.KID, &k.Algorithm, &k.PrivateKey, &k.MasterKeyID, &k.CreatedAt, &k.ActivatesAt); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования ключа подписи: %w", err)
+		}
+		result = append(result, k)
+	}
+	return result, rows.Err()
+}
+
+func (r *signingKeyRepo) Create(ctx context.Context, k *model.SigningKey) error {
+	query := `
+		INSERT INTO jwt_signing_keys (kid, algorithm, private_key, master_key_id, activates_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5)`
+
+	if _, err := r.db.Exec(ctx, query, k.KID, k.Algorithm, k.PrivateKey, k.MasterKeyID, k.ActivatesAt); err != nil {
+		return fmt.Errorf("ошибка создания ключа подписи: %w", err)
+	}
+	return nil
+}
+
+func (r *signingKeyRepo) CreateIfNoneSince(ctx context.Context, k *model.SigningKey, since time.Time) (bool, error) {
+	query := `
+		INSERT INTO jwt_signing_keys (kid, algorithm, private_key, master_key_id, activates_at)
+		SELECT $1::varchar, $2::varchar, $3::bytea, NULLIF($4::varchar, ''), $5::timestamptz
+		WHERE NOT EXISTS (SELECT 1 FROM jwt_signing_keys WHERE created_at > $6::timestamptz)`
+
+	tag, err := r.db.Exec(ctx, query, k.KID, k.Algorithm, k.PrivateKey, k.MasterKeyID, k.ActivatesAt, since)
+	if err != nil {
+		return false, fmt.Errorf("ошибка создания ключа подписи: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *signingKeyRepo) UpdatePrivateKey(ctx context.Context, kid string, privateKey []byte, masterKeyID string) error {
+	query := `
+		UPDATE jwt_signing_keys
+		SET private_key = $2, master_key_id = NULLIF($3, '')
+		WHERE kid = $1`
+
+	tag, err := r.db.Exec(ctx, query, kid, privateKey, masterKeyID)
+	if err != nil {
+		return fmt.Errorf("ошибка обновления ключа подписи: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *signingKeyRepo) DeleteRetired(ctx context.Context, cutoff time.Time) (int, error) {
+	query := `
+		DELETE FROM jwt_signing_keys k
+		WHERE EXISTS (
+			SELECT 1 FROM jwt_signing_keys n
+			WHERE n.activates_at > k.activates_at AND n.activates_at <= $1
+		)`
+
+	tag, err := r.db.Exec(ctx, query, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления ключей подписи: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -17,16 +17,34 @@ type StorageElementRepository interface {
 	Create(ctx context.Context, se *model.StorageElement) error
 	// GetByID возвращает SE по UUID.
 	GetByID(ctx context.Context, id string) (*model.StorageElement, error)
-	// List возвращает список SE с фильтрацией по mode и status.
-	List(ctx context.Context, mode, status *string, limit, offset int) ([]*model.StorageElement, error)
+	// List возвращает список SE с фильтрацией по mode, status и меткам.
+	List(ctx context.Context, filters StorageElementFilters, limit, offset int) ([]*model.StorageElement, error)
 	// Update обновляет SE.
 	Update(ctx context.Context, se *model.StorageElement) error
 	// Delete удаляет SE из реестра.
 	Delete(ctx context.Context, id string) error
 	// Count возвращает количество SE с фильтрацией.
-	Count(ctx context.Context, mode, status *string) (int, error)
+	Count(ctx context.Context, filters StorageElementFilters) (int, error)
+	// SetLabels заменяет метки SE.
+	SetLabels(ctx context.Context, id string, labels map[string]string) error
+	// ListLabelPairs возвращает все используемые пары метка=значение, отсортированные.
+	ListLabelPairs(ctx context.Context) ([]string, error)
 }
 
+// StorageElementFilters — фильтры для списка SE.
+type StorageElementFilters struct {
+	Mode   *string
+	Status *string
+	// Labels — SE должен иметь все указанные метки с указанными значениями
+	Labels map[string]string
+	// IDs — только SE с указанными ID (grants SA); nil — любые SE
+	IDs []string
+}
+
+// seLabelsColumn — метки SE в виде JSON-объекта {ключ: значение}.
+const seLabelsColumn = `COALESCE((SELECT jsonb_object_agg(l.key, l.value)
+			FROM storage_element_labels l WHERE l.storage_element_id = storage_elements.id), '{}'::jsonb)`
+
 // storageElementRepo — реализация StorageElementRepository.
 type storageElementRepo struct {
 	db DBTX
@@ -62,7 +80,8 @@ func (r *storageElementRepo) GetByID(ctx context.Context, id string) (*model.Sto
 	query := `
 		SELECT id, name, url, storage_id, mode, status,
 			capacity_bytes, used_bytes, available_bytes,
-			last_sync_at, last_file_sync_at, created_at, updated_at
+			last_sync_at, last_file_sync_at, created_at, updated_at,
+			` + seLabelsColumn + `
 		FROM storage_elements
 		WHERE id = $1`
 
@@ -71,6 +90,7 @@ func (r *storageElementRepo) GetByID(ctx context.Context, id string) (*model.Sto
 		&se.ID, &se.Name, &se.URL, &se.StorageID, &se.Mode, &se.Status,
 		&se.CapacityBytes, &se.UsedBytes, &se.AvailableBytes,
 		&se.LastSyncAt, &se.LastFileSyncAt, &se.CreatedAt, &se.UpdatedAt,
+		&se.Labels,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -81,36 +101,19 @@ func (r *storageElementRepo) GetByID(ctx context.Context, id string) (*model.Sto
 	return se, nil
 }
 
-func (r *storageElementRepo) List(ctx context.Context, mode, status *string, limit, offset int) ([]*model.StorageElement, error) {
-	// Динамическое построение WHERE
-	var conditions []string
-	var args []any
-	argNum := 1
-
-	if mode != nil {
-		conditions = append(conditions, fmt.Sprintf("mode = $%d", argNum))
-		args = append(args, *mode)
-		argNum++
-	}
-	if status != nil {
-		conditions = append(conditions, fmt.Sprintf("status = $%d", argNum))
-		args = append(args, *status)
-		argNum++
-	}
-
-	where := ""
-	if len(conditions) > 0 {
-		where = "WHERE " + strings.Join(conditions, " AND ")
-	}
+func (r *storageElementRepo) List(ctx context.Context, filters StorageElementFilters, limit, offset int) ([]*model.StorageElement, error) {
+	where, args := buildSEWhere(filters)
+	argNum := len(args) + 1
 
 	query := fmt.Sprintf(`
 		SELECT id, name, url, storage_id, mode, status,
 			capacity_bytes, used_bytes, available_bytes,
-			last_sync_at, last_file_sync_at, created_at, updated_at
+			last_sync_at, last_file_sync_at, created_at, updated_at,
+			%s
 		FROM storage_elements
 		%s
 		ORDER BY created_at DESC
-		LIMIT $%d OFFSET $%d`, where, argNum, argNum+1)
+		LIMIT $%d OFFSET $%d`, seLabelsColumn, where, argNum, argNum+1)
 
 	args = append(args, limit, offset)
 
@@ -127,6 +130,7 @@ func (r *storageElementRepo) List(ctx context.Context, mode, status *string, lim
 			&se.ID, &se.Name, &se.URL, &se.StorageID, &se.Mode, &se.Status,
 			&se.CapacityBytes, &se.UsedBytes, &se.AvailableBytes,
 			&se.LastSyncAt, &se.LastFileSyncAt, &se.CreatedAt, &se.UpdatedAt,
+			&se.Labels,
 		); err != nil {
 			return nil, fmt.Errorf("ошибка сканирования SE: %w", err)
 		}
@@ -172,32 +176,93 @@ func (r *storageElementRepo) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *storageElementRepo) Count(ctx context.Context, mode, status *string) (int, error) {
+func (r *storageElementRepo) Count(ctx context.Context, filters StorageElementFilters) (int, error) {
+	where, args := buildSEWhere(filters)
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM storage_elements %s`, where)
+
+	var count int
+	err := r.db.QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка подсчёта SE: %w", err)
+	}
+	return count, nil
+}
+
+func (r *storageElementRepo) SetLabels(ctx context.Context, id string, labels map[string]string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM storage_element_labels WHERE storage_element_id = $1`, id); err != nil {
+		return fmt.Errorf("ошибка удаления меток SE: %w", err)
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	values := make([]string, 0, len(labels))
+	for k, v := range labels {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO storage_element_labels (storage_element_id, key, value)
+		SELECT $1, k, v FROM unnest($2::text[], $3::text[]) AS t(k, v)`,
+		id, keys, values,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения меток SE: %w", err)
+	}
+	return nil
+}
+
+func (r *storageElementRepo) ListLabelPairs(ctx context.Context) ([]string, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT DISTINCT key || '=' || value AS pair
+		FROM storage_element_labels
+		ORDER BY pair`)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения меток SE: %w", err)
+	}
+	defer rows.Close()
+
+	var pairs []string
+	for rows.Next() {
+		var pair string
+		if err := rows.Scan(&pair); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования метки SE: %w", err)
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, rows.Err()
+}
+
+// buildSEWhere строит WHERE-условие и аргументы для фильтров SE.
+func buildSEWhere(filters StorageElementFilters) (where string, args []any) {
 	var conditions []string
-	var args []any
 	argNum := 1
 
-	if mode != nil {
+	if filters.Mode != nil {
 		conditions = append(conditions, fmt.Sprintf("mode = $%d", argNum))
-		args = append(args, *mode)
+		args = append(args, *filters.Mode)
 		argNum++
 	}
-	if status != nil {
+	if filters.Status != nil {
 		conditions = append(conditions, fmt.Sprintf("status = $%d", argNum))
-		args = append(args, *status)
+		args = append(args, *filters.Status)
+		argNum++
+	}
+	for k, v := range filters.Labels {
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (SELECT 1 FROM storage_element_labels l
+			WHERE l.storage_element_id = storage_elements.id AND l.key = $%d AND l.value = $%d)`, argNum, argNum+1))
+		args = append(args, k, v)
+		argNum += 2
+	}
+	if filters.IDs != nil {
+		conditions = append(conditions, fmt.Sprintf("id::text = ANY($%d)", argNum))
+		args = append(args, filters.IDs)
 	}
 
-	where := ""
 	if len(conditions) > 0 {
 		where = "WHERE " + strings.Join(conditions, " AND ")
 	}
-
-	query := fmt.Sprintf(`SELECT COUNT(*) FROM storage_elements %s`, where)
-
-	var count int
-	err := r.db.QueryRow(ctx, query, args...).Scan(&count)
-	if err != nil {
-		return 0, fmt.Errorf("ошибка подсчёта SE: %w", err)
-	}
-	return count, nil
+	return where, args
 }
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// SyncCheckpointRepository — интерфейс для таблиц sync_checkpoints и sync_seen_files.
+type SyncCheckpointRepository interface {
+	// Get возвращает курсор синхронизации SE (ErrNotFound — синхронизаций ещё не было).
+	Get(ctx context.Context, seID string) (*model.SyncCheckpoint, error)
+	// Save создаёт или обновляет курсор синхронизации SE.
+	Save(ctx context.Context, cp *model.SyncCheckpoint) error
+	// AddSeen сохраняет в staging ID файлов, найденных на SE задачей runID.
+	AddSeen(ctx context.Context, runID string, fileIDs []string) error
+	// ClearSeen удаляет staging-записи задачи runID.
+	ClearSeen(ctx context.Context, runID string) error
+	// PurgeSeen удаляет все staging-записи (при старте — от прерванных задач).
+	PurgeSeen(ctx context.Context) error
+}
+
+// syncCheckpointRepo — реализация SyncCheckpointRepository.
+type syncCheckpointRepo struct {
+	db DBTX
+}
+
+// NewSyncCheckpointRepository создаёт репозиторий курсоров синхронизации.
+func NewSyncCheckpointRepository(db DBTX) SyncCheckpointRepository {
+	return &syncCheckpointRepo{db: db}
+}
+
+func (r *syncCheckpointRepo) Get(ctx context.Context, seID string) (*model.SyncCheckpoint, error) {
+	query := `
+		SELECT storage_element_id, files_cursor, last_full_sync_at, updated_at
+		FROM sync_checkpoints
+		WHERE storage_element_id = $1`
+
+	cp := &model.SyncCheckpoint{}
+	err := r.db.QueryRow(ctx, query, seID).Scan(
+		&cp.StorageElementID, &cp.FilesCursor, &cp.LastFullSyncAt, &cp.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения курсора синхронизации: %w", err)
+	}
+	return cp, nil
+}
+
+func (r *syncCheckpointRepo) Save(ctx context.Context, cp *model.SyncCheckpoint) error {
+	query := `
+		INSERT INTO sync_checkpoints (storage_element_id, files_cursor, last_full_sync_at, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (storage_element_id) DO UPDATE SET
+			files_cursor = EXCLUDED.files_cursor,
+			last_full_sync_at = EXCLUDED.last_full_sync_at,
+			updated_at = NOW()
+		RETURNING updated_at`
+
+	err := r.db.QueryRow(ctx, query, cp.StorageElementID, cp.FilesCursor, cp.LastFullSyncAt).
+		Scan(&cp.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения курсора синхронизации: %w", err)
+	}
+	return nil
+}
+
+func (r *syncCheckpointRepo) AddSeen(ctx context.Context, runID string, fileIDs []string) error {
+	if len(fileIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO sync_seen_files (run_id, file_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT DO NOTHING`
+
+	if _, err := r.db.Exec(ctx, query, runID, fileIDs); err != nil {
+		return fmt.Errorf("ошибка записи найденных файлов синхронизации: %w", err)
+	}
+	return nil
+}
+
+func (r *syncCheckpointRepo) ClearSeen(ctx context.Context, runID string) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM sync_seen_files WHERE run_id = $1`, runID); err != nil {
+		return fmt.Errorf("ошибка очистки найденных файлов синхронизации: %w", err)
+	}
+	return nil
+}
+
+func (r *syncCheckpointRepo) PurgeSeen(ctx context.Context) error {
+	if _, err := r.db.Exec(ctx, `TRUNCATE sync_seen_files`); err != nil {
+		return fmt.Errorf("ошибка очистки staging синхронизации: %w", err)
+	}
+	return nil
+}
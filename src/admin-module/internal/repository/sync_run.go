@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// SyncRunRepository — интерфейс для таблицы sync_runs.
+type SyncRunRepository interface {
+	// Create сохраняет новую задачу синхронизации. Заполняет CreatedAt.
+	Create(ctx context.Context, run *model.SyncRun) error
+	// Update сохраняет состояние, счётчики и времена выполнения задачи.
+	Update(ctx context.Context, run *model.SyncRun) error
+	// GetByID возвращает задачу по UUID.
+	GetByID(ctx context.Context, id string) (*model.SyncRun, error)
+	// List возвращает задачи с фильтрацией и пагинацией (новые первыми).
+	List(ctx context.Context, filters SyncRunFilters, limit, offset int) ([]*model.SyncRun, error)
+	// Count возвращает количество задач с фильтрацией.
+	Count(ctx context.Context, filters SyncRunFilters) (int, error)
+	// FailUnfinished переводит задачи queued/running в failed с указанной ошибкой.
+	// Вызывается при старте: такие задачи прерваны перезапуском процесса.
+	FailUnfinished(ctx context.Context, reason string) (int, error)
+}
+
+// SyncRunFilters — фильтры для списка задач синхронизации.
+type SyncRunFilters struct {
+	StorageElementID *string
+	Status           *string
+	Trigger          *string
+}
+
+// syncRunRepo — реализация SyncRunRepository.
+type syncRunRepo struct {
+	db DBTX
+}
+
+// NewSyncRunRepository создаёт репозиторий задач синхронизации.
+func NewSyncRunRepository(db DBTX) SyncRunRepository {
+	return &syncRunRepo{db: db}
+}
+
+// syncRunColumns — список колонок sync_runs в порядке scanSyncRun.
+const syncRunColumns = `id, storage_element_id, trigger, mode, status, requested_by,
+	files_on_se, files_processed, files_added, files_updated, files_marked_deleted,
+	error, created_at, started_at, completed_at, duration_ms`
+
+// scanSyncRun сканирует строку sync_runs.
+func scanSyncRun(row pgx.Row) (*model.SyncRun, error) {
+	run := &model.SyncRun{}
+	err := row.Scan(
+		&run.ID, &run.StorageElementID, &run.Trigger, &run.Mode, &run.Status, &run.RequestedBy,
+		&run.FilesOnSE, &run.FilesProcessed, &run.FilesAdded, &run.FilesUpdated, &run.FilesMarkedDeleted,
+		&run.Error, &run.CreatedAt, &run.StartedAt, &run.CompletedAt, &run.DurationMs,
+	)
+	return run, err
+}
+
+func (r *syncRunRepo) Create(ctx context.Context, run *model.SyncRun) error {
+	query := `
+		INSERT INTO sync_runs (id, storage_element_id, trigger, mode, status, requested_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		run.ID, run.StorageElementID, run.Trigger, run.Mode, run.Status, run.RequestedBy,
+	).Scan(&run.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка создания задачи синхронизации: %w", err)
+	}
+	return nil
+}
+
+func (r *syncRunRepo) Update(ctx context.Context, run *model.SyncRun) error {
+	query := `
+		UPDATE sync_runs SET
+			status = $2, files_on_se = $3, files_processed = $4, files_added = $5,
+			files_updated = $6, files_marked_deleted = $7, error = $8,
+			started_at = $9, completed_at = $10, duration_ms = $11
+		WHERE id = $1`
+
+	tag, err := r.db.Exec(ctx, query,
+		run.ID, run.Status, run.FilesOnSE, run.FilesProcessed, run.FilesAdded,
+		run.FilesUpdated, run.FilesMarkedDeleted, run.Error,
+		run.StartedAt, run.CompletedAt, run.DurationMs,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка обновления задачи синхронизации: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *syncRunRepo) GetByID(ctx context.Context, id string) (*model.SyncRun, error) {
+	query := "SELECT " + syncRunColumns + " FROM sync_runs WHERE id = $1"
+
+	run, err := scanSyncRun(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения задачи синхронизации: %w", err)
+	}
+	return run, nil
+}
+
+// buildSyncRunWhere формирует WHERE-условие для фильтров задач синхронизации.
+func buildSyncRunWhere(filters SyncRunFilters) (whereClause string, args []any) {
+	var conditions []string
+
+	if filters.StorageElementID != nil {
+		args = append(args, *filters.StorageElementID)
+		conditions = append(conditions, fmt.Sprintf("storage_element_id = $%d", len(args)))
+	}
+	if filters.Status != nil {
+		args = append(args, *filters.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filters.Trigger != nil {
+		args = append(args, *filters.Trigger)
+		conditions = append(conditions, fmt.Sprintf("trigger = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+func (r *syncRunRepo) List(ctx context.Context, filters SyncRunFilters, limit, offset int) ([]*model.SyncRun, error) {
+	where, args := buildSyncRunWhere(filters)
+	argNum := len(args) + 1
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM sync_runs
+		%s
+		ORDER BY created_at DESC, id
+		LIMIT $%d OFFSET $%d`, syncRunColumns, where, argNum, argNum+1)
+
+	args = append(args, limit, offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения задач синхронизации: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.SyncRun
+	for rows.Next() {
+		run, err := scanSyncRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования задачи синхронизации: %w", err)
+		}
+		result = append(result, run)
+	}
+	return result, rows.Err()
+}
+
+func (r *syncRunRepo) Count(ctx context.Context, filters SyncRunFilters) (int, error) {
+	where, args := buildSyncRunWhere(filters)
+	query := "SELECT COUNT(*) FROM sync_runs " + where
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("ошибка подсчёта задач синхронизации: %w", err)
+	}
+	return count, nil
+}
+
+func (r *syncRunRepo) FailUnfinished(ctx context.Context, reason string) (int, error) {
+	query := `
+		UPDATE sync_runs SET
+			status = 'failed',
+			error = $1,
+			completed_at = NOW(),
+			duration_ms = CASE WHEN started_at IS NULL THEN NULL
+				ELSE (EXTRACT(EPOCH FROM NOW() - started_at) * 1000)::BIGINT END
+		WHERE status IN ('queued', 'running')`
+
+	tag, err := r.db.Exec(ctx, query, reason)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка завершения прерванных задач синхронизации: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
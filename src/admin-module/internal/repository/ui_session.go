@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// UISessionRepository — интерфейс для таблицы ui_sessions.
+type UISessionRepository interface {
+	// Create сохраняет новую сессию. Заполняет CreatedAt и LastSeenAt.
+	Create(ctx context.Context, s *model.UISession) error
+	// GetByTokenHash возвращает сессию по SHA-256 токена из cookie.
+	GetByTokenHash(ctx context.Context, tokenHash []byte) (*model.UISession, error)
+	// GetByID возвращает сессию по UUID.
+	GetByID(ctx context.Context, id string) (*model.UISession, error)
+	// Touch обновляет время последней активности сессии.
+	Touch(ctx context.Context, id string, at time.Time) error
+	// UpdateTokens сохраняет токены, обновлённые через refresh token.
+	UpdateTokens(ctx context.Context, id, tokens string) error
+	// List возвращает сессии, активные после idleSince (subject — необязательный
+	// фильтр), упорядоченные по пользователю и последней активности.
+	List(ctx context.Context, subject *string, idleSince time.Time) ([]*model.UISession, error)
+	// Delete удаляет сессию по ID.
+	Delete(ctx context.Context, id string) error
+	// DeleteByIDs удаляет сессии по списку ID. Возвращает количество удалённых.
+	DeleteByIDs(ctx context.Context, ids []string) (int, error)
+	// DeleteBySubject удаляет все сессии пользователя. Возвращает количество удалённых.
+	DeleteBySubject(ctx context.Context, subject string) (int, error)
+	// DeleteStale удаляет истёкшие сессии, сессии без активности с idleSince
+	// и сессии, зашифрованные другим ключом. Возвращает количество удалённых.
+	DeleteStale(ctx context.Context, keyID string, idleSince time.Time) (int, error)
+}
+
+// uiSessionRepo — реализация UISessionRepository.
+type uiSessionRepo struct {
+	db DBTX
+}
+
+// NewUISessionRepository создаёт репозиторий сессий Admin UI.
+func NewUISessionRepository(db DBTX) UISessionRepository {
+	return &uiSessionRepo{db: db}
+}
+
+// uiSessionColumns — список колонок ui_sessions в порядке scanUISession.
+const uiSessionColumns = `id, token_hash, key_id, subject, username, email, idp_role, role,
+	groups, tokens, remote_addr, user_agent, created_at, last_seen_at, expires_at`
+
+// scanUISession сканирует строку ui_sessions.
+func scanUISession(row pgx.Row) (*model.UISession, error) {
+	s := &model.UISession{}
+	err := row.Scan(
+		&s.ID, &s.TokenHash, &s.KeyID, &s.Subject, &s.Username, &s.Email, &s.IdpRole, &s.Role,
+		&s.Groups, &s.Tokens, &s.RemoteAddr, &s.UserAgent, &s.CreatedAt, &s.LastSeenAt, &s.ExpiresAt,
+	)
+	return s, err
+}
+
+func (r *uiSessionRepo) Create(ctx context.Context, s *model.UISession) error {
+	groups := s.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	query := `
+		INSERT INTO ui_sessions (id, token_hash, key_id, subject, username, email, idp_role, role,
+			groups, tokens, remote_addr, user_agent, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING created_at, last_seen_at`
+
+	err := r.db.QueryRow(ctx, query,
+		s.ID, s.TokenHash, s.KeyID, s.Subject, s.Username, s.Email, s.IdpRole, s.Role,
+		groups, s.Tokens, s.RemoteAddr, s.UserAgent, s.ExpiresAt,
+	).Scan(&s.CreatedAt, &s.LastSeenAt)
+	if err != nil {
+		return fmt.Errorf("ошибка создания UI-сессии: %w", err)
+	}
+	return nil
+}
+
+func (r *uiSessionRepo) GetByTokenHash(ctx context.Context, tokenHash []byte) (*model.UISession, error) {
+	query := "SELECT " + uiSessionColumns + " FROM ui_sessions WHERE token_hash = $1"
+
+	s, err := scanUISession(r.db.QueryRow(ctx, query, tokenHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения UI-сессии: %w", err)
+	}
+	return s, nil
+}
+
+func (r *uiSessionRepo) GetByID(ctx context.Context, id string) (*model.UISession, error) {
+	query := "SELECT " + uiSessionColumns + " FROM ui_sessions WHERE id = $1"
+
+	s, err := scanUISession(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения UI-сессии: %w", err)
+	}
+	return s, nil
+}
+
+func (r *uiSessionRepo) Touch(ctx context.Context, id string, at time.Time) error {
+	_, err := r.db.Exec(ctx, `UPDATE ui_sessions SET last_seen_at = $2 WHERE id = $1`, id, at)
+	if err != nil {
+		return fmt.Errorf("ошибка обновления активности UI-сессии: %w", err)
+	}
+	return nil
+}
+
+func (r *uiSessionRepo) UpdateTokens(ctx context.Context, id, tokens string) error {
+	tag, err := r.db.Exec(ctx, `UPDATE ui_sessions SET tokens = $2 WHERE id = $1`, id, tokens)
+	if err != nil {
+		return fmt.Errorf("ошибка обновления токенов UI-сессии: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *uiSessionRepo) List(ctx context.Context, subject *string, idleSince time.Time) ([]*model.UISession, error) {
+	query := `
+		SELECT ` + uiSessionColumns + `
+		FROM ui_sessions
+		WHERE expires_at > NOW() AND last_seen_at > $1 AND ($2::text IS NULL OR subject = $2)
+		ORDER BY username, last_seen_at DESC`
+
+	rows, err := r.db.Query(ctx, query, idleSince, subject)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения UI-сессий: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.UISession
+	for rows.Next() {
+		s, err := scanUISession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования UI-сессии: %w", err)
+		}
+		result = append(result, s)
+	}
+	return result, rows.Err()
+}
+
+func (r *uiSessionRepo) Delete(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM ui_sessions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления UI-сессии: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *uiSessionRepo) DeleteByIDs(ctx context.Context, ids []string) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	tag, err := r.db.Exec(ctx, `DELETE FROM ui_sessions WHERE id = ANY($1::uuid[])`, ids)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления UI-сессий: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *uiSessionRepo) DeleteBySubject(ctx context.Context, subject string) (int, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM ui_sessions WHERE subject = $1`, subject)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления UI-сессий пользователя: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *uiSessionRepo) DeleteStale(ctx context.Context, keyID string, idleSince time.Time) (int, error) {
+	query := `
+		DELETE FROM ui_sessions
+		WHERE key_id != $1 OR expires_at <= NOW() OR last_seen_at <= $2`
+
+	tag, err := r.db.Exec(ctx, query, keyID, idleSince)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления устаревших UI-сессий: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,289 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// WebhookEndpointRepository — интерфейс CRUD для таблицы webhook_endpoints.
+type WebhookEndpointRepository interface {
+	// Create создаёт endpoint.
+	Create(ctx context.Context, ep *model.WebhookEndpoint) error
+	// GetByID возвращает endpoint по UUID.
+	GetByID(ctx context.Context, id string) (*model.WebhookEndpoint, error)
+	// List возвращает все endpoints, отсортированные по имени.
+	List(ctx context.Context) ([]*model.WebhookEndpoint, error)
+	// Update обновляет endpoint.
+	Update(ctx context.Context, ep *model.WebhookEndpoint) error
+	// Delete удаляет endpoint вместе с журналом доставок.
+	Delete(ctx context.Context, id string) error
+}
+
+// WebhookDeliveryRepository — интерфейс для таблицы webhook_deliveries.
+type WebhookDeliveryRepository interface {
+	// Create сохраняет доставку в очередь.
+	Create(ctx context.Context, d *model.WebhookDelivery) error
+	// ListDue возвращает pending-доставки, время попытки которых наступило.
+	ListDue(ctx context.Context, limit int) ([]*model.WebhookDelivery, error)
+	// Save сохраняет результат попытки (status, attempts, response_status,
+	// last_error, next_attempt_at, delivered_at).
+	Save(ctx context.Context, d *model.WebhookDelivery) error
+	// List возвращает доставки с фильтрацией и пагинацией (новые первыми).
+	List(ctx context.Context, filters WebhookDeliveryFilters, limit, offset int) ([]*model.WebhookDelivery, error)
+	// Count возвращает количество доставок с фильтрацией.
+	Count(ctx context.Context, filters WebhookDeliveryFilters) (int, error)
+	// DeleteBefore удаляет завершённые доставки, созданные раньше before.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// WebhookDeliveryFilters — фильтры для журнала доставок.
+type WebhookDeliveryFilters struct {
+	EndpointID *string
+	Status     *string
+}
+
+// webhookEndpointRepo — реализация WebhookEndpointRepository.
+type webhookEndpointRepo struct {
+	db DBTX
+}
+
+// NewWebhookEndpointRepository создаёт репозиторий webhook endpoints.
+func NewWebhookEndpointRepository(db DBTX) WebhookEndpointRepository {
+	return &webhookEndpointRepo{db: db}
+}
+
+// webhookEndpointColumns — список колонок webhook_endpoints для SELECT.
+const webhookEndpointColumns = `id, name, url, secret, enabled, created_at, updated_at`
+
+// scanWebhookEndpoint сканирует строку webhook_endpoints.
+func scanWebhookEndpoint(row pgx.Row) (*model.WebhookEndpoint, error) {
+	ep := &model.WebhookEndpoint{}
+	err := row.Scan(&ep.ID, &ep.Name, &ep.URL, &ep.Secret, &ep.Enabled, &ep.CreatedAt, &ep.UpdatedAt)
+	return ep, err
+}
+
+func (r *webhookEndpointRepo) Create(ctx context.Context, ep *model.WebhookEndpoint) error {
+	query := `
+		INSERT INTO webhook_endpoints (id, name, url, secret, enabled)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query, ep.ID, ep.Name, ep.URL, ep.Secret, ep.Enabled).
+		Scan(&ep.CreatedAt, &ep.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: webhook с таким именем уже существует", ErrConflict)
+		}
+		return fmt.Errorf("ошибка создания webhook: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookEndpointRepo) GetByID(ctx context.Context, id string) (*model.WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + ` FROM webhook_endpoints WHERE id = $1`
+
+	ep, err := scanWebhookEndpoint(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("ошибка получения webhook: %w", err)
+	}
+	return ep, nil
+}
+
+func (r *webhookEndpointRepo) List(ctx context.Context) ([]*model.WebhookEndpoint, error) {
+	query := `SELECT ` + webhookEndpointColumns + ` FROM webhook_endpoints ORDER BY name`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения списка webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.WebhookEndpoint
+	for rows.Next() {
+		ep, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка сканирования webhook: %w", err)
+		}
+		result = append(result, ep)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка итерации webhooks: %w", err)
+	}
+	return result, nil
+}
+
+func (r *webhookEndpointRepo) Update(ctx context.Context, ep *model.WebhookEndpoint) error {
+	query := `
+		UPDATE webhook_endpoints
+		SET name = $2, url = $3, secret = $4, enabled = $5
+		WHERE id = $1
+		RETURNING updated_at`
+
+	err := r.db.QueryRow(ctx, query, ep.ID, ep.Name, ep.URL, ep.Secret, ep.Enabled).Scan(&ep.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: webhook с таким именем уже существует", ErrConflict)
+		}
+		return fmt.Errorf("ошибка обновления webhook: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookEndpointRepo) Delete(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM webhook_endpoints WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("ошибка удаления webhook: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// webhookDeliveryRepo — реализация WebhookDeliveryRepository.
+type webhookDeliveryRepo struct {
+	db DBTX
+}
+
+// NewWebhookDeliveryRepository создаёт репозиторий доставок webhooks.
+func NewWebhookDeliveryRepository(db DBTX) WebhookDeliveryRepository {
+	return &webhookDeliveryRepo{db: db}
+}
+
+// webhookDeliveryColumns — список колонок webhook_deliveries для SELECT.
+const webhookDeliveryColumns = `id, endpoint_id, rule_id, event, payload, status, attempts,
+	response_status, last_error, next_attempt_at, created_at, delivered_at`
+
+func (r *webhookDeliveryRepo) Create(ctx context.Context, d *model.WebhookDelivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (id, endpoint_id, rule_id, event, payload, status, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		d.ID, d.EndpointID, d.RuleID, d.Event, d.Payload, d.Status, d.NextAttemptAt,
+	).Scan(&d.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения доставки webhook: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookDeliveryRepo) ListDue(ctx context.Context, limit int) ([]*model.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= NOW()
+		ORDER BY next_attempt_at
+		LIMIT $1`
+
+	return r.query(ctx, query, limit)
+}
+
+func (r *webhookDeliveryRepo) Save(ctx context.Context, d *model.WebhookDelivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, response_status = $4, last_error = $5,
+			next_attempt_at = $6, delivered_at = $7
+		WHERE id = $1`
+
+	_, err := r.db.Exec(ctx, query,
+		d.ID, d.Status, d.Attempts, d.ResponseStatus, d.LastError, d.NextAttemptAt, d.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка сохранения результата доставки webhook: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookDeliveryRepo) List(
+	ctx context.Context,
+	filters WebhookDeliveryFilters,
+	limit, offset int,
+) ([]*model.WebhookDelivery, error) {
+	where, args := buildWebhookDeliveryWhere(filters)
+	argNum := len(args) + 1
+
+	query := fmt.Sprintf(`SELECT %s
+		FROM webhook_deliveries
+		%s
+		ORDER BY created_at DESC, id
+		LIMIT $%d OFFSET $%d`, webhookDeliveryColumns, where, argNum, argNum+1)
+	args = append(args, limit, offset)
+
+	return r.query(ctx, query, args...)
+}
+
+func (r *webhookDeliveryRepo) Count(ctx context.Context, filters WebhookDeliveryFilters) (int, error) {
+	where, args := buildWebhookDeliveryWhere(filters)
+
+	var count int
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM webhook_deliveries `+where, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка подсчёта доставок webhook: %w", err)
+	}
+	return count, nil
+}
+
+func (r *webhookDeliveryRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx,
+		`DELETE FROM webhook_deliveries WHERE created_at < $1 AND status <> 'pending'`, before)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка удаления старых доставок webhook: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// query выполняет SELECT и сканирует доставки.
+func (r *webhookDeliveryRepo) query(ctx context.Context, query string, args ...any) ([]*model.WebhookDelivery, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения доставок webhook: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.WebhookDelivery
+	for rows.Next() {
+		d := &model.WebhookDelivery{}
+		if err := rows.Scan(
+			&d.ID, &d.EndpointID, &d.RuleID, &d.Event, &d.Payload, &d.Status, &d.Attempts,
+			&d.ResponseStatus, &d.LastError, &d.NextAttemptAt, &d.CreatedAt, &d.DeliveredAt,
+		); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования доставки webhook: %w", err)
+		}
+		result = append(result, d)
+	}
+	return result, rows.Err()
+}
+
+// buildWebhookDeliveryWhere формирует WHERE для журнала доставок.
+func buildWebhookDeliveryWhere(filters WebhookDeliveryFilters) (string, []any) {
+	var conditions []string
+	var args []any
+
+	if filters.EndpointID != nil {
+		args = append(args, *filters.EndpointID)
+		conditions = append(conditions, fmt.Sprintf("endpoint_id = $%d", len(args)))
+	}
+	if filters.Status != nil {
+		args = append(args, *filters.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
@@ -1,17 +1,26 @@
 // Пакет seclient — HTTP-клиент для взаимодействия с Storage Elements.
 // Поддерживает TLS с кастомным CA (AM_SE_CA_CERT_PATH).
-// Операции: Info (GET /api/v1/info), ListFiles (GET /api/v1/files) с пагинацией.
+// Операции: Info (GET /api/v1/info), ListFiles (GET /api/v1/files) с пагинацией,
+// GetFile, Download и Import — для репликации файлов между SE;
+// UpdateFile и DeleteFile — для записи изменений реестра на SE;
+// Upload и DownloadRange — для загрузки и скачивания файлов из Admin UI
+// (с токеном пользователя, см. WithToken).
 package seclient
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -21,6 +30,16 @@ import (
 // Получает токен от Keycloak через Client Credentials flow.
 type TokenProvider func(ctx context.Context) (string, error)
 
+// tokenKey — ключ контекста для токена, заменяющего TokenProvider.
+type tokenKey struct{}
+
+// WithToken возвращает контекст, запросы с которым авторизуются указанным
+// токеном вместо токена TokenProvider (например, токеном сессии пользователя
+// Admin UI — SE проверяет права и записывает uploaded_by по нему).
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
 // SEInfo — информация о Storage Element (ответ GET /api/v1/info).
 type SEInfo struct {
 	StorageID   string      `json:"storage_id"`
@@ -47,12 +66,14 @@ type SEFileMetadata struct {
 	Checksum         string   `json:"checksum"`
 	UploadedBy       string   `json:"uploaded_by"`
 	UploadedAt       string   `json:"uploaded_at"`
+	UpdatedAt        string   `json:"updated_at,omitempty"`
 	Status           string   `json:"status"`
 	RetentionPolicy  string   `json:"retention_policy"`
 	TTLDays          *int     `json:"ttl_days,omitempty"`
 	ExpiresAt        *string  `json:"expires_at,omitempty"`
 	Description      *string  `json:"description,omitempty"`
 	Tags             []string `json:"tags,omitempty"`
+	CollectionID     string   `json:"collection_id,omitempty"`
 }
 
 // FileListResponse — ответ SE на GET /api/v1/files с пагинацией.
@@ -64,9 +85,96 @@ type FileListResponse struct {
 	HasMore bool             `json:"has_more"`
 }
 
+// ImportResult — результат импорта tar-архива на SE (ответ POST /api/v1/maintenance/import).
+type ImportResult struct {
+	Imported int           `json:"imported"`
+	Skipped  int           `json:"skipped"`
+	Failed   int           `json:"failed"`
+	Issues   []ImportIssue `json:"issues"`
+}
+
+// ImportIssue — файл архива, который SE не импортировал.
+type ImportIssue struct {
+	FileID      *string `json:"file_id"`
+	Reason      string  `json:"reason"`
+	Description string  `json:"description"`
+}
+
+// ReconcileResult — результат сверки attr.json с файловой системой SE
+// (ответ POST /api/v1/maintenance/reconcile).
+type ReconcileResult struct {
+	StartedAt    time.Time        `json:"started_at"`
+	CompletedAt  time.Time        `json:"completed_at"`
+	FilesChecked int              `json:"files_checked"`
+	Issues       []ReconcileIssue `json:"issues"`
+	Summary      ReconcileSummary `json:"summary"`
+}
+
+// ReconcileIssue — проблема, обнаруженная сверкой.
+type ReconcileIssue struct {
+	FileID      *string `json:"file_id"`
+	Path        *string `json:"path"`
+	Type        string  `json:"type"`
+	Description string  `json:"description"`
+}
+
+// ReconcileSummary — сводка сверки по типам проблем.
+type ReconcileSummary struct {
+	OK                 int `json:"ok"`
+	OrphanedFiles      int `json:"orphaned_files"`
+	MissingFiles       int `json:"missing_files"`
+	ChecksumMismatches int `json:"checksum_mismatches"`
+	SizeMismatches     int `json:"size_mismatches"`
+}
+
+// StatusError — ответ SE с неуспешным HTTP-статусом.
+// Возвращается UpdateFile, DeleteFile, Upload, DownloadRange и Reconcile.
+type StatusError struct {
+	// Op — операция клиента (UpdateFile, DeleteFile, Upload, DownloadRange, Reconcile)
+	Op string
+	// StatusCode — HTTP-статус ответа
+	StatusCode int
+	// Code — машиночитаемый код ошибки SE (VALIDATION_ERROR, MODE_NOT_ALLOWED, ...)
+	Code string
+	// Message — описание ошибки от SE
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("SE %s вернул статус %d (%s): %s", e.Op, e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("SE %s вернул статус %d: %s", e.Op, e.StatusCode, e.Message)
+}
+
+// IsRejected возвращает true, если SE отклонил запрос по существу (4xx, кроме
+// ошибок авторизации, таймаута и rate limit): повтор того же запроса не поможет.
+// Сетевые ошибки и 5xx — временные.
+func IsRejected(err error) bool {
+	var se *StatusError
+	if !errors.As(err, &se) {
+		return false
+	}
+	switch se.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden,
+		http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	}
+	return se.StatusCode >= 400 && se.StatusCode < 500
+}
+
+// IsNotFound возвращает true, если SE ответил 404.
+func IsNotFound(err error) bool {
+	var se *StatusError
+	return errors.As(err, &se) && se.StatusCode == http.StatusNotFound
+}
+
 // Client — HTTP-клиент для Storage Elements.
 type Client struct {
-	httpClient    *http.Client
+	httpClient *http.Client
+	// streamClient — клиент без общего таймаута для передачи содержимого файлов.
+	// Длительность передачи ограничивается контекстом вызывающего.
+	streamClient  *http.Client
 	tokenProvider TokenProvider
 	logger        *slog.Logger
 }
@@ -77,6 +185,7 @@ type Client struct {
 // tokenProvider — функция для получения JWT (может быть nil для public endpoints).
 func New(caCertPath string, timeout time.Duration, tokenProvider TokenProvider, logger *slog.Logger) (*Client, error) {
 	httpClient := &http.Client{Timeout: timeout}
+	streamClient := &http.Client{}
 
 	if caCertPath != "" {
 		tlsConfig, err := buildTLSConfig(caCertPath)
@@ -86,6 +195,7 @@ func New(caCertPath string, timeout time.Duration, tokenProvider TokenProvider,
 		httpClient.Transport = &http.Transport{
 			TLSClientConfig: tlsConfig,
 		}
+		streamClient.Transport = httpClient.Transport
 		logger.Info("CA-сертификат SE добавлен в пул доверия",
 			slog.String("ca_cert", caCertPath),
 		)
@@ -93,6 +203,7 @@ func New(caCertPath string, timeout time.Duration, tokenProvider TokenProvider,
 
 	return &Client{
 		httpClient:    httpClient,
+		streamClient:  streamClient,
 		tokenProvider: tokenProvider,
 		logger:        logger.With(slog.String("component", "se_client")),
 	}, nil
@@ -147,8 +258,13 @@ func (c *Client) Info(ctx context.Context, seURL string) (*SEInfo, error) {
 
 // ListFiles запрашивает список файлов у Storage Element с пагинацией.
 // GET /api/v1/files?limit=N&offset=M — требует авторизации (scope: files:read).
-func (c *Client) ListFiles(ctx context.Context, seURL string, limit, offset int) (*FileListResponse, error) {
+// updatedSince != nil — только файлы с updated_at >= updatedSince
+// (инкрементальная синхронизация).
+func (c *Client) ListFiles(ctx context.Context, seURL string, limit, offset int, updatedSince *time.Time) (*FileListResponse, error) {
 	reqURL := fmt.Sprintf("%s/api/v1/files?limit=%d&offset=%d", normalizeURL(seURL), limit, offset)
+	if updatedSince != nil {
+		reqURL += "&updated_since=" + url.QueryEscape(updatedSince.UTC().Format(time.RFC3339Nano))
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
 	if err != nil {
@@ -184,6 +300,380 @@ func (c *Client) ListFiles(ctx context.Context, seURL string, limit, offset int)
 	return &fileResp, nil
 }
 
+// GetFile запрашивает метаданные файла у Storage Element.
+// GET /api/v1/files/{file_id} — требует авторизации (scope: files:read).
+func (c *Client) GetFile(ctx context.Context, seURL, fileID string) (*SEFileMetadata, error) {
+	reqURL := normalizeURL(seURL) + "/api/v1/files/" + url.PathEscape(fileID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("создание запроса GetFile: %w", err)
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req) //nolint:gosec // G704: URL из конфигурации SE
+	if err != nil {
+		return nil, fmt.Errorf("запрос GetFile к %s: %w", seURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("SE %s GetFile вернул статус %d: %s", seURL, resp.StatusCode, string(body))
+	}
+
+	var meta SEFileMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&meta); err != nil {
+		return nil, fmt.Errorf("декодирование GetFile от %s: %w", seURL, err)
+	}
+
+	return &meta, nil
+}
+
+// Download открывает поток содержимого файла на Storage Element.
+// GET /api/v1/files/{file_id}/download — требует авторизации (scope: files:read).
+// Вызывающий обязан закрыть возвращённый ReadCloser.
+func (c *Client) Download(ctx context.Context, seURL, fileID string) (io.ReadCloser, error) {
+	reqURL := normalizeURL(seURL) + "/api/v1/files/" + url.PathEscape(fileID) + "/download"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("создание запроса Download: %w", err)
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.streamClient.Do(req) //nolint:gosec // G704: URL из конфигурации SE
+	if err != nil {
+		return nil, fmt.Errorf("запрос Download к %s: %w", seURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("SE %s Download вернул статус %d: %s", seURL, resp.StatusCode, string(body))
+	}
+
+	return resp.Body, nil
+}
+
+// FileUpload — загружаемый файл (multipart POST /api/v1/files/upload).
+type FileUpload struct {
+	Filename    string
+	ContentType string
+	Description string
+	Tags        []string
+	// CollectionID — коллекция файла (записывается в attr.json)
+	CollectionID string
+	// UploadedBy — автор файла вместо subject токена (SE принимает только
+	// при scope storage:write)
+	UploadedBy string
+	// Body — содержимое файла; передаётся потоком, без буферизации
+	Body io.Reader
+}
+
+// Upload загружает файл на Storage Element потоком.
+// POST /api/v1/files/upload — требует авторизации (scope: files:write).
+// Неуспешный ответ SE возвращается как *StatusError.
+func (c *Client) Upload(ctx context.Context, seURL string, upload FileUpload) (*SEFileMetadata, error) {
+	reqURL := normalizeURL(seURL) + "/api/v1/files/upload"
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeUploadForm(mw, upload))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, pr)
+	if err != nil {
+		pr.Close()
+		return nil, fmt.Errorf("создание запроса Upload: %w", err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	if err := c.authorize(ctx, req); err != nil {
+		pr.Close()
+		return nil, err
+	}
+
+	resp, err := c.streamClient.Do(req) //nolint:gosec // G704: URL из конфигурации SE
+	if err != nil {
+		return nil, fmt.Errorf("запрос Upload к %s: %w", seURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, readStatusError("Upload", resp)
+	}
+
+	var meta SEFileMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&meta); err != nil {
+		return nil, fmt.Errorf("декодирование Upload от %s: %w", seURL, err)
+	}
+
+	return &meta, nil
+}
+
+// writeUploadForm пишет multipart-форму загрузки: description, tags (JSON),
+// collection_id, uploaded_by и file.
+func writeUploadForm(mw *multipart.Writer, upload FileUpload) error {
+	if upload.Description != "" {
+		if err := mw.WriteField("description", upload.Description); err != nil {
+			return err
+		}
+	}
+	if len(upload.Tags) > 0 {
+		tags, err := json.Marshal(upload.Tags)
+		if err != nil {
+			return err
+		}
+		if err := mw.WriteField("tags", string(tags)); err != nil {
+			return err
+		}
+	}
+	if upload.CollectionID != "" {
+		if err := mw.WriteField("collection_id", upload.CollectionID); err != nil {
+			return err
+		}
+	}
+	if upload.UploadedBy != "" {
+		if err := mw.WriteField("uploaded_by", upload.UploadedBy); err != nil {
+			return err
+		}
+	}
+
+	contentType := upload.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", multipart.FileContentDisposition("file", upload.Filename))
+	h.Set("Content-Type", contentType)
+	part, err := mw.CreatePart(h)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, upload.Body); err != nil {
+		return err
+	}
+	return mw.Close()
+}
+
+// downloadRequestHeaders — заголовки запроса клиента, передаваемые SE при
+// скачивании: диапазоны (Range) и условные запросы.
+var downloadRequestHeaders = []string{"Range", "If-Range", "If-None-Match", "If-Modified-Since"}
+
+// DownloadRange открывает поток содержимого файла на Storage Element
+// с заголовками Range и условными заголовками из header.
+// GET /api/v1/files/{file_id}/download — требует авторизации (scope: files:read).
+// Возвращает ответ SE со статусом 200, 206, 304 или 416 — вызывающий
+// проксирует его клиенту и обязан закрыть Body. Иной статус — *StatusError.
+func (c *Client) DownloadRange(ctx context.Context, seURL, fileID string, header http.Header) (*http.Response, error) {
+	reqURL := normalizeURL(seURL) + "/api/v1/files/" + url.PathEscape(fileID) + "/download"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("создание запроса DownloadRange: %w", err)
+	}
+	for _, name := range downloadRequestHeaders {
+		if v := header.Get(name); v != "" {
+			req.Header.Set(name, v)
+		}
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.streamClient.Do(req) //nolint:gosec // G704: URL из конфигурации SE
+	if err != nil {
+		return nil, fmt.Errorf("запрос DownloadRange к %s: %w", seURL, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent, http.StatusNotModified, http.StatusRequestedRangeNotSatisfiable:
+		return resp, nil
+	default:
+		defer resp.Body.Close()
+		return nil, readStatusError("DownloadRange", resp)
+	}
+}
+
+// Import загружает tar-архив в формате экспорта SE.
+// POST /api/v1/maintenance/import — требует авторизации (scope: storage:write).
+func (c *Client) Import(ctx context.Context, seURL string, archive io.Reader) (*ImportResult, error) {
+	reqURL := normalizeURL(seURL) + "/api/v1/maintenance/import"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, archive)
+	if err != nil {
+		return nil, fmt.Errorf("создание запроса Import: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-tar")
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.streamClient.Do(req) //nolint:gosec // G704: URL из конфигурации SE
+	if err != nil {
+		return nil, fmt.Errorf("запрос Import к %s: %w", seURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("SE %s Import вернул статус %d: %s", seURL, resp.StatusCode, string(body))
+	}
+
+	var result ImportResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("декодирование Import от %s: %w", seURL, err)
+	}
+
+	return &result, nil
+}
+
+// Reconcile запускает сверку attr.json с файловой системой SE и ждёт результата.
+// POST /api/v1/maintenance/reconcile — требует авторизации (scope: storage:write).
+// Сверка может длиться долго: запрос выполняется без таймаута клиента,
+// длительность ограничивает ctx. Если сверка на SE уже выполняется,
+// возвращается StatusError с кодом RECONCILE_IN_PROGRESS (409).
+func (c *Client) Reconcile(ctx context.Context, seURL string) (*ReconcileResult, error) {
+	reqURL := normalizeURL(seURL) + "/api/v1/maintenance/reconcile"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("создание запроса Reconcile: %w", err)
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.streamClient.Do(req) //nolint:gosec // G704: URL из конфигурации SE
+	if err != nil {
+		return nil, fmt.Errorf("запрос Reconcile к %s: %w", seURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, readStatusError("Reconcile", resp)
+	}
+
+	var result ReconcileResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("декодирование Reconcile от %s: %w", seURL, err)
+	}
+
+	return &result, nil
+}
+
+// FileUpdate — изменяемые метаданные файла (тело PATCH /api/v1/files/{file_id}).
+type FileUpdate struct {
+	Description *string   `json:"description,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+}
+
+// UpdateFile обновляет метаданные файла на Storage Element.
+// PATCH /api/v1/files/{file_id} — требует авторизации (scope: files:write).
+// Неуспешный ответ SE возвращается как *StatusError.
+func (c *Client) UpdateFile(ctx context.Context, seURL, fileID string, upd FileUpdate) (*SEFileMetadata, error) {
+	reqURL := normalizeURL(seURL) + "/api/v1/files/" + url.PathEscape(fileID)
+
+	body, err := json.Marshal(upd)
+	if err != nil {
+		return nil, fmt.Errorf("сериализация UpdateFile: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("создание запроса UpdateFile: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req) //nolint:gosec // G704: URL из конфигурации SE
+	if err != nil {
+		return nil, fmt.Errorf("запрос UpdateFile к %s: %w", seURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, readStatusError("UpdateFile", resp)
+	}
+
+	var meta SEFileMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&meta); err != nil {
+		return nil, fmt.Errorf("декодирование UpdateFile от %s: %w", seURL, err)
+	}
+
+	return &meta, nil
+}
+
+// DeleteFile помечает файл на Storage Element как удалённый (soft delete).
+// DELETE /api/v1/files/{file_id} — требует авторизации (scope: files:write).
+// Неуспешный ответ SE возвращается как *StatusError.
+func (c *Client) DeleteFile(ctx context.Context, seURL, fileID string) error {
+	reqURL := normalizeURL(seURL) + "/api/v1/files/" + url.PathEscape(fileID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, reqURL, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("создание запроса DeleteFile: %w", err)
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req) //nolint:gosec // G704: URL из конфигурации SE
+	if err != nil {
+		return fmt.Errorf("запрос DeleteFile к %s: %w", seURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return readStatusError("DeleteFile", resp)
+	}
+	return nil
+}
+
+// readStatusError формирует *StatusError из ответа SE.
+// Тело ответа в формате {"error": {"code": ..., "message": ...}}; иное — как текст.
+func readStatusError(op string, resp *http.Response) *StatusError {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	se := &StatusError{Op: op, StatusCode: resp.StatusCode, Message: string(body)}
+
+	var errResp struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &errResp) == nil && errResp.Error.Code != "" {
+		se.Code = errResp.Error.Code
+		se.Message = errResp.Error.Message
+	}
+	return se
+}
+
+// authorize добавляет JWT в заголовок Authorization.
+// Токен из контекста (WithToken) имеет приоритет над TokenProvider.
+func (c *Client) authorize(ctx context.Context, req *http.Request) error {
+	if token, _ := ctx.Value(tokenKey{}).(string); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+	if c.tokenProvider == nil {
+		return nil
+	}
+	token, err := c.tokenProvider(ctx)
+	if err != nil {
+		return fmt.Errorf("получение токена для SE: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
 // normalizeURL убирает trailing slash из URL.
 func normalizeURL(rawURL string) string {
 	return strings.TrimRight(rawURL, "/")
@@ -3,11 +3,14 @@ package seclient
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -217,7 +220,7 @@ func TestClient_ListFiles(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	resp, err := client.ListFiles(context.Background(), server.URL, 100, 0)
+	resp, err := client.ListFiles(context.Background(), server.URL, 100, 0, nil)
 	if err != nil {
 		t.Fatalf("Ошибка ListFiles: %v", err)
 	}
@@ -260,7 +263,7 @@ func TestClient_ListFiles_NoToken(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	resp, err := client.ListFiles(context.Background(), server.URL, 100, 0)
+	resp, err := client.ListFiles(context.Background(), server.URL, 100, 0, nil)
 	if err != nil {
 		t.Fatalf("Ошибка ListFiles: %v", err)
 	}
@@ -280,7 +283,7 @@ func TestClient_ListFiles_TokenError(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, err = client.ListFiles(context.Background(), server.URL, 100, 0)
+	_, err = client.ListFiles(context.Background(), server.URL, 100, 0, nil)
 	if err == nil {
 		t.Fatal("ожидалась ошибка, получен nil")
 	}
@@ -298,7 +301,7 @@ func TestClient_ListFiles_Unauthorized(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, err = client.ListFiles(context.Background(), server.URL, 100, 0)
+	_, err = client.ListFiles(context.Background(), server.URL, 100, 0, nil)
 	if err == nil {
 		t.Fatal("ожидалась ошибка, получен nil")
 	}
@@ -353,7 +356,7 @@ func TestClient_ListFiles_Pagination(t *testing.T) {
 	}
 
 	// Первая страница
-	resp1, err := client.ListFiles(context.Background(), server.URL, 2, 0)
+	resp1, err := client.ListFiles(context.Background(), server.URL, 2, 0, nil)
 	if err != nil {
 		t.Fatalf("Ошибка первой страницы: %v", err)
 	}
@@ -365,7 +368,7 @@ func TestClient_ListFiles_Pagination(t *testing.T) {
 	}
 
 	// Вторая страница
-	resp2, err := client.ListFiles(context.Background(), server.URL, 2, 2)
+	resp2, err := client.ListFiles(context.Background(), server.URL, 2, 2, nil)
 	if err != nil {
 		t.Fatalf("Ошибка второй страницы: %v", err)
 	}
@@ -374,7 +377,7 @@ func TestClient_ListFiles_Pagination(t *testing.T) {
 	}
 
 	// Третья страница (неполная)
-	resp3, err := client.ListFiles(context.Background(), server.URL, 2, 4)
+	resp3, err := client.ListFiles(context.Background(), server.URL, 2, 4, nil)
 	if err != nil {
 		t.Fatalf("Ошибка третьей страницы: %v", err)
 	}
@@ -387,6 +390,184 @@ func TestClient_ListFiles_Pagination(t *testing.T) {
 	}
 }
 
+// TestClient_ListFiles_UpdatedSince проверяет передачу курсора инкрементальной синхронизации.
+func TestClient_ListFiles_UpdatedSince(t *testing.T) {
+	since := time.Date(2026, 3, 1, 12, 30, 0, 500, time.UTC)
+	server := setupMockSE(t, func(w http.ResponseWriter, r *http.Request) {
+		got, err := time.Parse(time.RFC3339Nano, r.URL.Query().Get("updated_since"))
+		if err != nil || !got.Equal(since) {
+			t.Errorf("updated_since = %q, ожидался %s", r.URL.Query().Get("updated_since"), since.Format(time.RFC3339Nano))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(FileListResponse{
+			Files: []SEFileMetadata{{FileID: "f1", UploadedAt: "2026-02-01T00:00:00Z", UpdatedAt: "2026-03-01T12:31:00Z"}},
+			Total: 1,
+		})
+	})
+
+	client, err := New("", 30*time.Second, nil, testLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.ListFiles(context.Background(), server.URL, 100, 0, &since)
+	if err != nil {
+		t.Fatalf("Ошибка ListFiles: %v", err)
+	}
+	if len(resp.Files) != 1 || resp.Files[0].UpdatedAt != "2026-03-01T12:31:00Z" {
+		t.Errorf("неожиданный ответ: %+v", resp.Files)
+	}
+}
+
+// TestClient_GetFileAndDownload проверяет GetFile и Download с авторизацией.
+func TestClient_GetFileAndDownload(t *testing.T) {
+	const fileID = "8f4e1c2a-3b5d-4e6f-9a7b-1c2d3e4f5a6b"
+	server := setupMockSE(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/files/" + fileID:
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(SEFileMetadata{FileID: fileID, Size: 5, Checksum: "abc", Status: "active"})
+		case "/api/v1/files/" + fileID + "/download":
+			w.Write([]byte("hello"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client, err := New("", 30*time.Second, mockTokenProvider("test-token"), testLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := client.GetFile(context.Background(), server.URL, fileID)
+	if err != nil {
+		t.Fatalf("Ошибка GetFile: %v", err)
+	}
+	if meta.FileID != fileID || meta.Size != 5 {
+		t.Errorf("неожиданные метаданные: %+v", meta)
+	}
+
+	body, err := client.Download(context.Background(), server.URL, fileID)
+	if err != nil {
+		t.Fatalf("Ошибка Download: %v", err)
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(body)
+	if string(data) != "hello" {
+		t.Errorf("содержимое = %q, ожидалось hello", data)
+	}
+
+	if _, err := client.Download(context.Background(), server.URL, "missing"); err == nil {
+		t.Error("ожидалась ошибка Download для отсутствующего файла")
+	}
+}
+
+// TestClient_Import проверяет отправку архива и разбор результата импорта.
+func TestClient_Import(t *testing.T) {
+	server := setupMockSE(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/maintenance/import" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Content-Type") != "application/x-tar" {
+			t.Errorf("Content-Type = %q, ожидался application/x-tar", r.Header.Get("Content-Type"))
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "archive" {
+			t.Errorf("тело запроса = %q", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"imported":0,"skipped":1,"failed":0,"issues":[{"file_id":null,"reason":"already_exists","description":"exists"}]}`))
+	})
+
+	client, err := New("", 30*time.Second, mockTokenProvider("test-token"), testLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Import(context.Background(), server.URL, strings.NewReader("archive"))
+	if err != nil {
+		t.Fatalf("Ошибка Import: %v", err)
+	}
+	if res.Skipped != 1 || len(res.Issues) != 1 || res.Issues[0].Reason != "already_exists" {
+		t.Errorf("неожиданный результат импорта: %+v", res)
+	}
+}
+
+// TestClient_UpdateAndDeleteFile проверяет запись изменений на SE и
+// классификацию ошибок SE.
+func TestClient_UpdateAndDeleteFile(t *testing.T) {
+	const fileID = "8f4e1c2a-3b5d-4e6f-9a7b-1c2d3e4f5a6b"
+	server := setupMockSE(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.URL.Path == "/api/v1/files/"+fileID && r.Method == http.MethodPatch:
+			var upd FileUpdate
+			if err := json.NewDecoder(r.Body).Decode(&upd); err != nil || upd.Tags == nil {
+				t.Errorf("тело PATCH: %+v, %v", upd, err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(SEFileMetadata{FileID: fileID, Tags: *upd.Tags, Status: "active"})
+		case r.URL.Path == "/api/v1/files/"+fileID && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/api/v1/files/ro" && r.Method == http.MethodDelete:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":{"code":"MODE_NOT_ALLOWED","message":"Удаление файлов недоступно в режиме ro"}}`))
+		case r.URL.Path == "/api/v1/files/busy":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client, err := New("", 30*time.Second, mockTokenProvider("test-token"), testLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	tags := []string{"a", "b"}
+	meta, err := client.UpdateFile(ctx, server.URL, fileID, FileUpdate{Tags: &tags})
+	if err != nil {
+		t.Fatalf("Ошибка UpdateFile: %v", err)
+	}
+	if len(meta.Tags) != 2 {
+		t.Errorf("теги после UpdateFile: %v", meta.Tags)
+	}
+
+	if err := client.DeleteFile(ctx, server.URL, fileID); err != nil {
+		t.Fatalf("Ошибка DeleteFile: %v", err)
+	}
+
+	// 409 — SE отклонил запрос, код ошибки разобран
+	err = client.DeleteFile(ctx, server.URL, "ro")
+	var se *StatusError
+	if !errors.As(err, &se) || se.Code != "MODE_NOT_ALLOWED" || !IsRejected(err) {
+		t.Errorf("DeleteFile(ro) = %v, ожидалась отклонённая ошибка MODE_NOT_ALLOWED", err)
+	}
+
+	// 404 — отклонено, IsNotFound
+	if _, err := client.UpdateFile(ctx, server.URL, "missing", FileUpdate{Tags: &tags}); !IsNotFound(err) || !IsRejected(err) {
+		t.Errorf("UpdateFile(missing) = %v, ожидался 404", err)
+	}
+
+	// 503 и сетевые ошибки — временные
+	if err := client.DeleteFile(ctx, server.URL, "busy"); err == nil || IsRejected(err) {
+		t.Errorf("DeleteFile(busy) = %v, ожидалась временная ошибка", err)
+	}
+	if err := client.DeleteFile(ctx, "http://127.0.0.1:1", fileID); err == nil || IsRejected(err) {
+		t.Errorf("DeleteFile(недоступен) = %v, ожидалась временная ошибка", err)
+	}
+}
+
 // TestNormalizeURL проверяет normalizeURL.
 func TestNormalizeURL(t *testing.T) {
 	tests := []struct {
@@ -412,3 +593,152 @@ func TestNormalizeURL(t *testing.T) {
 func intPtr(v int) *int {
 	return &v
 }
+
+// TestClient_UploadWithToken проверяет потоковую загрузку файла
+// и авторизацию токеном из контекста.
+func TestClient_UploadWithToken(t *testing.T) {
+	server := setupMockSE(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/files/upload" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer user-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("поле file: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		data, _ := io.ReadAll(file)
+		if string(data) != "hello" || header.Filename != "отчёт.txt" || header.Header.Get("Content-Type") != "text/plain" {
+			t.Errorf("файл: %q, %q, %q", data, header.Filename, header.Header.Get("Content-Type"))
+		}
+		if r.FormValue("description") != "desc" || r.FormValue("tags") != `["a","b"]` {
+			t.Errorf("поля формы: %q, %q", r.FormValue("description"), r.FormValue("tags"))
+		}
+		if r.FormValue("collection_id") != "c1" || r.FormValue("uploaded_by") != "u1" {
+			t.Errorf("collection_id, uploaded_by: %q, %q", r.FormValue("collection_id"), r.FormValue("uploaded_by"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(SEFileMetadata{FileID: "f1", OriginalFilename: header.Filename, Size: int64(len(data))})
+	})
+
+	client, err := New("", 30*time.Second, mockTokenProvider("service-token"), testLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	upload := FileUpload{
+		Filename: "отчёт.txt", ContentType: "text/plain", Description: "desc",
+		Tags: []string{"a", "b"}, CollectionID: "c1", UploadedBy: "u1",
+		Body: strings.NewReader("hello"),
+	}
+	meta, err := client.Upload(WithToken(context.Background(), "user-token"), server.URL, upload)
+	if err != nil {
+		t.Fatalf("Ошибка Upload: %v", err)
+	}
+	if meta.FileID != "f1" || meta.Size != 5 {
+		t.Errorf("неожиданные метаданные: %+v", meta)
+	}
+
+	// Без токена в контексте используется TokenProvider — SE отвечает 401
+	upload.Body = strings.NewReader("hello")
+	if _, err := client.Upload(context.Background(), server.URL, upload); err == nil || IsRejected(err) {
+		t.Errorf("Upload(service-token) = %v, ожидалась ошибка авторизации", err)
+	}
+}
+
+// TestClient_DownloadRange проверяет передачу Range и проксируемые статусы.
+func TestClient_DownloadRange(t *testing.T) {
+	const fileID = "8f4e1c2a-3b5d-4e6f-9a7b-1c2d3e4f5a6b"
+	server := setupMockSE(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/files/"+fileID+"/download" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Cookie") != "" {
+			t.Error("заголовок Cookie клиента не должен передаваться SE")
+		}
+		http.ServeContent(w, r, "file.txt", time.Time{}, strings.NewReader("hello world"))
+	})
+
+	client, err := New("", 30*time.Second, mockTokenProvider("test-token"), testLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	header := http.Header{}
+	header.Set("Range", "bytes=6-")
+	header.Set("Cookie", "session=secret")
+	resp, err := client.DownloadRange(ctx, server.URL, fileID, header)
+	if err != nil {
+		t.Fatalf("Ошибка DownloadRange: %v", err)
+	}
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent || string(data) != "world" {
+		t.Errorf("DownloadRange = %d %q, ожидалось 206 world", resp.StatusCode, data)
+	}
+
+	header.Set("Range", "bytes=100-")
+	resp, err = client.DownloadRange(ctx, server.URL, fileID, header)
+	if err != nil {
+		t.Fatalf("Ошибка DownloadRange: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		t.Errorf("статус = %d, ожидался 416", resp.StatusCode)
+	}
+
+	if _, err := client.DownloadRange(ctx, server.URL, "missing", http.Header{}); !IsNotFound(err) {
+		t.Errorf("DownloadRange(missing) = %v, ожидался 404", err)
+	}
+}
+
+// TestClient_Reconcile проверяет запуск сверки на SE и ошибку
+// «сверка уже выполняется».
+func TestClient_Reconcile(t *testing.T) {
+	inProgress := false
+	server := setupMockSE(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/maintenance/reconcile" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if inProgress {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":{"code":"RECONCILE_IN_PROGRESS","message":"busy"}}`))
+			return
+		}
+		w.Write([]byte(`{"started_at":"2026-02-21T16:00:00Z","completed_at":"2026-02-21T16:05:00Z",` +
+			`"files_checked":3,"issues":[{"file_id":null,"path":"orphan.bin","type":"orphaned_file","description":"no attr"}],` +
+			`"summary":{"ok":2,"orphaned_files":1,"missing_files":0,"checksum_mismatches":0,"size_mismatches":0}}`))
+	})
+
+	client, err := New("", 30*time.Second, mockTokenProvider("test-token"), testLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Reconcile(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("Ошибка Reconcile: %v", err)
+	}
+	if res.FilesChecked != 3 || res.Summary.OrphanedFiles != 1 || len(res.Issues) != 1 ||
+		res.Issues[0].Type != "orphaned_file" || res.Issues[0].Path == nil || *res.Issues[0].Path != "orphan.bin" {
+		t.Errorf("неожиданный результат сверки: %+v", res)
+	}
+
+	inProgress = true
+	_, err = client.Reconcile(context.Background(), server.URL)
+	var se *StatusError
+	if !errors.As(err, &se) || se.StatusCode != http.StatusConflict || se.Code != "RECONCILE_IN_PROGRESS" {
+		t.Errorf("Reconcile при выполняющейся сверке = %v, ожидался 409 RECONCILE_IN_PROGRESS", err)
+	}
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/bigkaa/goartstore/admin-module/internal/api/generated"
 	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
 	"github.com/bigkaa/goartstore/admin-module/internal/config"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp/local"
 	uihandlers "github.com/bigkaa/goartstore/admin-module/internal/ui/handlers"
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/i18n"
 	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
@@ -45,10 +47,14 @@ type UIComponents struct {
 	FilesHandler *uihandlers.FilesHandler
 	// AccessHandler — обработчик страницы «Управление доступом».
 	AccessHandler *uihandlers.AccessHandler
+	// ProfileHandler — обработчик страницы профиля и personal access tokens.
+	ProfileHandler *uihandlers.ProfileHandler
 	// MonitoringHandler — обработчик страницы мониторинга.
 	MonitoringHandler *uihandlers.MonitoringHandler
-	// SettingsHandler — обработчик страницы настроек (admin only).
+	// SettingsHandler — обработчик страницы настроек (разрешение settings:write).
 	SettingsHandler *uihandlers.SettingsHandler
+	// AuditHandler — обработчик страницы журнала аудита (разрешение audit:read).
+	AuditHandler *uihandlers.AuditHandler
 	// EventsHandler — обработчик SSE endpoints для real-time обновлений.
 	EventsHandler *uihandlers.EventsHandler
 }
@@ -56,24 +62,42 @@ type UIComponents struct {
 // New создаёт новый HTTP-сервер с настроенными routes и middleware.
 // handler — реализация generated.ServerInterface (APIHandler).
 // jwtAuth — JWT middleware (может быть nil для тестирования без auth).
+// localIdP — встроенный IdP (nil при Keycloak): token endpoint и JWKS.
 // uiComponents — компоненты Admin UI (nil если UI отключён).
-func New(cfg *config.Config, logger *slog.Logger, handler generated.ServerInterface, jwtAuth *middleware.JWTAuth, uiComponents *UIComponents) *Server {
+func New(
+	cfg *config.Config,
+	logger *slog.Logger,
+	handler generated.ServerInterface,
+	jwtAuth *middleware.JWTAuth,
+	localIdP *local.Provider,
+	uiComponents *UIComponents,
+) *Server {
 	router := chi.NewRouter()
 
 	// Глобальные middleware (применяются ко ВСЕМ маршрутам)
+	router.Use(middleware.RequestID(cfg.TrustedProxies))
 	router.Use(middleware.MetricsMiddleware())
 	router.Use(middleware.RequestLogger(logger))
 
 	// JWT middleware с исключениями для публичных endpoints и UI.
 	// Health и metrics проверяются Kubernetes напрямую, без API Gateway.
 	// /admin/* и /static/* используют cookie-based auth (не JWT Bearer).
+	// /oauth2/* и /.well-known/* — публичные endpoints локального IdP.
 	if jwtAuth != nil {
-		router.Use(jwtAuthWithExclusions(jwtAuth, "/health/", "/metrics", "/admin", "/static/"))
+		router.Use(jwtAuthWithExclusions(jwtAuth,
+			"/health/", "/metrics", "/admin", "/static/", "/oauth2/", "/.well-known/"))
 	}
 
 	// Все API маршруты через HandlerFromMux (oapi-codegen chi-server).
 	generated.HandlerFromMux(handler, router)
 
+	// --- Локальный IdP ---
+	if localIdP != nil {
+		router.Post(local.TokenPath, localIdP.HandleToken)
+		router.Get(local.JWKSPath, localIdP.HandleJWKS)
+		router.Get(local.DiscoveryPath, localIdP.HandleDiscovery)
+	}
+
 	// --- Admin UI маршруты ---
 	if uiComponents != nil {
 		registerUIRoutes(router, uiComponents, logger)
@@ -101,7 +125,9 @@ func registerUIRoutes(router chi.Router, ui *UIComponents, logger *slog.Logger)
 	router.Handle("/static/*", http.StripPrefix("/static/", http.FileServer(staticFS)))
 
 	// Auth endpoints — без UI auth middleware (публичные)
-	router.Get("/admin/login", ui.AuthHandler.HandleLogin)
+	// Форма входа по паролю (локальный IdP) локализуется
+	router.With(i18n.Middleware()).Get("/admin/login", ui.AuthHandler.HandleLogin)
+	router.With(i18n.Middleware()).Post("/admin/login", ui.AuthHandler.HandleLoginSubmit)
 	router.Get("/admin/callback", ui.AuthHandler.HandleCallback)
 	router.Post("/admin/logout", ui.AuthHandler.HandleLogout)
 
@@ -116,56 +142,114 @@ func registerUIRoutes(router chi.Router, ui *UIComponents, logger *slog.Logger)
 		// Dashboard (главная страница Admin UI)
 		r.Get("/", ui.DashboardHandler.HandleDashboard)
 
+		// Разрешения проверяются на уровне маршрутов: чтение, изменение
+		// и операции над каждым ресурсом требуют своего разрешения
+		need := uimiddleware.RequirePermission
+
 		// --- Storage Elements ---
 		if ui.StorageElementsHandler != nil {
 			se := ui.StorageElementsHandler
-			r.Get("/storage-elements", se.HandleList)
-			r.Get("/storage-elements/{id}", se.HandleDetail)
+			read := r.With(need(rbac.PermStorageRead))
+			write := r.With(need(rbac.PermStorageWrite))
+			sync := r.With(need(rbac.PermStorageSync))
+			read.Get("/storage-elements", se.HandleList)
+			read.Get("/storage-elements/{id}", se.HandleDetail)
 
 			// HTMX partials для SE
-			r.Get("/partials/se-table", se.HandleTablePartial)
-			r.Post("/partials/se-discover", se.HandleDiscover)
-			r.Post("/partials/se-register", se.HandleRegister)
-			r.Get("/partials/se-edit-form/{id}", se.HandleEditForm)
-			r.Put("/partials/se-edit/{id}", se.HandleEdit)
-			r.Delete("/partials/se-delete/{id}", se.HandleDelete)
-			r.Post("/partials/se-sync/{id}", se.HandleSync)
-			r.Post("/partials/se-sync-all", se.HandleSyncAll)
-			r.Get("/partials/se-files/{id}", se.HandleFilesPartial)
+			read.Get("/partials/se-table", se.HandleTablePartial)
+			write.Post("/partials/se-discover", se.HandleDiscover)
+			write.Post("/partials/se-register", se.HandleRegister)
+			write.Get("/partials/se-edit-form/{id}", se.HandleEditForm)
+			write.Put("/partials/se-edit/{id}", se.HandleEdit)
+			write.Delete("/partials/se-delete/{id}", se.HandleDelete)
+			sync.Post("/partials/se-sync/{id}", se.HandleSync)
+			sync.Post("/partials/se-sync-all", se.HandleSyncAll)
+			sync.Post("/partials/sync-cancel/{id}", se.HandleSyncCancel)
+			read.Get("/partials/se-files/{id}", se.HandleFilesPartial)
+			read.Get("/partials/se-reconcile/{id}", se.HandleReconcilePanel)
+			sync.Post("/partials/se-reconcile/{id}", se.HandleReconcile)
 		}
 
 		// --- Файловый реестр ---
 		if ui.FilesHandler != nil {
 			f := ui.FilesHandler
-			r.Get("/files", f.HandleList)
+			read := r.With(need(rbac.PermFilesRead))
+			write := r.With(need(rbac.PermFilesWrite))
+			read.Get("/files", f.HandleList)
+			read.Get("/files/{id}/download", f.HandleDownload)
+			read.Get("/files/{id}/preview", f.HandlePreview)
 
 			// HTMX partials для файлов
-			r.Get("/partials/file-table", f.HandleTablePartial)
-			r.Get("/partials/file-detail/{id}", f.HandleDetailModal)
-			r.Get("/partials/file-edit-form/{id}", f.HandleEditForm)
-			r.Put("/partials/file-update/{id}", f.HandleUpdate)
-			r.Delete("/partials/file-delete/{id}", f.HandleDelete)
+			read.Get("/partials/file-table", f.HandleTablePartial)
+			read.Get("/partials/file-detail/{id}", f.HandleDetailModal)
+			write.Get("/partials/file-edit-form/{id}", f.HandleEditForm)
+			write.Put("/partials/file-update/{id}", f.HandleUpdate)
+			r.With(need(rbac.PermFilesDelete)).Delete("/partials/file-delete/{id}", f.HandleDelete)
+			// Групповое удаление дополнительно требует files:delete (проверяет обработчик)
+			write.Post("/partials/file-batch-confirm", f.HandleBatchConfirm)
+			write.Post("/partials/file-batch", f.HandleBatchStart)
+			read.Get("/partials/file-batch/{id}", f.HandleBatchProgress)
+			write.Post("/partials/file-batch-cancel/{id}", f.HandleBatchCancel)
+			write.Get("/partials/file-upload-form", f.HandleUploadForm)
+			write.Post("/partials/file-upload", f.HandleUpload)
 		}
 
 		// --- Управление доступом ---
 		if ui.AccessHandler != nil {
 			a := ui.AccessHandler
+			// Страница доступна всем: обработчик показывает табы по разрешениям
 			r.Get("/access", a.HandleAccess)
 
 			// HTMX partials для пользователей
-			r.Get("/partials/users-table", a.HandleUsersTablePartial)
-			r.Get("/partials/user-detail/{id}", a.HandleUserDetail)
-			r.Post("/partials/user-role-override/{id}", a.HandleAddRoleOverride)
-			r.Delete("/partials/user-role-override/{id}", a.HandleRemoveRoleOverride)
+			usersRead := r.With(need(rbac.PermUsersRead))
+			usersWrite := r.With(need(rbac.PermUsersWrite))
+			usersRead.Get("/partials/users-table", a.HandleUsersTablePartial)
+			usersRead.Get("/partials/user-detail/{id}", a.HandleUserDetail)
+			usersWrite.Post("/partials/user-role-override/{id}", a.HandleAddRoleOverride)
+			usersWrite.Delete("/partials/user-role-override/{id}", a.HandleRemoveRoleOverride)
+			usersRead.Get("/partials/ui-sessions", a.HandleSessionsPartial)
+			usersWrite.Delete("/partials/ui-session/{id}", a.HandleSessionRevoke)
+			usersWrite.Delete("/partials/user-sessions/{id}", a.HandleUserSessionsRevoke)
 
 			// HTMX partials для SA
-			r.Get("/partials/sa-table", a.HandleSATablePartial)
-			r.Post("/partials/sa-create", a.HandleSACreate)
-			r.Get("/partials/sa-edit-form/{id}", a.HandleSAEditForm)
-			r.Put("/partials/sa-edit/{id}", a.HandleSAEdit)
-			r.Delete("/partials/sa-delete/{id}", a.HandleSADelete)
-			r.Post("/partials/sa-rotate/{id}", a.HandleSARotateSecret)
-			r.Post("/partials/sa-sync", a.HandleSASync)
+			saWrite := r.With(need(rbac.PermServiceAccountsWrite))
+			r.With(need(rbac.PermServiceAccountsRead)).Get("/partials/sa-table", a.HandleSATablePartial)
+			saWrite.Post("/partials/sa-create", a.HandleSACreate)
+			saWrite.Get("/partials/sa-edit-form/{id}", a.HandleSAEditForm)
+			saWrite.Put("/partials/sa-edit/{id}", a.HandleSAEdit)
+			saWrite.Delete("/partials/sa-delete/{id}", a.HandleSADelete)
+			saWrite.Post("/partials/sa-rotate/{id}", a.HandleSARotateSecret)
+			r.With(need(rbac.PermIdpWrite)).Post("/partials/sa-sync", a.HandleSASync)
+
+			// HTMX partials для ролей
+			rolesWrite := r.With(need(rbac.PermRolesWrite))
+			r.With(need(rbac.PermRolesRead)).Get("/partials/roles-table", a.HandleRolesTablePartial)
+			rolesWrite.Get("/partials/role-form", a.HandleRoleForm)
+			rolesWrite.Post("/partials/roles", a.HandleRoleCreate)
+			rolesWrite.Put("/partials/roles/{name}", a.HandleRoleUpdate)
+			rolesWrite.Delete("/partials/roles/{name}", a.HandleRoleDelete)
+
+			// HTMX partials для коллекций файлов
+			collectionsRead := r.With(need(rbac.PermCollectionsRead))
+			collectionsWrite := r.With(need(rbac.PermCollectionsWrite))
+			collectionsRead.Get("/partials/collections-table", a.HandleCollectionsTablePartial)
+			collectionsWrite.Get("/partials/collection-form", a.HandleCollectionForm)
+			collectionsWrite.Post("/partials/collections", a.HandleCollectionCreate)
+			collectionsWrite.Put("/partials/collections/{id}", a.HandleCollectionUpdate)
+			collectionsWrite.Delete("/partials/collections/{id}", a.HandleCollectionDelete)
+			collectionsRead.Get("/partials/collection-members/{id}", a.HandleCollectionMembers)
+			collectionsWrite.Put("/partials/collection-members/{id}", a.HandleCollectionMemberSet)
+			collectionsWrite.Delete("/partials/collection-members/{id}/{type}/{subject}", a.HandleCollectionMemberRemove)
+		}
+
+		// --- Профиль и personal access tokens ---
+		// Токены других пользователей — users:read / users:write (проверяет обработчик)
+		if ui.ProfileHandler != nil {
+			p := ui.ProfileHandler
+			r.Get("/profile", p.HandleProfile)
+			r.Get("/partials/personal-tokens", p.HandleTokensPartial)
+			r.Post("/partials/personal-tokens", p.HandleTokenCreate)
+			r.Delete("/partials/personal-token/{id}", p.HandleTokenRevoke)
 		}
 
 		// --- Мониторинг ---
@@ -177,14 +261,32 @@ func registerUIRoutes(router chi.Router, ui *UIComponents, logger *slog.Logger)
 			r.Get("/partials/monitoring-charts", m.HandleChartsPartial)
 		}
 
-		// --- Настройки (admin only) ---
+		// --- Настройки (settings:write) ---
 		if ui.SettingsHandler != nil {
 			s := ui.SettingsHandler
-			r.Get("/settings", s.HandleSettings)
+			settings := r.With(need(rbac.PermSettingsWrite))
+			settings.Get("/settings", s.HandleSettings)
 
 			// HTMX partials для настроек Prometheus
-			r.Put("/partials/settings-prometheus", s.HandlePrometheusUpdate)
-			r.Post("/partials/settings-prometheus-test", s.HandlePrometheusTest)
+			settings.Put("/partials/settings-prometheus", s.HandlePrometheusUpdate)
+			settings.Post("/partials/settings-prometheus-test", s.HandlePrometheusTest)
+
+			// HTMX partials для оповещений и webhooks
+			settings.Get("/partials/settings-alert-rule-form", s.HandleAlertRuleForm)
+			settings.Post("/partials/settings-alert-rules", s.HandleAlertRuleCreate)
+			settings.Put("/partials/settings-alert-rules/{id}", s.HandleAlertRuleUpdate)
+			settings.Delete("/partials/settings-alert-rules/{id}", s.HandleAlertRuleDelete)
+			settings.Post("/partials/settings-webhooks", s.HandleWebhookCreate)
+			settings.Post("/partials/settings-webhooks/{id}/toggle", s.HandleWebhookToggle)
+			settings.Post("/partials/settings-webhooks/{id}/test", s.HandleWebhookTest)
+			settings.Delete("/partials/settings-webhooks/{id}", s.HandleWebhookDelete)
+		}
+
+		// --- Журнал аудита (audit:read) ---
+		if ui.AuditHandler != nil {
+			audit := r.With(need(rbac.PermAuditRead))
+			audit.Get("/audit", ui.AuditHandler.HandleAudit)
+			audit.Get("/partials/audit-table", ui.AuditHandler.HandleTablePartial)
 		}
 
 		// --- SSE endpoints для real-time обновлений ---
@@ -196,7 +298,7 @@ func registerUIRoutes(router chi.Router, ui *UIComponents, logger *slog.Logger)
 	logger.Info("Admin UI маршруты зарегистрированы",
 		slog.String("static", "/static/*"),
 		slog.String("auth", "/admin/login, /admin/callback, /admin/logout"),
-		slog.String("protected", "/admin/*, /admin/access, /admin/monitoring, /admin/settings, /admin/events/*"),
+		slog.String("protected", "/admin/*, /admin/access, /admin/profile, /admin/monitoring, /admin/settings, /admin/audit, /admin/events/*"),
 	)
 }
 
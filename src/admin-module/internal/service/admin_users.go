@@ -1,5 +1,6 @@
 // Пакет service — бизнес-логика Admin Module.
-// admin_users.go — сервис управления пользователями (Keycloak + локальные role overrides).
+// admin_users.go — сервис управления пользователями (IdP + локальные role overrides).
+// При локальном IdP сервис также управляет учётными записями пользователей.
 package service
 
 import (
@@ -11,33 +12,35 @@ import (
 	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
-	"github.com/bigkaa/goartstore/admin-module/internal/keycloak"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 )
 
 // AdminUserService — сервис управления пользователями.
-// Объединяет данные из Keycloak (основной источник) с локальными role overrides.
+// Объединяет данные из IdP (основной источник) с локальными role overrides.
 type AdminUserService struct {
-	kcClient       *keycloak.Client
-	roleRepo       repository.RoleOverrideRepository
-	adminGroups    []string
-	readonlyGroups []string
-	logger         *slog.Logger
+	provider idp.Provider
+	roleRepo repository.RoleOverrideRepository
+	audit    *AuditService
+	roles    rbac.CatalogProvider
+	logger   *slog.Logger
 }
 
 // NewAdminUserService создаёт сервис управления пользователями.
+// roles — каталог ролей для маппинга групп IdP и проверки role overrides.
 func NewAdminUserService(
-	kcClient *keycloak.Client,
+	provider idp.Provider,
 	roleRepo repository.RoleOverrideRepository,
-	adminGroups, readonlyGroups []string,
+	audit *AuditService,
+	roles rbac.CatalogProvider,
 	logger *slog.Logger,
 ) *AdminUserService {
 	return &AdminUserService{
-		kcClient:       kcClient,
-		roleRepo:       roleRepo,
-		adminGroups:    adminGroups,
-		readonlyGroups: readonlyGroups,
-		logger:         logger.With(slog.String("component", "admin_users_service")),
+		provider: provider,
+		roleRepo: roleRepo,
+		audit:    audit,
+		roles:    roles,
+		logger:   logger.With(slog.String("component", "admin_users_service")),
 	}
 }
 
@@ -51,34 +54,49 @@ func (s *AdminUserService) GetCurrentUser(claims *middleware.AuthClaims) *model.
 		IdpRole:       claims.IdpRole,
 		RoleOverride:  claims.RoleOverride,
 		EffectiveRole: claims.EffectiveRole,
+		Roles:         claims.AssignedRoles,
+		Permissions:   permissionStrings(claims.Permissions),
 	}
 }
 
-// ListUsers возвращает список пользователей из Keycloak с role overrides.
+// IdPName возвращает имя текущего Identity Provider.
+func (s *AdminUserService) IdPName() string {
+	return s.provider.Name()
+}
+
+// AccountsManaged сообщает, управляются ли учётные записи пользователей
+// через Admin Module (локальный IdP).
+func (s *AdminUserService) AccountsManaged() bool {
+	_, ok := s.provider.(idp.UserManager)
+	return ok
+}
+
+// ListUsers возвращает список пользователей из IdP с role overrides.
 func (s *AdminUserService) ListUsers(ctx context.Context, limit, offset int) ([]*model.AdminUser, int, error) {
-	// Получаем пользователей из Keycloak
-	kcUsers, err := s.kcClient.ListUsers(ctx, "", offset, limit)
+	// Получаем пользователей из IdP
+	idpUsers, err := s.provider.ListUsers(ctx, "", offset, limit)
 	if err != nil {
-		return nil, 0, fmt.Errorf("получение пользователей из Keycloak: %w", err)
+		return nil, 0, fmt.Errorf("получение пользователей из IdP: %w", err)
 	}
 
 	// Получаем общее количество пользователей
-	total, err := s.kcClient.CountUsers(ctx)
+	total, err := s.provider.CountUsers(ctx)
 	if err != nil {
-		return nil, 0, fmt.Errorf("подсчёт пользователей в Keycloak: %w", err)
+		return nil, 0, fmt.Errorf("подсчёт пользователей в IdP: %w", err)
 	}
 
 	// Формируем AdminUser для каждого пользователя
-	users := make([]*model.AdminUser, 0, len(kcUsers))
-	for _, kcUser := range kcUsers {
-		user, err := s.enrichUser(ctx, &kcUser)
+	users := make([]*model.AdminUser, 0, len(idpUsers))
+	for i := range idpUsers {
+		idpUser := &idpUsers[i]
+		user, err := s.enrichUser(ctx, idpUser)
 		if err != nil {
 			s.logger.Warn("Ошибка обогащения пользователя",
-				slog.String("user_id", kcUser.ID),
+				slog.String("user_id", idpUser.ID),
 				slog.String("error", err.Error()),
 			)
 			// Добавляем пользователя без обогащения
-			users = append(users, s.basicUser(&kcUser))
+			users = append(users, s.basicUser(idpUser))
 			continue
 		}
 		users = append(users, user)
@@ -87,54 +105,65 @@ func (s *AdminUserService) ListUsers(ctx context.Context, limit, offset int) ([]
 	return users, total, nil
 }
 
-// GetUser возвращает пользователя по Keycloak ID.
+// GetUser возвращает пользователя по ID в IdP.
 func (s *AdminUserService) GetUser(ctx context.Context, id string) (*model.AdminUser, error) {
-	kcUser, err := s.kcClient.GetUser(ctx, id)
+	idpUser, err := s.getIdPUser(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("получение пользователя из Keycloak: %w", err)
+		return nil, err
 	}
 
-	user, err := s.enrichUser(ctx, kcUser)
+	user, err := s.enrichUser(ctx, idpUser)
 	if err != nil {
 		s.logger.Warn("Ошибка обогащения пользователя, используем базовые данные",
 			slog.String("user_id", id),
 			slog.String("error", err.Error()),
 		)
-		return s.basicUser(kcUser), nil
+		return s.basicUser(idpUser), nil
 	}
 
 	return user, nil
 }
 
+// getIdPUser возвращает пользователя IdP (ErrNotFound, если его нет).
+func (s *AdminUserService) getIdPUser(ctx context.Context, id string) (*idp.User, error) {
+	idpUser, err := s.provider.GetUser(ctx, id)
+	if err != nil {
+		if errors.Is(err, idp.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение пользователя из IdP: %w", err)
+	}
+	return idpUser, nil
+}
+
 // UpdateUser обновляет role override пользователя (через PUT).
 // Если roleOverride == nil, удаляет override.
-func (s *AdminUserService) UpdateUser(ctx context.Context, id string, roleOverride *string, updatedBy string) (*model.AdminUser, error) {
-	// Проверяем, что пользователь существует в Keycloak
-	kcUser, err := s.kcClient.GetUser(ctx, id)
+func (s *AdminUserService) UpdateUser(ctx context.Context, id string, roleOverride *string, actor *middleware.AuthClaims) (*model.AdminUser, error) {
+	// Проверяем, что пользователь существует в IdP
+	idpUser, err := s.getIdPUser(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("получение пользователя из Keycloak: %w", err)
+		return nil, err
 	}
 
 	if roleOverride == nil {
-		// Удаляем override
-		err = s.roleRepo.Delete(ctx, id)
-		if err != nil && !errors.Is(err, repository.ErrNotFound) {
-			return nil, fmt.Errorf("удаление role override: %w", err)
+		// Удаляем override (если он есть)
+		if err := s.deleteRoleOverride(ctx, id, false); err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, err
 		}
 	} else {
 		// Валидируем роль
-		if !rbac.IsValidRole(*roleOverride) {
-			return nil, ErrInvalidRole
+		if err := s.checkAssignableRole(*roleOverride, actor); err != nil {
+			return nil, err
 		}
 		// Upsert override
 		ro := &model.RoleOverride{
 			KeycloakUserID: id,
-			Username:       kcUser.Username,
+			Username:       idpUser.Username,
 			AdditionalRole: *roleOverride,
-			CreatedBy:      updatedBy,
+			CreatedBy:      actor.PreferredUsername,
 		}
-		if err := s.roleRepo.Upsert(ctx, ro); err != nil {
-			return nil, fmt.Errorf("установка role override: %w", err)
+		if err := s.upsertRoleOverride(ctx, ro); err != nil {
+			return nil, err
 		}
 	}
 
@@ -142,102 +171,225 @@ func (s *AdminUserService) UpdateUser(ctx context.Context, id string, roleOverri
 	return s.GetUser(ctx, id)
 }
 
-// DeleteUser удаляет role override пользователя.
+// DeleteUser удаляет role override пользователя и завершает все его UI-сессии.
 func (s *AdminUserService) DeleteUser(ctx context.Context, id string) error {
-	// Проверяем, что override существует
-	err := s.roleRepo.Delete(ctx, id)
-	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return ErrNotFound
-		}
-		return fmt.Errorf("удаление role override: %w", err)
-	}
-	return nil
+	return s.deleteRoleOverride(ctx, id, true)
+}
+
+// RemoveRoleOverride удаляет role override пользователя. UI-сессии, роль
+// которых без override понижается, завершаются.
+func (s *AdminUserService) RemoveRoleOverride(ctx context.Context, id string) error {
+	return s.deleteRoleOverride(ctx, id, false)
 }
 
 // SetRoleOverride устанавливает role override для пользователя.
-func (s *AdminUserService) SetRoleOverride(ctx context.Context, id, role, createdBy string) (*model.AdminUser, error) {
+// Назначить можно любую роль каталога, все разрешения которой есть у actor.
+func (s *AdminUserService) SetRoleOverride(ctx context.Context, id, role string, actor *middleware.AuthClaims) (*model.AdminUser, error) {
 	// Валидируем роль
-	if !rbac.IsValidRole(role) {
-		return nil, ErrInvalidRole
+	if err := s.checkAssignableRole(role, actor); err != nil {
+		return nil, err
 	}
 
-	// Проверяем, что пользователь существует в Keycloak
-	kcUser, err := s.kcClient.GetUser(ctx, id)
+	// Проверяем, что пользователь существует в IdP
+	idpUser, err := s.getIdPUser(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("получение пользователя из Keycloak: %w", err)
+		return nil, err
 	}
 
 	// Upsert override
 	ro := &model.RoleOverride{
 		KeycloakUserID: id,
-		Username:       kcUser.Username,
+		Username:       idpUser.Username,
 		AdditionalRole: role,
-		CreatedBy:      createdBy,
+		CreatedBy:      actor.PreferredUsername,
 	}
-	if err := s.roleRepo.Upsert(ctx, ro); err != nil {
-		return nil, fmt.Errorf("установка role override: %w", err)
+	if err := s.upsertRoleOverride(ctx, ro); err != nil {
+		return nil, err
 	}
 
 	// Возвращаем обновлённого пользователя
 	return s.GetUser(ctx, id)
 }
 
-// enrichUser обогащает данные пользователя Keycloak группами и role overrides.
-func (s *AdminUserService) enrichUser(ctx context.Context, kcUser *keycloak.KeycloakUser) (*model.AdminUser, error) {
+// checkAssignableRole проверяет, что роль есть в каталоге (ErrInvalidRole)
+// и что actor не выдаёт разрешений, которых нет у него самого (ErrForbidden).
+func (s *AdminUserService) checkAssignableRole(role string, actor *middleware.AuthClaims) error {
+	r, ok := s.roles.Catalog().Role(role)
+	if !ok {
+		return ErrInvalidRole
+	}
+	return checkRoleEscalation(actor, r.PermissionSet())
+}
+
+// upsertRoleOverride сохраняет role override и событие аудита в одной транзакции.
+// UI-сессии пользователя, роль которых с новым override понижается, завершаются
+// в той же транзакции.
+func (s *AdminUserService) upsertRoleOverride(ctx context.Context, ro *model.RoleOverride) error {
+	current, err := s.currentRoleOverride(ctx, ro.KeycloakUserID)
+	if err != nil {
+		return err
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionRoleOverrideSet,
+		TargetType: model.AuditTargetUser,
+		TargetID:   ro.KeycloakUserID,
+		Before:     roleOverrideAuditState(ro.Username, current),
+		After:      roleOverrideAuditState(ro.Username, &ro.AdditionalRole),
+	}
+	var revoked int
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		if err := repository.NewRoleOverrideRepository(db).Upsert(ctx, ro); err != nil {
+			return err
+		}
+		var revokeErr error
+		revoked, revokeErr = revokeDowngradedSessions(ctx, repository.NewUISessionRepository(db), s.roles.Catalog(), ro.KeycloakUserID, &ro.AdditionalRole)
+		return revokeErr
+	})
+	if err != nil {
+		return fmt.Errorf("установка role override: %w", err)
+	}
+	s.logRevokedSessions(ro.KeycloakUserID, revoked)
+	return nil
+}
+
+// deleteRoleOverride удаляет role override и записывает событие аудита.
+// В той же транзакции завершаются все UI-сессии пользователя (revokeAll)
+// или только сессии, роль которых понижается.
+// Возвращает ErrNotFound, если override отсутствует.
+func (s *AdminUserService) deleteRoleOverride(ctx context.Context, id string, revokeAll bool) error {
+	ro, err := s.roleRepo.GetByKeycloakUserID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("получение role override: %w", err)
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionRoleOverrideDelete,
+		TargetType: model.AuditTargetUser,
+		TargetID:   id,
+		Before:     roleOverrideAuditState(ro.Username, &ro.AdditionalRole),
+	}
+	var revoked int
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		if err := repository.NewRoleOverrideRepository(db).Delete(ctx, id); err != nil {
+			return err
+		}
+		sessions := repository.NewUISessionRepository(db)
+		var revokeErr error
+		if revokeAll {
+			revoked, revokeErr = sessions.DeleteBySubject(ctx, id)
+		} else {
+			revoked, revokeErr = revokeDowngradedSessions(ctx, sessions, s.roles.Catalog(), id, nil)
+		}
+		return revokeErr
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("удаление role override: %w", err)
+	}
+	s.logRevokedSessions(id, revoked)
+	return nil
+}
+
+// logRevokedSessions записывает в лог завершение UI-сессий пользователя.
+func (s *AdminUserService) logRevokedSessions(userID string, count int) {
+	if count > 0 {
+		s.logger.Info("UI-сессии пользователя завершены после изменения роли",
+			slog.String("user_id", userID),
+			slog.Int("count", count),
+		)
+	}
+}
+
+// currentRoleOverride возвращает текущую дополнительную роль пользователя (nil, если нет).
+func (s *AdminUserService) currentRoleOverride(ctx context.Context, id string) (*string, error) {
+	ro, err := s.roleRepo.GetByKeycloakUserID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("получение role override: %w", err)
+	}
+	return &ro.AdditionalRole, nil
+}
+
+// roleOverrideAuditState — состояние role override для журнала аудита.
+func roleOverrideAuditState(username string, role *string) map[string]any {
+	return map[string]any{
+		"username":      username,
+		"role_override": role,
+	}
+}
+
+// enrichUser обогащает данные пользователя IdP группами и role overrides.
+func (s *AdminUserService) enrichUser(ctx context.Context, idpUser *idp.User) (*model.AdminUser, error) {
 	// Получаем группы пользователя
-	kcGroups, err := s.kcClient.GetUserGroups(ctx, kcUser.ID)
+	idpGroups, err := s.provider.GetUserGroups(ctx, idpUser.ID)
 	if err != nil {
 		return nil, fmt.Errorf("получение групп: %w", err)
 	}
 
-	groups := make([]string, len(kcGroups))
-	for i, g := range kcGroups {
+	groups := make([]string, len(idpGroups))
+	for i, g := range idpGroups {
 		groups[i] = g.Name
 	}
 
-	// Вычисляем роль из групп
-	idpRole := rbac.MapGroupsToRole(groups, s.adminGroups, s.readonlyGroups)
-
 	// Получаем role override из БД
 	var roleOverride *string
-	ro, err := s.roleRepo.GetByKeycloakUserID(ctx, kcUser.ID)
+	ro, err := s.roleRepo.GetByKeycloakUserID(ctx, idpUser.ID)
 	if err == nil {
 		roleOverride = &ro.AdditionalRole
 	} else if !errors.Is(err, repository.ErrNotFound) {
 		s.logger.Warn("Ошибка получения role override",
-			slog.String("user_id", kcUser.ID),
+			slog.String("user_id", idpUser.ID),
 			slog.String("error", err.Error()),
 		)
 	}
 
-	// Вычисляем effective role
-	effectiveRole := rbac.EffectiveRole(idpRole, roleOverride)
+	// Вычисляем роли и разрешения по каталогу
+	assignment := s.roles.Catalog().Resolve(groups, nil, roleOverride)
 
 	return &model.AdminUser{
-		ID:            kcUser.ID,
-		Username:      kcUser.Username,
-		Email:         kcUser.Email,
-		FirstName:     kcUser.FirstName,
-		LastName:      kcUser.LastName,
-		Enabled:       kcUser.Enabled,
+		ID:            idpUser.ID,
+		Username:      idpUser.Username,
+		Email:         idpUser.Email,
+		FirstName:     idpUser.FirstName,
+		LastName:      idpUser.LastName,
+		Enabled:       idpUser.Enabled,
 		Groups:        groups,
-		IdpRole:       idpRole,
+		IdpRole:       assignment.IdpRole,
 		RoleOverride:  roleOverride,
-		EffectiveRole: effectiveRole,
-		CreatedAt:     kcUser.CreatedAtTime(),
+		EffectiveRole: assignment.EffectiveRole,
+		Roles:         assignment.Roles,
+		Permissions:   permissionStrings(assignment.Permissions),
+		CreatedAt:     idpUser.CreatedAt,
 	}, nil
 }
 
 // basicUser создаёт AdminUser без обогащения (fallback при ошибке).
-func (s *AdminUserService) basicUser(kcUser *keycloak.KeycloakUser) *model.AdminUser {
+func (s *AdminUserService) basicUser(idpUser *idp.User) *model.AdminUser {
 	return &model.AdminUser{
-		ID:        kcUser.ID,
-		Username:  kcUser.Username,
-		Email:     kcUser.Email,
-		FirstName: kcUser.FirstName,
-		LastName:  kcUser.LastName,
-		Enabled:   kcUser.Enabled,
-		CreatedAt: kcUser.CreatedAtTime(),
+		ID:        idpUser.ID,
+		Username:  idpUser.Username,
+		Email:     idpUser.Email,
+		FirstName: idpUser.FirstName,
+		LastName:  idpUser.LastName,
+		Enabled:   idpUser.Enabled,
+		CreatedAt: idpUser.CreatedAt,
+	}
+}
+
+// permissionStrings возвращает разрешения набора строками в порядке каталога.
+func permissionStrings(set rbac.PermissionSet) []string {
+	perms := set.List()
+	out := make([]string, len(perms))
+	for i, p := range perms {
+		out[i] = string(p)
 	}
+	return out
 }
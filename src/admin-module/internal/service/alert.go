@@ -0,0 +1,872 @@
+// alert.go — правила оповещений о событиях хранилища.
+//
+// AlertService с периодом AM_ALERT_EVAL_INTERVAL проверяет включённые
+// правила. Для каждого правила вычисляется набор объектов (SE, SA,
+// зависимость), для которых выполнено условие:
+//   - se_status — SE не в статусе online
+//   - capacity — заполнение SE не меньше threshold процентов (по умолчанию 90)
+//   - capacity_forecast — прогноз заполнения SE не больше threshold дней (14)
+//   - sync_errors — не меньше threshold неудачных синхронизаций SE подряд (3)
+//   - sa_secret_expiry — секрет SA истекает не позже чем через threshold дней (7)
+//   - dephealth — последняя проверка зависимости неуспешна
+//
+// Правила SE-типов можно ограничить одним SE (storage_element_id).
+// Новые объекты сохраняются в alert_states, и получателям правила
+// отправляется событие alert.firing; исчезнувшие удаляются с событием
+// alert.resolved. Пока условие выполняется, повторные события не отправляются.
+// Ошибка проверки правила не меняет его состояние.
+//
+// Prometheus-метрики:
+//   - admin_module_alerts_firing — количество сработавших оповещений по типу правила
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// alertsFiring — количество сработавших оповещений по типу правила.
+var alertsFiring = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "admin_module_alerts_firing",
+	Help: "Количество сработавших оповещений",
+}, []string{"type"})
+
+// alertRuleTypes — допустимые типы правил в порядке отображения.
+var alertRuleTypes = []string{
+	model.AlertRuleSEStatus,
+	model.AlertRuleCapacity,
+	model.AlertRuleCapacityForecast,
+	model.AlertRuleSyncErrors,
+	model.AlertRuleSASecretExpiry,
+	model.AlertRuleDephealth,
+}
+
+// AlertRuleTypes возвращает допустимые типы правил оповещений.
+func AlertRuleTypes() []string {
+	return append([]string(nil), alertRuleTypes...)
+}
+
+// alertSyncRunsExtra — сколько синхронизаций сверх порога читать для подсчёта
+// серии ошибок (queued/running в начале списка пропускаются).
+const alertSyncRunsExtra = 10
+
+// SecretExpiry — срок действия секрета Service Account.
+type SecretExpiry struct {
+	// ServiceAccountID — UUID SA
+	ServiceAccountID string
+	// Name — имя SA
+	Name string
+	// ExpiresAt — время истечения секрета
+	ExpiresAt time.Time
+}
+
+// SecretExpirySource — источник сроков действия секретов SA
+// для правил sa_secret_expiry. Пока источник не задан,
+// такие правила не срабатывают.
+type SecretExpirySource interface {
+	// SecretsExpiringBefore возвращает SA, секрет которых истекает раньше before.
+	SecretsExpiringBefore(ctx context.Context, before time.Time) ([]SecretExpiry, error)
+}
+
+// AlertRuleInput — параметры создания и замены правила оповещения.
+type AlertRuleInput struct {
+	Name string
+	Type string
+	// Threshold — порог; nil — значение по умолчанию для типа
+	Threshold *float64
+	// StorageElementID — ограничение правила одним SE (только SE-типы)
+	StorageElementID *string
+	Enabled          bool
+	EndpointIDs      []string
+}
+
+// AlertEvalResult — итог одной проверки правил.
+type AlertEvalResult struct {
+	// Rules — количество проверенных правил
+	Rules int
+	// Fired — новых сработавших оповещений
+	Fired int
+	// Resolved — восстановленных оповещений
+	Resolved int
+}
+
+// alertCandidate — объект, для которого выполнено условие правила.
+type alertCandidate struct {
+	Subject     string
+	SubjectName string
+	Message     string
+}
+
+// AlertService — управление правилами оповещений и их периодическая проверка.
+type AlertService struct {
+	ruleRepo    repository.AlertRuleRepository
+	stateRepo   repository.AlertStateRepository
+	seRepo      repository.StorageElementRepository
+	syncRunRepo repository.SyncRunRepository
+	capacitySvc *CapacityService
+	webhookSvc  *WebhookService
+	audit       *AuditService
+	interval    time.Duration
+	logger      *slog.Logger
+
+	// Опциональные источники данных
+	dephealthSvc *DephealthService
+	secretSource SecretExpirySource
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewAlertService создаёт сервис оповещений.
+func NewAlertService(
+	ruleRepo repository.AlertRuleRepository,
+	stateRepo repository.AlertStateRepository,
+	seRepo repository.StorageElementRepository,
+	syncRunRepo repository.SyncRunRepository,
+	capacitySvc *CapacityService,
+	webhookSvc *WebhookService,
+	audit *AuditService,
+	interval time.Duration,
+	logger *slog.Logger,
+) *AlertService {
+	return &AlertService{
+		ruleRepo:    ruleRepo,
+		stateRepo:   stateRepo,
+		seRepo:      seRepo,
+		syncRunRepo: syncRunRepo,
+		capacitySvc: capacitySvc,
+		webhookSvc:  webhookSvc,
+		audit:       audit,
+		interval:    interval,
+		logger:      logger.With(slog.String("component", "alerting")),
+	}
+}
+
+// SetDephealthService задаёт источник результатов проверок зависимостей
+// для правил dephealth.
+func (s *AlertService) SetDephealthService(ds *DephealthService) {
+	s.dephealthSvc = ds
+}
+
+// SetSecretExpirySource задаёт источник сроков действия секретов SA
+// для правил sa_secret_expiry.
+func (s *AlertService) SetSecretExpirySource(src SecretExpirySource) {
+	s.secretSource = src
+}
+
+// Start запускает периодическую проверку правил.
+func (s *AlertService) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		s.logger.Info("Проверка правил оповещений запущена",
+			slog.String("interval", s.interval.String()),
+		)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Проверка правил оповещений остановлена")
+				return
+			case <-ticker.C:
+			}
+
+			result, err := s.RunOnce(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					s.logger.Error("Ошибка проверки правил оповещений", slog.String("error", err.Error()))
+				}
+				continue
+			}
+			if result.Fired > 0 || result.Resolved > 0 {
+				s.logger.Info("Состояние оповещений изменилось",
+					slog.Int("fired", result.Fired),
+					slog.Int("resolved", result.Resolved),
+				)
+			}
+		}
+	}()
+}
+
+// Stop останавливает фоновую горутину и ждёт завершения.
+func (s *AlertService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+// --- Правила ---
+
+// Create создаёт правило оповещения.
+func (s *AlertService) Create(ctx context.Context, in AlertRuleInput) (*model.AlertRule, error) {
+	rule := &model.AlertRule{ID: uuid.New().String()}
+	if err := s.applyInput(ctx, rule, in); err != nil {
+		return nil, err
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionAlertRuleCreate,
+		TargetType: model.AuditTargetAlertRule,
+		TargetID:   rule.ID,
+		After:      alertRuleAuditState(rule),
+	}
+	err := s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		repo := repository.NewAlertRuleRepository(db)
+		if err := repo.Create(ctx, rule); err != nil {
+			return err
+		}
+		return repo.SetEndpoints(ctx, rule.ID, rule.EndpointIDs)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, fmt.Errorf("%w: правило '%s' уже существует", ErrConflict, rule.Name)
+		}
+		return nil, fmt.Errorf("сохранение правила оповещения: %w", err)
+	}
+
+	s.logger.Info("Правило оповещения создано",
+		slog.String("rule_id", rule.ID),
+		slog.String("name", rule.Name),
+		slog.String("type", rule.Type),
+	)
+	return rule, nil
+}
+
+// Get возвращает правило по ID.
+func (s *AlertService) Get(ctx context.Context, id string) (*model.AlertRule, error) {
+	rule, err := s.ruleRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение правила оповещения: %w", err)
+	}
+	return rule, nil
+}
+
+// List возвращает все правила оповещений.
+func (s *AlertService) List(ctx context.Context) ([]*model.AlertRule, error) {
+	rules, err := s.ruleRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("получение списка правил оповещений: %w", err)
+	}
+	return rules, nil
+}
+
+// Update заменяет параметры правила. При изменении условия или отключении
+// правила его сработавшие оповещения сбрасываются (без событий resolved).
+func (s *AlertService) Update(ctx context.Context, id string, in AlertRuleInput) (*model.AlertRule, error) {
+	rule, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := alertRuleAuditState(rule)
+	old := *rule
+
+	if err := s.applyInput(ctx, rule, in); err != nil {
+		return nil, err
+	}
+	resetStates := !rule.Enabled ||
+		rule.Type != old.Type ||
+		!equalFloatPtr(rule.Threshold, old.Threshold) ||
+		!equalStringPtr(rule.StorageElementID, old.StorageElementID)
+
+	change := &AuditChange{
+		Action:     model.AuditActionAlertRuleUpdate,
+		TargetType: model.AuditTargetAlertRule,
+		TargetID:   id,
+		Before:     before,
+		After:      alertRuleAuditState(rule),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		repo := repository.NewAlertRuleRepository(db)
+		if err := repo.Update(ctx, rule); err != nil {
+			return err
+		}
+		if err := repo.SetEndpoints(ctx, rule.ID, rule.EndpointIDs); err != nil {
+			return err
+		}
+		if resetStates {
+			return repository.NewAlertStateRepository(db).DeleteByRule(ctx, rule.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, ErrNotFound
+		case errors.Is(err, repository.ErrConflict):
+			return nil, fmt.Errorf("%w: правило '%s' уже существует", ErrConflict, rule.Name)
+		}
+		return nil, fmt.Errorf("обновление правила оповещения: %w", err)
+	}
+
+	s.logger.Info("Правило оповещения обновлено", slog.String("rule_id", id))
+	return rule, nil
+}
+
+// Delete удаляет правило вместе с его оповещениями.
+func (s *AlertService) Delete(ctx context.Context, id string) error {
+	rule, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionAlertRuleDelete,
+		TargetType: model.AuditTargetAlertRule,
+		TargetID:   id,
+		Before:     alertRuleAuditState(rule),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewAlertRuleRepository(db).Delete(ctx, id)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("удаление правила оповещения: %w", err)
+	}
+
+	s.logger.Info("Правило оповещения удалено", slog.String("rule_id", id))
+	return nil
+}
+
+// ListAlerts возвращает сработавшие оповещения (новые первыми).
+func (s *AlertService) ListAlerts(ctx context.Context) ([]*model.Alert, error) {
+	alerts, err := s.stateRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("получение сработавших оповещений: %w", err)
+	}
+	return alerts, nil
+}
+
+// applyInput проверяет параметры и переносит их в правило.
+func (s *AlertService) applyInput(ctx context.Context, rule *model.AlertRule, in AlertRuleInput) error {
+	if in.Name == "" || len(in.Name) > 100 {
+		return fmt.Errorf("%w: имя правила должно быть от 1 до 100 символов", ErrValidation)
+	}
+	threshold, err := normalizeAlertThreshold(in.Type, in.Threshold)
+	if err != nil {
+		return err
+	}
+
+	seID := in.StorageElementID
+	if seID != nil && *seID == "" {
+		seID = nil
+	}
+	if seID != nil {
+		if !alertRuleForSE(in.Type) {
+			return fmt.Errorf("%w: storage_element_id допустим только для правил о Storage Elements", ErrValidation)
+		}
+		if _, err := s.seRepo.GetByID(ctx, *seID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return fmt.Errorf("%w: Storage Element %s не найден", ErrValidation, *seID)
+			}
+			return fmt.Errorf("получение SE: %w", err)
+		}
+	}
+
+	endpointIDs := make([]string, 0, len(in.EndpointIDs))
+	seen := make(map[string]bool, len(in.EndpointIDs))
+	for _, epID := range in.EndpointIDs {
+		if seen[epID] {
+			continue
+		}
+		seen[epID] = true
+		if _, err := uuid.Parse(epID); err != nil {
+			return fmt.Errorf("%w: webhook %s не найден", ErrValidation, epID)
+		}
+		if _, err := s.webhookSvc.GetEndpoint(ctx, epID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return fmt.Errorf("%w: webhook %s не найден", ErrValidation, epID)
+			}
+			return err
+		}
+		endpointIDs = append(endpointIDs, epID)
+	}
+	sort.Strings(endpointIDs)
+
+	rule.Name = in.Name
+	rule.Type = in.Type
+	rule.Threshold = threshold
+	rule.StorageElementID = seID
+	rule.Enabled = in.Enabled
+	rule.EndpointIDs = endpointIDs
+	return nil
+}
+
+// normalizeAlertThreshold проверяет порог для типа правила и подставляет
+// значение по умолчанию.
+func normalizeAlertThreshold(ruleType string, threshold *float64) (*float64, error) {
+	var def float64
+	switch ruleType {
+	case model.AlertRuleSEStatus, model.AlertRuleDephealth:
+		if threshold != nil {
+			return nil, fmt.Errorf("%w: правило %s не использует threshold", ErrValidation, ruleType)
+		}
+		return nil, nil
+	case model.AlertRuleCapacity:
+		def = 90
+	case model.AlertRuleCapacityForecast:
+		def = 14
+	case model.AlertRuleSyncErrors:
+		def = 3
+	case model.AlertRuleSASecretExpiry:
+		def = 7
+	default:
+		return nil, fmt.Errorf("%w: неизвестный тип правила '%s'", ErrValidation, ruleType)
+	}
+
+	if threshold == nil {
+		return &def, nil
+	}
+	t := *threshold
+	switch ruleType {
+	case model.AlertRuleCapacity:
+		if t <= 0 || t > 100 {
+			return nil, fmt.Errorf("%w: порог заполнения должен быть в диапазоне (0, 100]", ErrValidation)
+		}
+	case model.AlertRuleCapacityForecast:
+		if t <= 0 {
+			return nil, fmt.Errorf("%w: порог прогноза должен быть больше 0 дней", ErrValidation)
+		}
+	case model.AlertRuleSyncErrors:
+		if t < 1 || t != math.Trunc(t) {
+			return nil, fmt.Errorf("%w: порог ошибок синхронизации должен быть целым числом >= 1", ErrValidation)
+		}
+	case model.AlertRuleSASecretExpiry:
+		if t < 0 {
+			return nil, fmt.Errorf("%w: порог истечения секрета не может быть отрицательным", ErrValidation)
+		}
+	}
+	return &t, nil
+}
+
+// alertRuleForSE возвращает true для правил, проверяющих Storage Elements.
+func alertRuleForSE(ruleType string) bool {
+	switch ruleType {
+	case model.AlertRuleSEStatus, model.AlertRuleCapacity,
+		model.AlertRuleCapacityForecast, model.AlertRuleSyncErrors:
+		return true
+	}
+	return false
+}
+
+// --- Проверка правил ---
+
+// RunOnce проверяет все правила и отправляет события изменений.
+func (s *AlertService) RunOnce(ctx context.Context) (*AlertEvalResult, error) {
+	rules, err := s.ruleRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("получение правил оповещений: %w", err)
+	}
+	alerts, err := s.stateRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("получение сработавших оповещений: %w", err)
+	}
+	byRule := make(map[string][]*model.Alert)
+	for _, a := range alerts {
+		byRule[a.RuleID] = append(byRule[a.RuleID], a)
+	}
+
+	result := &AlertEvalResult{}
+	data := &alertData{}
+	now := time.Now().UTC()
+
+	for _, rule := range rules {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		if !rule.Enabled {
+			if len(byRule[rule.ID]) > 0 {
+				if err := s.stateRepo.DeleteByRule(ctx, rule.ID); err != nil {
+					return result, err
+				}
+			}
+			continue
+		}
+		result.Rules++
+
+		candidates, err := s.evaluate(ctx, rule, data)
+		if err != nil {
+			s.logger.Warn("Ошибка проверки правила оповещения",
+				slog.String("rule", rule.Name),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		fired, resolved := diffAlerts(byRule[rule.ID], candidates)
+		for _, c := range fired {
+			alert := &model.Alert{
+				RuleID:      rule.ID,
+				Subject:     c.Subject,
+				SubjectName: c.SubjectName,
+				Message:     c.Message,
+				FiringSince: now,
+			}
+			if err := s.stateRepo.Create(ctx, alert); err != nil {
+				return result, err
+			}
+			s.notify(ctx, rule, alert, model.WebhookEventFiring, now)
+			result.Fired++
+		}
+		for _, alert := range resolved {
+			if err := s.stateRepo.Delete(ctx, rule.ID, alert.Subject); err != nil {
+				return result, err
+			}
+			s.notify(ctx, rule, alert, model.WebhookEventResolved, now)
+			result.Resolved++
+		}
+	}
+
+	s.updateFiringGauge(ctx)
+	return result, nil
+}
+
+// alertData — данные, общие для правил одной проверки (загружаются лениво).
+type alertData struct {
+	ses      []*model.StorageElement
+	overview *CapacityOverview
+}
+
+// storageElements возвращает SE, к которым применяется правило.
+func (s *AlertService) storageElements(ctx context.Context, rule *model.AlertRule, data *alertData) ([]*model.StorageElement, error) {
+	if data.ses == nil {
+		ses, err := s.seRepo.List(ctx, repository.StorageElementFilters{}, 1000, 0)
+		if err != nil {
+			return nil, fmt.Errorf("получение списка SE: %w", err)
+		}
+		data.ses = ses
+	}
+	if rule.StorageElementID == nil {
+		return data.ses, nil
+	}
+	for _, se := range data.ses {
+		if se.ID == *rule.StorageElementID {
+			return []*model.StorageElement{se}, nil
+		}
+	}
+	return nil, nil
+}
+
+// evaluate возвращает объекты, для которых выполнено условие правила.
+func (s *AlertService) evaluate(ctx context.Context, rule *model.AlertRule, data *alertData) ([]alertCandidate, error) {
+	threshold := 0.0
+	if rule.Threshold != nil {
+		threshold = *rule.Threshold
+	}
+
+	switch rule.Type {
+	case model.AlertRuleSEStatus:
+		ses, err := s.storageElements(ctx, rule, data)
+		if err != nil {
+			return nil, err
+		}
+		return evalSEStatus(ses), nil
+
+	case model.AlertRuleCapacity:
+		ses, err := s.storageElements(ctx, rule, data)
+		if err != nil {
+			return nil, err
+		}
+		return evalCapacity(ses, threshold), nil
+
+	case model.AlertRuleCapacityForecast:
+		ses, err := s.storageElements(ctx, rule, data)
+		if err != nil {
+			return nil, err
+		}
+		if data.overview == nil {
+			overview, err := s.capacitySvc.Overview(ctx)
+			if err != nil {
+				return nil, err
+			}
+			data.overview = overview
+		}
+		return evalCapacityForecast(ses, data.overview.PerSE, threshold), nil
+
+	case model.AlertRuleSyncErrors:
+		ses, err := s.storageElements(ctx, rule, data)
+		if err != nil {
+			return nil, err
+		}
+		var result []alertCandidate
+		for _, se := range ses {
+			seID := se.ID
+			runs, err := s.syncRunRepo.List(ctx,
+				repository.SyncRunFilters{StorageElementID: &seID},
+				int(threshold)+alertSyncRunsExtra, 0)
+			if err != nil {
+				return nil, fmt.Errorf("получение синхронизаций SE: %w", err)
+			}
+			if streak := syncErrorStreak(runs); float64(streak) >= threshold {
+				result = append(result, alertCandidate{
+					Subject:     se.ID,
+					SubjectName: se.Name,
+					Message:     fmt.Sprintf("Неудачных синхронизаций подряд: %d", streak),
+				})
+			}
+		}
+		return result, nil
+
+	case model.AlertRuleSASecretExpiry:
+		if s.secretSource == nil {
+			return nil, nil
+		}
+		now := time.Now().UTC()
+		before := now.Add(time.Duration(threshold * float64(24*time.Hour)))
+		secrets, err := s.secretSource.SecretsExpiringBefore(ctx, before)
+		if err != nil {
+			return nil, fmt.Errorf("получение сроков действия секретов SA: %w", err)
+		}
+		return evalSecretExpiry(secrets, now), nil
+
+	case model.AlertRuleDephealth:
+		if s.dephealthSvc == nil {
+			return nil, nil
+		}
+		return evalDephealth(s.dephealthSvc.Health()), nil
+	}
+
+	return nil, fmt.Errorf("неизвестный тип правила '%s'", rule.Type)
+}
+
+// notify ставит событие оповещения в очередь доставки получателям правила.
+func (s *AlertService) notify(ctx context.Context, rule *model.AlertRule, alert *model.Alert, event string, now time.Time) {
+	if len(rule.EndpointIDs) == 0 {
+		return
+	}
+	payload, err := alertPayload(rule, alert, event, now)
+	if err != nil {
+		s.logger.Error("Ошибка формирования события оповещения", slog.String("error", err.Error()))
+		return
+	}
+	ruleID := rule.ID
+	if _, err := s.webhookSvc.Enqueue(ctx, rule.EndpointIDs, &ruleID, event, payload); err != nil {
+		s.logger.Error("Ошибка постановки оповещения в очередь доставки",
+			slog.String("rule", rule.Name),
+			slog.String("subject", alert.Subject),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// updateFiringGauge обновляет метрику сработавших оповещений.
+func (s *AlertService) updateFiringGauge(ctx context.Context) {
+	alerts, err := s.stateRepo.List(ctx)
+	if err != nil {
+		return
+	}
+	counts := make(map[string]int, len(alertRuleTypes))
+	for _, a := range alerts {
+		counts[a.RuleType]++
+	}
+	for _, t := range alertRuleTypes {
+		alertsFiring.WithLabelValues(t).Set(float64(counts[t]))
+	}
+}
+
+// alertPayload формирует JSON-тело события alert.firing / alert.resolved.
+func alertPayload(rule *model.AlertRule, alert *model.Alert, event string, now time.Time) (json.RawMessage, error) {
+	return json.Marshal(map[string]any{
+		"event":       event,
+		"occurred_at": now,
+		"rule": map[string]any{
+			"id":        rule.ID,
+			"name":      rule.Name,
+			"type":      rule.Type,
+			"threshold": rule.Threshold,
+		},
+		"alert": map[string]any{
+			"subject":      alert.Subject,
+			"subject_name": alert.SubjectName,
+			"message":      alert.Message,
+			"firing_since": alert.FiringSince,
+		},
+	})
+}
+
+// diffAlerts сравнивает сработавшие оповещения правила с текущими кандидатами:
+// fired — новые объекты, resolved — объекты, для которых условие больше не выполняется.
+func diffAlerts(current []*model.Alert, candidates []alertCandidate) (fired []alertCandidate, resolved []*model.Alert) {
+	active := make(map[string]bool, len(current))
+	for _, a := range current {
+		active[a.Subject] = true
+	}
+	matched := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		matched[c.Subject] = true
+		if !active[c.Subject] {
+			fired = append(fired, c)
+		}
+	}
+	for _, a := range current {
+		if !matched[a.Subject] {
+			resolved = append(resolved, a)
+		}
+	}
+	return fired, resolved
+}
+
+// evalSEStatus — SE не в статусе online.
+func evalSEStatus(ses []*model.StorageElement) []alertCandidate {
+	var result []alertCandidate
+	for _, se := range ses {
+		if se.Status != "online" {
+			result = append(result, alertCandidate{
+				Subject:     se.ID,
+				SubjectName: se.Name,
+				Message:     fmt.Sprintf("Статус SE: %s", se.Status),
+			})
+		}
+	}
+	return result
+}
+
+// evalCapacity — заполнение SE не меньше threshold процентов.
+// SE с неизвестной ёмкостью пропускаются.
+func evalCapacity(ses []*model.StorageElement, threshold float64) []alertCandidate {
+	var result []alertCandidate
+	for _, se := range ses {
+		if se.CapacityBytes <= 0 {
+			continue
+		}
+		pct := float64(se.UsedBytes) / float64(se.CapacityBytes) * 100
+		if pct >= threshold {
+			result = append(result, alertCandidate{
+				Subject:     se.ID,
+				SubjectName: se.Name,
+				Message:     fmt.Sprintf("Заполнено %.1f%% (порог %.0f%%)", pct, threshold),
+			})
+		}
+	}
+	return result
+}
+
+// evalCapacityForecast — прогноз заполнения SE не больше threshold дней.
+// SE без прогноза (нет роста или мало данных) пропускаются.
+func evalCapacityForecast(ses []*model.StorageElement, perSE map[string]*CapacityForecast, threshold float64) []alertCandidate {
+	var result []alertCandidate
+	for _, se := range ses {
+		f := perSE[se.ID]
+		if f == nil || f.DaysUntilFull == nil {
+			continue
+		}
+		if *f.DaysUntilFull <= threshold {
+			result = append(result, alertCandidate{
+				Subject:     se.ID,
+				SubjectName: se.Name,
+				Message:     fmt.Sprintf("Заполнение через %.1f дн. (порог %.0f дн.)", *f.DaysUntilFull, threshold),
+			})
+		}
+	}
+	return result
+}
+
+// syncErrorStreak возвращает число неудачных синхронизаций подряд,
+// начиная с последней завершённой. runs — новые первыми; queued и running
+// пропускаются, отменённые не прерывают серию, успешная — прерывает.
+func syncErrorStreak(runs []*model.SyncRun) int {
+	streak := 0
+	for _, run := range runs {
+		switch run.Status {
+		case model.SyncRunFailed:
+			streak++
+		case model.SyncRunSucceeded:
+			return streak
+		}
+	}
+	return streak
+}
+
+// evalSecretExpiry — секреты SA, истекающие раньше границы порога
+// (отбор выполняет SecretExpirySource).
+func evalSecretExpiry(secrets []SecretExpiry, now time.Time) []alertCandidate {
+	result := make([]alertCandidate, 0, len(secrets))
+	for _, sec := range secrets {
+		msg := fmt.Sprintf("Секрет истекает %s", sec.ExpiresAt.UTC().Format("2006-01-02 15:04 UTC"))
+		if !sec.ExpiresAt.After(now) {
+			msg = fmt.Sprintf("Секрет истёк %s", sec.ExpiresAt.UTC().Format("2006-01-02 15:04 UTC"))
+		}
+		result = append(result, alertCandidate{
+			Subject:     sec.ServiceAccountID,
+			SubjectName: sec.Name,
+			Message:     msg,
+		})
+	}
+	return result
+}
+
+// evalDephealth — зависимости, последняя проверка которых неуспешна.
+func evalDephealth(health map[string]bool) []alertCandidate {
+	names := make([]string, 0, len(health))
+	for name, healthy := range health {
+		if !healthy {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	result := make([]alertCandidate, 0, len(names))
+	for _, name := range names {
+		result = append(result, alertCandidate{
+			Subject:     name,
+			SubjectName: name,
+			Message:     "Зависимость недоступна",
+		})
+	}
+	return result
+}
+
+// alertRuleAuditState — поля правила для журнала аудита.
+func alertRuleAuditState(rule *model.AlertRule) map[string]any {
+	return map[string]any{
+		"name":               rule.Name,
+		"type":               rule.Type,
+		"threshold":          rule.Threshold,
+		"storage_element_id": rule.StorageElementID,
+		"enabled":            rule.Enabled,
+		"endpoint_ids":       rule.EndpointIDs,
+	}
+}
+
+// equalFloatPtr сравнивает два *float64 по значению.
+func equalFloatPtr(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// equalStringPtr сравнивает два *string по значению.
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,148 @@
+// alert_test.go — unit-тесты условий правил оповещений:
+// пороги по умолчанию, вычисление объектов, серия ошибок синхронизации,
+// переходы firing/resolved.
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+func floatPtr(v float64) *float64 { return &v }
+
+// TestNormalizeAlertThreshold проверяет пороги по умолчанию и их валидацию.
+func TestNormalizeAlertThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		ruleType  string
+		threshold *float64
+		want      *float64
+		wantErr   bool
+	}{
+		{"se_status без порога", model.AlertRuleSEStatus, nil, nil, false},
+		{"se_status с порогом", model.AlertRuleSEStatus, floatPtr(1), nil, true},
+		{"capacity по умолчанию", model.AlertRuleCapacity, nil, floatPtr(90), false},
+		{"capacity 100", model.AlertRuleCapacity, floatPtr(100), floatPtr(100), false},
+		{"capacity больше 100", model.AlertRuleCapacity, floatPtr(101), nil, true},
+		{"прогноз по умолчанию", model.AlertRuleCapacityForecast, nil, floatPtr(14), false},
+		{"прогноз 0", model.AlertRuleCapacityForecast, floatPtr(0), nil, true},
+		{"sync_errors по умолчанию", model.AlertRuleSyncErrors, nil, floatPtr(3), false},
+		{"sync_errors дробный", model.AlertRuleSyncErrors, floatPtr(2.5), nil, true},
+		{"секрет 0 дней", model.AlertRuleSASecretExpiry, floatPtr(0), floatPtr(0), false},
+		{"неизвестный тип", "unknown", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeAlertThreshold(tt.ruleType, tt.threshold)
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Fatalf("ожидалась ErrValidation, получено %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("неожиданная ошибка: %v", err)
+			}
+			if !equalFloatPtr(got, tt.want) {
+				t.Errorf("порог = %v, ожидалось %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAlertEvaluators проверяет вычисление объектов по условиям правил.
+func TestAlertEvaluators(t *testing.T) {
+	ses := []*model.StorageElement{
+		{ID: "se-1", Name: "alpha", Status: "online", CapacityBytes: 100, UsedBytes: 95},
+		{ID: "se-2", Name: "beta", Status: "offline", CapacityBytes: 100, UsedBytes: 10},
+		{ID: "se-3", Name: "gamma", Status: "online", CapacityBytes: 0, UsedBytes: 0},
+	}
+
+	if got := evalSEStatus(ses); len(got) != 1 || got[0].Subject != "se-2" {
+		t.Errorf("se_status: %+v, ожидался se-2", got)
+	}
+	if got := evalCapacity(ses, 90); len(got) != 1 || got[0].Subject != "se-1" {
+		t.Errorf("capacity 90: %+v, ожидался se-1", got)
+	}
+	if got := evalCapacity(ses, 96); len(got) != 0 {
+		t.Errorf("capacity 96: %+v, ожидался пустой список", got)
+	}
+
+	perSE := map[string]*CapacityForecast{
+		"se-1": {DaysUntilFull: floatPtr(5)},
+		"se-2": {DaysUntilFull: floatPtr(30)},
+		"se-3": {},
+	}
+	if got := evalCapacityForecast(ses, perSE, 14); len(got) != 1 || got[0].Subject != "se-1" {
+		t.Errorf("capacity_forecast: %+v, ожидался se-1", got)
+	}
+
+	got := evalDephealth(map[string]bool{"postgres": true, "keycloak": false, "se-beta": false})
+	if len(got) != 2 || got[0].Subject != "keycloak" || got[1].Subject != "se-beta" {
+		t.Errorf("dephealth: %+v, ожидались keycloak и se-beta", got)
+	}
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	secrets := []SecretExpiry{
+		{ServiceAccountID: "sa-1", Name: "ingest", ExpiresAt: now.Add(48 * time.Hour)},
+		{ServiceAccountID: "sa-2", Name: "backup", ExpiresAt: now.Add(-time.Hour)},
+	}
+	exp := evalSecretExpiry(secrets, now)
+	if len(exp) != 2 || exp[0].Subject != "sa-1" || exp[1].Message == exp[0].Message {
+		t.Errorf("sa_secret_expiry: %+v", exp)
+	}
+}
+
+// TestSyncErrorStreak проверяет подсчёт неудачных синхронизаций подряд.
+func TestSyncErrorStreak(t *testing.T) {
+	runs := func(statuses ...string) []*model.SyncRun {
+		result := make([]*model.SyncRun, len(statuses))
+		for i, s := range statuses {
+			result[i] = &model.SyncRun{Status: s}
+		}
+		return result
+	}
+
+	tests := []struct {
+		name string
+		runs []*model.SyncRun
+		want int
+	}{
+		{"нет синхронизаций", nil, 0},
+		{"последняя успешна", runs(model.SyncRunSucceeded, model.SyncRunFailed), 0},
+		{"серия до успешной", runs(model.SyncRunFailed, model.SyncRunFailed, model.SyncRunSucceeded, model.SyncRunFailed), 2},
+		{"running пропускается", runs(model.SyncRunRunning, model.SyncRunQueued, model.SyncRunFailed, model.SyncRunSucceeded), 1},
+		{"cancelled не прерывает серию", runs(model.SyncRunFailed, model.SyncRunCancelled, model.SyncRunFailed), 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := syncErrorStreak(tt.runs); got != tt.want {
+				t.Errorf("syncErrorStreak = %d, ожидалось %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDiffAlerts проверяет переходы firing/resolved.
+func TestDiffAlerts(t *testing.T) {
+	current := []*model.Alert{{Subject: "a"}, {Subject: "b"}}
+	candidates := []alertCandidate{{Subject: "b"}, {Subject: "c"}}
+
+	fired, resolved := diffAlerts(current, candidates)
+	if len(fired) != 1 || fired[0].Subject != "c" {
+		t.Errorf("fired = %+v, ожидался c", fired)
+	}
+	if len(resolved) != 1 || resolved[0].Subject != "a" {
+		t.Errorf("resolved = %+v, ожидался a", resolved)
+	}
+
+	fired, resolved = diffAlerts(current, []alertCandidate{{Subject: "a"}, {Subject: "b"}})
+	if len(fired) != 0 || len(resolved) != 0 {
+		t.Errorf("без изменений: fired = %+v, resolved = %+v", fired, resolved)
+	}
+}
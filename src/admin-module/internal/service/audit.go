@@ -0,0 +1,192 @@
+// audit.go — сервис журнала аудита.
+// Изменения SA, role overrides, SE, файлового реестра и настроек UI
+// записываются в audit_events в той же транзакции, что и само изменение.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// auditSystemActor — actor_id для изменений без пользовательского контекста.
+const auditSystemActor = "admin-module"
+
+// AuditChange — описание изменения для журнала аудита.
+type AuditChange struct {
+	// Action — действие (model.AuditAction*)
+	Action string
+	// TargetType — тип объекта (model.AuditTarget*)
+	TargetType string
+	// TargetID — идентификатор объекта
+	TargetID string
+	// Before — состояние объекта до операции (nil при создании)
+	Before map[string]any
+	// After — состояние объекта после операции (nil при удалении)
+	After map[string]any
+}
+
+// AuditService — сервис журнала аудита.
+type AuditService struct {
+	txRunner *repository.TxRunner
+	repo     repository.AuditEventRepository
+	logger   *slog.Logger
+}
+
+// NewAuditService создаёт сервис журнала аудита.
+func NewAuditService(
+	txRunner *repository.TxRunner,
+	repo repository.AuditEventRepository,
+	logger *slog.Logger,
+) *AuditService {
+	return &AuditService{
+		txRunner: txRunner,
+		repo:     repo,
+		logger:   logger.With(slog.String("component", "audit_service")),
+	}
+}
+
+// RunInTx выполняет fn в транзакции и в ней же записывает событие change.
+// Если fn или запись события завершились ошибкой — транзакция откатывается,
+// поэтому изменение без записи в журнале невозможно.
+func (s *AuditService) RunInTx(ctx context.Context, change *AuditChange, fn func(db repository.DBTX) error) error {
+	return s.txRunner.RunInTx(ctx, func(tx pgx.Tx) error {
+		if err := fn(tx); err != nil {
+			return err
+		}
+		return s.insert(ctx, repository.NewAuditEventRepository(tx), change)
+	})
+}
+
+// Record записывает событие вне транзакции.
+// Используется для действий, не изменяющих таблицы Admin Module (ротация секрета SA).
+func (s *AuditService) Record(ctx context.Context, change *AuditChange) error {
+	return s.insert(ctx, s.repo, change)
+}
+
+// List возвращает события аудита с фильтрацией и пагинацией.
+func (s *AuditService) List(ctx context.Context, filters repository.AuditEventFilters, limit, offset int) ([]*model.AuditEvent, int, error) {
+	events, err := s.repo.List(ctx, filters, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение событий аудита: %w", err)
+	}
+
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчёт событий аудита: %w", err)
+	}
+
+	return events, total, nil
+}
+
+// insert формирует событие из change и контекста запроса и сохраняет его через repo.
+func (s *AuditService) insert(ctx context.Context, repo repository.AuditEventRepository, change *AuditChange) error {
+	event, err := newAuditEvent(ctx, change)
+	if err != nil {
+		return err
+	}
+	if err := repo.Insert(ctx, event); err != nil {
+		return fmt.Errorf("запись события аудита %s: %w", change.Action, err)
+	}
+
+	s.logger.Debug("Событие аудита записано",
+		slog.Int64("id", event.ID),
+		slog.String("action", event.Action),
+		slog.String("target_id", event.TargetID),
+		slog.String("actor", event.ActorName),
+	)
+	return nil
+}
+
+// newAuditEvent формирует событие: субъект берётся из AuthClaims контекста,
+// IP и request ID — из middleware.RequestID. Без claims событие
+// записывается от имени system.
+func newAuditEvent(ctx context.Context, change *AuditChange) (*model.AuditEvent, error) {
+	before, after, err := auditDiff(change.Before, change.After)
+	if err != nil {
+		return nil, fmt.Errorf("формирование diff события аудита: %w", err)
+	}
+
+	event := &model.AuditEvent{
+		ActorID:    auditSystemActor,
+		ActorType:  model.AuditActorSystem,
+		ActorName:  auditSystemActor,
+		Action:     change.Action,
+		TargetType: change.TargetType,
+		TargetID:   change.TargetID,
+		Before:     before,
+		After:      after,
+	}
+
+	if claims := middleware.ClaimsFromContext(ctx); claims != nil {
+		event.ActorID = claims.Subject
+		switch claims.SubjectType {
+		case middleware.SubjectTypeSA:
+			event.ActorType = model.AuditActorServiceAccount
+			event.ActorName = claims.ClientID
+		default:
+			event.ActorType = model.AuditActorUser
+			event.ActorName = claims.PreferredUsername
+		}
+	}
+
+	if ip := middleware.SourceIPFromContext(ctx); ip != "" {
+		event.SourceIP = &ip
+	}
+	if id := middleware.RequestIDFromContext(ctx); id != "" {
+		event.RequestID = &id
+	}
+
+	return event, nil
+}
+
+// auditDiff сериализует состояния объекта в JSON. Если заданы оба
+// состояния (обновление), сохраняются только изменившиеся поля; поле,
+// которого нет в новом состоянии, попадает в after со значением null.
+func auditDiff(before, after map[string]any) (beforeJSON, afterJSON json.RawMessage, err error) {
+	if before != nil && after != nil {
+		changedBefore := make(map[string]any)
+		changedAfter := make(map[string]any)
+		for key, newValue := range after {
+			oldValue := before[key]
+			oldJSON, err := json.Marshal(oldValue)
+			if err != nil {
+				return nil, nil, err
+			}
+			newJSON, err := json.Marshal(newValue)
+			if err != nil {
+				return nil, nil, err
+			}
+			if string(oldJSON) != string(newJSON) {
+				changedBefore[key] = oldValue
+				changedAfter[key] = newValue
+			}
+		}
+		for key, oldValue := range before {
+			if _, ok := after[key]; !ok {
+				changedBefore[key] = oldValue
+				changedAfter[key] = nil
+			}
+		}
+		before, after = changedBefore, changedAfter
+	}
+
+	if before != nil {
+		if beforeJSON, err = json.Marshal(before); err != nil {
+			return nil, nil, err
+		}
+	}
+	if after != nil {
+		if afterJSON, err = json.Marshal(after); err != nil {
+			return nil, nil, err
+		}
+	}
+	return beforeJSON, afterJSON, nil
+}
@@ -0,0 +1,116 @@
+// audit_test.go — unit-тесты формирования событий аудита.
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+// TestAuditDiff проверяет сериализацию состояний и отбор изменённых полей.
+func TestAuditDiff(t *testing.T) {
+	tests := []struct {
+		name       string
+		before     map[string]any
+		after      map[string]any
+		wantBefore string
+		wantAfter  string
+	}{
+		{
+			name:      "создание",
+			after:     map[string]any{"name": "sa_backup"},
+			wantAfter: `{"name":"sa_backup"}`,
+		},
+		{
+			name:       "удаление",
+			before:     map[string]any{"status": "active"},
+			wantBefore: `{"status":"active"}`,
+		},
+		{
+			name:       "обновление — только изменённые поля",
+			before:     map[string]any{"name": "se-01", "mode": "rw", "scopes": []string{"files:read"}},
+			after:      map[string]any{"name": "se-01", "mode": "ro", "scopes": []string{"files:read"}},
+			wantBefore: `{"mode":"rw"}`,
+			wantAfter:  `{"mode":"ro"}`,
+		},
+		{
+			name:       "обновление — удалённое поле",
+			before:     map[string]any{"name": "se-01", "description": "backup"},
+			after:      map[string]any{"name": "se-01"},
+			wantBefore: `{"description":"backup"}`,
+			wantAfter:  `{"description":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, after, err := auditDiff(tt.before, tt.after)
+			if err != nil {
+				t.Fatalf("auditDiff() ошибка: %v", err)
+			}
+			if string(before) != tt.wantBefore {
+				t.Errorf("before = %s, хотели %s", before, tt.wantBefore)
+			}
+			if string(after) != tt.wantAfter {
+				t.Errorf("after = %s, хотели %s", after, tt.wantAfter)
+			}
+		})
+	}
+}
+
+// TestNewAuditEvent проверяет определение субъекта, IP и request ID из контекста.
+func TestNewAuditEvent(t *testing.T) {
+	change := &AuditChange{
+		Action:     model.AuditActionSEDelete,
+		TargetType: model.AuditTargetStorageElement,
+		TargetID:   "se-1",
+	}
+
+	t.Run("без claims — system", func(t *testing.T) {
+		event, err := newAuditEvent(context.Background(), change)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if event.ActorType != model.AuditActorSystem || event.ActorID != auditSystemActor {
+			t.Errorf("actor = %s/%s, хотели system/%s", event.ActorType, event.ActorID, auditSystemActor)
+		}
+		if event.SourceIP != nil || event.RequestID != nil {
+			t.Errorf("SourceIP/RequestID должны быть nil без RequestID middleware")
+		}
+	})
+
+	t.Run("service account через HTTP", func(t *testing.T) {
+		var ctx context.Context
+		handler := middleware.RequestID(nil)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+		}))
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/storage-elements/se-1", nil)
+		req.RemoteAddr = "192.0.2.10:41000"
+		req.Header.Set(middleware.HeaderRequestID, "req-42")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		ctx = middleware.WithClaims(ctx, &middleware.AuthClaims{
+			Subject:     "sa-uuid",
+			SubjectType: middleware.SubjectTypeSA,
+			ClientID:    "sa_ingest",
+		})
+
+		event, err := newAuditEvent(ctx, change)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if event.ActorType != model.AuditActorServiceAccount || event.ActorID != "sa-uuid" || event.ActorName != "sa_ingest" {
+			t.Errorf("actor = %s/%s/%s", event.ActorType, event.ActorID, event.ActorName)
+		}
+		if event.SourceIP == nil || *event.SourceIP != "192.0.2.10" {
+			t.Errorf("SourceIP = %v, хотели 192.0.2.10", event.SourceIP)
+		}
+		if event.RequestID == nil || *event.RequestID != "req-42" {
+			t.Errorf("RequestID = %v, хотели req-42", event.RequestID)
+		}
+	})
+}
@@ -0,0 +1,317 @@
+// capacity.go — история ёмкости Storage Elements и прогноз заполнения.
+//
+// При каждой синхронизации SE StorageSyncService записывает снимок
+// capacity/used (таблица se_capacity_snapshots). Снимки старше
+// AM_CAPACITY_HISTORY_RETENTION удаляются фоновой задачей раз в час.
+//
+// Прогноз строится линейной регрессией (метод наименьших квадратов)
+// used_bytes по времени за последние AM_CAPACITY_FORECAST_WINDOW:
+// наклон — рост в байтах в сутки, число дней до заполнения —
+// (capacity − used) / рост. Без роста прогноз не строится.
+// Общий прогноз — по сумме ёмкостей и сумме трендов всех SE.
+//
+// История и прогноз не требуют Prometheus.
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// capacityCleanupInterval — интервал удаления устаревших снимков ёмкости.
+const capacityCleanupInterval = time.Hour
+
+// capacityMinForecastSpan — минимальный период истории для прогноза:
+// на более коротком отрезке наклон определяется шумом.
+const capacityMinForecastSpan = time.Hour
+
+// CapacityForecast — тренд и прогноз заполнения SE (или всех SE).
+type CapacityForecast struct {
+	// StorageElementID — UUID SE; пустой — сумма по всем SE
+	StorageElementID string
+	// CapacityBytes, UsedBytes — по последнему снимку
+	CapacityBytes int64
+	UsedBytes     int64
+	// Samples — число снимков, по которым построен тренд
+	Samples int
+	// SnapshotAt — время последнего снимка
+	SnapshotAt time.Time
+	// GrowthBytesPerDay — рост использования (байт/сутки), может быть отрицательным
+	GrowthBytesPerDay float64
+	// DaysUntilFull — дней до заполнения; nil — рост не ожидается или мало данных
+	DaysUntilFull *float64
+	// FullAt — ожидаемая дата заполнения; nil — аналогично DaysUntilFull
+	FullAt *time.Time
+}
+
+// CapacityPoint — точка графика использования хранилища.
+type CapacityPoint struct {
+	At            time.Time
+	CapacityBytes int64
+	UsedBytes     int64
+}
+
+// CapacityOverview — прогноз по всем SE для Dashboard.
+type CapacityOverview struct {
+	// Total — общий прогноз по всем SE
+	Total *CapacityForecast
+	// PerSE — прогнозы по SE (ключ — UUID SE)
+	PerSE map[string]*CapacityForecast
+	// Trend — суммарное использование по дням
+	Trend []CapacityPoint
+}
+
+// CapacityService — запись снимков ёмкости SE, прогноз и очистка истории.
+type CapacityService struct {
+	snapshotRepo repository.CapacitySnapshotRepository
+	retention    time.Duration
+	window       time.Duration
+	logger       *slog.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewCapacityService создаёт сервис истории ёмкости.
+// retention — срок хранения снимков, window — период истории для прогноза.
+func NewCapacityService(
+	snapshotRepo repository.CapacitySnapshotRepository,
+	retention, window time.Duration,
+	logger *slog.Logger,
+) *CapacityService {
+	return &CapacityService{
+		snapshotRepo: snapshotRepo,
+		retention:    retention,
+		window:       window,
+		logger:       logger.With(slog.String("component", "capacity")),
+	}
+}
+
+// Start запускает фоновую очистку устаревших снимков.
+func (s *CapacityService) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		s.logger.Info("Очистка истории ёмкости SE запущена",
+			slog.String("retention", s.retention.String()),
+		)
+
+		ticker := time.NewTicker(capacityCleanupInterval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := s.RunOnce(ctx); err != nil && ctx.Err() == nil {
+				s.logger.Error("Ошибка очистки истории ёмкости SE", slog.String("error", err.Error()))
+			}
+
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Очистка истории ёмкости SE остановлена")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop останавливает фоновую горутину и ждёт завершения.
+func (s *CapacityService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+// RunOnce удаляет снимки старше срока хранения. Возвращает количество удалённых.
+func (s *CapacityService) RunOnce(ctx context.Context) (int64, error) {
+	deleted, err := s.snapshotRepo.DeleteBefore(ctx, time.Now().UTC().Add(-s.retention))
+	if err != nil {
+		return 0, err
+	}
+	if deleted > 0 {
+		s.logger.Info("Удалены устаревшие снимки ёмкости SE", slog.Int64("deleted", deleted))
+	}
+	return deleted, nil
+}
+
+// Record сохраняет снимок текущей ёмкости SE.
+func (s *CapacityService) Record(ctx context.Context, se *model.StorageElement) error {
+	capturedAt := time.Now().UTC()
+	if se.LastSyncAt != nil {
+		capturedAt = *se.LastSyncAt
+	}
+	return s.snapshotRepo.Create(ctx, &model.CapacitySnapshot{
+		StorageElementID: se.ID,
+		CapturedAt:       capturedAt,
+		CapacityBytes:    se.CapacityBytes,
+		UsedBytes:        se.UsedBytes,
+		AvailableBytes:   se.AvailableBytes,
+	})
+}
+
+// SEForecast возвращает прогноз заполнения SE и историю использования
+// за период прогноза. Без снимков возвращает прогноз с Samples == 0.
+func (s *CapacityService) SEForecast(ctx context.Context, seID string) (*CapacityForecast, []CapacityPoint, error) {
+	snapshots, err := s.snapshotRepo.ListSince(ctx, seID, time.Now().UTC().Add(-s.window))
+	if err != nil {
+		return nil, nil, fmt.Errorf("получение истории ёмкости SE: %w", err)
+	}
+
+	forecast := linearForecast(snapshots)
+	forecast.StorageElementID = seID
+
+	points := make([]CapacityPoint, len(snapshots))
+	for i, snap := range snapshots {
+		points[i] = CapacityPoint{At: snap.CapturedAt, CapacityBytes: snap.CapacityBytes, UsedBytes: snap.UsedBytes}
+	}
+	return forecast, points, nil
+}
+
+// Overview возвращает прогнозы по всем SE, общий прогноз и суммарный
+// тренд использования по дням за период прогноза.
+func (s *CapacityService) Overview(ctx context.Context) (*CapacityOverview, error) {
+	snapshots, err := s.snapshotRepo.ListSince(ctx, "", time.Now().UTC().Add(-s.window))
+	if err != nil {
+		return nil, fmt.Errorf("получение истории ёмкости SE: %w", err)
+	}
+
+	bySE := make(map[string][]*model.CapacitySnapshot)
+	for _, snap := range snapshots {
+		bySE[snap.StorageElementID] = append(bySE[snap.StorageElementID], snap)
+	}
+
+	overview := &CapacityOverview{
+		PerSE: make(map[string]*CapacityForecast, len(bySE)),
+		Trend: dailyTotals(snapshots),
+	}
+	forecasts := make([]*CapacityForecast, 0, len(bySE))
+	for seID, seSnapshots := range bySE {
+		f := linearForecast(seSnapshots)
+		f.StorageElementID = seID
+		overview.PerSE[seID] = f
+		forecasts = append(forecasts, f)
+	}
+	overview.Total = combineForecasts(forecasts)
+
+	return overview, nil
+}
+
+// linearForecast строит прогноз по снимкам одного SE (по возрастанию времени).
+func linearForecast(snapshots []*model.CapacitySnapshot) *CapacityForecast {
+	f := &CapacityForecast{Samples: len(snapshots)}
+	if len(snapshots) == 0 {
+		return f
+	}
+
+	last := snapshots[len(snapshots)-1]
+	f.CapacityBytes = last.CapacityBytes
+	f.UsedBytes = last.UsedBytes
+	f.SnapshotAt = last.CapturedAt
+
+	first := snapshots[0]
+	if len(snapshots) < 2 || last.CapturedAt.Sub(first.CapturedAt) < capacityMinForecastSpan {
+		return f
+	}
+
+	// Наименьшие квадраты: x — сутки от первого снимка, y — used_bytes
+	n := float64(len(snapshots))
+	var sumX, sumY float64
+	for _, snap := range snapshots {
+		sumX += snap.CapturedAt.Sub(first.CapturedAt).Hours() / 24
+		sumY += float64(snap.UsedBytes)
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var sxy, sxx float64
+	for _, snap := range snapshots {
+		dx := snap.CapturedAt.Sub(first.CapturedAt).Hours()/24 - meanX
+		sxy += dx * (float64(snap.UsedBytes) - meanY)
+		sxx += dx * dx
+	}
+	if sxx == 0 {
+		return f
+	}
+	f.GrowthBytesPerDay = sxy / sxx
+
+	setDaysUntilFull(f)
+	return f
+}
+
+// combineForecasts суммирует прогнозы SE в общий прогноз.
+func combineForecasts(forecasts []*CapacityForecast) *CapacityForecast {
+	total := &CapacityForecast{}
+	for _, f := range forecasts {
+		total.CapacityBytes += f.CapacityBytes
+		total.UsedBytes += f.UsedBytes
+		total.Samples += f.Samples
+		total.GrowthBytesPerDay += f.GrowthBytesPerDay
+		if f.SnapshotAt.After(total.SnapshotAt) {
+			total.SnapshotAt = f.SnapshotAt
+		}
+	}
+	setDaysUntilFull(total)
+	return total
+}
+
+// setDaysUntilFull вычисляет DaysUntilFull и FullAt от последнего снимка
+// при положительном росте использования.
+func setDaysUntilFull(f *CapacityForecast) {
+	if f.GrowthBytesPerDay <= 0 || f.CapacityBytes <= 0 {
+		return
+	}
+	days := float64(f.CapacityBytes-f.UsedBytes) / f.GrowthBytesPerDay
+	if days < 0 {
+		days = 0
+	}
+	fullAt := f.SnapshotAt.Add(time.Duration(days * 24 * float64(time.Hour)))
+	f.DaysUntilFull = &days
+	f.FullAt = &fullAt
+}
+
+// dailyTotals агрегирует снимки всех SE в суммарное использование по дням (UTC):
+// для каждого SE берётся последний снимок дня, SE без снимка в этот день
+// учитываются с последним известным значением.
+func dailyTotals(snapshots []*model.CapacitySnapshot) []CapacityPoint {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	sorted := make([]*model.CapacitySnapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CapturedAt.Before(sorted[j].CapturedAt) })
+
+	latest := make(map[string]*model.CapacitySnapshot)
+	var points []CapacityPoint
+	flush := func(day time.Time) {
+		p := CapacityPoint{At: day}
+		for _, snap := range latest {
+			p.CapacityBytes += snap.CapacityBytes
+			p.UsedBytes += snap.UsedBytes
+		}
+		points = append(points, p)
+	}
+
+	day := sorted[0].CapturedAt.UTC().Truncate(24 * time.Hour)
+	for _, snap := range sorted {
+		snapDay := snap.CapturedAt.UTC().Truncate(24 * time.Hour)
+		if snapDay.After(day) {
+			flush(day)
+			day = snapDay
+		}
+		latest[snap.StorageElementID] = snap
+	}
+	flush(day)
+
+	return points
+}
@@ -0,0 +1,126 @@
+// capacity_test.go — unit-тесты прогноза заполнения SE:
+// линейная регрессия, суммарный прогноз, агрегация тренда по дням.
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+const testGB = int64(1024 * 1024 * 1024)
+
+// capacitySeries — снимки SE раз в сутки с ростом used на growth байт.
+func capacitySeries(seID string, start time.Time, days int, capacity, used, growth int64) []*model.CapacitySnapshot {
+	result := make([]*model.CapacitySnapshot, days)
+	for i := range days {
+		result[i] = &model.CapacitySnapshot{
+			StorageElementID: seID,
+			CapturedAt:       start.Add(time.Duration(i) * 24 * time.Hour),
+			CapacityBytes:    capacity,
+			UsedBytes:        used + int64(i)*growth,
+		}
+	}
+	return result
+}
+
+func TestLinearForecast_SteadyGrowth(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	// 100 GB, занято 10 GB, +2 GB в сутки; через 9 суток занято 28 GB
+	f := linearForecast(capacitySeries("se-1", start, 10, 100*testGB, 10*testGB, 2*testGB))
+
+	if f.Samples != 10 {
+		t.Errorf("Samples = %d, хотели 10", f.Samples)
+	}
+	if f.UsedBytes != 28*testGB {
+		t.Errorf("UsedBytes = %d, хотели %d", f.UsedBytes, 28*testGB)
+	}
+	if math.Abs(f.GrowthBytesPerDay-float64(2*testGB)) > 1 {
+		t.Errorf("GrowthBytesPerDay = %f, хотели %d", f.GrowthBytesPerDay, 2*testGB)
+	}
+	if f.DaysUntilFull == nil || math.Abs(*f.DaysUntilFull-36) > 1e-6 {
+		t.Fatalf("DaysUntilFull = %v, хотели 36", f.DaysUntilFull)
+	}
+	wantFull := start.Add(9 * 24 * time.Hour).Add(36 * 24 * time.Hour)
+	if f.FullAt == nil || f.FullAt.Sub(wantFull).Abs() > time.Second {
+		t.Errorf("FullAt = %v, хотели %v", f.FullAt, wantFull)
+	}
+}
+
+func TestLinearForecast_NoGrowth(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for name, growth := range map[string]int64{"flat": 0, "shrinking": -testGB} {
+		f := linearForecast(capacitySeries("se-1", start, 5, 100*testGB, 50*testGB, growth))
+		if f.DaysUntilFull != nil || f.FullAt != nil {
+			t.Errorf("%s: DaysUntilFull = %v, FullAt = %v, хотели nil", name, f.DaysUntilFull, f.FullAt)
+		}
+	}
+}
+
+func TestLinearForecast_NotEnoughData(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if f := linearForecast(nil); f.Samples != 0 || f.DaysUntilFull != nil {
+		t.Errorf("без снимков: %+v, хотели пустой прогноз", f)
+	}
+	if f := linearForecast(capacitySeries("se-1", start, 1, 100, 10, 0)); f.GrowthBytesPerDay != 0 || f.UsedBytes != 10 {
+		t.Errorf("один снимок: %+v, хотели used=10 без роста", f)
+	}
+
+	// Два снимка с разницей меньше capacityMinForecastSpan
+	short := []*model.CapacitySnapshot{
+		{StorageElementID: "se-1", CapturedAt: start, CapacityBytes: 100, UsedBytes: 10},
+		{StorageElementID: "se-1", CapturedAt: start.Add(10 * time.Minute), CapacityBytes: 100, UsedBytes: 50},
+	}
+	if f := linearForecast(short); f.GrowthBytesPerDay != 0 || f.DaysUntilFull != nil {
+		t.Errorf("короткий период: %+v, хотели без прогноза", f)
+	}
+}
+
+func TestCombineForecasts(t *testing.T) {
+	at := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	total := combineForecasts([]*CapacityForecast{
+		{CapacityBytes: 100, UsedBytes: 40, Samples: 3, GrowthBytesPerDay: 5, SnapshotAt: at},
+		{CapacityBytes: 200, UsedBytes: 60, Samples: 2, GrowthBytesPerDay: -1, SnapshotAt: at.Add(-time.Hour)},
+	})
+
+	if total.CapacityBytes != 300 || total.UsedBytes != 100 || total.Samples != 5 {
+		t.Errorf("сумма = %+v, хотели capacity=300 used=100 samples=5", total)
+	}
+	if total.GrowthBytesPerDay != 4 {
+		t.Errorf("GrowthBytesPerDay = %f, хотели 4", total.GrowthBytesPerDay)
+	}
+	if total.DaysUntilFull == nil || *total.DaysUntilFull != 50 {
+		t.Fatalf("DaysUntilFull = %v, хотели 50", total.DaysUntilFull)
+	}
+	if !total.SnapshotAt.Equal(at) {
+		t.Errorf("SnapshotAt = %v, хотели %v", total.SnapshotAt, at)
+	}
+}
+
+func TestDailyTotals_CarriesForward(t *testing.T) {
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	points := dailyTotals([]*model.CapacitySnapshot{
+		{StorageElementID: "se-1", CapturedAt: day.Add(1 * time.Hour), CapacityBytes: 100, UsedBytes: 10},
+		{StorageElementID: "se-1", CapturedAt: day.Add(5 * time.Hour), CapacityBytes: 100, UsedBytes: 20},
+		{StorageElementID: "se-2", CapturedAt: day.Add(6 * time.Hour), CapacityBytes: 50, UsedBytes: 5},
+		// Второй день: снимок есть только у se-1
+		{StorageElementID: "se-1", CapturedAt: day.Add(30 * time.Hour), CapacityBytes: 100, UsedBytes: 30},
+	})
+
+	want := []CapacityPoint{
+		{At: day, CapacityBytes: 150, UsedBytes: 25},
+		{At: day.Add(24 * time.Hour), CapacityBytes: 150, UsedBytes: 35},
+	}
+	if len(points) != len(want) {
+		t.Fatalf("len(points) = %d, хотели %d", len(points), len(want))
+	}
+	for i := range want {
+		if !points[i].At.Equal(want[i].At) || points[i].CapacityBytes != want[i].CapacityBytes ||
+			points[i].UsedBytes != want[i].UsedBytes {
+			t.Errorf("points[%d] = %+v, хотели %+v", i, points[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// collection_claims.go — claim коллекций Service Accounts.
+//
+// Storage Element записывает collection_id файла в attr.json, а синхронизация
+// переносит его в реестр, поэтому право write в коллекции проверяется уже при
+// загрузке на SE: SA получает claim artstore_collections со списком коллекций,
+// где он участник с правом write (в Keycloak — protocol mapper клиента).
+//
+// Claim пересчитывается при изменении участников коллекций и при каждой
+// синхронизации SA с IdP. Новые права действуют для токенов, выданных после
+// обновления claim.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// maxClaimSAs — максимальное число SA, для которых пересчитывается claim
+// коллекций за одну синхронизацию.
+const maxClaimSAs = 1000
+
+// CollectionClaimService — передача в IdP claim коллекций SA.
+type CollectionClaimService struct {
+	provider       idp.Provider
+	saRepo         repository.ServiceAccountRepository
+	collectionRepo repository.CollectionRepository
+	logger         *slog.Logger
+
+	// pushed — последние переданные в IdP значения claim (по ID SA)
+	mu     sync.Mutex
+	pushed map[string]string
+}
+
+// NewCollectionClaimService создаёт сервис claim коллекций SA.
+func NewCollectionClaimService(
+	provider idp.Provider,
+	saRepo repository.ServiceAccountRepository,
+	collectionRepo repository.CollectionRepository,
+	logger *slog.Logger,
+) *CollectionClaimService {
+	return &CollectionClaimService{
+		provider:       provider,
+		saRepo:         saRepo,
+		collectionRepo: collectionRepo,
+		logger:         logger.With(slog.String("component", "collection_claims")),
+		pushed:         make(map[string]string),
+	}
+}
+
+// Push пересчитывает claim SA с client_id clientID и передаёт его в IdP.
+func (s *CollectionClaimService) Push(ctx context.Context, clientID string) error {
+	sa, err := s.saRepo.GetByClientID(ctx, clientID)
+	if err != nil {
+		return fmt.Errorf("получение SA %s: %w", clientID, err)
+	}
+	all, err := s.collectionRepo.ServiceAccountCollections(ctx, model.CollectionPermWrite)
+	if err != nil {
+		return err
+	}
+	value, err := CollectionsClaim(all[clientID])
+	if err != nil {
+		return err
+	}
+	return s.setClaim(ctx, sa, value)
+}
+
+// SyncClaims пересчитывает claim всех SA и передаёт изменившиеся значения
+// в IdP. Возвращает число обновлённых SA.
+func (s *CollectionClaimService) SyncClaims(ctx context.Context) (int, error) {
+	all, err := s.collectionRepo.ServiceAccountCollections(ctx, model.CollectionPermWrite)
+	if err != nil {
+		return 0, err
+	}
+	accounts, err := s.saRepo.List(ctx, nil, maxClaimSAs, 0)
+	if err != nil {
+		return 0, fmt.Errorf("получение списка SA: %w", err)
+	}
+
+	updated := 0
+	for _, sa := range accounts {
+		if sa.KeycloakClientID == nil {
+			continue
+		}
+		value, err := CollectionsClaim(all[sa.ClientID])
+		if err != nil {
+			return updated, err
+		}
+
+		s.mu.Lock()
+		prev, known := s.pushed[sa.ID]
+		s.mu.Unlock()
+		// Без известного значения пустой claim тоже передаётся: он мог
+		// остаться от участия в коллекции до перезапуска Admin Module
+		if known && prev == value {
+			continue
+		}
+
+		if err := s.setClaim(ctx, sa, value); err != nil {
+			s.logger.Warn("Ошибка обновления claim коллекций в IdP",
+				slog.String("client_id", sa.ClientID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		if !known && value == "" {
+			continue
+		}
+		updated++
+	}
+	return updated, nil
+}
+
+// setClaim сохраняет значение claim в IdP и запоминает его.
+func (s *CollectionClaimService) setClaim(ctx context.Context, sa *model.ServiceAccount, value string) error {
+	if sa.KeycloakClientID == nil {
+		return fmt.Errorf("SA не синхронизирован с IdP: отсутствует keycloak_client_id")
+	}
+	if err := s.provider.SetClientClaim(ctx, *sa.KeycloakClientID, model.CollectionsClaimName, value); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.pushed[sa.ID] = value
+	s.mu.Unlock()
+	return nil
+}
+
+// CollectionsClaim формирует JSON-значение claim artstore_collections.
+// Пустой список коллекций — пустая строка (claim не нужен).
+func CollectionsClaim(collectionIDs []string) (string, error) {
+	if len(collectionIDs) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(collectionIDs)
+	if err != nil {
+		return "", fmt.Errorf("формирование claim коллекций: %w", err)
+	}
+	return string(data), nil
+}
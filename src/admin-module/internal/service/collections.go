@@ -0,0 +1,423 @@
+// collections.go — коллекции (namespaces) файлов: изоляция файлов команд,
+// участники с правами read/write/delete и квоты на объём и количество файлов.
+//
+// Файл коллекции доступен участникам с нужным правом и пользователям с
+// разрешением collections:write; файлы без коллекции — в общем пространстве.
+// Использование квоты считается по активным файлам реестра, поэтому проверка
+// при регистрации приблизительная: параллельные загрузки могут превысить
+// квоту на размер одного файла.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// collectionDescriptionMaxLength — максимальная длина описания коллекции.
+const collectionDescriptionMaxLength = 500
+
+// collectionNamePattern — допустимое имя коллекции (slug).
+var collectionNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{1,49}$`)
+
+// CollectionInput — параметры коллекции.
+type CollectionInput struct {
+	Name        string
+	Description string
+	// QuotaBytes, QuotaFiles — квоты (nil — без ограничения)
+	QuotaBytes *int64
+	QuotaFiles *int64
+}
+
+// CollectionMemberInput — участник коллекции и его права.
+type CollectionMemberInput struct {
+	SubjectType string
+	SubjectID   string
+	Permissions []string
+}
+
+// CollectionService — сервис коллекций файлов.
+type CollectionService struct {
+	repo   repository.CollectionRepository
+	audit  *AuditService
+	claims *CollectionClaimService
+	logger *slog.Logger
+}
+
+// NewCollectionService создаёт сервис коллекций.
+func NewCollectionService(
+	repo repository.CollectionRepository,
+	audit *AuditService,
+	logger *slog.Logger,
+) *CollectionService {
+	return &CollectionService{
+		repo:   repo,
+		audit:  audit,
+		logger: logger.With(slog.String("component", "collections")),
+	}
+}
+
+// SetClaimService задаёт сервис claim коллекций SA: при изменении участников
+// Service Accounts claim artstore_collections обновляется в IdP.
+func (s *CollectionService) SetClaimService(claims *CollectionClaimService) {
+	s.claims = claims
+}
+
+// List возвращает коллекции с использованием квот. Если scope не nil —
+// только коллекции, где субъект — участник с правом scope.Permission.
+func (s *CollectionService) List(ctx context.Context, scope *model.CollectionScope) ([]*model.Collection, error) {
+	collections, err := s.repo.List(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("получение коллекций: %w", err)
+	}
+	return collections, nil
+}
+
+// Get возвращает коллекцию по ID.
+func (s *CollectionService) Get(ctx context.Context, id string) (*model.Collection, error) {
+	c, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение коллекции: %w", err)
+	}
+	return c, nil
+}
+
+// Create создаёт коллекцию и записывает событие аудита.
+func (s *CollectionService) Create(ctx context.Context, actor *middleware.AuthClaims, in CollectionInput) (*model.Collection, error) {
+	c, err := validateCollectionInput(in)
+	if err != nil {
+		return nil, err
+	}
+	c.ID = uuid.New().String()
+	c.CreatedBy = actor.PreferredUsername
+
+	change := &AuditChange{
+		Action:     model.AuditActionCollectionCreate,
+		TargetType: model.AuditTargetCollection,
+		TargetID:   c.ID,
+		After:      collectionAuditState(c),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewCollectionRepository(db).Create(ctx, c)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, fmt.Errorf("%w: коллекция %q уже существует", ErrConflict, c.Name)
+		}
+		return nil, fmt.Errorf("создание коллекции: %w", err)
+	}
+
+	s.logger.Info("Коллекция создана",
+		slog.String("collection", c.Name),
+		slog.String("created_by", actor.PreferredUsername),
+	)
+	return c, nil
+}
+
+// Update изменяет имя, описание и квоты коллекции. ID коллекции
+// (записанный в attr.json файлов) не меняется.
+func (s *CollectionService) Update(ctx context.Context, actor *middleware.AuthClaims, id string, in CollectionInput) (*model.Collection, error) {
+	c, err := validateCollectionInput(in)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.ID = id
+
+	change := &AuditChange{
+		Action:     model.AuditActionCollectionUpdate,
+		TargetType: model.AuditTargetCollection,
+		TargetID:   id,
+		Before:     collectionAuditState(current),
+		After:      collectionAuditState(c),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewCollectionRepository(db).Update(ctx, c)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, ErrNotFound
+		case errors.Is(err, repository.ErrConflict):
+			return nil, fmt.Errorf("%w: коллекция %q уже существует", ErrConflict, c.Name)
+		}
+		return nil, fmt.Errorf("обновление коллекции: %w", err)
+	}
+	c.UsedBytes, c.UsedFiles, c.MemberCount = current.UsedBytes, current.UsedFiles, current.MemberCount
+
+	s.logger.Info("Коллекция изменена",
+		slog.String("collection", c.Name),
+		slog.String("updated_by", actor.PreferredUsername),
+	)
+	return c, nil
+}
+
+// Delete удаляет коллекцию с участниками. Коллекция с активными файлами
+// не удаляется (ErrConflict): её файлы остались бы без участников.
+func (s *CollectionService) Delete(ctx context.Context, actor *middleware.AuthClaims, id string) error {
+	current, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current.UsedFiles > 0 {
+		return fmt.Errorf("%w: в коллекции %d активных файлов", ErrConflict, current.UsedFiles)
+	}
+	members, err := s.repo.ListMembers(ctx, id)
+	if err != nil {
+		return fmt.Errorf("получение участников коллекции: %w", err)
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionCollectionDelete,
+		TargetType: model.AuditTargetCollection,
+		TargetID:   id,
+		Before:     collectionAuditState(current),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewCollectionRepository(db).Delete(ctx, id)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("удаление коллекции: %w", err)
+	}
+	for _, m := range members {
+		s.pushClaim(ctx, m.SubjectType, m.SubjectID)
+	}
+
+	s.logger.Info("Коллекция удалена",
+		slog.String("collection", current.Name),
+		slog.String("deleted_by", actor.PreferredUsername),
+	)
+	return nil
+}
+
+// Members возвращает участников коллекции.
+func (s *CollectionService) Members(ctx context.Context, id string) ([]*model.CollectionMember, error) {
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	members, err := s.repo.ListMembers(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("получение участников коллекции: %w", err)
+	}
+	return members, nil
+}
+
+// SetMember добавляет участника коллекции или заменяет его права.
+func (s *CollectionService) SetMember(ctx context.Context, actor *middleware.AuthClaims, id string, in CollectionMemberInput) (*model.CollectionMember, error) {
+	m, err := validateCollectionMember(in)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	m.CollectionID = id
+	m.AddedBy = actor.PreferredUsername
+
+	change := &AuditChange{
+		Action:     model.AuditActionCollectionMemberSet,
+		TargetType: model.AuditTargetCollection,
+		TargetID:   id,
+		After: map[string]any{
+			"subject_type": m.SubjectType,
+			"subject_id":   m.SubjectID,
+			"permissions":  m.Permissions,
+		},
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewCollectionRepository(db).UpsertMember(ctx, m)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("сохранение участника коллекции: %w", err)
+	}
+	s.pushClaim(ctx, m.SubjectType, m.SubjectID)
+
+	s.logger.Info("Участник коллекции сохранён",
+		slog.String("collection_id", id),
+		slog.String("subject_type", m.SubjectType),
+		slog.String("subject_id", m.SubjectID),
+		slog.String("updated_by", actor.PreferredUsername),
+	)
+	return m, nil
+}
+
+// RemoveMember удаляет участника коллекции.
+func (s *CollectionService) RemoveMember(ctx context.Context, actor *middleware.AuthClaims, id, subjectType, subjectID string) error {
+	change := &AuditChange{
+		Action:     model.AuditActionCollectionMemberRemove,
+		TargetType: model.AuditTargetCollection,
+		TargetID:   id,
+		Before: map[string]any{
+			"subject_type": subjectType,
+			"subject_id":   subjectID,
+		},
+	}
+	err := s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewCollectionRepository(db).DeleteMember(ctx, id, subjectType, subjectID)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("удаление участника коллекции: %w", err)
+	}
+	s.pushClaim(ctx, subjectType, subjectID)
+
+	s.logger.Info("Участник коллекции удалён",
+		slog.String("collection_id", id),
+		slog.String("subject_type", subjectType),
+		slog.String("subject_id", subjectID),
+		slog.String("removed_by", actor.PreferredUsername),
+	)
+	return nil
+}
+
+// pushClaim обновляет claim коллекций участника-SA. Ошибка не прерывает
+// операцию — claim будет обновлён при следующей синхронизации SA.
+func (s *CollectionService) pushClaim(ctx context.Context, subjectType, subjectID string) {
+	if s.claims == nil || subjectType != model.CollectionMemberServiceAccount {
+		return
+	}
+	if err := s.claims.Push(ctx, subjectID); err != nil {
+		s.logger.Warn("Ошибка обновления claim коллекций в IdP",
+			slog.String("client_id", subjectID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// CheckAccess проверяет право permission субъекта запроса на файл коллекции.
+// Файлы без коллекции и пользователи с collections:write не ограничиваются.
+// ErrForbidden — субъект не участник коллекции или у него нет права.
+func (s *CollectionService) CheckAccess(ctx context.Context, claims *middleware.AuthClaims, collectionID *string, permission string) error {
+	if collectionID == nil {
+		return nil
+	}
+	scope := claims.CollectionScope(permission)
+	if scope == nil {
+		return nil
+	}
+	perms, err := s.repo.MemberPermissions(ctx, *collectionID, scope.Subject)
+	if err != nil {
+		return fmt.Errorf("проверка доступа к коллекции: %w", err)
+	}
+	if !slices.Contains(perms, permission) {
+		return fmt.Errorf("%w: требуется право %s в коллекции", ErrForbidden, permission)
+	}
+	return nil
+}
+
+// CheckUpload проверяет загрузку файла размером size в коллекцию: право
+// write субъекта и квоты коллекции. ErrValidation — коллекции нет,
+// ErrForbidden — нет права, ErrQuotaExceeded — квота будет превышена.
+func (s *CollectionService) CheckUpload(ctx context.Context, claims *middleware.AuthClaims, collectionID string, size int64) error {
+	c, err := s.Get(ctx, collectionID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("%w: коллекция %s не найдена", ErrValidation, collectionID)
+		}
+		return err
+	}
+	if err := s.CheckAccess(ctx, claims, &collectionID, model.CollectionPermWrite); err != nil {
+		return err
+	}
+	return checkCollectionQuota(c, size)
+}
+
+// checkCollectionQuota проверяет, что файл размером size помещается в квоты.
+func checkCollectionQuota(c *model.Collection, size int64) error {
+	if c.QuotaFiles != nil && c.UsedFiles+1 > *c.QuotaFiles {
+		return fmt.Errorf("%w %q: достигнут лимит %d файлов", ErrQuotaExceeded, c.Name, *c.QuotaFiles)
+	}
+	if c.QuotaBytes != nil && c.UsedBytes+size > *c.QuotaBytes {
+		return fmt.Errorf("%w %q: занято %d из %d байт, файл — %d байт",
+			ErrQuotaExceeded, c.Name, c.UsedBytes, *c.QuotaBytes, size)
+	}
+	return nil
+}
+
+// validateCollectionInput проверяет и нормализует параметры коллекции.
+func validateCollectionInput(in CollectionInput) (*model.Collection, error) {
+	name := strings.TrimSpace(in.Name)
+	if !collectionNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("%w: имя коллекции — от 2 до 50 символов: строчные латинские буквы, цифры и дефис, начинается с буквы", ErrValidation)
+	}
+
+	description := strings.TrimSpace(in.Description)
+	if len([]rune(description)) > collectionDescriptionMaxLength {
+		return nil, fmt.Errorf("%w: описание коллекции — не длиннее %d символов", ErrValidation, collectionDescriptionMaxLength)
+	}
+
+	if (in.QuotaBytes != nil && *in.QuotaBytes <= 0) || (in.QuotaFiles != nil && *in.QuotaFiles <= 0) {
+		return nil, fmt.Errorf("%w: квоты коллекции должны быть положительными", ErrValidation)
+	}
+
+	return &model.Collection{
+		Name:        name,
+		Description: description,
+		QuotaBytes:  in.QuotaBytes,
+		QuotaFiles:  in.QuotaFiles,
+	}, nil
+}
+
+// validateCollectionMember проверяет участника: тип, идентификатор и права
+// (без повторов, в порядке model.CollectionPermissions).
+func validateCollectionMember(in CollectionMemberInput) (*model.CollectionMember, error) {
+	if in.SubjectType != model.CollectionMemberUser && in.SubjectType != model.CollectionMemberServiceAccount {
+		return nil, fmt.Errorf("%w: тип участника — user или service_account", ErrValidation)
+	}
+	subjectID := strings.TrimSpace(in.SubjectID)
+	if subjectID == "" || len(subjectID) > 255 {
+		return nil, fmt.Errorf("%w: укажите username пользователя или client_id SA (до 255 символов)", ErrValidation)
+	}
+
+	var perms []string
+	for _, p := range in.Permissions {
+		if !slices.Contains(model.CollectionPermissions, p) {
+			return nil, fmt.Errorf("%w: неизвестное право %q (read, write, delete)", ErrValidation, p)
+		}
+	}
+	for _, p := range model.CollectionPermissions {
+		if slices.Contains(in.Permissions, p) {
+			perms = append(perms, p)
+		}
+	}
+	if len(perms) == 0 {
+		return nil, fmt.Errorf("%w: укажите хотя бы одно право", ErrValidation)
+	}
+
+	return &model.CollectionMember{
+		SubjectType: in.SubjectType,
+		SubjectID:   subjectID,
+		Permissions: perms,
+	}, nil
+}
+
+// collectionAuditState — состояние коллекции для журнала аудита.
+func collectionAuditState(c *model.Collection) map[string]any {
+	return map[string]any{
+		"name":        c.Name,
+		"description": c.Description,
+		"quota_bytes": c.QuotaBytes,
+		"quota_files": c.QuotaFiles,
+	}
+}
@@ -0,0 +1,273 @@
+// collections_test.go — unit-тесты коллекций файлов: валидация параметров
+// и участников, проверка доступа по членству, квоты при загрузке и claim
+// коллекций SA.
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// fakeCollectionRepo — репозиторий коллекций в памяти (только чтение).
+type fakeCollectionRepo struct {
+	repository.CollectionRepository
+	collections map[string]*model.Collection
+	members     []*model.CollectionMember
+}
+
+func (f *fakeCollectionRepo) GetByID(_ context.Context, id string) (*model.Collection, error) {
+	c, ok := f.collections[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return c, nil
+}
+
+func (f *fakeCollectionRepo) MemberPermissions(_ context.Context, collectionID string, subject model.CollectionSubject) ([]string, error) {
+	for _, m := range f.members {
+		if m.CollectionID == collectionID && m.SubjectType == subject.Type && m.SubjectID == subject.ID {
+			return m.Permissions, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeCollectionRepo) ServiceAccountCollections(_ context.Context, permission string) (map[string][]string, error) {
+	result := make(map[string][]string)
+	for _, m := range f.members {
+		if m.SubjectType == model.CollectionMemberServiceAccount && slices.Contains(m.Permissions, permission) {
+			result[m.SubjectID] = append(result[m.SubjectID], m.CollectionID)
+		}
+	}
+	return result, nil
+}
+
+// fakeClaimSARepo — Service Accounts в памяти (поиск по client_id и список).
+type fakeClaimSARepo struct {
+	repository.ServiceAccountRepository
+	accounts []*model.ServiceAccount
+}
+
+func (f *fakeClaimSARepo) GetByClientID(_ context.Context, clientID string) (*model.ServiceAccount, error) {
+	for _, sa := range f.accounts {
+		if sa.ClientID == clientID {
+			return sa, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (f *fakeClaimSARepo) List(_ context.Context, _ *string, _, _ int) ([]*model.ServiceAccount, error) {
+	return f.accounts, nil
+}
+
+// fakeClaimProvider — IdP, запоминающий claims клиентов.
+type fakeClaimProvider struct {
+	idp.Provider
+	claims map[string]string
+	calls  int
+}
+
+func (f *fakeClaimProvider) SetClientClaim(_ context.Context, id, name, value string) error {
+	f.calls++
+	f.claims[id+"/"+name] = value
+	return nil
+}
+
+const testCollectionID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+func newTestCollections() *CollectionService {
+	quotaBytes, quotaFiles := int64(1000), int64(3)
+	repo := &fakeCollectionRepo{
+		collections: map[string]*model.Collection{
+			testCollectionID: {
+				ID: testCollectionID, Name: "marketing",
+				QuotaBytes: &quotaBytes, QuotaFiles: &quotaFiles,
+				UsedBytes: 900, UsedFiles: 2,
+			},
+		},
+		members: []*model.CollectionMember{
+			{CollectionID: testCollectionID, SubjectType: model.CollectionMemberUser, SubjectID: "alice", Permissions: []string{"read", "write"}},
+			{CollectionID: testCollectionID, SubjectType: model.CollectionMemberServiceAccount, SubjectID: "sa_ingest", Permissions: []string{"read"}},
+		},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewCollectionService(repo, nil, logger)
+}
+
+func TestCollectionServiceCheckAccess(t *testing.T) {
+	s := newTestCollections()
+	ctx := context.Background()
+	id := testCollectionID
+
+	alice := &middleware.AuthClaims{SubjectType: middleware.SubjectTypeUser, PreferredUsername: "alice",
+		Permissions: rbac.NewPermissionSet(rbac.PermFilesRead, rbac.PermFilesWrite)}
+	bob := &middleware.AuthClaims{SubjectType: middleware.SubjectTypeUser, PreferredUsername: "bob",
+		Permissions: rbac.NewPermissionSet(rbac.PermFilesRead)}
+	admin := &middleware.AuthClaims{SubjectType: middleware.SubjectTypeUser, PreferredUsername: "root",
+		Permissions: rbac.NewPermissionSet(rbac.PermCollectionsWrite)}
+	sa := &middleware.AuthClaims{SubjectType: middleware.SubjectTypeSA, ClientID: "sa_ingest"}
+
+	tests := []struct {
+		name       string
+		claims     *middleware.AuthClaims
+		collection *string
+		permission string
+		wantErr    error
+	}{
+		{"файл вне коллекций", bob, nil, model.CollectionPermDelete, nil},
+		{"участник с правом", alice, &id, model.CollectionPermWrite, nil},
+		{"участник без права", alice, &id, model.CollectionPermDelete, ErrForbidden},
+		{"не участник", bob, &id, model.CollectionPermRead, ErrForbidden},
+		{"collections:write — все коллекции", admin, &id, model.CollectionPermDelete, nil},
+		{"SA по client_id", sa, &id, model.CollectionPermRead, nil},
+		{"SA без права", sa, &id, model.CollectionPermWrite, ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.CheckAccess(ctx, tt.claims, tt.collection, tt.permission)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckAccess() = %v, хотели %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCollectionServiceCheckUpload(t *testing.T) {
+	s := newTestCollections()
+	ctx := context.Background()
+	alice := &middleware.AuthClaims{SubjectType: middleware.SubjectTypeUser, PreferredUsername: "alice"}
+	sa := &middleware.AuthClaims{SubjectType: middleware.SubjectTypeSA, ClientID: "sa_ingest"}
+
+	if err := s.CheckUpload(ctx, alice, testCollectionID, 100); err != nil {
+		t.Errorf("CheckUpload(100 байт) = %v, хотели nil", err)
+	}
+	if err := s.CheckUpload(ctx, alice, testCollectionID, 101); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("CheckUpload(101 байт) = %v, хотели ErrQuotaExceeded", err)
+	}
+	if err := s.CheckUpload(ctx, sa, testCollectionID, 1); !errors.Is(err, ErrForbidden) {
+		t.Errorf("CheckUpload(SA без write) = %v, хотели ErrForbidden", err)
+	}
+	if err := s.CheckUpload(ctx, alice, "00000000-0000-0000-0000-000000000000", 1); !errors.Is(err, ErrValidation) {
+		t.Errorf("CheckUpload(неизвестная коллекция) = %v, хотели ErrValidation", err)
+	}
+
+	// Квота на количество файлов
+	c, _ := s.Get(ctx, testCollectionID)
+	c.UsedFiles = 3
+	if err := s.CheckUpload(ctx, alice, testCollectionID, 1); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("CheckUpload(лимит файлов) = %v, хотели ErrQuotaExceeded", err)
+	}
+}
+
+func TestCollectionClaimService(t *testing.T) {
+	ctx := context.Background()
+	kcIngest, kcReports := "kc-ingest", "kc-reports"
+	repo := &fakeCollectionRepo{
+		members: []*model.CollectionMember{
+			{CollectionID: testCollectionID, SubjectType: model.CollectionMemberServiceAccount, SubjectID: "sa_ingest", Permissions: []string{"read", "write"}},
+			{CollectionID: testCollectionID, SubjectType: model.CollectionMemberServiceAccount, SubjectID: "sa_reports", Permissions: []string{"read"}},
+			{CollectionID: testCollectionID, SubjectType: model.CollectionMemberUser, SubjectID: "sa_ingest", Permissions: []string{"write"}},
+		},
+	}
+	saRepo := &fakeClaimSARepo{accounts: []*model.ServiceAccount{
+		{ID: "1", ClientID: "sa_ingest", KeycloakClientID: &kcIngest},
+		{ID: "2", ClientID: "sa_reports", KeycloakClientID: &kcReports},
+		{ID: "3", ClientID: "sa_new"},
+	}}
+	provider := &fakeClaimProvider{claims: make(map[string]string)}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := NewCollectionClaimService(provider, saRepo, repo, logger)
+
+	// Первая синхронизация передаёт claim всем SA в IdP, включая пустые
+	n, err := s.SyncClaims(ctx)
+	if err != nil {
+		t.Fatalf("SyncClaims() ошибка: %v", err)
+	}
+	if n != 1 || provider.calls != 2 {
+		t.Errorf("SyncClaims() = %d, вызовов IdP %d, хотели 1 и 2", n, provider.calls)
+	}
+	want := `["` + testCollectionID + `"]`
+	if got := provider.claims["kc-ingest/"+model.CollectionsClaimName]; got != want {
+		t.Errorf("claim sa_ingest = %q, хотели %q", got, want)
+	}
+	if got, ok := provider.claims["kc-reports/"+model.CollectionsClaimName]; !ok || got != "" {
+		t.Errorf("claim sa_reports = %q (%v), хотели пустой", got, ok)
+	}
+
+	// Без изменений IdP не вызывается
+	if n, _ := s.SyncClaims(ctx); n != 0 || provider.calls != 2 {
+		t.Errorf("повторный SyncClaims() = %d, вызовов IdP %d, хотели 0 и 2", n, provider.calls)
+	}
+
+	// Право write снято — Push удаляет claim
+	repo.members[0].Permissions = []string{"read"}
+	if err := s.Push(ctx, "sa_ingest"); err != nil {
+		t.Fatalf("Push() ошибка: %v", err)
+	}
+	if got := provider.claims["kc-ingest/"+model.CollectionsClaimName]; got != "" {
+		t.Errorf("claim sa_ingest после снятия write = %q, хотели пустой", got)
+	}
+	if err := s.Push(ctx, "sa_new"); err == nil {
+		t.Error("Push(SA без keycloak_client_id) = nil, хотели ошибку")
+	}
+}
+
+func TestValidateCollectionInput(t *testing.T) {
+	zero := int64(0)
+	for _, in := range []CollectionInput{
+		{Name: "A"},
+		{Name: "1team"},
+		{Name: "team_a"},
+		{Name: "team", QuotaBytes: &zero},
+		{Name: "team", QuotaFiles: &zero},
+	} {
+		if _, err := validateCollectionInput(in); !errors.Is(err, ErrValidation) {
+			t.Errorf("validateCollectionInput(%+v) = %v, хотели ErrValidation", in, err)
+		}
+	}
+
+	c, err := validateCollectionInput(CollectionInput{Name: " team-a ", Description: " Команда A "})
+	if err != nil {
+		t.Fatalf("validateCollectionInput() ошибка: %v", err)
+	}
+	if c.Name != "team-a" || c.Description != "Команда A" {
+		t.Errorf("нормализация: %q, %q", c.Name, c.Description)
+	}
+}
+
+func TestValidateCollectionMember(t *testing.T) {
+	for _, in := range []CollectionMemberInput{
+		{SubjectType: "group", SubjectID: "devs", Permissions: []string{"read"}},
+		{SubjectType: "user", SubjectID: " ", Permissions: []string{"read"}},
+		{SubjectType: "user", SubjectID: "alice"},
+		{SubjectType: "user", SubjectID: "alice", Permissions: []string{"admin"}},
+	} {
+		if _, err := validateCollectionMember(in); !errors.Is(err, ErrValidation) {
+			t.Errorf("validateCollectionMember(%+v) = %v, хотели ErrValidation", in, err)
+		}
+	}
+
+	// Права без повторов и в порядке read, write, delete
+	m, err := validateCollectionMember(CollectionMemberInput{
+		SubjectType: model.CollectionMemberServiceAccount,
+		SubjectID:   "sa_ingest",
+		Permissions: []string{"delete", "read", "delete"},
+	})
+	if err != nil {
+		t.Fatalf("validateCollectionMember() ошибка: %v", err)
+	}
+	if !slices.Equal(m.Permissions, []string{"read", "delete"}) {
+		t.Errorf("Permissions = %v, хотели [read delete]", m.Permissions)
+	}
+}
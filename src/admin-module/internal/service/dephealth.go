@@ -2,7 +2,7 @@
 //
 // Admin Module мониторит:
 //   - PostgreSQL — SQL checker через существующий pgxpool (connection pool mode, critical)
-//   - Keycloak — HTTP checker к JWKS endpoint (critical)
+//   - Keycloak — HTTP checker к JWKS endpoint (critical; не при локальном IdP)
 //   - Storage Elements — HTTP checker к /health/ready (динамические, non-critical)
 //
 // SE endpoints добавляются/удаляются динамически при CRUD операциях через
@@ -61,7 +61,8 @@ type DephealthService struct {
 //   - group — имя группы в метриках (AM_DEPHEALTH_GROUP)
 //   - db — *sql.DB, полученный из pgxpool через stdlib.OpenDBFromPool()
 //   - pgConnURL — URL подключения к PostgreSQL (для метрик/лейблов, не для подключения)
-//   - keycloakJWKSURL — URL JWKS endpoint Keycloak
+//   - keycloakJWKSURL — URL JWKS endpoint Keycloak (пусто — локальный IdP,
+//     зависимость Keycloak не регистрируется)
 //   - checkInterval — интервал проверки зависимостей (AM_DEPHEALTH_CHECK_INTERVAL)
 //   - tlsSkipVerify — пропускать проверку TLS-сертификатов (AM_TLS_SKIP_VERIFY)
 //   - isEntry — при true добавляет лейбл isentry=yes ко всем зависимостям (DEPHEALTH_ISENTRY)
@@ -149,9 +150,11 @@ func newDephealthService(
 		// состояние пула соединений и может обнаружить его исчерпание.
 		dephealth.AddDependency("postgresql", dephealth.TypePostgres,
 			pgcheck.New(pgcheck.WithDB(db)), pgDepOpts...),
-		// Keycloak — HTTP checker к JWKS endpoint
-		dephealth.HTTP("keycloak-jwks", kcDepOpts...),
 	)
+	if keycloakJWKSURL != "" {
+		// Keycloak — HTTP checker к JWKS endpoint
+		opts = append(opts, dephealth.HTTP("keycloak-jwks", kcDepOpts...))
+	}
 	opts = append(opts, extraOpts...)
 
 	dh, err := dephealth.New(serviceID, group, opts...)
@@ -168,7 +171,7 @@ func newDephealthService(
 
 // Start запускает периодическую проверку зависимостей.
 func (ds *DephealthService) Start(ctx context.Context) error {
-	ds.logger.Info("Мониторинг зависимостей запущен (PostgreSQL + Keycloak)")
+	ds.logger.Info("Мониторинг зависимостей запущен")
 	return ds.dh.Start(ctx)
 }
 
@@ -184,6 +187,17 @@ func (ds *DephealthService) Health() map[string]bool {
 	return ds.dh.Health()
 }
 
+// SEHealth возвращает результат последней проверки SE в dephealth.
+// known = false, если SE ещё не проверялся или не зарегистрирован.
+func (ds *DephealthService) SEHealth(name, seURL string) (healthy, known bool) {
+	host, port, _, err := parseSEURL(seURL)
+	if err != nil {
+		return false, false
+	}
+	healthy, known = ds.dh.Health()[NormalizeSEDepName(name)+":"+host+":"+port]
+	return healthy, known
+}
+
 // --- Динамическое управление SE endpoints ---
 
 // NormalizeSEDepName нормализует имя SE для dephealth (regex: ^[a-z][a-z0-9-]*$, 1-63).
@@ -9,11 +9,17 @@ var (
 	// ErrConflict — конфликт (дублирующийся ресурс).
 	ErrConflict = errors.New("конфликт — ресурс уже существует")
 	// ErrInvalidRole — некорректная роль.
-	ErrInvalidRole = errors.New("некорректная роль: допустимые значения — admin, readonly")
+	ErrInvalidRole = errors.New("некорректная роль: роль отсутствует в каталоге ролей")
+	// ErrForbidden — у пользователя недостаточно разрешений для операции.
+	ErrForbidden = errors.New("недостаточно прав")
 	// ErrSEUnavailable — Storage Element недоступен.
 	ErrSEUnavailable = errors.New("storage element недоступен")
 	// ErrIDPUnavailable — Identity Provider (Keycloak) недоступен.
 	ErrIDPUnavailable = errors.New("identity provider недоступен")
 	// ErrValidation — ошибка валидации входных данных.
 	ErrValidation = errors.New("ошибка валидации")
+	// ErrQuotaExceeded — превышена квота коллекции.
+	ErrQuotaExceeded = errors.New("превышена квота коллекции")
+	// ErrUnsupported — операция не поддерживается текущим Identity Provider.
+	ErrUnsupported = errors.New("операция не поддерживается текущим identity provider")
 )
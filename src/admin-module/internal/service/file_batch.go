@@ -0,0 +1,573 @@
+// file_batch.go — групповые операции над файлами реестра.
+//
+// FileBatchService выполняет удаление, добавление/удаление тегов и изменение
+// статуса для набора файлов как фоновую операцию (file_batch_operations).
+// Набор файлов фиксируется при создании операции (file_batch_results):
+// явный список ID или все файлы, подходящие под фильтр списка файлов.
+// Каждый файл обрабатывается через FileRegistryService — с записью на SE,
+// outbox и аудитом, как при изменении одного файла. Результат по каждому
+// файлу сохраняется; операцию можно отменить (Cancel).
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// MaxFileBatchFiles — максимум файлов в одной групповой операции.
+const MaxFileBatchFiles = 10000
+
+// fileBatchChunk — количество файлов, обрабатываемых между сохранениями прогресса.
+const fileBatchChunk = 50
+
+// maxConcurrentFileBatches — максимум одновременно выполняемых групповых операций.
+const maxConcurrentFileBatches = 2
+
+// Причины отмены и ошибки групповой операции.
+const (
+	fileBatchCancelledByUser     = "отменено пользователем"
+	fileBatchCancelledByShutdown = "прервано остановкой Admin Module"
+	fileBatchInterruptedOnStart  = "прервано перезапуском Admin Module"
+	fileBatchMissingFile         = "файл не найден или недоступен"
+)
+
+// FileBatchRequest — параметры групповой операции над файлами.
+type FileBatchRequest struct {
+	// Action — действие (delete, add_tags, remove_tags, set_status)
+	Action string
+	// Tags — теги для add_tags и remove_tags
+	Tags []string
+	// Status — новый статус для set_status
+	Status *string
+	// FileIDs — явный выбор файлов
+	FileIDs []string
+	// Filter — все файлы, подходящие под фильтр (взаимоисключающе с FileIDs)
+	Filter *repository.FileListFilters
+	// Grants, GrantSubjects — ограничения files:write SA: в операцию
+	// попадают только файлы, которые SA разрешено изменять
+	Grants        []grants.Grant
+	GrantSubjects []string
+	// Collections — ограничение по коллекциям: в операцию попадают файлы
+	// коллекций, где у субъекта есть право на действие (nil — без ограничения)
+	Collections *model.CollectionScope
+}
+
+// filters возвращает фильтр выбора файлов операции.
+func (r *FileBatchRequest) filters() repository.FileListFilters {
+	var f repository.FileListFilters
+	if r.Filter != nil {
+		f = *r.Filter
+	}
+	if r.FileIDs != nil {
+		f.FileIDs = r.FileIDs
+	}
+	f.Grants = r.Grants
+	f.GrantSubjects = r.GrantSubjects
+	f.Collections = r.Collections
+	return f
+}
+
+// activeFileBatch — выполняющаяся групповая операция.
+type activeFileBatch struct {
+	op           *model.FileBatchOperation
+	cancel       context.CancelFunc
+	cancelReason string
+	done         chan struct{}
+}
+
+// FileBatchService — сервис групповых операций над файлами.
+type FileBatchService struct {
+	batchRepo repository.FileBatchRepository
+	fileRepo  repository.FileRegistryRepository
+	files     *FileRegistryService
+	audit     *AuditService
+	logger    *slog.Logger
+
+	// runCtx — родительский контекст операций; отменяется в Stop
+	runCtx    context.Context
+	runCancel context.CancelFunc
+	sem       chan struct{}
+	runs      sync.WaitGroup
+
+	mu     sync.Mutex
+	active map[string]*activeFileBatch // operation ID → операция
+}
+
+// NewFileBatchService создаёт сервис групповых операций над файлами.
+func NewFileBatchService(
+	batchRepo repository.FileBatchRepository,
+	fileRepo repository.FileRegistryRepository,
+	files *FileRegistryService,
+	audit *AuditService,
+	logger *slog.Logger,
+) *FileBatchService {
+	runCtx, runCancel := context.WithCancel(context.Background())
+	return &FileBatchService{
+		batchRepo: batchRepo,
+		fileRepo:  fileRepo,
+		files:     files,
+		audit:     audit,
+		logger:    logger.With(slog.String("component", "file_batch")),
+		runCtx:    runCtx,
+		runCancel: runCancel,
+		sem:       make(chan struct{}, maxConcurrentFileBatches),
+		active:    make(map[string]*activeFileBatch),
+	}
+}
+
+// Start завершает операции, прерванные перезапуском процесса.
+func (s *FileBatchService) Start(ctx context.Context) {
+	if n, err := s.batchRepo.FailUnfinished(ctx, fileBatchInterruptedOnStart); err != nil {
+		s.logger.Warn("Ошибка завершения прерванных групповых операций", slog.String("error", err.Error()))
+	} else if n > 0 {
+		s.logger.Warn("Прерванные групповые операции помечены как failed", slog.Int("count", n))
+	}
+}
+
+// Stop отменяет выполняющиеся операции и ждёт их завершения.
+func (s *FileBatchService) Stop() {
+	s.mu.Lock()
+	for _, a := range s.active {
+		a.cancelReason = fileBatchCancelledByShutdown
+	}
+	s.mu.Unlock()
+	s.runCancel()
+	s.runs.Wait()
+}
+
+// Preview проверяет параметры операции и возвращает количество файлов,
+// которые в неё попадут.
+func (s *FileBatchService) Preview(ctx context.Context, req *FileBatchRequest) (int, error) {
+	if err := validateFileBatchRequest(req); err != nil {
+		return 0, err
+	}
+	n, err := s.fileRepo.Count(ctx, req.filters())
+	if err != nil {
+		return 0, fmt.Errorf("подсчёт файлов групповой операции: %w", err)
+	}
+	return n, nil
+}
+
+// StartOperation фиксирует набор файлов, ставит операцию в очередь и сразу
+// возвращает её. Файлы из FileIDs, не найденные в реестре (или недоступные SA),
+// сразу получают результат failed.
+func (s *FileBatchService) StartOperation(ctx context.Context, req *FileBatchRequest) (*model.FileBatchOperation, error) {
+	if err := validateFileBatchRequest(req); err != nil {
+		return nil, err
+	}
+
+	filters := req.filters()
+	matched, err := s.fileRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("подсчёт файлов групповой операции: %w", err)
+	}
+	if matched > MaxFileBatchFiles {
+		return nil, fmt.Errorf("%w: под выбор попадает %d файлов, максимум %d — уточните фильтр",
+			ErrValidation, matched, MaxFileBatchFiles)
+	}
+	if matched == 0 && len(req.FileIDs) == 0 {
+		return nil, fmt.Errorf("%w: под выбор не попадает ни одного файла", ErrValidation)
+	}
+
+	op := &model.FileBatchOperation{
+		ID:           uuid.New().String(),
+		Action:       req.Action,
+		Tags:         req.Tags,
+		TargetStatus: req.Status,
+		Status:       model.FileBatchQueued,
+		RequestedBy:  requestedBy(ctx),
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionFileBatch,
+		TargetType: model.AuditTargetFileBatch,
+		TargetID:   op.ID,
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		repo := repository.NewFileBatchRepository(db)
+		if err := repo.Create(ctx, op); err != nil {
+			return err
+		}
+		added, err := repo.AddFiles(ctx, op.ID, filters, MaxFileBatchFiles)
+		if err != nil {
+			return err
+		}
+		missing := 0
+		if len(req.FileIDs) > 0 {
+			if missing, err = repo.AddMissing(ctx, op.ID, req.FileIDs, fileBatchMissingFile); err != nil {
+				return err
+			}
+		}
+		op.Total = added + missing
+		op.Processed = missing
+		op.Failed = missing
+		change.After = fileBatchAuditState(op)
+		return repo.Update(ctx, op)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("создание групповой операции: %w", err)
+	}
+
+	s.logger.Info("Групповая операция над файлами запущена",
+		slog.String("operation_id", op.ID),
+		slog.String("action", op.Action),
+		slog.Int("total", op.Total),
+	)
+
+	// Изменения файлов выполняются от имени субъекта, запустившего операцию
+	runCtx, cancel := context.WithCancel(s.runCtx)
+	if claims := middleware.ClaimsFromContext(ctx); claims != nil {
+		runCtx = middleware.WithClaims(runCtx, claims)
+	}
+	a := &activeFileBatch{op: op, cancel: cancel, done: make(chan struct{})}
+	s.mu.Lock()
+	s.active[op.ID] = a
+	s.mu.Unlock()
+
+	// Снимок берётся до запуска: execute изменяет op в своей горутине
+	snapshot := *op
+	s.runs.Add(1)
+	go s.execute(runCtx, a)
+
+	return &snapshot, nil
+}
+
+// Cancel отменяет выполняющуюся операцию и ждёт её остановки.
+// Возвращает ErrNotFound для неизвестной операции и ErrConflict для завершённой.
+func (s *FileBatchService) Cancel(ctx context.Context, id string) (*model.FileBatchOperation, error) {
+	s.mu.Lock()
+	a, ok := s.active[id]
+	if ok {
+		a.cancelReason = fileBatchCancelledByUser
+		a.cancel()
+	}
+	s.mu.Unlock()
+
+	if ok {
+		s.logger.Info("Отмена групповой операции", slog.String("operation_id", id))
+		select {
+		case <-a.done:
+		case <-ctx.Done():
+		}
+	}
+
+	op, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok && op.Finished() {
+		return op, fmt.Errorf("%w: групповая операция уже завершена", ErrConflict)
+	}
+	return op, nil
+}
+
+// Get возвращает групповую операцию по ID.
+func (s *FileBatchService) Get(ctx context.Context, id string) (*model.FileBatchOperation, error) {
+	op, err := s.batchRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение групповой операции: %w", err)
+	}
+	return op, nil
+}
+
+// ListResults возвращает результаты операции по файлам с пагинацией.
+func (s *FileBatchService) ListResults(ctx context.Context, id string, status *string, limit, offset int) ([]*model.FileBatchResult, int, error) {
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, 0, err
+	}
+
+	results, err := s.batchRepo.ListResults(ctx, id, status, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение результатов групповой операции: %w", err)
+	}
+	total, err := s.batchRepo.CountResults(ctx, id, status)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчёт результатов групповой операции: %w", err)
+	}
+	return results, total, nil
+}
+
+// execute обрабатывает файлы операции порциями, сохраняя прогресс.
+func (s *FileBatchService) execute(ctx context.Context, a *activeFileBatch) {
+	defer s.runs.Done()
+	defer close(a.done)
+	defer s.release(a)
+
+	op := a.op
+	// Сохранение состояния не должно прерываться отменой операции
+	saveCtx := context.WithoutCancel(ctx)
+
+	select {
+	case s.sem <- struct{}{}:
+		defer func() { <-s.sem }()
+	case <-ctx.Done():
+		s.finish(saveCtx, a, ctx.Err())
+		return
+	}
+
+	startedAt := time.Now().UTC()
+	op.Status = model.FileBatchRunning
+	op.StartedAt = &startedAt
+	s.save(saveCtx, op)
+
+	for {
+		if ctx.Err() != nil {
+			s.finish(saveCtx, a, ctx.Err())
+			return
+		}
+		ids, err := s.batchRepo.NextQueued(saveCtx, op.ID, fileBatchChunk)
+		if err != nil {
+			s.finish(saveCtx, a, err)
+			return
+		}
+		if len(ids) == 0 {
+			s.finish(saveCtx, a, nil)
+			return
+		}
+
+		for _, fileID := range ids {
+			if ctx.Err() != nil {
+				break
+			}
+			res := &model.FileBatchResult{OperationID: op.ID, FileID: fileID}
+			res.Status, err = s.apply(ctx, op, fileID)
+			if err != nil {
+				if ctx.Err() != nil {
+					// Файл не обработан из-за отмены — остаётся в очереди
+					break
+				}
+				msg := err.Error()
+				res.Status = model.FileBatchResultFailed
+				res.Error = &msg
+			}
+			if err := s.batchRepo.SetResult(saveCtx, res); err != nil {
+				s.finish(saveCtx, a, err)
+				return
+			}
+			op.Processed++
+			switch res.Status {
+			case model.FileBatchResultSucceeded, model.FileBatchResultPending:
+				op.Succeeded++
+			case model.FileBatchResultSkipped:
+				op.Skipped++
+			default:
+				op.Failed++
+			}
+		}
+		s.save(saveCtx, op)
+	}
+}
+
+// apply применяет действие операции к одному файлу и возвращает результат.
+func (s *FileBatchService) apply(ctx context.Context, op *model.FileBatchOperation, fileID string) (string, error) {
+	f, err := s.fileRepo.GetByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", errors.New(fileBatchMissingFile)
+		}
+		return "", fmt.Errorf("получение файла: %w", err)
+	}
+
+	var updated *model.FileRecord
+	switch op.Action {
+	case model.FileBatchActionDelete:
+		if f.Status == statusDeleted {
+			return model.FileBatchResultSkipped, nil
+		}
+		updated, err = s.files.Delete(ctx, fileID)
+
+	case model.FileBatchActionAddTags, model.FileBatchActionRemoveTags:
+		tags, changed := applyBatchTags(f.Tags, op.Tags, op.Action == model.FileBatchActionAddTags)
+		if !changed {
+			return model.FileBatchResultSkipped, nil
+		}
+		updated, err = s.files.Update(ctx, fileID, nil, &tags, nil)
+
+	case model.FileBatchActionSetStatus:
+		if op.TargetStatus == nil || f.Status == *op.TargetStatus {
+			return model.FileBatchResultSkipped, nil
+		}
+		updated, err = s.files.Update(ctx, fileID, nil, nil, op.TargetStatus)
+
+	default:
+		return "", fmt.Errorf("неизвестное действие групповой операции: %s", op.Action)
+	}
+	if err != nil {
+		return "", err
+	}
+	if updated.PendingWrite != nil {
+		return model.FileBatchResultPending, nil
+	}
+	return model.FileBatchResultSucceeded, nil
+}
+
+// finish сохраняет итог операции: succeeded, failed или cancelled.
+// Ошибки отдельных файлов не делают операцию failed — они в результатах по файлам.
+func (s *FileBatchService) finish(ctx context.Context, a *activeFileBatch, err error) {
+	op := a.op
+	completedAt := time.Now().UTC()
+	op.CompletedAt = &completedAt
+	if op.StartedAt != nil {
+		durationMs := completedAt.Sub(*op.StartedAt).Milliseconds()
+		op.DurationMs = &durationMs
+	}
+
+	switch {
+	case err == nil:
+		op.Status = model.FileBatchSucceeded
+	case errors.Is(err, context.Canceled):
+		s.mu.Lock()
+		reason := a.cancelReason
+		s.mu.Unlock()
+		if reason == "" {
+			reason = fileBatchCancelledByUser
+		}
+		op.Status = model.FileBatchCancelled
+		op.Error = &reason
+	default:
+		msg := err.Error()
+		op.Status = model.FileBatchFailed
+		op.Error = &msg
+		s.logger.Warn("Ошибка групповой операции над файлами",
+			slog.String("operation_id", op.ID),
+			slog.String("error", msg),
+		)
+	}
+
+	if op.Status != model.FileBatchSucceeded {
+		if _, cErr := s.batchRepo.CancelQueued(ctx, op.ID); cErr != nil {
+			s.logger.Warn("Ошибка отмены необработанных файлов операции",
+				slog.String("operation_id", op.ID),
+				slog.String("error", cErr.Error()),
+			)
+		}
+	}
+
+	s.save(ctx, op)
+	s.logger.Info("Групповая операция над файлами завершена",
+		slog.String("operation_id", op.ID),
+		slog.String("status", op.Status),
+		slog.Int("succeeded", op.Succeeded),
+		slog.Int("skipped", op.Skipped),
+		slog.Int("failed", op.Failed),
+	)
+}
+
+// save сохраняет состояние операции в БД.
+func (s *FileBatchService) save(ctx context.Context, op *model.FileBatchOperation) {
+	if err := s.batchRepo.Update(ctx, op); err != nil {
+		s.logger.Warn("Ошибка сохранения состояния групповой операции",
+			slog.String("operation_id", op.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// release удаляет операцию из списка выполняющихся.
+func (s *FileBatchService) release(a *activeFileBatch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.active, a.op.ID)
+}
+
+// validateFileBatchRequest проверяет и нормализует параметры операции
+// (теги — без пробелов по краям и дубликатов).
+func validateFileBatchRequest(req *FileBatchRequest) error {
+	switch req.Action {
+	case model.FileBatchActionDelete:
+	case model.FileBatchActionAddTags, model.FileBatchActionRemoveTags:
+		req.Tags = normalizeBatchTags(req.Tags)
+		if len(req.Tags) == 0 {
+			return fmt.Errorf("%w: для действия %s нужен хотя бы один тег", ErrValidation, req.Action)
+		}
+	case model.FileBatchActionSetStatus:
+		if req.Status == nil {
+			return fmt.Errorf("%w: для действия set_status нужен статус", ErrValidation)
+		}
+		switch *req.Status {
+		case statusActive, statusDeleted, "expired":
+		default:
+			return fmt.Errorf("%w: неизвестный статус файла %q", ErrValidation, *req.Status)
+		}
+	default:
+		return fmt.Errorf("%w: неизвестное действие %q", ErrValidation, req.Action)
+	}
+
+	if (len(req.FileIDs) == 0) == (req.Filter == nil) {
+		return fmt.Errorf("%w: нужно указать либо список файлов, либо фильтр", ErrValidation)
+	}
+	if len(req.FileIDs) > MaxFileBatchFiles {
+		return fmt.Errorf("%w: выбрано %d файлов, максимум %d", ErrValidation, len(req.FileIDs), MaxFileBatchFiles)
+	}
+	for _, id := range req.FileIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return fmt.Errorf("%w: некорректный ID файла %q", ErrValidation, id)
+		}
+	}
+	return nil
+}
+
+// normalizeBatchTags убирает пробелы по краям, пустые значения и дубликаты.
+func normalizeBatchTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t != "" && !slices.Contains(result, t) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// applyBatchTags добавляет (add = true) или удаляет теги файла.
+// Возвращает новый список и признак изменения.
+func applyBatchTags(current, tags []string, add bool) ([]string, bool) {
+	result := slices.Clone(current)
+	if result == nil {
+		result = []string{}
+	}
+	changed := false
+	for _, t := range tags {
+		i := slices.Index(result, t)
+		switch {
+		case add && i < 0:
+			result = append(result, t)
+			changed = true
+		case !add && i >= 0:
+			result = slices.Delete(result, i, i+1)
+			changed = true
+		}
+	}
+	return result, changed
+}
+
+// fileBatchAuditState — параметры групповой операции для журнала аудита.
+func fileBatchAuditState(op *model.FileBatchOperation) map[string]any {
+	state := map[string]any{
+		"action": op.Action,
+		"total":  op.Total,
+	}
+	if len(op.Tags) > 0 {
+		state["tags"] = op.Tags
+	}
+	if op.TargetStatus != nil {
+		state["status"] = *op.TargetStatus
+	}
+	return state
+}
@@ -0,0 +1,87 @@
+// file_batch_test.go — unit-тесты групповых операций над файлами:
+// валидация запроса и изменение тегов.
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// TestValidateFileBatchRequest проверяет валидацию параметров операции.
+func TestValidateFileBatchRequest(t *testing.T) {
+	fileID := "11111111-1111-1111-1111-111111111111"
+	deleted := "deleted"
+	unknown := "archived"
+
+	tests := []struct {
+		name    string
+		req     FileBatchRequest
+		wantErr bool
+	}{
+		{"удаление по списку", FileBatchRequest{Action: model.FileBatchActionDelete, FileIDs: []string{fileID}}, false},
+		{"удаление по фильтру", FileBatchRequest{Action: model.FileBatchActionDelete, Filter: &repository.FileListFilters{}}, false},
+		{"теги", FileBatchRequest{Action: model.FileBatchActionAddTags, Tags: []string{"a"}, FileIDs: []string{fileID}}, false},
+		{"статус", FileBatchRequest{Action: model.FileBatchActionSetStatus, Status: &deleted, FileIDs: []string{fileID}}, false},
+		{"неизвестное действие", FileBatchRequest{Action: "purge", FileIDs: []string{fileID}}, true},
+		{"теги пустые", FileBatchRequest{Action: model.FileBatchActionRemoveTags, Tags: []string{" ", ""}, FileIDs: []string{fileID}}, true},
+		{"статус не указан", FileBatchRequest{Action: model.FileBatchActionSetStatus, FileIDs: []string{fileID}}, true},
+		{"неизвестный статус", FileBatchRequest{Action: model.FileBatchActionSetStatus, Status: &unknown, FileIDs: []string{fileID}}, true},
+		{"нет выбора", FileBatchRequest{Action: model.FileBatchActionDelete}, true},
+		{"список и фильтр", FileBatchRequest{Action: model.FileBatchActionDelete, FileIDs: []string{fileID}, Filter: &repository.FileListFilters{}}, true},
+		{"некорректный ID", FileBatchRequest{Action: model.FileBatchActionDelete, FileIDs: []string{"file-1"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFileBatchRequest(&tt.req)
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("ожидалась ErrValidation, получено %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("неожиданная ошибка: %v", err)
+			}
+		})
+	}
+
+	// Теги нормализуются
+	req := FileBatchRequest{Action: model.FileBatchActionAddTags, Tags: []string{" a ", "b", "a", ""}, FileIDs: []string{fileID}}
+	if err := validateFileBatchRequest(&req); err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+	if !slices.Equal(req.Tags, []string{"a", "b"}) {
+		t.Errorf("Tags = %v, ожидалось [a b]", req.Tags)
+	}
+}
+
+// TestApplyBatchTags проверяет добавление и удаление тегов.
+func TestApplyBatchTags(t *testing.T) {
+	current := []string{"a", "b"}
+
+	got, changed := applyBatchTags(current, []string{"b", "c"}, true)
+	if !changed || !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("add: %v, %v", got, changed)
+	}
+	if !slices.Equal(current, []string{"a", "b"}) {
+		t.Errorf("исходный список изменён: %v", current)
+	}
+
+	if _, changed := applyBatchTags(current, []string{"a"}, true); changed {
+		t.Error("add существующего тега: ожидалось без изменений")
+	}
+
+	got, changed = applyBatchTags(current, []string{"a", "x"}, false)
+	if !changed || !slices.Equal(got, []string{"b"}) {
+		t.Errorf("remove: %v, %v", got, changed)
+	}
+
+	got, changed = applyBatchTags(nil, []string{"x"}, false)
+	if changed || got == nil || len(got) != 0 {
+		t.Errorf("remove из пустого списка: %v, %v", got, changed)
+	}
+}
@@ -1,5 +1,7 @@
 // file_registry.go — сервис файлового реестра.
 // CRUD файлов: регистрация, получение, обновление, soft delete.
+// Обновление и удаление сначала применяются на основном SE файла
+// (SEWriteService), при недоступности SE — через outbox.
 package service
 
 import (
@@ -11,31 +13,54 @@ import (
 
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
 )
 
-// Константа статуса файла по умолчанию.
-const statusActive = "active"
+// Статусы файла.
+const (
+	statusActive  = "active"
+	statusDeleted = "deleted"
+)
 
 // FileRegistryService — сервис файлового реестра.
 type FileRegistryService struct {
-	fileRepo repository.FileRegistryRepository
-	seRepo   repository.StorageElementRepository
-	logger   *slog.Logger
+	fileRepo       repository.FileRegistryRepository
+	seRepo         repository.StorageElementRepository
+	replicaRepo    repository.FileReplicaRepository
+	writeRepo      repository.SEWriteRepository
+	writer         *SEWriteService
+	audit          *AuditService
+	replicationSvc *ReplicationService
+	logger         *slog.Logger
 }
 
 // NewFileRegistryService создаёт сервис файлового реестра.
 func NewFileRegistryService(
 	fileRepo repository.FileRegistryRepository,
 	seRepo repository.StorageElementRepository,
+	replicaRepo repository.FileReplicaRepository,
+	writeRepo repository.SEWriteRepository,
+	writer *SEWriteService,
+	audit *AuditService,
 	logger *slog.Logger,
 ) *FileRegistryService {
 	return &FileRegistryService{
-		fileRepo: fileRepo,
-		seRepo:   seRepo,
-		logger:   logger.With(slog.String("component", "file_registry_service")),
+		fileRepo:    fileRepo,
+		seRepo:      seRepo,
+		replicaRepo: replicaRepo,
+		writeRepo:   writeRepo,
+		writer:      writer,
+		audit:       audit,
+		logger:      logger.With(slog.String("component", "file_registry_service")),
 	}
 }
 
+// SetReplicationService устанавливает ссылку на ReplicationService.
+// После регистрации файла сервис репликации запускается вне очереди.
+func (s *FileRegistryService) SetReplicationService(replicationSvc *ReplicationService) {
+	s.replicationSvc = replicationSvc
+}
+
 // Register регистрирует файл в реестре.
 // Проверяет существование SE перед регистрацией.
 func (s *FileRegistryService) Register(ctx context.Context, f *model.FileRecord) error {
@@ -63,7 +88,16 @@ func (s *FileRegistryService) Register(ctx context.Context, f *model.FileRecord)
 	}
 
 	// Регистрируем файл
-	if err := s.fileRepo.Register(ctx, f); err != nil {
+	change := &AuditChange{
+		Action:     model.AuditActionFileRegister,
+		TargetType: model.AuditTargetFile,
+		TargetID:   f.FileID,
+		After:      fileAuditState(f),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewFileRegistryRepository(db).Register(ctx, f)
+	})
+	if err != nil {
 		if errors.Is(err, repository.ErrConflict) {
 			return fmt.Errorf("%w: файл с ID '%s' уже зарегистрирован", ErrConflict, f.FileID)
 		}
@@ -76,6 +110,10 @@ func (s *FileRegistryService) Register(ctx context.Context, f *model.FileRecord)
 		slog.String("filename", f.OriginalFilename),
 	)
 
+	if s.replicationSvc != nil && f.RetentionPolicy == "permanent" {
+		s.replicationSvc.Trigger()
+	}
+
 	return nil
 }
 
@@ -91,6 +129,13 @@ func (s *FileRegistryService) List(ctx context.Context, filters repository.FileL
 		return nil, 0, fmt.Errorf("подсчёт файлов: %w", err)
 	}
 
+	if err := s.attachReplicas(ctx, files); err != nil {
+		return nil, 0, err
+	}
+	if err := s.attachPendingWrites(ctx, files); err != nil {
+		return nil, 0, err
+	}
+
 	return files, total, nil
 }
 
@@ -103,10 +148,69 @@ func (s *FileRegistryService) Get(ctx context.Context, fileID string) (*model.Fi
 		}
 		return nil, fmt.Errorf("получение файла: %w", err)
 	}
+	if err := s.attachReplicas(ctx, []*model.FileRecord{f}); err != nil {
+		return nil, err
+	}
+	if err := s.attachPendingWrites(ctx, []*model.FileRecord{f}); err != nil {
+		return nil, err
+	}
 	return f, nil
 }
 
+// attachReplicas заполняет Replicas у переданных файлов.
+func (s *FileRegistryService) attachReplicas(ctx context.Context, files []*model.FileRecord) error {
+	if s.replicaRepo == nil || len(files) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(files))
+	byID := make(map[string]*model.FileRecord, len(files))
+	for _, f := range files {
+		ids = append(ids, f.FileID)
+		byID[f.FileID] = f
+	}
+
+	replicas, err := s.replicaRepo.ListByFiles(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("получение реплик файлов: %w", err)
+	}
+	for _, rep := range replicas {
+		if f, ok := byID[rep.FileID]; ok {
+			f.Replicas = append(f.Replicas, *rep)
+		}
+	}
+	return nil
+}
+
+// attachPendingWrites заполняет PendingWrite у переданных файлов.
+func (s *FileRegistryService) attachPendingWrites(ctx context.Context, files []*model.FileRecord) error {
+	if s.writeRepo == nil || len(files) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(files))
+	byID := make(map[string]*model.FileRecord, len(files))
+	for _, f := range files {
+		ids = append(ids, f.FileID)
+		byID[f.FileID] = f
+	}
+
+	writes, err := s.writeRepo.ListByFiles(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("получение изменений, ожидающих записи на SE: %w", err)
+	}
+	for _, w := range writes {
+		if f, ok := byID[w.FileID]; ok {
+			f.PendingWrite = w
+		}
+	}
+	return nil
+}
+
 // Update обновляет метаданные файла (description, tags, status).
+// Изменение сначала применяется на основном SE файла, затем в реестре.
+// Статус `deleted` выполняет удаление на SE; остальные статусы задаёт SE
+// (например, expired — GC по TTL), их изменение отклоняется.
 func (s *FileRegistryService) Update(ctx context.Context, fileID string, description *string, tags *[]string, status *string) (*model.FileRecord, error) {
 	// Получаем текущий файл
 	f, err := s.fileRepo.GetByID(ctx, fileID)
@@ -116,6 +220,18 @@ func (s *FileRegistryService) Update(ctx context.Context, fileID string, descrip
 		}
 		return nil, fmt.Errorf("получение файла для обновления: %w", err)
 	}
+	before := fileAuditState(f)
+
+	operation := model.SEWriteOpUpdate
+	if status != nil && *status != f.Status {
+		if *status != statusDeleted {
+			return nil, fmt.Errorf("%w: статус %s устанавливается Storage Element", ErrValidation, *status)
+		}
+		operation = model.SEWriteOpDelete
+	}
+	if operation == model.SEWriteOpUpdate && description == nil && tags == nil {
+		return f, nil
+	}
 
 	// Применяем обновления
 	if description != nil {
@@ -124,34 +240,133 @@ func (s *FileRegistryService) Update(ctx context.Context, fileID string, descrip
 	if tags != nil {
 		f.Tags = *tags
 	}
-	if status != nil {
-		f.Status = *status
+	if operation == model.SEWriteOpDelete {
+		f.Status = statusDeleted
 	}
 
-	// Обновляем в БД
-	if err := s.fileRepo.Update(ctx, f); err != nil {
-		return nil, fmt.Errorf("обновление файла: %w", err)
+	change := &AuditChange{
+		Action:     model.AuditActionFileUpdate,
+		TargetType: model.AuditTargetFile,
+		TargetID:   fileID,
+		Before:     before,
+		After:      fileAuditState(f),
+	}
+	if err := s.writeThrough(ctx, f, operation, change, func(db repository.DBTX) error {
+		return repository.NewFileRegistryRepository(db).Update(ctx, f)
+	}); err != nil {
+		return nil, err
 	}
 
 	s.logger.Info("Файл обновлён",
 		slog.String("file_id", fileID),
+		slog.Bool("se_pending", f.PendingWrite != nil),
 	)
 
 	return f, nil
 }
 
-// Delete выполняет soft delete файла (status → deleted).
-func (s *FileRegistryService) Delete(ctx context.Context, fileID string) error {
-	if err := s.fileRepo.Delete(ctx, fileID); err != nil {
+// Delete выполняет soft delete файла (status → deleted) на SE и в реестре.
+// Возвращает файл; PendingWrite != nil — удаление на SE отложено.
+func (s *FileRegistryService) Delete(ctx context.Context, fileID string) (*model.FileRecord, error) {
+	f, err := s.fileRepo.GetByID(ctx, fileID)
+	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return ErrNotFound
+			return nil, ErrNotFound
 		}
-		return fmt.Errorf("soft delete файла: %w", err)
+		return nil, fmt.Errorf("получение файла для удаления: %w", err)
 	}
 
+	change := &AuditChange{
+		Action:     model.AuditActionFileDelete,
+		TargetType: model.AuditTargetFile,
+		TargetID:   fileID,
+		Before:     map[string]any{"status": f.Status},
+		After:      map[string]any{"status": statusDeleted},
+	}
+	err = s.writeThrough(ctx, f, model.SEWriteOpDelete, change, func(db repository.DBTX) error {
+		return repository.NewFileRegistryRepository(db).Delete(ctx, fileID)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	f.Status = statusDeleted
+
 	s.logger.Info("Файл помечен как удалённый",
 		slog.String("file_id", fileID),
+		slog.Bool("se_pending", f.PendingWrite != nil),
 	)
 
+	return f, nil
+}
+
+// writeThrough применяет изменение файла на основном SE, затем выполняет
+// update в транзакции реестра (вместе с записью аудита).
+// Отказ SE по существу возвращается как ErrConflict, реестр не меняется.
+// При временной ошибке SE изменение сохраняется в outbox в той же
+// транзакции, f.PendingWrite указывает на него.
+func (s *FileRegistryService) writeThrough(
+	ctx context.Context,
+	f *model.FileRecord,
+	operation string,
+	change *AuditChange,
+	update func(db repository.DBTX) error,
+) error {
+	w := NewWrite(f, operation)
+
+	applyErr := s.writer.Apply(ctx, w)
+	if applyErr != nil && seclient.IsRejected(applyErr) {
+		seWritesTotal.WithLabelValues(operation, "rejected").Inc()
+		return fmt.Errorf("%w: Storage Element отклонил изменение: %s", ErrConflict, rejectionMessage(applyErr))
+	}
+
+	if applyErr != nil {
+		s.writer.scheduleRetry(w, applyErr)
+		s.logger.Warn("SE недоступен, изменение файла отложено",
+			slog.String("file_id", f.FileID),
+			slog.String("operation", operation),
+			slog.String("error", applyErr.Error()),
+		)
+	}
+
+	err := s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		if err := update(db); err != nil {
+			return err
+		}
+		writeRepo := repository.NewSEWriteRepository(db)
+		if applyErr != nil {
+			return writeRepo.Enqueue(ctx, w)
+		}
+		// Изменение применено на SE — более ранние отложенные изменения неактуальны
+		return writeRepo.DeleteByFile(ctx, f.FileID)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("обновление файла в реестре: %w", err)
+	}
+
+	if applyErr != nil {
+		f.PendingWrite = w
+		seWritesTotal.WithLabelValues(operation, "queued").Inc()
+	} else {
+		seWritesTotal.WithLabelValues(operation, "applied").Inc()
+	}
 	return nil
 }
+
+// fileAuditState — метаданные файла для журнала аудита.
+func fileAuditState(f *model.FileRecord) map[string]any {
+	return map[string]any{
+		"original_filename":  f.OriginalFilename,
+		"storage_element_id": f.StorageElementID,
+		"size":               f.Size,
+		"description":        f.Description,
+		"tags":               f.Tags,
+		"status":             f.Status,
+		"retention_policy":   f.RetentionPolicy,
+	}
+}
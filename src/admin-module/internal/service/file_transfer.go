@@ -0,0 +1,285 @@
+// file_transfer.go — загрузка и скачивание файлов через Admin Module (Admin UI).
+//
+// Загрузка: файл передаётся потоком на выбранный SE или на SE, выбранный
+// политикой размещения (PlacementService), с токеном пользователя — SE
+// проверяет его права и записывает uploaded_by. После ответа SE файл
+// регистрируется в реестре (с аудитом), как это делает Ingester. Файл
+// коллекции не может быть больше заявленного размера, по которому проверены
+// квоты; после загрузки квоты проверяются по размеру файла на SE.
+//
+// Скачивание: содержимое проксируется с основного SE файла или, если тот
+// недоступен, с подтверждённой реплики. Заголовки Range и условные заголовки
+// передаются SE, ответ (200, 206, 304, 416) возвращается клиенту как есть.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
+)
+
+// FileUploadRequest — параметры загрузки файла.
+type FileUploadRequest struct {
+	// StorageElementID — SE для загрузки; пустой — выбор политикой размещения
+	StorageElementID string
+	// RetentionPolicy — permanent или temporary (для выбора SE размещением)
+	RetentionPolicy string
+	// Size — заявленный размер файла (для резервирования места при размещении)
+	Size        int64
+	Filename    string
+	ContentType string
+	Description string
+	Tags        []string
+	// CollectionID — коллекция файла (пустая — общее пространство)
+	CollectionID string
+	// Claims — субъект загрузки (право write и квоты коллекции)
+	Claims *middleware.AuthClaims
+	// Body — содержимое файла
+	Body io.Reader
+	// Token — токен пользователя для запросов к SE
+	Token string
+	// Subject — sub пользователя: автор файла коллекции, который загружается
+	// токеном Admin Module
+	Subject string
+}
+
+// FileTransferService — загрузка файлов на SE и проксирование скачивания.
+type FileTransferService struct {
+	seClient    *seclient.Client
+	seRepo      repository.StorageElementRepository
+	files       *FileRegistryService
+	placement   *PlacementService
+	collections *CollectionService
+	logger      *slog.Logger
+}
+
+// NewFileTransferService создаёт сервис загрузки и скачивания файлов.
+func NewFileTransferService(
+	seClient *seclient.Client,
+	seRepo repository.StorageElementRepository,
+	files *FileRegistryService,
+	placement *PlacementService,
+	collections *CollectionService,
+	logger *slog.Logger,
+) *FileTransferService {
+	return &FileTransferService{
+		seClient:    seClient,
+		seRepo:      seRepo,
+		files:       files,
+		placement:   placement,
+		collections: collections,
+		logger:      logger.With(slog.String("component", "file_transfer")),
+	}
+}
+
+// Upload загружает файл на SE и регистрирует его в реестре.
+// Возвращает ErrValidation при некорректных параметрах или неподходящем SE,
+// ErrConflict — если подходящего SE нет или SE отклонил загрузку,
+// ErrForbidden и ErrQuotaExceeded — при проверке коллекции.
+func (s *FileTransferService) Upload(ctx context.Context, req *FileUploadRequest) (*model.FileRecord, error) {
+	if req.Filename == "" {
+		return nil, fmt.Errorf("%w: не указан файл", ErrValidation)
+	}
+	if req.CollectionID != "" {
+		if err := s.collections.CheckUpload(ctx, req.Claims, req.CollectionID, req.Size); err != nil {
+			return nil, err
+		}
+	}
+
+	se, release, err := s.uploadTarget(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Токен пользователя не допускает коллекции на SE (claim
+	// artstore_collections есть только у SA), поэтому файл коллекции
+	// загружается токеном Admin Module (storage:write) от имени пользователя
+	upload := seclient.FileUpload{
+		Filename:     req.Filename,
+		ContentType:  req.ContentType,
+		Description:  req.Description,
+		Tags:         req.Tags,
+		CollectionID: req.CollectionID,
+		Body:         req.Body,
+	}
+	uploadCtx := seclient.WithToken(ctx, req.Token)
+	var limited *sizeLimitReader
+	if req.CollectionID != "" {
+		limited = &sizeLimitReader{r: req.Body, limit: req.Size}
+		upload.UploadedBy = req.Subject
+		upload.Body = limited
+		uploadCtx = ctx
+	}
+	meta, err := s.seClient.Upload(uploadCtx, se.URL, upload)
+	if err != nil {
+		if limited != nil && limited.exceeded() {
+			return nil, fmt.Errorf("%w: файл больше заявленного размера %d байт", ErrValidation, req.Size)
+		}
+		if seclient.IsRejected(err) {
+			return nil, fmt.Errorf("%w: SE %s отклонил загрузку: %v", ErrConflict, se.Name, err)
+		}
+		return nil, fmt.Errorf("загрузка файла на SE %s: %w", se.Name, err)
+	}
+
+	f, err := seFileToRecord(*meta, se.ID)
+	if err != nil {
+		return nil, fmt.Errorf("ответ SE %s на загрузку: %w", se.Name, err)
+	}
+	if req.CollectionID != "" {
+		// Использование коллекции могло измениться во время загрузки
+		if err := s.collections.CheckUpload(ctx, req.Claims, req.CollectionID, f.Size); err != nil {
+			s.discard(ctx, se, f.FileID)
+			return nil, err
+		}
+	}
+	if err := s.files.Register(ctx, f); err != nil {
+		// Файл уже на SE: следующая синхронизация добавит его в реестр
+		s.logger.Warn("Файл загружен на SE, но не зарегистрирован",
+			slog.String("file_id", f.FileID),
+			slog.String("se_id", se.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("файл %s загружен на SE %s, но не зарегистрирован (появится после синхронизации): %w",
+			f.FileID, se.Name, err)
+	}
+
+	s.logger.Info("Файл загружен через Admin UI",
+		slog.String("file_id", f.FileID),
+		slog.String("se_id", se.ID),
+		slog.Int64("size", f.Size),
+	)
+	return f, nil
+}
+
+// discard удаляет с SE загруженный, но не допущенный в реестр файл
+// коллекции (токеном Admin Module, которым файл загружен). SE удаляет
+// файлы только в режиме edit; иначе файл появится в реестре после
+// синхронизации.
+func (s *FileTransferService) discard(ctx context.Context, se *model.StorageElement, fileID string) {
+	if err := s.seClient.DeleteFile(ctx, se.URL, fileID); err != nil {
+		s.logger.Warn("Не удалось удалить с SE файл коллекции сверх квоты",
+			slog.String("file_id", fileID),
+			slog.String("se_id", se.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// uploadTarget возвращает SE для загрузки и функцию освобождения резервирования.
+// Явно выбранный SE должен быть online и принимать загрузки (режим edit или rw).
+func (s *FileTransferService) uploadTarget(ctx context.Context, req *FileUploadRequest) (*model.StorageElement, func(), error) {
+	if req.StorageElementID != "" {
+		se, err := s.seRepo.GetByID(ctx, req.StorageElementID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, nil, fmt.Errorf("%w: Storage Element '%s' не найден", ErrValidation, req.StorageElementID)
+			}
+			return nil, nil, fmt.Errorf("получение SE: %w", err)
+		}
+		if se.Status != "online" {
+			return nil, nil, fmt.Errorf("%w: SE %s недоступен (статус %s)", ErrConflict, se.Name, se.Status)
+		}
+		if !AcceptsUploads(se) {
+			return nil, nil, fmt.Errorf("%w: SE %s в режиме %s не принимает загрузки", ErrValidation, se.Name, se.Mode)
+		}
+		return se, func() {}, nil
+	}
+
+	result, err := s.placement.Select(ctx, PlacementRequest{
+		RetentionPolicy: req.RetentionPolicy,
+		SizeBytes:       req.Size,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	release := func() {
+		_ = s.placement.Release(result.Reservation.ID)
+	}
+	return result.Candidates[0].StorageElement, release, nil
+}
+
+// Download открывает содержимое файла на SE с заголовками Range и условными
+// заголовками из header. Пробует основной SE, затем подтверждённые реплики —
+// при сетевых ошибках и 5xx. Возвращает метаданные файла и ответ SE;
+// вызывающий обязан закрыть Body ответа.
+func (s *FileTransferService) Download(ctx context.Context, fileID, token string, header http.Header) (*model.FileRecord, *http.Response, error) {
+	f, err := s.files.Get(ctx, fileID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seIDs := []string{f.StorageElementID}
+	for _, rep := range f.Replicas {
+		if rep.Status == model.ReplicaStatusSynced {
+			seIDs = append(seIDs, rep.StorageElementID)
+		}
+	}
+
+	ctx = seclient.WithToken(ctx, token)
+	var lastErr error
+	for _, seID := range seIDs {
+		se, err := s.seRepo.GetByID(ctx, seID)
+		if err != nil {
+			lastErr = fmt.Errorf("получение SE %s: %w", seID, err)
+			continue
+		}
+		resp, err := s.seClient.DownloadRange(ctx, se.URL, fileID, header)
+		if err == nil {
+			return f, resp, nil
+		}
+		if seclient.IsNotFound(err) {
+			lastErr = fmt.Errorf("%w: файл отсутствует на SE %s", ErrNotFound, se.Name)
+			continue
+		}
+		if seclient.IsRejected(err) {
+			return nil, nil, fmt.Errorf("%w: SE %s отклонил скачивание: %v", ErrConflict, se.Name, err)
+		}
+		s.logger.Warn("SE недоступен для скачивания файла",
+			slog.String("file_id", fileID),
+			slog.String("se_id", se.ID),
+			slog.String("error", err.Error()),
+		)
+		lastErr = fmt.Errorf("скачивание с SE %s: %w", se.Name, err)
+	}
+	return nil, nil, lastErr
+}
+
+// AcceptsUploads возвращает true для SE, принимающего загрузки:
+// режим edit (temporary) или rw (permanent).
+func AcceptsUploads(se *model.StorageElement) bool {
+	return se.Mode == "edit" || se.Mode == "rw"
+}
+
+// errUploadTooLarge — поток файла длиннее заявленного размера.
+var errUploadTooLarge = errors.New("файл больше заявленного размера")
+
+// sizeLimitReader — поток файла, прерывающийся ошибкой после limit байт
+// (в отличие от io.LimitReader, который молча обрезал бы файл).
+type sizeLimitReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.exceeded() {
+		return 0, errUploadTooLarge
+	}
+	return n, err
+}
+
+// exceeded сообщает, что прочитано больше limit байт.
+func (l *sizeLimitReader) exceeded() bool {
+	return l.read > l.limit
+}
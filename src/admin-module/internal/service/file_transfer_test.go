@@ -0,0 +1,151 @@
+// file_transfer_test.go — unit-тесты загрузки файлов через Admin Module:
+// выбор SE для загрузки и квоты коллекций.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
+)
+
+// TestUploadTarget проверяет выбор SE: явно указанный SE и политику размещения.
+func TestUploadTarget(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	placement := newTestPlacement(PlacementPolicyMostFree,
+		placementSE("rw-small", "rw", "online", 100, nil),
+		placementSE("rw-big", "rw", "online", 1000, nil),
+		placementSE("edit", "edit", "online", 500, nil),
+	)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		se      *model.StorageElement
+		wantErr error
+	}{
+		{"edit online", placementSE("se", "edit", "online", 0, nil), nil},
+		{"rw online", placementSE("se", "rw", "online", 0, nil), nil},
+		{"ro", placementSE("se", "ro", "online", 0, nil), ErrValidation},
+		{"offline", placementSE("se", "rw", "offline", 0, nil), ErrConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewFileTransferService(nil, &stubSERepo{se: tt.se}, nil, placement, nil, logger)
+			se, release, err := s.uploadTarget(ctx, &FileUploadRequest{StorageElementID: "se"})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("uploadTarget() = %v, ожидалась %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("uploadTarget() ошибка: %v", err)
+			}
+			release()
+			if se != tt.se {
+				t.Errorf("выбран %s, ожидался явно указанный SE", se.ID)
+			}
+		})
+	}
+
+	// Без SE — политика размещения по retention policy, резервирование освобождается
+	s := NewFileTransferService(nil, nil, nil, placement, nil, logger)
+	se, release, err := s.uploadTarget(ctx, &FileUploadRequest{RetentionPolicy: "permanent", Size: 200})
+	if err != nil {
+		t.Fatalf("uploadTarget(placement) ошибка: %v", err)
+	}
+	if se.ID != "rw-big" {
+		t.Errorf("выбран %s, ожидался rw-big", se.ID)
+	}
+	if len(placement.reservations) != 1 {
+		t.Errorf("резервирований %d, ожидалось 1", len(placement.reservations))
+	}
+	release()
+	if len(placement.reservations) != 0 {
+		t.Errorf("после release резервирований %d, ожидалось 0", len(placement.reservations))
+	}
+
+	if _, _, err := s.uploadTarget(ctx, &FileUploadRequest{RetentionPolicy: "permanent", Size: 5000}); !errors.Is(err, ErrConflict) {
+		t.Errorf("uploadTarget(нет места) = %v, ожидалась ErrConflict", err)
+	}
+}
+
+// TestUploadCollectionQuota проверяет загрузку в коллекцию: файл больше
+// заявленного размера прерывается, а файл, превысивший квоту во время
+// загрузки, удаляется с SE и не регистрируется.
+func TestUploadCollectionQuota(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	ctx := context.Background()
+	collections := newTestCollections()
+	c, _ := collections.Get(ctx, testCollectionID)
+
+	var uploaded []byte
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			uploaded, _ = io.ReadAll(file)
+			// Параллельная загрузка заняла квоту коллекции
+			c.UsedBytes = 950
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(seclient.SEFileMetadata{
+				FileID: "f1", OriginalFilename: "a.txt", Size: int64(len(uploaded)), Status: "active",
+				RetentionPolicy: "permanent", UploadedAt: time.Now().UTC().Format(time.RFC3339),
+				CollectionID: testCollectionID,
+			})
+		case http.MethodDelete:
+			deleted = strings.TrimPrefix(r.URL.Path, "/api/v1/files/")
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client, err := seclient.New("", 5*time.Second, nil, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	se := placementSE("se", "edit", "online", 0, nil)
+	se.URL = server.URL
+	s := NewFileTransferService(client, &stubSERepo{se: se}, nil, nil, collections, logger)
+	alice := &middleware.AuthClaims{SubjectType: middleware.SubjectTypeUser, PreferredUsername: "alice"}
+	request := func(size int64, body string) *FileUploadRequest {
+		return &FileUploadRequest{
+			StorageElementID: "se", Size: size, Filename: "a.txt",
+			CollectionID: testCollectionID, Claims: alice, Body: strings.NewReader(body),
+		}
+	}
+
+	// Заявленный размер 5 байт, фактический — 10
+	if _, err := s.Upload(ctx, request(5, "0123456789")); !errors.Is(err, ErrValidation) {
+		t.Errorf("Upload(больше заявленного) = %v, хотели ErrValidation", err)
+	}
+	if uploaded != nil {
+		t.Errorf("SE получил файл %q, хотели прерванную загрузку", uploaded)
+	}
+
+	// Квота проверена по 100 байт, но во время загрузки использование выросло
+	if _, err := s.Upload(ctx, request(100, strings.Repeat("x", 100))); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("Upload(квота во время загрузки) = %v, хотели ErrQuotaExceeded", err)
+	}
+	if deleted != "f1" {
+		t.Errorf("удалён файл %q, хотели f1", deleted)
+	}
+}
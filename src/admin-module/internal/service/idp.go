@@ -1,5 +1,5 @@
-// idp.go — сервис статуса Identity Provider (Keycloak).
-// GetStatus — проверка подключения, RealmInfo, подсчёт users/clients.
+// idp.go — сервис статуса Identity Provider (Keycloak или локальный IdP).
+// GetStatus — проверка подключения, подсчёт users/clients.
 // SyncSA — принудительная синхронизация SA через SASyncService.
 package service
 
@@ -10,13 +10,13 @@ import (
 	"time"
 
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
-	"github.com/bigkaa/goartstore/admin-module/internal/keycloak"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 )
 
 // IDPService — сервис статуса Identity Provider.
 type IDPService struct {
-	kcClient      *keycloak.Client
+	provider      idp.Provider
 	saRepo        repository.ServiceAccountRepository
 	syncStateRepo repository.SyncStateRepository
 	saSyncSvc     *SASyncService
@@ -26,10 +26,13 @@ type IDPService struct {
 	logger        *slog.Logger
 }
 
-// IDPStatus — статус подключения к Keycloak.
+// IDPStatus — статус подключения к IdP.
 type IDPStatus struct {
-	Connected    bool
-	Realm        string
+	// Provider — keycloak или local
+	Provider  string
+	Connected bool
+	Realm     string
+	// KeycloakURL — URL Keycloak или issuer локального IdP
 	KeycloakURL  string
 	UsersCount   *int
 	ClientsCount *int
@@ -39,14 +42,14 @@ type IDPStatus struct {
 
 // NewIDPService создаёт сервис статуса IdP.
 func NewIDPService(
-	kcClient *keycloak.Client,
+	provider idp.Provider,
 	saRepo repository.ServiceAccountRepository,
 	syncStateRepo repository.SyncStateRepository,
 	keycloakURL, realm, saPrefix string,
 	logger *slog.Logger,
 ) *IDPService {
 	return &IDPService{
-		kcClient:      kcClient,
+		provider:      provider,
 		saRepo:        saRepo,
 		syncStateRepo: syncStateRepo,
 		keycloakURL:   keycloakURL,
@@ -62,17 +65,17 @@ func (s *IDPService) SetSASyncService(saSyncSvc *SASyncService) {
 	s.saSyncSvc = saSyncSvc
 }
 
-// GetStatus возвращает статус подключения к Keycloak.
+// GetStatus возвращает статус подключения к IdP.
 func (s *IDPService) GetStatus(ctx context.Context) *IDPStatus {
 	status := &IDPStatus{
+		Provider:    s.provider.Name(),
 		Realm:       s.realm,
 		KeycloakURL: s.keycloakURL,
 	}
 
-	// Проверяем доступность Keycloak
-	_, err := s.kcClient.RealmInfo(ctx)
-	if err != nil {
-		errMsg := fmt.Sprintf("Keycloak недоступен: %v", err)
+	// Проверяем доступность IdP
+	if err := s.provider.Ping(ctx); err != nil {
+		errMsg := fmt.Sprintf("IdP недоступен: %v", err)
 		status.Error = &errMsg
 		status.Connected = false
 		return status
@@ -81,7 +84,7 @@ func (s *IDPService) GetStatus(ctx context.Context) *IDPStatus {
 	status.Connected = true
 
 	// Подсчёт пользователей
-	usersCount, err := s.kcClient.CountUsers(ctx)
+	usersCount, err := s.provider.CountUsers(ctx)
 	if err != nil {
 		s.logger.Warn("Ошибка подсчёта пользователей", slog.String("error", err.Error()))
 	} else {
@@ -89,7 +92,7 @@ func (s *IDPService) GetStatus(ctx context.Context) *IDPStatus {
 	}
 
 	// Подсчёт SA clients (с префиксом sa_*)
-	clients, err := s.kcClient.ListClients(ctx, s.saPrefix, 0, 1000)
+	clients, err := s.provider.ListClients(ctx, s.saPrefix, 0, 1000)
 	if err != nil {
 		s.logger.Warn("Ошибка получения списка SA clients", slog.String("error", err.Error()))
 	} else {
@@ -108,7 +111,7 @@ func (s *IDPService) GetStatus(ctx context.Context) *IDPStatus {
 	return status
 }
 
-// SyncSA выполняет принудительную синхронизацию SA с Keycloak.
+// SyncSA выполняет принудительную синхронизацию SA с IdP.
 // Делегирует SASyncService.SyncNow для полной reconciliation.
 func (s *IDPService) SyncSA(ctx context.Context) (*model.SASyncResult, error) {
 	s.logger.Info("Принудительная синхронизация SA запущена")
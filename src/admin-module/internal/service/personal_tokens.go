@@ -0,0 +1,388 @@
+// personal_tokens.go — personal access tokens пользователей Admin Module.
+//
+// Пользователь выпускает именованный токен с набором scopes и сроком
+// действия для скриптов к Admin REST API. Токен показывается один раз,
+// в БД хранится его SHA-256. Запрос с токеном выполняется от имени
+// владельца: middleware.JWTAuth берёт его текущие группы из IdP (через
+// ResolvePersonalToken) и role override. Истёкшие токены видны в списке
+// ещё personalTokenExpiredRetention, затем удаляются фоновой очисткой.
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+const (
+	// personalTokenCleanupInterval — интервал удаления давно истёкших токенов.
+	personalTokenCleanupInterval = time.Hour
+	// personalTokenExpiredRetention — сколько истёкший токен остаётся в списке.
+	personalTokenExpiredRetention = 7 * 24 * time.Hour
+	// personalTokenOwnerCacheTTL — время кэширования данных владельца из IdP.
+	personalTokenOwnerCacheTTL = time.Minute
+	// personalTokenNameMaxLength — максимальная длина названия токена.
+	personalTokenNameMaxLength = 100
+	// personalTokenRandomBytes — энтропия токена.
+	personalTokenRandomBytes = 32
+	// personalTokenPrefixLength — длина начала токена, сохраняемого для списка.
+	personalTokenPrefixLength = len(middleware.PersonalTokenPrefix) + 8
+)
+
+// PersonalTokenScopes — scopes, которые можно выдать personal access token.
+var PersonalTokenScopes = []string{
+	"files:read", "files:write",
+	"storage:read", "storage:write",
+	"admin:read", "admin:write",
+}
+
+// errPersonalTokenInvalid — токен неизвестен, истёк или владелец недоступен.
+var errPersonalTokenInvalid = errors.New("personal access token недействителен")
+
+// PersonalTokenRequest — параметры нового personal access token.
+type PersonalTokenRequest struct {
+	Name      string
+	Scopes    []string
+	ExpiresAt time.Time
+}
+
+// personalTokenOwnerEntry — данные владельца из IdP в кэше.
+type personalTokenOwnerEntry struct {
+	user    *idp.User
+	groups  []string
+	expires time.Time
+}
+
+// PersonalTokenService — сервис personal access tokens.
+type PersonalTokenService struct {
+	repo       repository.PersonalTokenRepository
+	provider   idp.Provider
+	audit      *AuditService
+	maxTTL     time.Duration
+	maxPerUser int
+	logger     *slog.Logger
+
+	// owners — кэш пользователей IdP по sub, чтобы не обращаться к IdP на каждый запрос
+	mu     sync.Mutex
+	owners map[string]personalTokenOwnerEntry
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPersonalTokenService создаёт сервис personal access tokens.
+// maxTTL — максимальный срок действия токена (AM_PAT_MAX_TTL),
+// maxPerUser — лимит действующих токенов пользователя (AM_PAT_MAX_PER_USER).
+func NewPersonalTokenService(
+	repo repository.PersonalTokenRepository,
+	provider idp.Provider,
+	audit *AuditService,
+	maxTTL time.Duration,
+	maxPerUser int,
+	logger *slog.Logger,
+) *PersonalTokenService {
+	return &PersonalTokenService{
+		repo:       repo,
+		provider:   provider,
+		audit:      audit,
+		maxTTL:     maxTTL,
+		maxPerUser: maxPerUser,
+		logger:     logger.With(slog.String("component", "personal_tokens")),
+		owners:     make(map[string]personalTokenOwnerEntry),
+	}
+}
+
+// MaxTTL возвращает максимальный срок действия токена.
+func (s *PersonalTokenService) MaxTTL() time.Duration {
+	return s.maxTTL
+}
+
+// Start запускает фоновую очистку давно истёкших токенов.
+func (s *PersonalTokenService) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(personalTokenCleanupInterval)
+		defer ticker.Stop()
+
+		for {
+			s.Cleanup(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop останавливает фоновую очистку и ждёт завершения.
+func (s *PersonalTokenService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+// Cleanup удаляет токены, истёкшие раньше personalTokenExpiredRetention.
+// Возвращает количество удалённых.
+func (s *PersonalTokenService) Cleanup(ctx context.Context) int {
+	n, err := s.repo.DeleteExpired(ctx, time.Now().Add(-personalTokenExpiredRetention))
+	if err != nil {
+		s.logger.Error("Ошибка удаления истёкших personal access tokens",
+			slog.String("error", err.Error()),
+		)
+		return 0
+	}
+	if n > 0 {
+		s.logger.Info("Истёкшие personal access tokens удалены", slog.Int("count", n))
+	}
+	return n
+}
+
+// Create выпускает токен для пользователя owner и записывает событие аудита.
+// Возвращает сохранённый токен и его значение — оно больше нигде не доступно.
+func (s *PersonalTokenService) Create(
+	ctx context.Context, owner *middleware.AuthClaims, req PersonalTokenRequest,
+) (*model.PersonalToken, string, error) {
+	now := time.Now()
+	name, scopes, err := s.validateRequest(req, now)
+	if err != nil {
+		return nil, "", err
+	}
+
+	active, err := s.repo.CountActive(ctx, owner.Subject, now)
+	if err != nil {
+		return nil, "", fmt.Errorf("подсчёт personal access tokens: %w", err)
+	}
+	if active >= s.maxPerUser {
+		return nil, "", fmt.Errorf("%w: достигнут лимит действующих токенов (%d)", ErrValidation, s.maxPerUser)
+	}
+
+	secret := make([]byte, personalTokenRandomBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("генерация personal access token: %w", err)
+	}
+	value := middleware.PersonalTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	token := &model.PersonalToken{
+		ID:          uuid.New().String(),
+		TokenHash:   hashPersonalToken(value),
+		TokenPrefix: value[:personalTokenPrefixLength],
+		Subject:     owner.Subject,
+		Username:    owner.PreferredUsername,
+		Name:        name,
+		Scopes:      scopes,
+		ExpiresAt:   req.ExpiresAt,
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionPersonalTokenCreate,
+		TargetType: model.AuditTargetPersonalToken,
+		TargetID:   token.ID,
+		After:      personalTokenAuditState(token),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewPersonalTokenRepository(db).Create(ctx, token)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, "", fmt.Errorf("%w: токен «%s» уже существует", ErrConflict, name)
+		}
+		return nil, "", fmt.Errorf("создание personal access token: %w", err)
+	}
+
+	s.logger.Info("Personal access token создан",
+		slog.String("token_id", token.ID),
+		slog.String("user_id", token.Subject),
+		slog.String("name", token.Name),
+	)
+	return token, value, nil
+}
+
+// validateRequest проверяет название, scopes и срок действия токена.
+// Возвращает нормализованные название и scopes.
+func (s *PersonalTokenService) validateRequest(req PersonalTokenRequest, now time.Time) (string, []string, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len([]rune(name)) > personalTokenNameMaxLength {
+		return "", nil, fmt.Errorf("%w: название токена — от 1 до %d символов", ErrValidation, personalTokenNameMaxLength)
+	}
+
+	if len(req.Scopes) == 0 {
+		return "", nil, fmt.Errorf("%w: укажите хотя бы один scope", ErrValidation)
+	}
+	scopes := make([]string, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		if !slices.Contains(PersonalTokenScopes, scope) {
+			return "", nil, fmt.Errorf("%w: недопустимый scope %q", ErrValidation, scope)
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	slices.Sort(scopes)
+
+	if !req.ExpiresAt.After(now) {
+		return "", nil, fmt.Errorf("%w: срок действия должен быть в будущем", ErrValidation)
+	}
+	if req.ExpiresAt.After(now.Add(s.maxTTL)) {
+		return "", nil, fmt.Errorf("%w: срок действия не может превышать %s", ErrValidation, s.maxTTL)
+	}
+	return name, scopes, nil
+}
+
+// List возвращает токены (subject — необязательный фильтр по владельцу).
+func (s *PersonalTokenService) List(ctx context.Context, subject *string) ([]*model.PersonalToken, error) {
+	tokens, err := s.repo.List(ctx, subject)
+	if err != nil {
+		return nil, fmt.Errorf("получение personal access tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// Revoke отзывает токен и записывает событие аудита. Если задан owner,
+// отзывается только токен этого пользователя (чужой — ErrNotFound).
+func (s *PersonalTokenService) Revoke(ctx context.Context, id string, owner *string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return ErrNotFound
+	}
+	token, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("получение personal access token: %w", err)
+	}
+	if owner != nil && token.Subject != *owner {
+		return ErrNotFound
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionPersonalTokenRevoke,
+		TargetType: model.AuditTargetPersonalToken,
+		TargetID:   token.ID,
+		Before:     personalTokenAuditState(token),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewPersonalTokenRepository(db).Delete(ctx, id)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("отзыв personal access token: %w", err)
+	}
+	return nil
+}
+
+// ResolvePersonalToken проверяет токен и возвращает владельца с его
+// текущими группами в IdP. Реализует middleware.PersonalTokenResolver.
+func (s *PersonalTokenService) ResolvePersonalToken(ctx context.Context, value string) (*middleware.PersonalTokenOwner, error) {
+	token, err := s.repo.GetByTokenHash(ctx, hashPersonalToken(value))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, errPersonalTokenInvalid
+		}
+		return nil, err
+	}
+	now := time.Now()
+	if token.Expired(now) {
+		return nil, fmt.Errorf("%w: срок действия истёк", errPersonalTokenInvalid)
+	}
+
+	user, groups, err := s.owner(ctx, token.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Touch(ctx, token.ID, now, middleware.SourceIPFromContext(ctx)); err != nil {
+		s.logger.Warn("Ошибка записи использования personal access token",
+			slog.String("token_id", token.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return &middleware.PersonalTokenOwner{
+		TokenID:  token.ID,
+		Subject:  token.Subject,
+		Username: user.Username,
+		Email:    user.Email,
+		Groups:   groups,
+		Scopes:   token.Scopes,
+	}, nil
+}
+
+// owner возвращает пользователя IdP и имена его групп (с кэшированием
+// на personalTokenOwnerCacheTTL). Отключённый или удалённый пользователь — ошибка.
+func (s *PersonalTokenService) owner(ctx context.Context, subject string) (*idp.User, []string, error) {
+	now := time.Now()
+	s.mu.Lock()
+	entry, ok := s.owners[subject]
+	s.mu.Unlock()
+
+	if !ok || now.After(entry.expires) {
+		user, err := s.provider.GetUser(ctx, subject)
+		if err != nil {
+			if errors.Is(err, idp.ErrNotFound) {
+				return nil, nil, fmt.Errorf("%w: владелец не найден в IdP", errPersonalTokenInvalid)
+			}
+			return nil, nil, fmt.Errorf("получение владельца токена: %w", err)
+		}
+		idpGroups, err := s.provider.GetUserGroups(ctx, subject)
+		if err != nil {
+			return nil, nil, fmt.Errorf("получение групп владельца токена: %w", err)
+		}
+		groups := make([]string, len(idpGroups))
+		for i, g := range idpGroups {
+			groups[i] = g.Name
+		}
+
+		entry = personalTokenOwnerEntry{user: user, groups: groups, expires: now.Add(personalTokenOwnerCacheTTL)}
+		s.mu.Lock()
+		s.owners[subject] = entry
+		s.mu.Unlock()
+	}
+
+	if !entry.user.Enabled {
+		return nil, nil, fmt.Errorf("%w: владелец отключён в IdP", errPersonalTokenInvalid)
+	}
+	return entry.user, entry.groups, nil
+}
+
+// hashPersonalToken возвращает SHA-256 токена.
+func hashPersonalToken(value string) []byte {
+	h := sha256.Sum256([]byte(value))
+	return h[:]
+}
+
+// personalTokenAuditState — состояние токена для журнала аудита (без хеша).
+func personalTokenAuditState(t *model.PersonalToken) map[string]any {
+	return map[string]any{
+		"user_id":    t.Subject,
+		"username":   t.Username,
+		"name":       t.Name,
+		"scopes":     t.Scopes,
+		"expires_at": t.ExpiresAt,
+	}
+}
@@ -0,0 +1,149 @@
+// personal_tokens_test.go — unit-тесты personal access tokens:
+// валидация параметров и проверка токена с владельцем из IdP.
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// fakePersonalTokenRepo — репозиторий токенов в памяти (только чтение и Touch).
+type fakePersonalTokenRepo struct {
+	repository.PersonalTokenRepository
+	tokens  []*model.PersonalToken
+	touched int
+}
+
+func (f *fakePersonalTokenRepo) GetByTokenHash(_ context.Context, hash []byte) (*model.PersonalToken, error) {
+	for _, t := range f.tokens {
+		if string(t.TokenHash) == string(hash) {
+			return t, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (f *fakePersonalTokenRepo) Touch(context.Context, string, time.Time, string) error {
+	f.touched++
+	return nil
+}
+
+// fakeTokenOwners — IdP с пользователями и группами (только чтение).
+type fakeTokenOwners struct {
+	idp.Provider
+	users  map[string]*idp.User
+	groups map[string][]idp.Group
+	calls  int
+}
+
+func (f *fakeTokenOwners) GetUser(_ context.Context, id string) (*idp.User, error) {
+	f.calls++
+	u, ok := f.users[id]
+	if !ok {
+		return nil, idp.ErrNotFound
+	}
+	return u, nil
+}
+
+func (f *fakeTokenOwners) GetUserGroups(_ context.Context, id string) ([]idp.Group, error) {
+	return f.groups[id], nil
+}
+
+// newTestPersonalTokens создаёт сервис с токенами amp_alice (alice),
+// amp_expired (истёк) и amp_bob (отключённый пользователь).
+func newTestPersonalTokens() (*PersonalTokenService, *fakePersonalTokenRepo, *fakeTokenOwners) {
+	now := time.Now()
+	repo := &fakePersonalTokenRepo{tokens: []*model.PersonalToken{
+		{ID: "t1", TokenHash: hashPersonalToken("amp_alice"), Subject: "u-alice",
+			Scopes: []string{"files:read"}, ExpiresAt: now.Add(time.Hour)},
+		{ID: "t2", TokenHash: hashPersonalToken("amp_expired"), Subject: "u-alice",
+			Scopes: []string{"files:read"}, ExpiresAt: now.Add(-time.Minute)},
+		{ID: "t3", TokenHash: hashPersonalToken("amp_bob"), Subject: "u-bob",
+			Scopes: []string{"admin:read"}, ExpiresAt: now.Add(time.Hour)},
+	}}
+	owners := &fakeTokenOwners{
+		users: map[string]*idp.User{
+			"u-alice": {ID: "u-alice", Username: "alice", Email: "alice@example.com", Enabled: true},
+			"u-bob":   {ID: "u-bob", Username: "bob", Enabled: false},
+		},
+		groups: map[string][]idp.Group{
+			"u-alice": {{ID: "g1", Name: "artstore-admins", Path: "/artstore-admins"}},
+		},
+	}
+	svc := NewPersonalTokenService(repo, owners, nil, 30*24*time.Hour, 5,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	return svc, repo, owners
+}
+
+// TestResolvePersonalToken проверяет принятие и отклонение токенов.
+func TestResolvePersonalToken(t *testing.T) {
+	svc, repo, owners := newTestPersonalTokens()
+	ctx := context.Background()
+
+	owner, err := svc.ResolvePersonalToken(ctx, "amp_alice")
+	if err != nil {
+		t.Fatalf("ResolvePersonalToken() ошибка: %v", err)
+	}
+	if owner.TokenID != "t1" || owner.Subject != "u-alice" || owner.Username != "alice" ||
+		len(owner.Groups) != 1 || owner.Groups[0] != "artstore-admins" || owner.Scopes[0] != "files:read" {
+		t.Errorf("владелец = %+v", owner)
+	}
+	if repo.touched != 1 {
+		t.Errorf("Touch() вызван %d раз, ожидался 1", repo.touched)
+	}
+
+	// Повторный запрос — данные владельца из кэша
+	if _, err := svc.ResolvePersonalToken(ctx, "amp_alice"); err != nil {
+		t.Fatalf("повторный ResolvePersonalToken() ошибка: %v", err)
+	}
+	if owners.calls != 1 {
+		t.Errorf("обращений к IdP = %d, ожидалось 1", owners.calls)
+	}
+
+	for _, value := range []string{"amp_unknown", "amp_expired", "amp_bob"} {
+		if _, err := svc.ResolvePersonalToken(ctx, value); !errors.Is(err, errPersonalTokenInvalid) {
+			t.Errorf("ResolvePersonalToken(%s) = %v, ожидалась errPersonalTokenInvalid", value, err)
+		}
+	}
+}
+
+// TestPersonalTokenValidateRequest проверяет валидацию параметров токена.
+func TestPersonalTokenValidateRequest(t *testing.T) {
+	svc, _, _ := newTestPersonalTokens()
+	now := time.Now()
+
+	name, scopes, err := svc.validateRequest(PersonalTokenRequest{
+		Name:      "  ci  ",
+		Scopes:    []string{"files:write", "admin:read", "files:write"},
+		ExpiresAt: now.Add(24 * time.Hour),
+	}, now)
+	if err != nil {
+		t.Fatalf("validateRequest() ошибка: %v", err)
+	}
+	if name != "ci" || len(scopes) != 2 || scopes[0] != "admin:read" || scopes[1] != "files:write" {
+		t.Errorf("name = %q, scopes = %v", name, scopes)
+	}
+
+	invalid := map[string]PersonalTokenRequest{
+		"пустое название":   {Name: " ", Scopes: []string{"files:read"}, ExpiresAt: now.Add(time.Hour)},
+		"без scopes":        {Name: "ci", ExpiresAt: now.Add(time.Hour)},
+		"неизвестный scope": {Name: "ci", Scopes: []string{"files:delete"}, ExpiresAt: now.Add(time.Hour)},
+		"срок в прошлом":    {Name: "ci", Scopes: []string{"files:read"}, ExpiresAt: now.Add(-time.Hour)},
+		"срок больше max":   {Name: "ci", Scopes: []string{"files:read"}, ExpiresAt: now.Add(31 * 24 * time.Hour)},
+	}
+	for name, req := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := svc.validateRequest(req, now); !errors.Is(err, ErrValidation) {
+				t.Errorf("validateRequest() = %v, ожидалась ErrValidation", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+// placement.go — выбор Storage Element для загрузки файла.
+//
+// Клиенты (Ingester и др.) вызывают POST /api/v1/storage-elements/select
+// вместо самостоятельного выбора SE по GET /api/v1/storage-elements.
+//
+// Кандидаты — SE со статусом online в режиме, соответствующем retention policy
+// (temporary → edit, permanent → rw), без неудачной последней проверки dephealth
+// и со свободным местом не меньше размера файла с учётом активных резервирований.
+// Кандидаты ранжируются политикой размещения:
+//   - most_free — больше свободного места
+//   - round_robin — по очереди
+//   - weighted — случайно, с вероятностью пропорционально свободному месту
+//   - prefer_labels — больше совпадений с запрошенными метками, затем most_free
+//
+// require_labels — обязательные метки: SE без них не рассматриваются.
+//
+// На первом SE списка резервируется место под файл на AM_PLACEMENT_RESERVATION_TTL,
+// чтобы параллельные загрузки не выбрали один и тот же почти заполненный SE.
+// Резервирования хранятся в памяти экземпляра Admin Module и освобождаются
+// клиентом после загрузки или по истечении TTL.
+//
+// Prometheus-метрики:
+//   - admin_module_placement_selections_total — результаты выбора SE (selected, no_candidates)
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// Политики размещения файлов.
+const (
+	// PlacementPolicyMostFree — SE с наибольшим свободным местом
+	PlacementPolicyMostFree = "most_free"
+	// PlacementPolicyRoundRobin — SE по очереди
+	PlacementPolicyRoundRobin = "round_robin"
+	// PlacementPolicyWeighted — случайный выбор, вес — свободное место
+	PlacementPolicyWeighted = "weighted"
+	// PlacementPolicyPreferLabels — SE с наибольшим числом совпадающих меток
+	PlacementPolicyPreferLabels = "prefer_labels"
+)
+
+// placementSelectionsTotal — результаты выбора SE для загрузки.
+var placementSelectionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "admin_module_placement_selections_total",
+	Help: "Количество запросов выбора SE для загрузки файла",
+}, []string{"policy", "result"}) // result: selected, no_candidates
+
+// PlacementRequest — параметры выбора SE.
+type PlacementRequest struct {
+	// RetentionPolicy — temporary или permanent
+	RetentionPolicy string
+	// SizeBytes — размер загружаемого файла
+	SizeBytes int64
+	// Labels — предпочтительные метки SE (для политики prefer_labels)
+	Labels map[string]string
+	// RequireLabels — обязательные метки SE
+	RequireLabels map[string]string
+	// Policy — политика размещения; пустая — политика по умолчанию
+	Policy string
+	// Allow — дополнительный фильтр SE (grants SA); nil — любые SE
+	Allow func(se *model.StorageElement) bool
+}
+
+// PlacementCandidate — SE, подходящий для загрузки.
+type PlacementCandidate struct {
+	StorageElement *model.StorageElement
+	// FreeBytes — свободное место за вычетом резервирований
+	FreeBytes int64
+	// ReservedBytes — место, зарезервированное активными резервированиями
+	ReservedBytes int64
+	// LabelMatches — число совпавших запрошенных меток
+	LabelMatches int
+}
+
+// SEReservation — резервирование места на SE под загружаемый файл.
+type SEReservation struct {
+	ID               string
+	StorageElementID string
+	SizeBytes        int64
+	ExpiresAt        time.Time
+}
+
+// PlacementResult — результат выбора SE.
+type PlacementResult struct {
+	// Policy — применённая политика
+	Policy string
+	// Candidates — подходящие SE в порядке предпочтения; первый — выбранный
+	Candidates []*PlacementCandidate
+	// Reservation — резервирование на выбранном SE
+	Reservation *SEReservation
+}
+
+// PlacementService — выбор SE для загрузки и учёт резервирований.
+type PlacementService struct {
+	seRepo         repository.StorageElementRepository
+	dephealthSvc   *DephealthService
+	policy         string
+	reservationTTL time.Duration
+	logger         *slog.Logger
+
+	// rrCounter — смещение для политики round_robin
+	rrCounter atomic.Uint64
+
+	mu           sync.Mutex
+	reservations map[string]*SEReservation
+}
+
+// NewPlacementService создаёт сервис выбора SE.
+// policy — политика по умолчанию, reservationTTL — время жизни резервирования.
+func NewPlacementService(
+	seRepo repository.StorageElementRepository,
+	policy string,
+	reservationTTL time.Duration,
+	logger *slog.Logger,
+) *PlacementService {
+	return &PlacementService{
+		seRepo:         seRepo,
+		policy:         policy,
+		reservationTTL: reservationTTL,
+		logger:         logger.With(slog.String("component", "placement")),
+		reservations:   make(map[string]*SEReservation),
+	}
+}
+
+// SetDephealthService устанавливает ссылку на DephealthService.
+// Если nil — состояние проверок dephealth при выборе SE не учитывается.
+func (s *PlacementService) SetDephealthService(dephealthSvc *DephealthService) {
+	s.dephealthSvc = dephealthSvc
+}
+
+// Select выбирает SE для загрузки файла и резервирует на нём место.
+// Возвращает ErrValidation при некорректных параметрах и ErrConflict,
+// если подходящих SE нет.
+func (s *PlacementService) Select(ctx context.Context, req PlacementRequest) (*PlacementResult, error) {
+	mode, err := placementMode(req.RetentionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if req.SizeBytes < 0 {
+		return nil, fmt.Errorf("%w: size_bytes не может быть отрицательным", ErrValidation)
+	}
+	policy := req.Policy
+	if policy == "" {
+		policy = s.policy
+	}
+	if !isPlacementPolicy(policy) {
+		return nil, fmt.Errorf("%w: неизвестная политика размещения %q", ErrValidation, policy)
+	}
+
+	online := "online"
+	ses, err := s.seRepo.List(ctx, repository.StorageElementFilters{
+		Mode:   &mode,
+		Status: &online,
+		Labels: req.RequireLabels,
+	}, 1000, 0)
+	if err != nil {
+		return nil, fmt.Errorf("получение списка SE: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	reserved := s.reservedBytes(now)
+
+	candidates := make([]*PlacementCandidate, 0, len(ses))
+	for _, se := range ses {
+		if req.Allow != nil && !req.Allow(se) {
+			continue
+		}
+		if s.dephealthSvc != nil {
+			if healthy, known := s.dephealthSvc.SEHealth(se.Name, se.URL); known && !healthy {
+				continue
+			}
+		}
+		c := &PlacementCandidate{
+			StorageElement: se,
+			ReservedBytes:  reserved[se.ID],
+			FreeBytes:      availableBytes(se) - reserved[se.ID],
+			LabelMatches:   labelMatches(se.Labels, req.Labels),
+		}
+		if c.FreeBytes < req.SizeBytes {
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+
+	if len(candidates) == 0 {
+		placementSelectionsTotal.WithLabelValues(policy, "no_candidates").Inc()
+		return nil, fmt.Errorf("%w: нет SE в режиме %s со статусом online, обязательными метками и свободным местом %d байт",
+			ErrConflict, mode, req.SizeBytes)
+	}
+
+	s.rank(policy, candidates)
+
+	chosen := candidates[0]
+	reservation := &SEReservation{
+		ID:               uuid.New().String(),
+		StorageElementID: chosen.StorageElement.ID,
+		SizeBytes:        req.SizeBytes,
+		ExpiresAt:        now.Add(s.reservationTTL),
+	}
+	s.reservations[reservation.ID] = reservation
+	chosen.ReservedBytes += req.SizeBytes
+	chosen.FreeBytes -= req.SizeBytes
+
+	placementSelectionsTotal.WithLabelValues(policy, "selected").Inc()
+	s.logger.Debug("Выбран SE для загрузки",
+		slog.String("se_id", chosen.StorageElement.ID),
+		slog.String("policy", policy),
+		slog.Int64("size_bytes", req.SizeBytes),
+		slog.Int("candidates", len(candidates)),
+	)
+
+	return &PlacementResult{
+		Policy:      policy,
+		Candidates:  candidates,
+		Reservation: reservation,
+	}, nil
+}
+
+// Release освобождает резервирование после загрузки файла.
+// Возвращает ErrNotFound, если резервирование не найдено или истекло.
+func (s *PlacementService) Release(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.reservations[id]
+	if !ok || !r.ExpiresAt.After(time.Now().UTC()) {
+		delete(s.reservations, id)
+		return ErrNotFound
+	}
+	delete(s.reservations, id)
+	return nil
+}
+
+// reservedBytes удаляет истёкшие резервирования и возвращает
+// суммарный зарезервированный объём по SE. Вызывается под s.mu.
+func (s *PlacementService) reservedBytes(now time.Time) map[string]int64 {
+	reserved := make(map[string]int64)
+	for id, r := range s.reservations {
+		if !r.ExpiresAt.After(now) {
+			delete(s.reservations, id)
+			continue
+		}
+		reserved[r.StorageElementID] += r.SizeBytes
+	}
+	return reserved
+}
+
+// rank упорядочивает кандидатов согласно политике.
+func (s *PlacementService) rank(policy string, candidates []*PlacementCandidate) {
+	byFree := func(i, j int) bool { return candidates[i].FreeBytes > candidates[j].FreeBytes }
+
+	switch policy {
+	case PlacementPolicyRoundRobin:
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].StorageElement.ID < candidates[j].StorageElement.ID
+		})
+		offset := int(s.rrCounter.Add(1) % uint64(len(candidates))) //nolint:gosec // G115: остаток меньше len
+		rotated := make([]*PlacementCandidate, 0, len(candidates))
+		rotated = append(rotated, candidates[offset:]...)
+		copy(candidates, append(rotated, candidates[:offset]...))
+
+	case PlacementPolicyWeighted:
+		weightedShuffle(candidates)
+
+	case PlacementPolicyPreferLabels:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].LabelMatches != candidates[j].LabelMatches {
+				return candidates[i].LabelMatches > candidates[j].LabelMatches
+			}
+			return byFree(i, j)
+		})
+
+	default:
+		sort.SliceStable(candidates, byFree)
+	}
+}
+
+// weightedShuffle упорядочивает кандидатов случайной выборкой без возвращения:
+// вероятность оказаться выше пропорциональна свободному месту.
+func weightedShuffle(candidates []*PlacementCandidate) {
+	for i := range candidates {
+		var total int64
+		for _, c := range candidates[i:] {
+			total += max(c.FreeBytes, 1)
+		}
+		pick := rand.Int64N(total) //nolint:gosec // G404: криптостойкость не требуется
+		for j := i; j < len(candidates); j++ {
+			pick -= max(candidates[j].FreeBytes, 1)
+			if pick < 0 {
+				candidates[i], candidates[j] = candidates[j], candidates[i]
+				break
+			}
+		}
+	}
+}
+
+// placementMode возвращает режим SE для retention policy.
+func placementMode(retentionPolicy string) (string, error) {
+	switch retentionPolicy {
+	case "temporary":
+		return "edit", nil
+	case "permanent":
+		return "rw", nil
+	default:
+		return "", fmt.Errorf("%w: retention_policy должен быть temporary или permanent", ErrValidation)
+	}
+}
+
+// isPlacementPolicy проверяет, что политика размещения известна.
+func isPlacementPolicy(policy string) bool {
+	switch policy {
+	case PlacementPolicyMostFree, PlacementPolicyRoundRobin, PlacementPolicyWeighted, PlacementPolicyPreferLabels:
+		return true
+	}
+	return false
+}
+
+// labelMatches возвращает число запрошенных меток, совпадающих с метками SE.
+func labelMatches(seLabels, want map[string]string) int {
+	n := 0
+	for k, v := range want {
+		if got, ok := seLabels[k]; ok && got == v {
+			n++
+		}
+	}
+	return n
+}
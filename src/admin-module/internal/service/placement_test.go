@@ -0,0 +1,167 @@
+// placement_test.go — unit-тесты выбора SE для загрузки:
+// фильтрация кандидатов, политики размещения, резервирования.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// listSERepo возвращает SE с фильтрацией по mode и status.
+type listSERepo struct {
+	repository.StorageElementRepository
+	ses []*model.StorageElement
+}
+
+func (r *listSERepo) List(_ context.Context, f repository.StorageElementFilters, _, _ int) ([]*model.StorageElement, error) {
+	var out []*model.StorageElement
+	for _, se := range r.ses {
+		if (f.Mode == nil || se.Mode == *f.Mode) && (f.Status == nil || se.Status == *f.Status) &&
+			labelMatches(se.Labels, f.Labels) == len(f.Labels) {
+			out = append(out, se)
+		}
+	}
+	return out, nil
+}
+
+func newTestPlacement(policy string, ses ...*model.StorageElement) *PlacementService {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	return NewPlacementService(&listSERepo{ses: ses}, policy, time.Minute, logger)
+}
+
+func placementSE(id, mode, status string, free int64, labels map[string]string) *model.StorageElement {
+	return &model.StorageElement{ID: id, Name: id, URL: "http://" + id, Mode: mode, Status: status,
+		AvailableBytes: &free, Labels: labels}
+}
+
+// TestPlacementSelect проверяет фильтрацию кандидатов и учёт резервирований.
+func TestPlacementSelect(t *testing.T) {
+	s := newTestPlacement(PlacementPolicyMostFree,
+		placementSE("rw-big", "rw", "online", 1000, nil),
+		placementSE("rw-small", "rw", "online", 600, nil),
+		placementSE("rw-offline", "rw", "offline", 5000, nil),
+		placementSE("ro", "ro", "online", 5000, nil),
+		placementSE("edit", "edit", "online", 100, nil),
+	)
+	ctx := context.Background()
+
+	res, err := s.Select(ctx, PlacementRequest{RetentionPolicy: "permanent", SizeBytes: 500})
+	if err != nil {
+		t.Fatalf("Select() ошибка: %v", err)
+	}
+	if len(res.Candidates) != 2 || res.Candidates[0].StorageElement.ID != "rw-big" {
+		t.Fatalf("Candidates = %v, хотели [rw-big rw-small]", candidateIDs(res.Candidates))
+	}
+	if res.Reservation.StorageElementID != "rw-big" || res.Candidates[0].FreeBytes != 500 {
+		t.Errorf("Reservation = %+v, FreeBytes = %d", res.Reservation, res.Candidates[0].FreeBytes)
+	}
+
+	// Резервирование уменьшает свободное место: теперь больше у rw-small
+	res2, err := s.Select(ctx, PlacementRequest{RetentionPolicy: "permanent", SizeBytes: 550})
+	if err != nil {
+		t.Fatalf("Select() повторный ошибка: %v", err)
+	}
+	if ids := candidateIDs(res2.Candidates); len(ids) != 1 || ids[0] != "rw-small" {
+		t.Errorf("Candidates после резервирования = %v, хотели [rw-small]", ids)
+	}
+
+	// Освобождение возвращает место
+	if err := s.Release(res.Reservation.ID); err != nil {
+		t.Fatalf("Release() ошибка: %v", err)
+	}
+	if err := s.Release(res.Reservation.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Release() повторный = %v, хотели ErrNotFound", err)
+	}
+
+	if _, err := s.Select(ctx, PlacementRequest{RetentionPolicy: "temporary", SizeBytes: 500}); !errors.Is(err, ErrConflict) {
+		t.Errorf("Select(нет места) = %v, хотели ErrConflict", err)
+	}
+	if _, err := s.Select(ctx, PlacementRequest{RetentionPolicy: "forever"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Select(retention) = %v, хотели ErrValidation", err)
+	}
+	if _, err := s.Select(ctx, PlacementRequest{RetentionPolicy: "permanent", Policy: "random"}); !errors.Is(err, ErrValidation) {
+		t.Errorf("Select(policy) = %v, хотели ErrValidation", err)
+	}
+}
+
+// TestPlacementPolicies проверяет ранжирование кандидатов политиками.
+func TestPlacementPolicies(t *testing.T) {
+	ses := []*model.StorageElement{
+		placementSE("a", "rw", "online", 100, map[string]string{"zone": "dc1"}),
+		placementSE("b", "rw", "online", 300, map[string]string{"zone": "dc2", "tier": "ssd"}),
+		placementSE("c", "rw", "online", 200, map[string]string{"zone": "dc2"}),
+	}
+	ctx := context.Background()
+
+	s := newTestPlacement(PlacementPolicyPreferLabels, ses...)
+	res, err := s.Select(ctx, PlacementRequest{RetentionPolicy: "permanent", Labels: map[string]string{"zone": "dc1"}})
+	if err != nil {
+		t.Fatalf("Select() ошибка: %v", err)
+	}
+	if ids := candidateIDs(res.Candidates); ids[0] != "a" || ids[1] != "b" {
+		t.Errorf("prefer_labels = %v, хотели a, затем b", ids)
+	}
+
+	// require_labels: SE без обязательной метки не рассматриваются
+	res, err = s.Select(ctx, PlacementRequest{RetentionPolicy: "permanent", RequireLabels: map[string]string{"zone": "dc2"}})
+	if err != nil {
+		t.Fatalf("Select() ошибка: %v", err)
+	}
+	if ids := candidateIDs(res.Candidates); len(ids) != 2 || ids[0] != "b" {
+		t.Errorf("require_labels = %v, хотели [b c]", ids)
+	}
+
+	// Allow (grants SA): запрещённые SE не рассматриваются
+	allowA := func(se *model.StorageElement) bool { return se.ID == "a" }
+	res, err = s.Select(ctx, PlacementRequest{RetentionPolicy: "permanent", Allow: allowA})
+	if err != nil {
+		t.Fatalf("Select() ошибка: %v", err)
+	}
+	if ids := candidateIDs(res.Candidates); len(ids) != 1 || ids[0] != "a" {
+		t.Errorf("Allow = %v, хотели [a]", ids)
+	}
+	_, err = s.Select(ctx, PlacementRequest{RetentionPolicy: "permanent", Allow: allowA,
+		RequireLabels: map[string]string{"zone": "dc2"}})
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("Select(нет разрешённых SE) = %v, хотели ErrConflict", err)
+	}
+
+	// round_robin: каждый SE выбирается по очереди
+	s = newTestPlacement(PlacementPolicyRoundRobin, ses...)
+	seen := make(map[string]bool)
+	for range ses {
+		res, err := s.Select(ctx, PlacementRequest{RetentionPolicy: "permanent"})
+		if err != nil {
+			t.Fatalf("Select() ошибка: %v", err)
+		}
+		seen[res.Reservation.StorageElementID] = true
+	}
+	if len(seen) != len(ses) {
+		t.Errorf("round_robin выбрал %v, хотели все SE", seen)
+	}
+
+	// weighted: порядок — перестановка всех кандидатов
+	s = newTestPlacement(PlacementPolicyWeighted, ses...)
+	res, err = s.Select(ctx, PlacementRequest{RetentionPolicy: "permanent"})
+	if err != nil {
+		t.Fatalf("Select() ошибка: %v", err)
+	}
+	if len(res.Candidates) != len(ses) {
+		t.Errorf("weighted вернул %d кандидатов, хотели %d", len(res.Candidates), len(ses))
+	}
+}
+
+func candidateIDs(cs []*PlacementCandidate) []string {
+	ids := make([]string, 0, len(cs))
+	for _, c := range cs {
+		ids = append(ids, c.StorageElement.ID)
+	}
+	return ids
+}
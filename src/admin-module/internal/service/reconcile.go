@@ -0,0 +1,270 @@
+// reconcile.go — сверка Storage Elements, запускаемая из Admin Module.
+//
+// ReconcileService вызывает POST /api/v1/maintenance/reconcile на SE в фоне
+// (сверка на большом SE может длиться минуты) и сохраняет отчёт со сводкой
+// и списком проблем (se_reconcile_reports, se_reconcile_issues). Для SE
+// одновременно выполняется не более одной сверки; хранятся последние
+// reconcileHistoryPerSE отчётов.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
+)
+
+// reconcileTimeout — максимальная длительность сверки одного SE.
+const reconcileTimeout = 2 * time.Hour
+
+// reconcileHistoryPerSE — количество хранимых отчётов сверки на SE.
+const reconcileHistoryPerSE = 20
+
+// MaxReconcileIssues — максимум проблем, сохраняемых в одном отчёте.
+const MaxReconcileIssues = 1000
+
+// Ошибки сверки.
+const (
+	reconcileInProgressOnSE    = "сверка на SE уже выполняется (запущена SE или другим клиентом)"
+	reconcileCancelledShutdown = "прервано остановкой Admin Module"
+	reconcileInterruptedStart  = "прервано перезапуском Admin Module"
+)
+
+// ReconcileService — сервис сверки Storage Elements.
+type ReconcileService struct {
+	seClient   *seclient.Client
+	seRepo     repository.StorageElementRepository
+	reportRepo repository.ReconcileReportRepository
+	logger     *slog.Logger
+
+	// runCtx — родительский контекст сверок; отменяется в Stop
+	runCtx    context.Context
+	runCancel context.CancelFunc
+	runs      sync.WaitGroup
+
+	mu         sync.Mutex
+	activeBySE map[string]string // SE ID → ID отчёта выполняющейся сверки
+}
+
+// NewReconcileService создаёт сервис сверки SE.
+func NewReconcileService(
+	seClient *seclient.Client,
+	seRepo repository.StorageElementRepository,
+	reportRepo repository.ReconcileReportRepository,
+	logger *slog.Logger,
+) *ReconcileService {
+	runCtx, runCancel := context.WithCancel(context.Background())
+	return &ReconcileService{
+		seClient:   seClient,
+		seRepo:     seRepo,
+		reportRepo: reportRepo,
+		logger:     logger.With(slog.String("component", "reconcile")),
+		runCtx:     runCtx,
+		runCancel:  runCancel,
+		activeBySE: make(map[string]string),
+	}
+}
+
+// Start завершает сверки, прерванные перезапуском процесса.
+func (s *ReconcileService) Start(ctx context.Context) {
+	if n, err := s.reportRepo.FailUnfinished(ctx, reconcileInterruptedStart); err != nil {
+		s.logger.Warn("Ошибка завершения прерванных сверок", slog.String("error", err.Error()))
+	} else if n > 0 {
+		s.logger.Warn("Прерванные сверки помечены как failed", slog.Int("count", n))
+	}
+}
+
+// Stop отменяет выполняющиеся сверки и ждёт их завершения.
+func (s *ReconcileService) Stop() {
+	s.runCancel()
+	s.runs.Wait()
+}
+
+// StartRun запускает сверку SE в фоне и сразу возвращает отчёт в состоянии
+// running. Если для SE сверка уже выполняется, возвращается её отчёт вместе
+// с ErrConflict; для offline SE — ErrSEUnavailable.
+func (s *ReconcileService) StartRun(ctx context.Context, seID string) (*model.ReconcileReport, error) {
+	se, err := s.seRepo.GetByID(ctx, seID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение SE для сверки: %w", err)
+	}
+	if se.Status == "offline" {
+		return nil, fmt.Errorf("%w: SE %s offline", ErrSEUnavailable, se.Name)
+	}
+
+	report := &model.ReconcileReport{
+		ID:               uuid.New().String(),
+		StorageElementID: seID,
+		Status:           model.ReconcileRunning,
+		RequestedBy:      requestedBy(ctx),
+	}
+
+	// Резервируем SE до записи в БД, чтобы не запустить две сверки параллельно
+	s.mu.Lock()
+	if reportID, ok := s.activeBySE[seID]; ok {
+		s.mu.Unlock()
+		existing, err := s.Get(ctx, reportID)
+		if err != nil {
+			return nil, err
+		}
+		return existing, fmt.Errorf("%w: сверка SE уже выполняется", ErrConflict)
+	}
+	s.activeBySE[seID] = report.ID
+	s.mu.Unlock()
+
+	if err := s.reportRepo.Create(ctx, report); err != nil {
+		s.release(seID)
+		return nil, fmt.Errorf("создание отчёта сверки: %w", err)
+	}
+
+	s.logger.Info("Сверка SE запущена",
+		slog.String("report_id", report.ID),
+		slog.String("se_id", seID),
+	)
+
+	s.runs.Add(1)
+	go s.execute(*report, se.URL)
+
+	return report, nil
+}
+
+// Get возвращает отчёт сверки по ID.
+func (s *ReconcileService) Get(ctx context.Context, id string) (*model.ReconcileReport, error) {
+	report, err := s.reportRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение отчёта сверки: %w", err)
+	}
+	return report, nil
+}
+
+// ListReports возвращает последние отчёты сверки SE (новые первыми).
+func (s *ReconcileService) ListReports(ctx context.Context, seID string, limit int) ([]*model.ReconcileReport, error) {
+	reports, err := s.reportRepo.ListBySE(ctx, seID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("получение отчётов сверки: %w", err)
+	}
+	return reports, nil
+}
+
+// ListIssues возвращает проблемы отчёта (issueType — необязательный фильтр)
+// со сведениями о файле в реестре.
+func (s *ReconcileService) ListIssues(ctx context.Context, reportID string, issueType *string, limit int) ([]*model.ReconcileIssue, error) {
+	issues, err := s.reportRepo.ListIssues(ctx, reportID, issueType, limit)
+	if err != nil {
+		return nil, fmt.Errorf("получение проблем сверки: %w", err)
+	}
+	return issues, nil
+}
+
+// execute выполняет сверку на SE и сохраняет итог.
+func (s *ReconcileService) execute(report model.ReconcileReport, seURL string) {
+	defer s.runs.Done()
+	defer s.release(report.StorageElementID)
+
+	ctx, cancel := context.WithTimeout(s.runCtx, reconcileTimeout)
+	defer cancel()
+
+	result, err := s.seClient.Reconcile(ctx, seURL)
+	issues := applyReconcileResult(&report, result, reconcileError(s.runCtx, err))
+
+	// Сохранение итога не должно прерываться остановкой сервиса
+	saveCtx := context.WithoutCancel(ctx)
+	if err := s.reportRepo.Complete(saveCtx, &report, issues); err != nil {
+		s.logger.Warn("Ошибка сохранения отчёта сверки",
+			slog.String("report_id", report.ID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	if _, err := s.reportRepo.Prune(saveCtx, report.StorageElementID, reconcileHistoryPerSE); err != nil {
+		s.logger.Warn("Ошибка удаления старых отчётов сверки",
+			slog.String("se_id", report.StorageElementID),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	s.logger.Info("Сверка SE завершена",
+		slog.String("report_id", report.ID),
+		slog.String("se_id", report.StorageElementID),
+		slog.String("status", report.Status),
+		slog.Int("issues", report.IssuesTotal),
+	)
+}
+
+// reconcileError переводит ошибку запроса сверки в текст для отчёта.
+func reconcileError(runCtx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if runCtx.Err() != nil {
+		return errors.New(reconcileCancelledShutdown)
+	}
+	var se *seclient.StatusError
+	if errors.As(err, &se) && se.StatusCode == http.StatusConflict {
+		return errors.New(reconcileInProgressOnSE)
+	}
+	return err
+}
+
+// applyReconcileResult заполняет итог отчёта по ответу SE (или ошибке) и
+// возвращает проблемы для сохранения — не более MaxReconcileIssues.
+func applyReconcileResult(report *model.ReconcileReport, result *seclient.ReconcileResult, err error) []*model.ReconcileIssue {
+	completedAt := time.Now().UTC()
+	report.CompletedAt = &completedAt
+
+	if err != nil {
+		msg := err.Error()
+		report.Status = model.ReconcileFailed
+		report.Error = &msg
+		return nil
+	}
+
+	report.Status = model.ReconcileSucceeded
+	report.FilesChecked = result.FilesChecked
+	report.FilesOK = result.Summary.OK
+	report.OrphanedFiles = result.Summary.OrphanedFiles
+	report.MissingFiles = result.Summary.MissingFiles
+	report.ChecksumMismatches = result.Summary.ChecksumMismatches
+	report.SizeMismatches = result.Summary.SizeMismatches
+	report.IssuesTotal = len(result.Issues)
+
+	issues := make([]*model.ReconcileIssue, 0, min(len(result.Issues), MaxReconcileIssues))
+	for _, issue := range result.Issues[:min(len(result.Issues), MaxReconcileIssues)] {
+		fileID := issue.FileID
+		if fileID != nil {
+			if _, err := uuid.Parse(*fileID); err != nil {
+				fileID = nil
+			}
+		}
+		issues = append(issues, &model.ReconcileIssue{
+			ReportID:    report.ID,
+			Type:        issue.Type,
+			FileID:      fileID,
+			Path:        issue.Path,
+			Description: issue.Description,
+		})
+	}
+	return issues
+}
+
+// release освобождает SE для следующей сверки.
+func (s *ReconcileService) release(seID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.activeBySE, seID)
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
+)
+
+// TestApplyReconcileResult проверяет перенос ответа SE в отчёт сверки.
+func TestApplyReconcileResult(t *testing.T) {
+	fileID := uuid.New().String()
+	badID := "not-a-uuid"
+	path := "data/orphan.bin"
+
+	result := &seclient.ReconcileResult{
+		FilesChecked: 10,
+		Summary: seclient.ReconcileSummary{
+			OK: 7, OrphanedFiles: 1, MissingFiles: 1, ChecksumMismatches: 1,
+		},
+		Issues: []seclient.ReconcileIssue{
+			{FileID: &fileID, Type: model.ReconcileIssueMissingFile, Description: "нет файла"},
+			{Path: &path, Type: model.ReconcileIssueOrphanedFile, Description: "нет attr.json"},
+			{FileID: &badID, Type: model.ReconcileIssueChecksumMismatch, Description: "checksum"},
+		},
+	}
+
+	report := &model.ReconcileReport{ID: "r1", Status: model.ReconcileRunning}
+	issues := applyReconcileResult(report, result, nil)
+	if report.Status != model.ReconcileSucceeded || report.CompletedAt == nil {
+		t.Errorf("отчёт = %+v, ожидалось succeeded с CompletedAt", report)
+	}
+	if report.FilesChecked != 10 || report.FilesOK != 7 || report.IssuesTotal != 3 {
+		t.Errorf("сводка = %+v", report)
+	}
+	if len(issues) != 3 || issues[0].FileID == nil || issues[0].ReportID != "r1" {
+		t.Fatalf("проблемы = %+v", issues)
+	}
+	if issues[2].FileID != nil {
+		t.Errorf("некорректный file_id должен сохраняться как nil, получен %q", *issues[2].FileID)
+	}
+
+	// Проблемы сверх лимита не сохраняются, но учитываются в IssuesTotal
+	many := &seclient.ReconcileResult{Issues: make([]seclient.ReconcileIssue, MaxReconcileIssues+5)}
+	report = &model.ReconcileReport{}
+	if issues := applyReconcileResult(report, many, nil); len(issues) != MaxReconcileIssues ||
+		report.IssuesTotal != MaxReconcileIssues+5 {
+		t.Errorf("сохранено %d проблем из %d", len(issues), report.IssuesTotal)
+	}
+
+	report = &model.ReconcileReport{}
+	if issues := applyReconcileResult(report, nil, errors.New("SE недоступен")); issues != nil ||
+		report.Status != model.ReconcileFailed || report.Error == nil {
+		t.Errorf("при ошибке отчёт = %+v, проблемы = %v", report, issues)
+	}
+}
+
+// TestReconcileError проверяет тексты ошибок сверки.
+func TestReconcileError(t *testing.T) {
+	busy := fmt.Errorf("запрос: %w", &seclient.StatusError{Op: "Reconcile", StatusCode: http.StatusConflict})
+	stopped, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name   string
+		runCtx context.Context
+		err    error
+		want   string
+	}{
+		{"нет ошибки", context.Background(), nil, ""},
+		{"сверка на SE уже идёт", context.Background(), busy, reconcileInProgressOnSE},
+		{"остановка Admin Module", stopped, context.Canceled, reconcileCancelledShutdown},
+		{"прочая ошибка", context.Background(), errors.New("boom"), "boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reconcileError(tt.runCtx, tt.err)
+			if (got == nil) != (tt.want == "") || (got != nil && got.Error() != tt.want) {
+				t.Errorf("reconcileError() = %v, ожидалось %q", got, tt.want)
+			}
+		})
+	}
+}
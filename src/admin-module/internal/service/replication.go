@@ -0,0 +1,544 @@
+// replication.go — сервис репликации permanent-файлов между Storage Elements.
+//
+// ReplicationService периодически (AM_REPLICATION_INTERVAL) и после регистрации
+// нового файла ищет активные permanent-файлы, у которых число копий
+// (основная на file_registry.storage_element_id + synced-реплики) меньше
+// AM_REPLICATION_FACTOR, и копирует их на SE в режиме rw:
+//  1. GET /api/v1/files/{id} и /download на SE-источнике
+//  2. Сборка tar-архива в формате экспорта SE ({id}.attr.json + {id}) на лету
+//  3. POST /api/v1/maintenance/import на целевой SE (file_id сохраняется)
+//  4. Запись результата в file_replicas
+//
+// Источник — основной SE, если он online, иначе любая synced-реплика.
+// При заданной AM_REPLICATION_SPREAD_LABEL (например, zone) предпочтение
+// отдаётся SE со значением метки, которого ещё нет среди копий файла.
+// Потерянные реплики обнаруживает StorageSyncService (DeleteMissing),
+// после чего файл снова попадает в выборку и реплицируется заново.
+//
+// Повторы выполняются с экспоненциальной задержкой (AM_REPLICATION_INTERVAL,
+// 2×, 4×, ..., не более seWriteMaxDelay): неудачная копия получает
+// file_replicas.next_attempt_at, а пропущенный файл (нет источника или
+// целевых SE) — запись в file_replication_deferrals. До наступления срока
+// файл не попадает в выборку и не вытесняет новые файлы.
+//
+// Prometheus-метрики:
+//   - admin_module_replication_files_total — результаты копирования (synced, failed)
+//   - admin_module_replication_bytes_total — объём скопированных данных
+package service
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
+)
+
+// Политики выбора целевых SE для реплик.
+const (
+	// ReplicationPolicyMostFree — SE с наибольшим свободным местом
+	ReplicationPolicyMostFree = "most_free"
+	// ReplicationPolicyRoundRobin — SE по очереди
+	ReplicationPolicyRoundRobin = "round_robin"
+)
+
+// replicationBatchSize — максимальное число файлов, обрабатываемых за один проход.
+const replicationBatchSize = 100
+
+// Prometheus-метрики репликации.
+var (
+	replicationFilesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "admin_module_replication_files_total",
+		Help: "Количество попыток копирования файлов на SE-реплики",
+	}, []string{"result"}) // result: synced, failed
+
+	replicationBytesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "admin_module_replication_bytes_total",
+		Help: "Объём данных, скопированных на SE-реплики",
+	})
+)
+
+// ReplicationResult — итог одного прохода репликации.
+type ReplicationResult struct {
+	// Checked — количество файлов без достаточного числа копий
+	Checked int
+	// Replicated — количество успешно созданных реплик
+	Replicated int
+	// Failed — количество неудачных попыток
+	Failed int
+	// Skipped — файлы без доступного источника или целевых SE
+	Skipped int
+}
+
+// ReplicationService — фоновый сервис репликации файлов.
+type ReplicationService struct {
+	seClient    *seclient.Client
+	seRepo      repository.StorageElementRepository
+	replicaRepo repository.FileReplicaRepository
+	factor      int
+	policy      string
+	spreadLabel string
+	interval    time.Duration
+	logger      *slog.Logger
+
+	// rrCounter — смещение для политики round_robin
+	rrCounter atomic.Uint64
+
+	trigger chan struct{}
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewReplicationService создаёт сервис репликации.
+// factor — требуемое число копий файла (1 — репликация отключена).
+// spreadLabel — ключ метки SE, по которому распределяются копии (пустой — не учитывается).
+func NewReplicationService(
+	seClient *seclient.Client,
+	seRepo repository.StorageElementRepository,
+	replicaRepo repository.FileReplicaRepository,
+	factor int,
+	policy string,
+	spreadLabel string,
+	interval time.Duration,
+	logger *slog.Logger,
+) *ReplicationService {
+	return &ReplicationService{
+		seClient:    seClient,
+		seRepo:      seRepo,
+		replicaRepo: replicaRepo,
+		factor:      factor,
+		policy:      policy,
+		spreadLabel: spreadLabel,
+		interval:    interval,
+		logger:      logger.With(slog.String("component", "replication")),
+		trigger:     make(chan struct{}, 1),
+	}
+}
+
+// Enabled возвращает true, если требуется больше одной копии файла.
+func (s *ReplicationService) Enabled() bool {
+	return s.factor > 1
+}
+
+// Start запускает фоновую горутину репликации.
+// При factor <= 1 горутина не запускается.
+func (s *ReplicationService) Start(ctx context.Context) {
+	if !s.Enabled() {
+		s.logger.Info("Репликация файлов отключена (AM_REPLICATION_FACTOR=1)")
+		return
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		s.logger.Info("Репликация файлов запущена",
+			slog.Int("factor", s.factor),
+			slog.String("policy", s.policy),
+			slog.String("interval", s.interval.String()),
+		)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Репликация файлов остановлена")
+				return
+			case <-ticker.C:
+			case <-s.trigger:
+			}
+
+			result, err := s.RunOnce(ctx)
+			if err != nil {
+				s.logger.Error("Ошибка репликации", slog.String("error", err.Error()))
+				continue
+			}
+			if result.Checked > 0 {
+				s.logger.Info("Проход репликации завершён",
+					slog.Int("checked", result.Checked),
+					slog.Int("replicated", result.Replicated),
+					slog.Int("failed", result.Failed),
+					slog.Int("skipped", result.Skipped),
+				)
+			}
+		}
+	}()
+}
+
+// Stop останавливает фоновую горутину и ждёт завершения.
+func (s *ReplicationService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+// Trigger запрашивает внеочередной проход репликации.
+// Не блокирует: повторные вызовы до начала прохода объединяются.
+func (s *ReplicationService) Trigger() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// RunOnce выполняет один проход репликации.
+func (s *ReplicationService) RunOnce(ctx context.Context) (*ReplicationResult, error) {
+	result := &ReplicationResult{}
+	if !s.Enabled() {
+		return result, nil
+	}
+
+	files, err := s.replicaRepo.ListUnderReplicated(ctx, s.factor, replicationBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("поиск файлов для репликации: %w", err)
+	}
+	result.Checked = len(files)
+	if len(files) == 0 {
+		return result, nil
+	}
+
+	ses, err := s.seRepo.List(ctx, repository.StorageElementFilters{}, 1000, 0)
+	if err != nil {
+		return nil, fmt.Errorf("получение списка SE для репликации: %w", err)
+	}
+	seByID := make(map[string]*model.StorageElement, len(ses))
+	for _, se := range ses {
+		seByID[se.ID] = se
+	}
+
+	ids := make([]string, 0, len(files))
+	for _, f := range files {
+		ids = append(ids, f.FileID)
+	}
+	replicas, err := s.replicaRepo.ListByFiles(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("получение реплик файлов: %w", err)
+	}
+	replicasByFile := make(map[string][]*model.FileReplica, len(files))
+	for _, rep := range replicas {
+		replicasByFile[rep.FileID] = append(replicasByFile[rep.FileID], rep)
+	}
+	deferrals, err := s.replicaRepo.ListDeferrals(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("получение отложенных репликаций: %w", err)
+	}
+	deferralByFile := make(map[string]*model.ReplicationDeferral, len(deferrals))
+	for _, d := range deferrals {
+		deferralByFile[d.FileID] = d
+	}
+
+	for _, f := range files {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		s.replicateFile(ctx, f, replicasByFile[f.FileID], deferralByFile[f.FileID], ses, seByID, result)
+	}
+
+	return result, nil
+}
+
+// replicateFile досоздаёт недостающие копии одного файла.
+// deferral — отложенная репликация файла с прошлых проходов (nil — нет).
+func (s *ReplicationService) replicateFile(
+	ctx context.Context,
+	f *model.FileRecord,
+	replicas []*model.FileReplica,
+	deferral *model.ReplicationDeferral,
+	ses []*model.StorageElement,
+	seByID map[string]*model.StorageElement,
+	result *ReplicationResult,
+) {
+	// SE, на которых копия уже есть, и SE-источник
+	holders := map[string]bool{f.StorageElementID: true}
+	attempts := make(map[string]int, len(replicas))
+	var source *model.StorageElement
+	if se := seByID[f.StorageElementID]; se != nil && isReplicaSource(se) {
+		source = se
+	}
+	for _, rep := range replicas {
+		attempts[rep.StorageElementID] = rep.Attempts
+		if rep.Status != model.ReplicaStatusSynced {
+			continue
+		}
+		holders[rep.StorageElementID] = true
+		if se := seByID[rep.StorageElementID]; source == nil && se != nil && isReplicaSource(se) {
+			source = se
+		}
+	}
+
+	need := s.factor - len(holders)
+	if need <= 0 {
+		return
+	}
+	if source == nil {
+		s.logger.Warn("Репликация: нет доступного источника файла",
+			slog.String("file_id", f.FileID),
+		)
+		result.Skipped++
+		s.deferFile(ctx, f.FileID, deferral, model.ReplicationDeferNoSource)
+		return
+	}
+
+	targets := s.chooseTargets(ses, holders, need)
+	if len(targets) == 0 {
+		s.logger.Warn("Репликация: нет подходящих SE для реплики",
+			slog.String("file_id", f.FileID),
+			slog.Int("need", need),
+		)
+		result.Skipped++
+		s.deferFile(ctx, f.FileID, deferral, model.ReplicationDeferNoTargets)
+		return
+	}
+	if deferral != nil {
+		if err := s.replicaRepo.ClearDeferral(ctx, f.FileID); err != nil {
+			s.logger.Error("Ошибка удаления отложенной репликации",
+				slog.String("file_id", f.FileID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	for _, target := range targets {
+		rep := &model.FileReplica{
+			FileID:           f.FileID,
+			StorageElementID: target.ID,
+		}
+		if err := s.copyFile(ctx, f.FileID, source, target); err != nil {
+			msg := err.Error()
+			rep.Status = model.ReplicaStatusFailed
+			rep.LastError = &msg
+			rep.Attempts = attempts[target.ID] + 1
+			next := time.Now().UTC().Add(retryDelay(s.interval, rep.Attempts))
+			rep.NextAttemptAt = &next
+			result.Failed++
+			replicationFilesTotal.WithLabelValues("failed").Inc()
+			s.logger.Warn("Ошибка репликации файла",
+				slog.String("file_id", f.FileID),
+				slog.String("source_se", source.ID),
+				slog.String("target_se", target.ID),
+				slog.Int("attempts", rep.Attempts),
+				slog.Time("next_attempt_at", next),
+				slog.String("error", msg),
+			)
+		} else {
+			now := time.Now().UTC()
+			rep.Status = model.ReplicaStatusSynced
+			rep.ReplicatedAt = &now
+			result.Replicated++
+			replicationFilesTotal.WithLabelValues("synced").Inc()
+			replicationBytesTotal.Add(float64(f.Size))
+			s.logger.Info("Файл реплицирован",
+				slog.String("file_id", f.FileID),
+				slog.String("source_se", source.ID),
+				slog.String("target_se", target.ID),
+			)
+		}
+
+		if err := s.replicaRepo.Upsert(ctx, rep); err != nil {
+			s.logger.Error("Ошибка сохранения состояния реплики",
+				slog.String("file_id", f.FileID),
+				slog.String("target_se", target.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// deferFile откладывает репликацию пропущенного файла: попытка номер
+// attempts+1 выполняется не раньше чем через retryDelay.
+func (s *ReplicationService) deferFile(ctx context.Context, fileID string, prev *model.ReplicationDeferral, reason string) {
+	d := &model.ReplicationDeferral{FileID: fileID, Reason: reason, Attempts: 1}
+	if prev != nil {
+		d.Attempts = prev.Attempts + 1
+	}
+	d.NextAttemptAt = time.Now().UTC().Add(retryDelay(s.interval, d.Attempts))
+
+	if err := s.replicaRepo.Defer(ctx, d); err != nil {
+		s.logger.Error("Ошибка сохранения отложенной репликации",
+			slog.String("file_id", fileID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// copyFile копирует файл с SE-источника на целевой SE через tar-импорт.
+// Содержимое передаётся потоком, без буферизации на диске Admin Module.
+func (s *ReplicationService) copyFile(ctx context.Context, fileID string, source, target *model.StorageElement) error {
+	meta, err := s.seClient.GetFile(ctx, source.URL, fileID)
+	if err != nil {
+		return err
+	}
+
+	content, err := s.seClient.Download(ctx, source.URL, fileID)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	pr, pw := io.Pipe()
+	writeErr := make(chan error, 1)
+	go func() {
+		err := writeReplicaArchive(pw, meta, content)
+		pw.CloseWithError(err)
+		writeErr <- err
+	}()
+
+	res, err := s.seClient.Import(ctx, target.URL, pr)
+	// Разблокируем writer, если SE прервал чтение архива
+	pr.CloseWithError(errors.New("импорт завершён"))
+	archiveErr := <-writeErr
+	if err != nil {
+		return err
+	}
+	if archiveErr != nil {
+		return fmt.Errorf("формирование архива: %w", archiveErr)
+	}
+
+	// already_exists — файл с этим file_id уже есть на SE (например, после
+	// потери записи в file_replicas), копия считается подтверждённой.
+	if res.Imported == 1 || res.Skipped == 1 {
+		return nil
+	}
+	if len(res.Issues) > 0 {
+		return fmt.Errorf("SE %s отклонил импорт (%s): %s", target.ID, res.Issues[0].Reason, res.Issues[0].Description)
+	}
+	return fmt.Errorf("SE %s не импортировал файл", target.ID)
+}
+
+// chooseTargets выбирает до n целевых SE для реплик согласно политике.
+// Кандидаты — SE со статусом online в режиме rw, не хранящие копию файла.
+// При заданной spreadLabel вперёд выносятся SE с новым значением метки.
+func (s *ReplicationService) chooseTargets(
+	ses []*model.StorageElement,
+	exclude map[string]bool,
+	n int,
+) []*model.StorageElement {
+	candidates := make([]*model.StorageElement, 0, len(ses))
+	for _, se := range ses {
+		if exclude[se.ID] || se.Status != "online" || se.Mode != "rw" {
+			continue
+		}
+		candidates = append(candidates, se)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	switch s.policy {
+	case ReplicationPolicyRoundRobin:
+		sort.Slice(candidates, func(i, j int) bool { return candidates[i].ID < candidates[j].ID })
+		offset := int(s.rrCounter.Add(1) % uint64(len(candidates))) //nolint:gosec // G115: остаток меньше len
+		candidates = append(candidates[offset:], candidates[:offset]...)
+	default:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return availableBytes(candidates[i]) > availableBytes(candidates[j])
+		})
+	}
+
+	if s.spreadLabel != "" {
+		candidates = spreadByLabel(candidates, ses, exclude, s.spreadLabel)
+	}
+
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	return candidates
+}
+
+// spreadByLabel переупорядочивает кандидатов: сначала SE, значение метки key
+// которых не встречается среди SE с копией файла (holders) и выбранных ранее,
+// затем остальные в исходном порядке. SE без метки не получают приоритета.
+func spreadByLabel(
+	candidates, ses []*model.StorageElement,
+	holders map[string]bool,
+	key string,
+) []*model.StorageElement {
+	used := make(map[string]bool)
+	for _, se := range ses {
+		if holders[se.ID] {
+			used[se.Labels[key]] = true
+		}
+	}
+
+	preferred := make([]*model.StorageElement, 0, len(candidates))
+	rest := make([]*model.StorageElement, 0, len(candidates))
+	for _, se := range candidates {
+		v := se.Labels[key]
+		if v != "" && !used[v] {
+			used[v] = true
+			preferred = append(preferred, se)
+			continue
+		}
+		rest = append(rest, se)
+	}
+	return append(preferred, rest...)
+}
+
+// isReplicaSource проверяет, можно ли скачать файл с SE.
+// В режиме ar содержимое требует восстановления из холодного архива.
+func isReplicaSource(se *model.StorageElement) bool {
+	return se.Status == "online" && se.Mode != "ar"
+}
+
+// availableBytes возвращает свободное место SE.
+func availableBytes(se *model.StorageElement) int64 {
+	if se.AvailableBytes != nil {
+		return *se.AvailableBytes
+	}
+	return se.CapacityBytes - se.UsedBytes
+}
+
+// writeReplicaArchive пишет tar-архив в формате экспорта SE:
+// запись {file_id}.attr.json с метаданными, затем {file_id} с содержимым.
+func writeReplicaArchive(w io.Writer, meta *seclient.SEFileMetadata, content io.Reader) error {
+	attrData, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("сериализация attr.json: %w", err)
+	}
+
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    meta.FileID + ".attr.json",
+		Mode:    0o644,
+		Size:    int64(len(attrData)),
+		ModTime: time.Now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("запись заголовка attr.json: %w", err)
+	}
+	if _, err := tw.Write(attrData); err != nil {
+		return fmt.Errorf("запись attr.json: %w", err)
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    meta.FileID,
+		Mode:    0o644,
+		Size:    meta.Size,
+		ModTime: time.Now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("запись заголовка файла: %w", err)
+	}
+	if _, err := io.Copy(tw, content); err != nil {
+		return fmt.Errorf("передача содержимого файла: %w", err)
+	}
+
+	return tw.Close()
+}
@@ -0,0 +1,254 @@
+// replication_test.go — unit-тесты выбора целевых SE и копирования файла через tar-импорт.
+package service
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
+)
+
+func testReplicationService(t *testing.T, policy string) *ReplicationService {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	client, err := seclient.New("", 5*time.Second, nil, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewReplicationService(client, nil, nil, 2, policy, "", time.Minute, logger)
+}
+
+func int64Ptr(v int64) *int64 { return &v }
+
+// TestChooseTargets проверяет фильтрацию кандидатов и политики выбора.
+func TestChooseTargets(t *testing.T) {
+	ses := []*model.StorageElement{
+		{ID: "a", Mode: "rw", Status: "online", AvailableBytes: int64Ptr(100)},
+		{ID: "b", Mode: "rw", Status: "online", AvailableBytes: int64Ptr(300)},
+		{ID: "c", Mode: "rw", Status: "online", AvailableBytes: int64Ptr(200)},
+		{ID: "d", Mode: "ro", Status: "online", AvailableBytes: int64Ptr(900)},
+		{ID: "e", Mode: "rw", Status: "offline", AvailableBytes: int64Ptr(900)},
+	}
+
+	s := testReplicationService(t, ReplicationPolicyMostFree)
+	got := s.chooseTargets(ses, map[string]bool{"b": true}, 2)
+	if len(got) != 2 || got[0].ID != "c" || got[1].ID != "a" {
+		t.Errorf("most_free: получено %v, ожидалось [c a]", ids(got))
+	}
+
+	s = testReplicationService(t, ReplicationPolicyRoundRobin)
+	first := s.chooseTargets(ses, nil, 1)
+	second := s.chooseTargets(ses, nil, 1)
+	if len(first) != 1 || len(second) != 1 || first[0].ID == second[0].ID {
+		t.Errorf("round_robin: ожидались разные SE, получено %v и %v", ids(first), ids(second))
+	}
+
+	if got := s.chooseTargets(ses, map[string]bool{"a": true, "b": true, "c": true}, 1); len(got) != 0 {
+		t.Errorf("ожидалось отсутствие кандидатов, получено %v", ids(got))
+	}
+}
+
+// TestChooseTargets_SpreadLabel проверяет распределение копий по значениям метки.
+func TestChooseTargets_SpreadLabel(t *testing.T) {
+	ses := []*model.StorageElement{
+		{ID: "a", Mode: "rw", Status: "online", AvailableBytes: int64Ptr(100), Labels: map[string]string{"zone": "z1"}},
+		{ID: "b", Mode: "rw", Status: "online", AvailableBytes: int64Ptr(400), Labels: map[string]string{"zone": "z1"}},
+		{ID: "c", Mode: "rw", Status: "online", AvailableBytes: int64Ptr(300), Labels: map[string]string{"zone": "z2"}},
+		{ID: "d", Mode: "rw", Status: "online", AvailableBytes: int64Ptr(200), Labels: map[string]string{"zone": "z3"}},
+		{ID: "e", Mode: "rw", Status: "online", AvailableBytes: int64Ptr(900)},
+	}
+
+	s := testReplicationService(t, ReplicationPolicyMostFree)
+	s.spreadLabel = "zone"
+
+	// Копия уже в z1 (a): сначала z2 и z3, затем остальные по свободному месту
+	got := s.chooseTargets(ses, map[string]bool{"a": true}, 3)
+	if len(got) != 3 || got[0].ID != "c" || got[1].ID != "d" || got[2].ID != "e" {
+		t.Errorf("spread: получено %v, ожидалось [c d e]", ids(got))
+	}
+
+	// Без копий: по одному SE из каждой зоны, SE без метки — последним
+	got = s.chooseTargets(ses, nil, 4)
+	if len(got) != 4 || got[0].ID != "b" || got[1].ID != "c" || got[2].ID != "d" || got[3].ID != "e" {
+		t.Errorf("spread без копий: получено %v, ожидалось [b c d e]", ids(got))
+	}
+}
+
+// TestCopyFile проверяет передачу файла с SE-источника на целевой SE в формате экспорта.
+func TestCopyFile(t *testing.T) {
+	const fileID = "8f4e1c2a-3b5d-4e6f-9a7b-1c2d3e4f5a6b"
+	content := "replicated content"
+
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/files/" + fileID:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(seclient.SEFileMetadata{
+				FileID: fileID, OriginalFilename: "a.txt", Size: int64(len(content)),
+				Checksum: "abc", Status: "active", RetentionPolicy: "permanent",
+				UploadedAt: "2026-01-01T00:00:00Z",
+			})
+		case "/api/v1/files/" + fileID + "/download":
+			_, _ = io.WriteString(w, content)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer source.Close()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tr := tar.NewReader(r.Body)
+
+		hdr, err := tr.Next()
+		if err != nil || hdr.Name != fileID+".attr.json" {
+			t.Errorf("первая запись архива: %v, %v", hdr, err)
+			return
+		}
+		var meta seclient.SEFileMetadata
+		if err := json.NewDecoder(tr).Decode(&meta); err != nil || meta.FileID != fileID {
+			t.Errorf("attr.json: %+v, %v", meta, err)
+		}
+
+		hdr, err = tr.Next()
+		if err != nil || hdr.Name != fileID {
+			t.Errorf("вторая запись архива: %v, %v", hdr, err)
+			return
+		}
+		data, _ := io.ReadAll(tr)
+		if string(data) != content {
+			t.Errorf("содержимое = %q", data)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"imported":1,"skipped":0,"failed":0,"issues":[]}`)
+	}))
+	defer target.Close()
+
+	s := testReplicationService(t, ReplicationPolicyMostFree)
+	err := s.copyFile(context.Background(), fileID,
+		&model.StorageElement{ID: "src", URL: source.URL},
+		&model.StorageElement{ID: "dst", URL: target.URL},
+	)
+	if err != nil {
+		t.Fatalf("copyFile() ошибка: %v", err)
+	}
+}
+
+// TestCopyFile_Rejected проверяет ошибку при отказе целевого SE.
+func TestCopyFile_Rejected(t *testing.T) {
+	const fileID = "8f4e1c2a-3b5d-4e6f-9a7b-1c2d3e4f5a6b"
+
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/files/"+fileID {
+			_ = json.NewEncoder(w).Encode(seclient.SEFileMetadata{FileID: fileID, Size: 1, Status: "active"})
+			return
+		}
+		_, _ = io.WriteString(w, "x")
+	}))
+	defer source.Close()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_, _ = io.WriteString(w, `{"imported":0,"skipped":0,"failed":1,"issues":[{"file_id":null,"reason":"storage_full","description":"нет места"}]}`)
+	}))
+	defer target.Close()
+
+	s := testReplicationService(t, ReplicationPolicyMostFree)
+	err := s.copyFile(context.Background(), fileID,
+		&model.StorageElement{ID: "src", URL: source.URL},
+		&model.StorageElement{ID: "dst", URL: target.URL},
+	)
+	if err == nil {
+		t.Fatal("ожидалась ошибка при отказе импорта")
+	}
+}
+
+// fakeReplicaRepo — репозиторий реплик в памяти (только запись результатов).
+type fakeReplicaRepo struct {
+	repository.FileReplicaRepository
+	upserted []*model.FileReplica
+	deferred map[string]*model.ReplicationDeferral
+	cleared  []string
+}
+
+func (f *fakeReplicaRepo) Upsert(_ context.Context, r *model.FileReplica) error {
+	f.upserted = append(f.upserted, r)
+	return nil
+}
+
+func (f *fakeReplicaRepo) Defer(_ context.Context, d *model.ReplicationDeferral) error {
+	f.deferred[d.FileID] = d
+	return nil
+}
+
+func (f *fakeReplicaRepo) ClearDeferral(_ context.Context, fileID string) error {
+	f.cleared = append(f.cleared, fileID)
+	return nil
+}
+
+// TestReplicateFile_Backoff проверяет экспоненциальную задержку повторов:
+// пропущенный файл откладывается, неудачная копия получает next_attempt_at.
+func TestReplicateFile_Backoff(t *testing.T) {
+	repo := &fakeReplicaRepo{deferred: make(map[string]*model.ReplicationDeferral)}
+	s := testReplicationService(t, ReplicationPolicyMostFree)
+	s.replicaRepo = repo
+
+	primary := &model.StorageElement{ID: "a", Mode: "rw", Status: "online"}
+	f := &model.FileRecord{FileID: "f1", StorageElementID: "a"}
+	seByID := map[string]*model.StorageElement{"a": primary}
+
+	// Нет целевых SE — третий пропуск подряд откладывается на 4×interval
+	prev := &model.ReplicationDeferral{FileID: "f1", Attempts: 2}
+	result := &ReplicationResult{}
+	before := time.Now().UTC()
+	s.replicateFile(context.Background(), f, nil, prev, []*model.StorageElement{primary}, seByID, result)
+
+	d := repo.deferred["f1"]
+	if result.Skipped != 1 || d == nil {
+		t.Fatalf("ожидалась отложенная репликация, skipped=%d", result.Skipped)
+	}
+	if d.Attempts != 3 || d.Reason != model.ReplicationDeferNoTargets {
+		t.Errorf("deferral = %+v, хотели attempts=3, reason=no_targets", d)
+	}
+	if wait := d.NextAttemptAt.Sub(before); wait < 4*time.Minute || wait > 5*time.Minute {
+		t.Errorf("задержка = %s, хотели 4m", wait)
+	}
+
+	// Целевой SE появился, но недоступен: отсрочка снимается,
+	// а вторая неудачная попытка откладывается на 2×interval
+	target := &model.StorageElement{ID: "b", Mode: "rw", Status: "online", URL: "http://127.0.0.1:1"}
+	replicas := []*model.FileReplica{{FileID: "f1", StorageElementID: "b", Status: model.ReplicaStatusFailed, Attempts: 1}}
+	before = time.Now().UTC()
+	s.replicateFile(context.Background(), f, replicas, d, []*model.StorageElement{primary, target}, seByID, &ReplicationResult{})
+	if len(repo.cleared) != 1 || repo.cleared[0] != "f1" {
+		t.Errorf("ClearDeferral не вызван: %v", repo.cleared)
+	}
+	if len(repo.upserted) != 1 {
+		t.Fatalf("Upsert вызван %d раз, хотели 1", len(repo.upserted))
+	}
+	rep := repo.upserted[0]
+	if rep.Status != model.ReplicaStatusFailed || rep.Attempts != 2 || rep.NextAttemptAt == nil {
+		t.Fatalf("реплика = %+v, хотели failed, attempts=2", rep)
+	}
+	if wait := rep.NextAttemptAt.Sub(before); wait < 2*time.Minute || wait > 3*time.Minute {
+		t.Errorf("задержка реплики = %s, хотели 2m", wait)
+	}
+}
+
+func ids(ses []*model.StorageElement) []string {
+	result := make([]string, 0, len(ses))
+	for _, se := range ses {
+		result = append(result, se.ID)
+	}
+	return result
+}
@@ -0,0 +1,398 @@
+// roles.go — каталог ролей Admin Module: встроенные admin и readonly
+// (группы из AM_ROLE_ADMIN_GROUPS / AM_ROLE_READONLY_GROUPS) и
+// пользовательские роли из таблицы roles.
+//
+// Каталог хранится в памяти и перечитывается после каждого изменения и
+// раз в roleCatalogReloadInterval (изменения, сделанные другими репликами).
+// Пользователь не может выдать больше прав, чем имеет сам: изменять можно
+// только роли, все разрешения которых (до и после изменения) есть у него.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+const (
+	// roleCatalogReloadInterval — интервал перечитывания каталога ролей из БД.
+	roleCatalogReloadInterval = 30 * time.Second
+	// roleDescriptionMaxLength — максимальная длина описания роли.
+	roleDescriptionMaxLength = 500
+)
+
+// roleNamePattern — допустимое имя роли (slug).
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{1,49}$`)
+
+// RoleInput — параметры пользовательской роли.
+type RoleInput struct {
+	Name        string
+	Description string
+	Permissions []string
+	Groups      []string
+}
+
+// RoleService — сервис каталога ролей. Реализует rbac.CatalogProvider.
+type RoleService struct {
+	repo    repository.RoleRepository
+	audit   *AuditService
+	builtin []rbac.Role
+	catalog atomic.Pointer[rbac.Catalog]
+	logger  *slog.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewRoleService создаёт сервис ролей. До первой загрузки из БД
+// каталог содержит только встроенные роли.
+func NewRoleService(
+	repo repository.RoleRepository,
+	audit *AuditService,
+	adminGroups, readonlyGroups []string,
+	logger *slog.Logger,
+) *RoleService {
+	s := &RoleService{
+		repo:    repo,
+		audit:   audit,
+		builtin: rbac.BuiltinRoles(adminGroups, readonlyGroups),
+		logger:  logger.With(slog.String("component", "roles")),
+	}
+	s.catalog.Store(rbac.NewCatalog(s.builtin...))
+	return s
+}
+
+// Catalog возвращает текущий каталог ролей.
+func (s *RoleService) Catalog() *rbac.Catalog {
+	return s.catalog.Load()
+}
+
+// Reload перечитывает пользовательские роли из БД.
+func (s *RoleService) Reload(ctx context.Context) error {
+	stored, err := s.repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("загрузка ролей: %w", err)
+	}
+
+	roles := slices.Clone(s.builtin)
+	for _, r := range stored {
+		roles = append(roles, roleFromModel(r))
+	}
+	s.catalog.Store(rbac.NewCatalog(roles...))
+	return nil
+}
+
+// Start загружает каталог и запускает его периодическое перечитывание.
+func (s *RoleService) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(roleCatalogReloadInterval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.Reload(ctx); err != nil && ctx.Err() == nil {
+				s.logger.Error("Ошибка загрузки каталога ролей",
+					slog.String("error", err.Error()),
+				)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop останавливает перечитывание каталога и ждёт завершения.
+func (s *RoleService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+// List возвращает все роли (встроенные первыми), перечитав каталог из БД.
+func (s *RoleService) List(ctx context.Context) ([]rbac.Role, error) {
+	if err := s.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return s.Catalog().Roles(), nil
+}
+
+// Get возвращает роль по имени.
+func (s *RoleService) Get(ctx context.Context, name string) (rbac.Role, error) {
+	if err := s.Reload(ctx); err != nil {
+		return rbac.Role{}, err
+	}
+	role, ok := s.Catalog().Role(name)
+	if !ok {
+		return rbac.Role{}, ErrNotFound
+	}
+	return role, nil
+}
+
+// Create создаёт пользовательскую роль и записывает событие аудита.
+func (s *RoleService) Create(ctx context.Context, actor *middleware.AuthClaims, in RoleInput) (rbac.Role, error) {
+	role, err := validateRoleInput(in)
+	if err != nil {
+		return rbac.Role{}, err
+	}
+	if rbac.IsBuiltinRole(role.Name) {
+		return rbac.Role{}, fmt.Errorf("%w: имя %q занято встроенной ролью", ErrConflict, role.Name)
+	}
+	if err := checkRoleEscalation(actor, role.PermissionSet()); err != nil {
+		return rbac.Role{}, err
+	}
+
+	stored := roleToModel(role)
+	stored.CreatedBy = actor.PreferredUsername
+	change := &AuditChange{
+		Action:     model.AuditActionRoleCreate,
+		TargetType: model.AuditTargetRole,
+		TargetID:   role.Name,
+		After:      roleAuditState(role),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewRoleRepository(db).Create(ctx, stored)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return rbac.Role{}, fmt.Errorf("%w: роль %q уже существует", ErrConflict, role.Name)
+		}
+		return rbac.Role{}, fmt.Errorf("создание роли: %w", err)
+	}
+
+	s.logger.Info("Роль создана",
+		slog.String("role", role.Name),
+		slog.String("created_by", actor.PreferredUsername),
+	)
+	s.reloadAfterChange(ctx)
+	return role, nil
+}
+
+// Update изменяет описание, разрешения и группы пользовательской роли.
+// Встроенные роли не изменяются (ErrConflict).
+func (s *RoleService) Update(ctx context.Context, actor *middleware.AuthClaims, name string, in RoleInput) (rbac.Role, error) {
+	in.Name = name
+	role, err := validateRoleInput(in)
+	if err != nil {
+		return rbac.Role{}, err
+	}
+	current, err := s.customRole(ctx, name)
+	if err != nil {
+		return rbac.Role{}, err
+	}
+	if err := checkRoleEscalation(actor, current.PermissionSet()); err != nil {
+		return rbac.Role{}, err
+	}
+	if err := checkRoleEscalation(actor, role.PermissionSet()); err != nil {
+		return rbac.Role{}, err
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionRoleUpdate,
+		TargetType: model.AuditTargetRole,
+		TargetID:   name,
+		Before:     roleAuditState(current),
+		After:      roleAuditState(role),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewRoleRepository(db).Update(ctx, roleToModel(role))
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return rbac.Role{}, ErrNotFound
+		}
+		return rbac.Role{}, fmt.Errorf("обновление роли: %w", err)
+	}
+
+	s.logger.Info("Роль изменена",
+		slog.String("role", name),
+		slog.String("updated_by", actor.PreferredUsername),
+	)
+	s.reloadAfterChange(ctx)
+	return role, nil
+}
+
+// Delete удаляет пользовательскую роль. Роль, назначенная через role
+// override, и встроенные роли не удаляются (ErrConflict).
+func (s *RoleService) Delete(ctx context.Context, actor *middleware.AuthClaims, name string) error {
+	current, err := s.customRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := checkRoleEscalation(actor, current.PermissionSet()); err != nil {
+		return err
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionRoleDelete,
+		TargetType: model.AuditTargetRole,
+		TargetID:   name,
+		Before:     roleAuditState(current),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		n, err := repository.NewRoleOverrideRepository(db).CountByRole(ctx, name)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return fmt.Errorf("%w: роль назначена %d пользователям через role override", ErrConflict, n)
+		}
+		return repository.NewRoleRepository(db).Delete(ctx, name)
+	})
+	if err != nil {
+		if errors.Is(err, ErrConflict) {
+			return err
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("удаление роли: %w", err)
+	}
+
+	s.logger.Info("Роль удалена",
+		slog.String("role", name),
+		slog.String("deleted_by", actor.PreferredUsername),
+	)
+	s.reloadAfterChange(ctx)
+	return nil
+}
+
+// customRole возвращает пользовательскую роль из БД: ErrConflict для
+// встроенной роли, ErrNotFound — если роли нет.
+func (s *RoleService) customRole(ctx context.Context, name string) (rbac.Role, error) {
+	if rbac.IsBuiltinRole(name) {
+		return rbac.Role{}, fmt.Errorf("%w: встроенная роль %q не изменяется", ErrConflict, name)
+	}
+	stored, err := s.repo.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return rbac.Role{}, ErrNotFound
+		}
+		return rbac.Role{}, fmt.Errorf("получение роли: %w", err)
+	}
+	return roleFromModel(stored), nil
+}
+
+// reloadAfterChange перечитывает каталог после изменения роли.
+// Ошибка не возвращается: изменение уже сохранено, каталог обновится
+// при следующем периодическом перечитывании.
+func (s *RoleService) reloadAfterChange(ctx context.Context) {
+	if err := s.Reload(ctx); err != nil {
+		s.logger.Warn("Ошибка перечитывания каталога ролей",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// checkRoleEscalation проверяет, что у actor есть все разрешения perms.
+func checkRoleEscalation(actor *middleware.AuthClaims, perms rbac.PermissionSet) error {
+	for _, p := range perms.List() {
+		if !actor.HasPermission(p) {
+			return fmt.Errorf("%w: нельзя выдать разрешение %s, которого нет у вас", ErrForbidden, p)
+		}
+	}
+	return nil
+}
+
+// validateRoleInput проверяет и нормализует параметры роли: имя (slug),
+// описание, разрешения из каталога (без повторов, в порядке каталога)
+// и группы IdP (без пустых и повторов).
+func validateRoleInput(in RoleInput) (rbac.Role, error) {
+	name := strings.TrimSpace(in.Name)
+	if !roleNamePattern.MatchString(name) {
+		return rbac.Role{}, fmt.Errorf("%w: имя роли — от 2 до 50 символов: строчные латинские буквы, цифры и дефис, начинается с буквы", ErrValidation)
+	}
+
+	description := strings.TrimSpace(in.Description)
+	if len([]rune(description)) > roleDescriptionMaxLength {
+		return rbac.Role{}, fmt.Errorf("%w: описание роли — не длиннее %d символов", ErrValidation, roleDescriptionMaxLength)
+	}
+
+	if len(in.Permissions) == 0 {
+		return rbac.Role{}, fmt.Errorf("%w: укажите хотя бы одно разрешение", ErrValidation)
+	}
+	perms := make([]rbac.Permission, 0, len(in.Permissions))
+	for _, p := range in.Permissions {
+		perm := rbac.Permission(strings.TrimSpace(p))
+		if !rbac.IsValidPermission(perm) {
+			return rbac.Role{}, fmt.Errorf("%w: неизвестное разрешение %q", ErrValidation, p)
+		}
+		perms = append(perms, perm)
+	}
+
+	var groups []string
+	for _, g := range in.Groups {
+		g = strings.TrimSpace(g)
+		if g != "" && !slices.Contains(groups, g) {
+			groups = append(groups, g)
+		}
+	}
+
+	return rbac.Role{
+		Name:        name,
+		Description: description,
+		Permissions: rbac.NewPermissionSet(perms...).List(),
+		Groups:      groups,
+	}, nil
+}
+
+// roleFromModel конвертирует роль из БД в роль каталога.
+func roleFromModel(r *model.Role) rbac.Role {
+	perms := make([]rbac.Permission, 0, len(r.Permissions))
+	for _, p := range r.Permissions {
+		// Разрешения, исключённые из каталога, игнорируются
+		if perm := rbac.Permission(p); rbac.IsValidPermission(perm) {
+			perms = append(perms, perm)
+		}
+	}
+	return rbac.Role{
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: perms,
+		Groups:      r.Groups,
+	}
+}
+
+// roleToModel конвертирует роль каталога в запись БД.
+func roleToModel(r rbac.Role) *model.Role {
+	perms := make([]string, len(r.Permissions))
+	for i, p := range r.Permissions {
+		perms[i] = string(p)
+	}
+	return &model.Role{
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: perms,
+		Groups:      r.Groups,
+	}
+}
+
+// roleAuditState — состояние роли для журнала аудита.
+func roleAuditState(r rbac.Role) map[string]any {
+	return map[string]any{
+		"description": r.Description,
+		"permissions": r.Permissions,
+		"groups":      r.Groups,
+	}
+}
@@ -0,0 +1,141 @@
+// roles_test.go — unit-тесты каталога ролей: валидация пользовательских
+// ролей, защита от повышения прав и загрузка каталога из БД.
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// fakeRoleRepo — репозиторий ролей в памяти (только чтение).
+type fakeRoleRepo struct {
+	repository.RoleRepository
+	roles []*model.Role
+}
+
+func (f *fakeRoleRepo) List(context.Context) ([]*model.Role, error) {
+	return f.roles, nil
+}
+
+func (f *fakeRoleRepo) GetByName(_ context.Context, name string) (*model.Role, error) {
+	for _, r := range f.roles {
+		if r.Name == name {
+			return r, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func newTestRoles() *RoleService {
+	repo := &fakeRoleRepo{roles: []*model.Role{
+		{Name: "file-curator", Permissions: []string{"files:read", "files:write"}, Groups: []string{"curators"}},
+		// Разрешение, исключённое из каталога, игнорируется
+		{Name: "legacy", Permissions: []string{"files:read", "files:archive"}},
+	}}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewRoleService(repo, nil, []string{"artstore-admins"}, []string{"artstore-viewers"}, logger)
+}
+
+func TestRoleServiceReload(t *testing.T) {
+	s := newTestRoles()
+
+	if s.Catalog().Has("file-curator") {
+		t.Fatal("до загрузки каталог содержит только встроенные роли")
+	}
+	if err := s.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload() ошибка: %v", err)
+	}
+
+	c := s.Catalog()
+	if got := c.MapGroupsToRoles([]string{"curators", "artstore-viewers"}); !slices.Equal(got, []string{rbac.RoleReadonly, "file-curator"}) {
+		t.Errorf("MapGroupsToRoles() = %v", got)
+	}
+	legacy, _ := c.Role("legacy")
+	if !slices.Equal(legacy.Permissions, []rbac.Permission{rbac.PermFilesRead}) {
+		t.Errorf("legacy.Permissions = %v, хотели [files:read]", legacy.Permissions)
+	}
+}
+
+func TestRoleServiceGuards(t *testing.T) {
+	s := newTestRoles()
+	ctx := context.Background()
+	curator := &middleware.AuthClaims{
+		PreferredUsername: "carol",
+		Permissions:       rbac.NewPermissionSet(rbac.PermFilesRead, rbac.PermFilesWrite, rbac.PermRolesWrite),
+	}
+
+	// Нельзя выдать разрешение, которого нет у себя
+	_, err := s.Create(ctx, curator, RoleInput{Name: "ops", Permissions: []string{"storage:sync"}})
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Create(storage:sync) = %v, хотели ErrForbidden", err)
+	}
+
+	// Встроенные роли не создаются, не изменяются и не удаляются
+	_, err = s.Create(ctx, curator, RoleInput{Name: rbac.RoleAdmin, Permissions: []string{"files:read"}})
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("Create(admin) = %v, хотели ErrConflict", err)
+	}
+	if _, err := s.Update(ctx, curator, rbac.RoleReadonly, RoleInput{Permissions: []string{"files:read"}}); !errors.Is(err, ErrConflict) {
+		t.Errorf("Update(readonly) = %v, хотели ErrConflict", err)
+	}
+	if err := s.Delete(ctx, curator, rbac.RoleAdmin); !errors.Is(err, ErrConflict) {
+		t.Errorf("Delete(admin) = %v, хотели ErrConflict", err)
+	}
+
+	if err := s.Delete(ctx, curator, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Delete(missing) = %v, хотели ErrNotFound", err)
+	}
+}
+
+func TestValidateRoleInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      RoleInput
+		wantErr bool
+		want    []rbac.Permission
+	}{
+		{
+			name: "нормализация разрешений и групп",
+			in: RoleInput{
+				Name:        "storage-operator",
+				Permissions: []string{"storage:sync", "files:read", "storage:sync"},
+				Groups:      []string{" ops ", "", "ops"},
+			},
+			want: []rbac.Permission{rbac.PermFilesRead, rbac.PermStorageSync},
+		},
+		{name: "заглавные буквы в имени", in: RoleInput{Name: "Ops", Permissions: []string{"files:read"}}, wantErr: true},
+		{name: "имя из одного символа", in: RoleInput{Name: "o", Permissions: []string{"files:read"}}, wantErr: true},
+		{name: "без разрешений", in: RoleInput{Name: "empty"}, wantErr: true},
+		{name: "неизвестное разрешение", in: RoleInput{Name: "ops", Permissions: []string{"audit:write"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := validateRoleInput(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("ошибка = %v, хотели ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("неожиданная ошибка: %v", err)
+			}
+			if !slices.Equal(role.Permissions, tt.want) {
+				t.Errorf("Permissions = %v, хотели %v", role.Permissions, tt.want)
+			}
+			if !slices.Equal(role.Groups, []string{"ops"}) {
+				t.Errorf("Groups = %v, хотели [ops]", role.Groups)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+// sa_grants.go — ограничения scopes Service Accounts по ресурсам.
+//
+// Grants SA хранятся в sa_resource_grants и передаются в IdP как claim
+// artstore_grants (в Keycloak — protocol mapper клиента). Метки SE
+// разрешаются в список идентификаторов SE (UUID и storage_id) при формировании claim, поэтому
+// SE и Query Module проверяют доступ без обращения к Admin Module.
+//
+// Claim пересчитывается при изменении grants и при каждой синхронизации SA
+// с IdP (учитывает новые SE и изменённые метки). Новые ограничения
+// действуют для токенов, выданных после обновления claim.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// maxSAGrants — максимальное число grants у одного SA.
+const maxSAGrants = 20
+
+// SAGrantService — управление ограничениями scopes SA по ресурсам.
+type SAGrantService struct {
+	provider  idp.Provider
+	saRepo    repository.ServiceAccountRepository
+	grantRepo repository.SAGrantRepository
+	seRepo    repository.StorageElementRepository
+	audit     *AuditService
+	logger    *slog.Logger
+
+	// pushed — последние переданные в IdP значения claim (по ID SA)
+	mu     sync.Mutex
+	pushed map[string]string
+}
+
+// NewSAGrantService создаёт сервис grants SA.
+func NewSAGrantService(
+	provider idp.Provider,
+	saRepo repository.ServiceAccountRepository,
+	grantRepo repository.SAGrantRepository,
+	seRepo repository.StorageElementRepository,
+	audit *AuditService,
+	logger *slog.Logger,
+) *SAGrantService {
+	return &SAGrantService{
+		provider:  provider,
+		saRepo:    saRepo,
+		grantRepo: grantRepo,
+		seRepo:    seRepo,
+		audit:     audit,
+		logger:    logger.With(slog.String("component", "sa_grants")),
+		pushed:    make(map[string]string),
+	}
+}
+
+// List возвращает grants SA.
+func (s *SAGrantService) List(ctx context.Context, saID string) ([]*model.ResourceGrant, error) {
+	if _, err := s.getSA(ctx, saID); err != nil {
+		return nil, err
+	}
+	list, err := s.grantRepo.ListBySA(ctx, saID)
+	if err != nil {
+		return nil, fmt.Errorf("получение grants SA: %w", err)
+	}
+	return list, nil
+}
+
+// Replace заменяет grants SA и обновляет claim в IdP.
+// Пустой список снимает все ограничения.
+func (s *SAGrantService) Replace(ctx context.Context, saID string, list []*model.ResourceGrant) ([]*model.ResourceGrant, error) {
+	sa, err := s.getSA(ctx, saID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validate(ctx, sa, list); err != nil {
+		return nil, err
+	}
+
+	before, err := s.grantRepo.ListBySA(ctx, saID)
+	if err != nil {
+		return nil, fmt.Errorf("получение grants SA: %w", err)
+	}
+
+	for _, g := range list {
+		g.ID = uuid.New().String()
+		g.ServiceAccountID = saID
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionSAGrants,
+		TargetType: model.AuditTargetServiceAccount,
+		TargetID:   saID,
+		Before:     map[string]any{"grants": grantsAuditState(before)},
+		After:      map[string]any{"grants": grantsAuditState(list)},
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewSAGrantRepository(db).Replace(ctx, saID, list)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("сохранение grants SA: %w", err)
+	}
+
+	ses, err := s.seRepo.List(ctx, repository.StorageElementFilters{}, 1000, 0)
+	if err != nil {
+		s.logger.Warn("Ошибка получения SE для claim grants — claim обновится при синхронизации SA",
+			slog.String("sa_id", saID),
+			slog.String("error", err.Error()),
+		)
+	} else if err := s.pushClaim(ctx, sa, list, ses); err != nil {
+		// Не прерываем — claim будет обновлён при следующей синхронизации SA
+		s.logger.Warn("Ошибка обновления claim grants в IdP",
+			slog.String("sa_id", saID),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	s.logger.Info("Grants SA обновлены",
+		slog.String("sa_id", saID),
+		slog.Int("count", len(list)),
+	)
+	return list, nil
+}
+
+// SyncClaims пересчитывает claim всех SA с grants и передаёт изменившиеся
+// значения в IdP. Возвращает число обновлённых SA.
+func (s *SAGrantService) SyncClaims(ctx context.Context) (int, error) {
+	all, err := s.grantRepo.ListAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("получение grants SA: %w", err)
+	}
+	if len(all) == 0 {
+		return 0, nil
+	}
+
+	ses, err := s.seRepo.List(ctx, repository.StorageElementFilters{}, 1000, 0)
+	if err != nil {
+		return 0, fmt.Errorf("получение списка SE: %w", err)
+	}
+
+	updated := 0
+	for saID, list := range all {
+		sa, err := s.saRepo.GetByID(ctx, saID)
+		if err != nil {
+			s.logger.Warn("Ошибка получения SA для claim grants",
+				slog.String("sa_id", saID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		changed, err := s.pushClaimIfChanged(ctx, sa, list, ses)
+		if err != nil {
+			s.logger.Warn("Ошибка обновления claim grants в IdP",
+				slog.String("sa_id", saID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		if changed {
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// pushClaimIfChanged передаёт claim в IdP, если он отличается от
+// переданного ранее этим экземпляром.
+func (s *SAGrantService) pushClaimIfChanged(
+	ctx context.Context, sa *model.ServiceAccount, list []*model.ResourceGrant, ses []*model.StorageElement,
+) (bool, error) {
+	value, err := GrantsClaim(list, ses)
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	prev, known := s.pushed[sa.ID]
+	s.mu.Unlock()
+	if known && prev == value {
+		return false, nil
+	}
+
+	if err := s.setClaim(ctx, sa, value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// pushClaim безусловно передаёт claim в IdP.
+func (s *SAGrantService) pushClaim(
+	ctx context.Context, sa *model.ServiceAccount, list []*model.ResourceGrant, ses []*model.StorageElement,
+) error {
+	value, err := GrantsClaim(list, ses)
+	if err != nil {
+		return err
+	}
+	return s.setClaim(ctx, sa, value)
+}
+
+// setClaim сохраняет значение claim в IdP и запоминает его.
+func (s *SAGrantService) setClaim(ctx context.Context, sa *model.ServiceAccount, value string) error {
+	if sa.KeycloakClientID == nil {
+		return fmt.Errorf("SA не синхронизирован с IdP: отсутствует keycloak_client_id")
+	}
+	if err := s.provider.SetClientClaim(ctx, *sa.KeycloakClientID, grants.ClaimName, value); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.pushed[sa.ID] = value
+	s.mu.Unlock()
+	return nil
+}
+
+// GrantsClaim формирует JSON-значение claim artstore_grants: ограничения
+// SE по ID и меткам разрешаются в UUID и storage_id подходящих SE из ses.
+// Пустой список grants — пустая строка (claim не нужен).
+func GrantsClaim(list []*model.ResourceGrant, ses []*model.StorageElement) (string, error) {
+	if len(list) == 0 {
+		return "", nil
+	}
+
+	claim := make([]grants.Grant, 0, len(list))
+	for _, g := range list {
+		cg := grants.Grant{
+			Scope:     g.Scope,
+			Retention: g.RetentionPolicies,
+			Own:       g.OwnFilesOnly,
+		}
+		if g.RestrictsSE() {
+			// Пустой (не nil) список — ни один SE не подходит
+			cg.SE = []string{}
+			for _, se := range ses {
+				if len(g.SEIDs) > 0 && !slices.Contains(g.SEIDs, se.ID) {
+					continue
+				}
+				if labelMatches(se.Labels, g.SELabels) != len(g.SELabels) {
+					continue
+				}
+				cg.SE = append(cg.SE, se.ID)
+				if se.StorageID != "" {
+					cg.SE = append(cg.SE, se.StorageID)
+				}
+			}
+		}
+		claim = append(claim, cg)
+	}
+
+	data, err := json.Marshal(claim)
+	if err != nil {
+		return "", fmt.Errorf("сериализация claim grants: %w", err)
+	}
+	return string(data), nil
+}
+
+// validate проверяет grants SA.
+func (s *SAGrantService) validate(ctx context.Context, sa *model.ServiceAccount, list []*model.ResourceGrant) error {
+	if len(list) > maxSAGrants {
+		return fmt.Errorf("%w: не более %d grants у SA", ErrValidation, maxSAGrants)
+	}
+
+	for i, g := range list {
+		switch g.Scope {
+		case grants.ScopeFilesRead, grants.ScopeFilesWrite, grants.ScopeStorageRead, grants.ScopeStorageWrite:
+		default:
+			return fmt.Errorf("%w: grant %d: scope %q нельзя ограничить по ресурсам", ErrValidation, i+1, g.Scope)
+		}
+		if !slices.Contains(sa.Scopes, g.Scope) {
+			return fmt.Errorf("%w: grant %d: у SA нет scope %s", ErrValidation, i+1, g.Scope)
+		}
+
+		isFiles := g.Scope == grants.ScopeFilesRead || g.Scope == grants.ScopeFilesWrite
+		if !isFiles && (len(g.RetentionPolicies) > 0 || g.OwnFilesOnly) {
+			return fmt.Errorf("%w: grant %d: ограничения по файлам допустимы только для files:read и files:write",
+				ErrValidation, i+1)
+		}
+		if !g.RestrictsSE() && len(g.RetentionPolicies) == 0 && !g.OwnFilesOnly {
+			return fmt.Errorf("%w: grant %d: укажите хотя бы одно ограничение", ErrValidation, i+1)
+		}
+
+		for _, p := range g.RetentionPolicies {
+			if p != "permanent" && p != "temporary" {
+				return fmt.Errorf("%w: grant %d: неизвестная политика хранения %q", ErrValidation, i+1, p)
+			}
+		}
+		if err := ValidateLabels(g.SELabels); err != nil {
+			return fmt.Errorf("grant %d: %w", i+1, err)
+		}
+		for _, seID := range g.SEIDs {
+			if _, err := uuid.Parse(seID); err != nil {
+				return fmt.Errorf("%w: grant %d: некорректный ID SE %q", ErrValidation, i+1, seID)
+			}
+			if _, err := s.seRepo.GetByID(ctx, seID); err != nil {
+				if errors.Is(err, repository.ErrNotFound) {
+					return fmt.Errorf("%w: grant %d: SE %s не найден", ErrValidation, i+1, seID)
+				}
+				return fmt.Errorf("получение SE %s: %w", seID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// getSA возвращает SA или ErrNotFound.
+func (s *SAGrantService) getSA(ctx context.Context, saID string) (*model.ServiceAccount, error) {
+	sa, err := s.saRepo.GetByID(ctx, saID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение SA: %w", err)
+	}
+	return sa, nil
+}
+
+// grantsAuditState — grants SA для журнала аудита.
+func grantsAuditState(list []*model.ResourceGrant) []map[string]any {
+	result := make([]map[string]any, 0, len(list))
+	for _, g := range list {
+		result = append(result, map[string]any{
+			"scope":              g.Scope,
+			"se_ids":             g.SEIDs,
+			"se_labels":          g.SELabels,
+			"retention_policies": g.RetentionPolicies,
+			"own_files_only":     g.OwnFilesOnly,
+		})
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+// sa_grants_test.go — unit-тесты grants SA: формирование claim и валидация.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/grants"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+)
+
+const (
+	testSEEdgeA = "11111111-1111-1111-1111-111111111111"
+	testSEEdgeB = "22222222-2222-2222-2222-222222222222"
+	testSECore  = "33333333-3333-3333-3333-333333333333"
+)
+
+// TestGrantsClaim проверяет разрешение меток и ID SE в claim.
+func TestGrantsClaim(t *testing.T) {
+	ses := []*model.StorageElement{
+		{ID: testSEEdgeA, StorageID: "edge-a", Labels: map[string]string{"zone": "eu-1", "tier": "edge"}},
+		{ID: testSEEdgeB, StorageID: "edge-b", Labels: map[string]string{"zone": "us-1", "tier": "edge"}},
+		{ID: testSECore, StorageID: "core", Labels: map[string]string{"zone": "eu-1", "tier": "core"}},
+	}
+	list := []*model.ResourceGrant{
+		{Scope: grants.ScopeFilesWrite, SELabels: map[string]string{"zone": "eu-1", "tier": "edge"}},
+		{Scope: grants.ScopeFilesRead, OwnFilesOnly: true, RetentionPolicies: []string{"temporary"}},
+		{Scope: grants.ScopeStorageRead, SELabels: map[string]string{"zone": "ap-1"}},
+		{Scope: grants.ScopeStorageWrite, SEIDs: []string{testSECore, testSEEdgeB}, SELabels: map[string]string{"zone": "eu-1"}},
+	}
+
+	value, err := GrantsClaim(list, ses)
+	if err != nil {
+		t.Fatalf("GrantsClaim() ошибка: %v", err)
+	}
+
+	var claim grants.Set
+	if err := json.Unmarshal([]byte(value), &claim); err != nil {
+		t.Fatalf("claim не является JSON-массивом grants: %v (%s)", err, value)
+	}
+	if len(claim) != 4 {
+		t.Fatalf("ожидалось 4 grants, получено %d", len(claim))
+	}
+
+	if got := claim[0].SE; len(got) != 2 || got[0] != testSEEdgeA || got[1] != "edge-a" {
+		t.Errorf("files:write SE = %v, ожидались UUID и storage_id edge-a", got)
+	}
+	if claim[1].SE != nil || !claim[1].Own || len(claim[1].Retention) != 1 {
+		t.Errorf("files:read grant = %+v, ожидались own и retention без ограничения SE", claim[1])
+	}
+	if claim[2].SE == nil || len(claim[2].SE) != 0 {
+		t.Errorf("storage:read SE = %v, ожидался пустой список (нет SE с меткой)", claim[2].SE)
+	}
+	if got := claim[3].SE; len(got) != 2 || got[0] != testSECore {
+		t.Errorf("storage:write SE = %v, ожидался только core (ID и метка одновременно)", got)
+	}
+
+	// Запреты после разрешения claim
+	if claim.Allows(grants.ScopeFilesWrite, grants.Access{SE: []string{"edge-b"}}) {
+		t.Error("загрузка на SE вне зоны должна запрещаться")
+	}
+	if claim.Allows(grants.ScopeStorageRead, grants.Access{SE: []string{"core"}}) {
+		t.Error("storage:read без подходящих SE должен запрещаться")
+	}
+
+	empty, err := GrantsClaim(nil, ses)
+	if err != nil || empty != "" {
+		t.Errorf("GrantsClaim(nil) = %q, %v; ожидалась пустая строка", empty, err)
+	}
+}
+
+// TestSAGrantValidate проверяет валидацию grants SA.
+func TestSAGrantValidate(t *testing.T) {
+	svc := &SAGrantService{seRepo: &stubSERepo{se: &model.StorageElement{ID: testSEEdgeA}}}
+	sa := &model.ServiceAccount{Scopes: []string{"files:read", "files:write", "storage:read"}}
+
+	tests := []struct {
+		name    string
+		grant   model.ResourceGrant
+		wantErr bool
+	}{
+		{"SE по ID", model.ResourceGrant{Scope: "files:write", SEIDs: []string{testSEEdgeA}}, false},
+		{"метки и политика", model.ResourceGrant{Scope: "files:write", SELabels: map[string]string{"zone": "eu-1"}, RetentionPolicies: []string{"temporary"}}, false},
+		{"только свои файлы", model.ResourceGrant{Scope: "files:read", OwnFilesOnly: true}, false},
+		{"scope, которого нет у SA", model.ResourceGrant{Scope: "storage:write", SEIDs: []string{testSEEdgeA}}, true},
+		{"scope без ресурсов", model.ResourceGrant{Scope: "admin:read", OwnFilesOnly: true}, true},
+		{"без ограничений", model.ResourceGrant{Scope: "files:read"}, true},
+		{"own для storage", model.ResourceGrant{Scope: "storage:read", OwnFilesOnly: true}, true},
+		{"неизвестная политика", model.ResourceGrant{Scope: "files:read", RetentionPolicies: []string{"forever"}}, true},
+		{"некорректный ID SE", model.ResourceGrant{Scope: "files:read", SEIDs: []string{"edge-a"}}, true},
+		{"некорректная метка", model.ResourceGrant{Scope: "files:read", SELabels: map[string]string{"Zone": "x"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.grant
+			err := svc.validate(context.Background(), sa, []*model.ResourceGrant{&g})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validate() ошибка = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrValidation) {
+				t.Errorf("ожидалась ErrValidation, получено %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// sa_secret_expiry.go — фоновая приостановка SA с истёкшим секретом.
+//
+// SASecretExpiryService раз в AM_SA_SECRET_CHECK_INTERVAL находит активные SA,
+// секрет которых выдан раньше, чем AM_SA_SECRET_LIFETIME назад, и
+// приостанавливает их в Keycloak и локально (ServiceAccountService.ExpireSecrets).
+// При AM_SA_SECRET_LIFETIME=0 секреты не истекают и проверка ничего не делает.
+//
+// Prometheus-метрики:
+//   - admin_module_sa_secrets_expired_total — число SA, приостановленных из-за истечения секрета
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// saSecretsExpired — счётчик SA, приостановленных из-за истечения секрета.
+var saSecretsExpired = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "admin_module_sa_secrets_expired_total",
+	Help: "Число Service Accounts, приостановленных из-за истечения секрета",
+})
+
+// SASecretExpiryService — фоновый сервис приостановки SA с истёкшим секретом.
+type SASecretExpiryService struct {
+	saSvc    *ServiceAccountService
+	interval time.Duration
+	logger   *slog.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSASecretExpiryService создаёт сервис проверки сроков действия секретов SA.
+func NewSASecretExpiryService(
+	saSvc *ServiceAccountService,
+	interval time.Duration,
+	logger *slog.Logger,
+) *SASecretExpiryService {
+	return &SASecretExpiryService{
+		saSvc:    saSvc,
+		interval: interval,
+		logger:   logger.With(slog.String("component", "sa_secret_expiry")),
+	}
+}
+
+// Start запускает фоновую горутину. Первая проверка выполняется сразу.
+func (s *SASecretExpiryService) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		s.logger.Info("Проверка сроков действия секретов SA запущена",
+			slog.String("interval", s.interval.String()),
+			slog.String("lifetime", s.saSvc.SecretPolicy().Lifetime.String()),
+		)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.RunOnce(ctx)
+
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Проверка сроков действия секретов SA остановлена")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop останавливает фоновую горутину и ждёт завершения.
+func (s *SASecretExpiryService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+// RunOnce выполняет одну проверку и возвращает число приостановленных SA.
+func (s *SASecretExpiryService) RunOnce(ctx context.Context) int {
+	suspended, err := s.saSvc.ExpireSecrets(ctx)
+	if err != nil {
+		s.logger.Error("Ошибка проверки сроков действия секретов SA",
+			slog.String("error", err.Error()),
+		)
+		return 0
+	}
+
+	if suspended > 0 {
+		saSecretsExpired.Add(float64(suspended))
+		s.logger.Info("SA с истёкшим секретом приостановлены",
+			slog.Int("count", suspended),
+		)
+	}
+	return suspended
+}
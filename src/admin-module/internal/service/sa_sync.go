@@ -1,7 +1,8 @@
-// sa_sync.go — сервис периодической синхронизации Service Accounts с Keycloak.
+// sa_sync.go — сервис периодической синхронизации Service Accounts с IdP.
 //
 // SASyncService запускает фоновую горутину с ticker (AM_SA_SYNC_INTERVAL),
-// которая выполняет reconciliation SA между локальной БД и Keycloak.
+// которая выполняет reconciliation SA между локальной БД и IdP (Keycloak
+// или клиентами локального IdP).
 //
 // Reconciliation:
 //  1. Получить SA clients из Keycloak (с префиксом sa_*)
@@ -9,6 +10,7 @@
 //  3. В Keycloak, но не локально → создать в локальной БД (source=keycloak)
 //  4. Локально, но не в Keycloak → создать в Keycloak (source=local)
 //  5. В обоих → сравнить scopes, обновить при расхождении (local wins)
+//  6. Пересчитать claim grants SA (новые SE и изменённые метки)
 //
 // Prometheus-метрики:
 //   - admin_module_sa_sync_duration_seconds — длительность синхронизации SA
@@ -25,7 +27,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
-	"github.com/bigkaa/goartstore/admin-module/internal/keycloak"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 )
 
@@ -36,13 +38,15 @@ var saSyncDuration = promauto.NewHistogram(prometheus.HistogramOpts{
 	Buckets: prometheus.ExponentialBuckets(0.1, 2, 10), // 0.1s … ~51s
 })
 
-// SASyncService — фоновый сервис синхронизации SA с Keycloak.
+// SASyncService — фоновый сервис синхронизации SA с IdP.
 type SASyncService struct {
-	kcClient      *keycloak.Client
+	provider      idp.Provider
 	saRepo        repository.ServiceAccountRepository
 	syncStateRepo repository.SyncStateRepository
 	saPrefix      string
 	interval      time.Duration
+	grantSvc      *SAGrantService
+	collClaims    *CollectionClaimService
 	logger        *slog.Logger
 
 	cancel context.CancelFunc
@@ -51,7 +55,7 @@ type SASyncService struct {
 
 // NewSASyncService создаёт сервис синхронизации SA.
 func NewSASyncService(
-	kcClient *keycloak.Client,
+	provider idp.Provider,
 	saRepo repository.ServiceAccountRepository,
 	syncStateRepo repository.SyncStateRepository,
 	saPrefix string,
@@ -59,7 +63,7 @@ func NewSASyncService(
 	logger *slog.Logger,
 ) *SASyncService {
 	return &SASyncService{
-		kcClient:      kcClient,
+		provider:      provider,
 		saRepo:        saRepo,
 		syncStateRepo: syncStateRepo,
 		saPrefix:      saPrefix,
@@ -68,6 +72,18 @@ func NewSASyncService(
 	}
 }
 
+// SetGrantService задаёт сервис grants SA: после синхронизации claim grants
+// пересчитываются. Вызывается до Start.
+func (s *SASyncService) SetGrantService(grantSvc *SAGrantService) {
+	s.grantSvc = grantSvc
+}
+
+// SetCollectionClaimService задаёт сервис claim коллекций SA: после
+// синхронизации claim коллекций пересчитываются. Вызывается до Start.
+func (s *SASyncService) SetCollectionClaimService(collClaims *CollectionClaimService) {
+	s.collClaims = collClaims
+}
+
 // Start запускает фоновую горутину с периодической синхронизацией SA.
 func (s *SASyncService) Start(ctx context.Context) {
 	ctx, s.cancel = context.WithCancel(ctx)
@@ -128,7 +144,7 @@ func (s *SASyncService) SyncNow(ctx context.Context) (*model.SASyncResult, error
 	startedAt := time.Now().UTC()
 
 	// 1. Получаем SA clients из Keycloak (с префиксом sa_*)
-	kcClients, err := s.kcClient.ListClients(ctx, s.saPrefix, 0, 1000)
+	kcClients, err := s.provider.ListClients(ctx, s.saPrefix, 0, 1000)
 	if err != nil {
 		return nil, fmt.Errorf("получение SA clients из Keycloak: %w", err)
 	}
@@ -140,7 +156,7 @@ func (s *SASyncService) SyncNow(ctx context.Context) (*model.SASyncResult, error
 	}
 
 	// 3. Строим карты по client_id для быстрого поиска
-	kcMap := make(map[string]keycloak.KeycloakClient, len(kcClients))
+	kcMap := make(map[string]idp.Client, len(kcClients))
 	for _, kc := range kcClients {
 		kcMap[kc.ClientID] = kc
 	}
@@ -194,7 +210,7 @@ func (s *SASyncService) SyncNow(ctx context.Context) (*model.SASyncResult, error
 			continue
 		}
 
-		if scopesDiffer(sa.Scopes, kc.DefaultClientScopes) {
+		if scopesDiffer(sa.Scopes, kc.Scopes) {
 			if err := s.reconcileScopes(ctx, sa, kc, now); err != nil {
 				s.logger.Warn("Ошибка reconcile scopes SA",
 					slog.String("client_id", sa.ClientID),
@@ -221,14 +237,31 @@ func (s *SASyncService) SyncNow(ctx context.Context) (*model.SASyncResult, error
 		}
 	}
 
-	// 7. Обновляем timestamp синхронизации
+	// 7. Пересчитываем claim grants (SE могли добавиться или сменить метки)
+	// и claim коллекций
+	if s.grantSvc != nil {
+		if n, err := s.grantSvc.SyncClaims(ctx); err != nil {
+			s.logger.Warn("Ошибка обновления claim grants SA", slog.String("error", err.Error()))
+		} else if n > 0 {
+			s.logger.Info("Claim grants SA обновлены", slog.Int("count", n))
+		}
+	}
+	if s.collClaims != nil {
+		if n, err := s.collClaims.SyncClaims(ctx); err != nil {
+			s.logger.Warn("Ошибка обновления claim коллекций SA", slog.String("error", err.Error()))
+		} else if n > 0 {
+			s.logger.Info("Claim коллекций SA обновлены", slog.Int("count", n))
+		}
+	}
+
+	// 8. Обновляем timestamp синхронизации
 	if err := s.syncStateRepo.UpdateSASyncAt(ctx, now); err != nil {
 		s.logger.Warn("Ошибка обновления last_sa_sync_at", slog.String("error", err.Error()))
 	}
 
 	completedAt := time.Now().UTC()
 
-	// 8. Prometheus-метрика
+	// 9. Prometheus-метрика
 	saSyncDuration.Observe(completedAt.Sub(startedAt).Seconds())
 
 	// Подсчёт финальных итогов
@@ -247,7 +280,7 @@ func (s *SASyncService) SyncNow(ctx context.Context) (*model.SASyncResult, error
 }
 
 // createLocalFromKC создаёт локальный SA из Keycloak client.
-func (s *SASyncService) createLocalFromKC(ctx context.Context, kc keycloak.KeycloakClient, now time.Time) error {
+func (s *SASyncService) createLocalFromKC(ctx context.Context, kc idp.Client, now time.Time) error {
 	name := kc.Name
 	if name == "" {
 		name = kc.ClientID
@@ -269,7 +302,7 @@ func (s *SASyncService) createLocalFromKC(ctx context.Context, kc keycloak.Keycl
 		ClientID:         kc.ClientID,
 		Name:             name,
 		Description:      desc,
-		Scopes:           kc.DefaultClientScopes,
+		Scopes:           kc.Scopes,
 		Status:           status,
 		Source:           "keycloak",
 		LastSyncedAt:     &now,
@@ -285,7 +318,13 @@ func (s *SASyncService) createKCFromLocal(ctx context.Context, sa *model.Service
 		desc = *sa.Description
 	}
 
-	kcID, err := s.kcClient.CreateClient(ctx, sa.ClientID, sa.Name, desc, sa.Scopes)
+	kcID, _, err := s.provider.CreateClient(ctx, &idp.Client{
+		ClientID:    sa.ClientID,
+		Name:        sa.Name,
+		Description: desc,
+		Enabled:     sa.Status == "active",
+		Scopes:      sa.Scopes,
+	})
 	if err != nil {
 		return fmt.Errorf("создание client в Keycloak: %w", err)
 	}
@@ -302,19 +341,12 @@ func (s *SASyncService) createKCFromLocal(ctx context.Context, sa *model.Service
 
 // reconcileScopes обновляет scopes: локальная БД — источник истины.
 // При расхождении scopes обновляем Keycloak.
-func (s *SASyncService) reconcileScopes(ctx context.Context, sa *model.ServiceAccount, kc keycloak.KeycloakClient, now time.Time) error {
-	// Обновляем Keycloak client с локальными scopes
-	updatedKC := &keycloak.KeycloakClient{
-		ID:                     kc.ID,
-		ClientID:               kc.ClientID,
-		Name:                   kc.Name,
-		Description:            kc.Description,
-		Enabled:                kc.Enabled,
-		ServiceAccountsEnabled: kc.ServiceAccountsEnabled,
-		DefaultClientScopes:    sa.Scopes,
-	}
+func (s *SASyncService) reconcileScopes(ctx context.Context, sa *model.ServiceAccount, kc idp.Client, now time.Time) error {
+	// Обновляем client IdP с локальными scopes
+	updated := kc
+	updated.Scopes = sa.Scopes
 
-	if err := s.kcClient.UpdateClient(ctx, kc.ID, updatedKC); err != nil {
+	if err := s.provider.UpdateClient(ctx, &updated); err != nil {
 		return fmt.Errorf("обновление scopes в Keycloak: %w", err)
 	}
 
@@ -0,0 +1,303 @@
+// se_write.go — запись изменений файлового реестра на Storage Elements.
+//
+// attr.json на SE — единственный источник истины для метаданных файлов,
+// поэтому изменения description/tags и soft delete из Admin Module сначала
+// применяются на основном SE файла (PATCH/DELETE /api/v1/files/{id}), затем
+// в реестре. Если SE временно недоступен (сетевая ошибка, 5xx, 401/403),
+// изменение сохраняется в outbox (se_write_outbox), реестр обновляется сразу,
+// а SEWriteService повторяет запись с экспоненциальной задержкой
+// (AM_SE_WRITE_RETRY_INTERVAL, не более seWriteMaxDelay). Пока изменение
+// в outbox, синхронизация не откатывает запись реестра.
+//
+// Отказ SE по существу (4xx: режим SE не допускает операцию, файл не найден)
+// помечает изменение как failed — повторов не будет, следующая полная
+// синхронизация вернёт в реестр состояние SE.
+//
+// Prometheus-метрики:
+//   - admin_module_se_writes_total — результаты записи на SE (applied, queued, rejected)
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
+)
+
+// seWriteBatchSize — максимальное число изменений, обрабатываемых за один проход.
+const seWriteBatchSize = 100
+
+// seWriteMaxDelay — максимальная задержка между попытками записи на SE.
+const seWriteMaxDelay = time.Hour
+
+// seWritesTotal — результаты записи изменений на SE.
+var seWritesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "admin_module_se_writes_total",
+	Help: "Количество попыток записи изменений файлов на SE",
+}, []string{"operation", "result"}) // result: applied, queued, rejected
+
+// SEWriteResult — итог одного прохода повторной записи.
+type SEWriteResult struct {
+	// Due — количество изменений, время попытки которых наступило
+	Due int
+	// Applied — применено на SE
+	Applied int
+	// Retried — временная ошибка, попытка отложена
+	Retried int
+	// Rejected — отклонено SE
+	Rejected int
+}
+
+// SEWriteService — запись изменений файлов на SE и повтор из outbox.
+type SEWriteService struct {
+	seClient  *seclient.Client
+	seRepo    repository.StorageElementRepository
+	writeRepo repository.SEWriteRepository
+	interval  time.Duration
+	logger    *slog.Logger
+
+	trigger chan struct{}
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewSEWriteService создаёт сервис записи изменений на SE.
+// interval — период проверки outbox и базовая задержка повтора.
+func NewSEWriteService(
+	seClient *seclient.Client,
+	seRepo repository.StorageElementRepository,
+	writeRepo repository.SEWriteRepository,
+	interval time.Duration,
+	logger *slog.Logger,
+) *SEWriteService {
+	return &SEWriteService{
+		seClient:  seClient,
+		seRepo:    seRepo,
+		writeRepo: writeRepo,
+		interval:  interval,
+		logger:    logger.With(slog.String("component", "se_write")),
+		trigger:   make(chan struct{}, 1),
+	}
+}
+
+// Start запускает фоновую горутину повторной записи.
+func (s *SEWriteService) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		s.logger.Info("Повторная запись изменений на SE запущена",
+			slog.String("interval", s.interval.String()),
+		)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Повторная запись изменений на SE остановлена")
+				return
+			case <-ticker.C:
+			case <-s.trigger:
+			}
+
+			result, err := s.RunOnce(ctx)
+			if err != nil {
+				s.logger.Error("Ошибка повторной записи на SE", slog.String("error", err.Error()))
+				continue
+			}
+			if result.Due > 0 {
+				s.logger.Info("Проход повторной записи на SE завершён",
+					slog.Int("due", result.Due),
+					slog.Int("applied", result.Applied),
+					slog.Int("retried", result.Retried),
+					slog.Int("rejected", result.Rejected),
+				)
+			}
+		}
+	}()
+}
+
+// Stop останавливает фоновую горутину и ждёт завершения.
+func (s *SEWriteService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+// Trigger запрашивает внеочередной проход повторной записи.
+// Не блокирует: повторные вызовы до начала прохода объединяются.
+func (s *SEWriteService) Trigger() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// NewWrite создаёт изменение файла f для записи на SE.
+// Для update передаётся итоговое состояние description и tags.
+func NewWrite(f *model.FileRecord, operation string) *model.SEWrite {
+	w := &model.SEWrite{
+		ID:               uuid.New().String(),
+		FileID:           f.FileID,
+		StorageElementID: f.StorageElementID,
+		Operation:        operation,
+		Status:           model.SEWriteStatusPending,
+	}
+	if operation == model.SEWriteOpUpdate {
+		w.Description = f.Description
+		w.Tags = f.Tags
+	}
+	return w
+}
+
+// Apply применяет изменение на основном SE файла.
+// Ошибка, для которой seclient.IsRejected == true, означает отказ SE по существу.
+func (s *SEWriteService) Apply(ctx context.Context, w *model.SEWrite) error {
+	se, err := s.seRepo.GetByID(ctx, w.StorageElementID)
+	if err != nil {
+		return fmt.Errorf("получение SE %s: %w", w.StorageElementID, err)
+	}
+
+	switch w.Operation {
+	case model.SEWriteOpUpdate:
+		desc := ""
+		if w.Description != nil {
+			desc = *w.Description
+		}
+		tags := w.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		_, err = s.seClient.UpdateFile(ctx, se.URL, w.FileID, seclient.FileUpdate{
+			Description: &desc,
+			Tags:        &tags,
+		})
+		return err
+
+	case model.SEWriteOpDelete:
+		err = s.seClient.DeleteFile(ctx, se.URL, w.FileID)
+		if err == nil || seclient.IsNotFound(err) {
+			// Файла на SE уже нет — результат тот же
+			return nil
+		}
+		if isConflict(err) {
+			// Повторное удаление SE отклоняет как конфликт — проверяем статус
+			meta, getErr := s.seClient.GetFile(ctx, se.URL, w.FileID)
+			if getErr == nil && meta.Status == "deleted" {
+				return nil
+			}
+		}
+		return err
+
+	default:
+		return fmt.Errorf("неизвестная операция записи на SE: %s", w.Operation)
+	}
+}
+
+// RunOnce выполняет один проход повторной записи изменений из outbox.
+func (s *SEWriteService) RunOnce(ctx context.Context) (*SEWriteResult, error) {
+	writes, err := s.writeRepo.ListDue(ctx, seWriteBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("получение изменений для записи на SE: %w", err)
+	}
+
+	result := &SEWriteResult{Due: len(writes)}
+	for _, w := range writes {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		err := s.Apply(ctx, w)
+		switch {
+		case err == nil:
+			if cErr := s.writeRepo.Complete(ctx, w.ID); cErr != nil {
+				return result, cErr
+			}
+			result.Applied++
+			seWritesTotal.WithLabelValues(w.Operation, "applied").Inc()
+			s.logger.Info("Изменение файла записано на SE",
+				slog.String("file_id", w.FileID),
+				slog.String("operation", w.Operation),
+				slog.Int("attempts", w.Attempts+1),
+			)
+
+		case seclient.IsRejected(err):
+			if fErr := s.writeRepo.Fail(ctx, w.ID, err.Error()); fErr != nil {
+				return result, fErr
+			}
+			result.Rejected++
+			seWritesTotal.WithLabelValues(w.Operation, "rejected").Inc()
+			s.logger.Warn("SE отклонил изменение файла",
+				slog.String("file_id", w.FileID),
+				slog.String("operation", w.Operation),
+				slog.String("error", err.Error()),
+			)
+
+		default:
+			s.scheduleRetry(w, err)
+			if rErr := s.writeRepo.Retry(ctx, w); rErr != nil {
+				return result, rErr
+			}
+			result.Retried++
+			s.logger.Warn("Ошибка записи изменения на SE, попытка отложена",
+				slog.String("file_id", w.FileID),
+				slog.String("operation", w.Operation),
+				slog.Int("attempts", w.Attempts),
+				slog.Time("next_attempt_at", w.NextAttemptAt),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	return result, nil
+}
+
+// scheduleRetry фиксирует неудачную попытку и назначает следующую.
+func (s *SEWriteService) scheduleRetry(w *model.SEWrite, err error) {
+	msg := err.Error()
+	w.Attempts++
+	w.LastError = &msg
+	w.NextAttemptAt = time.Now().UTC().Add(retryDelay(s.interval, w.Attempts))
+}
+
+// retryDelay возвращает задержку перед попыткой номер attempts+1:
+// base, 2×base, 4×base, ... но не более seWriteMaxDelay.
+func retryDelay(base time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < seWriteMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, seWriteMaxDelay)
+}
+
+// isConflict возвращает true, если SE ответил 409.
+func isConflict(err error) bool {
+	var se *seclient.StatusError
+	return errors.As(err, &se) && se.StatusCode == http.StatusConflict
+}
+
+// rejectionMessage возвращает текст отказа SE для пользователя.
+func rejectionMessage(err error) string {
+	var se *seclient.StatusError
+	if errors.As(err, &se) && se.Message != "" {
+		return se.Message
+	}
+	return err.Error()
+}
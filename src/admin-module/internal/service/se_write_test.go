@@ -0,0 +1,111 @@
+// se_write_test.go — unit-тесты записи изменений файлов на SE:
+// операции PATCH/DELETE, классификация ошибок, задержка повтора.
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
+)
+
+// stubSERepo возвращает один SE по ID; остальные методы не используются.
+type stubSERepo struct {
+	repository.StorageElementRepository
+	se *model.StorageElement
+}
+
+func (r *stubSERepo) GetByID(_ context.Context, _ string) (*model.StorageElement, error) {
+	return r.se, nil
+}
+
+// TestSEWriteApply проверяет запись изменений на SE и обработку ответов SE.
+func TestSEWriteApply(t *testing.T) {
+	var patched seclient.FileUpdate
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/api/v1/files/"):]
+		switch {
+		case r.Method == http.MethodPatch:
+			_ = json.NewDecoder(r.Body).Decode(&patched)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(seclient.SEFileMetadata{FileID: id, Status: "active"})
+		case r.Method == http.MethodDelete && id == "gone":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodDelete && (id == "deleted" || id == "ro"):
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":{"code":"MODE_NOT_ALLOWED","message":"недоступно"}}`))
+		case r.Method == http.MethodGet:
+			status := "active"
+			if id == "deleted" {
+				status = "deleted"
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(seclient.SEFileMetadata{FileID: id, Status: status})
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	client, err := seclient.New("", 5*time.Second, nil, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSEWriteService(client, &stubSERepo{se: &model.StorageElement{ID: "se", URL: server.URL}}, nil, time.Minute, logger)
+	ctx := context.Background()
+
+	// update: передаётся итоговое состояние, nil-теги — пустой список
+	f := &model.FileRecord{FileID: "f1", StorageElementID: "se"}
+	if err := s.Apply(ctx, NewWrite(f, model.SEWriteOpUpdate)); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if patched.Description == nil || *patched.Description != "" || patched.Tags == nil || len(*patched.Tags) != 0 {
+		t.Errorf("тело PATCH: %+v", patched)
+	}
+
+	tests := []struct {
+		fileID   string
+		wantErr  bool
+		rejected bool
+	}{
+		{"gone", false, false},    // файла на SE нет — удаление выполнено
+		{"deleted", false, false}, // уже удалён на SE
+		{"ro", true, true},        // SE не допускает удаление
+		{"busy", true, false},     // SE временно недоступен
+	}
+	for _, tt := range tests {
+		t.Run(tt.fileID, func(t *testing.T) {
+			err := s.Apply(ctx, NewWrite(&model.FileRecord{FileID: tt.fileID, StorageElementID: "se"}, model.SEWriteOpDelete))
+			if (err != nil) != tt.wantErr || seclient.IsRejected(err) != tt.rejected {
+				t.Errorf("Apply(delete %s) = %v, хотели err=%v rejected=%v", tt.fileID, err, tt.wantErr, tt.rejected)
+			}
+		})
+	}
+}
+
+// TestRetryDelay проверяет экспоненциальную задержку повтора с ограничением.
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{20, seWriteMaxDelay},
+	}
+	for _, tt := range tests {
+		if got := retryDelay(30*time.Second, tt.attempts); got != tt.want {
+			t.Errorf("retryDelay(30s, %d) = %v, хотели %v", tt.attempts, got, tt.want)
+		}
+	}
+}
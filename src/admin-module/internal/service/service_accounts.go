@@ -1,6 +1,7 @@
 // service_accounts.go — сервис управления Service Accounts.
-// CRUD SA: создание в Keycloak (Client Credentials) + локальная БД,
-// обновление, удаление, ротация секрета.
+// CRUD SA: создание в IdP (Client Credentials) + локальная БД,
+// обновление, удаление, ротация секрета с периодом действия старого секрета,
+// срок действия секретов и приостановка SA с истёкшим секретом.
 package service
 
 import (
@@ -16,58 +17,80 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
-	"github.com/bigkaa/goartstore/admin-module/internal/keycloak"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 )
 
+// maxSecretRotationGrace — максимальный период действия старого секрета после ротации.
+const maxSecretRotationGrace = 720 * time.Hour
+
+// SecretPolicy — политика срока действия секретов SA.
+type SecretPolicy struct {
+	// Lifetime — срок действия секрета (0 — без истечения)
+	Lifetime time.Duration
+	// RotationGrace — период действия старого секрета после ротации по умолчанию
+	RotationGrace time.Duration
+	// ExpiryWarning — за сколько до истечения секрет считается «скоро истекающим»
+	ExpiryWarning time.Duration
+}
+
 // ServiceAccountService — сервис управления Service Accounts.
 type ServiceAccountService struct { //nolint:revive // stuttering допустим — DDD naming
-	kcClient *keycloak.Client
+	provider idp.Provider
 	saRepo   repository.ServiceAccountRepository
+	audit    *AuditService
 	saPrefix string // Префикс client_id (по умолчанию "sa_")
+	secrets  SecretPolicy
 	logger   *slog.Logger
 }
 
 // NewServiceAccountService создаёт сервис Service Accounts.
 func NewServiceAccountService(
-	kcClient *keycloak.Client,
+	provider idp.Provider,
 	saRepo repository.ServiceAccountRepository,
+	audit *AuditService,
 	saPrefix string,
+	secrets SecretPolicy,
 	logger *slog.Logger,
 ) *ServiceAccountService {
 	return &ServiceAccountService{
-		kcClient: kcClient,
+		provider: provider,
 		saRepo:   saRepo,
+		audit:    audit,
 		saPrefix: saPrefix,
+		secrets:  secrets,
 		logger:   logger.With(slog.String("component", "sa_service")),
 	}
 }
 
+// SecretPolicy возвращает политику срока действия секретов.
+func (s *ServiceAccountService) SecretPolicy() SecretPolicy {
+	return s.secrets
+}
+
 // ServiceAccountWithSecret — SA с секретом (возвращается только при создании).
 type ServiceAccountWithSecret struct { //nolint:revive // stuttering допустим — DDD naming
 	*model.ServiceAccount
 	ClientSecret string //nolint:gosec // G117: структура SA с секретом
 }
 
-// Create создаёт новый Service Account: регистрирует в Keycloak + сохраняет в БД.
+// Create создаёт новый Service Account: регистрирует в IdP + сохраняет в БД.
 // Возвращает SA с client_secret (показывается только один раз).
 func (s *ServiceAccountService) Create(ctx context.Context, name, description string, scopes []string) (*ServiceAccountWithSecret, error) {
 	// Генерируем client_id: sa_<name>_<random>
 	clientID := s.generateClientID(name)
 	saID := uuid.New().String()
 
-	// Создаём клиент в Keycloak
-	kcInternalID, err := s.kcClient.CreateClient(ctx, clientID, name, description, scopes)
+	// Создаём клиент в IdP и получаем его секрет
+	kcInternalID, clientSecret, err := s.provider.CreateClient(ctx, &idp.Client{
+		ClientID:    clientID,
+		Name:        name,
+		Description: description,
+		Enabled:     true,
+		Scopes:      scopes,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("создание клиента в Keycloak: %w", err)
-	}
-
-	// Получаем секрет от Keycloak
-	clientSecret, err := s.kcClient.GetClientSecret(ctx, kcInternalID)
-	if err != nil {
-		// Попытка очистки: удаляем созданного клиента в Keycloak
-		_ = s.kcClient.DeleteClient(ctx, kcInternalID)
-		return nil, fmt.Errorf("получение секрета из Keycloak: %w", err)
+		return nil, fmt.Errorf("создание клиента в IdP: %w", err)
 	}
 
 	// Сохраняем в локальную БД
@@ -87,16 +110,27 @@ func (s *ServiceAccountService) Create(ctx context.Context, name, description st
 		Status:           "active",
 		Source:           "local",
 		LastSyncedAt:     &now,
+		SecretIssuedAt:   now,
 	}
 
-	if err := s.saRepo.Create(ctx, sa); err != nil {
-		// Попытка очистки: удаляем клиента из Keycloak
-		_ = s.kcClient.DeleteClient(ctx, kcInternalID)
+	change := &AuditChange{
+		Action:     model.AuditActionSACreate,
+		TargetType: model.AuditTargetServiceAccount,
+		TargetID:   saID,
+		After:      saAuditState(sa),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewServiceAccountRepository(db).Create(ctx, sa)
+	})
+	if err != nil {
+		// Попытка очистки: удаляем клиента из IdP
+		_ = s.provider.DeleteClient(ctx, kcInternalID)
 		if errors.Is(err, repository.ErrConflict) {
 			return nil, fmt.Errorf("%w: SA с именем '%s' уже существует", ErrConflict, name)
 		}
 		return nil, fmt.Errorf("сохранение SA в БД: %w", err)
 	}
+	s.applySecretExpiry(sa)
 
 	s.logger.Info("SA создан",
 		slog.String("sa_id", saID),
@@ -122,6 +156,9 @@ func (s *ServiceAccountService) List(ctx context.Context, status *string, limit,
 		return nil, 0, fmt.Errorf("подсчёт SA: %w", err)
 	}
 
+	for _, sa := range sas {
+		s.applySecretExpiry(sa)
+	}
 	return sas, total, nil
 }
 
@@ -134,10 +171,11 @@ func (s *ServiceAccountService) Get(ctx context.Context, id string) (*model.Serv
 		}
 		return nil, fmt.Errorf("получение SA: %w", err)
 	}
+	s.applySecretExpiry(sa)
 	return sa, nil
 }
 
-// Update обновляет SA: локальную БД + Keycloak.
+// Update обновляет SA: локальную БД + IdP.
 func (s *ServiceAccountService) Update(ctx context.Context, id string, name, description *string, scopes []string, status *string) (*model.ServiceAccount, error) {
 	// Получаем текущий SA из БД
 	sa, err := s.saRepo.GetByID(ctx, id)
@@ -147,6 +185,13 @@ func (s *ServiceAccountService) Update(ctx context.Context, id string, name, des
 		}
 		return nil, fmt.Errorf("получение SA для обновления: %w", err)
 	}
+	before := saAuditState(sa)
+	s.applySecretExpiry(sa)
+
+	// SA с истёкшим секретом нельзя активировать без ротации
+	if status != nil && *status == "active" && sa.Status != "active" && s.secretExpired(sa, time.Now().UTC()) {
+		return nil, fmt.Errorf("%w: секрет SA истёк — выполните ротацию секрета перед активацией", ErrValidation)
+	}
 
 	// Применяем обновления
 	if name != nil {
@@ -162,22 +207,21 @@ func (s *ServiceAccountService) Update(ctx context.Context, id string, name, des
 		sa.Status = *status
 	}
 
-	// Обновляем в Keycloak (если есть keycloak_client_id)
+	// Обновляем в IdP (если есть keycloak_client_id)
 	if sa.KeycloakClientID != nil {
-		kcClient := &keycloak.KeycloakClient{
-			ID:                     *sa.KeycloakClientID,
-			ClientID:               sa.ClientID,
-			Name:                   sa.Name,
-			Enabled:                sa.Status == "active",
-			ServiceAccountsEnabled: true,
-			DefaultClientScopes:    sa.Scopes,
+		client := &idp.Client{
+			ID:       *sa.KeycloakClientID,
+			ClientID: sa.ClientID,
+			Name:     sa.Name,
+			Enabled:  sa.Status == "active",
+			Scopes:   sa.Scopes,
 		}
 		if sa.Description != nil {
-			kcClient.Description = *sa.Description
+			client.Description = *sa.Description
 		}
 
-		if err := s.kcClient.UpdateClient(ctx, *sa.KeycloakClientID, kcClient); err != nil {
-			s.logger.Warn("Ошибка обновления SA в Keycloak",
+		if err := s.provider.UpdateClient(ctx, client); err != nil {
+			s.logger.Warn("Ошибка обновления SA в IdP",
 				slog.String("sa_id", id),
 				slog.String("error", err.Error()),
 			)
@@ -186,7 +230,17 @@ func (s *ServiceAccountService) Update(ctx context.Context, id string, name, des
 	}
 
 	// Обновляем в БД
-	if err := s.saRepo.Update(ctx, sa); err != nil {
+	change := &AuditChange{
+		Action:     model.AuditActionSAUpdate,
+		TargetType: model.AuditTargetServiceAccount,
+		TargetID:   id,
+		Before:     before,
+		After:      saAuditState(sa),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewServiceAccountRepository(db).Update(ctx, sa)
+	})
+	if err != nil {
 		return nil, fmt.Errorf("обновление SA в БД: %w", err)
 	}
 
@@ -197,9 +251,9 @@ func (s *ServiceAccountService) Update(ctx context.Context, id string, name, des
 	return sa, nil
 }
 
-// Delete удаляет SA: из БД + из Keycloak.
+// Delete удаляет SA: из БД + из IdP.
 func (s *ServiceAccountService) Delete(ctx context.Context, id string) error {
-	// Получаем SA для удаления из Keycloak
+	// Получаем SA для удаления из IdP
 	sa, err := s.saRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
@@ -208,10 +262,10 @@ func (s *ServiceAccountService) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("получение SA для удаления: %w", err)
 	}
 
-	// Удаляем из Keycloak (если есть keycloak_client_id)
+	// Удаляем из IdP (если есть keycloak_client_id)
 	if sa.KeycloakClientID != nil {
-		if err := s.kcClient.DeleteClient(ctx, *sa.KeycloakClientID); err != nil {
-			s.logger.Warn("Ошибка удаления SA из Keycloak",
+		if err := s.provider.DeleteClient(ctx, *sa.KeycloakClientID); err != nil {
+			s.logger.Warn("Ошибка удаления SA из IdP",
 				slog.String("sa_id", id),
 				slog.String("kc_client_id", *sa.KeycloakClientID),
 				slog.String("error", err.Error()),
@@ -221,7 +275,16 @@ func (s *ServiceAccountService) Delete(ctx context.Context, id string) error {
 	}
 
 	// Удаляем из БД
-	if err := s.saRepo.Delete(ctx, id); err != nil {
+	change := &AuditChange{
+		Action:     model.AuditActionSADelete,
+		TargetType: model.AuditTargetServiceAccount,
+		TargetID:   id,
+		Before:     saAuditState(sa),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewServiceAccountRepository(db).Delete(ctx, id)
+	})
+	if err != nil {
 		return fmt.Errorf("удаление SA из БД: %w", err)
 	}
 
@@ -233,34 +296,225 @@ func (s *ServiceAccountService) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// RotateSecret генерирует новый секрет SA в Keycloak.
-// Возвращает client_id и новый секрет.
-func (s *ServiceAccountService) RotateSecret(ctx context.Context, id string) (clientID, newSecret string, err error) {
+// SecretRotation — результат ротации секрета SA.
+type SecretRotation struct {
+	// ClientID — идентификатор SA для аутентификации
+	ClientID string
+	// ClientSecret — новый секрет (показывается только один раз)
+	ClientSecret string //nolint:gosec // G117: результат ротации содержит секрет
+	// SecretExpiresAt — срок действия нового секрета (nil — без истечения)
+	SecretExpiresAt *time.Time
+	// PreviousSecretExpiresAt — до этого времени действует старый секрет (nil — недействителен сразу)
+	PreviousSecretExpiresAt *time.Time
+}
+
+// RotateSecret генерирует новый секрет SA в IdP.
+// grace — период действия старого секрета (nil — AM_SA_SECRET_ROTATION_GRACE,
+// 0 — старый секрет недействителен сразу).
+func (s *ServiceAccountService) RotateSecret(ctx context.Context, id string, grace *time.Duration) (*SecretRotation, error) {
+	gracePeriod := s.secrets.RotationGrace
+	if grace != nil {
+		gracePeriod = *grace
+	}
+	if gracePeriod < 0 || gracePeriod > maxSecretRotationGrace {
+		return nil, fmt.Errorf("%w: период действия старого секрета должен быть от 0 до %s",
+			ErrValidation, maxSecretRotationGrace)
+	}
+
 	// Получаем SA
 	sa, err := s.saRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return "", "", ErrNotFound
+			return nil, ErrNotFound
 		}
-		return "", "", fmt.Errorf("получение SA для ротации: %w", err)
+		return nil, fmt.Errorf("получение SA для ротации: %w", err)
 	}
 
 	if sa.KeycloakClientID == nil {
-		return "", "", fmt.Errorf("SA не синхронизирован с Keycloak: отсутствует keycloak_client_id")
+		return nil, fmt.Errorf("SA не синхронизирован с IdP: отсутствует keycloak_client_id")
 	}
 
-	// Регенерируем секрет в Keycloak
-	newSecret, err = s.kcClient.RegenerateClientSecret(ctx, *sa.KeycloakClientID)
+	// Регенерируем секрет в IdP; старый секрет действует до конца периода
+	now := time.Now().UTC()
+	var keepPreviousUntil *time.Time
+	if gracePeriod > 0 {
+		graceEnd := now.Add(gracePeriod)
+		keepPreviousUntil = &graceEnd
+	}
+	newSecret, previousExpiresAt, err := s.provider.RotateClientSecret(ctx, *sa.KeycloakClientID, keepPreviousUntil)
 	if err != nil {
-		return "", "", fmt.Errorf("регенерация секрета в Keycloak: %w", err)
+		return nil, fmt.Errorf("ротация секрета в IdP: %w", err)
+	}
+	if keepPreviousUntil != nil && previousExpiresAt == nil {
+		// Новый секрет уже выдан — старый просто перестаёт действовать
+		s.logger.Warn("Не удалось сохранить старый секрет SA на период ротации",
+			slog.String("sa_id", id),
+		)
+	}
+
+	sa.SecretIssuedAt = now
+	sa.PreviousSecretExpiresAt = previousExpiresAt
+	s.applySecretExpiry(sa)
+	if err := s.saRepo.SetSecretIssued(ctx, id, now, previousExpiresAt); err != nil {
+		s.logger.Error("Ошибка сохранения времени выдачи секрета SA",
+			slog.String("sa_id", id),
+			slog.String("error", err.Error()),
+		)
 	}
 
 	s.logger.Info("Секрет SA ротирован",
 		slog.String("sa_id", id),
 		slog.String("client_id", sa.ClientID),
+		slog.Duration("grace", gracePeriod),
 	)
 
-	return sa.ClientID, newSecret, nil
+	// Секрет уже изменён в IdP — ошибка журнала не отменяет ротацию
+	auditErr := s.audit.Record(ctx, &AuditChange{
+		Action:     model.AuditActionSARotateSecret,
+		TargetType: model.AuditTargetServiceAccount,
+		TargetID:   id,
+		After: map[string]any{
+			"client_id":                  sa.ClientID,
+			"secret_issued_at":           now,
+			"previous_secret_expires_at": previousExpiresAt,
+		},
+	})
+	if auditErr != nil {
+		s.logger.Error("Ошибка записи события аудита ротации секрета",
+			slog.String("sa_id", id),
+			slog.String("error", auditErr.Error()),
+		)
+	}
+
+	return &SecretRotation{
+		ClientID:                sa.ClientID,
+		ClientSecret:            newSecret,
+		SecretExpiresAt:         sa.SecretExpiresAt,
+		PreviousSecretExpiresAt: previousExpiresAt,
+	}, nil
+}
+
+// ListExpiringSecrets возвращает активные SA, секрет которых истекает
+// в течение within (включая уже истёкшие), по возрастанию срока.
+func (s *ServiceAccountService) ListExpiringSecrets(ctx context.Context, within time.Duration) ([]*model.ServiceAccount, error) {
+	return s.activeExpiringBefore(ctx, time.Now().UTC().Add(within))
+}
+
+// SecretsExpiringBefore реализует SecretExpirySource для правил оповещений.
+func (s *ServiceAccountService) SecretsExpiringBefore(ctx context.Context, before time.Time) ([]SecretExpiry, error) {
+	sas, err := s.activeExpiringBefore(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SecretExpiry, 0, len(sas))
+	for _, sa := range sas {
+		result = append(result, SecretExpiry{
+			ServiceAccountID: sa.ID,
+			Name:             sa.Name,
+			ExpiresAt:        *sa.SecretExpiresAt,
+		})
+	}
+	return result, nil
+}
+
+// ExpireSecrets приостанавливает активные SA с истёкшим секретом:
+// отключает client в IdP и меняет статус на suspended.
+// SA, который не удалось отключить в IdP, остаётся активным
+// до следующей проверки. Возвращает число приостановленных SA.
+func (s *ServiceAccountService) ExpireSecrets(ctx context.Context) (int, error) {
+	sas, err := s.activeExpiringBefore(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	suspended := 0
+	for _, sa := range sas {
+		if err := s.suspendExpired(ctx, sa); err != nil {
+			s.logger.Warn("Ошибка приостановки SA с истёкшим секретом",
+				slog.String("sa_id", sa.ID),
+				slog.String("client_id", sa.ClientID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		suspended++
+		s.logger.Info("SA приостановлен: секрет истёк",
+			slog.String("sa_id", sa.ID),
+			slog.String("client_id", sa.ClientID),
+			slog.Time("secret_expires_at", *sa.SecretExpiresAt),
+		)
+	}
+	return suspended, nil
+}
+
+// suspendExpired отключает SA с истёкшим секретом в IdP и локально.
+func (s *ServiceAccountService) suspendExpired(ctx context.Context, sa *model.ServiceAccount) error {
+	if sa.KeycloakClientID != nil {
+		client, err := s.provider.GetClient(ctx, *sa.KeycloakClientID)
+		if err != nil {
+			return fmt.Errorf("получение client из IdP: %w", err)
+		}
+		client.Enabled = false
+		if err := s.provider.UpdateClient(ctx, client); err != nil {
+			return fmt.Errorf("отключение client в IdP: %w", err)
+		}
+	}
+
+	before := saAuditState(sa)
+	sa.Status = "suspended"
+	change := &AuditChange{
+		Action:     model.AuditActionSASecretExpired,
+		TargetType: model.AuditTargetServiceAccount,
+		TargetID:   sa.ID,
+		Before:     before,
+		After:      saAuditState(sa),
+	}
+	return s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewServiceAccountRepository(db).Update(ctx, sa)
+	})
+}
+
+// activeExpiringBefore возвращает активные SA, секрет которых истекает раньше before.
+// При сроке действия 0 секреты не истекают.
+func (s *ServiceAccountService) activeExpiringBefore(ctx context.Context, before time.Time) ([]*model.ServiceAccount, error) {
+	if s.secrets.Lifetime <= 0 {
+		return nil, nil
+	}
+
+	sas, err := s.saRepo.ListActiveSecretIssuedBefore(ctx, before.Add(-s.secrets.Lifetime))
+	if err != nil {
+		return nil, fmt.Errorf("получение SA по сроку действия секрета: %w", err)
+	}
+	for _, sa := range sas {
+		s.applySecretExpiry(sa)
+	}
+	return sas, nil
+}
+
+// applySecretExpiry вычисляет срок действия секрета SA по политике.
+func (s *ServiceAccountService) applySecretExpiry(sa *model.ServiceAccount) {
+	sa.SecretExpiresAt = nil
+	if s.secrets.Lifetime > 0 && !sa.SecretIssuedAt.IsZero() {
+		expiresAt := sa.SecretIssuedAt.Add(s.secrets.Lifetime)
+		sa.SecretExpiresAt = &expiresAt
+	}
+}
+
+// secretExpired проверяет, истёк ли секрет SA к моменту now.
+func (s *ServiceAccountService) secretExpired(sa *model.ServiceAccount, now time.Time) bool {
+	return sa.SecretExpiresAt != nil && !sa.SecretExpiresAt.After(now)
+}
+
+// saAuditState — состояние SA для журнала аудита (без секретов).
+func saAuditState(sa *model.ServiceAccount) map[string]any {
+	return map[string]any{
+		"client_id":   sa.ClientID,
+		"name":        sa.Name,
+		"description": sa.Description,
+		"scopes":      sa.Scopes,
+		"status":      sa.Status,
+	}
 }
 
 // generateClientID генерирует уникальный client_id в формате: sa_<name>_<random>.
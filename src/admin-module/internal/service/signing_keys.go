@@ -0,0 +1,87 @@
+// signing_keys.go — сервис ключей подписи JWT встроенного IdP:
+// состояние набора ключей и ручная ротация. При внешнем IdP (Keycloak)
+// операции возвращают ErrUnsupported — ключами управляет Keycloak.
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+)
+
+// SigningKeyService — сервис ключей подписи JWT.
+type SigningKeyService struct {
+	provider idp.Provider
+	audit    *AuditService
+	logger   *slog.Logger
+}
+
+// NewSigningKeyService создаёт сервис ключей подписи JWT.
+func NewSigningKeyService(provider idp.Provider, audit *AuditService, logger *slog.Logger) *SigningKeyService {
+	return &SigningKeyService{
+		provider: provider,
+		audit:    audit,
+		logger:   logger.With(slog.String("component", "signing_key_service")),
+	}
+}
+
+// Status возвращает состояние ключей подписи.
+func (s *SigningKeyService) Status(ctx context.Context) (*idp.SigningKeyStatus, error) {
+	km, err := s.keyManager()
+	if err != nil {
+		return nil, err
+	}
+	status, err := km.SigningKeyStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("получение ключей подписи: %w", err)
+	}
+	return status, nil
+}
+
+// Rotate создаёт новый ключ подписи и возвращает обновлённое состояние.
+// immediate — новый ключ подписывает токены сразу, без публикации заранее.
+func (s *SigningKeyService) Rotate(ctx context.Context, immediate bool) (*idp.SigningKeyStatus, error) {
+	km, err := s.keyManager()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := km.RotateSigningKey(ctx, immediate)
+	if err != nil {
+		return nil, fmt.Errorf("ротация ключа подписи: %w", err)
+	}
+
+	// Ключ уже создан — ошибка аудита только логируется
+	if err := s.audit.Record(ctx, &AuditChange{
+		Action:     model.AuditActionSigningKeyRotate,
+		TargetType: model.AuditTargetSigningKey,
+		TargetID:   key.KID,
+		After: map[string]any{
+			"algorithm":    key.Algorithm,
+			"activates_at": key.ActivatesAt.UTC().Format(time.RFC3339),
+			"immediate":    immediate,
+		},
+	}); err != nil {
+		s.logger.Error("Ошибка записи аудита ротации ключа подписи",
+			slog.String("kid", key.KID),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return km.SigningKeyStatus(ctx)
+}
+
+// keyManager возвращает управление ключами текущего IdP
+// или ErrUnsupported, если IdP внешний.
+func (s *SigningKeyService) keyManager() (idp.KeyManager, error) {
+	km, ok := s.provider.(idp.KeyManager)
+	if !ok {
+		return nil, fmt.Errorf("%w: ключами подписи JWT управляет %s",
+			ErrUnsupported, s.provider.Name())
+	}
+	return km, nil
+}
@@ -1,5 +1,5 @@
 // storage_elements.go — сервис управления Storage Elements.
-// CRUD SE: discover, регистрация, обновление, удаление.
+// CRUD SE: discover, регистрация, обновление, удаление, метки.
 // Sync делегирует синхронизацию StorageSyncService (Phase 5).
 package service
 
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,11 +19,21 @@ import (
 	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
 )
 
+// reLabelKey — допустимый ключ метки SE (1-63 символа).
+var reLabelKey = regexp.MustCompile(`^[a-z0-9]([a-z0-9._/-]{0,61}[a-z0-9])?$`)
+
+// Ограничения меток SE.
+const (
+	maxSELabels         = 32
+	maxSELabelValueSize = 255
+)
+
 // StorageElementService — сервис управления Storage Elements.
 type StorageElementService struct {
 	seClient     *seclient.Client
 	seRepo       repository.StorageElementRepository
 	fileRepo     repository.FileRegistryRepository
+	audit        *AuditService
 	syncSvc      *StorageSyncService
 	dephealthSvc *DephealthService
 	logger       *slog.Logger
@@ -32,12 +44,14 @@ func NewStorageElementService(
 	seClient *seclient.Client,
 	seRepo repository.StorageElementRepository,
 	fileRepo repository.FileRegistryRepository,
+	audit *AuditService,
 	logger *slog.Logger,
 ) *StorageElementService {
 	return &StorageElementService{
 		seClient: seClient,
 		seRepo:   seRepo,
 		fileRepo: fileRepo,
+		audit:    audit,
 		logger:   logger.With(slog.String("component", "se_service")),
 	}
 }
@@ -91,7 +105,11 @@ func (s *StorageElementService) Discover(ctx context.Context, url string) (*Disc
 }
 
 // Create регистрирует новый SE: discover + сохранение в БД + полная синхронизация файлов.
-func (s *StorageElementService) Create(ctx context.Context, name, url string) (*model.StorageElement, error) {
+func (s *StorageElementService) Create(ctx context.Context, name, url string, labels map[string]string) (*model.StorageElement, error) {
+	if err := ValidateLabels(labels); err != nil {
+		return nil, err
+	}
+
 	// Предпросмотр SE
 	info, err := s.seClient.Info(ctx, url)
 	if err != nil {
@@ -108,6 +126,7 @@ func (s *StorageElementService) Create(ctx context.Context, name, url string) (*
 		StorageID:  info.StorageID,
 		Mode:       info.Mode,
 		Status:     info.Status,
+		Labels:     labels,
 		LastSyncAt: &now,
 	}
 
@@ -119,7 +138,20 @@ func (s *StorageElementService) Create(ctx context.Context, name, url string) (*
 	}
 
 	// Сохраняем в БД
-	if err := s.seRepo.Create(ctx, se); err != nil {
+	change := &AuditChange{
+		Action:     model.AuditActionSECreate,
+		TargetType: model.AuditTargetStorageElement,
+		TargetID:   seID,
+		After:      seAuditState(se),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		repo := repository.NewStorageElementRepository(db)
+		if err := repo.Create(ctx, se); err != nil {
+			return err
+		}
+		return repo.SetLabels(ctx, seID, labels)
+	})
+	if err != nil {
 		if errors.Is(err, repository.ErrConflict) {
 			return nil, fmt.Errorf("%w: SE с URL '%s' уже зарегистрирован", ErrConflict, url)
 		}
@@ -146,34 +178,25 @@ func (s *StorageElementService) Create(ctx context.Context, name, url string) (*
 
 	// Запуск полной синхронизации файлов в фоне (не блокируем Create)
 	if s.syncSvc != nil {
-		go func() {
-			syncCtx := context.Background()
-			result, syncErr := s.syncSvc.SyncOne(syncCtx, seID)
-			if syncErr != nil {
-				s.logger.Warn("Ошибка полной синхронизации при создании SE",
-					slog.String("se_id", seID),
-					slog.String("error", syncErr.Error()),
-				)
-			} else {
-				s.logger.Info("Полная синхронизация при создании SE завершена",
-					slog.String("se_id", seID),
-					slog.Int("files_added", result.FilesAdded),
-				)
-			}
-		}()
+		if _, syncErr := s.syncSvc.StartRun(ctx, seID, model.SyncTriggerRegistration); syncErr != nil {
+			s.logger.Warn("Не удалось запустить полную синхронизацию при создании SE",
+				slog.String("se_id", seID),
+				slog.String("error", syncErr.Error()),
+			)
+		}
 	}
 
 	return se, nil
 }
 
 // List возвращает список SE с фильтрацией и пагинацией.
-func (s *StorageElementService) List(ctx context.Context, mode, status *string, limit, offset int) ([]*model.StorageElement, int, error) {
-	ses, err := s.seRepo.List(ctx, mode, status, limit, offset)
+func (s *StorageElementService) List(ctx context.Context, filters repository.StorageElementFilters, limit, offset int) ([]*model.StorageElement, int, error) {
+	ses, err := s.seRepo.List(ctx, filters, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("получение списка SE: %w", err)
 	}
 
-	total, err := s.seRepo.Count(ctx, mode, status)
+	total, err := s.seRepo.Count(ctx, filters)
 	if err != nil {
 		return nil, 0, fmt.Errorf("подсчёт SE: %w", err)
 	}
@@ -193,8 +216,36 @@ func (s *StorageElementService) Get(ctx context.Context, id string) (*model.Stor
 	return se, nil
 }
 
-// Update обновляет SE (name, url). Mode/status/capacity обновляются через sync.
-func (s *StorageElementService) Update(ctx context.Context, id string, name, url *string) (*model.StorageElement, error) {
+// GetFile возвращает файл из attr.json на SE в виде записи реестра.
+// ErrNotFound — SE не найден, ErrSEUnavailable — SE не вернул метаданные файла.
+func (s *StorageElementService) GetFile(ctx context.Context, seID, fileID string) (*model.FileRecord, error) {
+	se, err := s.Get(ctx, seID)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := s.seClient.GetFile(ctx, se.URL, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSEUnavailable, err)
+	}
+	return seFileToRecord(*meta, se.ID)
+}
+
+// ListLabelPairs возвращает используемые пары метка=значение (для фильтров UI).
+func (s *StorageElementService) ListLabelPairs(ctx context.Context) ([]string, error) {
+	pairs, err := s.seRepo.ListLabelPairs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("получение меток SE: %w", err)
+	}
+	return pairs, nil
+}
+
+// Update обновляет SE (name, url, метки). Mode/status/capacity обновляются через sync.
+// labels == nil — метки не меняются, пустой map — метки удаляются.
+func (s *StorageElementService) Update(ctx context.Context, id string, name, url *string, labels map[string]string) (*model.StorageElement, error) {
+	if err := ValidateLabels(labels); err != nil {
+		return nil, err
+	}
+
 	// Получаем текущий SE
 	se, err := s.seRepo.GetByID(ctx, id)
 	if err != nil {
@@ -204,9 +255,10 @@ func (s *StorageElementService) Update(ctx context.Context, id string, name, url
 		return nil, fmt.Errorf("получение SE для обновления: %w", err)
 	}
 
-	// Сохраняем старые значения для dephealth update
+	// Сохраняем старые значения для dephealth update и аудита
 	oldName := se.Name
 	oldURL := se.URL
+	before := seAuditState(se)
 
 	// Применяем обновления
 	if name != nil {
@@ -215,9 +267,29 @@ func (s *StorageElementService) Update(ctx context.Context, id string, name, url
 	if url != nil {
 		se.URL = *url
 	}
+	if labels != nil {
+		se.Labels = labels
+	}
 
 	// Обновляем в БД
-	if err := s.seRepo.Update(ctx, se); err != nil {
+	change := &AuditChange{
+		Action:     model.AuditActionSEUpdate,
+		TargetType: model.AuditTargetStorageElement,
+		TargetID:   id,
+		Before:     before,
+		After:      seAuditState(se),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		repo := repository.NewStorageElementRepository(db)
+		if err := repo.Update(ctx, se); err != nil {
+			return err
+		}
+		if labels == nil {
+			return nil
+		}
+		return repo.SetLabels(ctx, id, labels)
+	})
+	if err != nil {
 		if errors.Is(err, repository.ErrConflict) {
 			return nil, fmt.Errorf("%w: URL или storage_id уже зарегистрирован", ErrConflict)
 		}
@@ -243,16 +315,26 @@ func (s *StorageElementService) Update(ctx context.Context, id string, name, url
 
 // Delete удаляет SE из реестра. Физические файлы не удаляются.
 func (s *StorageElementService) Delete(ctx context.Context, id string) error {
-	// Получаем SE для dephealth (name, URL) перед удалением
-	var seName, seURL string
-	if s.dephealthSvc != nil {
-		if se, getErr := s.seRepo.GetByID(ctx, id); getErr == nil {
-			seName = se.Name
-			seURL = se.URL
+	// Получаем SE для аудита и dephealth (name, URL) перед удалением
+	se, err := s.seRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
 		}
+		return fmt.Errorf("получение SE для удаления: %w", err)
 	}
+	seName, seURL := se.Name, se.URL
 
-	if err := s.seRepo.Delete(ctx, id); err != nil {
+	change := &AuditChange{
+		Action:     model.AuditActionSEDelete,
+		TargetType: model.AuditTargetStorageElement,
+		TargetID:   id,
+		Before:     seAuditState(se),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewStorageElementRepository(db).Delete(ctx, id)
+	})
+	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrNotFound
 		}
@@ -277,28 +359,100 @@ func (s *StorageElementService) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// Sync выполняет полную синхронизацию SE (info + файлы).
-// Делегирует StorageSyncService.SyncOne для полной синхронизации.
-func (s *StorageElementService) Sync(ctx context.Context, id string) (*model.SyncResult, error) {
-	// Проверяем существование SE
-	_, err := s.seRepo.GetByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return nil, ErrNotFound
+// seAuditState — состояние SE для журнала аудита.
+func seAuditState(se *model.StorageElement) map[string]any {
+	return map[string]any{
+		"name":       se.Name,
+		"url":        se.URL,
+		"storage_id": se.StorageID,
+		"mode":       se.Mode,
+		"labels":     se.Labels,
+	}
+}
+
+// ValidateLabels проверяет метки SE: ключ — строчные латинские буквы, цифры,
+// '.', '_', '-', '/' (до 63 символов), значение — непустое, до 255 символов.
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > maxSELabels {
+		return fmt.Errorf("%w: не более %d меток у SE", ErrValidation, maxSELabels)
+	}
+	for k, v := range labels {
+		if !reLabelKey.MatchString(k) {
+			return fmt.Errorf("%w: недопустимый ключ метки %q", ErrValidation, k)
+		}
+		if v == "" || len(v) > maxSELabelValueSize {
+			return fmt.Errorf("%w: значение метки %q должно быть от 1 до %d символов", ErrValidation, k, maxSELabelValueSize)
+		}
+	}
+	return nil
+}
+
+// ParseLabels разбирает метки из пар "ключ=значение". Пустые элементы пропускаются.
+func ParseLabels(pairs []string) (map[string]string, error) {
+	labels := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: метка %q должна иметь вид ключ=значение", ErrValidation, pair)
 		}
-		return nil, fmt.Errorf("получение SE для sync: %w", err)
+		labels[strings.TrimSpace(k)] = strings.TrimSpace(v)
 	}
+	if err := ValidateLabels(labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
 
-	// Делегируем полную синхронизацию StorageSyncService
+// Sync запускает фоновую синхронизацию SE (info + файлы) и возвращает задачу.
+// Если синхронизация SE уже выполняется — возвращает её и ErrConflict.
+func (s *StorageElementService) Sync(ctx context.Context, id string) (*model.SyncRun, error) {
 	if s.syncSvc == nil {
 		return nil, fmt.Errorf("сервис синхронизации не инициализирован")
 	}
+	return s.syncSvc.StartRun(ctx, id, model.SyncTriggerManual)
+}
 
-	result, err := s.syncSvc.SyncOne(ctx, id)
-	if err != nil {
-		// Оборачиваем ошибку SE unavailable
-		return nil, fmt.Errorf("синхронизация SE: %w", err)
+// SyncAll запускает фоновую синхронизацию всех SE со статусом online.
+func (s *StorageElementService) SyncAll(ctx context.Context) ([]*model.SyncRun, error) {
+	if s.syncSvc == nil {
+		return nil, fmt.Errorf("сервис синхронизации не инициализирован")
 	}
+	return s.syncSvc.StartAll(ctx, model.SyncTriggerManual)
+}
 
-	return result, nil
+// GetSyncRun возвращает задачу синхронизации по ID.
+func (s *StorageElementService) GetSyncRun(ctx context.Context, runID string) (*model.SyncRun, error) {
+	if s.syncSvc == nil {
+		return nil, fmt.Errorf("сервис синхронизации не инициализирован")
+	}
+	return s.syncSvc.GetRun(ctx, runID)
+}
+
+// ListSyncRuns возвращает историю задач синхронизации.
+func (s *StorageElementService) ListSyncRuns(ctx context.Context, filters repository.SyncRunFilters, limit, offset int) ([]*model.SyncRun, int, error) {
+	if s.syncSvc == nil {
+		return nil, 0, fmt.Errorf("сервис синхронизации не инициализирован")
+	}
+	return s.syncSvc.ListRuns(ctx, filters, limit, offset)
+}
+
+// CancelSyncRun отменяет выполняющуюся задачу синхронизации.
+func (s *StorageElementService) CancelSyncRun(ctx context.Context, runID string) (*model.SyncRun, error) {
+	if s.syncSvc == nil {
+		return nil, fmt.Errorf("сервис синхронизации не инициализирован")
+	}
+	return s.syncSvc.CancelRun(ctx, runID)
+}
+
+// SubscribeSyncRuns подписывает на изменения задач синхронизации (для SSE).
+// Без сервиса синхронизации возвращает nil-канал.
+func (s *StorageElementService) SubscribeSyncRuns() (<-chan model.SyncRun, func()) {
+	if s.syncSvc == nil {
+		return nil, func() {}
+	}
+	return s.syncSvc.Subscribe()
 }
@@ -3,11 +3,26 @@
 // StorageSyncService запускает фоновую горутину с ticker (AM_SYNC_INTERVAL),
 // которая обходит все SE со статусом online и синхронизирует файловый реестр.
 //
-// SyncOne выполняет полную синхронизацию одного SE:
+// Каждая синхронизация SE — фоновая задача (таблица sync_runs) с источником
+// запуска (periodic, manual, registration), счётчиками, ошибкой и длительностью.
+// Прогресс задач сохраняется после каждой страницы файлов и рассылается
+// подписчикам (SSE Admin UI); задачу можно отменить (CancelRun).
+//
+// Синхронизация инкрементальная: для каждого SE хранится курсор
+// (sync_checkpoints) — максимальный updated_at метаданных на SE. Периодическая
+// задача запрашивает только файлы, изменённые с курсора, и записывает только
+// фактически изменившиеся записи реестра. Полная синхронизация (ручная, при
+// регистрации, без курсора или раз в AM_SYNC_FULL_INTERVAL) дополнительно ищет
+// удалённые файлы: ID найденных файлов пишутся в staging-таблицу
+// sync_seen_files, разность множеств вычисляется в PostgreSQL.
+//
+// syncOne синхронизирует один SE:
 //  1. GET /api/v1/info → обновить mode/status/capacity в БД
-//  2. Постраничный GET /api/v1/files → batch upsert в file_registry
-//  3. Пометить отсутствующие файлы как deleted
-//  4. Обновить last_sync_at, last_file_sync_at
+//  2. Постраничный GET /api/v1/files[?updated_since=курсор] → upsert в file_registry,
+//     подтверждение найденных реплик; full — запись ID в sync_seen_files
+//  3. full: пометить отсутствующие файлы как deleted, удалить потерянные реплики
+//  4. Сохранить курсор, обновить last_sync_at, last_file_sync_at
+//  5. Записать снимок ёмкости SE (se_capacity_snapshots) для прогноза заполнения
 //
 // Prometheus-метрики:
 //   - admin_module_sync_duration_seconds — длительность синхронизации
@@ -16,14 +31,17 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
@@ -40,19 +58,64 @@ var (
 	syncFilesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "admin_module_sync_files_total",
 		Help: "Количество обработанных файлов при синхронизации",
-	}, []string{"se_id", "operation"}) // operation: added, updated, deleted
+	}, []string{"se_id", "operation"}) // operation: added, updated, deleted, replica_confirmed, replica_lost
+)
+
+// maxConcurrentSyncs — максимум одновременно выполняемых задач синхронизации.
+const maxConcurrentSyncs = 5
+
+// syncProgressBuffer — размер буфера канала подписчика на прогресс синхронизации.
+const syncProgressBuffer = 32
+
+// syncCursorOverlap — перекрытие курсора инкрементальной синхронизации.
+// Файлы, изменённые на SE незадолго до листинга, могут попасть в индекс SE
+// позже файлов с бо́льшим updated_at; повторно обработанные неизменившиеся
+// файлы не перезаписываются.
+const syncCursorOverlap = time.Minute
+
+// Причины отмены задачи синхронизации (сохраняются в sync_runs.error).
+const (
+	syncCancelledByUser     = "отменено пользователем"
+	syncCancelledByShutdown = "прервано остановкой Admin Module"
+	syncInterruptedOnStart  = "прервано перезапуском Admin Module"
 )
 
+// activeSyncRun — выполняющаяся задача синхронизации.
+type activeSyncRun struct {
+	run          *model.SyncRun
+	cancel       context.CancelFunc
+	cancelReason string
+	done         chan struct{}
+}
+
 // StorageSyncService — фоновый сервис синхронизации файлового реестра.
+// Каждая синхронизация SE выполняется как задача (sync_runs) в отдельной
+// горутине; одновременно для одного SE выполняется не более одной задачи.
 type StorageSyncService struct {
 	seClient      *seclient.Client
 	seRepo        repository.StorageElementRepository
 	fileRepo      repository.FileRegistryRepository
+	replicaRepo   repository.FileReplicaRepository
 	syncStateRepo repository.SyncStateRepository
+	runRepo       repository.SyncRunRepository
+	cpRepo        repository.SyncCheckpointRepository
+	capacitySvc   *CapacityService
 	pageSize      int
 	interval      time.Duration
+	fullInterval  time.Duration
 	logger        *slog.Logger
 
+	// runCtx — родительский контекст задач; отменяется в Stop
+	runCtx    context.Context
+	runCancel context.CancelFunc
+	sem       chan struct{}
+	runs      sync.WaitGroup
+
+	mu          sync.Mutex
+	active      map[string]*activeSyncRun // run ID → задача
+	activeBySE  map[string]string         // SE ID → run ID
+	subscribers map[chan model.SyncRun]struct{}
+
 	cancel context.CancelFunc
 	done   chan struct{}
 }
@@ -62,25 +125,55 @@ func NewStorageSyncService(
 	seClient *seclient.Client,
 	seRepo repository.StorageElementRepository,
 	fileRepo repository.FileRegistryRepository,
+	replicaRepo repository.FileReplicaRepository,
 	syncStateRepo repository.SyncStateRepository,
+	runRepo repository.SyncRunRepository,
+	cpRepo repository.SyncCheckpointRepository,
 	pageSize int,
-	interval time.Duration,
+	interval, fullInterval time.Duration,
 	logger *slog.Logger,
 ) *StorageSyncService {
+	runCtx, runCancel := context.WithCancel(context.Background())
 	return &StorageSyncService{
 		seClient:      seClient,
 		seRepo:        seRepo,
 		fileRepo:      fileRepo,
+		replicaRepo:   replicaRepo,
 		syncStateRepo: syncStateRepo,
+		runRepo:       runRepo,
+		cpRepo:        cpRepo,
 		pageSize:      pageSize,
 		interval:      interval,
+		fullInterval:  fullInterval,
 		logger:        logger.With(slog.String("component", "storage_sync")),
+		runCtx:        runCtx,
+		runCancel:     runCancel,
+		sem:           make(chan struct{}, maxConcurrentSyncs),
+		active:        make(map[string]*activeSyncRun),
+		activeBySE:    make(map[string]string),
+		subscribers:   make(map[chan model.SyncRun]struct{}),
 	}
 }
 
+// SetCapacityService устанавливает сервис истории ёмкости.
+// Если nil — снимки ёмкости SE при синхронизации не записываются.
+func (s *StorageSyncService) SetCapacityService(capacitySvc *CapacityService) {
+	s.capacitySvc = capacitySvc
+}
+
 // Start запускает фоновую горутину с периодической синхронизацией.
-// Вызывается один раз при старте приложения.
+// Вызывается один раз при старте приложения. Задачи, оставшиеся
+// в состоянии queued/running после прошлого запуска, помечаются failed.
 func (s *StorageSyncService) Start(ctx context.Context) {
+	if n, err := s.runRepo.FailUnfinished(ctx, syncInterruptedOnStart); err != nil {
+		s.logger.Warn("Ошибка завершения прерванных задач синхронизации", slog.String("error", err.Error()))
+	} else if n > 0 {
+		s.logger.Warn("Прерванные задачи синхронизации помечены как failed", slog.Int("count", n))
+	}
+	if err := s.cpRepo.PurgeSeen(ctx); err != nil {
+		s.logger.Warn("Ошибка очистки staging синхронизации", slog.String("error", err.Error()))
+	}
+
 	ctx, s.cancel = context.WithCancel(ctx)
 	s.done = make(chan struct{})
 
@@ -89,6 +182,7 @@ func (s *StorageSyncService) Start(ctx context.Context) {
 
 		s.logger.Info("Периодическая синхронизация файлового реестра запущена",
 			slog.String("interval", s.interval.String()),
+			slog.String("full_interval", s.fullInterval.String()),
 			slog.Int("page_size", s.pageSize),
 		)
 
@@ -102,12 +196,12 @@ func (s *StorageSyncService) Start(ctx context.Context) {
 				return
 			case <-ticker.C:
 				s.logger.Info("Запуск периодической синхронизации всех SE")
-				results, err := s.SyncAll(ctx)
+				runs, err := s.SyncAll(ctx, model.SyncTriggerPeriodic)
 				if err != nil {
 					s.logger.Error("Ошибка периодической синхронизации", slog.String("error", err.Error()))
 				} else {
 					s.logger.Info("Периодическая синхронизация завершена",
-						slog.Int("se_count", len(results)),
+						slog.Int("se_count", len(runs)),
 					)
 				}
 			}
@@ -115,7 +209,8 @@ func (s *StorageSyncService) Start(ctx context.Context) {
 	}()
 }
 
-// Stop останавливает фоновую горутину и ждёт завершения.
+// Stop останавливает фоновую горутину, отменяет выполняющиеся задачи
+// и ждёт их завершения.
 func (s *StorageSyncService) Stop() {
 	if s.cancel != nil {
 		s.cancel()
@@ -123,14 +218,134 @@ func (s *StorageSyncService) Stop() {
 	if s.done != nil {
 		<-s.done
 	}
+
+	s.mu.Lock()
+	for _, a := range s.active {
+		a.cancelReason = syncCancelledByShutdown
+	}
+	s.mu.Unlock()
+	s.runCancel()
+	s.runs.Wait()
+}
+
+// StartRun ставит в очередь задачу синхронизации SE и сразу возвращает её.
+// Задача выполняется в фоне; прогресс сохраняется в sync_runs и рассылается
+// подписчикам (Subscribe). Если для SE уже выполняется задача, возвращается
+// она же вместе с ошибкой ErrConflict.
+func (s *StorageSyncService) StartRun(ctx context.Context, seID, trigger string) (*model.SyncRun, error) {
+	if _, err := s.seRepo.GetByID(ctx, seID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение SE для sync: %w", err)
+	}
+
+	mode, err := s.syncMode(ctx, seID, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &model.SyncRun{
+		ID:               uuid.New().String(),
+		StorageElementID: seID,
+		Trigger:          trigger,
+		Mode:             mode,
+		Status:           model.SyncRunQueued,
+		RequestedBy:      requestedBy(ctx),
+	}
+
+	// Резервируем SE до записи в БД, чтобы не запустить две задачи параллельно
+	s.mu.Lock()
+	if runID, ok := s.activeBySE[seID]; ok {
+		s.mu.Unlock()
+		existing, err := s.GetRun(ctx, runID)
+		if err != nil {
+			return nil, err
+		}
+		return existing, fmt.Errorf("%w: синхронизация SE уже выполняется (задача %s)", ErrConflict, runID)
+	}
+	runCtx, cancel := context.WithCancel(s.runCtx)
+	a := &activeSyncRun{run: run, cancel: cancel, done: make(chan struct{})}
+	s.active[run.ID] = a
+	s.activeBySE[seID] = run.ID
+	s.mu.Unlock()
+
+	if err := s.runRepo.Create(ctx, run); err != nil {
+		s.release(a)
+		cancel()
+		return nil, fmt.Errorf("создание задачи синхронизации: %w", err)
+	}
+
+	// Снимок берётся до запуска: execute изменяет run в своей горутине
+	snapshot := *run
+	s.runs.Add(1)
+	go s.execute(runCtx, a)
+
+	return &snapshot, nil
 }
 
-// SyncAll синхронизирует все SE со статусом online.
-// Каждый SE обрабатывается параллельно (до 5 одновременно).
-func (s *StorageSyncService) SyncAll(ctx context.Context) ([]*model.SyncResult, error) {
-	// Получаем все SE со статусом online (без лимита)
+// syncMode выбирает режим синхронизации: инкрементальный — только для
+// периодической задачи при наличии курсора и недавней полной синхронизации.
+func (s *StorageSyncService) syncMode(ctx context.Context, seID, trigger string) (string, error) {
+	if trigger != model.SyncTriggerPeriodic {
+		return model.SyncModeFull, nil
+	}
+
+	cp, err := s.cpRepo.Get(ctx, seID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.SyncModeFull, nil
+		}
+		return "", fmt.Errorf("получение курсора синхронизации: %w", err)
+	}
+	if cp.FilesCursor == nil || cp.LastFullSyncAt == nil ||
+		time.Since(*cp.LastFullSyncAt) >= s.fullInterval {
+		return model.SyncModeFull, nil
+	}
+	return model.SyncModeIncremental, nil
+}
+
+// SyncAll запускает синхронизацию всех SE со статусом online и ждёт
+// завершения задач. SE, для которых синхронизация уже выполняется, пропускаются.
+func (s *StorageSyncService) SyncAll(ctx context.Context, trigger string) ([]*model.SyncRun, error) {
+	runs, err := s.StartAll(ctx, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*model.SyncRun, 0, len(runs))
+	for _, run := range runs {
+		s.wait(ctx, run.ID)
+		finished, getErr := s.runRepo.GetByID(context.WithoutCancel(ctx), run.ID)
+		if getErr != nil {
+			s.logger.Warn("Ошибка получения результата синхронизации",
+				slog.String("run_id", run.ID),
+				slog.String("error", getErr.Error()),
+			)
+			continue
+		}
+		if finished.Status != model.SyncRunSucceeded && finished.Error != nil {
+			s.logger.Warn("Ошибка синхронизации SE",
+				slog.String("se_id", finished.StorageElementID),
+				slog.String("error", *finished.Error),
+			)
+		}
+		results = append(results, finished)
+	}
+
+	// Обновляем глобальное время синхронизации файлов
+	if err := s.syncStateRepo.UpdateFileSyncAt(context.WithoutCancel(ctx), time.Now().UTC()); err != nil {
+		s.logger.Warn("Ошибка обновления last_file_sync_at", slog.String("error", err.Error()))
+	}
+
+	return results, nil
+}
+
+// StartAll ставит в очередь синхронизацию всех SE со статусом online
+// и возвращает созданные задачи, не дожидаясь их выполнения.
+func (s *StorageSyncService) StartAll(ctx context.Context, trigger string) ([]*model.SyncRun, error) {
 	onlineStatus := "online"
-	ses, err := s.seRepo.List(ctx, nil, &onlineStatus, 1000, 0)
+	ses, err := s.seRepo.List(ctx, repository.StorageElementFilters{Status: &onlineStatus}, 1000, 0)
 	if err != nil {
 		return nil, fmt.Errorf("получение списка SE для sync: %w", err)
 	}
@@ -142,67 +357,274 @@ func (s *StorageSyncService) SyncAll(ctx context.Context) ([]*model.SyncResult,
 
 	s.logger.Info("Синхронизация файлового реестра",
 		slog.Int("se_count", len(ses)),
+		slog.String("trigger", trigger),
 	)
 
-	// Параллельная синхронизация с ограничением concurrency
-	const maxConcurrency = 5
-	sem := make(chan struct{}, maxConcurrency)
+	runs := make([]*model.SyncRun, 0, len(ses))
+	for _, se := range ses {
+		run, err := s.StartRun(ctx, se.ID, trigger)
+		if err != nil {
+			s.logger.Info("Синхронизация SE не запущена",
+				slog.String("se_id", se.ID),
+				slog.String("reason", err.Error()),
+			)
+			continue
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
 
-	var mu sync.Mutex
-	var results []*model.SyncResult
-	var syncErrors []error
+// CancelRun отменяет выполняющуюся задачу и ждёт её остановки.
+// Возвращает ErrNotFound для неизвестной задачи и ErrConflict для завершённой.
+func (s *StorageSyncService) CancelRun(ctx context.Context, runID string) (*model.SyncRun, error) {
+	s.mu.Lock()
+	a, ok := s.active[runID]
+	if ok {
+		a.cancelReason = syncCancelledByUser
+		a.cancel()
+	}
+	s.mu.Unlock()
 
-	var wg sync.WaitGroup
-	for _, se := range ses {
-		wg.Add(1)
-		go func(seID string) {
-			defer wg.Done()
+	if ok {
+		s.logger.Info("Отмена синхронизации SE",
+			slog.String("run_id", runID),
+			slog.String("se_id", a.run.StorageElementID),
+		)
+		s.wait(ctx, runID)
+	}
 
-			// Ограничение concurrency
-			sem <- struct{}{}
-			defer func() { <-sem }()
+	run, err := s.GetRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok && run.Finished() {
+		return run, fmt.Errorf("%w: задача синхронизации уже завершена", ErrConflict)
+	}
+	return run, nil
+}
 
-			result, err := s.SyncOne(ctx, seID)
-			mu.Lock()
-			defer mu.Unlock()
-			if err != nil {
-				syncErrors = append(syncErrors, fmt.Errorf("SE %s: %w", seID, err))
-			} else {
-				results = append(results, result)
-			}
-		}(se.ID)
+// GetRun возвращает задачу синхронизации по ID.
+func (s *StorageSyncService) GetRun(ctx context.Context, runID string) (*model.SyncRun, error) {
+	run, err := s.runRepo.GetByID(ctx, runID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение задачи синхронизации: %w", err)
 	}
-	wg.Wait()
+	return run, nil
+}
 
-	// Обновляем глобальное время синхронизации файлов
-	now := time.Now().UTC()
-	if err := s.syncStateRepo.UpdateFileSyncAt(ctx, now); err != nil {
-		s.logger.Warn("Ошибка обновления last_file_sync_at", slog.String("error", err.Error()))
+// ListRuns возвращает историю задач синхронизации с фильтрацией и пагинацией.
+func (s *StorageSyncService) ListRuns(ctx context.Context, filters repository.SyncRunFilters, limit, offset int) ([]*model.SyncRun, int, error) {
+	runs, err := s.runRepo.List(ctx, filters, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение задач синхронизации: %w", err)
 	}
 
-	// Логируем ошибки отдельных SE
-	for _, syncErr := range syncErrors {
-		s.logger.Warn("Ошибка синхронизации SE", slog.String("error", syncErr.Error()))
+	total, err := s.runRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчёт задач синхронизации: %w", err)
 	}
 
-	return results, nil
+	return runs, total, nil
+}
+
+// Subscribe подписывает на изменения задач синхронизации (старт, прогресс,
+// завершение). Медленный подписчик пропускает события, а не блокирует задачи.
+// Возвращённую функцию отписки нужно вызвать при отключении клиента.
+func (s *StorageSyncService) Subscribe() (<-chan model.SyncRun, func()) {
+	ch := make(chan model.SyncRun, syncProgressBuffer)
+
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.mu.Unlock()
+
+	return ch, func() {
+		s.mu.Lock()
+		delete(s.subscribers, ch)
+		s.mu.Unlock()
+	}
 }
 
-// SyncOne выполняет полную синхронизацию одного SE:
-// 1. Запрос актуальной информации (mode, status, capacity)
-// 2. Постраничная загрузка списка файлов
-// 3. Batch upsert в файловый реестр
-// 4. Пометка отсутствующих файлов как deleted
-// 5. Обновление timestamps
-func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.SyncResult, error) {
+// execute выполняет задачу: ожидает слот (не более maxConcurrentSyncs задач),
+// синхронизирует SE и сохраняет итог.
+func (s *StorageSyncService) execute(ctx context.Context, a *activeSyncRun) {
+	defer s.runs.Done()
+	defer close(a.done)
+	defer s.release(a)
+
+	run := a.run
+	// Сохранение состояния не должно прерываться отменой задачи
+	saveCtx := context.WithoutCancel(ctx)
+	s.publish(run)
+
+	select {
+	case s.sem <- struct{}{}:
+		defer func() { <-s.sem }()
+	case <-ctx.Done():
+		s.finish(saveCtx, a, nil, ctx.Err())
+		return
+	}
+
 	startedAt := time.Now().UTC()
+	run.Status = model.SyncRunRunning
+	run.StartedAt = &startedAt
+	s.save(saveCtx, run)
+
+	result, err := s.syncOne(ctx, run.StorageElementID, run.ID, run.Mode, func(p *model.SyncResult, processed int) {
+		run.FilesOnSE = p.FilesOnSE
+		run.FilesProcessed = processed
+		run.FilesAdded = p.FilesAdded
+		run.FilesUpdated = p.FilesUpdated
+		s.save(saveCtx, run)
+	})
+	if err != nil && ctx.Err() != nil {
+		// Ошибка SE-клиента из-за отмены контекста — это отмена задачи
+		err = ctx.Err()
+	}
+	s.finish(saveCtx, a, result, err)
+}
+
+// finish сохраняет итог задачи: succeeded, failed или cancelled.
+func (s *StorageSyncService) finish(ctx context.Context, a *activeSyncRun, result *model.SyncResult, err error) {
+	run := a.run
+	completedAt := time.Now().UTC()
+	run.CompletedAt = &completedAt
+	if run.StartedAt != nil {
+		durationMs := completedAt.Sub(*run.StartedAt).Milliseconds()
+		run.DurationMs = &durationMs
+	}
+
+	switch {
+	case err == nil:
+		run.Status = model.SyncRunSucceeded
+		run.FilesOnSE = result.FilesOnSE
+		run.FilesProcessed = result.FilesOnSE
+		run.FilesAdded = result.FilesAdded
+		run.FilesUpdated = result.FilesUpdated
+		run.FilesMarkedDeleted = result.FilesMarkedDeleted
+	case errors.Is(err, context.Canceled):
+		s.mu.Lock()
+		reason := a.cancelReason
+		s.mu.Unlock()
+		if reason == "" {
+			reason = syncCancelledByUser
+		}
+		run.Status = model.SyncRunCancelled
+		run.Error = &reason
+	default:
+		msg := err.Error()
+		run.Status = model.SyncRunFailed
+		run.Error = &msg
+		s.logger.Warn("Ошибка синхронизации SE",
+			slog.String("run_id", run.ID),
+			slog.String("se_id", run.StorageElementID),
+			slog.String("trigger", run.Trigger),
+			slog.String("error", msg),
+		)
+	}
+
+	s.save(ctx, run)
+}
 
-	// 1. Получаем SE из БД
+// save сохраняет состояние задачи в БД и рассылает его подписчикам.
+func (s *StorageSyncService) save(ctx context.Context, run *model.SyncRun) {
+	if err := s.runRepo.Update(ctx, run); err != nil {
+		s.logger.Warn("Ошибка сохранения состояния задачи синхронизации",
+			slog.String("run_id", run.ID),
+			slog.String("error", err.Error()),
+		)
+	}
+	s.publish(run)
+}
+
+// publish рассылает копию состояния задачи подписчикам без блокировки.
+func (s *StorageSyncService) publish(run *model.SyncRun) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subscribers {
+		select {
+		case ch <- *run:
+		default:
+		}
+	}
+}
+
+// release удаляет задачу из списка выполняющихся.
+func (s *StorageSyncService) release(a *activeSyncRun) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.active, a.run.ID)
+	if s.activeBySE[a.run.StorageElementID] == a.run.ID {
+		delete(s.activeBySE, a.run.StorageElementID)
+	}
+}
+
+// wait ждёт завершения выполняющейся задачи или отмены ctx.
+func (s *StorageSyncService) wait(ctx context.Context, runID string) {
+	s.mu.Lock()
+	a, ok := s.active[runID]
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+	select {
+	case <-a.done:
+	case <-ctx.Done():
+	}
+}
+
+// requestedBy возвращает имя субъекта запроса (preferred_username или
+// client_id SA) для sync_runs.requested_by; nil для фоновых задач.
+func requestedBy(ctx context.Context) *string {
+	claims := middleware.ClaimsFromContext(ctx)
+	if claims == nil {
+		return nil
+	}
+	name := claims.PreferredUsername
+	if claims.SubjectType == middleware.SubjectTypeSA {
+		name = claims.ClientID
+	}
+	return &name
+}
+
+// syncOne синхронизирует один SE в режиме mode (full или incremental):
+//  1. Запрос актуальной информации (mode, status, capacity)
+//  2. Постраничная загрузка файлов (incremental — изменённых с курсора)
+//  3. Upsert изменившихся записей реестра, подтверждение найденных реплик
+//  4. full: пометка отсутствующих файлов как deleted, удаление потерянных
+//     реплик (разность с staging-таблицей sync_seen_files задачи runID)
+//  5. Сохранение курсора и timestamps
+//
+// progress вызывается после каждой обработанной страницы файлов.
+func (s *StorageSyncService) syncOne(
+	ctx context.Context,
+	seID, runID, mode string,
+	progress func(p *model.SyncResult, processed int),
+) (*model.SyncResult, error) {
+	startedAt := time.Now().UTC()
+	full := mode != model.SyncModeIncremental
+
+	// 1. Получаем SE и курсор из БД
 	se, err := s.seRepo.GetByID(ctx, seID)
 	if err != nil {
 		return nil, fmt.Errorf("получение SE: %w", err)
 	}
 
+	cp, err := s.cpRepo.Get(ctx, seID)
+	if errors.Is(err, repository.ErrNotFound) {
+		cp, err = &model.SyncCheckpoint{StorageElementID: seID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("получение курсора синхронизации: %w", err)
+	}
+	var updatedSince *time.Time
+	if !full {
+		updatedSince = cp.FilesCursor
+	}
+
 	// 2. Запрашиваем актуальную информацию
 	info, err := s.seClient.Info(ctx, se.URL)
 	if err != nil {
@@ -225,12 +647,25 @@ func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.S
 		slog.String("se_id", seID),
 		slog.String("mode", info.Mode),
 		slog.String("status", info.Status),
+		slog.String("sync_mode", mode),
 	)
 
-	// 4. Постраничная загрузка файлов и batch upsert
+	if full {
+		// Staging-записи нужны только до конца задачи
+		defer func() {
+			if err := s.cpRepo.ClearSeen(context.WithoutCancel(ctx), runID); err != nil {
+				s.logger.Warn("Ошибка очистки staging синхронизации",
+					slog.String("run_id", runID),
+					slog.String("error", err.Error()),
+				)
+			}
+		}()
+	}
+
+	// 4. Постраничная загрузка файлов и upsert
 	var totalFilesOnSE int
-	var totalAdded, totalUpdated int
-	var allFileIDs []string
+	var totalAdded, totalUpdated, replicasConfirmed int
+	var maxChangedAt time.Time
 
 	offset := 0
 	for {
@@ -240,7 +675,7 @@ func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.S
 		}
 
 		var fileResp *seclient.FileListResponse
-		fileResp, err = s.seClient.ListFiles(ctx, se.URL, s.pageSize, offset)
+		fileResp, err = s.seClient.ListFiles(ctx, se.URL, s.pageSize, offset, updatedSince)
 		if err != nil {
 			return nil, fmt.Errorf("запрос ListFiles SE %s (offset=%d): %w", se.URL, offset, err)
 		}
@@ -253,6 +688,7 @@ func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.S
 
 		// Конвертируем SEFileMetadata → FileRecord
 		records := make([]*model.FileRecord, 0, len(fileResp.Files))
+		pageIDs := make([]string, 0, len(fileResp.Files))
 		for _, f := range fileResp.Files {
 			var record *model.FileRecord
 			record, err = seFileToRecord(f, seID)
@@ -264,10 +700,19 @@ func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.S
 				continue
 			}
 			records = append(records, record)
-			allFileIDs = append(allFileIDs, f.FileID)
+			pageIDs = append(pageIDs, f.FileID)
+			if changedAt := seFileChangedAt(f, record.UploadedAt); changedAt.After(maxChangedAt) {
+				maxChangedAt = changedAt
+			}
+		}
+
+		if full {
+			if err = s.cpRepo.AddSeen(ctx, runID, pageIDs); err != nil {
+				return nil, fmt.Errorf("staging файлов SE %s (offset=%d): %w", seID, offset, err)
+			}
 		}
 
-		// Batch upsert
+		// Upsert (неизменившиеся записи не перезаписываются)
 		var added, updated int
 		added, updated, err = s.fileRepo.BatchUpsert(ctx, records)
 		if err != nil {
@@ -276,6 +721,14 @@ func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.S
 		totalAdded += added
 		totalUpdated += updated
 
+		// Реплики, найденные на SE, подтверждаем
+		var confirmed int
+		confirmed, err = s.replicaRepo.ConfirmFound(ctx, seID, pageIDs)
+		if err != nil {
+			return nil, fmt.Errorf("подтверждение реплик SE %s: %w", seID, err)
+		}
+		replicasConfirmed += confirmed
+
 		s.logger.Debug("Страница файлов обработана",
 			slog.String("se_id", seID),
 			slog.Int("offset", offset),
@@ -285,6 +738,11 @@ func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.S
 		)
 
 		offset += len(fileResp.Files)
+		progress(&model.SyncResult{
+			FilesOnSE:    totalFilesOnSE,
+			FilesAdded:   totalAdded,
+			FilesUpdated: totalUpdated,
+		}, offset)
 
 		// Если получили меньше файлов, чем pageSize — достигли конца
 		if len(fileResp.Files) < s.pageSize {
@@ -292,27 +750,69 @@ func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.S
 		}
 	}
 
-	// 5. Пометить отсутствующие файлы как deleted
-	markedDeleted, err := s.fileRepo.MarkDeletedExcept(ctx, seID, allFileIDs)
-	if err != nil {
-		return nil, fmt.Errorf("пометка удалённых файлов SE %s: %w", seID, err)
+	// 5. Полная синхронизация: удалённые файлы и потерянные реплики —
+	// разность с найденными на SE (staging)
+	var markedDeleted, replicasLost int
+	if full {
+		markedDeleted, err = s.fileRepo.MarkDeletedUnseen(ctx, seID, runID)
+		if err != nil {
+			return nil, fmt.Errorf("пометка удалённых файлов SE %s: %w", seID, err)
+		}
+
+		replicasLost, err = s.replicaRepo.DeleteMissing(ctx, seID, runID)
+		if err != nil {
+			return nil, fmt.Errorf("сверка реплик SE %s: %w", seID, err)
+		}
+		if replicasLost > 0 {
+			s.logger.Warn("На SE не найдены реплики файлов",
+				slog.String("se_id", seID),
+				slog.Int("replicas_lost", replicasLost),
+			)
+		}
 	}
 
-	// 6. Обновляем timestamps SE
+	// 6. Сохраняем курсор: следующая инкрементальная синхронизация запросит
+	// файлы, изменённые после обработанных (с перекрытием)
+	if !maxChangedAt.IsZero() {
+		cursor := maxChangedAt.Add(-syncCursorOverlap)
+		if cp.FilesCursor == nil || cursor.After(*cp.FilesCursor) {
+			cp.FilesCursor = &cursor
+		}
+	}
+	if full {
+		cp.LastFullSyncAt = &startedAt
+	}
+	if err := s.cpRepo.Save(ctx, cp); err != nil {
+		return nil, fmt.Errorf("сохранение курсора синхронизации SE %s: %w", seID, err)
+	}
+
+	// 7. Обновляем timestamps SE
 	fileSyncAt := time.Now().UTC()
 	se.LastFileSyncAt = &fileSyncAt
 	if err := s.seRepo.Update(ctx, se); err != nil {
 		return nil, fmt.Errorf("обновление SE после sync: %w", err)
 	}
 
+	// Снимок ёмкости для тренда и прогноза; ошибка не прерывает синхронизацию
+	if s.capacitySvc != nil && info.Capacity != nil {
+		if err := s.capacitySvc.Record(ctx, se); err != nil {
+			s.logger.Warn("Ошибка записи снимка ёмкости SE",
+				slog.String("se_id", seID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
 	completedAt := time.Now().UTC()
 
-	// 7. Обновляем Prometheus-метрики
+	// 8. Обновляем Prometheus-метрики
 	duration := completedAt.Sub(startedAt).Seconds()
 	syncDuration.WithLabelValues(seID).Observe(duration)
 	syncFilesTotal.WithLabelValues(seID, "added").Add(float64(totalAdded))
 	syncFilesTotal.WithLabelValues(seID, "updated").Add(float64(totalUpdated))
 	syncFilesTotal.WithLabelValues(seID, "deleted").Add(float64(markedDeleted))
+	syncFilesTotal.WithLabelValues(seID, "replica_confirmed").Add(float64(replicasConfirmed))
+	syncFilesTotal.WithLabelValues(seID, "replica_lost").Add(float64(replicasLost))
 
 	result := &model.SyncResult{
 		StorageElementID:   seID,
@@ -326,6 +826,7 @@ func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.S
 
 	s.logger.Info("Синхронизация SE завершена",
 		slog.String("se_id", seID),
+		slog.String("sync_mode", mode),
 		slog.Int("files_on_se", totalFilesOnSE),
 		slog.Int("files_added", totalAdded),
 		slog.Int("files_updated", totalUpdated),
@@ -336,6 +837,17 @@ func (s *StorageSyncService) SyncOne(ctx context.Context, seID string) (*model.S
 	return result, nil
 }
 
+// seFileChangedAt возвращает время последнего изменения метаданных файла на SE.
+// SE без поля updated_at (старые версии) — время загрузки.
+func seFileChangedAt(f seclient.SEFileMetadata, uploadedAt time.Time) time.Time {
+	if f.UpdatedAt != "" {
+		if t, err := time.Parse(time.RFC3339, f.UpdatedAt); err == nil {
+			return t
+		}
+	}
+	return uploadedAt
+}
+
 // seFileToRecord конвертирует метаданные файла SE в FileRecord для реестра.
 func seFileToRecord(f seclient.SEFileMetadata, seID string) (*model.FileRecord, error) {
 	// Парсинг UploadedAt (ISO 8601 / RFC 3339)
@@ -359,6 +871,10 @@ func seFileToRecord(f seclient.SEFileMetadata, seID string) (*model.FileRecord,
 		RetentionPolicy:  f.RetentionPolicy,
 		TTLDays:          f.TTLDays,
 	}
+	if f.CollectionID != "" {
+		collectionID := f.CollectionID
+		record.CollectionID = &collectionID
+	}
 
 	// Парсинг ExpiresAt (опциональное поле)
 	if f.ExpiresAt != nil {
@@ -0,0 +1,94 @@
+// storage_sync_test.go — unit-тесты задач синхронизации SE: субъект запуска,
+// рассылка прогресса подписчикам, курсор инкрементальной синхронизации.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/seclient"
+)
+
+// TestRequestedBy проверяет определение субъекта, запустившего синхронизацию.
+func TestRequestedBy(t *testing.T) {
+	if got := requestedBy(context.Background()); got != nil {
+		t.Errorf("без claims = %q, хотели nil", *got)
+	}
+
+	ctx := middleware.WithClaims(context.Background(), &middleware.AuthClaims{
+		SubjectType:       middleware.SubjectTypeUser,
+		PreferredUsername: "alice",
+	})
+	if got := requestedBy(ctx); got == nil || *got != "alice" {
+		t.Errorf("пользователь = %v, хотели alice", got)
+	}
+
+	ctx = middleware.WithClaims(context.Background(), &middleware.AuthClaims{
+		SubjectType: middleware.SubjectTypeSA,
+		ClientID:    "sa_ingest",
+	})
+	if got := requestedBy(ctx); got == nil || *got != "sa_ingest" {
+		t.Errorf("SA = %v, хотели sa_ingest", got)
+	}
+}
+
+// TestSyncRunSubscribe проверяет доставку изменений подписчику, отказ от
+// блокировки при переполнении буфера и отписку.
+func TestSyncRunSubscribe(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	s := NewStorageSyncService(nil, nil, nil, nil, nil, nil, nil, 100, time.Hour, 24*time.Hour, logger)
+
+	updates, unsubscribe := s.Subscribe()
+
+	run := &model.SyncRun{ID: "run-1", Status: model.SyncRunRunning, FilesProcessed: 100}
+	s.publish(run)
+	run.FilesProcessed = 200 // Подписчик получает копию, а не ссылку
+
+	got := <-updates
+	if got.ID != "run-1" || got.FilesProcessed != 100 {
+		t.Errorf("получено %+v, хотели run-1 с FilesProcessed=100", got)
+	}
+
+	// Переполнение буфера не блокирует publish
+	done := make(chan struct{})
+	go func() {
+		for range syncProgressBuffer + 10 {
+			s.publish(run)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publish заблокирован медленным подписчиком")
+	}
+
+	unsubscribe()
+	s.mu.Lock()
+	n := len(s.subscribers)
+	s.mu.Unlock()
+	if n != 0 {
+		t.Errorf("после отписки подписчиков: %d, хотели 0", n)
+	}
+}
+
+// TestSEFileChangedAt проверяет выбор времени изменения файла для курсора.
+func TestSEFileChangedAt(t *testing.T) {
+	uploaded := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	f := seclient.SEFileMetadata{UpdatedAt: "2026-02-01T10:00:00.123456Z"}
+	want := time.Date(2026, 2, 1, 10, 0, 0, 123456000, time.UTC)
+	if got := seFileChangedAt(f, uploaded); !got.Equal(want) {
+		t.Errorf("updated_at = %v, хотели %v", got, want)
+	}
+
+	// SE старой версии без updated_at — время загрузки
+	if got := seFileChangedAt(seclient.SEFileMetadata{}, uploaded); !got.Equal(uploaded) {
+		t.Errorf("без updated_at = %v, хотели %v", got, uploaded)
+	}
+}
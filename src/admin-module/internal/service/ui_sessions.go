@@ -0,0 +1,197 @@
+// ui_sessions.go — серверные сессии Admin UI: список, отзыв и очистка.
+//
+// Сессии создаёт и проверяет ui/auth.SessionManager; UISessionService
+// отдаёт администратору список активных сессий, завершает их с записью в
+// аудит и раз в uiSessionCleanupInterval удаляет истёкшие сессии и сессии,
+// зашифрованные прежним ключом AM_UI_SESSION_SECRET.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// uiSessionCleanupInterval — интервал удаления истёкших UI-сессий.
+const uiSessionCleanupInterval = 15 * time.Minute
+
+// UISessionService — сервис серверных сессий Admin UI.
+type UISessionService struct {
+	repo  repository.UISessionRepository
+	audit *AuditService
+	// keyID — отпечаток текущего ключа сессий; сессии с другим ключом удаляются
+	keyID       string
+	idleTimeout time.Duration
+	logger      *slog.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewUISessionService создаёт сервис UI-сессий.
+func NewUISessionService(
+	repo repository.UISessionRepository,
+	audit *AuditService,
+	keyID string,
+	idleTimeout time.Duration,
+	logger *slog.Logger,
+) *UISessionService {
+	return &UISessionService{
+		repo:        repo,
+		audit:       audit,
+		keyID:       keyID,
+		idleTimeout: idleTimeout,
+		logger:      logger.With(slog.String("component", "ui_sessions")),
+	}
+}
+
+// Start запускает фоновую очистку. Первая очистка выполняется сразу:
+// после смены ключа сессии прежнего ключа удаляются при старте.
+func (s *UISessionService) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(uiSessionCleanupInterval)
+		defer ticker.Stop()
+
+		for {
+			s.Cleanup(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop останавливает фоновую очистку и ждёт завершения.
+func (s *UISessionService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+// Cleanup удаляет истёкшие сессии и сессии, зашифрованные другим ключом.
+// Возвращает количество удалённых.
+func (s *UISessionService) Cleanup(ctx context.Context) int {
+	n, err := s.repo.DeleteStale(ctx, s.keyID, time.Now().Add(-s.idleTimeout))
+	if err != nil {
+		s.logger.Error("Ошибка удаления устаревших UI-сессий",
+			slog.String("error", err.Error()),
+		)
+		return 0
+	}
+	if n > 0 {
+		s.logger.Info("Устаревшие UI-сессии удалены", slog.Int("count", n))
+	}
+	return n
+}
+
+// List возвращает активные сессии (subject — необязательный фильтр по пользователю).
+func (s *UISessionService) List(ctx context.Context, subject *string) ([]*model.UISession, error) {
+	sessions, err := s.repo.List(ctx, subject, time.Now().Add(-s.idleTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("получение UI-сессий: %w", err)
+	}
+	return sessions, nil
+}
+
+// Revoke завершает сессию и записывает событие аудита.
+// Пользователь будет отправлен на вход при следующем запросе.
+func (s *UISessionService) Revoke(ctx context.Context, id string) error {
+	session, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("получение UI-сессии: %w", err)
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionUISessionRevoke,
+		TargetType: model.AuditTargetUser,
+		TargetID:   session.Subject,
+		Before: map[string]any{
+			"username":    session.Username,
+			"session_id":  session.ID,
+			"remote_addr": session.RemoteAddr,
+			"created_at":  session.CreatedAt,
+		},
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewUISessionRepository(db).Delete(ctx, id)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("завершение UI-сессии: %w", err)
+	}
+	return nil
+}
+
+// RevokeUser завершает все сессии пользователя и записывает событие аудита.
+// Возвращает количество завершённых сессий.
+func (s *UISessionService) RevokeUser(ctx context.Context, subject string) (int, error) {
+	sessions, err := s.repo.List(ctx, &subject, time.Time{})
+	if err != nil {
+		return 0, fmt.Errorf("получение UI-сессий пользователя: %w", err)
+	}
+	if len(sessions) == 0 {
+		return 0, nil
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionUISessionRevoke,
+		TargetType: model.AuditTargetUser,
+		TargetID:   subject,
+		Before: map[string]any{
+			"username": sessions[0].Username,
+			"sessions": len(sessions),
+		},
+	}
+	var n int
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		var delErr error
+		n, delErr = repository.NewUISessionRepository(db).DeleteBySubject(ctx, subject)
+		return delErr
+	})
+	if err != nil {
+		return 0, fmt.Errorf("завершение UI-сессий пользователя: %w", err)
+	}
+	return n, nil
+}
+
+// revokeDowngradedSessions удаляет UI-сессии пользователя, роль которых даёт
+// разрешения, отсутствующие у него с новым override (nil — override снят).
+// Роль других сессий не меняется: повышение вступает в силу при следующем входе.
+func revokeDowngradedSessions(
+	ctx context.Context, repo repository.UISessionRepository, catalog *rbac.Catalog, userID string, override *string,
+) (int, error) {
+	sessions, err := repo.List(ctx, &userID, time.Time{})
+	if err != nil {
+		return 0, err
+	}
+	var ids []string
+	for _, session := range sessions {
+		granted := catalog.Resolve(session.Groups, []string{session.IdpRole}, override).Permissions
+		if !granted.Contains(catalog.Permissions(session.Role)) {
+			ids = append(ids, session.ID)
+		}
+	}
+	return repo.DeleteByIDs(ctx, ids)
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 )
 
@@ -27,16 +28,19 @@ var validSettingKeys = map[string]string{
 // UISettingsService — сервис для работы с настройками UI.
 type UISettingsService struct {
 	repo   repository.UISettingsRepository
+	audit  *AuditService
 	logger *slog.Logger
 }
 
 // NewUISettingsService создаёт сервис настроек UI.
 func NewUISettingsService(
 	repo repository.UISettingsRepository,
+	audit *AuditService,
 	logger *slog.Logger,
 ) *UISettingsService {
 	return &UISettingsService{
 		repo:   repo,
+		audit:  audit,
 		logger: logger.With(slog.String("service", "ui_settings")),
 	}
 }
@@ -67,7 +71,26 @@ func (s *UISettingsService) Set(ctx context.Context, key, value, updatedBy strin
 		return err
 	}
 
-	if err := s.repo.Set(ctx, key, value, updatedBy); err != nil {
+	var before map[string]any
+	current, err := s.repo.Get(ctx, key)
+	switch {
+	case err == nil:
+		before = map[string]any{"value": current.Value}
+	case !errors.Is(err, repository.ErrNotFound):
+		return fmt.Errorf("ошибка получения настройки %q: %w", key, err)
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionUISettingSet,
+		TargetType: model.AuditTargetUISetting,
+		TargetID:   key,
+		Before:     before,
+		After:      map[string]any{"value": value},
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewUISettingsRepository(db).Set(ctx, key, value, updatedBy)
+	})
+	if err != nil {
 		return fmt.Errorf("ошибка сохранения настройки %q: %w", key, err)
 	}
 
@@ -89,7 +112,24 @@ func (s *UISettingsService) List(ctx context.Context) ([]repository.UISetting, e
 
 // Delete удаляет настройку по ключу.
 func (s *UISettingsService) Delete(ctx context.Context, key string) error {
-	if err := s.repo.Delete(ctx, key); err != nil {
+	current, err := s.repo.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("ошибка получения настройки %q: %w", key, err)
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionUISettingDelete,
+		TargetType: model.AuditTargetUISetting,
+		TargetID:   key,
+		Before:     map[string]any{"value": current.Value},
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewUISettingsRepository(db).Delete(ctx, key)
+	})
+	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrNotFound
 		}
@@ -0,0 +1,222 @@
+// user_accounts.go — управление учётными записями пользователей локального IdP:
+// создание, изменение, удаление и сброс пароля. При внешнем IdP (Keycloak)
+// операции возвращают ErrUnsupported — пользователи управляются в Keycloak.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// CreateAccount создаёт пользователя в локальном IdP.
+func (s *AdminUserService) CreateAccount(ctx context.Context, account idp.UserAccount, password string) (*model.AdminUser, error) {
+	um, err := s.userManager()
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := um.CreateUser(ctx, account, password)
+	if err != nil {
+		return nil, mapIdPAccountError(err, "создание пользователя")
+	}
+
+	s.recordAccountChange(ctx, &AuditChange{
+		Action:     model.AuditActionUserCreate,
+		TargetType: model.AuditTargetUser,
+		TargetID:   user.ID,
+		After:      accountAuditState(account),
+	})
+	s.logger.Info("Пользователь создан",
+		slog.String("user_id", user.ID),
+		slog.String("username", user.Username),
+	)
+	return s.GetUser(ctx, user.ID)
+}
+
+// UpdateAccount обновляет учётную запись пользователя локального IdP.
+// При отключении пользователя все его UI-сессии завершаются.
+func (s *AdminUserService) UpdateAccount(ctx context.Context, id string, account idp.UserAccount) (*model.AdminUser, error) {
+	um, err := s.userManager()
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	account.Username = current.Username
+
+	if _, err := um.UpdateUser(ctx, id, account); err != nil {
+		return nil, mapIdPAccountError(err, "обновление пользователя")
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionUserUpdate,
+		TargetType: model.AuditTargetUser,
+		TargetID:   id,
+		Before: accountAuditState(idp.UserAccount{
+			Username:  current.Username,
+			Email:     current.Email,
+			FirstName: current.FirstName,
+			LastName:  current.LastName,
+			Enabled:   current.Enabled,
+			Groups:    current.Groups,
+		}),
+		After: accountAuditState(account),
+	}
+	var revoked int
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		if account.Enabled {
+			return nil
+		}
+		var revokeErr error
+		revoked, revokeErr = repository.NewUISessionRepository(db).DeleteBySubject(ctx, id)
+		return revokeErr
+	})
+	if err != nil {
+		// Учётная запись уже изменена в IdP — ошибка аудита не откатывает её
+		s.logger.Error("Ошибка записи аудита изменения пользователя",
+			slog.String("user_id", id),
+			slog.String("error", err.Error()),
+		)
+	}
+	s.logRevokedSessions(id, revoked)
+	return s.GetUser(ctx, id)
+}
+
+// DeleteAccount удаляет пользователя из локального IdP вместе с его
+// role override, UI-сессиями и personal access tokens.
+func (s *AdminUserService) DeleteAccount(ctx context.Context, id string) error {
+	um, err := s.userManager()
+	if err != nil {
+		return err
+	}
+
+	current, err := s.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := um.DeleteUser(ctx, id); err != nil {
+		return mapIdPAccountError(err, "удаление пользователя")
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionUserDelete,
+		TargetType: model.AuditTargetUser,
+		TargetID:   id,
+		Before: accountAuditState(idp.UserAccount{
+			Username:  current.Username,
+			Email:     current.Email,
+			FirstName: current.FirstName,
+			LastName:  current.LastName,
+			Enabled:   current.Enabled,
+			Groups:    current.Groups,
+		}),
+	}
+	var revoked int
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		if err := repository.NewRoleOverrideRepository(db).Delete(ctx, id); err != nil &&
+			!errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+		if _, err := repository.NewPersonalTokenRepository(db).DeleteBySubject(ctx, id); err != nil {
+			return err
+		}
+		var revokeErr error
+		revoked, revokeErr = repository.NewUISessionRepository(db).DeleteBySubject(ctx, id)
+		return revokeErr
+	})
+	if err != nil {
+		return fmt.Errorf("удаление данных пользователя: %w", err)
+	}
+	s.logRevokedSessions(id, revoked)
+	s.logger.Info("Пользователь удалён",
+		slog.String("user_id", id),
+		slog.String("username", current.Username),
+	)
+	return nil
+}
+
+// ResetPassword устанавливает новый пароль пользователя локального IdP
+// и снимает блокировку входа.
+func (s *AdminUserService) ResetPassword(ctx context.Context, id, password string) error {
+	um, err := s.userManager()
+	if err != nil {
+		return err
+	}
+
+	current, err := s.getIdPUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := um.SetPassword(ctx, id, password); err != nil {
+		return mapIdPAccountError(err, "установка пароля")
+	}
+
+	s.recordAccountChange(ctx, &AuditChange{
+		Action:     model.AuditActionUserPasswordReset,
+		TargetType: model.AuditTargetUser,
+		TargetID:   id,
+		After:      map[string]any{"username": current.Username},
+	})
+	return nil
+}
+
+// userManager возвращает управление учётными записями текущего IdP
+// или ErrUnsupported, если IdP внешний.
+func (s *AdminUserService) userManager() (idp.UserManager, error) {
+	um, ok := s.provider.(idp.UserManager)
+	if !ok {
+		return nil, fmt.Errorf("%w: учётные записи пользователей управляются в %s",
+			ErrUnsupported, s.provider.Name())
+	}
+	return um, nil
+}
+
+// recordAccountChange записывает событие аудита для уже выполненного
+// в IdP изменения. Ошибка записи только логируется.
+func (s *AdminUserService) recordAccountChange(ctx context.Context, change *AuditChange) {
+	if err := s.audit.Record(ctx, change); err != nil {
+		s.logger.Error("Ошибка записи аудита учётной записи",
+			slog.String("action", change.Action),
+			slog.String("user_id", change.TargetID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// mapIdPAccountError преобразует ошибки IdP в ошибки сервисного слоя.
+func mapIdPAccountError(err error, op string) error {
+	switch {
+	case errors.Is(err, idp.ErrNotFound):
+		return ErrNotFound
+	case errors.Is(err, idp.ErrConflict):
+		return ErrConflict
+	case errors.Is(err, idp.ErrValidation):
+		return fmt.Errorf("%w: %w", ErrValidation, err)
+	default:
+		return fmt.Errorf("%s: %w", op, err)
+	}
+}
+
+// accountAuditState — состояние учётной записи для журнала аудита
+// (пароль не записывается).
+func accountAuditState(account idp.UserAccount) map[string]any {
+	return map[string]any{
+		"username":   account.Username,
+		"email":      account.Email,
+		"first_name": account.FirstName,
+		"last_name":  account.LastName,
+		"enabled":    account.Enabled,
+		"groups":     account.Groups,
+	}
+}
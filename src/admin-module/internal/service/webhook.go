@@ -0,0 +1,576 @@
+// webhook.go — доставка оповещений на webhook endpoints.
+//
+// Каждое событие (alert.firing, alert.resolved, webhook.test) сохраняется
+// в webhook_deliveries для каждого включённого endpoint получателя и
+// отправляется фоновой задачей HTTP POST с JSON-телом. Запрос подписывается
+// HMAC-SHA256 ключом endpoint:
+//
+//	X-Artstore-Signature: sha256=hex(HMAC(secret, "<X-Artstore-Timestamp>.<тело>"))
+//
+// Ответ 2xx — доставлено. Иначе попытка повторяется с экспоненциальной
+// задержкой (webhookRetryBase, 2×, 4×, ... не более часа), после
+// AM_WEBHOOK_MAX_ATTEMPTS попыток доставка помечается failed.
+// Завершённые доставки старше AM_WEBHOOK_DELIVERY_RETENTION удаляются.
+//
+// Prometheus-метрики:
+//   - admin_module_webhook_deliveries_total — результаты попыток (delivered, retried, failed)
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+const (
+	// webhookDispatchInterval — период проверки очереди доставок.
+	webhookDispatchInterval = 10 * time.Second
+	// webhookRetryBase — задержка перед второй попыткой доставки.
+	webhookRetryBase = 30 * time.Second
+	// webhookBatchSize — максимальное число доставок за один проход.
+	webhookBatchSize = 100
+	// webhookCleanupInterval — период удаления старых доставок.
+	webhookCleanupInterval = time.Hour
+	// webhookMinSecretLength — минимальная длина ключа подписи, заданного вручную.
+	webhookMinSecretLength = 16
+	// webhookMaxErrorBody — сколько байт тела ответа сохранять в last_error.
+	webhookMaxErrorBody = 512
+)
+
+// Заголовки запроса доставки webhook.
+const (
+	WebhookHeaderEvent     = "X-Artstore-Event"
+	WebhookHeaderDelivery  = "X-Artstore-Delivery"
+	WebhookHeaderTimestamp = "X-Artstore-Timestamp"
+	WebhookHeaderSignature = "X-Artstore-Signature"
+)
+
+// webhookDeliveriesTotal — результаты попыток доставки webhooks.
+var webhookDeliveriesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "admin_module_webhook_deliveries_total",
+	Help: "Количество попыток доставки webhooks",
+}, []string{"event", "result"}) // result: delivered, retried, failed
+
+// WebhookDispatchResult — итог одного прохода доставки.
+type WebhookDispatchResult struct {
+	// Due — количество доставок, время попытки которых наступило
+	Due int
+	// Delivered — доставлено
+	Delivered int
+	// Retried — ошибка, попытка отложена
+	Retried int
+	// Failed — попытки исчерпаны
+	Failed int
+}
+
+// WebhookService — управление webhook endpoints и доставка событий.
+type WebhookService struct {
+	endpointRepo repository.WebhookEndpointRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	audit        *AuditService
+	httpClient   *http.Client
+	maxAttempts  int
+	retention    time.Duration
+	logger       *slog.Logger
+
+	trigger chan struct{}
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewWebhookService создаёт сервис webhooks.
+// timeout — таймаут HTTP-запроса, maxAttempts — число попыток доставки,
+// retention — срок хранения журнала доставок.
+func NewWebhookService(
+	endpointRepo repository.WebhookEndpointRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	audit *AuditService,
+	timeout time.Duration,
+	maxAttempts int,
+	retention time.Duration,
+	logger *slog.Logger,
+) *WebhookService {
+	return &WebhookService{
+		endpointRepo: endpointRepo,
+		deliveryRepo: deliveryRepo,
+		audit:        audit,
+		httpClient:   &http.Client{Timeout: timeout},
+		maxAttempts:  maxAttempts,
+		retention:    retention,
+		logger:       logger.With(slog.String("component", "webhook")),
+		trigger:      make(chan struct{}, 1),
+	}
+}
+
+// Start запускает фоновую доставку и очистку журнала.
+func (s *WebhookService) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		s.logger.Info("Доставка webhooks запущена",
+			slog.Int("max_attempts", s.maxAttempts),
+			slog.String("retention", s.retention.String()),
+		)
+
+		ticker := time.NewTicker(webhookDispatchInterval)
+		defer ticker.Stop()
+		cleanup := time.NewTicker(webhookCleanupInterval)
+		defer cleanup.Stop()
+
+		s.cleanup(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Доставка webhooks остановлена")
+				return
+			case <-cleanup.C:
+				s.cleanup(ctx)
+				continue
+			case <-ticker.C:
+			case <-s.trigger:
+			}
+
+			result, err := s.RunOnce(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					s.logger.Error("Ошибка доставки webhooks", slog.String("error", err.Error()))
+				}
+				continue
+			}
+			if result.Due > 0 {
+				s.logger.Info("Проход доставки webhooks завершён",
+					slog.Int("due", result.Due),
+					slog.Int("delivered", result.Delivered),
+					slog.Int("retried", result.Retried),
+					slog.Int("failed", result.Failed),
+				)
+			}
+		}
+	}()
+}
+
+// Stop останавливает фоновую горутину и ждёт завершения.
+func (s *WebhookService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+// Trigger запрашивает внеочередной проход доставки.
+// Не блокирует: повторные вызовы до начала прохода объединяются.
+func (s *WebhookService) Trigger() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// --- Endpoints ---
+
+// CreateEndpoint создаёт webhook endpoint. Пустой secret — ключ подписи
+// генерируется. Возвращённый endpoint содержит ключ (показывается один раз).
+func (s *WebhookService) CreateEndpoint(ctx context.Context, name, rawURL, secret string, enabled bool) (*model.WebhookEndpoint, error) {
+	if secret == "" {
+		secret = generateWebhookSecret()
+	}
+	ep := &model.WebhookEndpoint{
+		ID:      uuid.New().String(),
+		Name:    name,
+		URL:     rawURL,
+		Secret:  secret,
+		Enabled: enabled,
+	}
+	if err := validateWebhookEndpoint(ep); err != nil {
+		return nil, err
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionWebhookCreate,
+		TargetType: model.AuditTargetWebhook,
+		TargetID:   ep.ID,
+		After:      webhookAuditState(ep),
+	}
+	err := s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewWebhookEndpointRepository(db).Create(ctx, ep)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			return nil, fmt.Errorf("%w: webhook '%s' уже существует", ErrConflict, name)
+		}
+		return nil, fmt.Errorf("сохранение webhook: %w", err)
+	}
+
+	s.logger.Info("Webhook создан", slog.String("webhook_id", ep.ID), slog.String("name", name))
+	return ep, nil
+}
+
+// GetEndpoint возвращает webhook endpoint по ID.
+func (s *WebhookService) GetEndpoint(ctx context.Context, id string) (*model.WebhookEndpoint, error) {
+	ep, err := s.endpointRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("получение webhook: %w", err)
+	}
+	return ep, nil
+}
+
+// ListEndpoints возвращает все webhook endpoints.
+func (s *WebhookService) ListEndpoints(ctx context.Context) ([]*model.WebhookEndpoint, error) {
+	eps, err := s.endpointRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("получение списка webhooks: %w", err)
+	}
+	return eps, nil
+}
+
+// UpdateEndpoint обновляет webhook endpoint; nil-поля не меняются.
+func (s *WebhookService) UpdateEndpoint(
+	ctx context.Context,
+	id string,
+	name, rawURL, secret *string,
+	enabled *bool,
+) (*model.WebhookEndpoint, error) {
+	ep, err := s.GetEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := webhookAuditState(ep)
+
+	if name != nil {
+		ep.Name = *name
+	}
+	if rawURL != nil {
+		ep.URL = *rawURL
+	}
+	secretChanged := secret != nil && *secret != ep.Secret
+	if secret != nil {
+		ep.Secret = *secret
+	}
+	if enabled != nil {
+		ep.Enabled = *enabled
+	}
+	if err := validateWebhookEndpoint(ep); err != nil {
+		return nil, err
+	}
+
+	after := webhookAuditState(ep)
+	if secretChanged {
+		// Ключ подписи в журнал не попадает — только факт замены
+		after["secret_changed"] = true
+	}
+	change := &AuditChange{
+		Action:     model.AuditActionWebhookUpdate,
+		TargetType: model.AuditTargetWebhook,
+		TargetID:   id,
+		Before:     before,
+		After:      after,
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewWebhookEndpointRepository(db).Update(ctx, ep)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, ErrNotFound
+		case errors.Is(err, repository.ErrConflict):
+			return nil, fmt.Errorf("%w: webhook '%s' уже существует", ErrConflict, ep.Name)
+		}
+		return nil, fmt.Errorf("обновление webhook: %w", err)
+	}
+
+	s.logger.Info("Webhook обновлён", slog.String("webhook_id", id))
+	return ep, nil
+}
+
+// DeleteEndpoint удаляет webhook endpoint вместе с журналом доставок.
+func (s *WebhookService) DeleteEndpoint(ctx context.Context, id string) error {
+	ep, err := s.GetEndpoint(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	change := &AuditChange{
+		Action:     model.AuditActionWebhookDelete,
+		TargetType: model.AuditTargetWebhook,
+		TargetID:   id,
+		Before:     webhookAuditState(ep),
+	}
+	err = s.audit.RunInTx(ctx, change, func(db repository.DBTX) error {
+		return repository.NewWebhookEndpointRepository(db).Delete(ctx, id)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("удаление webhook: %w", err)
+	}
+
+	s.logger.Info("Webhook удалён", slog.String("webhook_id", id))
+	return nil
+}
+
+// TestEndpoint ставит в очередь тестовую доставку на endpoint
+// (в том числе отключённый) и запускает внеочередной проход.
+func (s *WebhookService) TestEndpoint(ctx context.Context, id string) (*model.WebhookDelivery, error) {
+	ep, err := s.GetEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"event":       model.WebhookEventTest,
+		"occurred_at": time.Now().UTC(),
+		"webhook":     map[string]string{"id": ep.ID, "name": ep.Name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("формирование тестового события: %w", err)
+	}
+
+	d := newWebhookDelivery(ep.ID, nil, model.WebhookEventTest, payload)
+	if err := s.deliveryRepo.Create(ctx, d); err != nil {
+		return nil, fmt.Errorf("сохранение тестовой доставки: %w", err)
+	}
+	s.Trigger()
+	return d, nil
+}
+
+// --- Доставки ---
+
+// Enqueue ставит событие в очередь доставки на включённые endpoints из списка.
+// Возвращает количество созданных доставок.
+func (s *WebhookService) Enqueue(ctx context.Context, endpointIDs []string, ruleID *string, event string, payload json.RawMessage) (int, error) {
+	if len(endpointIDs) == 0 {
+		return 0, nil
+	}
+
+	queued := 0
+	for _, id := range endpointIDs {
+		ep, err := s.endpointRepo.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			return queued, fmt.Errorf("получение webhook %s: %w", id, err)
+		}
+		if !ep.Enabled {
+			continue
+		}
+		if err := s.deliveryRepo.Create(ctx, newWebhookDelivery(ep.ID, ruleID, event, payload)); err != nil {
+			return queued, err
+		}
+		queued++
+	}
+
+	if queued > 0 {
+		s.Trigger()
+	}
+	return queued, nil
+}
+
+// ListDeliveries возвращает журнал доставок с фильтрацией и пагинацией.
+func (s *WebhookService) ListDeliveries(
+	ctx context.Context,
+	filters repository.WebhookDeliveryFilters,
+	limit, offset int,
+) ([]*model.WebhookDelivery, int, error) {
+	items, err := s.deliveryRepo.List(ctx, filters, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение журнала доставок: %w", err)
+	}
+	total, err := s.deliveryRepo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчёт доставок: %w", err)
+	}
+	return items, total, nil
+}
+
+// RunOnce выполняет один проход доставки из очереди.
+func (s *WebhookService) RunOnce(ctx context.Context) (*WebhookDispatchResult, error) {
+	due, err := s.deliveryRepo.ListDue(ctx, webhookBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("получение доставок webhook: %w", err)
+	}
+
+	result := &WebhookDispatchResult{Due: len(due)}
+	endpoints := make(map[string]*model.WebhookEndpoint)
+
+	for _, d := range due {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		ep, ok := endpoints[d.EndpointID]
+		if !ok {
+			ep, err = s.endpointRepo.GetByID(ctx, d.EndpointID)
+			if err != nil {
+				return result, fmt.Errorf("получение webhook %s: %w", d.EndpointID, err)
+			}
+			endpoints[d.EndpointID] = ep
+		}
+
+		status, sendErr := s.send(ctx, ep, d)
+		d.Attempts++
+		if status != 0 {
+			d.ResponseStatus = &status
+		}
+
+		switch {
+		case sendErr == nil:
+			now := time.Now().UTC()
+			d.Status = model.WebhookDeliveryDelivered
+			d.DeliveredAt = &now
+			d.LastError = nil
+			result.Delivered++
+			webhookDeliveriesTotal.WithLabelValues(d.Event, "delivered").Inc()
+		case d.Attempts >= s.maxAttempts:
+			msg := sendErr.Error()
+			d.Status = model.WebhookDeliveryFailed
+			d.LastError = &msg
+			result.Failed++
+			webhookDeliveriesTotal.WithLabelValues(d.Event, "failed").Inc()
+			s.logger.Warn("Доставка webhook не удалась, попытки исчерпаны",
+				slog.String("delivery_id", d.ID),
+				slog.String("webhook", ep.Name),
+				slog.Int("attempts", d.Attempts),
+				slog.String("error", msg),
+			)
+		default:
+			msg := sendErr.Error()
+			d.LastError = &msg
+			d.NextAttemptAt = time.Now().UTC().Add(retryDelay(webhookRetryBase, d.Attempts))
+			result.Retried++
+			webhookDeliveriesTotal.WithLabelValues(d.Event, "retried").Inc()
+		}
+
+		if err := s.deliveryRepo.Save(ctx, d); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// send отправляет доставку d на endpoint ep. Возвращает HTTP-статус ответа
+// (0 — ответа нет) и ошибку, если доставка не подтверждена.
+func (s *WebhookService) send(ctx context.Context, ep *model.WebhookEndpoint, d *model.WebhookDelivery) (int, error) {
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("формирование запроса: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "artstore-admin-module")
+	req.Header.Set(WebhookHeaderEvent, d.Event)
+	req.Header.Set(WebhookHeaderDelivery, d.ID)
+	req.Header.Set(WebhookHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookHeaderSignature, WebhookSignature(ep.Secret, timestamp, d.Payload))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, webhookMaxErrorBody))
+	return resp.StatusCode, fmt.Errorf("HTTP %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+}
+
+// cleanup удаляет завершённые доставки старше срока хранения.
+func (s *WebhookService) cleanup(ctx context.Context) {
+	deleted, err := s.deliveryRepo.DeleteBefore(ctx, time.Now().UTC().Add(-s.retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("Ошибка очистки журнала доставок webhooks", slog.String("error", err.Error()))
+		}
+		return
+	}
+	if deleted > 0 {
+		s.logger.Info("Удалены старые доставки webhooks", slog.Int64("deleted", deleted))
+	}
+}
+
+// WebhookSignature возвращает значение заголовка X-Artstore-Signature:
+// "sha256=" + hex(HMAC-SHA256(secret, "<timestamp>.<body>")).
+func WebhookSignature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newWebhookDelivery создаёт доставку, готовую к первой попытке.
+func newWebhookDelivery(endpointID string, ruleID *string, event string, payload json.RawMessage) *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		ID:            uuid.New().String(),
+		EndpointID:    endpointID,
+		RuleID:        ruleID,
+		Event:         event,
+		Payload:       payload,
+		Status:        model.WebhookDeliveryPending,
+		NextAttemptAt: time.Now().UTC(),
+	}
+}
+
+// validateWebhookEndpoint проверяет имя, URL и ключ подписи endpoint.
+func validateWebhookEndpoint(ep *model.WebhookEndpoint) error {
+	if ep.Name == "" || len(ep.Name) > 100 {
+		return fmt.Errorf("%w: имя webhook должно быть от 1 до 100 символов", ErrValidation)
+	}
+	u, err := url.Parse(ep.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: URL webhook должен быть абсолютным http(s) URL", ErrValidation)
+	}
+	if len(ep.Secret) < webhookMinSecretLength {
+		return fmt.Errorf("%w: ключ подписи должен быть не короче %d символов", ErrValidation, webhookMinSecretLength)
+	}
+	return nil
+}
+
+// generateWebhookSecret генерирует ключ подписи (32 случайных байта в hex).
+func generateWebhookSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// webhookAuditState — поля endpoint для журнала аудита (без ключа подписи).
+func webhookAuditState(ep *model.WebhookEndpoint) map[string]any {
+	return map[string]any{
+		"name":    ep.Name,
+		"url":     ep.URL,
+		"enabled": ep.Enabled,
+	}
+}
@@ -0,0 +1,186 @@
+// webhook_test.go — unit-тесты доставки webhooks:
+// подпись запроса, успешная доставка, повтор с задержкой, исчерпание попыток.
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+)
+
+// fakeWebhookEndpointRepo хранит endpoints в памяти.
+type fakeWebhookEndpointRepo struct {
+	repository.WebhookEndpointRepository
+	endpoints map[string]*model.WebhookEndpoint
+}
+
+func (r *fakeWebhookEndpointRepo) GetByID(_ context.Context, id string) (*model.WebhookEndpoint, error) {
+	ep, ok := r.endpoints[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return ep, nil
+}
+
+// fakeWebhookDeliveryRepo хранит доставки в памяти.
+type fakeWebhookDeliveryRepo struct {
+	repository.WebhookDeliveryRepository
+	mu         sync.Mutex
+	deliveries []*model.WebhookDelivery
+}
+
+func (r *fakeWebhookDeliveryRepo) Create(_ context.Context, d *model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d.CreatedAt = time.Now().UTC()
+	r.deliveries = append(r.deliveries, d)
+	return nil
+}
+
+func (r *fakeWebhookDeliveryRepo) ListDue(_ context.Context, limit int) ([]*model.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []*model.WebhookDelivery
+	now := time.Now().UTC()
+	for _, d := range r.deliveries {
+		if d.Status == model.WebhookDeliveryPending && !d.NextAttemptAt.After(now) && len(result) < limit {
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeWebhookDeliveryRepo) Save(_ context.Context, _ *model.WebhookDelivery) error {
+	return nil
+}
+
+// TestWebhookDispatch проверяет подпись, успешную доставку и повторы.
+func TestWebhookDispatch(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+
+	var (
+		mu       sync.Mutex
+		failNext bool
+		headers  http.Header
+		body     []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		headers = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		if failNext {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("upstream down"))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	endpoints := &fakeWebhookEndpointRepo{endpoints: map[string]*model.WebhookEndpoint{
+		"ep-1": {ID: "ep-1", Name: "ops", URL: server.URL, Secret: secret, Enabled: true},
+		"ep-2": {ID: "ep-2", Name: "off", URL: server.URL, Secret: secret, Enabled: false},
+	}}
+	deliveries := &fakeWebhookDeliveryRepo{}
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	svc := NewWebhookService(endpoints, deliveries, nil, 5*time.Second, 2, time.Hour, logger)
+	ctx := context.Background()
+
+	// Отключённый endpoint не получает событий
+	queued, err := svc.Enqueue(ctx, []string{"ep-1", "ep-2", "missing"}, nil, model.WebhookEventFiring, []byte(`{"event":"alert.firing"}`))
+	if err != nil || queued != 1 {
+		t.Fatalf("Enqueue = %d, %v; ожидалась 1 доставка", queued, err)
+	}
+
+	result, err := svc.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+	if result.Delivered != 1 {
+		t.Fatalf("Delivered = %d, ожидалось 1", result.Delivered)
+	}
+	d := deliveries.deliveries[0]
+	if d.Status != model.WebhookDeliveryDelivered || d.Attempts != 1 || d.DeliveredAt == nil {
+		t.Errorf("доставка: status=%s attempts=%d delivered_at=%v", d.Status, d.Attempts, d.DeliveredAt)
+	}
+
+	ts, err := strconv.ParseInt(headers.Get(WebhookHeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("некорректный %s: %v", WebhookHeaderTimestamp, err)
+	}
+	if got, want := headers.Get(WebhookHeaderSignature), WebhookSignature(secret, ts, body); got != want {
+		t.Errorf("подпись = %s, ожидалась %s", got, want)
+	}
+	if headers.Get(WebhookHeaderEvent) != model.WebhookEventFiring || headers.Get(WebhookHeaderDelivery) != d.ID {
+		t.Errorf("заголовки события: %v", headers)
+	}
+
+	// Ошибка получателя: повтор с задержкой, затем failed после maxAttempts
+	mu.Lock()
+	failNext = true
+	mu.Unlock()
+	if _, err := svc.Enqueue(ctx, []string{"ep-1"}, nil, model.WebhookEventResolved, []byte(`{}`)); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	failed := deliveries.deliveries[1]
+
+	before := time.Now().UTC()
+	result, err = svc.RunOnce(ctx)
+	if err != nil || result.Retried != 1 {
+		t.Fatalf("RunOnce = %+v, %v; ожидался 1 повтор", result, err)
+	}
+	if failed.Status != model.WebhookDeliveryPending || failed.Attempts != 1 {
+		t.Errorf("после первой ошибки: status=%s attempts=%d", failed.Status, failed.Attempts)
+	}
+	if failed.NextAttemptAt.Before(before.Add(webhookRetryBase)) {
+		t.Errorf("next_attempt_at = %v, ожидалось не раньше %v", failed.NextAttemptAt, before.Add(webhookRetryBase))
+	}
+	if failed.ResponseStatus == nil || *failed.ResponseStatus != http.StatusBadGateway || failed.LastError == nil {
+		t.Errorf("результат попытки: status=%v error=%v", failed.ResponseStatus, failed.LastError)
+	}
+
+	failed.NextAttemptAt = time.Now().UTC()
+	result, err = svc.RunOnce(ctx)
+	if err != nil || result.Failed != 1 {
+		t.Fatalf("RunOnce = %+v, %v; ожидалась 1 неудачная доставка", result, err)
+	}
+	if failed.Status != model.WebhookDeliveryFailed || failed.Attempts != 2 {
+		t.Errorf("после исчерпания попыток: status=%s attempts=%d", failed.Status, failed.Attempts)
+	}
+}
+
+// TestValidateWebhookEndpoint проверяет валидацию endpoint.
+func TestValidateWebhookEndpoint(t *testing.T) {
+	valid := model.WebhookEndpoint{Name: "ops", URL: "https://hooks.example.com/x", Secret: "0123456789abcdef"}
+	if err := validateWebhookEndpoint(&valid); err != nil {
+		t.Fatalf("корректный endpoint: %v", err)
+	}
+
+	for name, mutate := range map[string]func(*model.WebhookEndpoint){
+		"пустое имя":    func(ep *model.WebhookEndpoint) { ep.Name = "" },
+		"схема ftp":     func(ep *model.WebhookEndpoint) { ep.URL = "ftp://example.com" },
+		"без хоста":     func(ep *model.WebhookEndpoint) { ep.URL = "https:///x" },
+		"короткий ключ": func(ep *model.WebhookEndpoint) { ep.Secret = "short" },
+	} {
+		ep := valid
+		mutate(&ep)
+		if err := validateWebhookEndpoint(&ep); err == nil {
+			t.Errorf("%s: ожидалась ошибка валидации", name)
+		}
+	}
+
+	if len(generateWebhookSecret()) != 64 {
+		t.Error("сгенерированный ключ должен содержать 64 hex-символа")
+	}
+}
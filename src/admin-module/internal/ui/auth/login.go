@@ -0,0 +1,21 @@
+// login.go — интерфейсы входа в Admin UI, не зависящие от провайдера:
+// обновление токенов сессии (Keycloak OIDC или локальный IdP) и вход
+// по имени пользователя и паролю (только локальный IdP).
+package auth
+
+import "context"
+
+// TokenRefresher обновляет токены UI-сессии по refresh token.
+// Реализуется OIDCClient и адаптером локального IdP.
+type TokenRefresher interface {
+	RefreshTokens(refreshToken string) (*TokenResponse, error)
+}
+
+// PasswordAuthenticator проверяет имя пользователя и пароль и выдаёт токены.
+// Ошибки неверных учётных данных и блокировки — idp.ErrInvalidCredentials
+// и idp.ErrLocked.
+type PasswordAuthenticator interface {
+	PasswordLogin(ctx context.Context, username, password string) (*TokenResponse, error)
+}
+
+var _ TokenRefresher = (*OIDCClient)(nil)
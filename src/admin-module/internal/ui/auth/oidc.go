@@ -119,13 +119,15 @@ func GeneratePKCE() (*PKCEParams, error) {
 // redirectURI — URL callback (например, http://localhost:8000/admin/callback).
 // state — случайный state parameter для CSRF-защиты.
 // codeChallenge — PKCE code_challenge (S256).
+// Scopes files:read и files:write (optional scopes клиента artstore-admin-ui)
+// нужны для загрузки и скачивания файлов на SE с токеном сессии.
 func (c *OIDCClient) AuthorizeURL(redirectURI, state, codeChallenge string) string {
 	params := url.Values{
 		"client_id":             {c.clientID},
 		"response_type":         {"code"},
 		"redirect_uri":          {redirectURI},
 		"state":                 {state},
-		"scope":                 {"openid profile email groups"},
+		"scope":                 {"openid profile email groups files:read files:write"},
 		"code_challenge":        {codeChallenge},
 		"code_challenge_method": {"S256"},
 	}
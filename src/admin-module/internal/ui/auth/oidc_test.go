@@ -98,9 +98,9 @@ func TestOIDCClientAuthorizeURL(t *testing.T) {
 		}
 	}
 
-	// Scope должен содержать openid profile email groups
+	// Scope должен содержать openid profile email groups и scopes файлов для SE
 	scope := params.Get("scope")
-	for _, s := range []string{"openid", "profile", "email", "groups"} {
+	for _, s := range []string{"openid", "profile", "email", "groups", "files:read", "files:write"} {
 		if !strings.Contains(scope, s) {
 			t.Errorf("Scope должен содержать %q, scope=%q", s, scope)
 		}
@@ -1,40 +1,83 @@
 // Пакет auth — аутентификация и управление сессиями Admin UI.
-// Шифрование сессий AES-256-GCM, OIDC-клиент для Keycloak (PKCE).
+// Серверные сессии в PostgreSQL с шифрованием токенов AES-256-GCM,
+// OIDC-клиент для Keycloak (PKCE).
 package auth
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 )
 
-// Имя cookie для зашифрованной сессии UI.
+// Имя cookie с токеном UI-сессии.
 const SessionCookieName = "artstore_session"
 
-// Максимальный возраст cookie сессии (24 часа).
-const SessionCookieMaxAge = 24 * 60 * 60
+// sessionTokenSize — размер случайного токена сессии в байтах.
+const sessionTokenSize = 32
+
+// sessionTouchInterval — минимальный интервал обновления last_seen_at:
+// не записываем активность в БД на каждый запрос.
+const sessionTouchInterval = time.Minute
+
+// ErrSessionInvalid — сессия не найдена, истекла, отозвана или зашифрована
+// другим ключом. Cookie такой сессии нужно удалить и отправить пользователя на вход.
+var ErrSessionInvalid = errors.New("UI-сессия недействительна")
+
+// SessionStore — хранилище серверных сессий.
+// Реализуется repository.UISessionRepository.
+type SessionStore interface {
+	Create(ctx context.Context, s *model.UISession) error
+	GetByTokenHash(ctx context.Context, tokenHash []byte) (*model.UISession, error)
+	Touch(ctx context.Context, id string, at time.Time) error
+	UpdateTokens(ctx context.Context, id, tokens string) error
+	Delete(ctx context.Context, id string) error
+}
+
+// SessionTimeouts — таймауты UI-сессий.
+type SessionTimeouts struct {
+	// Idle — сессия завершается после такого периода без запросов.
+	Idle time.Duration
+	// Absolute — максимальное время жизни сессии с момента входа.
+	Absolute time.Duration
+}
 
-// SessionData — данные сессии Admin UI, хранящиеся в зашифрованном cookie.
+// SessionData — данные UI-сессии. Токены хранятся в БД зашифрованными,
+// остальные поля — в колонках ui_sessions.
 type SessionData struct {
+	// ID — UUID серверной сессии.
+	ID string `json:"-"`
 	// AccessToken — JWT access token от Keycloak.
 	AccessToken string `json:"access_token"` //nolint:gosec // G117: данные сессии
 	// RefreshToken — refresh token для обновления access token.
 	RefreshToken string `json:"refresh_token"` //nolint:gosec // G117: данные сессии
 	// ExpiresAt — время истечения access token (Unix timestamp).
 	ExpiresAt int64 `json:"expires_at"`
+	// Subject — sub пользователя из JWT (Keycloak user ID).
+	Subject string `json:"sub,omitempty"`
 	// Username — preferred_username из JWT.
 	Username string `json:"username"`
 	// Email — email пользователя из JWT.
 	Email string `json:"email"`
-	// Role — effective роль пользователя (admin, readonly).
+	// IdpRole — роль из групп IdP на момент входа.
+	IdpRole string `json:"idp_role,omitempty"`
+	// Role — effective роль пользователя (встроенная или пользовательская).
 	Role string `json:"role"`
 	// Groups — группы пользователя из JWT.
 	Groups []string `json:"groups,omitempty"`
@@ -47,18 +90,23 @@ func (s *SessionData) IsExpired() bool {
 }
 
 // SessionManager — менеджер сессий Admin UI.
-// Шифрует/дешифрует SessionData в HTTP cookies через AES-256-GCM.
+// Хранит сессии в SessionStore, в cookie передаёт только случайный токен
+// (в БД — его SHA-256). Токены Keycloak шифруются через AES-256-GCM.
 type SessionManager struct {
 	// gcm — AEAD cipher для шифрования/дешифрования.
 	gcm cipher.AEAD
+	// keyID — отпечаток ключа: сессии, зашифрованные другим ключом, недействительны.
+	keyID string
 	// secure — использовать Secure flag для cookie (true для HTTPS).
-	secure bool
+	secure   bool
+	store    SessionStore
+	timeouts SessionTimeouts
 }
 
 // NewSessionManager создаёт новый менеджер сессий.
 // key — 32-байтовый ключ для AES-256-GCM.
 // Если key пустой — генерируется случайный ключ (непостоянный между рестартами).
-func NewSessionManager(key string, secure bool) (*SessionManager, error) {
+func NewSessionManager(key string, secure bool, store SessionStore, timeouts SessionTimeouts) (*SessionManager, error) {
 	var keyBytes []byte
 
 	if key == "" {
@@ -88,12 +136,27 @@ func NewSessionManager(key string, secure bool) (*SessionManager, error) {
 		return nil, fmt.Errorf("ошибка создания GCM: %w", err)
 	}
 
+	keyHash := sha256.Sum256(keyBytes)
+
 	return &SessionManager{
-		gcm:    gcm,
-		secure: secure,
+		gcm:      gcm,
+		keyID:    hex.EncodeToString(keyHash[:8]),
+		secure:   secure,
+		store:    store,
+		timeouts: timeouts,
 	}, nil
 }
 
+// KeyID возвращает отпечаток ключа шифрования сессий.
+func (sm *SessionManager) KeyID() string {
+	return sm.keyID
+}
+
+// Timeouts возвращает таймауты сессий.
+func (sm *SessionManager) Timeouts() SessionTimeouts {
+	return sm.timeouts
+}
+
 // Encrypt шифрует SessionData и возвращает base64-строку.
 func (sm *SessionManager) Encrypt(data *SessionData) (string, error) {
 	plaintext, err := json.Marshal(data)
@@ -139,18 +202,44 @@ func (sm *SessionManager) Decrypt(encrypted string) (*SessionData, error) {
 	return &data, nil
 }
 
-// SetSessionCookie устанавливает зашифрованный session cookie в ответ.
-func (sm *SessionManager) SetSessionCookie(w http.ResponseWriter, data *SessionData) error {
-	encrypted, err := sm.Encrypt(data)
+// CreateSession сохраняет новую сессию и устанавливает cookie с её токеном.
+// Заполняет data.ID.
+func (sm *SessionManager) CreateSession(w http.ResponseWriter, r *http.Request, data *SessionData) error {
+	token := make([]byte, sessionTokenSize)
+	if _, err := io.ReadFull(rand.Reader, token); err != nil {
+		return fmt.Errorf("ошибка генерации токена сессии: %w", err)
+	}
+
+	tokens, err := sm.encryptTokens(data)
 	if err != nil {
 		return err
 	}
 
+	session := &model.UISession{
+		ID:         uuid.New().String(),
+		TokenHash:  tokenHash(token),
+		KeyID:      sm.keyID,
+		Subject:    data.Subject,
+		Username:   data.Username,
+		Email:      data.Email,
+		IdpRole:    data.IdpRole,
+		Role:       data.Role,
+		Groups:     data.Groups,
+		Tokens:     tokens,
+		RemoteAddr: clientIP(r),
+		UserAgent:  r.UserAgent(),
+		ExpiresAt:  time.Now().Add(sm.timeouts.Absolute),
+	}
+	if err := sm.store.Create(r.Context(), session); err != nil {
+		return fmt.Errorf("ошибка сохранения сессии: %w", err)
+	}
+	data.ID = session.ID
+
 	http.SetCookie(w, &http.Cookie{
 		Name:     SessionCookieName,
-		Value:    encrypted,
+		Value:    base64.RawURLEncoding.EncodeToString(token),
 		Path:     "/admin",
-		MaxAge:   SessionCookieMaxAge,
+		MaxAge:   int(sm.timeouts.Absolute / time.Second),
 		HttpOnly: true,
 		Secure:   sm.secure,
 		SameSite: http.SameSiteLaxMode,
@@ -158,8 +247,9 @@ func (sm *SessionManager) SetSessionCookie(w http.ResponseWriter, data *SessionD
 	return nil
 }
 
-// GetSessionFromRequest извлекает и дешифрует SessionData из cookie запроса.
-// Возвращает nil, nil если cookie отсутствует.
+// GetSessionFromRequest возвращает сессию по cookie запроса и отмечает активность.
+// Возвращает nil, nil если cookie отсутствует и ErrSessionInvalid, если
+// сессия не найдена или больше не действует (недействительная сессия удаляется).
 func (sm *SessionManager) GetSessionFromRequest(r *http.Request) (*SessionData, error) {
 	cookie, err := r.Cookie(SessionCookieName)
 	if err != nil {
@@ -169,10 +259,65 @@ func (sm *SessionManager) GetSessionFromRequest(r *http.Request) (*SessionData,
 		return nil, err
 	}
 
-	return sm.Decrypt(cookie.Value)
+	token, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil || len(token) != sessionTokenSize {
+		return nil, ErrSessionInvalid
+	}
+
+	ctx := r.Context()
+	session, err := sm.store.GetByTokenHash(ctx, tokenHash(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrSessionInvalid
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	data, err := sm.sessionData(session, now)
+	if err != nil {
+		if delErr := sm.store.Delete(ctx, session.ID); delErr != nil && !errors.Is(delErr, repository.ErrNotFound) {
+			return nil, delErr
+		}
+		return nil, err
+	}
+
+	if now.Sub(session.LastSeenAt) >= sessionTouchInterval {
+		if err := sm.store.Touch(ctx, session.ID, now); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// UpdateTokens сохраняет токены сессии, обновлённые через refresh token.
+func (sm *SessionManager) UpdateTokens(ctx context.Context, data *SessionData) error {
+	tokens, err := sm.encryptTokens(data)
+	if err != nil {
+		return err
+	}
+	if err := sm.store.UpdateTokens(ctx, data.ID, tokens); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrSessionInvalid
+		}
+		return fmt.Errorf("ошибка сохранения токенов сессии: %w", err)
+	}
+	return nil
+}
+
+// DestroySession удаляет сессию (logout) и её cookie.
+func (sm *SessionManager) DestroySession(ctx context.Context, w http.ResponseWriter, data *SessionData) error {
+	sm.ClearSessionCookie(w)
+	if data == nil || data.ID == "" {
+		return nil
+	}
+	if err := sm.store.Delete(ctx, data.ID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("ошибка удаления сессии: %w", err)
+	}
+	return nil
 }
 
-// ClearSessionCookie удаляет session cookie из ответа (logout).
+// ClearSessionCookie удаляет session cookie из ответа.
 func (sm *SessionManager) ClearSessionCookie(w http.ResponseWriter) {
 	http.SetCookie(w, &http.Cookie{
 		Name:     SessionCookieName,
@@ -185,6 +330,61 @@ func (sm *SessionManager) ClearSessionCookie(w http.ResponseWriter) {
 	})
 }
 
+// sessionData проверяет сроки и ключ сессии и расшифровывает её токены.
+func (sm *SessionManager) sessionData(session *model.UISession, now time.Time) (*SessionData, error) {
+	if session.KeyID != sm.keyID ||
+		!now.Before(session.ExpiresAt) ||
+		!now.Before(session.LastSeenAt.Add(sm.timeouts.Idle)) {
+		return nil, ErrSessionInvalid
+	}
+
+	tokens, err := sm.Decrypt(session.Tokens)
+	if err != nil {
+		return nil, ErrSessionInvalid
+	}
+
+	return &SessionData{
+		ID:           session.ID,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt,
+		Subject:      session.Subject,
+		Username:     session.Username,
+		Email:        session.Email,
+		IdpRole:      session.IdpRole,
+		Role:         session.Role,
+		Groups:       session.Groups,
+	}, nil
+}
+
+// encryptTokens шифрует токены Keycloak сессии для хранения в БД.
+func (sm *SessionManager) encryptTokens(data *SessionData) (string, error) {
+	return sm.Encrypt(&SessionData{
+		AccessToken:  data.AccessToken,
+		RefreshToken: data.RefreshToken,
+		ExpiresAt:    data.ExpiresAt,
+	})
+}
+
+// tokenHash возвращает SHA-256 токена сессии.
+func tokenHash(token []byte) []byte {
+	h := sha256.Sum256(token)
+	return h[:]
+}
+
+// clientIP возвращает IP-адрес клиента, определённый middleware RequestID
+// с учётом доверенных прокси (AM_TRUSTED_PROXIES), иначе — адрес соединения.
+func clientIP(r *http.Request) string {
+	if ip := middleware.SourceIPFromContext(r.Context()); ip != "" {
+		return ip
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
 // sha256Key хеширует строковый ключ в 32 bytes через SHA-256.
 func sha256Key(key string) []byte {
 	h := sha256.Sum256([]byte(key))
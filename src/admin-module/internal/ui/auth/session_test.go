@@ -1,15 +1,22 @@
 package auth
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 )
 
 // TestSessionEncryptDecryptRoundTrip проверяет шифрование и дешифрование SessionData.
 func TestSessionEncryptDecryptRoundTrip(t *testing.T) {
-	sm, err := NewSessionManager("", false)
+	sm, err := NewSessionManager("", false, nil, SessionTimeouts{})
 	if err != nil {
 		t.Fatalf("Ошибка создания SessionManager: %v", err)
 	}
@@ -66,7 +73,7 @@ func TestSessionEncryptDecryptRoundTrip(t *testing.T) {
 
 // TestSessionManagerWithStringKey проверяет инициализацию с произвольной строкой-ключом.
 func TestSessionManagerWithStringKey(t *testing.T) {
-	sm, err := NewSessionManager("my-secret-key-for-testing", false)
+	sm, err := NewSessionManager("my-secret-key-for-testing", false, nil, SessionTimeouts{})
 	if err != nil {
 		t.Fatalf("Ошибка создания SessionManager с string-ключом: %v", err)
 	}
@@ -94,8 +101,8 @@ func TestSessionManagerWithStringKey(t *testing.T) {
 
 // TestSessionDecryptWithWrongKey проверяет, что дешифрование чужим ключом не работает.
 func TestSessionDecryptWithWrongKey(t *testing.T) {
-	sm1, _ := NewSessionManager("key-one", false)
-	sm2, _ := NewSessionManager("key-two", false)
+	sm1, _ := NewSessionManager("key-one", false, nil, SessionTimeouts{})
+	sm2, _ := NewSessionManager("key-two", false, nil, SessionTimeouts{})
 
 	data := &SessionData{AccessToken: "secret"}
 	encrypted, err := sm1.Encrypt(data)
@@ -137,56 +144,65 @@ func TestSessionIsExpired(t *testing.T) {
 	}
 }
 
-// TestSessionCookieSetAndGet проверяет установку и извлечение cookie.
-func TestSessionCookieSetAndGet(t *testing.T) {
-	sm, _ := NewSessionManager("test-key", false)
+// TestSessionCreateAndGet проверяет создание серверной сессии и её получение по cookie.
+func TestSessionCreateAndGet(t *testing.T) {
+	store := newMemStore()
+	sm := newTestSessionManager(t, "test-key", store)
 
 	data := &SessionData{
-		AccessToken: "access-123",
-		Username:    "admin",
-		Role:        "admin",
-		ExpiresAt:   time.Now().Add(5 * time.Minute).Unix(),
+		AccessToken:  "access-123",
+		RefreshToken: "refresh-456",
+		Subject:      "kc-user-1",
+		Username:     "admin",
+		IdpRole:      "readonly",
+		Role:         "admin",
+		ExpiresAt:    time.Now().Add(5 * time.Minute).Unix(),
 	}
 
-	// Устанавливаем cookie
 	w := httptest.NewRecorder()
-	if err := sm.SetSessionCookie(w, data); err != nil {
-		t.Fatalf("Ошибка установки cookie: %v", err)
+	req := httptest.NewRequest(http.MethodGet, "/admin/callback", nil)
+	// Без RequestID middleware X-Forwarded-For не учитывается
+	req.Header.Set("X-Forwarded-For", "10.0.0.1, 10.0.0.2")
+	if err := sm.CreateSession(w, req, data); err != nil {
+		t.Fatalf("Ошибка создания сессии: %v", err)
 	}
-
-	// Извлекаем cookie из response и создаём request с ним
-	resp := w.Result()
-	cookies := resp.Cookies()
-	if len(cookies) == 0 {
-		t.Fatal("Cookie не установлен")
+	if data.ID == "" {
+		t.Fatal("CreateSession не заполнил ID")
 	}
 
-	req := httptest.NewRequest(http.MethodGet, "/admin/", nil)
-	req.AddCookie(cookies[0])
+	cookie := sessionCookie(t, w)
+	if strings.Contains(cookie.Value, "access-123") || len(cookie.Value) > 64 {
+		t.Errorf("Cookie должен содержать только токен сессии, получено %q", cookie.Value)
+	}
+	stored := store.sessions[data.ID]
+	if stored == nil || stored.RemoteAddr != "192.0.2.1" || stored.Role != "admin" || stored.KeyID != sm.KeyID() {
+		t.Fatalf("Сессия в хранилище: %+v", stored)
+	}
+	if strings.Contains(stored.Tokens, "access-123") {
+		t.Error("Токены в хранилище должны быть зашифрованы")
+	}
 
-	// Читаем сессию из request
-	got, err := sm.GetSessionFromRequest(req)
+	got, err := sm.GetSessionFromRequest(requestWithCookie(cookie))
 	if err != nil {
-		t.Fatalf("Ошибка чтения сессии из cookie: %v", err)
-	}
-	if got == nil {
-		t.Fatal("Сессия не найдена")
+		t.Fatalf("Ошибка чтения сессии: %v", err)
 	}
-	if got.AccessToken != data.AccessToken {
-		t.Errorf("AccessToken: want %q, got %q", data.AccessToken, got.AccessToken)
+	if got.ID != data.ID || got.AccessToken != data.AccessToken || got.RefreshToken != data.RefreshToken {
+		t.Errorf("Токены сессии: got %+v", got)
 	}
-	if got.Username != data.Username {
-		t.Errorf("Username: want %q, got %q", data.Username, got.Username)
+	if got.Username != "admin" || got.IdpRole != "readonly" || got.Role != "admin" {
+		t.Errorf("Данные пользователя: got %+v", got)
 	}
 
 	// Проверяем атрибуты cookie
-	cookie := cookies[0]
 	if cookie.Name != SessionCookieName {
 		t.Errorf("Cookie name: want %q, got %q", SessionCookieName, cookie.Name)
 	}
 	if cookie.Path != "/admin" {
 		t.Errorf("Cookie path: want %q, got %q", "/admin", cookie.Path)
 	}
+	if cookie.MaxAge != int((24 * time.Hour).Seconds()) {
+		t.Errorf("Cookie MaxAge: want %d, got %d", int((24 * time.Hour).Seconds()), cookie.MaxAge)
+	}
 	if !cookie.HttpOnly {
 		t.Error("Cookie должен быть HttpOnly")
 	}
@@ -195,9 +211,130 @@ func TestSessionCookieSetAndGet(t *testing.T) {
 	}
 }
 
+// TestSessionInvalid проверяет, что отозванные, истёкшие и зашифрованные
+// другим ключом сессии недействительны и удаляются из хранилища.
+func TestSessionInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *model.UISession)
+		key    string
+	}{
+		{name: "отозвана", modify: func(s *model.UISession) { s.ID = "" }},
+		{name: "таймаут неактивности", modify: func(s *model.UISession) { s.LastSeenAt = time.Now().Add(-2 * time.Hour) }},
+		{name: "абсолютный таймаут", modify: func(s *model.UISession) { s.ExpiresAt = time.Now().Add(-time.Second) }},
+		{name: "другой ключ", key: "new-key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemStore()
+			sm := newTestSessionManager(t, "test-key", store)
+
+			data := &SessionData{AccessToken: "access", Username: "user"}
+			w := httptest.NewRecorder()
+			if err := sm.CreateSession(w, httptest.NewRequest(http.MethodGet, "/admin/callback", nil), data); err != nil {
+				t.Fatalf("Ошибка создания сессии: %v", err)
+			}
+			cookie := sessionCookie(t, w)
+
+			if tt.modify != nil {
+				tt.modify(store.sessions[data.ID])
+				if store.sessions[data.ID].ID == "" {
+					delete(store.sessions, data.ID)
+				}
+			}
+			if tt.key != "" {
+				sm = newTestSessionManager(t, tt.key, store)
+			}
+
+			got, err := sm.GetSessionFromRequest(requestWithCookie(cookie))
+			if !errors.Is(err, ErrSessionInvalid) || got != nil {
+				t.Fatalf("GetSessionFromRequest() = %+v, %v, хотели ErrSessionInvalid", got, err)
+			}
+			if _, ok := store.sessions[data.ID]; ok {
+				t.Error("Недействительная сессия должна быть удалена")
+			}
+		})
+	}
+}
+
+// TestSessionTouch проверяет обновление времени активности не чаще sessionTouchInterval.
+func TestSessionTouch(t *testing.T) {
+	store := newMemStore()
+	sm := newTestSessionManager(t, "test-key", store)
+
+	data := &SessionData{AccessToken: "access"}
+	w := httptest.NewRecorder()
+	if err := sm.CreateSession(w, httptest.NewRequest(http.MethodGet, "/admin/callback", nil), data); err != nil {
+		t.Fatalf("Ошибка создания сессии: %v", err)
+	}
+	cookie := sessionCookie(t, w)
+
+	if _, err := sm.GetSessionFromRequest(requestWithCookie(cookie)); err != nil {
+		t.Fatalf("Ошибка чтения сессии: %v", err)
+	}
+	if store.touches != 0 {
+		t.Errorf("Touch вызван %d раз для свежей сессии", store.touches)
+	}
+
+	store.sessions[data.ID].LastSeenAt = time.Now().Add(-10 * time.Minute)
+	if _, err := sm.GetSessionFromRequest(requestWithCookie(cookie)); err != nil {
+		t.Fatalf("Ошибка чтения сессии: %v", err)
+	}
+	if store.touches != 1 || time.Since(store.sessions[data.ID].LastSeenAt) > time.Minute {
+		t.Errorf("Активность не обновлена: touches=%d", store.touches)
+	}
+}
+
+// TestSessionUpdateTokensAndDestroy проверяет сохранение обновлённых токенов и logout.
+func TestSessionUpdateTokensAndDestroy(t *testing.T) {
+	store := newMemStore()
+	sm := newTestSessionManager(t, "test-key", store)
+
+	data := &SessionData{AccessToken: "access-1", RefreshToken: "refresh-1"}
+	w := httptest.NewRecorder()
+	if err := sm.CreateSession(w, httptest.NewRequest(http.MethodGet, "/admin/callback", nil), data); err != nil {
+		t.Fatalf("Ошибка создания сессии: %v", err)
+	}
+	cookie := sessionCookie(t, w)
+
+	data.AccessToken, data.RefreshToken = "access-2", "refresh-2"
+	if err := sm.UpdateTokens(context.Background(), data); err != nil {
+		t.Fatalf("Ошибка обновления токенов: %v", err)
+	}
+	got, err := sm.GetSessionFromRequest(requestWithCookie(cookie))
+	if err != nil || got.AccessToken != "access-2" || got.RefreshToken != "refresh-2" {
+		t.Fatalf("После UpdateTokens: %+v, %v", got, err)
+	}
+
+	w = httptest.NewRecorder()
+	if err := sm.DestroySession(context.Background(), w, got); err != nil {
+		t.Fatalf("Ошибка удаления сессии: %v", err)
+	}
+	if len(store.sessions) != 0 {
+		t.Error("Сессия не удалена из хранилища")
+	}
+	if c := sessionCookie(t, w); c.MaxAge != -1 {
+		t.Errorf("Cookie не очищен: MaxAge=%d", c.MaxAge)
+	}
+	if err := sm.UpdateTokens(context.Background(), data); !errors.Is(err, ErrSessionInvalid) {
+		t.Errorf("UpdateTokens() удалённой сессии = %v, хотели ErrSessionInvalid", err)
+	}
+}
+
+// TestSessionMalformedCookie проверяет, что cookie прежнего формата недействителен.
+func TestSessionMalformedCookie(t *testing.T) {
+	sm := newTestSessionManager(t, "test-key", newMemStore())
+
+	req := requestWithCookie(&http.Cookie{Name: SessionCookieName, Value: "not-a-session-token"})
+	if _, err := sm.GetSessionFromRequest(req); !errors.Is(err, ErrSessionInvalid) {
+		t.Errorf("GetSessionFromRequest() = %v, хотели ErrSessionInvalid", err)
+	}
+}
+
 // TestSessionCookieMissing проверяет, что отсутствие cookie возвращает nil, nil.
 func TestSessionCookieMissing(t *testing.T) {
-	sm, _ := NewSessionManager("test-key", false)
+	sm := newTestSessionManager(t, "test-key", newMemStore())
 
 	req := httptest.NewRequest(http.MethodGet, "/admin/", nil)
 	data, err := sm.GetSessionFromRequest(req)
@@ -211,7 +348,7 @@ func TestSessionCookieMissing(t *testing.T) {
 
 // TestClearSessionCookie проверяет очистку session cookie.
 func TestClearSessionCookie(t *testing.T) {
-	sm, _ := NewSessionManager("test-key", false)
+	sm := newTestSessionManager(t, "test-key", newMemStore())
 
 	w := httptest.NewRecorder()
 	sm.ClearSessionCookie(w)
@@ -230,3 +367,84 @@ func TestClearSessionCookie(t *testing.T) {
 		t.Error("Value должен быть пустым")
 	}
 }
+
+// memStore — SessionStore в памяти для тестов.
+type memStore struct {
+	sessions map[string]*model.UISession
+	touches  int
+}
+
+func newMemStore() *memStore {
+	return &memStore{sessions: make(map[string]*model.UISession)}
+}
+
+func (m *memStore) Create(_ context.Context, s *model.UISession) error {
+	now := time.Now()
+	s.CreatedAt, s.LastSeenAt = now, now
+	m.sessions[s.ID] = s
+	return nil
+}
+
+func (m *memStore) GetByTokenHash(_ context.Context, hash []byte) (*model.UISession, error) {
+	for _, s := range m.sessions {
+		if bytes.Equal(s.TokenHash, hash) {
+			cp := *s
+			return &cp, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memStore) Touch(_ context.Context, id string, at time.Time) error {
+	m.touches++
+	if s, ok := m.sessions[id]; ok {
+		s.LastSeenAt = at
+	}
+	return nil
+}
+
+func (m *memStore) UpdateTokens(_ context.Context, id, tokens string) error {
+	s, ok := m.sessions[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	s.Tokens = tokens
+	return nil
+}
+
+func (m *memStore) Delete(_ context.Context, id string) error {
+	if _, ok := m.sessions[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(m.sessions, id)
+	return nil
+}
+
+// newTestSessionManager создаёт SessionManager с таймаутами 1h/24h.
+func newTestSessionManager(t *testing.T, key string, store SessionStore) *SessionManager {
+	t.Helper()
+	sm, err := NewSessionManager(key, false, store, SessionTimeouts{Idle: time.Hour, Absolute: 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("Ошибка создания SessionManager: %v", err)
+	}
+	return sm
+}
+
+// sessionCookie возвращает session cookie из ответа.
+func sessionCookie(t *testing.T, w *httptest.ResponseRecorder) *http.Cookie {
+	t.Helper()
+	for _, c := range w.Result().Cookies() {
+		if c.Name == SessionCookieName {
+			return c
+		}
+	}
+	t.Fatal("Session cookie не установлен")
+	return nil
+}
+
+// requestWithCookie создаёт запрос к /admin/ с cookie.
+func requestWithCookie(cookie *http.Cookie) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/admin/", nil)
+	req.AddCookie(cookie)
+	return req
+}
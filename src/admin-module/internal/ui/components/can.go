@@ -0,0 +1,14 @@
+package components
+
+import (
+	"context"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
+)
+
+// Can проверяет разрешение текущего пользователя — для показа действий
+// в шаблонах. Доступ к самим действиям проверяют маршруты UI.
+func Can(ctx context.Context, perm rbac.Permission) bool {
+	return uimiddleware.Can(ctx, perm)
+}
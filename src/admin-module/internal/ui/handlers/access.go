@@ -1,7 +1,9 @@
 // Пакет handlers — HTTP-обработчики Admin UI.
 // Файл access.go — обработчики страницы «Управление доступом»:
-// два таба (Пользователи / Service Accounts), фильтрация, поиск,
-// role overrides для пользователей, CRUD SA, ротация секрета, синхронизация.
+// табы Пользователи / Service Accounts / Сессии / Токены / Роли, фильтрация, поиск,
+// role overrides для пользователей, CRUD SA, ротация секрета, синхронизация,
+// список активных UI-сессий и их завершение, пользовательские роли.
+// Табы и действия доступны по разрешениям пользователя.
 package handlers
 
 import (
@@ -9,12 +11,18 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
+	apimiddleware "github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/auth"
 	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages"
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages/partials"
@@ -31,7 +39,13 @@ type AccessHandler struct {
 	usersSvc *service.AdminUserService
 	saSvc    *service.ServiceAccountService
 	idpSvc   *service.IDPService
-	logger   *slog.Logger
+	// sessionsSvc — серверные сессии Admin UI
+	sessionsSvc *service.UISessionService
+	// rolesSvc — каталог ролей (встроенные и пользовательские)
+	rolesSvc *service.RoleService
+	// collectionsSvc — коллекции файлов, участники и квоты
+	collectionsSvc *service.CollectionService
+	logger         *slog.Logger
 }
 
 // NewAccessHandler создаёт новый AccessHandler.
@@ -39,13 +53,19 @@ func NewAccessHandler(
 	usersSvc *service.AdminUserService,
 	saSvc *service.ServiceAccountService,
 	idpSvc *service.IDPService,
+	sessionsSvc *service.UISessionService,
+	rolesSvc *service.RoleService,
+	collectionsSvc *service.CollectionService,
 	logger *slog.Logger,
 ) *AccessHandler {
 	return &AccessHandler{
-		usersSvc: usersSvc,
-		saSvc:    saSvc,
-		idpSvc:   idpSvc,
-		logger:   logger.With(slog.String("component", "ui.access")),
+		usersSvc:       usersSvc,
+		saSvc:          saSvc,
+		idpSvc:         idpSvc,
+		sessionsSvc:    sessionsSvc,
+		rolesSvc:       rolesSvc,
+		collectionsSvc: collectionsSvc,
+		logger:         logger.With(slog.String("component", "ui.access")),
 	}
 }
 
@@ -61,10 +81,15 @@ func (h *AccessHandler) HandleAccess(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	// Определяем активный таб
+	// Определяем активный таб среди доступных пользователю
+	tabs := accessTabs(r)
+	if len(tabs) == 0 {
+		http.Redirect(w, r, "/admin/", http.StatusFound)
+		return
+	}
 	activeTab := r.URL.Query().Get("tab")
-	if activeTab != "sa" {
-		activeTab = "users"
+	if !slices.Contains(tabs, activeTab) {
+		activeTab = tabs[0]
 	}
 
 	// === Пользователи ===
@@ -164,19 +189,10 @@ func (h *AccessHandler) HandleAccess(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Преобразуем SA в UI-модели с поиском
+	now := time.Now()
 	saItems := make([]pages.SAListItem, 0, len(sas))
 	for _, sa := range sas {
-		item := pages.SAListItem{
-			ID:           sa.ID,
-			ClientID:     sa.ClientID,
-			Name:         sa.Name,
-			Description:  sa.Description,
-			Scopes:       sa.Scopes,
-			Status:       sa.Status,
-			Source:       sa.Source,
-			LastSyncedAt: sa.LastSyncedAt,
-			CreatedAt:    sa.CreatedAt,
-		}
+		item := h.saListItem(sa, now)
 
 		// Поиск по name/client_id
 		if saSearch != "" &&
@@ -205,6 +221,20 @@ func (h *AccessHandler) HandleAccess(w http.ResponseWriter, r *http.Request) {
 	}
 	pagedSAItems := saItems[saStartIdx:saEndIdx]
 
+	// SA, секрет которых скоро истекает
+	var expiringItems []pages.SAListItem
+	if warning := h.saSvc.SecretPolicy().ExpiryWarning; warning > 0 {
+		expiring, expErr := h.saSvc.ListExpiringSecrets(ctx, warning)
+		if expErr != nil {
+			h.logger.Error("Ошибка получения SA с истекающими секретами",
+				slog.String("error", expErr.Error()),
+			)
+		}
+		for _, sa := range expiring {
+			expiringItems = append(expiringItems, h.saListItem(sa, now))
+		}
+	}
+
 	// Статус IdP
 	idpStatus := h.idpSvc.GetStatus(ctx)
 
@@ -212,6 +242,9 @@ func (h *AccessHandler) HandleAccess(w http.ResponseWriter, r *http.Request) {
 		Username:  session.Username,
 		Role:      session.Role,
 		ActiveTab: activeTab,
+		RoleNames: h.roleNames(),
+
+		SessionsUser: r.URL.Query().Get("user"),
 
 		// Пользователи
 		Users: pagedUserItems,
@@ -235,7 +268,10 @@ func (h *AccessHandler) HandleAccess(w http.ResponseWriter, r *http.Request) {
 		SATotalPages: saTotalPages,
 		SATotalItems: saTotalFiltered,
 
+		ExpiringSecrets: expiringItems,
+
 		// IdP статус
+		IDPProvider:     idpStatus.Provider,
 		IDPConnected:    idpStatus.Connected,
 		IDPRealm:        idpStatus.Realm,
 		IDPUsersCount:   idpStatus.UsersCount,
@@ -256,11 +292,6 @@ func (h *AccessHandler) HandleAccess(w http.ResponseWriter, r *http.Request) {
 // HandleUsersTablePartial обрабатывает GET /admin/partials/users-table — partial для HTMX.
 func (h *AccessHandler) HandleUsersTablePartial(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	session := uimiddleware.SessionFromContext(ctx)
-	role := ""
-	if session != nil {
-		role = session.Role
-	}
 
 	page := 1
 	if p, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && p > 0 {
@@ -329,7 +360,6 @@ func (h *AccessHandler) HandleUsersTablePartial(w http.ResponseWriter, r *http.R
 
 	tableData := partials.UsersTableData{
 		Items:      items[offset:end],
-		Role:       role,
 		Page:       page,
 		TotalPages: totalPages,
 		TotalItems: totalFiltered,
@@ -349,12 +379,6 @@ func (h *AccessHandler) HandleUserDetail(w http.ResponseWriter, r *http.Request)
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	session := uimiddleware.SessionFromContext(ctx)
-	role := ""
-	if session != nil {
-		role = session.Role
-	}
-
 	user, err := h.usersSvc.GetUser(ctx, id)
 	if err != nil {
 		h.renderAlert(w, r, "Ошибка получения пользователя: "+err.Error())
@@ -373,7 +397,8 @@ func (h *AccessHandler) HandleUserDetail(w http.ResponseWriter, r *http.Request)
 		RoleOverride:  user.RoleOverride,
 		EffectiveRole: user.EffectiveRole,
 		CreatedAt:     user.CreatedAt,
-		Role:          role, // Роль текущего пользователя (для RBAC)
+		// Роли для role override (проверку эскалации выполняет сервис)
+		AssignableRoles: h.roleNames(),
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -384,29 +409,39 @@ func (h *AccessHandler) HandleUserDetail(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// HandleAddRoleOverride обрабатывает POST /admin/partials/user-role-override/{id} — повышение роли.
+// HandleAddRoleOverride обрабатывает POST /admin/partials/user-role-override/{id} —
+// назначение роли (form role, по умолчанию admin).
 func (h *AccessHandler) HandleAddRoleOverride(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	session := uimiddleware.SessionFromContext(ctx)
-	if session == nil || session.Role != roleAdmin {
+	if !uimiddleware.Can(ctx, rbac.PermUsersWrite) {
 		h.renderAlert(w, r, "Нет прав для этого действия")
 		return
 	}
 
-	_, err := h.usersSvc.SetRoleOverride(ctx, id, "admin", session.Username)
+	role := r.FormValue("role")
+	if role == "" {
+		role = rbac.RoleAdmin
+	}
+
+	_, err := h.usersSvc.SetRoleOverride(ctx, id, role, apimiddleware.ClaimsFromContext(ctx))
 	if err != nil {
 		h.logger.Warn("Ошибка добавления role override",
 			slog.String("user_id", id),
+			slog.String("role", role),
 			slog.String("error", err.Error()),
 		)
-		h.renderAlert(w, r, "Ошибка повышения роли: "+err.Error())
+		if errors.Is(err, service.ErrForbidden) {
+			h.renderAlert(w, r, "Нельзя назначить роль с разрешениями, которых нет у вас")
+		} else {
+			h.renderAlert(w, r, "Ошибка назначения роли: "+err.Error())
+		}
 		return
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := partials.AccessActionSuccess("Роль пользователя повышена до admin").Render(ctx, w); err != nil {
+	if err := partials.AccessActionSuccess("Пользователю назначена роль "+role).Render(ctx, w); err != nil {
 		h.logger.Error("Ошибка рендеринга success",
 			slog.String("error", err.Error()),
 		)
@@ -420,13 +455,12 @@ func (h *AccessHandler) HandleRemoveRoleOverride(w http.ResponseWriter, r *http.
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	session := uimiddleware.SessionFromContext(ctx)
-	if session == nil || session.Role != roleAdmin {
+	if !uimiddleware.Can(ctx, rbac.PermUsersWrite) {
 		h.renderAlert(w, r, "Нет прав для этого действия")
 		return
 	}
 
-	err := h.usersSvc.DeleteUser(ctx, id)
+	err := h.usersSvc.RemoveRoleOverride(ctx, id)
 	if err != nil {
 		h.logger.Warn("Ошибка удаления role override",
 			slog.String("user_id", id),
@@ -451,11 +485,6 @@ func (h *AccessHandler) HandleRemoveRoleOverride(w http.ResponseWriter, r *http.
 // HandleSATablePartial обрабатывает GET /admin/partials/sa-table — partial таблицы SA.
 func (h *AccessHandler) HandleSATablePartial(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	session := uimiddleware.SessionFromContext(ctx)
-	role := ""
-	if session != nil {
-		role = session.Role
-	}
 
 	page := 1
 	if p, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && p > 0 {
@@ -476,19 +505,10 @@ func (h *AccessHandler) HandleSATablePartial(w http.ResponseWriter, r *http.Requ
 		)
 	}
 
+	now := time.Now()
 	items := make([]pages.SAListItem, 0, len(sas))
 	for _, sa := range sas {
-		item := pages.SAListItem{
-			ID:           sa.ID,
-			ClientID:     sa.ClientID,
-			Name:         sa.Name,
-			Description:  sa.Description,
-			Scopes:       sa.Scopes,
-			Status:       sa.Status,
-			Source:       sa.Source,
-			LastSyncedAt: sa.LastSyncedAt,
-			CreatedAt:    sa.CreatedAt,
-		}
+		item := h.saListItem(sa, now)
 
 		if search != "" &&
 			!containsLower(item.Name, toLower(search)) &&
@@ -516,7 +536,6 @@ func (h *AccessHandler) HandleSATablePartial(w http.ResponseWriter, r *http.Requ
 
 	tableData := partials.SATableData{
 		Items:      items[offset:end],
-		Role:       role,
 		Page:       page,
 		TotalPages: totalPages,
 		TotalItems: totalFiltered,
@@ -535,8 +554,7 @@ func (h *AccessHandler) HandleSATablePartial(w http.ResponseWriter, r *http.Requ
 func (h *AccessHandler) HandleSACreate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	session := uimiddleware.SessionFromContext(ctx)
-	if session == nil || session.Role != roleAdmin {
+	if !uimiddleware.Can(ctx, rbac.PermServiceAccountsWrite) {
 		h.renderAlert(w, r, "Нет прав для создания SA")
 		return
 	}
@@ -612,8 +630,7 @@ func (h *AccessHandler) HandleSAEdit(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	session := uimiddleware.SessionFromContext(ctx)
-	if session == nil || session.Role != roleAdmin {
+	if !uimiddleware.Can(ctx, rbac.PermServiceAccountsWrite) {
 		h.renderAlert(w, r, "Нет прав для редактирования SA")
 		return
 	}
@@ -645,9 +662,12 @@ func (h *AccessHandler) HandleSAEdit(w http.ResponseWriter, r *http.Request) {
 			slog.String("sa_id", id),
 			slog.String("error", err.Error()),
 		)
-		if errors.Is(err, service.ErrNotFound) {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
 			h.renderAlert(w, r, "SA не найден")
-		} else {
+		case errors.Is(err, service.ErrValidation):
+			h.renderAlert(w, r, err.Error())
+		default:
 			h.renderAlert(w, r, "Ошибка обновления: "+err.Error())
 		}
 		return
@@ -668,8 +688,7 @@ func (h *AccessHandler) HandleSADelete(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	session := uimiddleware.SessionFromContext(ctx)
-	if session == nil || session.Role != roleAdmin {
+	if !uimiddleware.Can(ctx, rbac.PermServiceAccountsWrite) {
 		h.renderAlert(w, r, "Нет прав для удаления SA")
 		return
 	}
@@ -701,13 +720,13 @@ func (h *AccessHandler) HandleSARotateSecret(w http.ResponseWriter, r *http.Requ
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	session := uimiddleware.SessionFromContext(ctx)
-	if session == nil || session.Role != roleAdmin {
+	if !uimiddleware.Can(ctx, rbac.PermServiceAccountsWrite) {
 		h.renderAlert(w, r, "Нет прав для ротации секрета")
 		return
 	}
 
-	clientID, newSecret, err := h.saSvc.RotateSecret(ctx, id)
+	// Из UI секрет ротируется с grace-периодом по умолчанию (AM_SA_SECRET_ROTATION_GRACE)
+	rotation, err := h.saSvc.RotateSecret(ctx, id, nil)
 	if err != nil {
 		h.logger.Warn("Ошибка ротации секрета SA",
 			slog.String("sa_id", id),
@@ -722,7 +741,7 @@ func (h *AccessHandler) HandleSARotateSecret(w http.ResponseWriter, r *http.Requ
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := partials.SASecretDisplay(clientID, newSecret).Render(ctx, w); err != nil {
+	if err := partials.SASecretDisplay(rotation.ClientID, rotation.ClientSecret, rotation.PreviousSecretExpiresAt).Render(ctx, w); err != nil {
 		h.logger.Error("Ошибка рендеринга SA secret display",
 			slog.String("error", err.Error()),
 		)
@@ -733,8 +752,7 @@ func (h *AccessHandler) HandleSARotateSecret(w http.ResponseWriter, r *http.Requ
 func (h *AccessHandler) HandleSASync(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	session := uimiddleware.SessionFromContext(ctx)
-	if session == nil || session.Role != roleAdmin {
+	if !uimiddleware.Can(ctx, rbac.PermIdpWrite) {
 		h.renderAlert(w, r, "Нет прав для синхронизации")
 		return
 	}
@@ -756,6 +774,582 @@ func (h *AccessHandler) HandleSASync(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// saListItem преобразует SA в UI-модель с состоянием секрета на момент now.
+func (h *AccessHandler) saListItem(sa *model.ServiceAccount, now time.Time) pages.SAListItem {
+	item := pages.SAListItem{
+		ID:              sa.ID,
+		ClientID:        sa.ClientID,
+		Name:            sa.Name,
+		Description:     sa.Description,
+		Scopes:          sa.Scopes,
+		Status:          sa.Status,
+		Source:          sa.Source,
+		LastSyncedAt:    sa.LastSyncedAt,
+		CreatedAt:       sa.CreatedAt,
+		SecretExpiresAt: sa.SecretExpiresAt,
+		SecretState:     pages.SecretStateNever,
+	}
+
+	if sa.SecretExpiresAt != nil {
+		switch {
+		case !sa.SecretExpiresAt.After(now):
+			item.SecretState = pages.SecretStateExpired
+		case sa.SecretExpiresAt.Before(now.Add(h.saSvc.SecretPolicy().ExpiryWarning)):
+			item.SecretState = pages.SecretStateExpiring
+		default:
+			item.SecretState = pages.SecretStateValid
+		}
+	}
+	return item
+}
+
+// HandleSessionsPartial обрабатывает GET /admin/partials/ui-sessions —
+// активные UI-сессии (query user — фильтр по пользователю). Разрешение users:read.
+func (h *AccessHandler) HandleSessionsPartial(w http.ResponseWriter, r *http.Request) {
+	session := uimiddleware.SessionFromContext(r.Context())
+	if session == nil || !uimiddleware.Can(r.Context(), rbac.PermUsersRead) {
+		h.renderAlert(w, r, "Нет прав для этого действия")
+		return
+	}
+	h.renderSessions(w, r, session, r.URL.Query().Get("user"), "", "")
+}
+
+// HandleSessionRevoke обрабатывает DELETE /admin/partials/ui-session/{id} —
+// завершение сессии. Разрешение users:write.
+func (h *AccessHandler) HandleSessionRevoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+	user := r.URL.Query().Get("user")
+
+	session := uimiddleware.SessionFromContext(ctx)
+	if session == nil || !uimiddleware.Can(ctx, rbac.PermUsersWrite) {
+		h.renderAlert(w, r, "Нет прав для этого действия")
+		return
+	}
+
+	if err := h.sessionsSvc.Revoke(ctx, id); err != nil {
+		h.logger.Warn("Ошибка завершения UI-сессии",
+			slog.String("session_id", id),
+			slog.String("error", err.Error()),
+		)
+		msg := "Ошибка завершения сессии: " + err.Error()
+		if errors.Is(err, service.ErrNotFound) {
+			msg = "Сессия не найдена или уже завершена"
+		}
+		h.renderSessions(w, r, session, user, "error", msg)
+		return
+	}
+	h.renderSessions(w, r, session, user, "success", "Сессия завершена")
+}
+
+// HandleUserSessionsRevoke обрабатывает DELETE /admin/partials/user-sessions/{id} —
+// завершение всех сессий пользователя. Разрешение users:write.
+func (h *AccessHandler) HandleUserSessionsRevoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	session := uimiddleware.SessionFromContext(ctx)
+	if session == nil || !uimiddleware.Can(ctx, rbac.PermUsersWrite) {
+		h.renderAlert(w, r, "Нет прав для этого действия")
+		return
+	}
+
+	n, err := h.sessionsSvc.RevokeUser(ctx, id)
+	if err != nil {
+		h.logger.Warn("Ошибка завершения UI-сессий пользователя",
+			slog.String("user_id", id),
+			slog.String("error", err.Error()),
+		)
+		h.renderSessions(w, r, session, id, "error", "Ошибка завершения сессий: "+err.Error())
+		return
+	}
+	h.renderSessions(w, r, session, id, "success", fmt.Sprintf("Завершено сессий: %d", n))
+}
+
+// renderSessions рендерит таблицу активных UI-сессий с необязательным alert.
+func (h *AccessHandler) renderSessions(
+	w http.ResponseWriter, r *http.Request,
+	current *auth.SessionData, user, alertVariant, alert string,
+) {
+	ctx := r.Context()
+
+	var subject *string
+	if user != "" {
+		subject = &user
+	}
+	sessions, err := h.sessionsSvc.List(ctx, subject)
+	if err != nil {
+		h.logger.Error("Ошибка получения UI-сессий",
+			slog.String("error", err.Error()),
+		)
+		alertVariant, alert = "error", "Ошибка получения сессий: "+err.Error()
+	}
+
+	data := partials.UISessionsData{
+		User:         user,
+		UserName:     user,
+		AlertVariant: alertVariant,
+		Alert:        alert,
+	}
+	for _, s := range sessions {
+		if s.Subject == user {
+			data.UserName = s.Username
+		}
+		data.Items = append(data.Items, partials.UISessionItem{
+			ID:         s.ID,
+			Subject:    s.Subject,
+			Username:   s.Username,
+			Email:      s.Email,
+			Role:       s.Role,
+			RemoteAddr: s.RemoteAddr,
+			UserAgent:  s.UserAgent,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    s.ID == current.ID,
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.UISessionsTable(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга UI-сессий",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// HandleRolesTablePartial обрабатывает GET /admin/partials/roles-table —
+// роли и матрица разрешений.
+func (h *AccessHandler) HandleRolesTablePartial(w http.ResponseWriter, r *http.Request) {
+	h.renderRoles(w, r, "", "")
+}
+
+// HandleRoleForm обрабатывает GET /admin/partials/role-form — форма роли
+// (query name — изменение пользовательской роли, без name — создание).
+func (h *AccessHandler) HandleRoleForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := r.URL.Query().Get("name")
+
+	data := partials.RoleFormData{Permissions: rbac.NewPermissionSet()}
+	if name != "" {
+		role, err := h.rolesSvc.Get(ctx, name)
+		if err != nil {
+			h.renderAlert(w, r, roleErrorMessage(err, "Ошибка получения роли"))
+			return
+		}
+		if role.Builtin {
+			h.renderAlert(w, r, fmt.Sprintf("Встроенная роль '%s' не изменяется", name))
+			return
+		}
+		data = partials.RoleFormData{
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: role.PermissionSet(),
+			Groups:      role.Groups,
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.RoleForm(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга формы роли",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// HandleRoleCreate обрабатывает POST /admin/partials/roles — создание роли.
+func (h *AccessHandler) HandleRoleCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		h.renderRoles(w, r, "error", "Ошибка разбора формы")
+		return
+	}
+
+	role, err := h.rolesSvc.Create(ctx, apimiddleware.ClaimsFromContext(ctx), roleInputFromForm(r))
+	if err != nil {
+		h.logger.Warn("Ошибка создания роли",
+			slog.String("name", r.FormValue("name")),
+			slog.String("error", err.Error()),
+		)
+		h.renderRoles(w, r, "error", roleErrorMessage(err, "Ошибка создания роли"))
+		return
+	}
+	h.renderRoles(w, r, "success", fmt.Sprintf("Роль '%s' создана", role.Name))
+}
+
+// HandleRoleUpdate обрабатывает PUT /admin/partials/roles/{name} — изменение роли.
+func (h *AccessHandler) HandleRoleUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := chi.URLParam(r, "name")
+
+	if err := r.ParseForm(); err != nil {
+		h.renderRoles(w, r, "error", "Ошибка разбора формы")
+		return
+	}
+
+	if _, err := h.rolesSvc.Update(ctx, apimiddleware.ClaimsFromContext(ctx), name, roleInputFromForm(r)); err != nil {
+		h.logger.Warn("Ошибка изменения роли",
+			slog.String("name", name),
+			slog.String("error", err.Error()),
+		)
+		h.renderRoles(w, r, "error", roleErrorMessage(err, "Ошибка изменения роли"))
+		return
+	}
+	h.renderRoles(w, r, "success", fmt.Sprintf("Роль '%s' изменена", name))
+}
+
+// HandleRoleDelete обрабатывает DELETE /admin/partials/roles/{name} — удаление роли.
+func (h *AccessHandler) HandleRoleDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := chi.URLParam(r, "name")
+
+	if err := h.rolesSvc.Delete(ctx, apimiddleware.ClaimsFromContext(ctx), name); err != nil {
+		h.logger.Warn("Ошибка удаления роли",
+			slog.String("name", name),
+			slog.String("error", err.Error()),
+		)
+		h.renderRoles(w, r, "error", roleErrorMessage(err, "Ошибка удаления роли"))
+		return
+	}
+	h.renderRoles(w, r, "success", fmt.Sprintf("Роль '%s' удалена", name))
+}
+
+// renderRoles рендерит таб ролей с необязательным alert.
+func (h *AccessHandler) renderRoles(w http.ResponseWriter, r *http.Request, alertVariant, alert string) {
+	ctx := r.Context()
+
+	roles, err := h.rolesSvc.List(ctx)
+	if err != nil {
+		h.logger.Error("Ошибка получения ролей",
+			slog.String("error", err.Error()),
+		)
+		alertVariant, alert = "error", "Ошибка получения ролей: "+err.Error()
+		roles = h.rolesSvc.Catalog().Roles()
+	}
+
+	data := partials.RolesData{
+		Roles:        roles,
+		AlertVariant: alertVariant,
+		Alert:        alert,
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.RolesTable(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга ролей",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// HandleCollectionsTablePartial обрабатывает GET /admin/partials/collections-table —
+// коллекции файлов с использованием квот.
+func (h *AccessHandler) HandleCollectionsTablePartial(w http.ResponseWriter, r *http.Request) {
+	h.renderCollections(w, r, "", "")
+}
+
+// HandleCollectionForm обрабатывает GET /admin/partials/collection-form — форма
+// коллекции (query id — изменение, без id — создание).
+func (h *AccessHandler) HandleCollectionForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.URL.Query().Get("id")
+
+	var data partials.CollectionFormData
+	if id != "" {
+		c, err := h.collectionsSvc.Get(ctx, id)
+		if err != nil {
+			h.renderAlert(w, r, collectionErrorMessage(err, "Ошибка получения коллекции"))
+			return
+		}
+		data = partials.CollectionFormData{
+			ID:          c.ID,
+			Name:        c.Name,
+			Description: c.Description,
+			QuotaBytes:  c.QuotaBytes,
+			QuotaFiles:  c.QuotaFiles,
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.CollectionForm(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга формы коллекции",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// HandleCollectionCreate обрабатывает POST /admin/partials/collections — создание коллекции.
+func (h *AccessHandler) HandleCollectionCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	in, err := collectionInputFromForm(r)
+	if err != nil {
+		h.renderCollections(w, r, "error", err.Error())
+		return
+	}
+
+	c, err := h.collectionsSvc.Create(ctx, apimiddleware.ClaimsFromContext(ctx), in)
+	if err != nil {
+		h.logger.Warn("Ошибка создания коллекции",
+			slog.String("name", in.Name),
+			slog.String("error", err.Error()),
+		)
+		h.renderCollections(w, r, "error", collectionErrorMessage(err, "Ошибка создания коллекции"))
+		return
+	}
+	h.renderCollections(w, r, "success", fmt.Sprintf("Коллекция '%s' создана", c.Name))
+}
+
+// HandleCollectionUpdate обрабатывает PUT /admin/partials/collections/{id} — изменение коллекции.
+func (h *AccessHandler) HandleCollectionUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	in, err := collectionInputFromForm(r)
+	if err != nil {
+		h.renderCollections(w, r, "error", err.Error())
+		return
+	}
+
+	c, err := h.collectionsSvc.Update(ctx, apimiddleware.ClaimsFromContext(ctx), id, in)
+	if err != nil {
+		h.logger.Warn("Ошибка изменения коллекции",
+			slog.String("id", id),
+			slog.String("error", err.Error()),
+		)
+		h.renderCollections(w, r, "error", collectionErrorMessage(err, "Ошибка изменения коллекции"))
+		return
+	}
+	h.renderCollections(w, r, "success", fmt.Sprintf("Коллекция '%s' изменена", c.Name))
+}
+
+// HandleCollectionDelete обрабатывает DELETE /admin/partials/collections/{id} — удаление коллекции.
+func (h *AccessHandler) HandleCollectionDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	if err := h.collectionsSvc.Delete(ctx, apimiddleware.ClaimsFromContext(ctx), id); err != nil {
+		h.logger.Warn("Ошибка удаления коллекции",
+			slog.String("id", id),
+			slog.String("error", err.Error()),
+		)
+		h.renderCollections(w, r, "error", collectionErrorMessage(err, "Ошибка удаления коллекции"))
+		return
+	}
+	h.renderCollections(w, r, "success", "Коллекция удалена")
+}
+
+// HandleCollectionMembers обрабатывает GET /admin/partials/collection-members/{id} —
+// участники коллекции.
+func (h *AccessHandler) HandleCollectionMembers(w http.ResponseWriter, r *http.Request) {
+	h.renderCollectionMembers(w, r, chi.URLParam(r, "id"), "", "")
+}
+
+// HandleCollectionMemberSet обрабатывает PUT /admin/partials/collection-members/{id} —
+// добавление участника или замена его прав.
+func (h *AccessHandler) HandleCollectionMemberSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	if err := r.ParseForm(); err != nil {
+		h.renderCollectionMembers(w, r, id, "error", "Ошибка разбора формы")
+		return
+	}
+
+	m, err := h.collectionsSvc.SetMember(ctx, apimiddleware.ClaimsFromContext(ctx), id, service.CollectionMemberInput{
+		SubjectType: r.FormValue("subject_type"),
+		SubjectID:   strings.TrimSpace(r.FormValue("subject_id")),
+		Permissions: r.Form["permissions"],
+	})
+	if err != nil {
+		h.logger.Warn("Ошибка сохранения участника коллекции",
+			slog.String("id", id),
+			slog.String("error", err.Error()),
+		)
+		h.renderCollectionMembers(w, r, id, "error", collectionErrorMessage(err, "Ошибка сохранения участника"))
+		return
+	}
+	h.renderCollectionMembers(w, r, id, "success", fmt.Sprintf("Участник '%s' сохранён", m.SubjectID))
+}
+
+// HandleCollectionMemberRemove обрабатывает
+// DELETE /admin/partials/collection-members/{id}/{type}/{subject} — удаление участника.
+func (h *AccessHandler) HandleCollectionMemberRemove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+	subject := chi.URLParam(r, "subject")
+
+	err := h.collectionsSvc.RemoveMember(ctx, apimiddleware.ClaimsFromContext(ctx), id, chi.URLParam(r, "type"), subject)
+	if err != nil {
+		h.logger.Warn("Ошибка удаления участника коллекции",
+			slog.String("id", id),
+			slog.String("subject", subject),
+			slog.String("error", err.Error()),
+		)
+		h.renderCollectionMembers(w, r, id, "error", collectionErrorMessage(err, "Ошибка удаления участника"))
+		return
+	}
+	h.renderCollectionMembers(w, r, id, "success", fmt.Sprintf("Участник '%s' удалён", subject))
+}
+
+// renderCollections рендерит таб коллекций с необязательным alert.
+func (h *AccessHandler) renderCollections(w http.ResponseWriter, r *http.Request, alertVariant, alert string) {
+	ctx := r.Context()
+
+	collections, err := h.collectionsSvc.List(ctx, nil)
+	if err != nil {
+		h.logger.Error("Ошибка получения коллекций",
+			slog.String("error", err.Error()),
+		)
+		alertVariant, alert = "error", "Ошибка получения коллекций: "+err.Error()
+	}
+
+	data := partials.CollectionsData{
+		Collections:  collections,
+		AlertVariant: alertVariant,
+		Alert:        alert,
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.CollectionsTable(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга коллекций",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// renderCollectionMembers рендерит панель участников коллекции с необязательным alert.
+func (h *AccessHandler) renderCollectionMembers(w http.ResponseWriter, r *http.Request, id, alertVariant, alert string) {
+	ctx := r.Context()
+
+	c, err := h.collectionsSvc.Get(ctx, id)
+	if err != nil {
+		h.renderAlert(w, r, collectionErrorMessage(err, "Ошибка получения коллекции"))
+		return
+	}
+
+	members, err := h.collectionsSvc.Members(ctx, id)
+	if err != nil {
+		h.logger.Error("Ошибка получения участников коллекции",
+			slog.String("id", id),
+			slog.String("error", err.Error()),
+		)
+		alertVariant, alert = "error", "Ошибка получения участников: "+err.Error()
+	}
+
+	data := partials.CollectionMembersData{
+		Collection:   c,
+		Members:      members,
+		AlertVariant: alertVariant,
+		Alert:        alert,
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.CollectionMembers(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга участников коллекции",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// roleNames возвращает имена ролей текущего каталога.
+func (h *AccessHandler) roleNames() []string {
+	roles := h.rolesSvc.Catalog().Roles()
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = role.Name
+	}
+	return names
+}
+
+// accessTabs возвращает табы страницы, доступные пользователю, в порядке отображения.
+func accessTabs(r *http.Request) []string {
+	ctx := r.Context()
+	var tabs []string
+	if uimiddleware.Can(ctx, rbac.PermUsersRead) {
+		tabs = append(tabs, "users")
+	}
+	if uimiddleware.Can(ctx, rbac.PermServiceAccountsRead) {
+		tabs = append(tabs, "sa")
+	}
+	if uimiddleware.Can(ctx, rbac.PermUsersRead) {
+		tabs = append(tabs, "sessions", "tokens")
+	}
+	if uimiddleware.Can(ctx, rbac.PermRolesRead) {
+		tabs = append(tabs, "roles")
+	}
+	if uimiddleware.Can(ctx, rbac.PermCollectionsRead) {
+		tabs = append(tabs, "collections")
+	}
+	return tabs
+}
+
+// roleInputFromForm собирает параметры роли из формы: группы IdP — через запятую.
+func roleInputFromForm(r *http.Request) service.RoleInput {
+	var groups []string
+	for _, g := range strings.Split(r.FormValue("groups"), ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			groups = append(groups, g)
+		}
+	}
+	return service.RoleInput{
+		Name:        strings.TrimSpace(r.FormValue("name")),
+		Description: strings.TrimSpace(r.FormValue("description")),
+		Permissions: r.Form["permissions"],
+		Groups:      groups,
+	}
+}
+
+// roleErrorMessage возвращает текст ошибки операции с ролью для alert.
+func roleErrorMessage(err error, message string) string {
+	switch {
+	case errors.Is(err, service.ErrValidation), errors.Is(err, service.ErrConflict):
+		return err.Error()
+	case errors.Is(err, service.ErrForbidden):
+		return "Нельзя выдать роли разрешения, которых нет у вас"
+	case errors.Is(err, service.ErrNotFound):
+		return "Роль не найдена"
+	default:
+		return message + ": " + err.Error()
+	}
+}
+
+// collectionInputFromForm собирает параметры коллекции из формы: пустая
+// квота — без ограничения.
+func collectionInputFromForm(r *http.Request) (service.CollectionInput, error) {
+	if err := r.ParseForm(); err != nil {
+		return service.CollectionInput{}, fmt.Errorf("%w: некорректная форма", service.ErrValidation)
+	}
+	in := service.CollectionInput{
+		Name:        strings.TrimSpace(r.FormValue("name")),
+		Description: strings.TrimSpace(r.FormValue("description")),
+	}
+	for field, dst := range map[string]**int64{"quota_bytes": &in.QuotaBytes, "quota_files": &in.QuotaFiles} {
+		v := strings.TrimSpace(r.FormValue(field))
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return service.CollectionInput{}, fmt.Errorf("%w: некорректное значение квоты %s: %s", service.ErrValidation, field, v)
+		}
+		*dst = &n
+	}
+	return in, nil
+}
+
+// collectionErrorMessage возвращает текст ошибки операции с коллекцией для alert.
+func collectionErrorMessage(err error, message string) string {
+	switch {
+	case errors.Is(err, service.ErrValidation), errors.Is(err, service.ErrConflict):
+		return err.Error()
+	case errors.Is(err, service.ErrNotFound):
+		return "Коллекция или участник не найдены"
+	default:
+		return message + ": " + err.Error()
+	}
+}
+
 // renderAlert рендерит alert-компонент с вариантом "error".
 func (h *AccessHandler) renderAlert(w http.ResponseWriter, r *http.Request, msg string) {
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
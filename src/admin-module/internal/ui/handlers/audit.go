@@ -0,0 +1,218 @@
+// Пакет handlers — HTTP-обработчики Admin UI.
+// Файл audit.go — обработчики страницы журнала аудита (audit:read):
+// список событий с фильтрацией по субъекту, действию, объекту и периоду.
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages"
+)
+
+// Размер страницы журнала аудита
+const auditPageSize = 50
+
+// auditDateLayout — формат дат в фильтре периода (input type="date").
+const auditDateLayout = "2006-01-02"
+
+// AuditHandler — обработчик страницы журнала аудита.
+type AuditHandler struct {
+	auditSvc *service.AuditService
+	logger   *slog.Logger
+}
+
+// NewAuditHandler создаёт новый AuditHandler.
+func NewAuditHandler(auditSvc *service.AuditService, logger *slog.Logger) *AuditHandler {
+	return &AuditHandler{
+		auditSvc: auditSvc,
+		logger:   logger.With(slog.String("component", "ui.audit")),
+	}
+}
+
+// HandleAudit обрабатывает GET /admin/audit — страница журнала аудита (audit:read).
+func (h *AuditHandler) HandleAudit(w http.ResponseWriter, r *http.Request) {
+	session := uimiddleware.SessionFromContext(r.Context())
+	if session == nil {
+		http.Redirect(w, r, "/admin/login", http.StatusFound)
+		return
+	}
+	if !uimiddleware.Can(r.Context(), rbac.PermAuditRead) {
+		http.Redirect(w, r, "/admin/", http.StatusFound)
+		return
+	}
+
+	data := h.loadData(r)
+	data.Username = session.Username
+	data.Role = session.Role
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.Audit(data).Render(r.Context(), w); err != nil {
+		h.logger.Error("Ошибка рендеринга Audit",
+			slog.String("error", err.Error()),
+		)
+		http.Error(w, "Ошибка рендеринга страницы", http.StatusInternalServerError)
+	}
+}
+
+// HandleTablePartial обрабатывает GET /admin/partials/audit-table — partial для HTMX.
+func (h *AuditHandler) HandleTablePartial(w http.ResponseWriter, r *http.Request) {
+	session := uimiddleware.SessionFromContext(r.Context())
+	if session == nil || !uimiddleware.Can(r.Context(), rbac.PermAuditRead) {
+		http.Error(w, "Недостаточно прав", http.StatusForbidden)
+		return
+	}
+
+	data := h.loadData(r)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.AuditTable(data).Render(r.Context(), w); err != nil {
+		h.logger.Error("Ошибка рендеринга audit table partial",
+			slog.String("error", err.Error()),
+		)
+		http.Error(w, "Ошибка рендеринга", http.StatusInternalServerError)
+	}
+}
+
+// loadData читает фильтры из query string и загружает страницу событий.
+func (h *AuditHandler) loadData(r *http.Request) pages.AuditData {
+	q := r.URL.Query()
+	filters := pages.AuditFilters{
+		Actor:      q.Get("actor"),
+		Action:     q.Get("action"),
+		TargetType: q.Get("target_type"),
+		TargetID:   q.Get("target_id"),
+		From:       q.Get("from"),
+		To:         q.Get("to"),
+	}
+
+	page := 1
+	if p, err := strconv.Atoi(q.Get("page")); err == nil && p > 0 {
+		page = p
+	}
+
+	offset := (page - 1) * auditPageSize
+	events, total, err := h.auditSvc.List(r.Context(), buildAuditFilters(filters), auditPageSize, offset)
+	if err != nil {
+		h.logger.Error("Ошибка получения журнала аудита",
+			slog.String("error", err.Error()),
+		)
+	}
+
+	items := make([]pages.AuditEventItem, len(events))
+	for i, e := range events {
+		items[i] = auditEventItem(e)
+	}
+
+	totalPages := (total + auditPageSize - 1) / auditPageSize
+	if totalPages < 1 {
+		totalPages = 1
+	}
+
+	return pages.AuditData{
+		Items:      items,
+		Filters:    filters,
+		Page:       page,
+		TotalPages: totalPages,
+		TotalItems: total,
+		PageSize:   auditPageSize,
+	}
+}
+
+// buildAuditFilters преобразует фильтры формы в фильтры репозитория.
+// Дата «по» включительная: к ней прибавляются сутки.
+func buildAuditFilters(f pages.AuditFilters) repository.AuditEventFilters {
+	var filters repository.AuditEventFilters
+	if f.Actor != "" {
+		filters.Actor = &f.Actor
+	}
+	if f.Action != "" {
+		filters.Action = &f.Action
+	}
+	if f.TargetType != "" {
+		filters.TargetType = &f.TargetType
+	}
+	if f.TargetID != "" {
+		filters.TargetID = &f.TargetID
+	}
+	if from, err := time.Parse(auditDateLayout, f.From); err == nil {
+		filters.From = &from
+	}
+	if to, err := time.Parse(auditDateLayout, f.To); err == nil {
+		to = to.AddDate(0, 0, 1)
+		filters.To = &to
+	}
+	return filters
+}
+
+// auditEventItem преобразует событие аудита в элемент таблицы.
+func auditEventItem(e *model.AuditEvent) pages.AuditEventItem {
+	item := pages.AuditEventItem{
+		ID:         e.ID,
+		OccurredAt: e.OccurredAt,
+		ActorType:  e.ActorType,
+		ActorName:  e.ActorName,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		Changes:    auditChanges(e.Before, e.After),
+	}
+	if e.SourceIP != nil {
+		item.SourceIP = *e.SourceIP
+	}
+	if e.RequestID != nil {
+		item.RequestID = *e.RequestID
+	}
+	return item
+}
+
+// auditChanges формирует список изменённых полей из before/after (JSON-объекты).
+func auditChanges(beforeJSON, afterJSON json.RawMessage) []pages.AuditChangeItem {
+	before := decodeAuditState(beforeJSON)
+	after := decodeAuditState(afterJSON)
+
+	fields := make(map[string]struct{}, len(before)+len(after))
+	for k := range before {
+		fields[k] = struct{}{}
+	}
+	for k := range after {
+		fields[k] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	changes := make([]pages.AuditChangeItem, 0, len(keys))
+	for _, k := range keys {
+		changes = append(changes, pages.AuditChangeItem{
+			Field:  k,
+			Before: string(before[k]),
+			After:  string(after[k]),
+		})
+	}
+	return changes
+}
+
+// decodeAuditState декодирует JSON-объект состояния; значения остаются в JSON.
+func decodeAuditState(data json.RawMessage) map[string]json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil
+	}
+	return state
+}
@@ -1,18 +1,23 @@
 // Пакет handlers — HTTP-обработчики Admin UI.
-// auth.go — аутентификация через Keycloak OIDC (Authorization Code + PKCE).
+// auth.go — аутентификация через Keycloak OIDC (Authorization Code + PKCE)
+// или, при локальном IdP, по имени пользователя и паролю.
 package handlers
 
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"strings"
 	"time"
 
+	apimiddleware "github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
 	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/idp"
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/auth"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages"
 )
 
 // Имя cookie для хранения PKCE state (code_verifier + state).
@@ -22,22 +27,27 @@ const stateCookieName = "artstore_auth_state"
 const stateCookieMaxAge = 5 * 60
 
 // AuthHandler — обработчики аутентификации Admin UI.
+// Режим определяется конструктором: OIDC (Keycloak) или вход по паролю (локальный IdP).
 type AuthHandler struct {
-	oidcClient     *auth.OIDCClient
+	oidcClient *auth.OIDCClient
+	// passwordAuth — вход по паролю (nil — вход через Keycloak OIDC).
+	passwordAuth   auth.PasswordAuthenticator
 	sessionManager *auth.SessionManager
-	logger         *slog.Logger
-	// adminGroups — группы Keycloak, дающие роль admin.
-	adminGroups []string
-	// readonlyGroups — группы Keycloak, дающие роль readonly.
-	readonlyGroups []string
+	// roleProvider — role overrides: дополняют роли из групп IdP.
+	roleProvider apimiddleware.RoleOverrideProvider
+	// roles — каталог ролей: маппинг групп IdP на роли.
+	roles  rbac.CatalogProvider
+	logger *slog.Logger
 	// secureCookie — использовать Secure flag для state cookie.
 	secureCookie bool
 }
 
-// NewAuthHandler создаёт новый AuthHandler.
+// NewAuthHandler создаёт новый AuthHandler. До вызова SetRoleCatalog
+// используются только встроенные роли admin и readonly.
 func NewAuthHandler(
 	oidcClient *auth.OIDCClient,
 	sessionManager *auth.SessionManager,
+	roleProvider apimiddleware.RoleOverrideProvider,
 	adminGroups, readonlyGroups []string,
 	secureCookie bool,
 	logger *slog.Logger,
@@ -45,13 +55,33 @@ func NewAuthHandler(
 	return &AuthHandler{
 		oidcClient:     oidcClient,
 		sessionManager: sessionManager,
+		roleProvider:   roleProvider,
+		roles:          rbac.NewCatalog(rbac.BuiltinRoles(adminGroups, readonlyGroups)...),
 		logger:         logger.With(slog.String("component", "ui_auth")),
-		adminGroups:    adminGroups,
-		readonlyGroups: readonlyGroups,
 		secureCookie:   secureCookie,
 	}
 }
 
+// SetRoleCatalog задаёт каталог ролей (встроенные и пользовательские роли).
+func (h *AuthHandler) SetRoleCatalog(roles rbac.CatalogProvider) {
+	h.roles = roles
+}
+
+// NewPasswordAuthHandler создаёт AuthHandler для входа по имени
+// пользователя и паролю (локальный IdP).
+func NewPasswordAuthHandler(
+	passwordAuth auth.PasswordAuthenticator,
+	sessionManager *auth.SessionManager,
+	roleProvider apimiddleware.RoleOverrideProvider,
+	adminGroups, readonlyGroups []string,
+	secureCookie bool,
+	logger *slog.Logger,
+) *AuthHandler {
+	h := NewAuthHandler(nil, sessionManager, roleProvider, adminGroups, readonlyGroups, secureCookie, logger)
+	h.passwordAuth = passwordAuth
+	return h
+}
+
 // stateData — данные, сохраняемые в state cookie на время auth flow.
 type stateData struct {
 	// State — CSRF state parameter.
@@ -62,8 +92,14 @@ type stateData struct {
 
 // HandleLogin — GET /admin/login
 // Генерирует PKCE и state, сохраняет в short-lived cookie,
-// redirect на Keycloak authorize endpoint.
+// redirect на Keycloak authorize endpoint. При локальном IdP —
+// форма входа по паролю.
 func (h *AuthHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
+	if h.passwordAuth != nil {
+		h.renderLogin(w, r, http.StatusOK, pages.LoginData{})
+		return
+	}
+
 	// Генерируем PKCE
 	pkce, err := auth.GeneratePKCE()
 	if err != nil {
@@ -111,10 +147,58 @@ func (h *AuthHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, authorizeURL, http.StatusFound)
 }
 
+// HandleLoginSubmit — POST /admin/login (только локальный IdP)
+// Проверяет имя пользователя и пароль, создаёт серверную сессию,
+// redirect на /admin/.
+func (h *AuthHandler) HandleLoginSubmit(w http.ResponseWriter, r *http.Request) {
+	if h.passwordAuth == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	username := strings.TrimSpace(r.FormValue("username"))
+	password := r.FormValue("password")
+	data := pages.LoginData{Username: username}
+
+	tokenResp, err := h.passwordAuth.PasswordLogin(r.Context(), username, password)
+	switch {
+	case errors.Is(err, idp.ErrInvalidCredentials):
+		h.logger.Info("Неудачный вход в Admin UI",
+			slog.String("username", username),
+			slog.String("remote_addr", r.RemoteAddr),
+		)
+		data.Error = "login.error.invalid"
+		h.renderLogin(w, r, http.StatusUnauthorized, data)
+		return
+	case errors.Is(err, idp.ErrLocked):
+		data.Error = "login.error.locked"
+		h.renderLogin(w, r, http.StatusTooManyRequests, data)
+		return
+	case err != nil:
+		h.logger.Error("Ошибка входа по паролю",
+			slog.String("username", username),
+			slog.String("error", err.Error()),
+		)
+		data.Error = "login.error.unavailable"
+		h.renderLogin(w, r, http.StatusServiceUnavailable, data)
+		return
+	}
+
+	if !h.startSession(w, r, tokenResp) {
+		return
+	}
+	http.Redirect(w, r, "/admin/", http.StatusSeeOther)
+}
+
 // HandleCallback — GET /admin/callback
-// Обменивает authorization code на tokens, создаёт session cookie,
+// Обменивает authorization code на tokens, создаёт серверную сессию,
 // redirect на /admin/.
 func (h *AuthHandler) HandleCallback(w http.ResponseWriter, r *http.Request) {
+	if h.passwordAuth != nil {
+		http.NotFound(w, r)
+		return
+	}
+
 	// 1. Проверяем ошибку от Keycloak
 	if errCode := r.URL.Query().Get("error"); errCode != "" {
 		errDesc := r.URL.Query().Get("error_description")
@@ -188,39 +272,86 @@ func (h *AuthHandler) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 7. Извлекаем данные пользователя из access token (JWT payload)
+	// 7. Создаём сессию по полученным токенам
+	if !h.startSession(w, r, tokenResp) {
+		return
+	}
+
+	// 8. Redirect на /admin/
+	http.Redirect(w, r, "/admin/", http.StatusFound)
+}
+
+// startSession создаёт серверную сессию по токенам IdP и устанавливает cookie.
+// При ошибке отвечает клиенту и возвращает false.
+func (h *AuthHandler) startSession(w http.ResponseWriter, r *http.Request, tokenResp *auth.TokenResponse) bool {
+	// Извлекаем данные пользователя из access token (JWT payload)
 	sessionData, err := h.buildSessionFromToken(tokenResp)
 	if err != nil {
 		h.logger.Error("Ошибка извлечения данных из токена",
 			slog.String("error", err.Error()),
 		)
 		http.Error(w, "Ошибка обработки токена", http.StatusInternalServerError)
-		return
+		return false
 	}
 
-	// 8. Устанавливаем session cookie
-	if err := h.sessionManager.SetSessionCookie(w, sessionData); err != nil {
-		h.logger.Error("Ошибка установки session cookie",
+	// Применяем role override — как для API-запросов пользователя
+	override, err := h.roleProvider.GetRoleOverride(r.Context(), sessionData.Subject)
+	if err != nil {
+		h.logger.Error("Ошибка получения role override",
+			slog.String("user_id", sessionData.Subject),
 			slog.String("error", err.Error()),
 		)
 		http.Error(w, "Ошибка создания сессии", http.StatusInternalServerError)
-		return
+		return false
+	}
+	sessionData.Role = h.roles.Catalog().Resolve(sessionData.Groups, []string{sessionData.IdpRole}, override).EffectiveRole
+
+	// Создаём сессию и устанавливаем cookie с её токеном
+	if err := h.sessionManager.CreateSession(w, r, sessionData); err != nil {
+		h.logger.Error("Ошибка создания сессии",
+			slog.String("error", err.Error()),
+		)
+		http.Error(w, "Ошибка создания сессии", http.StatusInternalServerError)
+		return false
 	}
 
 	h.logger.Info("Пользователь аутентифицирован",
 		slog.String("username", sessionData.Username),
 		slog.String("role", sessionData.Role),
 	)
+	return true
+}
 
-	// 9. Redirect на /admin/
-	http.Redirect(w, r, "/admin/", http.StatusFound)
+// renderLogin отображает форму входа по паролю.
+func (h *AuthHandler) renderLogin(w http.ResponseWriter, r *http.Request, status int, data pages.LoginData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := pages.Login(data).Render(r.Context(), w); err != nil {
+		h.logger.Error("Ошибка рендеринга страницы входа", slog.String("error", err.Error()))
+	}
 }
 
 // HandleLogout — POST /admin/logout
-// Очищает session cookie, redirect на Keycloak logout endpoint.
+// Удаляет сессию и её cookie, redirect на Keycloak logout endpoint
+// (при локальном IdP — на форму входа).
 func (h *AuthHandler) HandleLogout(w http.ResponseWriter, r *http.Request) {
-	// Очищаем session cookie
-	h.sessionManager.ClearSessionCookie(w)
+	// Маршрут не проходит через UIAuth — находим сессию сами.
+	// Недействительную или неизвестную сессию удалять не нужно
+	session, err := h.sessionManager.GetSessionFromRequest(r)
+	if err != nil && !errors.Is(err, auth.ErrSessionInvalid) {
+		h.logger.Warn("Ошибка чтения сессии при logout", slog.String("error", err.Error()))
+	}
+	if err := h.sessionManager.DestroySession(r.Context(), w, session); err != nil {
+		h.logger.Warn("Ошибка удаления сессии при logout", slog.String("error", err.Error()))
+	}
+
+	h.logger.Info("Пользователь выполняет logout")
+
+	// Локальный IdP — сессия IdP отсутствует, возвращаемся на форму входа
+	if h.passwordAuth != nil {
+		http.Redirect(w, r, "/admin/login", http.StatusSeeOther)
+		return
+	}
 
 	// Формируем URL для redirect после logout
 	postLogoutRedirectURI := h.buildBaseURL(r) + "/admin/login"
@@ -228,8 +359,6 @@ func (h *AuthHandler) HandleLogout(w http.ResponseWriter, r *http.Request) {
 	// Redirect на Keycloak logout
 	logoutURL := h.oidcClient.LogoutURL("", postLogoutRedirectURI)
 
-	h.logger.Info("Пользователь выполняет logout")
-
 	http.Redirect(w, r, logoutURL, http.StatusFound)
 }
 
@@ -260,7 +389,7 @@ func (h *AuthHandler) buildBaseURL(r *http.Request) string {
 }
 
 // buildSessionFromToken извлекает данные пользователя из JWT access token.
-// JWT payload парсится без валидации подписи (доверяем Keycloak на этапе callback).
+// JWT payload парсится без валидации подписи (токен получен напрямую от IdP).
 func (h *AuthHandler) buildSessionFromToken(tokenResp *auth.TokenResponse) (*auth.SessionData, error) {
 	// Парсим JWT payload (второй сегмент, base64url-encoded)
 	parts := strings.SplitN(tokenResp.AccessToken, ".", 3)
@@ -278,25 +407,21 @@ func (h *AuthHandler) buildSessionFromToken(tokenResp *auth.TokenResponse) (*aut
 		return nil, fmt.Errorf("ошибка парсинга JWT claims: %w", err)
 	}
 
-	// Маппинг групп → роль
-	role := rbac.MapGroupsToRole(claims.Groups, h.adminGroups, h.readonlyGroups)
-
-	// Если роль не определена через группы — пробуем через realm_access.roles
-	if role == "" && claims.RealmAccess != nil {
-		for _, r := range claims.RealmAccess.Roles {
-			if rbac.IsValidRole(r) {
-				candidate := rbac.HighestRole([]string{role, r})
-				role = candidate
-			}
-		}
+	// Маппинг групп → роли; если группы не дали ролей — realm_access.roles
+	var realmRoles []string
+	if claims.RealmAccess != nil {
+		realmRoles = claims.RealmAccess.Roles
 	}
+	role := h.roles.Catalog().Resolve(claims.Groups, realmRoles, nil).IdpRole
 
 	return &auth.SessionData{
 		AccessToken:  tokenResp.AccessToken,
 		RefreshToken: tokenResp.RefreshToken,
 		ExpiresAt:    time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second).Unix(),
+		Subject:      claims.Sub,
 		Username:     claims.PreferredUsername,
 		Email:        claims.Email,
+		IdpRole:      role,
 		Role:         role,
 		Groups:       claims.Groups,
 	}, nil
@@ -25,6 +25,7 @@ type DashboardHandler struct {
 	storageElemsSvc *service.StorageElementService
 	filesSvc        *service.FileRegistryService
 	serviceAcctsSvc *service.ServiceAccountService
+	capacitySvc     *service.CapacityService
 	dephealthSvc    *service.DephealthService // может быть nil
 	logger          *slog.Logger
 }
@@ -34,6 +35,7 @@ func NewDashboardHandler(
 	storageElemsSvc *service.StorageElementService,
 	filesSvc *service.FileRegistryService,
 	serviceAcctsSvc *service.ServiceAccountService,
+	capacitySvc *service.CapacityService,
 	dephealthSvc *service.DephealthService,
 	logger *slog.Logger,
 ) *DashboardHandler {
@@ -41,6 +43,7 @@ func NewDashboardHandler(
 		storageElemsSvc: storageElemsSvc,
 		filesSvc:        filesSvc,
 		serviceAcctsSvc: serviceAcctsSvc,
+		capacitySvc:     capacitySvc,
 		dephealthSvc:    dephealthSvc,
 		logger:          logger.With(slog.String("component", "ui.dashboard")),
 	}
@@ -98,7 +101,7 @@ func (h *DashboardHandler) collectDashboardData(ctx context.Context, username, r
 // collectSEMetrics собирает метрики Storage Elements: список SE, счётчики по статусам.
 func (h *DashboardHandler) collectSEMetrics(ctx context.Context, data *pages.DashboardData) {
 	// Получаем все SE (без фильтрации, лимит 1000 — достаточно для Dashboard)
-	ses, total, err := h.storageElemsSvc.List(ctx, nil, nil, 1000, 0)
+	ses, total, err := h.storageElemsSvc.List(ctx, repository.StorageElementFilters{}, 1000, 0)
 	if err != nil {
 		h.logger.Error("Ошибка получения SE для Dashboard",
 			slog.String("error", err.Error()),
@@ -139,6 +142,52 @@ func (h *DashboardHandler) collectSEMetrics(ctx context.Context, data *pages.Das
 
 	// Подсчитываем файлы для каждого SE
 	h.collectFilesPerSE(ctx, data)
+
+	// Тренд и прогноз заполнения
+	h.collectCapacityForecast(ctx, data)
+}
+
+// collectCapacityForecast добавляет общий прогноз заполнения, суммарный тренд
+// и прогнозы по SE из истории снимков ёмкости.
+func (h *DashboardHandler) collectCapacityForecast(ctx context.Context, data *pages.DashboardData) {
+	overview, err := h.capacitySvc.Overview(ctx)
+	if err != nil {
+		h.logger.Warn("Ошибка получения прогноза заполнения",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	data.CapacityForecast = forecastView(overview.Total)
+	data.CapacityTrend = trendPoints(overview.Trend)
+	for i := range data.StorageElements {
+		if f, ok := overview.PerSE[data.StorageElements[i].ID]; ok {
+			data.StorageElements[i].Forecast = forecastView(f)
+		}
+	}
+}
+
+// forecastView преобразует прогноз заполнения для отображения.
+func forecastView(f *service.CapacityForecast) *pages.CapacityForecastView {
+	return &pages.CapacityForecastView{
+		Samples:           f.Samples,
+		GrowthBytesPerDay: f.GrowthBytesPerDay,
+		DaysUntilFull:     f.DaysUntilFull,
+		FullAt:            f.FullAt,
+	}
+}
+
+// trendPoints преобразует точки истории использования для графика.
+func trendPoints(points []service.CapacityPoint) []pages.CapacityTrendPoint {
+	result := make([]pages.CapacityTrendPoint, len(points))
+	for i, p := range points {
+		result[i] = pages.CapacityTrendPoint{
+			At:            p.At,
+			CapacityBytes: p.CapacityBytes,
+			UsedBytes:     p.UsedBytes,
+		}
+	}
+	return result
 }
 
 // collectFilesPerSE подсчитывает количество файлов для каждого SE в списке.
@@ -1,6 +1,7 @@
 // Пакет handlers — HTTP-обработчики Admin UI.
 // Файл events.go — SSE (Server-Sent Events) endpoints для real-time обновлений:
-// статусы зависимостей (PostgreSQL, Keycloak), статусы SE, агрегированные метрики.
+// статусы зависимостей (PostgreSQL, Keycloak), статусы SE, агрегированные метрики,
+// прогресс фоновых задач синхронизации SE.
 // Каждый SSE-клиент обслуживается отдельной горутиной.
 package handlers
 
@@ -13,6 +14,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
 	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
 )
@@ -69,9 +72,25 @@ type seStatusItem struct {
 	Mode   string `json:"mode"`
 }
 
+// syncProgressEvent — SSE-событие изменения задачи синхронизации SE.
+type syncProgressEvent struct {
+	ID                 string `json:"id"`
+	StorageElementID   string `json:"storage_element_id"`
+	Status             string `json:"status"`
+	FilesOnSE          int    `json:"files_on_se"`
+	FilesProcessed     int    `json:"files_processed"`
+	FilesAdded         int    `json:"files_added"`
+	FilesUpdated       int    `json:"files_updated"`
+	FilesMarkedDeleted int    `json:"files_marked_deleted"`
+	Error              string `json:"error"`
+}
+
 // HandleSystemStatus обрабатывает GET /admin/events/system-status — SSE endpoint.
 // Периодически (каждые 15с) отправляет клиенту статусы зависимостей и SE.
 // Формат: event: dep-status\ndata: {json}\n\n, event: se-status\ndata: {json}\n\n
+// Изменения задач синхронизации SE отправляются сразу: event: sync-progress.
+// Параметр sync_runs (ID через запятую) — отправить текущее состояние этих задач
+// при подключении (задача могла завершиться до открытия соединения).
 // Graceful disconnect при закрытии клиентом соединения (context cancel).
 func (h *EventsHandler) HandleSystemStatus(w http.ResponseWriter, r *http.Request) {
 	session := uimiddleware.SessionFromContext(r.Context())
@@ -102,9 +121,15 @@ func (h *EventsHandler) HandleSystemStatus(w http.ResponseWriter, r *http.Reques
 		slog.String("remote_addr", r.RemoteAddr),
 	)
 
+	// Подписка на задачи синхронизации — до отправки их начального состояния,
+	// чтобы не пропустить изменения между чтением и подпиской.
+	syncUpdates, unsubscribe := h.storageElemsSvc.SubscribeSyncRuns()
+	defer unsubscribe()
+
 	// Отправляем начальные данные сразу при подключении
 	h.sendDepStatus(ctx, w, rc)
 	h.sendSEStatus(ctx, w, rc)
+	h.sendInitialSyncProgress(ctx, w, rc, r.URL.Query().Get("sync_runs"))
 
 	// Периодическая отправка
 	ticker := time.NewTicker(h.sseInterval)
@@ -121,8 +146,50 @@ func (h *EventsHandler) HandleSystemStatus(w http.ResponseWriter, r *http.Reques
 		case <-ticker.C:
 			h.sendDepStatus(ctx, w, rc)
 			h.sendSEStatus(ctx, w, rc)
+		case run := <-syncUpdates:
+			h.sendSyncProgress(w, rc, &run)
+		}
+	}
+}
+
+// sendInitialSyncProgress отправляет текущее состояние запрошенных задач синхронизации.
+func (h *EventsHandler) sendInitialSyncProgress(ctx context.Context, w http.ResponseWriter, rc *http.ResponseController, ids string) {
+	if ids == "" {
+		return
+	}
+	for _, id := range strings.Split(ids, ",") {
+		run, err := h.storageElemsSvc.GetSyncRun(ctx, strings.TrimSpace(id))
+		if err != nil {
+			continue
 		}
+		h.sendSyncProgress(w, rc, run)
+	}
+}
+
+// sendSyncProgress отправляет SSE-событие с состоянием задачи синхронизации.
+func (h *EventsHandler) sendSyncProgress(w http.ResponseWriter, rc *http.ResponseController, run *model.SyncRun) {
+	event := syncProgressEvent{
+		ID:                 run.ID,
+		StorageElementID:   run.StorageElementID,
+		Status:             run.Status,
+		FilesOnSE:          run.FilesOnSE,
+		FilesProcessed:     run.FilesProcessed,
+		FilesAdded:         run.FilesAdded,
+		FilesUpdated:       run.FilesUpdated,
+		FilesMarkedDeleted: run.FilesMarkedDeleted,
+	}
+	if run.Error != nil {
+		event.Error = *run.Error
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		h.logger.Error("Ошибка сериализации sync-progress", slog.String("error", err.Error()))
+		return
 	}
+
+	fmt.Fprintf(w, "event: sync-progress\ndata: %s\n\n", data)
+	_ = rc.Flush()
 }
 
 // sendDepStatus отправляет SSE-событие со статусами зависимостей.
@@ -158,7 +225,7 @@ func (h *EventsHandler) sendDepStatus(_ context.Context, w http.ResponseWriter,
 
 // sendSEStatus отправляет SSE-событие со статусами Storage Elements.
 func (h *EventsHandler) sendSEStatus(ctx context.Context, w http.ResponseWriter, rc *http.ResponseController) {
-	ses, _, err := h.storageElemsSvc.List(ctx, nil, nil, 1000, 0)
+	ses, _, err := h.storageElemsSvc.List(ctx, repository.StorageElementFilters{}, 1000, 0)
 	if err != nil {
 		h.logger.Error("Ошибка получения SE для SSE", slog.String("error", err.Error()))
 		return
@@ -1,19 +1,28 @@
 // Пакет handlers — HTTP-обработчики Admin UI.
 // Файл files.go — обработчики страниц файлового реестра:
 // список файлов (с фильтрацией, поиском, пагинацией, сортировкой),
-// детальный просмотр, редактирование, soft delete.
+// детальный просмотр, редактирование, soft delete, групповые операции,
+// загрузка файла на SE, скачивание и предпросмотр содержимого.
 package handlers
 
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 
+	apimiddleware "github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
 	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
@@ -24,22 +33,49 @@ import (
 // Размер страницы по умолчанию для таблицы файлов
 const filePageSize = 20
 
+// Количество ошибок по файлам, показываемых в панели групповой операции
+const fileBatchFailuresShown = 20
+
+// Максимальный размер текстового поля формы загрузки
+const fileUploadFieldLimit = 64 << 10
+
+// fileProxyHeaders — заголовки ответа SE, передаваемые клиенту при скачивании.
+var fileProxyHeaders = []string{
+	"Content-Length", "Content-Range", "Accept-Ranges", "ETag", "Last-Modified",
+}
+
+// fileBatchFormFields — поля формы групповой операции, которые диалог
+// подтверждения передаёт в запрос запуска (параметры действия, выбор и фильтры).
+var fileBatchFormFields = []string{
+	"action", "tags", "target_status", "file_ids", "all_matching",
+	"status", "retention", "se", "collection", "content_type", "q", "show_deleted",
+}
+
 // FilesHandler — обработчик страниц файлового реестра.
 type FilesHandler struct {
 	filesSvc        *service.FileRegistryService
+	fileBatchSvc    *service.FileBatchService
+	fileTransferSvc *service.FileTransferService
 	storageElemsSvc *service.StorageElementService
+	collectionsSvc  *service.CollectionService
 	logger          *slog.Logger
 }
 
 // NewFilesHandler создаёт новый FilesHandler.
 func NewFilesHandler(
 	filesSvc *service.FileRegistryService,
+	fileBatchSvc *service.FileBatchService,
+	fileTransferSvc *service.FileTransferService,
 	storageElemsSvc *service.StorageElementService,
+	collectionsSvc *service.CollectionService,
 	logger *slog.Logger,
 ) *FilesHandler {
 	return &FilesHandler{
 		filesSvc:        filesSvc,
+		fileBatchSvc:    fileBatchSvc,
+		fileTransferSvc: fileTransferSvc,
 		storageElemsSvc: storageElemsSvc,
+		collectionsSvc:  collectionsSvc,
 		logger:          logger.With(slog.String("component", "ui.files")),
 	}
 }
@@ -58,6 +94,7 @@ func (h *FilesHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 	status := r.URL.Query().Get("status")
 	retention := r.URL.Query().Get("retention")
 	seID := r.URL.Query().Get("se")
+	collection := r.URL.Query().Get("collection")
 	contentType := r.URL.Query().Get("content_type")
 	search := r.URL.Query().Get("q")
 	pageStr := r.URL.Query().Get("page")
@@ -65,6 +102,12 @@ func (h *FilesHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 	sortDir := r.URL.Query().Get("order")
 	showDeleted := r.URL.Query().Get("show_deleted")
 
+	// Карточка файла, открываемая при загрузке страницы (ссылки из отчётов сверки)
+	openFileID := r.URL.Query().Get("file")
+	if _, err := uuid.Parse(openFileID); err != nil {
+		openFileID = ""
+	}
+
 	// Парсинг номера страницы
 	page := 1
 	if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
@@ -80,7 +123,8 @@ func (h *FilesHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Подготовка фильтров для сервиса
-	filters := h.buildFilters(status, retention, seID, showDeleted, session.Role)
+	filters := h.buildFilters(status, retention, seID, collection, contentType, search, showDeleted, uimiddleware.Can(ctx, rbac.PermFilesDelete))
+	filters.Collections = collectionScope(ctx, model.CollectionPermRead)
 
 	// Получаем список файлов
 	offset := (page - 1) * filePageSize
@@ -91,10 +135,11 @@ func (h *FilesHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 		)
 	}
 
-	// Получаем список SE для фильтра (имена)
+	// Получаем список SE и доступных коллекций для фильтров (имена)
 	seList := h.getSENames(ctx)
+	collections := h.getCollectionNames(ctx, model.CollectionPermRead)
 
-	// Преобразуем в отображаемые элементы с фильтрацией по поиску и content_type
+	// Преобразуем в отображаемые элементы
 	items := make([]pages.FileListItem, 0, len(files))
 	for _, f := range files {
 		item := pages.FileListItem{
@@ -108,44 +153,32 @@ func (h *FilesHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 			Status:           f.Status,
 			RetentionPolicy:  f.RetentionPolicy,
 		}
+		item.PendingWrite, item.PendingWriteErr = pendingWriteState(f)
 
 		// Находим имя SE
 		item.SEName = h.findSEName(seList, f.StorageElementID)
-
-		// Фильтрация по content_type (client-side, т.к. нет фильтра в репозитории)
-		if contentType != "" && !matchContentType(f.ContentType, contentType) {
-			continue
-		}
-
-		// Фильтрация по поиску (имя файла)
-		if search != "" && !fileMatchSearch(f.OriginalFilename, search) {
-			continue
-		}
+		item.CollectionName = findCollectionName(collections, f.CollectionID)
 
 		items = append(items, item)
 	}
 
-	// При поиске/content_type фильтре корректируем общее количество
-	totalFiltered := total
-	if search != "" || contentType != "" {
-		totalFiltered = len(items)
-	}
-
-	// Пагинация
-	totalPages := (totalFiltered + filePageSize - 1) / filePageSize
+	// Пагинация (все фильтры применены в БД, total учитывает их)
+	totalPages := (total + filePageSize - 1) / filePageSize
 	if totalPages < 1 {
 		totalPages = 1
 	}
 
 	data := pages.FileListData{
-		Username: session.Username,
-		Role:     session.Role,
-		Items:    items,
-		SEList:   seList,
+		Username:    session.Username,
+		Role:        session.Role,
+		Items:       items,
+		SEList:      seList,
+		Collections: collections,
 		Filters: pages.FileListFilters{
 			Status:      status,
 			Retention:   retention,
 			SEID:        seID,
+			Collection:  collection,
 			ContentType: contentType,
 			Search:      search,
 			ShowDeleted: showDeleted == "true",
@@ -154,8 +187,9 @@ func (h *FilesHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 		SortDir:    sortDir,
 		Page:       page,
 		TotalPages: totalPages,
-		TotalItems: totalFiltered,
+		TotalItems: total,
 		PageSize:   filePageSize,
+		OpenFileID: openFileID,
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -172,17 +206,11 @@ func (h *FilesHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 func (h *FilesHandler) HandleTablePartial(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Роль пользователя для RBAC
-	session := uimiddleware.SessionFromContext(ctx)
-	role := ""
-	if session != nil {
-		role = session.Role
-	}
-
 	// Извлекаем параметры
 	status := r.URL.Query().Get("status")
 	retention := r.URL.Query().Get("retention")
 	seID := r.URL.Query().Get("se")
+	collection := r.URL.Query().Get("collection")
 	contentType := r.URL.Query().Get("content_type")
 	search := r.URL.Query().Get("q")
 	pageStr := r.URL.Query().Get("page")
@@ -202,7 +230,8 @@ func (h *FilesHandler) HandleTablePartial(w http.ResponseWriter, r *http.Request
 		sortDir = "desc"
 	}
 
-	filters := h.buildFilters(status, retention, seID, showDeleted, role)
+	filters := h.buildFilters(status, retention, seID, collection, contentType, search, showDeleted, uimiddleware.Can(ctx, rbac.PermFilesDelete))
+	filters.Collections = collectionScope(ctx, model.CollectionPermRead)
 
 	offset := (page - 1) * filePageSize
 	files, total, err := h.filesSvc.List(ctx, filters, filePageSize, offset)
@@ -213,6 +242,7 @@ func (h *FilesHandler) HandleTablePartial(w http.ResponseWriter, r *http.Request
 	}
 
 	seList := h.getSENames(ctx)
+	collections := h.getCollectionNames(ctx, model.CollectionPermRead)
 
 	items := make([]pages.FileListItem, 0, len(files))
 	for _, f := range files {
@@ -227,38 +257,26 @@ func (h *FilesHandler) HandleTablePartial(w http.ResponseWriter, r *http.Request
 			Status:           f.Status,
 			RetentionPolicy:  f.RetentionPolicy,
 		}
+		item.PendingWrite, item.PendingWriteErr = pendingWriteState(f)
 
 		item.SEName = h.findSEName(seList, f.StorageElementID)
-
-		if contentType != "" && !matchContentType(f.ContentType, contentType) {
-			continue
-		}
-
-		if search != "" && !fileMatchSearch(f.OriginalFilename, search) {
-			continue
-		}
+		item.CollectionName = findCollectionName(collections, f.CollectionID)
 
 		items = append(items, item)
 	}
 
-	totalFiltered := total
-	if search != "" || contentType != "" {
-		totalFiltered = len(items)
-	}
-
-	totalPages := (totalFiltered + filePageSize - 1) / filePageSize
+	totalPages := (total + filePageSize - 1) / filePageSize
 	if totalPages < 1 {
 		totalPages = 1
 	}
 
 	tableData := partials.FileTableData{
 		Items:      items,
-		Role:       role,
 		SortKey:    sortKey,
 		SortDir:    sortDir,
 		Page:       page,
 		TotalPages: totalPages,
-		TotalItems: totalFiltered,
+		TotalItems: total,
 		PageSize:   filePageSize,
 	}
 
@@ -276,19 +294,8 @@ func (h *FilesHandler) HandleDetailModal(w http.ResponseWriter, r *http.Request)
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	session := uimiddleware.SessionFromContext(ctx)
-	role := ""
-	if session != nil {
-		role = session.Role
-	}
-
-	f, err := h.filesSvc.Get(ctx, id)
-	if err != nil {
-		if errors.Is(err, service.ErrNotFound) {
-			h.renderAlert(w, r, "Файл не найден")
-		} else {
-			h.renderAlert(w, r, "Ошибка получения файла: "+err.Error())
-		}
+	f, ok := h.getFileWithAccess(w, r, id, model.CollectionPermRead)
+	if !ok {
 		return
 	}
 
@@ -304,6 +311,7 @@ func (h *FilesHandler) HandleDetailModal(w http.ResponseWriter, r *http.Request)
 		Checksum:         f.Checksum,
 		StorageElementID: f.StorageElementID,
 		SEName:           seName,
+		CollectionName:   findCollectionName(h.getCollectionNames(ctx, model.CollectionPermRead), f.CollectionID),
 		UploadedBy:       f.UploadedBy,
 		UploadedAt:       f.UploadedAt,
 		Description:      f.Description,
@@ -314,8 +322,8 @@ func (h *FilesHandler) HandleDetailModal(w http.ResponseWriter, r *http.Request)
 		ExpiresAt:        f.ExpiresAt,
 		CreatedAt:        f.CreatedAt,
 		UpdatedAt:        f.UpdatedAt,
-		Role:             role,
 	}
+	detail.PendingWrite, detail.PendingWriteErr = pendingWriteState(f)
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	if err := partials.FileDetailContent(detail).Render(ctx, w); err != nil {
@@ -330,9 +338,8 @@ func (h *FilesHandler) HandleEditForm(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	f, err := h.filesSvc.Get(ctx, id)
-	if err != nil {
-		h.renderAlert(w, r, "Файл не найден")
+	f, ok := h.getFileWithAccess(w, r, id, model.CollectionPermWrite)
+	if !ok {
 		return
 	}
 
@@ -360,21 +367,14 @@ func (h *FilesHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	description := r.FormValue("description")
-	tagsStr := r.FormValue("tags")
-
-	// Парсим теги (разделитель — запятая)
-	var tags []string
-	if tagsStr != "" {
-		for _, t := range strings.Split(tagsStr, ",") {
-			t = strings.TrimSpace(t)
-			if t != "" {
-				tags = append(tags, t)
-			}
-		}
+	if _, ok := h.getFileWithAccess(w, r, id, model.CollectionPermWrite); !ok {
+		return
 	}
 
-	_, err := h.filesSvc.Update(ctx, id, &description, &tags, nil)
+	description := r.FormValue("description")
+	tags := splitFormList(r.FormValue("tags"))
+
+	f, err := h.filesSvc.Update(ctx, id, &description, &tags, nil)
 	if err != nil {
 		h.logger.Warn("Ошибка обновления файла",
 			slog.String("file_id", id),
@@ -389,7 +389,7 @@ func (h *FilesHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := partials.FileEditSuccess().Render(ctx, w); err != nil {
+	if err := partials.FileEditSuccess(f.PendingWrite != nil).Render(ctx, w); err != nil {
 		h.logger.Error("Ошибка рендеринга file edit success",
 			slog.String("error", err.Error()),
 		)
@@ -401,7 +401,11 @@ func (h *FilesHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	err := h.filesSvc.Delete(ctx, id)
+	if _, ok := h.getFileWithAccess(w, r, id, model.CollectionPermDelete); !ok {
+		return
+	}
+
+	f, err := h.filesSvc.Delete(ctx, id)
 	if err != nil {
 		h.logger.Warn("Ошибка удаления файла",
 			slog.String("file_id", id),
@@ -416,20 +420,501 @@ func (h *FilesHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := partials.FileDeleteSuccess().Render(ctx, w); err != nil {
+	if err := partials.FileDeleteSuccess(f.PendingWrite != nil).Render(ctx, w); err != nil {
 		h.logger.Error("Ошибка рендеринга file delete success",
 			slog.String("error", err.Error()),
 		)
 	}
 }
 
+// HandleUploadForm обрабатывает GET /admin/partials/file-upload-form —
+// форма загрузки файла со списком SE, принимающих загрузки (files:write).
+func (h *FilesHandler) HandleUploadForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !uimiddleware.Can(ctx, rbac.PermFilesWrite) {
+		h.renderAlert(w, r, "Нет прав для этого действия")
+		return
+	}
+
+	ses, _, err := h.storageElemsSvc.List(ctx, repository.StorageElementFilters{}, 1000, 0)
+	if err != nil {
+		h.logger.Warn("Ошибка получения списка SE для загрузки",
+			slog.String("error", err.Error()),
+		)
+	}
+	options := make([]partials.FileUploadSE, 0, len(ses))
+	for _, se := range ses {
+		if se.Status != "online" || !service.AcceptsUploads(se) {
+			continue
+		}
+		options = append(options, partials.FileUploadSE{
+			ID:   se.ID,
+			Name: se.Name,
+			Mode: se.Mode,
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.FileUploadForm(options, h.uploadCollections(ctx)).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга file upload form",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// HandleUpload обрабатывает POST /admin/partials/file-upload — загрузка файла
+// на SE с токеном сессии пользователя (files:write); файл коллекции —
+// с токеном Admin Module от имени пользователя. Multipart читается
+// потоком: текстовые поля предшествуют полю file, содержимое которого
+// передаётся на SE без буферизации.
+func (h *FilesHandler) HandleUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	session := uimiddleware.SessionFromContext(ctx)
+	if session == nil || !uimiddleware.Can(ctx, rbac.PermFilesWrite) {
+		h.renderAlert(w, r, "Нет прав для этого действия")
+		return
+	}
+
+	// Загрузка большого файла длится дольше таймаутов HTTP-сервера
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	mr, err := r.MultipartReader()
+	if err != nil {
+		h.renderAlert(w, r, "Ошибка разбора формы")
+		return
+	}
+
+	fields := make(map[string]string)
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			h.renderAlert(w, r, "Не выбран файл")
+			return
+		}
+		if err != nil {
+			h.renderAlert(w, r, "Ошибка разбора формы")
+			return
+		}
+
+		if part.FormName() != "file" {
+			value, err := io.ReadAll(io.LimitReader(part, fileUploadFieldLimit))
+			if err != nil {
+				h.renderAlert(w, r, "Ошибка разбора формы")
+				return
+			}
+			fields[part.FormName()] = string(value)
+			continue
+		}
+
+		if part.FileName() == "" {
+			h.renderAlert(w, r, "Не выбран файл")
+			return
+		}
+		size, err := strconv.ParseInt(fields["size"], 10, 64)
+		if err != nil || size < 0 {
+			h.renderAlert(w, r, "Некорректный размер файла")
+			return
+		}
+		h.upload(w, r, &service.FileUploadRequest{
+			StorageElementID: fields["se"],
+			RetentionPolicy:  fields["retention"],
+			Size:             size,
+			Filename:         part.FileName(),
+			ContentType:      part.Header.Get("Content-Type"),
+			Description:      fields["description"],
+			Tags:             splitFormList(fields["tags"]),
+			CollectionID:     fields["collection"],
+			Body:             part,
+			Token:            session.AccessToken,
+			Subject:          session.Subject,
+			Claims:           apimiddleware.ClaimsFromContext(ctx),
+		})
+		return
+	}
+}
+
+// upload выполняет загрузку и рендерит результат.
+func (h *FilesHandler) upload(w http.ResponseWriter, r *http.Request, req *service.FileUploadRequest) {
+	ctx := r.Context()
+
+	f, err := h.fileTransferSvc.Upload(ctx, req)
+	if err != nil {
+		h.logger.Warn("Ошибка загрузки файла",
+			slog.String("filename", req.Filename),
+			slog.String("error", err.Error()),
+		)
+		h.renderAlert(w, r, "Ошибка загрузки: "+err.Error())
+		return
+	}
+
+	seName := h.findSEName(h.getSENames(ctx), f.StorageElementID)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.FileUploadSuccess(f.OriginalFilename, seName).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга file upload success",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// HandleDownload обрабатывает GET /admin/files/{id}/download — скачивание
+// содержимого файла через Admin Module (с поддержкой Range).
+func (h *FilesHandler) HandleDownload(w http.ResponseWriter, r *http.Request) {
+	h.serveContent(w, r, false)
+}
+
+// HandlePreview обрабатывает GET /admin/files/{id}/preview — просмотр
+// содержимого в браузере для изображений, PDF и текста.
+func (h *FilesHandler) HandlePreview(w http.ResponseWriter, r *http.Request) {
+	h.serveContent(w, r, true)
+}
+
+// serveContent проксирует содержимое файла с SE. Для предпросмотра
+// ответ отдаётся inline: текст — как text/plain, изображения — с CSP
+// sandbox (SVG не исполняет скрипты в контексте Admin UI).
+func (h *FilesHandler) serveContent(w http.ResponseWriter, r *http.Request, inline bool) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	session := uimiddleware.SessionFromContext(ctx)
+	if session == nil {
+		http.Error(w, "Требуется аутентификация", http.StatusUnauthorized)
+		return
+	}
+
+	// Файл чужой коллекции не раскрывается
+	if f, err := h.filesSvc.Get(ctx, id); err == nil {
+		err = h.collectionsSvc.CheckAccess(ctx, apimiddleware.ClaimsFromContext(ctx), f.CollectionID, model.CollectionPermRead)
+		if err != nil {
+			http.Error(w, "Файл не найден", http.StatusNotFound)
+			return
+		}
+	}
+
+	// Скачивание большого файла длится дольше WriteTimeout HTTP-сервера
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	f, resp, err := h.fileTransferSvc.Download(ctx, id, session.AccessToken, r.Header)
+	if err != nil {
+		h.logger.Warn("Ошибка скачивания файла",
+			slog.String("file_id", id),
+			slog.String("error", err.Error()),
+		)
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			http.Error(w, "Файл не найден", http.StatusNotFound)
+		case errors.Is(err, service.ErrConflict):
+			http.Error(w, err.Error(), http.StatusForbidden)
+		default:
+			http.Error(w, "Storage Element недоступен", http.StatusBadGateway)
+		}
+		return
+	}
+	defer resp.Body.Close()
+
+	kind := pages.FilePreviewKind(f.ContentType)
+	if inline && kind == "" {
+		http.Error(w, "Предпросмотр недоступен для типа "+f.ContentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	header := w.Header()
+	for _, name := range fileProxyHeaders {
+		if v := resp.Header.Get(name); v != "" {
+			header.Set(name, v)
+		}
+	}
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	contentType := f.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	disposition := "attachment"
+	if inline {
+		disposition = "inline"
+		switch kind {
+		case "text":
+			contentType = "text/plain; charset=utf-8"
+		case "image":
+			header.Set("Content-Security-Policy", "sandbox")
+		}
+	}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{
+		"filename": f.OriginalFilename,
+	}))
+
+	w.WriteHeader(resp.StatusCode)
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		h.logger.Debug("Скачивание файла прервано",
+			slog.String("file_id", id),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// HandleBatchConfirm обрабатывает POST /admin/partials/file-batch-confirm —
+// диалог подтверждения групповой операции с количеством затрагиваемых файлов
+// (files:write, удаление — также files:delete).
+func (h *FilesHandler) HandleBatchConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		h.renderAlert(w, r, "Ошибка разбора формы")
+		return
+	}
+	req := h.parseBatchForm(r)
+	if !canRunFileBatch(r, req.Action) {
+		h.renderAlert(w, r, "Нет прав для этого действия")
+		return
+	}
+
+	count, err := h.fileBatchSvc.Preview(ctx, req)
+	if err != nil {
+		if errors.Is(err, service.ErrValidation) {
+			h.renderAlert(w, r, err.Error())
+		} else {
+			h.logger.Error("Ошибка подсчёта файлов групповой операции",
+				slog.String("error", err.Error()),
+			)
+			h.renderAlert(w, r, "Ошибка подсчёта файлов: "+err.Error())
+		}
+		return
+	}
+	if count > service.MaxFileBatchFiles {
+		h.renderAlert(w, r, fmt.Sprintf(
+			"Под выбор попадает %d файлов, максимум %d — уточните фильтр", count, service.MaxFileBatchFiles))
+		return
+	}
+	if count == 0 {
+		h.renderAlert(w, r, "Под выбор не попадает ни одного файла")
+		return
+	}
+
+	data := partials.FileBatchConfirmData{
+		Action: req.Action,
+		Tags:   strings.Join(req.Tags, ", "),
+		Count:  count,
+	}
+	if req.Status != nil {
+		data.TargetStatus = *req.Status
+	}
+	if len(req.FileIDs) > count {
+		data.Missing = len(req.FileIDs) - count
+	}
+	for _, name := range fileBatchFormFields {
+		data.Params = append(data.Params, partials.FormParam{Name: name, Value: r.FormValue(name)})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.FileBatchConfirm(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга file batch confirm",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// HandleBatchStart обрабатывает POST /admin/partials/file-batch — запуск
+// групповой операции (files:write, удаление — также files:delete).
+// Возвращает панель прогресса операции.
+func (h *FilesHandler) HandleBatchStart(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		h.renderAlert(w, r, "Ошибка разбора формы")
+		return
+	}
+	req := h.parseBatchForm(r)
+	if !canRunFileBatch(r, req.Action) {
+		h.renderAlert(w, r, "Нет прав для этого действия")
+		return
+	}
+
+	op, err := h.fileBatchSvc.StartOperation(ctx, req)
+	if err != nil {
+		h.logger.Warn("Ошибка запуска групповой операции",
+			slog.String("action", req.Action),
+			slog.String("error", err.Error()),
+		)
+		h.renderAlert(w, r, "Ошибка запуска групповой операции: "+err.Error())
+		return
+	}
+
+	h.renderBatchProgress(w, r, op)
+}
+
+// HandleBatchProgress обрабатывает GET /admin/partials/file-batch/{id} —
+// панель прогресса групповой операции (обновляется опросом до завершения).
+func (h *FilesHandler) HandleBatchProgress(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	op, err := h.fileBatchSvc.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			h.renderAlert(w, r, "Групповая операция не найдена")
+		} else {
+			h.renderAlert(w, r, "Ошибка получения групповой операции: "+err.Error())
+		}
+		return
+	}
+
+	h.renderBatchProgress(w, r, op)
+}
+
+// HandleBatchCancel обрабатывает POST /admin/partials/file-batch-cancel/{id} —
+// отмена групповой операции (files:write).
+func (h *FilesHandler) HandleBatchCancel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	if !uimiddleware.Can(ctx, rbac.PermFilesWrite) {
+		h.renderAlert(w, r, "Нет прав для этого действия")
+		return
+	}
+
+	op, err := h.fileBatchSvc.Cancel(ctx, id)
+	if err != nil {
+		h.logger.Warn("Ошибка отмены групповой операции",
+			slog.String("operation_id", id),
+			slog.String("error", err.Error()),
+		)
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			h.renderAlert(w, r, "Групповая операция не найдена")
+		case errors.Is(err, service.ErrConflict):
+			h.renderAlert(w, r, "Групповая операция уже завершена")
+		default:
+			h.renderAlert(w, r, "Ошибка отмены: "+err.Error())
+		}
+		return
+	}
+
+	h.renderBatchProgress(w, r, op)
+}
+
+// renderBatchProgress рендерит панель прогресса групповой операции.
+// Для завершённой операции добавляет первые ошибки по файлам.
+func (h *FilesHandler) renderBatchProgress(w http.ResponseWriter, r *http.Request, op *model.FileBatchOperation) {
+	ctx := r.Context()
+
+	data := partials.FileBatchProgressData{
+		ID:        op.ID,
+		Action:    op.Action,
+		Status:    op.Status,
+		Finished:  op.Finished(),
+		Total:     op.Total,
+		Processed: op.Processed,
+		Succeeded: op.Succeeded,
+		Skipped:   op.Skipped,
+		Failed:    op.Failed,
+	}
+	if op.Error != nil {
+		data.Error = *op.Error
+	}
+
+	if data.Finished && op.Failed > 0 {
+		failed := model.FileBatchResultFailed
+		results, _, err := h.fileBatchSvc.ListResults(ctx, op.ID, &failed, fileBatchFailuresShown, 0)
+		if err != nil {
+			h.logger.Warn("Ошибка получения результатов групповой операции",
+				slog.String("operation_id", op.ID),
+				slog.String("error", err.Error()),
+			)
+		}
+		for _, res := range results {
+			item := partials.FileBatchFailure{FileID: res.FileID}
+			if res.Error != nil {
+				item.Error = *res.Error
+			}
+			data.Failures = append(data.Failures, item)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.FileBatchProgress(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга file batch progress",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// parseBatchForm формирует параметры групповой операции из формы:
+// явный список file_ids или все файлы под текущие фильтры (all_matching=true).
+func (h *FilesHandler) parseBatchForm(r *http.Request) *service.FileBatchRequest {
+	req := &service.FileBatchRequest{
+		Action: r.FormValue("action"),
+		Tags:   splitFormList(r.FormValue("tags")),
+	}
+	if status := r.FormValue("target_status"); status != "" {
+		req.Status = &status
+	}
+
+	if r.FormValue("all_matching") == "true" {
+		filters := h.buildFilters(
+			r.FormValue("status"), r.FormValue("retention"), r.FormValue("se"), r.FormValue("collection"),
+			r.FormValue("content_type"), r.FormValue("q"), r.FormValue("show_deleted"),
+			uimiddleware.Can(r.Context(), rbac.PermFilesDelete),
+		)
+		req.Filter = &filters
+	} else {
+		req.FileIDs = splitFormList(r.FormValue("file_ids"))
+	}
+
+	// Файлы коллекций — только с правом на действие в коллекции
+	collectionPerm := model.CollectionPermWrite
+	if req.Action == "delete" {
+		collectionPerm = model.CollectionPermDelete
+	}
+	req.Collections = collectionScope(r.Context(), collectionPerm)
+	return req
+}
+
+// canRunFileBatch проверяет разрешения на групповую операцию:
+// files:write, для удаления — также files:delete.
+func canRunFileBatch(r *http.Request, action string) bool {
+	ctx := r.Context()
+	if !uimiddleware.Can(ctx, rbac.PermFilesWrite) {
+		return false
+	}
+	return action != "delete" || uimiddleware.Can(ctx, rbac.PermFilesDelete)
+}
+
+// splitFormList разбирает список значений через запятую, пропуская пустые.
+func splitFormList(s string) []string {
+	var result []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// pendingWriteState возвращает состояние изменения файла, не применённого на SE,
+// и текст последней ошибки записи.
+func pendingWriteState(f *model.FileRecord) (status, lastError string) {
+	if f.PendingWrite == nil {
+		return "", ""
+	}
+	if f.PendingWrite.LastError != nil {
+		lastError = *f.PendingWrite.LastError
+	}
+	return f.PendingWrite.Status, lastError
+}
+
 // buildFilters формирует фильтры для запроса к сервису.
-func (h *FilesHandler) buildFilters(status, retention, seID, showDeleted, role string) repository.FileListFilters {
+// Поиск по имени и content_type применяются в БД, чтобы пагинация и total были корректны.
+// Удалённые файлы показываются только при allowDeleted (разрешение files:delete).
+func (h *FilesHandler) buildFilters(status, retention, seID, collection, contentType, search, showDeleted string, allowDeleted bool) repository.FileListFilters {
 	var filters repository.FileListFilters
 
 	if status != "" {
 		filters.Status = &status
-	} else if showDeleted != "true" || role != "admin" {
+	} else if showDeleted != "true" || !allowDeleted {
 		// По умолчанию показываем только активные файлы (если не включён showDeleted)
 		activeStatus := "active"
 		filters.Status = &activeStatus
@@ -443,12 +928,24 @@ func (h *FilesHandler) buildFilters(status, retention, seID, showDeleted, role s
 		filters.StorageElementID = &seID
 	}
 
+	if _, err := uuid.Parse(collection); err == nil {
+		filters.CollectionID = &collection
+	}
+
+	if contentType != "" {
+		filters.ContentType = &contentType
+	}
+
+	if search = strings.TrimSpace(search); search != "" {
+		filters.Filename = &search
+	}
+
 	return filters
 }
 
 // getSENames получает список SE с именами для фильтра.
 func (h *FilesHandler) getSENames(ctx context.Context) []pages.SEOption {
-	ses, _, err := h.storageElemsSvc.List(ctx, nil, nil, 1000, 0)
+	ses, _, err := h.storageElemsSvc.List(ctx, repository.StorageElementFilters{}, 1000, 0)
 	if err != nil {
 		h.logger.Warn("Ошибка получения списка SE для фильтра",
 			slog.String("error", err.Error()),
@@ -466,6 +963,105 @@ func (h *FilesHandler) getSENames(ctx context.Context) []pages.SEOption {
 	return result
 }
 
+// getCollectionNames возвращает коллекции, где у пользователя есть право
+// permission (все — при разрешении collections:write).
+func (h *FilesHandler) getCollectionNames(ctx context.Context, permission string) []pages.CollectionOption {
+	collections, err := h.collectionsSvc.List(ctx, collectionScope(ctx, permission))
+	if err != nil {
+		h.logger.Warn("Ошибка получения списка коллекций",
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	result := make([]pages.CollectionOption, 0, len(collections))
+	for _, c := range collections {
+		result = append(result, pages.CollectionOption{ID: c.ID, Name: c.Name})
+	}
+	return result
+}
+
+// uploadCollections возвращает коллекции для формы загрузки (право write)
+// с остатком квот.
+func (h *FilesHandler) uploadCollections(ctx context.Context) []partials.FileUploadCollection {
+	collections, err := h.collectionsSvc.List(ctx, collectionScope(ctx, model.CollectionPermWrite))
+	if err != nil {
+		h.logger.Warn("Ошибка получения списка коллекций для загрузки",
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	result := make([]partials.FileUploadCollection, 0, len(collections))
+	for _, c := range collections {
+		item := partials.FileUploadCollection{ID: c.ID, Name: c.Name}
+		var quota []string
+		if c.QuotaBytes != nil {
+			quota = append(quota, pages.FileFormatBytesFn(c.UsedBytes)+" / "+pages.FileFormatBytesFn(*c.QuotaBytes))
+		}
+		if c.QuotaFiles != nil {
+			quota = append(quota, fmt.Sprintf("%d / %d", c.UsedFiles, *c.QuotaFiles))
+		}
+		item.Quota = strings.Join(quota, ", ")
+		result = append(result, item)
+	}
+	return result
+}
+
+// getFileWithAccess возвращает файл, если у пользователя есть право
+// permission в его коллекции. Иначе рендерит alert и возвращает false;
+// файл чужой коллекции без права read не раскрывается.
+func (h *FilesHandler) getFileWithAccess(w http.ResponseWriter, r *http.Request, id, permission string) (*model.FileRecord, bool) {
+	ctx := r.Context()
+
+	f, err := h.filesSvc.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			h.renderAlert(w, r, "Файл не найден")
+		} else {
+			h.renderAlert(w, r, "Ошибка получения файла: "+err.Error())
+		}
+		return nil, false
+	}
+
+	err = h.collectionsSvc.CheckAccess(ctx, apimiddleware.ClaimsFromContext(ctx), f.CollectionID, permission)
+	switch {
+	case err == nil:
+		return f, true
+	case errors.Is(err, service.ErrForbidden) && permission == model.CollectionPermRead:
+		h.renderAlert(w, r, "Файл не найден")
+	case errors.Is(err, service.ErrForbidden):
+		h.renderAlert(w, r, "Нет прав для этого действия: "+err.Error())
+	default:
+		h.renderAlert(w, r, "Ошибка проверки доступа: "+err.Error())
+	}
+	return nil, false
+}
+
+// collectionScope — ограничение файлов по коллекциям для пользователя
+// UI-сессии (nil — без ограничения).
+func collectionScope(ctx context.Context, permission string) *model.CollectionScope {
+	claims := apimiddleware.ClaimsFromContext(ctx)
+	if claims == nil {
+		// Без claims доступны только файлы вне коллекций
+		return &model.CollectionScope{Permission: permission}
+	}
+	return claims.CollectionScope(permission)
+}
+
+// findCollectionName находит имя коллекции по ID (пустое — файл вне коллекций).
+func findCollectionName(collections []pages.CollectionOption, id *string) string {
+	if id == nil {
+		return ""
+	}
+	for _, c := range collections {
+		if c.ID == *id {
+			return c.Name
+		}
+	}
+	return (*id)[:8] + "..."
+}
+
 // findSEName находит имя SE по ID.
 func (h *FilesHandler) findSEName(seList []pages.SEOption, seID string) string {
 	for _, se := range seList {
@@ -485,21 +1081,3 @@ func (h *FilesHandler) renderAlert(w http.ResponseWriter, r *http.Request, msg s
 		)
 	}
 }
-
-// matchContentType проверяет соответствие content_type фильтру.
-// Фильтр может быть группой (image, video, audio, application, text) или точным типом.
-func matchContentType(ct, filter string) bool {
-	if filter == "" {
-		return true
-	}
-	// Если фильтр — группа (без "/"), проверяем prefix
-	if !strings.Contains(filter, "/") {
-		return strings.HasPrefix(strings.ToLower(ct), strings.ToLower(filter)+"/")
-	}
-	return strings.EqualFold(ct, filter)
-}
-
-// fileMatchSearch проверяет, содержит ли имя файла поисковый запрос.
-func fileMatchSearch(filename, search string) bool {
-	return containsLower(filename, toLower(search))
-}
@@ -0,0 +1,254 @@
+// Пакет handlers — HTTP-обработчики Admin UI.
+// Файл profile.go — обработчики страницы профиля пользователя:
+// выпуск, список и отзыв personal access tokens. Пользователь с разрешением
+// users:read видит токены всех пользователей (таб на странице доступа),
+// с разрешением users:write — отзывает их.
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	apimiddleware "github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/auth"
+	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages/partials"
+)
+
+const (
+	// personalTokenDefaultTTL — срок действия нового токена, предлагаемый в форме.
+	personalTokenDefaultTTL = 30 * 24 * time.Hour
+	// personalTokenDateLayout — формат срока действия в форме (input type="date").
+	personalTokenDateLayout = "2006-01-02"
+)
+
+// ProfileHandler — обработчик страницы профиля и personal access tokens.
+type ProfileHandler struct {
+	tokensSvc *service.PersonalTokenService
+	logger    *slog.Logger
+}
+
+// NewProfileHandler создаёт новый ProfileHandler.
+func NewProfileHandler(tokensSvc *service.PersonalTokenService, logger *slog.Logger) *ProfileHandler {
+	return &ProfileHandler{
+		tokensSvc: tokensSvc,
+		logger:    logger.With(slog.String("component", "ui.profile")),
+	}
+}
+
+// HandleProfile обрабатывает GET /admin/profile — профиль текущего пользователя.
+func (h *ProfileHandler) HandleProfile(w http.ResponseWriter, r *http.Request) {
+	session := uimiddleware.SessionFromContext(r.Context())
+	if session == nil {
+		http.Redirect(w, r, "/admin/login", http.StatusFound)
+		return
+	}
+
+	now := time.Now().UTC()
+	defaultTTL := min(personalTokenDefaultTTL, h.tokensSvc.MaxTTL())
+	data := pages.ProfileData{
+		Username:         session.Username,
+		Role:             session.Role,
+		Email:            session.Email,
+		IdpRole:          session.IdpRole,
+		Groups:           session.Groups,
+		Scopes:           service.PersonalTokenScopes,
+		DefaultExpiresAt: now.Add(defaultTTL).Format(personalTokenDateLayout),
+		MaxExpiresAt:     now.Add(h.tokensSvc.MaxTTL()).Format(personalTokenDateLayout),
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.Profile(data).Render(r.Context(), w); err != nil {
+		h.logger.Error("Ошибка рендеринга profile page",
+			slog.String("error", err.Error()),
+		)
+		http.Error(w, "Ошибка рендеринга страницы", http.StatusInternalServerError)
+	}
+}
+
+// HandleTokensPartial обрабатывает GET /admin/partials/personal-tokens —
+// токены текущего пользователя (query all=true — всех пользователей, только admin).
+func (h *ProfileHandler) HandleTokensPartial(w http.ResponseWriter, r *http.Request) {
+	session := uimiddleware.SessionFromContext(r.Context())
+	if session == nil {
+		http.Error(w, "Недостаточно прав", http.StatusForbidden)
+		return
+	}
+	all, ok := h.listAll(r)
+	if !ok {
+		h.renderTokens(w, r, session, false, partials.PersonalTokensData{
+			AlertVariant: "error", Alert: "Нет прав для этого действия",
+		})
+		return
+	}
+	h.renderTokens(w, r, session, all, partials.PersonalTokensData{})
+}
+
+// HandleTokenCreate обрабатывает POST /admin/partials/personal-tokens —
+// выпуск токена текущего пользователя. Значение токена показывается один раз.
+func (h *ProfileHandler) HandleTokenCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	session := uimiddleware.SessionFromContext(ctx)
+	claims := apimiddleware.ClaimsFromContext(ctx)
+	if session == nil || claims == nil {
+		http.Error(w, "Недостаточно прав", http.StatusForbidden)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		h.renderTokens(w, r, session, false, partials.PersonalTokensData{
+			AlertVariant: "error", Alert: "Ошибка разбора формы",
+		})
+		return
+	}
+
+	// Срок действия — начало выбранного дня (UTC), чтобы последний
+	// доступный в форме день не превышал AM_PAT_MAX_TTL
+	expiresAt, err := time.Parse(personalTokenDateLayout, r.FormValue("expires_at"))
+	if err != nil {
+		h.renderTokens(w, r, session, false, partials.PersonalTokensData{
+			AlertVariant: "error", Alert: "Некорректный срок действия токена",
+		})
+		return
+	}
+
+	token, value, err := h.tokensSvc.Create(ctx, claims, service.PersonalTokenRequest{
+		Name:      r.FormValue("name"),
+		Scopes:    r.Form["scopes"],
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		msg := "Ошибка создания токена: " + err.Error()
+		if errors.Is(err, service.ErrValidation) || errors.Is(err, service.ErrConflict) {
+			msg = err.Error()
+		} else {
+			h.logger.Error("Ошибка создания personal access token",
+				slog.String("error", err.Error()),
+			)
+		}
+		h.renderTokens(w, r, session, false, partials.PersonalTokensData{
+			AlertVariant: "error", Alert: msg,
+		})
+		return
+	}
+
+	h.renderTokens(w, r, session, false, partials.PersonalTokensData{
+		NewToken:     value,
+		NewTokenName: token.Name,
+	})
+}
+
+// HandleTokenRevoke обрабатывает DELETE /admin/partials/personal-token/{id} —
+// отзыв токена: своего — любым пользователем, чужого — admin.
+func (h *ProfileHandler) HandleTokenRevoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	session := uimiddleware.SessionFromContext(ctx)
+	if session == nil {
+		http.Error(w, "Недостаточно прав", http.StatusForbidden)
+		return
+	}
+	all, ok := h.listAll(r)
+	if !ok {
+		h.renderTokens(w, r, session, false, partials.PersonalTokensData{
+			AlertVariant: "error", Alert: "Нет прав для этого действия",
+		})
+		return
+	}
+
+	// Без разрешения users:write отзываются только свои токены
+	var owner *string
+	if !uimiddleware.Can(ctx, rbac.PermUsersWrite) {
+		subject := sessionSubject(session)
+		owner = &subject
+	}
+
+	if err := h.tokensSvc.Revoke(ctx, id, owner); err != nil {
+		h.logger.Warn("Ошибка отзыва personal access token",
+			slog.String("token_id", id),
+			slog.String("error", err.Error()),
+		)
+		msg := "Ошибка отзыва токена: " + err.Error()
+		if errors.Is(err, service.ErrNotFound) {
+			msg = "Токен не найден или уже отозван"
+		}
+		h.renderTokens(w, r, session, all, partials.PersonalTokensData{
+			AlertVariant: "error", Alert: msg,
+		})
+		return
+	}
+	h.renderTokens(w, r, session, all, partials.PersonalTokensData{
+		AlertVariant: "success", Alert: "Токен отозван",
+	})
+}
+
+// listAll определяет режим списка по query all. false во втором
+// значении — список всех токенов запрошен без разрешения users:read.
+func (h *ProfileHandler) listAll(r *http.Request) (bool, bool) {
+	all := r.URL.Query().Get("all") == "true"
+	return all, !all || uimiddleware.Can(r.Context(), rbac.PermUsersRead)
+}
+
+// renderTokens рендерит таблицу personal access tokens: все токены
+// (all) или токены текущего пользователя.
+func (h *ProfileHandler) renderTokens(
+	w http.ResponseWriter, r *http.Request,
+	session *auth.SessionData, all bool, data partials.PersonalTokensData,
+) {
+	ctx := r.Context()
+
+	var subject *string
+	if !all {
+		s := sessionSubject(session)
+		subject = &s
+	}
+	tokens, err := h.tokensSvc.List(ctx, subject)
+	if err != nil {
+		h.logger.Error("Ошибка получения personal access tokens",
+			slog.String("error", err.Error()),
+		)
+		data.AlertVariant, data.Alert = "error", "Ошибка получения токенов: "+err.Error()
+	}
+
+	now := time.Now()
+	data.All = all
+	for _, t := range tokens {
+		data.Items = append(data.Items, partials.PersonalTokenItem{
+			ID:          t.ID,
+			Name:        t.Name,
+			TokenPrefix: t.TokenPrefix,
+			Subject:     t.Subject,
+			Username:    t.Username,
+			Scopes:      t.Scopes,
+			CreatedAt:   t.CreatedAt,
+			ExpiresAt:   t.ExpiresAt,
+			LastUsedAt:  t.LastUsedAt,
+			LastUsedIP:  t.LastUsedIP,
+			Expired:     t.Expired(now),
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.PersonalTokensTable(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга personal access tokens",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// sessionSubject — ID пользователя UI-сессии. Для сессий, созданных
+// до появления поля Subject, используется username (как в claims сессии).
+func sessionSubject(session *auth.SessionData) string {
+	if session.Subject != "" {
+		return session.Subject
+	}
+	return session.Username
+}
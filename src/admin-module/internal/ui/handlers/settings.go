@@ -1,6 +1,7 @@
 // Пакет handlers — HTTP-обработчики Admin UI.
-// Файл settings.go — обработчик страницы настроек (admin only):
-// конфигурация Prometheus (URL, enabled, timeout), проверка подключения.
+// Файл settings.go — обработчик страницы настроек (settings:write):
+// конфигурация Prometheus (URL, enabled, timeout), проверка подключения,
+// правила оповещений и webhook endpoints.
 package handlers
 
 import (
@@ -8,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
 	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages"
@@ -15,30 +17,36 @@ import (
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/prometheus"
 )
 
-// Константа роли администратора для проверки доступа.
-const roleAdmin = "admin"
-
 // SettingsHandler — обработчик страницы настроек.
 type SettingsHandler struct {
-	settingsSvc *service.UISettingsService
-	promClient  *prometheus.Client
-	logger      *slog.Logger
+	settingsSvc  *service.UISettingsService
+	promClient   *prometheus.Client
+	alertSvc     *service.AlertService
+	webhookSvc   *service.WebhookService
+	storageElems *service.StorageElementService
+	logger       *slog.Logger
 }
 
 // NewSettingsHandler создаёт новый SettingsHandler.
 func NewSettingsHandler(
 	settingsSvc *service.UISettingsService,
 	promClient *prometheus.Client,
+	alertSvc *service.AlertService,
+	webhookSvc *service.WebhookService,
+	storageElems *service.StorageElementService,
 	logger *slog.Logger,
 ) *SettingsHandler {
 	return &SettingsHandler{
-		settingsSvc: settingsSvc,
-		promClient:  promClient,
-		logger:      logger.With(slog.String("component", "ui.settings")),
+		settingsSvc:  settingsSvc,
+		promClient:   promClient,
+		alertSvc:     alertSvc,
+		webhookSvc:   webhookSvc,
+		storageElems: storageElems,
+		logger:       logger.With(slog.String("component", "ui.settings")),
 	}
 }
 
-// HandleSettings обрабатывает GET /admin/settings — страница настроек (admin only).
+// HandleSettings обрабатывает GET /admin/settings — страница настроек (settings:write).
 func (h *SettingsHandler) HandleSettings(w http.ResponseWriter, r *http.Request) {
 	session := uimiddleware.SessionFromContext(r.Context())
 	if session == nil {
@@ -46,8 +54,8 @@ func (h *SettingsHandler) HandleSettings(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Только admin может просматривать настройки
-	if session.Role != roleAdmin {
+	// Настройки доступны с разрешением settings:write
+	if !uimiddleware.Can(r.Context(), rbac.PermSettingsWrite) {
 		http.Redirect(w, r, "/admin/", http.StatusFound)
 		return
 	}
@@ -63,6 +71,7 @@ func (h *SettingsHandler) HandleSettings(w http.ResponseWriter, r *http.Request)
 			Timeout:         h.settingsSvc.GetPrometheusTimeout(ctx).String(),
 			RetentionPeriod: h.getRetentionPeriod(ctx),
 		},
+		Alerting: h.collectAlerting(ctx),
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -84,7 +93,7 @@ func (h *SettingsHandler) HandlePrometheusUpdate(w http.ResponseWriter, r *http.
 		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
-	if session.Role != roleAdmin {
+	if !uimiddleware.Can(r.Context(), rbac.PermSettingsWrite) {
 		h.renderAlert(w, r, "error", "Нет прав для изменения настроек")
 		return
 	}
@@ -133,7 +142,7 @@ func (h *SettingsHandler) HandlePrometheusTest(w http.ResponseWriter, r *http.Re
 		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
-	if session.Role != roleAdmin {
+	if !uimiddleware.Can(r.Context(), rbac.PermSettingsWrite) {
 		h.renderAlert(w, r, "error", "Нет прав для этого действия")
 		return
 	}
@@ -0,0 +1,367 @@
+// Файл settings_alerting.go — раздел «Оповещения» страницы настроек:
+// сработавшие оповещения, правила, webhook endpoints и журнал доставок.
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/repository"
+	"github.com/bigkaa/goartstore/admin-module/internal/service"
+	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages/partials"
+)
+
+// settingsDeliveriesLimit — количество последних доставок на странице настроек.
+const settingsDeliveriesLimit = 20
+
+// collectAlerting собирает данные раздела оповещений.
+// Ошибки логируются; соответствующая таблица остаётся пустой.
+func (h *SettingsHandler) collectAlerting(ctx context.Context) pages.AlertingSettings {
+	var data pages.AlertingSettings
+	if h.alertSvc == nil || h.webhookSvc == nil {
+		return data
+	}
+
+	endpointNames := make(map[string]string)
+	if eps, err := h.webhookSvc.ListEndpoints(ctx); err != nil {
+		h.logger.Warn("Ошибка получения webhooks", slog.String("error", err.Error()))
+	} else {
+		for _, ep := range eps {
+			endpointNames[ep.ID] = ep.Name
+			data.Webhooks = append(data.Webhooks, pages.WebhookItem{
+				ID: ep.ID, Name: ep.Name, URL: ep.URL, Enabled: ep.Enabled,
+			})
+		}
+	}
+
+	if alerts, err := h.alertSvc.ListAlerts(ctx); err != nil {
+		h.logger.Warn("Ошибка получения оповещений", slog.String("error", err.Error()))
+	} else {
+		for _, a := range alerts {
+			data.Alerts = append(data.Alerts, pages.AlertItem{
+				RuleName:    a.RuleName,
+				RuleType:    a.RuleType,
+				SubjectName: a.SubjectName,
+				Message:     a.Message,
+				FiringSince: a.FiringSince,
+			})
+		}
+	}
+
+	seNames := h.storageElementNames(ctx)
+	if rules, err := h.alertSvc.List(ctx); err != nil {
+		h.logger.Warn("Ошибка получения правил оповещений", slog.String("error", err.Error()))
+	} else {
+		for _, rule := range rules {
+			item := pages.AlertRuleItem{
+				ID:      rule.ID,
+				Name:    rule.Name,
+				Type:    rule.Type,
+				Enabled: rule.Enabled,
+			}
+			if rule.Threshold != nil {
+				item.Threshold = strconv.FormatFloat(*rule.Threshold, 'f', -1, 64)
+			}
+			if rule.StorageElementID != nil {
+				item.SEName = seNames[*rule.StorageElementID]
+			}
+			for _, id := range rule.EndpointIDs {
+				item.Endpoints = append(item.Endpoints, endpointNames[id])
+			}
+			data.Rules = append(data.Rules, item)
+		}
+	}
+
+	deliveries, _, err := h.webhookSvc.ListDeliveries(ctx, repository.WebhookDeliveryFilters{}, settingsDeliveriesLimit, 0)
+	if err != nil {
+		h.logger.Warn("Ошибка получения журнала доставок", slog.String("error", err.Error()))
+	}
+	for _, d := range deliveries {
+		item := pages.WebhookDeliveryItem{
+			EndpointName: endpointNames[d.EndpointID],
+			Event:        d.Event,
+			Status:       d.Status,
+			Attempts:     d.Attempts,
+			CreatedAt:    d.CreatedAt,
+		}
+		if d.ResponseStatus != nil {
+			item.ResponseStatus = strconv.Itoa(*d.ResponseStatus)
+		}
+		if d.LastError != nil {
+			item.LastError = *d.LastError
+		}
+		data.Deliveries = append(data.Deliveries, item)
+	}
+
+	return data
+}
+
+// storageElementNames возвращает имена SE по ID.
+func (h *SettingsHandler) storageElementNames(ctx context.Context) map[string]string {
+	names := make(map[string]string)
+	if h.storageElems == nil {
+		return names
+	}
+	ses, _, err := h.storageElems.List(ctx, repository.StorageElementFilters{}, 1000, 0)
+	if err != nil {
+		h.logger.Warn("Ошибка получения списка SE", slog.String("error", err.Error()))
+		return names
+	}
+	for _, se := range ses {
+		names[se.ID] = se.Name
+	}
+	return names
+}
+
+// HandleAlertRuleForm обрабатывает GET /admin/partials/settings-alert-rule-form.
+// Без параметра id — форма создания, с id — форма редактирования правила.
+func (h *SettingsHandler) HandleAlertRuleForm(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsWrite(w, r) {
+		return
+	}
+	ctx := r.Context()
+
+	form := partials.AlertRuleFormData{
+		Type:        model.AlertRuleSEStatus,
+		Enabled:     true,
+		EndpointIDs: map[string]bool{},
+		Types:       service.AlertRuleTypes(),
+	}
+	if id := r.URL.Query().Get("id"); id != "" {
+		rule, err := h.alertSvc.Get(ctx, id)
+		if err != nil {
+			h.renderAlert(w, r, "error", "Правило не найдено")
+			return
+		}
+		form.ID = rule.ID
+		form.Name = rule.Name
+		form.Type = rule.Type
+		form.Enabled = rule.Enabled
+		if rule.Threshold != nil {
+			form.Threshold = strconv.FormatFloat(*rule.Threshold, 'f', -1, 64)
+		}
+		if rule.StorageElementID != nil {
+			form.StorageElementID = *rule.StorageElementID
+		}
+		for _, epID := range rule.EndpointIDs {
+			form.EndpointIDs[epID] = true
+		}
+	}
+
+	for id, name := range h.storageElementNames(ctx) {
+		form.StorageElements = append(form.StorageElements, partials.SelectOption{Value: id, Label: name})
+	}
+	sortSelectOptions(form.StorageElements)
+	if eps, err := h.webhookSvc.ListEndpoints(ctx); err == nil {
+		for _, ep := range eps {
+			form.Endpoints = append(form.Endpoints, partials.SelectOption{Value: ep.ID, Label: ep.Name})
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.AlertRuleForm(form).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга формы правила", slog.String("error", err.Error()))
+	}
+}
+
+// HandleAlertRuleCreate обрабатывает POST /admin/partials/settings-alert-rules.
+func (h *SettingsHandler) HandleAlertRuleCreate(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsWrite(w, r) {
+		return
+	}
+	in, ok := h.parseAlertRuleForm(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.alertSvc.Create(r.Context(), in); err != nil {
+		h.renderAlertingError(w, r, err, "Ошибка создания правила")
+		return
+	}
+	h.renderSaved(w, r, "Правило оповещения создано")
+}
+
+// HandleAlertRuleUpdate обрабатывает PUT /admin/partials/settings-alert-rules/{id}.
+func (h *SettingsHandler) HandleAlertRuleUpdate(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsWrite(w, r) {
+		return
+	}
+	in, ok := h.parseAlertRuleForm(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.alertSvc.Update(r.Context(), chi.URLParam(r, "id"), in); err != nil {
+		h.renderAlertingError(w, r, err, "Ошибка обновления правила")
+		return
+	}
+	h.renderSaved(w, r, "Правило оповещения сохранено")
+}
+
+// HandleAlertRuleDelete обрабатывает DELETE /admin/partials/settings-alert-rules/{id}.
+func (h *SettingsHandler) HandleAlertRuleDelete(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsWrite(w, r) {
+		return
+	}
+	if err := h.alertSvc.Delete(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.renderAlertingError(w, r, err, "Ошибка удаления правила")
+		return
+	}
+	h.renderSaved(w, r, "Правило оповещения удалено")
+}
+
+// HandleWebhookCreate обрабатывает POST /admin/partials/settings-webhooks.
+// Показывает ключ подписи один раз.
+func (h *SettingsHandler) HandleWebhookCreate(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsWrite(w, r) {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		h.renderAlert(w, r, "error", "Ошибка разбора формы: "+err.Error())
+		return
+	}
+
+	ep, err := h.webhookSvc.CreateEndpoint(r.Context(),
+		r.FormValue("name"), r.FormValue("url"), r.FormValue("secret"), true)
+	if err != nil {
+		h.renderAlertingError(w, r, err, "Ошибка создания webhook")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.WebhookCreated(ep.Name, ep.Secret).Render(r.Context(), w); err != nil {
+		h.logger.Error("Ошибка рендеринга webhook", slog.String("error", err.Error()))
+	}
+}
+
+// HandleWebhookToggle обрабатывает POST /admin/partials/settings-webhooks/{id}/toggle.
+// Включает или отключает доставку на endpoint.
+func (h *SettingsHandler) HandleWebhookToggle(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsWrite(w, r) {
+		return
+	}
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	ep, err := h.webhookSvc.GetEndpoint(ctx, id)
+	if err != nil {
+		h.renderAlertingError(w, r, err, "Ошибка получения webhook")
+		return
+	}
+	enabled := !ep.Enabled
+	if _, err := h.webhookSvc.UpdateEndpoint(ctx, id, nil, nil, nil, &enabled); err != nil {
+		h.renderAlertingError(w, r, err, "Ошибка обновления webhook")
+		return
+	}
+
+	if enabled {
+		h.renderSaved(w, r, "Webhook включён")
+	} else {
+		h.renderSaved(w, r, "Webhook отключён")
+	}
+}
+
+// HandleWebhookDelete обрабатывает DELETE /admin/partials/settings-webhooks/{id}.
+func (h *SettingsHandler) HandleWebhookDelete(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsWrite(w, r) {
+		return
+	}
+	if err := h.webhookSvc.DeleteEndpoint(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.renderAlertingError(w, r, err, "Ошибка удаления webhook")
+		return
+	}
+	h.renderSaved(w, r, "Webhook удалён")
+}
+
+// HandleWebhookTest обрабатывает POST /admin/partials/settings-webhooks/{id}/test.
+func (h *SettingsHandler) HandleWebhookTest(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsWrite(w, r) {
+		return
+	}
+	if _, err := h.webhookSvc.TestEndpoint(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.renderAlertingError(w, r, err, "Ошибка тестовой доставки")
+		return
+	}
+	h.renderSaved(w, r, "Тестовая доставка поставлена в очередь")
+}
+
+// parseAlertRuleForm разбирает форму правила оповещения.
+func (h *SettingsHandler) parseAlertRuleForm(w http.ResponseWriter, r *http.Request) (service.AlertRuleInput, bool) {
+	if err := r.ParseForm(); err != nil {
+		h.renderAlert(w, r, "error", "Ошибка разбора формы: "+err.Error())
+		return service.AlertRuleInput{}, false
+	}
+
+	in := service.AlertRuleInput{
+		Name:        r.FormValue("name"),
+		Type:        r.FormValue("type"),
+		Enabled:     r.FormValue("enabled") == "true",
+		EndpointIDs: r.Form["endpoint_ids"],
+	}
+	if raw := r.FormValue("threshold"); raw != "" {
+		t, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			h.renderAlert(w, r, "error", "Порог должен быть числом")
+			return service.AlertRuleInput{}, false
+		}
+		in.Threshold = &t
+	}
+	if seID := r.FormValue("storage_element_id"); seID != "" {
+		in.StorageElementID = &seID
+	}
+	return in, true
+}
+
+// requireSettingsWrite проверяет разрешение settings:write для HTMX-действий.
+func (h *SettingsHandler) requireSettingsWrite(w http.ResponseWriter, r *http.Request) bool {
+	session := uimiddleware.SessionFromContext(r.Context())
+	if session == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return false
+	}
+	if !uimiddleware.Can(r.Context(), rbac.PermSettingsWrite) {
+		h.renderAlert(w, r, "error", "Нет прав для этого действия")
+		return false
+	}
+	if h.alertSvc == nil || h.webhookSvc == nil {
+		h.renderAlert(w, r, "error", "Оповещения не инициализированы")
+		return false
+	}
+	return true
+}
+
+// renderAlertingError отрисовывает ошибку сервиса оповещений.
+func (h *SettingsHandler) renderAlertingError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		h.renderAlert(w, r, "error", "Объект не найден")
+	case errors.Is(err, service.ErrValidation), errors.Is(err, service.ErrConflict):
+		h.renderAlert(w, r, "error", err.Error())
+	default:
+		h.logger.Error(msg, slog.String("error", err.Error()))
+		h.renderAlert(w, r, "error", msg+": "+err.Error())
+	}
+}
+
+// renderSaved отрисовывает сообщение об успехе с перезагрузкой страницы.
+func (h *SettingsHandler) renderSaved(w http.ResponseWriter, r *http.Request, msg string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.SettingsSaved(msg).Render(r.Context(), w); err != nil {
+		h.logger.Error("Ошибка рендеринга результата", slog.String("error", err.Error()))
+	}
+}
+
+// sortSelectOptions сортирует варианты выбора по подписи.
+func sortSelectOptions(options []partials.SelectOption) {
+	sort.Slice(options, func(i, j int) bool { return options[i].Label < options[j].Label })
+}
@@ -1,17 +1,22 @@
 // Пакет handlers — HTTP-обработчики Admin UI.
 // Файл storage_elements.go — обработчики страниц управления Storage Elements:
 // список SE (с фильтрацией, поиском, пагинацией), discover, регистрация,
-// редактирование, удаление, синхронизация, детальная страница.
+// редактирование, удаление, синхронизация, детальная страница,
+// сверка SE (reconcile) с историей отчётов.
 package handlers
 
 import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/model"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 	"github.com/bigkaa/goartstore/admin-module/internal/repository"
 	"github.com/bigkaa/goartstore/admin-module/internal/service"
 	uimiddleware "github.com/bigkaa/goartstore/admin-module/internal/ui/middleware"
@@ -25,10 +30,18 @@ const sePageSize = 20
 // Константа статуса "active" для фильтрации файлов.
 const statusActive = "active"
 
+// Количество отчётов в истории сверки SE
+const seReconcileHistorySize = 10
+
+// Количество проблем сверки, показываемых в панели
+const seReconcileIssuesShown = 200
+
 // StorageElementsHandler — обработчик страниц Storage Elements.
 type StorageElementsHandler struct {
 	storageElemsSvc *service.StorageElementService
 	filesSvc        *service.FileRegistryService
+	capacitySvc     *service.CapacityService
+	reconcileSvc    *service.ReconcileService
 	logger          *slog.Logger
 }
 
@@ -36,11 +49,15 @@ type StorageElementsHandler struct {
 func NewStorageElementsHandler(
 	storageElemsSvc *service.StorageElementService,
 	filesSvc *service.FileRegistryService,
+	capacitySvc *service.CapacityService,
+	reconcileSvc *service.ReconcileService,
 	logger *slog.Logger,
 ) *StorageElementsHandler {
 	return &StorageElementsHandler{
 		storageElemsSvc: storageElemsSvc,
 		filesSvc:        filesSvc,
+		capacitySvc:     capacitySvc,
+		reconcileSvc:    reconcileSvc,
 		logger:          logger.With(slog.String("component", "ui.storage_elements")),
 	}
 }
@@ -58,6 +75,7 @@ func (h *StorageElementsHandler) HandleList(w http.ResponseWriter, r *http.Reque
 	// Извлекаем параметры фильтрации из query string
 	mode := r.URL.Query().Get("mode")
 	status := r.URL.Query().Get("status")
+	label := r.URL.Query().Get("label")
 	search := r.URL.Query().Get("q")
 	pageStr := r.URL.Query().Get("page")
 	sortKey := r.URL.Query().Get("sort")
@@ -77,18 +95,9 @@ func (h *StorageElementsHandler) HandleList(w http.ResponseWriter, r *http.Reque
 		sortDir = "asc"
 	}
 
-	// Подготовка фильтров для сервиса
-	var modePtr, statusPtr *string
-	if mode != "" {
-		modePtr = &mode
-	}
-	if status != "" {
-		statusPtr = &status
-	}
-
 	// Получаем список SE
 	offset := (page - 1) * sePageSize
-	ses, total, err := h.storageElemsSvc.List(ctx, modePtr, statusPtr, sePageSize, offset)
+	ses, total, err := h.storageElemsSvc.List(ctx, seListFilters(mode, status, label), sePageSize, offset)
 	if err != nil {
 		h.logger.Error("Ошибка получения списка SE",
 			slog.String("error", err.Error()),
@@ -107,6 +116,7 @@ func (h *StorageElementsHandler) HandleList(w http.ResponseWriter, r *http.Reque
 			CapacityBytes: se.CapacityBytes,
 			UsedBytes:     se.UsedBytes,
 			LastSyncAt:    se.LastSyncAt,
+			Labels:        se.Labels,
 		}
 
 		// Подсчитываем файлы SE
@@ -132,6 +142,14 @@ func (h *StorageElementsHandler) HandleList(w http.ResponseWriter, r *http.Reque
 		items = append(items, item)
 	}
 
+	// Все пары меток для фильтра
+	labelOptions, err := h.storageElemsSvc.ListLabelPairs(ctx)
+	if err != nil {
+		h.logger.Warn("Ошибка получения меток SE",
+			slog.String("error", err.Error()),
+		)
+	}
+
 	// При поиске корректируем общее количество
 	totalFiltered := total
 	if search != "" {
@@ -151,14 +169,16 @@ func (h *StorageElementsHandler) HandleList(w http.ResponseWriter, r *http.Reque
 		Filters: pages.SEListFilters{
 			Mode:   mode,
 			Status: status,
+			Label:  label,
 			Search: search,
 		},
-		SortKey:    sortKey,
-		SortDir:    sortDir,
-		Page:       page,
-		TotalPages: totalPages,
-		TotalItems: totalFiltered,
-		PageSize:   sePageSize,
+		LabelOptions: labelOptions,
+		SortKey:      sortKey,
+		SortDir:      sortDir,
+		Page:         page,
+		TotalPages:   totalPages,
+		TotalItems:   totalFiltered,
+		PageSize:     sePageSize,
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -178,18 +198,12 @@ func (h *StorageElementsHandler) HandleTablePartial(w http.ResponseWriter, r *ht
 	// Извлекаем параметры
 	mode := r.URL.Query().Get("mode")
 	status := r.URL.Query().Get("status")
+	label := r.URL.Query().Get("label")
 	search := r.URL.Query().Get("q")
 	pageStr := r.URL.Query().Get("page")
 	sortKey := r.URL.Query().Get("sort")
 	sortDir := r.URL.Query().Get("order")
 
-	// Роль пользователя для RBAC
-	session := uimiddleware.SessionFromContext(ctx)
-	role := ""
-	if session != nil {
-		role = session.Role
-	}
-
 	page := 1
 	if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
 		page = p
@@ -202,16 +216,8 @@ func (h *StorageElementsHandler) HandleTablePartial(w http.ResponseWriter, r *ht
 		sortDir = "asc"
 	}
 
-	var modePtr, statusPtr *string
-	if mode != "" {
-		modePtr = &mode
-	}
-	if status != "" {
-		statusPtr = &status
-	}
-
 	offset := (page - 1) * sePageSize
-	ses, total, err := h.storageElemsSvc.List(ctx, modePtr, statusPtr, sePageSize, offset)
+	ses, total, err := h.storageElemsSvc.List(ctx, seListFilters(mode, status, label), sePageSize, offset)
 	if err != nil {
 		h.logger.Error("Ошибка получения списка SE (partial)",
 			slog.String("error", err.Error()),
@@ -229,6 +235,7 @@ func (h *StorageElementsHandler) HandleTablePartial(w http.ResponseWriter, r *ht
 			CapacityBytes: se.CapacityBytes,
 			UsedBytes:     se.UsedBytes,
 			LastSyncAt:    se.LastSyncAt,
+			Labels:        se.Labels,
 		}
 
 		activeStatus := statusActive
@@ -260,7 +267,6 @@ func (h *StorageElementsHandler) HandleTablePartial(w http.ResponseWriter, r *ht
 
 	tableData := partials.SETableData{
 		Items:      items,
-		Role:       role,
 		SortKey:    sortKey,
 		SortDir:    sortDir,
 		Page:       page,
@@ -339,7 +345,7 @@ func (h *StorageElementsHandler) HandleRegister(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	_, err := h.storageElemsSvc.Create(ctx, name, url)
+	_, err := h.storageElemsSvc.Create(ctx, name, url, nil)
 	if err != nil {
 		h.logger.Warn("Ошибка регистрации SE",
 			slog.String("name", name),
@@ -384,7 +390,14 @@ func (h *StorageElementsHandler) HandleEdit(w http.ResponseWriter, r *http.Reque
 		urlPtr = &url
 	}
 
-	_, err := h.storageElemsSvc.Update(ctx, id, namePtr, urlPtr)
+	// Метки — по одной паре key=value на строку; пустое поле удаляет все метки
+	labels, err := service.ParseLabels(strings.Split(r.FormValue("labels"), "\n"))
+	if err != nil {
+		h.renderAlert(w, r, err.Error())
+		return
+	}
+
+	_, err = h.storageElemsSvc.Update(ctx, id, namePtr, urlPtr, labels)
 	if err != nil {
 		h.logger.Warn("Ошибка обновления SE",
 			slog.String("se_id", id),
@@ -395,6 +408,8 @@ func (h *StorageElementsHandler) HandleEdit(w http.ResponseWriter, r *http.Reque
 			h.renderAlert(w, r, "SE не найден")
 		case errors.Is(err, service.ErrConflict):
 			h.renderAlert(w, r, "URL или storage_id уже зарегистрирован")
+		case errors.Is(err, service.ErrValidation):
+			h.renderAlert(w, r, err.Error())
 		default:
 			h.renderAlert(w, r, "Ошибка обновления: "+err.Error())
 		}
@@ -449,14 +464,15 @@ func (h *StorageElementsHandler) HandleDelete(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// HandleSync обрабатывает POST /admin/partials/se-sync/{id} — синхронизация одного SE.
+// HandleSync обрабатывает POST /admin/partials/se-sync/{id} — запуск фоновой
+// синхронизации одного SE. Если синхронизация уже выполняется — показывает её прогресс.
 func (h *StorageElementsHandler) HandleSync(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := chi.URLParam(r, "id")
 
-	result, err := h.storageElemsSvc.Sync(ctx, id)
-	if err != nil {
-		h.logger.Warn("Ошибка синхронизации SE",
+	run, err := h.storageElemsSvc.Sync(ctx, id)
+	if err != nil && !(errors.Is(err, service.ErrConflict) && run != nil) {
+		h.logger.Warn("Ошибка запуска синхронизации SE",
 			slog.String("se_id", id),
 			slog.String("error", err.Error()),
 		)
@@ -468,43 +484,89 @@ func (h *StorageElementsHandler) HandleSync(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := partials.SESyncSuccess(result).Render(ctx, w); err != nil {
-		h.logger.Error("Ошибка рендеринга sync success",
+	h.renderSyncProgress(w, r, []*model.SyncRun{run})
+}
+
+// HandleSyncAll обрабатывает POST /admin/partials/se-sync-all — запуск фоновой
+// синхронизации всех SE со статусом online.
+func (h *StorageElementsHandler) HandleSyncAll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	runs, err := h.storageElemsSvc.SyncAll(ctx)
+	if err != nil {
+		h.logger.Warn("Ошибка запуска синхронизации всех SE",
 			slog.String("error", err.Error()),
 		)
+		h.renderAlert(w, r, "Ошибка синхронизации: "+err.Error())
+		return
 	}
+
+	h.renderSyncProgress(w, r, runs)
 }
 
-// HandleSyncAll обрабатывает POST /admin/partials/se-sync-all — синхронизация всех SE.
-func (h *StorageElementsHandler) HandleSyncAll(w http.ResponseWriter, r *http.Request) {
+// HandleSyncCancel обрабатывает POST /admin/partials/sync-cancel/{id} — отмена
+// задачи синхронизации (storage:sync). Итоговый статус приходит через SSE.
+func (h *StorageElementsHandler) HandleSyncCancel(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	id := chi.URLParam(r, "id")
 
-	// Получаем все SE
-	ses, _, err := h.storageElemsSvc.List(ctx, nil, nil, 1000, 0)
-	if err != nil {
-		h.renderAlert(w, r, "Ошибка получения списка SE: "+err.Error())
+	if !uimiddleware.Can(ctx, rbac.PermStorageSync) {
+		h.renderAlert(w, r, "Нет прав для этого действия")
 		return
 	}
 
-	synced := 0
-	failed := 0
-	for _, se := range ses {
-		_, syncErr := h.storageElemsSvc.Sync(ctx, se.ID)
-		if syncErr != nil {
-			h.logger.Warn("Ошибка синхронизации SE (sync-all)",
-				slog.String("se_id", se.ID),
-				slog.String("error", syncErr.Error()),
-			)
-			failed++
-		} else {
-			synced++
+	if _, err := h.storageElemsSvc.CancelSyncRun(ctx, id); err != nil {
+		h.logger.Warn("Ошибка отмены синхронизации",
+			slog.String("run_id", id),
+			slog.String("error", err.Error()),
+		)
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			h.renderAlert(w, r, "Задача синхронизации не найдена")
+		case errors.Is(err, service.ErrConflict):
+			h.renderAlert(w, r, "Задача синхронизации уже завершена")
+		default:
+			h.renderAlert(w, r, "Ошибка отмены: "+err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// renderSyncProgress рендерит панель прогресса задач синхронизации.
+func (h *StorageElementsHandler) renderSyncProgress(w http.ResponseWriter, r *http.Request, runs []*model.SyncRun) {
+	ctx := r.Context()
+
+	// Имена SE для отображения в панели
+	names := make(map[string]string)
+	if ses, _, err := h.storageElemsSvc.List(ctx, repository.StorageElementFilters{}, 1000, 0); err == nil {
+		for _, se := range ses {
+			names[se.ID] = se.Name
+		}
+	}
+
+	items := make([]partials.SyncRunItem, len(runs))
+	for i, run := range runs {
+		items[i] = partials.SyncRunItem{
+			ID:                 run.ID,
+			StorageElementID:   run.StorageElementID,
+			SEName:             names[run.StorageElementID],
+			Status:             run.Status,
+			FilesOnSE:          run.FilesOnSE,
+			FilesProcessed:     run.FilesProcessed,
+			FilesAdded:         run.FilesAdded,
+			FilesUpdated:       run.FilesUpdated,
+			FilesMarkedDeleted: run.FilesMarkedDeleted,
+		}
+		if run.Error != nil {
+			items[i].Error = *run.Error
 		}
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := partials.SESyncAllSuccess(synced, failed).Render(ctx, w); err != nil {
-		h.logger.Error("Ошибка рендеринга sync-all success",
+	if err := partials.SESyncProgress(items).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга sync progress",
 			slog.String("error", err.Error()),
 		)
 	}
@@ -575,10 +637,23 @@ func (h *StorageElementsHandler) HandleDetail(w http.ResponseWriter, r *http.Req
 		LastFileSyncAt: se.LastFileSyncAt,
 		CreatedAt:      se.CreatedAt,
 		UpdatedAt:      se.UpdatedAt,
+		Labels:         se.Labels,
 		FileCount:      fileCount,
 		Files:          fileItems,
 	}
 
+	// Тренд и прогноз заполнения SE
+	forecast, history, err := h.capacitySvc.SEForecast(ctx, id)
+	if err != nil {
+		h.logger.Warn("Ошибка получения прогноза заполнения SE",
+			slog.String("se_id", id),
+			slog.String("error", err.Error()),
+		)
+	} else {
+		data.CapacityForecast = forecastView(forecast)
+		data.CapacityHistory = trendPoints(history)
+	}
+
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	if err := pages.SEDetail(data).Render(ctx, w); err != nil {
 		h.logger.Error("Ошибка рендеринга SE detail",
@@ -652,6 +727,153 @@ func (h *StorageElementsHandler) HandleFilesPartial(w http.ResponseWriter, r *ht
 	}
 }
 
+// HandleReconcilePanel обрабатывает GET /admin/partials/se-reconcile/{id} —
+// панель сверки SE: отчёт (query report, по умолчанию последний) с проблемами
+// (query type — фильтр по типу) и история отчётов.
+func (h *StorageElementsHandler) HandleReconcilePanel(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	h.renderReconcilePanel(w, r, chi.URLParam(r, "id"), q.Get("report"), q.Get("type"), "")
+}
+
+// HandleReconcile обрабатывает POST /admin/partials/se-reconcile/{id} —
+// запуск сверки SE (storage:sync).
+func (h *StorageElementsHandler) HandleReconcile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := chi.URLParam(r, "id")
+
+	if !uimiddleware.Can(ctx, rbac.PermStorageSync) {
+		h.renderReconcilePanel(w, r, id, "", "", "Нет прав для этого действия")
+		return
+	}
+
+	report, err := h.reconcileSvc.StartRun(ctx, id)
+	switch {
+	case err == nil, errors.Is(err, service.ErrConflict) && report != nil:
+		// Уже выполняющаяся сверка показывается как только что запущенная
+		h.renderReconcilePanel(w, r, id, report.ID, "", "")
+	case errors.Is(err, service.ErrNotFound):
+		h.renderReconcilePanel(w, r, id, "", "", "SE не найден")
+	default:
+		h.logger.Warn("Ошибка запуска сверки SE",
+			slog.String("se_id", id),
+			slog.String("error", err.Error()),
+		)
+		h.renderReconcilePanel(w, r, id, "", "", "Ошибка запуска сверки: "+err.Error())
+	}
+}
+
+// renderReconcilePanel рендерит панель сверки SE с выбранным отчётом.
+func (h *StorageElementsHandler) renderReconcilePanel(w http.ResponseWriter, r *http.Request, seID, reportID, issueType, alert string) {
+	ctx := r.Context()
+
+	data := partials.SEReconcileData{
+		SEID:      seID,
+		IssueType: issueType,
+		Alert:     alert,
+	}
+
+	reports, err := h.reconcileSvc.ListReports(ctx, seID, seReconcileHistorySize)
+	if err != nil {
+		h.logger.Warn("Ошибка получения отчётов сверки SE",
+			slog.String("se_id", seID),
+			slog.String("error", err.Error()),
+		)
+	}
+	var selected *model.ReconcileReport
+	for _, rep := range reports {
+		data.Reports = append(data.Reports, reconcileReportItem(rep))
+		if rep.Status == model.ReconcileRunning {
+			data.Running = true
+		}
+		if rep.ID == reportID || (reportID == "" && selected == nil) {
+			selected = rep
+		}
+	}
+	// Отчёт вне последних в истории открывается по ссылке
+	if selected == nil && reportID != "" {
+		if rep, err := h.reconcileSvc.Get(ctx, reportID); err == nil && rep.StorageElementID == seID {
+			selected = rep
+		}
+	}
+
+	if selected != nil {
+		item := reconcileReportItem(selected)
+		data.Selected = &item
+
+		if selected.Status == model.ReconcileSucceeded && selected.IssuesTotal > 0 {
+			var typeFilter *string
+			if issueType != "" {
+				typeFilter = &issueType
+			}
+			issues, err := h.reconcileSvc.ListIssues(ctx, selected.ID, typeFilter, seReconcileIssuesShown)
+			if err != nil {
+				h.logger.Warn("Ошибка получения проблем сверки SE",
+					slog.String("report_id", selected.ID),
+					slog.String("error", err.Error()),
+				)
+			}
+			for _, issue := range issues {
+				data.Issues = append(data.Issues, reconcileIssueItem(issue))
+			}
+			data.Truncated = len(issues) >= seReconcileIssuesShown ||
+				(issueType == "" && selected.IssuesTotal > len(issues))
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := partials.SEReconcilePanel(data).Render(ctx, w); err != nil {
+		h.logger.Error("Ошибка рендеринга панели сверки SE",
+			slog.String("se_id", seID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// reconcileReportItem преобразует отчёт сверки для отображения.
+func reconcileReportItem(rep *model.ReconcileReport) partials.ReconcileReportItem {
+	item := partials.ReconcileReportItem{
+		ID:                 rep.ID,
+		Status:             rep.Status,
+		FilesChecked:       rep.FilesChecked,
+		FilesOK:            rep.FilesOK,
+		OrphanedFiles:      rep.OrphanedFiles,
+		MissingFiles:       rep.MissingFiles,
+		ChecksumMismatches: rep.ChecksumMismatches,
+		SizeMismatches:     rep.SizeMismatches,
+		IssuesTotal:        rep.IssuesTotal,
+		CreatedAt:          rep.CreatedAt,
+		DurationMs:         rep.DurationMs,
+	}
+	if rep.RequestedBy != nil {
+		item.RequestedBy = *rep.RequestedBy
+	}
+	if rep.Error != nil {
+		item.Error = *rep.Error
+	}
+	return item
+}
+
+// reconcileIssueItem преобразует проблему сверки для отображения.
+func reconcileIssueItem(issue *model.ReconcileIssue) partials.ReconcileIssueItem {
+	item := partials.ReconcileIssueItem{
+		Type:        issue.Type,
+		Description: issue.Description,
+	}
+	if issue.FileID != nil {
+		item.FileID = *issue.FileID
+	}
+	if issue.Path != nil {
+		item.Path = *issue.Path
+	}
+	if issue.RegistryFilename != nil {
+		item.RegistryFilename = *issue.RegistryFilename
+	}
+	if issue.RegistryStatus != nil {
+		item.RegistryStatus = *issue.RegistryStatus
+	}
+	return item
+}
+
 // HandleEditForm обрабатывает GET /admin/partials/se-edit-form/{id} — форма редактирования SE.
 func (h *StorageElementsHandler) HandleEditForm(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -664,7 +886,7 @@ func (h *StorageElementsHandler) HandleEditForm(w http.ResponseWriter, r *http.R
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := partials.SEEditForm(se.ID, se.Name, se.URL).Render(ctx, w); err != nil {
+	if err := partials.SEEditForm(se.ID, se.Name, se.URL, formatLabels(se.Labels)).Render(ctx, w); err != nil {
 		h.logger.Error("Ошибка рендеринга edit form",
 			slog.String("error", err.Error()),
 		)
@@ -691,6 +913,39 @@ func (h *StorageElementsHandler) renderAlert(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// seListFilters формирует фильтры списка SE из query-параметров.
+// label — пара key=value; некорректное значение игнорируется.
+func seListFilters(mode, status, label string) repository.StorageElementFilters {
+	var filters repository.StorageElementFilters
+	if mode != "" {
+		filters.Mode = &mode
+	}
+	if status != "" {
+		filters.Status = &status
+	}
+	if label != "" {
+		if labels, err := service.ParseLabels([]string{label}); err == nil {
+			filters.Labels = labels
+		}
+	}
+	return filters
+}
+
+// formatLabels форматирует метки SE для формы редактирования:
+// по одной паре key=value на строку, в порядке ключей.
+func formatLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	lines := make([]string, len(keys))
+	for i, k := range keys {
+		lines[i] = k + "=" + labels[k]
+	}
+	return strings.Join(lines, "\n")
+}
+
 // matchSearch проверяет, содержит ли SE-элемент поисковый запрос (имя или URL).
 func matchSearch(item pages.SEListItem, search string) bool {
 	search = toLower(search)
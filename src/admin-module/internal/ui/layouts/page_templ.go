@@ -19,7 +19,7 @@ type PageParams struct {
 	Title       string           // Заголовок страницы (h1)
 	Breadcrumbs []BreadcrumbItem // Навигационная цепочка
 	Username    string           // Имя пользователя (из сессии)
-	Role        string           // Роль: "admin" или "readonly"
+	Role        string           // Effective роль пользователя
 	ActiveNav   string           // Активный пункт навигации (для sidebar)
 }
 
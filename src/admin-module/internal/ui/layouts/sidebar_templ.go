@@ -8,21 +8,28 @@ package layouts
 import "github.com/a-h/templ"
 import templruntime "github.com/a-h/templ/runtime"
 
-import "github.com/bigkaa/goartstore/admin-module/internal/ui/i18n"
+import (
+	"context"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/components"
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/i18n"
+)
 
 // SidebarParams — параметры боковой навигации
 type SidebarParams struct {
-	ActiveNav string // Идентификатор активного пункта: "dashboard", "monitoring", "storage-elements", "files", "access", "settings"
-	Role      string // Роль пользователя: "admin" или "readonly"
+	ActiveNav string // Идентификатор активного пункта: "dashboard", "monitoring", "storage-elements", "files", "access", "audit", "settings"
+	Role      string // Effective роль пользователя
 }
 
 // navItem — элемент навигации (внутренний тип)
 type navItem struct {
-	ID        string // Идентификатор для сравнения с ActiveNav
-	LabelKey  string // Ключ перевода (i18n)
-	URL       string // URL страницы
-	Icon      string // SVG path (d-attribute для <path>)
-	AdminOnly bool   // Показывать только для admin
+	ID       string // Идентификатор для сравнения с ActiveNav
+	LabelKey string // Ключ перевода (i18n)
+	URL      string // URL страницы
+	Icon     string // SVG path (d-attribute для <path>)
+	// Permissions — пункт показывается при любом из разрешений (пусто — всем)
+	Permissions []rbac.Permission
 }
 
 // navItems — список пунктов навигации
@@ -42,16 +49,18 @@ var navItems = []navItem{
 		Icon: "M3 13.125C3 12.504 3.504 12 4.125 12h2.25c.621 0 1.125.504 1.125 1.125v6.75C7.5 20.496 6.996 21 6.375 21h-2.25A1.125 1.125 0 013 19.875v-6.75zM9.75 8.625c0-.621.504-1.125 1.125-1.125h2.25c.621 0 1.125.504 1.125 1.125v11.25c0 .621-.504 1.125-1.125 1.125h-2.25a1.125 1.125 0 01-1.125-1.125V8.625zM16.5 4.125c0-.621.504-1.125 1.125-1.125h2.25C20.496 3 21 3.504 21 4.125v15.75c0 .621-.504 1.125-1.125 1.125h-2.25a1.125 1.125 0 01-1.125-1.125V4.125z",
 	},
 	{
-		ID:       "storage-elements",
-		LabelKey: "nav.storage_elements",
-		URL:      "/admin/storage-elements",
+		ID:          "storage-elements",
+		LabelKey:    "nav.storage_elements",
+		URL:         "/admin/storage-elements",
+		Permissions: []rbac.Permission{rbac.PermStorageRead},
 		// Иконка: server
 		Icon: "M21.75 17.25v-.228a4.5 4.5 0 00-.12-1.03l-2.268-9.64a3.375 3.375 0 00-3.285-2.602H7.923a3.375 3.375 0 00-3.285 2.602l-2.268 9.64a4.5 4.5 0 00-.12 1.03v.228m19.5 0a3 3 0 01-3 3H5.25a3 3 0 01-3-3m19.5 0a3 3 0 00-3-3H5.25a3 3 0 00-3 3m16.5 0h.008v.008h-.008v-.008zm-3 0h.008v.008h-.008v-.008z",
 	},
 	{
-		ID:       "files",
-		LabelKey: "nav.files",
-		URL:      "/admin/files",
+		ID:          "files",
+		LabelKey:    "nav.files",
+		URL:         "/admin/files",
+		Permissions: []rbac.Permission{rbac.PermFilesRead},
 		// Иконка: document-text
 		Icon: "M19.5 14.25v-2.625a3.375 3.375 0 00-3.375-3.375h-1.5A1.125 1.125 0 0113.5 7.125v-1.5a3.375 3.375 0 00-3.375-3.375H8.25m0 12.75h7.5m-7.5 3H12M10.5 2.25H5.625c-.621 0-1.125.504-1.125 1.125v17.25c0 .621.504 1.125 1.125 1.125h12.75c.621 0 1.125-.504 1.125-1.125V11.25a9 9 0 00-9-9z",
 	},
@@ -59,19 +68,43 @@ var navItems = []navItem{
 		ID:       "access",
 		LabelKey: "nav.access",
 		URL:      "/admin/access",
+		Permissions: []rbac.Permission{
+			rbac.PermUsersRead, rbac.PermServiceAccountsRead, rbac.PermRolesRead,
+		},
 		// Иконка: users
 		Icon: "M15 19.128a9.38 9.38 0 002.625.372 9.337 9.337 0 004.121-.952 4.125 4.125 0 00-7.533-2.493M15 19.128v-.003c0-1.113-.285-2.16-.786-3.07M15 19.128v.106A12.318 12.318 0 018.624 21c-2.331 0-4.512-.645-6.374-1.766l-.001-.109a6.375 6.375 0 0111.964-3.07M12 6.375a3.375 3.375 0 11-6.75 0 3.375 3.375 0 016.75 0zm8.25 2.25a2.625 2.625 0 11-5.25 0 2.625 2.625 0 015.25 0z",
 	},
 	{
-		ID:        "settings",
-		LabelKey:  "nav.settings",
-		URL:       "/admin/settings",
-		AdminOnly: true,
+		ID:          "audit",
+		LabelKey:    "nav.audit",
+		URL:         "/admin/audit",
+		Permissions: []rbac.Permission{rbac.PermAuditRead},
+		// Иконка: clipboard-document-list
+		Icon: "M9 12h3.75M9 15h3.75M9 18h3.75m3 .75H18a2.25 2.25 0 002.25-2.25V6.108c0-1.135-.845-2.098-1.976-2.192a48.424 48.424 0 00-1.123-.08m-5.801 0c-.065.21-.1.433-.1.664 0 .414.336.75.75.75h4.5a.75.75 0 00.75-.75 2.25 2.25 0 00-.1-.664m-5.8 0A2.251 2.251 0 0113.5 2.25H15c1.012 0 1.867.668 2.15 1.586m-5.8 0c-.376.023-.75.05-1.124.08C9.095 4.01 8.25 4.973 8.25 6.108V8.25m0 0H4.875c-.621 0-1.125.504-1.125 1.125v11.25c0 .621.504 1.125 1.125 1.125h9.75c.621 0 1.125-.504 1.125-1.125V9.375c0-.621-.504-1.125-1.125-1.125H8.25zM6.75 12h.008v.008H6.75V12zm0 3h.008v.008H6.75V15zm0 3h.008v.008H6.75V18z",
+	},
+	{
+		ID:          "settings",
+		LabelKey:    "nav.settings",
+		URL:         "/admin/settings",
+		Permissions: []rbac.Permission{rbac.PermSettingsWrite},
 		// Иконка: cog-6-tooth
 		Icon: "M9.594 3.94c.09-.542.56-.94 1.11-.94h2.593c.55 0 1.02.398 1.11.94l.213 1.281c.063.374.313.686.645.87.074.04.147.083.22.127.324.196.72.257 1.075.124l1.217-.456a1.125 1.125 0 011.37.49l1.296 2.247a1.125 1.125 0 01-.26 1.431l-1.003.827c-.293.24-.438.613-.431.992a6.759 6.759 0 010 .255c-.007.378.138.75.43.99l1.005.828c.424.35.534.954.26 1.43l-1.298 2.247a1.125 1.125 0 01-1.369.491l-1.217-.456c-.355-.133-.75-.072-1.076.124a6.57 6.57 0 01-.22.128c-.331.183-.581.495-.644.869l-.213 1.28c-.09.543-.56.941-1.11.941h-2.594c-.55 0-1.019-.398-1.11-.94l-.213-1.281c-.062-.374-.312-.686-.644-.87a6.52 6.52 0 01-.22-.127c-.325-.196-.72-.257-1.076-.124l-1.217.456a1.125 1.125 0 01-1.369-.49l-1.297-2.247a1.125 1.125 0 01.26-1.431l1.004-.827c.292-.24.437-.613.43-.992a6.932 6.932 0 010-.255c.007-.378-.138-.75-.43-.99l-1.004-.828a1.125 1.125 0 01-.26-1.43l1.297-2.247a1.125 1.125 0 011.37-.491l1.216.456c.356.133.751.072 1.076-.124.072-.044.146-.087.22-.128.332-.183.582-.495.644-.869l.214-1.281z M15 12a3 3 0 11-6 0 3 3 0 016 0z",
 	},
 }
 
+// navItemVisible проверяет, доступен ли пункт навигации пользователю.
+func navItemVisible(ctx context.Context, item navItem) bool {
+	if len(item.Permissions) == 0 {
+		return true
+	}
+	for _, perm := range item.Permissions {
+		if components.Can(ctx, perm) {
+			return true
+		}
+	}
+	return false
+}
+
 // Sidebar — боковая навигация с логотипом, пунктами меню и collapse на мобильных
 func Sidebar(params SidebarParams) templ.Component {
 	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
@@ -101,7 +134,7 @@ func Sidebar(params SidebarParams) templ.Component {
 		var templ_7745c5c3_Var2 string
 		templ_7745c5c3_Var2, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "app.name"))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 103, Col: 84}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 136, Col: 84}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var2))
 		if templ_7745c5c3_Err != nil {
@@ -112,7 +145,7 @@ func Sidebar(params SidebarParams) templ.Component {
 			return templ_7745c5c3_Err
 		}
 		for _, item := range navItems {
-			if !item.AdminOnly || params.Role == "admin" {
+			if navItemVisible(ctx, item) {
 				templ_7745c5c3_Err = sidebarNavItem(item, params.ActiveNav == item.ID).Render(ctx, templ_7745c5c3_Buffer)
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
@@ -126,7 +159,7 @@ func Sidebar(params SidebarParams) templ.Component {
 		var templ_7745c5c3_Var3 string
 		templ_7745c5c3_Var3, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "app.version"))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 118, Col: 67}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 151, Col: 67}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var3))
 		if templ_7745c5c3_Err != nil {
@@ -169,7 +202,7 @@ func sidebarNavItem(item navItem, active bool) templ.Component {
 		var templ_7745c5c3_Var5 templ.SafeURL
 		templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinURLErrs(templ.SafeURL(item.URL))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 140, Col: 32}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 173, Col: 32}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
 		if templ_7745c5c3_Err != nil {
@@ -197,7 +230,7 @@ func sidebarNavItem(item navItem, active bool) templ.Component {
 		var templ_7745c5c3_Var6 string
 		templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(item.Icon)
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 148, Col: 69}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 181, Col: 69}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
 		if templ_7745c5c3_Err != nil {
@@ -210,7 +243,7 @@ func sidebarNavItem(item navItem, active bool) templ.Component {
 		var templ_7745c5c3_Var7 string
 		templ_7745c5c3_Var7, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, item.LabelKey))
 		if templ_7745c5c3_Err != nil {
-			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 150, Col: 30}
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/layouts/sidebar.templ`, Line: 183, Col: 30}
 		}
 		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var7))
 		if templ_7745c5c3_Err != nil {
@@ -1,13 +1,17 @@
 // Пакет middleware — HTTP middleware для Admin UI.
-// auth.go — проверка UI-сессии (cookie-based), авто-refresh токенов.
+// auth.go — проверка серверной UI-сессии по cookie, авто-refresh токенов,
+// вычисление разрешений пользователя и проверка разрешений маршрутов.
 package middleware
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"time"
 
+	apimiddleware "github.com/bigkaa/goartstore/admin-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/auth"
 )
 
@@ -20,23 +24,32 @@ const (
 )
 
 // UIAuth — middleware для проверки аутентификации UI-пользователей.
-// Извлекает сессию из зашифрованного cookie, при необходимости обновляет
-// access token через Keycloak, redirect на /admin/login при отсутствии сессии.
+// Находит серверную сессию по cookie, при необходимости обновляет access token
+// через IdP, redirect на /admin/login при отсутствии или истечении сессии.
+// Разрешения вычисляются на каждый запрос по каталогу ролей и role override,
+// поэтому изменения ролей действуют без повторного входа.
 type UIAuth struct {
 	sessionManager *auth.SessionManager
-	oidcClient     *auth.OIDCClient
+	refresher      auth.TokenRefresher
+	roles          rbac.CatalogProvider
+	roleProvider   apimiddleware.RoleOverrideProvider
 	logger         *slog.Logger
 }
 
 // NewUIAuth создаёт новый UIAuth middleware.
+// refresher — OIDC-клиент Keycloak или адаптер локального IdP.
 func NewUIAuth(
 	sessionManager *auth.SessionManager,
-	oidcClient *auth.OIDCClient,
+	refresher auth.TokenRefresher,
+	roles rbac.CatalogProvider,
+	roleProvider apimiddleware.RoleOverrideProvider,
 	logger *slog.Logger,
 ) *UIAuth {
 	return &UIAuth{
 		sessionManager: sessionManager,
-		oidcClient:     oidcClient,
+		refresher:      refresher,
+		roles:          roles,
+		roleProvider:   roleProvider,
 		logger:         logger.With(slog.String("component", "ui_auth_middleware")),
 	}
 }
@@ -46,14 +59,22 @@ func NewUIAuth(
 func (ua *UIAuth) Middleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// 1. Извлекаем сессию из cookie
+			// 1. Находим сессию по cookie
 			session, err := ua.sessionManager.GetSessionFromRequest(r)
 			if err != nil {
-				ua.logger.Debug("Ошибка чтения UI-сессии",
-					slog.String("error", err.Error()),
+				if !errors.Is(err, auth.ErrSessionInvalid) {
+					// Хранилище сессий недоступно — сессия может быть действительна,
+					// cookie не трогаем
+					ua.logger.Error("Ошибка чтения UI-сессии",
+						slog.String("error", err.Error()),
+					)
+					http.Error(w, "Хранилище сессий недоступно", http.StatusServiceUnavailable)
+					return
+				}
+				ua.logger.Debug("UI-сессия недействительна",
 					slog.String("remote_addr", r.RemoteAddr),
 				)
-				// Повреждённый cookie — очищаем и redirect на login
+				// Истёкшая, отозванная или неизвестная сессия — очищаем cookie и redirect на login
 				ua.sessionManager.ClearSessionCookie(w)
 				http.Redirect(w, r, "/admin/login", http.StatusFound)
 				return
@@ -74,14 +95,18 @@ func (ua *UIAuth) Middleware() func(http.Handler) http.Handler {
 						slog.String("username", session.Username),
 						slog.String("error", refreshErr.Error()),
 					)
-					ua.sessionManager.ClearSessionCookie(w)
+					if err := ua.sessionManager.DestroySession(r.Context(), w, session); err != nil {
+						ua.logger.Warn("Ошибка удаления UI-сессии",
+							slog.String("error", err.Error()),
+						)
+					}
 					http.Redirect(w, r, "/admin/login", http.StatusFound)
 					return
 				}
 
-				// Обновляем session cookie с новыми токенами
-				if err := ua.sessionManager.SetSessionCookie(w, refreshed); err != nil {
-					ua.logger.Error("Ошибка обновления session cookie",
+				// Сохраняем новые токены в сессии
+				if err := ua.sessionManager.UpdateTokens(r.Context(), refreshed); err != nil {
+					ua.logger.Error("Ошибка сохранения обновлённых токенов сессии",
 						slog.String("error", err.Error()),
 					)
 					ua.sessionManager.ClearSessionCookie(w)
@@ -95,33 +120,107 @@ func (ua *UIAuth) Middleware() func(http.Handler) http.Handler {
 				)
 			}
 
-			// 4. Помещаем сессию в контекст
+			// 4. Роли и разрешения — по текущему каталогу ролей и role override
+			claims, err := ua.resolveClaims(r.Context(), session)
+			if err != nil {
+				ua.logger.Error("Ошибка получения role override",
+					slog.String("username", session.Username),
+					slog.String("error", err.Error()),
+				)
+				http.Error(w, "Ошибка проверки прав доступа", http.StatusServiceUnavailable)
+				return
+			}
+
+			// 5. Помещаем сессию в контекст, а также claims субъекта —
+			// сервисный слой (аудит) получает пользователя так же, как для API
 			ctx := context.WithValue(r.Context(), ContextKeyUISession, session)
+			ctx = apimiddleware.WithClaims(ctx, claims)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
-// refreshSession обновляет access token через Keycloak refresh token.
+// refreshSession обновляет access token через refresh token IdP.
 // Возвращает обновлённую SessionData или ошибку.
 func (ua *UIAuth) refreshSession(session *auth.SessionData) (*auth.SessionData, error) {
-	tokenResp, err := ua.oidcClient.RefreshTokens(session.RefreshToken)
+	tokenResp, err := ua.refresher.RefreshTokens(session.RefreshToken)
 	if err != nil {
 		return nil, err
 	}
 
 	// Обновляем данные сессии, сохраняя username/role/groups
 	return &auth.SessionData{
+		ID:           session.ID,
 		AccessToken:  tokenResp.AccessToken,
 		RefreshToken: tokenResp.RefreshToken,
 		ExpiresAt:    time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second).Unix(),
+		Subject:      session.Subject,
 		Username:     session.Username,
 		Email:        session.Email,
+		IdpRole:      session.IdpRole,
 		Role:         session.Role,
 		Groups:       session.Groups,
 	}, nil
 }
 
+// resolveClaims формирует claims сессии и вычисляет роли и разрешения.
+// Effective роль сессии обновляется для отображения в интерфейсе.
+func (ua *UIAuth) resolveClaims(ctx context.Context, session *auth.SessionData) (*apimiddleware.AuthClaims, error) {
+	claims := sessionClaims(session)
+	override, err := ua.roleProvider.GetRoleOverride(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	claims.RoleOverride = override
+	claims.ApplyAssignment(ua.roles.Catalog().Resolve(session.Groups, []string{session.IdpRole}, override))
+	session.Role = claims.EffectiveRole
+	return claims, nil
+}
+
+// RequirePermission возвращает middleware, пропускающее запрос только при
+// наличии у пользователя разрешения perm. Страницы перенаправляют на
+// Dashboard, HTMX-запросы и действия получают 403.
+// Применяется после UIAuth.
+func RequirePermission(perm rbac.Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if Can(r.Context(), perm) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if r.Method == http.MethodGet && r.Header.Get("HX-Request") == "" {
+				http.Redirect(w, r, "/admin/", http.StatusFound)
+				return
+			}
+			http.Error(w, "Недостаточно прав: требуется разрешение "+string(perm), http.StatusForbidden)
+		})
+	}
+}
+
+// Can проверяет, есть ли у пользователя UI-сессии разрешение perm.
+func Can(ctx context.Context, perm rbac.Permission) bool {
+	claims := apimiddleware.ClaimsFromContext(ctx)
+	return claims != nil && claims.HasPermission(perm)
+}
+
+// sessionClaims формирует AuthClaims Admin User из данных UI-сессии.
+// Для сессий, созданных до появления поля Subject, используется username.
+func sessionClaims(session *auth.SessionData) *apimiddleware.AuthClaims {
+	subject := session.Subject
+	if subject == "" {
+		subject = session.Username
+	}
+	return &apimiddleware.AuthClaims{
+		Subject:           subject,
+		SubjectType:       apimiddleware.SubjectTypeUser,
+		PreferredUsername: session.Username,
+		Email:             session.Email,
+		Groups:            session.Groups,
+		IdpRole:           session.IdpRole,
+		EffectiveRole:     session.Role,
+	}
+}
+
 // SessionFromContext извлекает SessionData из контекста запроса.
 // Возвращает nil если сессия не найдена (не прошёл через UIAuth middleware).
 func SessionFromContext(ctx context.Context) *auth.SessionData {
@@ -0,0 +1,308 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.977
+package pages
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/bigkaa/goartstore/admin-module/internal/ui/i18n"
+)
+
+// CapacityForecastView — тренд и прогноз заполнения хранилища для отображения.
+type CapacityForecastView struct {
+	Samples           int        // Число снимков ёмкости в периоде прогноза
+	GrowthBytesPerDay float64    // Рост использования, байт/сутки
+	DaysUntilFull     *float64   // Дней до заполнения (nil — рост не ожидается)
+	FullAt            *time.Time // Ожидаемая дата заполнения
+}
+
+// CapacityTrendPoint — точка графика использования хранилища.
+type CapacityTrendPoint struct {
+	At            time.Time
+	CapacityBytes int64
+	UsedBytes     int64
+}
+
+// capacityTrendChartData — данные для графика тренда использования.
+type capacityTrendChartData struct {
+	Used  [][2]float64 `json:"used"`  // [timestamp ms, GB]
+	Total [][2]float64 `json:"total"` // [timestamp ms, GB]
+}
+
+// capacityTrendJSON формирует JSON-данные для графика тренда использования.
+func capacityTrendJSON(points []CapacityTrendPoint) string {
+	const GB = 1024 * 1024 * 1024
+
+	data := capacityTrendChartData{
+		Used:  make([][2]float64, 0, len(points)),
+		Total: make([][2]float64, 0, len(points)),
+	}
+	for _, p := range points {
+		ts := float64(p.At.UnixMilli())
+		data.Used = append(data.Used, [2]float64{ts, float64(p.UsedBytes) / GB})
+		data.Total = append(data.Total, [2]float64{ts, float64(p.CapacityBytes) / GB})
+	}
+
+	b, _ := json.Marshal(data)
+	return string(b)
+}
+
+// capacityGrowth форматирует рост использования в сутки.
+func capacityGrowth(ctx context.Context, f *CapacityForecastView) string {
+	if f == nil || f.Samples < 2 {
+		return "—"
+	}
+	sign := "+"
+	growth := f.GrowthBytesPerDay
+	if growth < 0 {
+		sign = "−"
+		growth = -growth
+	}
+	return sign + i18n.Tf(ctx, "capacity.per_day", formatBytesLocal(int64(math.Round(growth))))
+}
+
+// capacityDaysUntilFull форматирует прогноз числа дней до заполнения.
+func capacityDaysUntilFull(ctx context.Context, f *CapacityForecastView) string {
+	switch {
+	case f == nil || f.Samples < 2:
+		return i18n.T(ctx, "capacity.not_enough_data")
+	case f.DaysUntilFull == nil:
+		return i18n.T(ctx, "capacity.not_expected")
+	case *f.DaysUntilFull < 1:
+		return i18n.T(ctx, "capacity.less_than_day")
+	default:
+		return i18n.Tf(ctx, "capacity.days", int(math.Floor(*f.DaysUntilFull)))
+	}
+}
+
+// capacityFullAt форматирует ожидаемую дату заполнения.
+func capacityFullAt(f *CapacityForecastView) string {
+	if f == nil || f.FullAt == nil {
+		return "—"
+	}
+	return f.FullAt.Format("02.01.2006")
+}
+
+// capacityDaysClass — CSS-класс прогноза: предупреждение при заполнении
+// в течение 30 дней, ошибка — в течение 7 дней.
+func capacityDaysClass(f *CapacityForecastView) string {
+	switch {
+	case f == nil || f.DaysUntilFull == nil:
+		return "text-text-secondary"
+	case *f.DaysUntilFull < 7:
+		return "text-status-error"
+	case *f.DaysUntilFull < 30:
+		return "text-status-warning"
+	default:
+		return "text-status-success"
+	}
+}
+
+// capacityForecastCard — карточка тренда и прогноза заполнения хранилища.
+func capacityForecastCard(f *CapacityForecastView, trend []CapacityTrendPoint) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"card mb-6\"><div class=\"flex items-center justify-between mb-4\"><h2 class=\"text-lg font-semibold text-text-primary\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var2 string
+		templ_7745c5c3_Var2, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "capacity.title"))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 107, Col: 86}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var2))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "</h2><span class=\"text-xs text-text-muted\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var3 string
+		templ_7745c5c3_Var3, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "capacity.source"))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 108, Col: 73}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var3))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "</span></div><div class=\"grid grid-cols-1 sm:grid-cols-3 gap-4 mb-4 text-sm\"><div class=\"p-3 bg-bg-elevated rounded-lg\"><p class=\"text-text-muted text-xs uppercase tracking-wide mb-1\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var4 string
+		templ_7745c5c3_Var4, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "capacity.growth"))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 112, Col: 100}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, "</p><p class=\"text-text-primary font-medium\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var5 string
+		templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(capacityGrowth(ctx, f))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 113, Col: 69}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, "</p></div><div class=\"p-3 bg-bg-elevated rounded-lg\"><p class=\"text-text-muted text-xs uppercase tracking-wide mb-1\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var6 string
+		templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "capacity.days_until_full"))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 116, Col: 109}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "</p>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var7 = []any{"font-medium", capacityDaysClass(f)}
+		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var7...)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "<p class=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var8 string
+		templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(templ.CSSClasses(templ_7745c5c3_Var7).String())
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 1, Col: 0}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var9 string
+		templ_7745c5c3_Var9, templ_7745c5c3_Err = templ.JoinStringErrs(capacityDaysUntilFull(ctx, f))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 117, Col: 84}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var9))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "</p></div><div class=\"p-3 bg-bg-elevated rounded-lg\"><p class=\"text-text-muted text-xs uppercase tracking-wide mb-1\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var10 string
+		templ_7745c5c3_Var10, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "capacity.full_at"))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 120, Col: 101}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var10))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "</p><p class=\"text-text-primary font-medium\">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var11 string
+		templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.JoinStringErrs(capacityFullAt(f))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 121, Col: 64}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var11))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "</p></div></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if len(trend) > 1 {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "<div x-data=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var12 string
+			templ_7745c5c3_Var12, templ_7745c5c3_Err = templ.JoinStringErrs(fmt.Sprintf("{ chartData: %s, labels: { used: '%s', total: '%s' } }", capacityTrendJSON(trend), i18n.T(ctx, "dashboard.chart.used_gb"), i18n.T(ctx, "capacity.total_gb")))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 126, Col: 182}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var12))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "\" x-init=\"\n\t\t\t\t\tnew ApexCharts($refs.chart, {\n\t\t\t\t\t\tchart: {\n\t\t\t\t\t\t\ttype: 'area',\n\t\t\t\t\t\t\theight: 240,\n\t\t\t\t\t\t\tbackground: 'transparent',\n\t\t\t\t\t\t\ttoolbar: { show: false },\n\t\t\t\t\t\t\tzoom: { enabled: false },\n\t\t\t\t\t\t\tfontFamily: 'Inter, sans-serif',\n\t\t\t\t\t\t},\n\t\t\t\t\t\tseries: [\n\t\t\t\t\t\t\t{ name: labels.used, data: chartData.used },\n\t\t\t\t\t\t\t{ name: labels.total, data: chartData.total },\n\t\t\t\t\t\t],\n\t\t\t\t\t\tcolors: ['#22c55e', '#6b7280'],\n\t\t\t\t\t\tfill: { type: 'solid', opacity: [0.25, 0] },\n\t\t\t\t\t\tstroke: { width: [2, 1], dashArray: [0, 4], curve: 'straight' },\n\t\t\t\t\t\txaxis: {\n\t\t\t\t\t\t\ttype: 'datetime',\n\t\t\t\t\t\t\tlabels: { style: { colors: '#9ca3af', fontSize: '12px' }, datetimeUTC: false },\n\t\t\t\t\t\t},\n\t\t\t\t\t\tyaxis: {\n\t\t\t\t\t\t\tmin: 0,\n\t\t\t\t\t\t\tlabels: {\n\t\t\t\t\t\t\t\tstyle: { colors: '#9ca3af', fontSize: '12px' },\n\t\t\t\t\t\t\t\tformatter: (v) => v.toFixed(1) + ' GB',\n\t\t\t\t\t\t\t},\n\t\t\t\t\t\t},\n\t\t\t\t\t\tgrid: { borderColor: '#374151', strokeDashArray: 4 },\n\t\t\t\t\t\tlegend: { labels: { colors: '#9ca3af' }, position: 'top' },\n\t\t\t\t\t\ttooltip: {\n\t\t\t\t\t\t\ttheme: 'dark',\n\t\t\t\t\t\t\tx: { format: 'dd.MM.yyyy HH:mm' },\n\t\t\t\t\t\t\ty: { formatter: (v) => v.toFixed(2) + ' GB' },\n\t\t\t\t\t\t},\n\t\t\t\t\t\tdataLabels: { enabled: false },\n\t\t\t\t\t}).render();\n\t\t\t\t\"><div x-ref=\"chart\"></div></div>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		} else {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "<p class=\"text-sm text-text-muted text-center py-6\">")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var13 string
+			templ_7745c5c3_Var13, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "capacity.no_history"))
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/capacity_forecast.templ`, Line: 169, Col: 91}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var13))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "</p>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, "</div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
@@ -11,6 +11,7 @@ import templruntime "github.com/a-h/templ/runtime"
 import (
 	"fmt"
 
+	"github.com/bigkaa/goartstore/admin-module/internal/domain/rbac"
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/components"
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/i18n"
 	"github.com/bigkaa/goartstore/admin-module/internal/ui/pages"
@@ -19,7 +20,6 @@ import (
 // UsersTableData — данные для partial таблицы пользователей.
 type UsersTableData struct {
 	Items      []pages.UserListItem
-	Role       string // Роль текущего пользователя
 	Page       int
 	TotalPages int
 	TotalItems int
@@ -117,7 +117,7 @@ func UsersTableBody(data UsersTableData) templ.Component {
 		if templ_7745c5c3_Err != nil {
 			return templ_7745c5c3_Err
 		}
-		if data.Role == "admin" {
+		if components.Can(ctx, rbac.PermUsersRead) {
 			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "<th class=\"px-4 py-3 font-medium text-right\">")
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
@@ -146,9 +146,9 @@ func UsersTableBody(data UsersTableData) templ.Component {
 				return templ_7745c5c3_Err
 			}
 			var templ_7745c5c3_Var8 string
-			templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(usersPartialColCount(data.Role))
+			templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(usersPartialColCount(components.Can(ctx, rbac.PermUsersRead)))
 			if templ_7745c5c3_Err != nil {
-				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/partials/users_table.templ`, Line: 43, Col: 49}
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/ui/pages/partials/users_table.templ`, Line: 43, Col: 79}
 			}
 			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
 			if templ_7745c5c3_Err != nil {
@@ -305,7 +305,7 @@ func UsersTableBody(data UsersTableData) templ.Component {
 			if templ_7745c5c3_Err != nil {
 				return templ_7745c5c3_Err
 			}
-			if data.Role == "admin" {
+			if components.Can(ctx, rbac.PermUsersRead) {
 				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 28, "<td class=\"px-4 py-3 text-right\"><div class=\"flex items-center justify-end gap-1\"><button class=\"p-1.5 text-text-muted hover:text-accent-primary transition-colors rounded-button hover:bg-bg-hover\" title=\"")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
@@ -336,7 +336,7 @@ func UsersTableBody(data UsersTableData) templ.Component {
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
-				if user.EffectiveRole != "admin" {
+				if user.EffectiveRole != rbac.RoleAdmin && components.Can(ctx, rbac.PermUsersWrite) {
 					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 31, "<button class=\"p-1.5 text-text-muted hover:text-status-info transition-colors rounded-button hover:bg-bg-hover\" title=\"")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
@@ -381,7 +381,7 @@ func UsersTableBody(data UsersTableData) templ.Component {
 						return templ_7745c5c3_Err
 					}
 				}
-				if user.RoleOverride != nil {
+				if user.RoleOverride != nil && components.Can(ctx, rbac.PermUsersWrite) {
 					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 35, "<button class=\"p-1.5 text-text-muted hover:text-status-warning transition-colors rounded-button hover:bg-bg-hover\" title=\"")
 					if templ_7745c5c3_Err != nil {
 						return templ_7745c5c3_Err
@@ -460,8 +460,8 @@ func UsersTableBody(data UsersTableData) templ.Component {
 }
 
 // usersPartialColCount — количество колонок.
-func usersPartialColCount(role string) string {
-	if role == "admin" {
+func usersPartialColCount(withActions bool) string {
+	if withActions {
 		return "7"
 	}
 	return "6"
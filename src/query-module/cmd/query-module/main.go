@@ -112,6 +112,7 @@ func main() {
 
 	// 9. Repository слой
 	fileRepo := repository.NewFileRepository(pool)
+	jwtAuth.SetRoleSource(repository.NewRoleRepository(pool))
 
 	// 10. LRU-кэш метаданных файлов
 	cacheService := service.NewCacheService(cfg.CacheMaxSize, cfg.CacheTTL)
@@ -376,106 +376,112 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x863Ibx5X/q3SN/6kALAAcghfL+Jc/0BZt06EohaSS2hgqYgg0yZGAGXhmwBWjYhUv",
-	"pi0XHWmdVSopr3NRNlX5lCqQIiSIF/AVel5hn2TrnO6Z6Z4ZABQlObtb/mKLwEz36XP9nUvjgVa1G03b",
-	"opbnaqUHWtNwjAb1qIN/fWTW6WwN/lWjbtUxm55pW1pJY//JzlmXnbA2O/W/Yef+AXtJWJcdsw4793dZ",
-	"1/+Cf+3vsp6/TfwvWJu9ZKesTTK3b89eJxsTWS2nmbBW0/DWtZxmGQ2qlbRVs06XzZqW0xz6ect0aE0r",
-	"eU6L5jS3uk4bBtBC7xuNZh2enpzU6bUJXc/T4nsr+Ymx2kTeeHdsKj8xMTU1OTkxoeu6ruW0VdtpGJ5W",
-	"0lotXNrbbMLbrueY1pq2tbUFu7lN23IpP7btrJi1GrXgj6ptedTy4J9Gs1k3qwYwYfSua1sKMQ806ji2",
-	"w1+pwfof3Vz4YPb69Zl5Lac1qOsaa/Ap+yPrsGPW83f8XcGgr9g56xF24W+zNjsi7Jid+o8JO/IP2AXr",
-	"sVN2DnxlXfiwxy5YBx70v2Rd1tWA+Ig1/8+hq1pJe2c0kuoo/9YdnQHyFsQ5+aljUh1GmbaV02YtjzqW",
-	"UZ+JDntV/szOL80szE/PLc8sLNxcUJn0W3bu7/m7/jae/Nx/jGf3H7IuOwTFIv4OsuGIM+ONsuGV985p",
-	"87b3kd2yaq/HkPmbS8sf3bw9f13lxd+48RBQA/gP/IWW9kZPPWCXnHbbMlreuu2Yv6avecbb89O3lz65",
-	"uTD7q5nYMf+KDD/091jH3/V3gOltkEPCp3wJlvBGz/4n3HAP/7vLjjgJ4NBOWRcZwo7A1aGH487u018u",
-	"Ec++Ry2JDvQd6mZJz/kUbIuds2PW9rf9XbGc/wX4SXYGZifrWpdk0Ca7gY8NXcMO68B7O6yLNHfYmX9A",
-	"ph3P9WyHgnNtOnaTOp7JXVooBvVjLpQEkd+zNpJwji6giyTjDkDCCeuxY4XIAmG/B43xvwr8VKls5Unl",
-	"F9Nzs9enl2ZvznMTr5D/2n7C+XkC50WBnwgmdOBU+7B28OcxMgr/wOVC8+DrBEElqbH4tKxo4oXdV9Iw",
-	"XCX04RLtg1wkvjQ7jydfXpie/3hm4KFfkgXDWqNknRo16uDLizPLt+enfzE9Ozf9wZx4e9GzHWONkpk6",
-	"bVDLU8jw9yAisHOSQRq6pGb/q1W3jVpW0CI7WUHM0RAPV7a0nBRmZb8Ui52SBSe06O+sw05ZD/wkHl3S",
-	"JNYDkUEs6/o7KOYufhDplELAAOeUjOYydPiMa3hE5Z3weXvlLq16iee5oSQfyyEYukE9o2Z4Rspp/8wj",
-	"NVfdM9Aw1pZVWAJBCeusrtPqPbfVSC67+Ml0vjg5Rfx9/zf+Q4UpxthKsTpem6CTq1PvXntPN1aqNbo6",
-	"VhyfmLzU32nCFH59mX8hQy2zYazR0btNupb2nkK0/Br7I2uzQ38bdJX12AlBjXjGemBv7IK1yUYR0F+r",
-	"XjdW4A0O9hIb0PtN06HusuGlsP4JmmKbBL4w8kMkIzymRxtN2zGczazCw6JenMrr4/ni+NLYZGlcL+n6",
-	"r2TAWDM8mvfMBr0MjQF2fQsQNafZjrlmWkZ9GXbhYFneprlue/Zy3bBqbtVo0sLdZqqcHAriNW1ruWnX",
-	"zeomLmKB4n2mhSwC9aROw4AoCpYg7RJ+nLK2a/46zQv8hbXZCzQIJQ8AnHsIf6Ej3ZelMlmcKF67pktM",
-	"MS1vaiLa07Q8ukYd3NQzvJYrH8OoeuYG1QKVAWbWaJ16tKaeJXwucRDPWHMV7n6m1e01GxZyjFU4+0YR",
-	"1jI92sAHkyvwDwzHMTbxb6++XDM23VQ8sI124e9jAJGQPiYC4Jv3h2jxuN5XOSVOtZoQFGhtmAlBGN7G",
-	"aP+YsBeszZ752/4ee8HRyOziTXJtSh/LkdtLH6bZUjFfHBtuSwmOhdStbKZQ9x1EWpWaLjslGbe1Aijt",
-	"BcAxlRjXWDatNep61FnmjnJopIhSz6StxTyjUPZc5LjVE6jcDrU0xf7SAs0n1Kh76x/C0knE1j/cPsF4",
-	"ytPFLoIazM1ZGwQJADLCmF8K38gBA/6vxw7ZKYbmtr+fTbXvhKnZ99C41hw4KYjaMOuqkeETg9kulu3P",
-	"iDlzg8qAWuWHS50Nsxrzhp+3qLOZb9i1Vp1e+ihDCc9poL2uZzSa6navpfkb1HETYXOsoBf0y7JOpita",
-	"Lxeypj9rF6hR2+zPW67dyc+btuutOdT9vD4s9ZJVOU69tEoaha8p2HiGxw79r1kXUyYO3f09f4enKfa9",
-	"CskUdT2L4PgWJ2vx53MkDrHx6UDf5XfQdR8iqGlj6ujvALp9Jlx6NwIk7Ix1cRmwlQrJTOrjyW1T0D1H",
-	"5K9ld/9b1TfwsqlaskgNp7q+QD9vUTctrv2etbl7A4mcQ4ADFwlJx0mERnrsqEDYbyGn5t+fikob1th6",
-	"mG+IOmehbLHf+TthYYD4e1gGhdB0Dp90CaawXXZOMJ089b+BRCsHSVePvcDg2va/Zm3/UZCDYjIfUuMf",
-	"lC3Ayoqish47IxUOWSoFVAbVJDF40fsetQJpJMo7IS14RtRZfwcSLpEGdv1HSqGWHbIOe0FEknvCumqE",
-	"5QizYdyfo9aat66VxvQ+mDhAq8MoYl2AichDmY6m4XimUScNw6uuqzQIWCYRUZycTKGibjZMoR2rRqvu",
-	"AbF6ogr0HQZPNFZeB+Jc6rAX/h6nlKf8gMzOsQyIKTS38C9Zh1NiNsBIx3TYoGFa4s80RNYw7i/3Ac3f",
-	"szY78XeQI3KNHbdLQdOZAEsr/BnTJ65NvjuVhqRDyvRUykyrP2VdzNZfj67ixKvTFJaqhAg1oRhaLply",
-	"dNhzoFGydtbmzp7eN6peUA8KyjdgfjtYqbjApL0jChKZquHSvGm5YFZge7ymIjYWq3yFdrSLitN/rRyZ",
-	"nZv92QyALlCrPayBnMLL3PSyiodHKsHIxRHvpGWEq6suVbU6qdNPUSZfhyeCGEVEra6NIvJ3RBXmBAsT",
-	"g4WA8bdP8YOv0cO2xUsO1kPP29e8C4T9ASnooTa9kGtzR3yRI1wZXuZlty4/RAUUonIFofo7pJJA+JVL",
-	"yRrpPBZ238NeADtKXw2lnY0V0pL+alLXL5mmD/WeF8J97WIRs5PIKCX16pvqJwGV7XhhThYYnprcpNbB",
-	"OO+xwt1FXh1BBOHSkl6P2IrlXshaUpLO/iaTT2V+tGaayuFb4N2kB2X/5e8pD0tsU8+dliWi0+zLR9up",
-	"UUdlJTBPS2nEtYOCMiZlgeImOSpRZ4QrpVMgQeNg97AGMhQsSFhE4U+BgMBThBOiFWBy2eqLfjCggumf",
-	"gLzaWBnvYoH6QAFFBUUSg4s8fSs6Q48JWfMz1mZnJBO1F45Ro59jkR09yjE2/p4jQ74J8ZsEBAPq+XIq",
-	"cIoVkwbWkRrG/Vn+5aSerCpF6rjqBXqV1tLzD3KyUT0XRf8DgXYRzcbpZz3ebYIjHkRln7SKz1heH1vS",
-	"IWu4YsWHrtoOvRr5x1z3rkY4ZjzF8dLke6XJ995UqSrpl9Wq1ZH/EItreyQzNFRdqaTVN0Xq25L8Sxzi",
-	"+gcKcCI8grdBlRE6QFbUYS8TSci64S430mX5Owya3xCeOHX8r/1vU7C1fyCfWKlkrth2nRoWHCi0l/Af",
-	"g0oQ4fFbdQ+MKa0+K+UHfbLHh6HWvcQzQB7v76qgNhUxyShNWfuv2AbcQ2yG4leAmrxy6rqe7Rn1voUO",
-	"WBLBWDyfkXtncBx/X0mDlQNNpmwcS+G5BAJqAkaGp85FKjEoeQ9FkzzOP0RREju0fbMxSVkLhD2VXHQX",
-	"4O7gblzhx3bcj+24H9txP7bj/pntuLfTRvu/0A/DVkC15Zje5iKEdO6iV6jhUGe6BTl08NdHAYs+/eVS",
-	"Iqf59JdLZGERfDeObeX4eGUUEV6S6VrDtMgN7C0UyhZ7wsvAMhCCPBL/5X+FSUo7UA7Qkx47FPNLXXZG",
-	"3KrdpKQCHHNLDjVqFZJZnM6WLTFVhnp26n9DKgbsWxmtwEO2Vd+skAwn5bZLnSzPexDMIAzCk0ayW/e8",
-	"Jh9pM61VOxjNM6qoVGKmNpgKI0vUaCTCg/bzFnU2xbl5RnyGVQ6Mspc7vwwh+s2kFcpW2XrnHRLViuAD",
-	"9gSW4YH9OS8rxrZEDN3GikuXHeK4FuRn8pZXqeyJckmHvA8IXRpeY50ceXNlP1EIIj/9yYZRb9Gf/DSL",
-	"pw55oEz5+o/D4heCNkQ7pHLr5uISGTWa5ujG2KiLmKkCmPzTxZvzZMWubZIMPsKO0K8DUb2y9fHMEvbG",
-	"85CAEEzyeuy5SJB4CQsyJ8zpt/0DpVuBTCXYC97B2tsRPMCz2R47ysFX57ichCwvWBt9JqItvkY2FPrT",
-	"uNawDnyl6J7oQ2PdG4njnbTkm6rwAeieYkZyjsOTiG+wyBCVEk+IvFOubOFAGg6nc0h/Idh9LldeuDxi",
-	"pUxe+Wc9fP9IbIFDo/4j0WKMjesVCPsuorBs8SE2VDq+/oW/7T/G8coOub0wF3/fLYQ6cxwBW2BHWEX5",
-	"ZGnplhgldHgvzI1mRbHy0mOHKDJxLLTxZ1jZSdpzKLX/EGp8xIcI+AgfnPYksNlO0ARBEsPOmDJYIASK",
-	"m3MJ9nEjizOJLhkyaEKfkOox/h667VP/W3ZOPv4w9KggkLQpXuz6HQuH0snmiKp0McagykXFrhhGUs7c",
-	"KVtS1zZTEbimkuVTNH0OImurv1cg7B98uAXo6GApvBOypYPq0mZHmOjgdHCQz2Hu+Ihr4jMgT3gfUQQR",
-	"6bYqI0zkuQC5e+xijngWfIhTxiRjeJ5TuOvaFufd4gxELd4MRJvvirDZDcLjaVB24VbU5UEwoFM4HFx9",
-	"H9UANn4R3GAIle0PKmHS/K+/n/QV3WTXgIyMLK4bDq1JzfSRETi0HNoxWMgfECyycp+N4t/FZv4pb+rx",
-	"4ewoumUgohOHrpmu52xyUV+wrv+QKxu2Bjv+t7CJSvAxSljxK12cA++AgfgHob1eJvqBBYXjtHypF9LO",
-	"7Gk6twVUIewcC2kgXQz4SJkc9x8rIw3gZsRIw3Eg6m/ZE9hp1so3aMN2Nsncwm1SNarrlGTG9fyU7pKl",
-	"pblseKpn6GsfI9X7KSk5lzHowb8NGskuW6JPT61a0zYtzyUZdoLnPGMdUhldx4mT0ZEK8Kgy2qCeY1bd",
-	"Slaa/sbjAB78AMEUB4RlK4PwMDscGb4tbCguHJStV8OGdbNKRWFPAL5bjrlheIDHW05dgES3NDq6Znrr",
-	"rZVC1W6Mrphr9wxjdM02BDzj8yEeJguK4k7fmpXGMkqaXhgr6JgCN6llNE2tpI0X9MI4diu9dUTnAVjB",
-	"E44+EJnEFny1lloM+22KswxR0SUmqoWhID4hs9c5fI8eRNe6LULDY24tCVcRM21F6oLVdpM6eNNltqaV",
-	"tI+pp8yE55Tbc5+llyWjR0bF7bqtO7HrZ0Vdv8Qdm8vdfVEITLv68v3gSfWtnDahj/XbJSR7VLkghC+N",
-	"D38pumeHb0wMfyO8Z7WV0yY5mwa/oF5Xk5NJlJCcRvKMlxukdgek4rYaDcPZvASbgiqFWEO7Azv1sYLR",
-	"4IJGf3N4OgjztuXkQDHVTNOx728C2o0hSI7D5WdLZWusQNifEKJ1MAqJsJQTiOmEtQU6WJyJyiHdID8J",
-	"0Fgm3ZiyZavIe4Ps1N/DtiDYNKLbGfGSEoSnb80GF65cvtj1D7JlaxwWSUkLkpgcwGM3mMkR9XkSgfQY",
-	"7kIv/p2cN1wJlI+MvApGLo2MEMREcEoOdkGOEIxPXwvkvllQ+/pIls+/oZAnuOveF3r8AkHGEY649QGE",
-	"Ct4KspQ3g2P7JlJ4pjeeRGH/6BlGMX6UE1LB9UtkZdOj7vuuZzhenlq1CqfsT5G2HgF3/Ueh5fA2YrhW",
-	"OEzxIY8S+aXNphhouDF7YybP6/nxqYfgYT59UolmV1NHt0ShKix0ZJU1rptu03ZNcFklYnieUV0Hu/j/",
-	"JCgSvl/WCoVCWRNjFj00S9FCFKPpeAusy87EZbvpapU2vTyyyBU8kgdvQpmBaamCwvdnlow18bwQ24n/",
-	"GzHh2JMgWYZ7uJ6/I6TZIUHhMpsS568Lfw2x9MpBPhd38pK28dt/AdGJytIgDftbdG+0RCpxrYqNH/Gv",
-	"9TyfC5wMbuDz7aM7+EiUJt+ujReKXw2x2FWPennXc6jRUJFLWNReMS3e6Ui5mp9+zy6quEWGzK8VKmGZ",
-	"nw2JVLRLrY4jYwaeOKel6L26SB8bwFZQ4W5zrawN2QHUV12yrHQVhywASxT1qR9MEH9PL4DGxZFRDDU7",
-	"RCZXkMBlXuEqnZQ5CY1hVLS5LimjwWL4nwqZJ8amXvcnG6Sbzer1/T6zEgAF2lgTh/B5jsl1kAqLsEYU",
-	"EHqgpB9v+Nct+t7AvmpCAW8VX4+n6oVvhakxzJmLj5+JGy2CX7nUmyNvkoeXuYf+GknW4LRncJLF+yLB",
-	"7aRBI8pqPa9vf2knCTrEaNmRv+fvyy0r3oICCDIyItpQIyOXa0Th/PPXHKF/Ic27PYNHEp2p8HZK2SLS",
-	"2urK4Q2kxETiWXLIOQQd4UwzgGQ8SNDuCo5y2YZXv2nd9KPCSYadNhZYYAH1aHv+o8Rstr8XDGEXpLKh",
-	"3OXyD/pd8iFXu+MzYMw1ccmHDL7jQzJ9hu6iHCZt6DbI5pQZKc4aoRy8lisP3V6apn5NcT69jan5M6xv",
-	"Kiu0RV6mVOtf4qS3zDxS4UMBlbRKGx8U+witng8pUNf7wK5tvrE6mXqPbEudhfCcFt16i0W62IRmWvAa",
-	"NqPJ4YB++UjEByitDaNu1q4bHg0RkuSP+0XMZFDHeVstp2GTOy3IxX8KRglz6iCzKMWcif7yLmGH/gEf",
-	"Hr1A63rOOiQ+PSxGIPA4N8RNodhJumCYomEd3lpKuyh05XMEP6DFLnBOfhdT2wEb5UjK050SwRCSI8Hl",
-	"H44p3zYMUipqmZQfPYo7z+wPWBz+QWu96UhBgh8Ca3D8IRpOdXOD9i3o/qy1Qh2LQsIBz1nUdUnTsVdo",
-	"gaS2Poq6nosqhUIqX6Lu7hBesyoknGR0W157i74q5U5+mpY9Fb+Q1g0JjgtE4fmcwhWJ1Zy7KqtBaJuX",
-	"4TU8aCrM/rOoBHWwuIvOhQ+/wodR2zxWKc8PuhROMmG9Ot5q5rM+T6WrMwdpN86jHjrfHuJ4SNXbvHPO",
-	"HW24FckMuYSeVhyTfkjg7aud+nsFQ/VOOpl9L+guBLzMcr8y/s8lMS4BEFF2oKksqErdz1ZE47t/j+l7",
-	"MabVxTt2AByl36BjHXLLsRvUW6ctF51UWI0GAFniQwb+V/63/i4mbCd8DkK5BervK0El0Gb270HtXijr",
-	"Gc4J4gxL0Pc/j+56SvEyddeUAbGXJIPQ84J1/Ic8duWUHxfLvjodaQXYAavIVfVgKvJE3LpNu3gBS0UT",
-	"FOumN9owXZegoeE2aZfT1etysCIwWfxeoMiiXmI7cCbJOhLeLBSiijpXOACtdlwydePXm6Rap4bVagbz",
-	"XdgE6vm7fEQoT+I6lXZ7t8NeEs9u2nV7bVPoKMkYzeZyjTapVaNWdXOZKzKmhrFv6oaH/3dp1bZqbi7+",
-	"Pc8h0t7k3yzXqAcWlt7NvyFsZqgf8+h9b7RZN8yYe4iqi++QT2bmbhG8L73M8cIyXpIhQ2/oXMaK3iFL",
-	"/3JrJm35qt0CGFS2kt894Dx4v6y5rWqVum5Z2yJjxWvvTgx+GjEwPDtRhJ2lgwWdbPeSZ0uz1dhh4kvG",
-	"zhP7us+RJq7pQ18ITzU+idowvOCtanfkHwe66+gx0gjVK+mxxQ/eBE2lARPapuV6hlVNTvc8WLddb6v0",
-	"oGk73hbmMI5prIhMbz0sigV3f+t21ajjx1s5Dd5Rv76mj+upl7v97XDeLJYGsjaBt/LX9PH3gIF3woNe",
-	"shgXtaAEwk52zVIHMdKjQKfP2rx4mFxaAo4YWV1sfp/JQo8WEZLburP13wEAAP//cPl4yUJbAAA=",
+	"H4sIAAAAAAAC/+x863LbyJX/q3Rh/qmQKpKCKEq2mZoPmrFmRonGdiQ5qX+GLhEiWxLGJMABQK8Vl6p0",
+	"ieaymh3vzDqVVDY3Z1OVT6miadGmdaFfofEK+yRb53QD6AZAUpbk7KXmiy2SQOOc0+fyO5fGI61mN1u2",
+	"RS3P1cqPtJbhGE3qUQc/fWA26EId/qpTt+aYLc+0La2ssf9gZ6zPjlmHnfhfszP/kL0irM+OWI+d+Xus",
+	"7/+K/+zvsYG/Q/xfsQ57xU5Yh2Tu3l24SR6UslpOM2GtluFtajnNMppUK2vrZoOumnUtpzn0s7bp0LpW",
+	"9pw2zWlubZM2DaCFPjSarQZcPTOj0+slXc/T4o21fGmqXsob16Zm86XS7OzMTKmk67qu5bR122kanlbW",
+	"2m1c2ttqwd2u55jWhra9vQ1Pc1u25VLOtu2smfU6teBDzbY8annwp9FqNcyaAUKY/NS1LYWYRxp1HNvh",
+	"t9Rh/Q9uL723cPPm/C0tpzWp6xob8C37A+uxIzbwd/09IaAv2BkbEPba32Ed1iXsiJ34jwnr+ofsNRuw",
+	"E3YGcmV9+HLAXrMeXOh/zvqsr21vy6L5fw5d18raO5PRrk7yX93JeSBvSfDJuY7t6jjKtO2ctmB51LGM",
+	"xnzE7EXls3BrZX7p1tzi6vzS0u0lVUjfsTN/39/zd5DzM/8x8u5/yfrsGSgW8XdRDF38t3OlYnjjZ+e0",
+	"W7b3gd226pcTyK3bK6sf3L5766Yqi79y4yGgBvAPfEJLu1KuRzwlp921jLa3aTvmL+klebx7a+7uyke3",
+	"lxZ+MR9j8y8o8Gf+Puv5e/4uCL0D+5DwKZ+DJVwp73/EB+7jv3usy0kAh3bC+igQ1gVXhx6OO7sf/3yF",
+	"ePZ9akl0oO9QH5b0nE/BttgZO2Idf8ffE8v5vwI/yU7B7GRd65MM2mRfXBe5hl3Wg/t2WR9p7rFT/5DM",
+	"OZ7r2Q4F59py7BZ1PJO6yjaoX/NNSRD5e9ZBEs7QBfSRZHwCkHDMBuxIIbJA2G9AY/wvAj9Vrlh5Uv3Z",
+	"3OLCzbmVhdu3uIlXyX/uPOHyPAZ+ccOPhRB6wNUBrB18PEJB4QdcLjQPvk4QVJIai1fLiiZu2HsjDcNV",
+	"Qh8u0T7KReJNC7eQ89WluVsfzo9k+hVZMqwNSjapUacO3rw8v3r31tzP5hYW595bFHcve7ZjbFAy36BN",
+	"ankKGf4+RAR2RjJIQ5/U7X+yGrZRzwpaZCcriOmO8XAVS8tJYVb2S7HYKVlwQov+xnrshA1YV7AuaRIb",
+	"sB6PZX1/F7e5z3oyCX2FgBHOKRnNZejwCdfwiMp74fX22qe05iWu54aSvCyHYOhj6hl1wzNSuP0Tj9Rc",
+	"dU9Bw1hHVmEJBCWss7ZJa/fddjO57PJHc/nizCzxD/x/8b9UhGJMrRVr0/USnVmfvXb9hm6s1ep0fao4",
+	"XZo51+e0zazZjQatwbMBhCWZ/B0yeYIajEaiQDur3WjETLPLd+xYvY+9yiqsXKvdoLOz127kr5WKM/mS",
+	"Xqf5G6XSWp7q19ZrU+s3dINeS8Fx8DxjrUEDiJjCDoapVf6DjBzNprFBJz9t0Y00MShcy7exP7AOe+bv",
+	"gOmxATsmqODP2QDcB3vNOuRB8TyE0Yct06HuquGlCPkJepYOCVx75FZJRgQAjzZbtmM4W6oci3pxNq9P",
+	"54vTK1Mz5Wm9rOu/kOVWNzya98wmPQ+NARR/C4g7p9mOuWFaRmMVnsKxv/yY1qbt2asNw6q7NaNFC5+2",
+	"UvfJobC9oK0tu2HWtnARC+zoEy0UEVgbdZoGgAIwbOkp4dcpa7vmL9Oc2p9Zh71E+1bSGoDtz+ATxoUD",
+	"eVdmiqXi9eu6JBTT8mZL0TNNy6Mb1MGHeobXdmU2jJpnPqBaoDIgzDptUI/WVV7C6xKMeMaGq0j3E61h",
+	"b9iwkGOsA+8PirCW6dEmXphcgX9hOI6xhZ+9xmrd2HJT4c0O2oV/gPFQSlwwr4FQczBGi6f1ocopSard",
+	"ghhH6+NMCFDFDoKXx4S9ZB323N/x99lLDq4Wlm+T67P6VI7cXXk/zZaK+eLUeFtKSCykbm0rzYsCcFCp",
+	"6bMTknHba4T12UtAlyoxrrFqWhvU9aizyv3+2MAXZdJJW4t5RqHsuSgOqRyo0g61NMX+0uLmR9RoeJvv",
+	"w9JJADocPTxBeMCz3z5iNCw1sA5sJODhCDJ/Lnwjxz/434A9w3ADPx9kU+07YWr2fTSuDQc4ha02zIZq",
+	"ZPb95EoxsYtlhwti0XxA5fxAlYdLnQdmLeYNP2tTZyvftOvtBj03K2MJz2mgva5nNFvq4y6l+Q+o4ybC",
+	"5lRBL+jnFZ1MV7ReLhTNcNEuUaO+NVy2XLuT37ds19twqPtZY1wmKatynHpplTQKL7mx8YSVPfO/AhxF",
+	"gkzE3/d3edZl36+STFHXswjF7nCyln+6SOIZA14d6Lt8D7ruZwhqOpgJ+7sEYI5w6f0IkLBT1sdlwFaq",
+	"JDOjTycfm5Ks8ATjUnb3v1V9Ay+bqiXL1HBqm0v0szZ10+Lab1iHuzfYkTMIcOAiIYc6jtDIgHULhH0H",
+	"JQL++4koHCL0HsB9Qdm2ULHYr/3dsM5B/H2s6kJoOoNv+gQz8j47I5gdn/hfQ96YA2Q/YC8xuHb8r1jH",
+	"/yZIqbE2EVLjH1YswMqKorIBOyVVDlmqBVSGeF1idB7y14gUZDGZYfSvIsFIx8T0oUetQEvGEYby2YW8",
+	"VmTbff8bJWliz1iPvSSilnDM+mrk58i3aTxcpNaGt6mVp/QhdAUoeqyo+uyUU6LQ0TIczzQapGl4tU2V",
+	"BgEXJSKKMzMpVDTMpim0dt1oNzwgVs+l55DoRHi5jUupx176+5xSXllhXa7icFHoeT5nPU6J2QTnMaXD",
+	"A5qmJT6mIcWm8XB1CJj/PeuwY6ihAU6QWhn+TjrKzwQYX5HPlF66PnNtNg3hh5TpqZSZ1nDK+uzs0nQV",
+	"S29OU1gRFFuoCcXQcslUqMdeAI2SF2IdHoToQ6PmBWW3oEoGbgFsvwuZMq/fYN0nUzNcmjctF8wKfAIv",
+	"XYkHi1W+QDvaY/2Ra+XIwuLCT+YBDIJa7WOp6QRu5qaXVSIPUgnOR7B4Ly1TXV93qarVSZ1+invyVcgR",
+	"xE4iSqId3CJ/VxS7jlln7CYgLhhSY+JrDLA79IonEWFEGGreBcJ+ixQMUJteyiXQLl+kiyvDzby62edM",
+	"VEEhqhfYVH+XVBOZR/Vce410Hgm7H2DLhXXTV8PdzlasMf5qRtfPWT4Y6z1fC/e1h7XiXiLTldRraAki",
+	"CfRsxwtzxcDw1KQrtdzIZY+NhD7KqgsRhO+WdHskVqyqQzaVkgwPN5l8qvCjNdNUDu8C7yZdKPsvf1+5",
+	"WBKbynda9grLDpej7dSpo4oShKel9Ds7Qd2enXAGhkhUos4IV0qnQILswdPD2sxYsCBhJEU+BcL+lLo5",
+	"IYoCIVesoajM3xOmfwz71cEGRJ91Y6VpBIQyryOLT0MrTWPZhGz+OeuwU5KJSsVHqNEvsJeBHuUI1IS9",
+	"QIF8HeJKCaAG1PPlVOAUK3KNrG81jYcL/McZPVntitRx3aNOKn9CeDnZqF6wXkghcI0oO04/G/CmHrB4",
+	"GJWj0ipRU3l9akWHbOaClSi6bjv0YuQfscHFCcdMrDhdnrlRnrlxVSW0pF9Wq2ld/0ss+u2TzNhQdaFS",
+	"29DUbWjn989xiOsfKsCJ8AjeAVVG6ABpTI+9SiRHm4a72kzfy19j0Pya8ISu53/lf5uCrf1DmWOlwrpm",
+	"2w1qWNq2ZC/hH6NKIyH77YYHxpRWN5bygyFZ7Zeh1r1CHqC+4O+poDYVMckoTVn7L5gR7iM2w+1XgJq8",
+	"cuq6nu0ZjaEFmF7U21LzGblFCez4B0p6rjA0k/LgWGmB70BATSDIkOtcpBKjigrh1iTZ+bsolmIjfGg2",
+	"JilrgbCnkovuA9wd3fQsfN/1/L7r+X3X8/uu5/ddzyvrer6dbuX/hbYjdlxqbcf0tpYBofCIs0YNhzpz",
+	"bW8z+vRBIKIf/3wlkaLBmN/SMoQiHPbL8aHcKMC9InP1pmmRj7GFA9X0J7jHCq6DtBj/ggkonCQUygF6",
+	"MmDPxNQbFNLcmt2ipAoSc8sONaAtszyXrVhiFhH17MT/mlQNeG51sgoX2VZjq0oynJS7LnWyPI1DbIao",
+	"DjmN9m7T81p8ENK01u1goBNqYeVHwSR2MEtIVqjRTIQH7adQohJ88wQfMtMjDhrOx7+MiIZNMhYqVsV6",
+	"5x0Slb7gC/YEluE45QWvksYeiSlBBwtIfZj4Q2ByqjzyIoVKUf3pkXch4ZBGHqHyeHVVTFHXIj/8wQOj",
+	"0aY/+GEWuQ5loMyG+4/DWh5Qw8Ebqd65vbxCJo2WOflgatJFCFiFFOPHy7dvkTW7vkUyeAnrol8HogYV",
+	"68P5FRxByEM+RTBnHbAXIt/jFTlIBLFEseMfKk0hFCroAbC4i0nYYZCcD1g3Bz+d4XISUH4Nc7CwN6wX",
+	"rJENN/1pXGtgErRiKbon2v1YxkfieMMyeae6+YDOIMHgiBfNUNRMQmmyYyI/KVexcIwRjzTwDOW1EPeZ",
+	"XEji+xGrzPJGBhvg/V3Wi0aN/W9EJzc25Fkg7HcRhRWLQ0pUOr7+a38HGrAIs+8uLcbvdwuhzhxFOJ11",
+	"paLQRysrd8QAqsNbjm40YYyFJBijOGODgC208edskCJf/3G4a7+Lt+Lgh6jMwD3Fc39fMMGzXXguWrOo",
+	"9iT6eSQDjsltGTWaI1UFslezBfUBw/C32gI/8w9FVQk8w66/z575/yyGc9FZ7Cqe+EdKTzOFwMCHRHW2",
+	"HqTf+6HRB2dmTnmqLxQGe6HC10cVehR6J5h7CUuoFavtUgckkSPLc+HXpNYwIa6a9R8RpP4ZpqSfs44i",
+	"qiZtrlHHrSpRK1sQapJ87mNOahB1sNyIcWeYIFP2rUBkv4X7EitSsl6g3/FVldJkLj3DTI0uogWDlvYF",
+	"13hS0kuhiv678LRdPk7EZ5Nhi46DsNIL2o5wS9QjV0aMhM/hIsP1hkS65flUpoEkqQLq7yNjJ/637Ix8",
+	"+H4Y9IGTtOMJ4FMAN+Aje9kcUf1izHbRK0bl5RiMV3juVSxpfiNTFdC7muXzdEMYkR2qv18g7O98zA3o",
+	"6GHzqReKhe94h3XhEn7sIaigYLXmG+4snwN5IkCKsqMocKl7hPbEN5BH8D7q4WnwJR6fIBnD85wCnFHh",
+	"sluGxpFov2NY6rOjcMuC6MoLndzR9zlOC+gUMRFXP0A1gAe/DI5mhcr2W5Uw6WCDf5AMZ/1kn45MTCxv",
+	"Gg6tS2M1ExPAtGzHiGfkL2CrTgSswO2XHUOPvYpUAABYBlwdceiG6XrOFt9q6Fh+iffyZnzP/xYeohJ8",
+	"hDushL4+HnABF7DrH4Yh5TwADSwoPCfAl3opPZk9TZe2QNMEzjUgXOlw14iUydD0sTLchH6BDzcdBVv9",
+	"LXsCT1qw8k3atJ0tsrh0l9SM2iYlmWk9P6u7ZGVlMRty9RzhwGOk+iDFRfkHgR7866izJhVLTOxQq96y",
+	"TctzSYYdI5+nrEeqk5s4ezY5UQUZVSeb1HPMmlvNSsdakB1IWd5DvM9zloqVwQwmOz55eVvpizhJVbHe",
+	"LH1pmDUqSukiJ7njmA8MD1LGttMQeYxbnpzcML3N9lqhZjcn18yN+4YxuWEbIoPgk2Ie5rOK4s7dWZAG",
+	"tMqaXpgq6HC13aKW0TK1sjZd0AvTOB/gbWICGeBp5HDykUh2t+GnjdTy83cpzjIE7uc4KiIMBSE0WbjJ",
+	"M8zoQnStOyI0PObWknAVMdNOBv/wdE0ieOfQ8EPUyz3efgIscQvdizBNRyCaHJohj7waStbBk4JwqFj7",
+	"kHrKmZqccvr4k/R+Q3TJpDidvH0vdny3qOvnOKN4vrODCoFpRwd/P3L7QJ1K+tSwp4RkTyoHLPGm6fE3",
+	"ReeU8Y7S+DvCc6rbOW1G18ffoB73lcsquENyQeUTLbJ77R7sittuNqEUOV5MQb1OrKHdgycNMbbJ4IDb",
+	"cKt7Oir768hpsuIRMi3HfrgFeV8sl+IZqXxtuWJNFQj7I2p8D4OdiH45AcwA6HMQsjwfFQb7QaYegL5M",
+	"us1mK1ZRIOcTnkGggWGeNy9uUmL93J2F4MCqyxe7+V62Yk3DIikJcjI7XZ7nnl6E5FPe0wnT1Ri8w2Ah",
+	"5afkYunpxMSbQPHyxATkVByKc0wN+wgx/+RSWPpqsfPlATMfuMVNLvEIcSD0+CVimS7O1A7BnQqsC/L1",
+	"q4HLQ0sKyNOVlxOwMfwcYxJn5ZhUcf0yWdvyqPuu6xmOl6dWvVqQawxvOZBVLDgwHpgFzAed+N+EJsoH",
+	"EUKiw3Gs93k4yq9stcRI1McLH8/neQstPjcVXMzn16rRVH7q8KeoDYe1xayyxk3TbdlQkbStMjE8z6ht",
+	"ggH+iAR1+XcrWqFQqGhVgZnR/sUQgjh0g8d1+3CICpeeq9Voy8vjXrhiM+TRvVA5wIZVjcD751eMDXG9",
+	"0I9jaPSqyTQ2/tCVQtuRq02PBL2CbAqguCkCAwTtC6OJXDyaSGrNj2kHRCeKuaNU+a/RAf8yqcbVNzbA",
+	"yH/W83yyeCZ4VQp/fPSyFCRKk1+DEO/NvBk0smse9fKu50DFX4FIYR9pzbR4czHlHSrpB6KjInfkMfj5",
+	"byX+c96QSEW71IYUCmYkxzktRe/VRYbYAHZfoeda0cY8AdRXXbKizCWMWQCWKOqz/7CN+Ft6zyG+HRnF",
+	"ULNj9uQCO3CeW7hKJ/echMYwKTrL59yj0dvwPxWbl6ZmL/tuHekVFOp7VoZMWwHm6GAbCuL0GRYLgtRe",
+	"hDWioN1DJc+54tcQDX1VxkUzF7ireDmZqm/mUIQaA7e5+ACrOKsn5JVLPRN3lTI8zwtDLpHNjc6vRmdz",
+	"vBUZnLscdchBrU8ObenuJkGHGE6F7tqB3CXmXV+AIBMTovM7MXG+3i+eoPiKpwJyS+I5XJJoBofn7ioW",
+	"kdZWVw7PViZmmk+TxyRC0BGeisgWBCNBhzlg5bw95mHz/umsAifjuI0FFn8vzhpU9uOnO/x90e7OFqQy",
+	"qNxY9g+HHV8kFzu9OGJQPnF8kYw+vUgyQ8Z2o2QpRcZh2qhMWXLRCOXgtWm5nXhumobNofDzH1gDeI71",
+	"WmWFjkgAle7DKzwrIguPVPkcjki5vrsUHyM6tX3lusQFsIdcG9UcjptArOc6pN+aVpTkw7IfoN/ik03U",
+	"9d6z61tXVlJUz/huqwNUMPi1/RbrmbEp9bTwO25OnQMa/fyxFJkwrQdGw6zfNDwaYjwpogyL+UlYgmcO",
+	"oHAPkzFpYTr+1jElUKuHOUTV6lQMpewR9sw/5PMHr9E/vGA9Ej9BIeamkJ2P7XoaJ1CN6Yopl/DkZtph",
+	"yQvzEbyrkb3297mzZ6ejHpQjKVf3ygSDYI4EByA5Kn7bQE4pPmZS3q8Xd//Zf2Ad/R9aFk/HOhKAEmiJ",
+	"IyjRAmzAyOuw2vdP2mvUsSikTHCdRV2XtBx7jcIrAVIKkUVdz0VFVbErn8MX4DOxvFdIOMnoTSbaW/RV",
+	"Ke9LSdOyp+JlnP2Q4PiGKDJfVKQiiZpLVxU1bNrWeWQNF5qKsP8kalk9rIOjc+ET8wMMesEgQ6ypkB/1",
+	"wg6SCUv78eY/HxB8GoVz/zDtbSDRVAN/vP+NRNXbfB8Id7Tho0hmzAtC0sp70kte3r7aqe+SGat3Emf2",
+	"/aARE8gyy/3K9H8vifEdgC3KjjSVJVWph9mKGEUYaia8+Ydp2zH2Q5TXnbIeuePYTept0raLTiqspwN0",
+	"LPOxD/8L/1t/z/8iWOO1ehLeP1CCSqDN7N+CNodQ1lMcLsapomAS4yw67y7Fy9SnpsyZvSIZBM8wIPQl",
+	"j1055T2W2TenI62EPGIVuS8QjFIfizcPpB0+g6WimZZN05tsmq5L0NDwMWkv6FCPDMOKIGTxalqRB77C",
+	"zul8UnQkPF0ttipq8uGpCbU5lWkYv9wiNTiG2G4FE3fYL4P3EeDQVp7EdSrtDQYw4uTZLbthb2wJHSUZ",
+	"o9VardMWterUqm2tckXG5Db2S8Pw8H+X1myr7ubiv/MsKO1O/stqnXpgYemDDx8Lmxnrxzz60JtsNQwz",
+	"5h6i+ug75KP5xTsE3xmxyvHCKh4UJGNPKZ7Hit4hK///znza8jW7DTCoYiV/e8Rl8G5Fc9u1GnXdirZN",
+	"porXr5VGX40YGK4tFSuWwljQ9HfPyVuarcaYiS8Z4yf28xCWStf1sTeEXE3PoDaML9mr2h35x5HuOrqM",
+	"NEP1Snps8TKyoC024liHabmeYdWS81aPNm3X2y4/atmOt405jGPCwSV+PDks6wXvP2jYNaOBX2/nNLhH",
+	"/fm6Pq2nvuAC3sIgJgBjaSDrELgrf12fvgECvBcyes5yYtREEwg72fdLnVkZNm2cvjYvf27nRgBHjKwu",
+	"rMtO5U2PFhE7t31v+78GAF9v3U6tYQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -111,9 +111,12 @@ type ErrorResponse struct {
 // FileMetadata Полные метаданные файла
 type FileMetadata struct {
 	// Checksum SHA-256 хэш
-	Checksum    string  `json:"checksum"`
-	ContentType string  `json:"content_type"`
-	Description *string `json:"description"`
+	Checksum string `json:"checksum"`
+
+	// CollectionId Коллекция файла (null — файл вне коллекций)
+	CollectionId *openapi_types.UUID `json:"collection_id"`
+	ContentType  string              `json:"content_type"`
+	Description  *string             `json:"description"`
 
 	// ExpiresAt Дата истечения (для temporary)
 	ExpiresAt        *time.Time                  `json:"expires_at"`
@@ -189,6 +192,9 @@ type HealthReadyResponseStatus string
 // Если не указан ни один фильтр, возвращаются все файлы
 // со статусом `active`.
 type SearchRequest struct {
+	// CollectionId Фильтр по коллекции
+	CollectionId *openapi_types.UUID `json:"collection_id,omitempty"`
+
 	// FileExtension Фильтр по расширению файла (без точки)
 	FileExtension *string `json:"file_extension,omitempty"`
 
@@ -286,9 +292,12 @@ type SearchResponse struct {
 // SearchResultItem Элемент результатов поиска. Содержит метаданные файла.
 type SearchResultItem struct {
 	// Checksum SHA-256 хэш
-	Checksum    string  `json:"checksum"`
-	ContentType string  `json:"content_type"`
-	Description *string `json:"description"`
+	Checksum string `json:"checksum"`
+
+	// CollectionId Коллекция файла (null — файл вне коллекций)
+	CollectionId *openapi_types.UUID `json:"collection_id"`
+	ContentType  string              `json:"content_type"`
+	Description  *string             `json:"description"`
 
 	// ExpiresAt Дата истечения (для temporary)
 	ExpiresAt        *time.Time                      `json:"expires_at"`
@@ -10,6 +10,9 @@ import (
 
 	apierrors "github.com/bigkaa/goartstore/query-module/internal/api/errors"
 	"github.com/bigkaa/goartstore/query-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/query-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/query-module/internal/domain/grants"
+	"github.com/bigkaa/goartstore/query-module/internal/domain/model"
 	"github.com/bigkaa/goartstore/query-module/internal/service"
 )
 
@@ -29,6 +32,15 @@ func (h *APIHandler) handleGetFileMetadata(w http.ResponseWriter, r *http.Reques
 		apierrors.InternalError(w, "Внутренняя ошибка при получении метаданных файла")
 		return
 	}
+	if !allowsFile(r, record) {
+		apierrors.Forbidden(w, "Недостаточно прав: файл вне grants SA")
+		return
+	}
+	// Файл чужой коллекции не раскрывается
+	if ok, err := h.allowsCollection(r, record); err != nil || !ok {
+		h.writeCollectionDenied(w, fileID.String(), err)
+		return
+	}
 
 	// Конвертация domain модели в API-тип FileMetadata
 	resp := generated.FileMetadata{
@@ -45,6 +57,7 @@ func (h *APIHandler) handleGetFileMetadata(w http.ResponseWriter, r *http.Reques
 		RetentionPolicy:  generated.FileMetadataRetentionPolicy(record.RetentionPolicy),
 		TtlDays:          record.TTLDays,
 		ExpiresAt:        record.ExpiresAt,
+		CollectionId:     parseUUIDPtr(record.CollectionID),
 	}
 
 	writeJSON(w, http.StatusOK, resp)
@@ -60,6 +73,22 @@ func (h *APIHandler) handleDownloadFile(w http.ResponseWriter, r *http.Request,
 		rangeHeader = *params.Range
 	}
 
+	// Grants SA и коллекции: метаданные проверяются до обращения к SE
+	if claims := middleware.ClaimsFromContext(r.Context()); claims != nil &&
+		(claims.Grants.Restricted(grants.ScopeFilesRead) || claims.CollectionSubject() != nil) {
+		record, err := h.searchService.GetFileMetadata(r.Context(), fileID.String())
+		if err == nil {
+			if !allowsFile(r, record) {
+				apierrors.Forbidden(w, "Недостаточно прав: файл вне grants SA")
+				return
+			}
+			if ok, err := h.allowsCollection(r, record); err != nil || !ok {
+				h.writeCollectionDenied(w, fileID.String(), err)
+				return
+			}
+		}
+	}
+
 	// Вызываем download service — полный pipeline (кэш → AM → SE → streaming)
 	err := h.downloadService.Download(r.Context(), w, fileID.String(), rangeHeader)
 	if err != nil {
@@ -88,6 +117,42 @@ func (h *APIHandler) handleDownloadFile(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// allowsFile проверяет grants files:read субъекта запроса для файла.
+func allowsFile(r *http.Request, record *model.FileRecord) bool {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil {
+		return true
+	}
+	return claims.AllowsGrant(grants.ScopeFilesRead, grants.Access{
+		SE:         []string{record.StorageElementID},
+		Retention:  record.RetentionPolicy,
+		UploadedBy: record.UploadedBy,
+	})
+}
+
+// allowsCollection проверяет право read субъекта запроса в коллекции файла.
+func (h *APIHandler) allowsCollection(r *http.Request, record *model.FileRecord) (bool, error) {
+	claims := middleware.ClaimsFromContext(r.Context())
+	if claims == nil {
+		return true, nil
+	}
+	return h.searchService.CanRead(r.Context(), record, claims.CollectionSubject())
+}
+
+// writeCollectionDenied отвечает на отказ в доступе к коллекции файла:
+// 404 (файл не раскрывается) или 500 при ошибке проверки.
+func (h *APIHandler) writeCollectionDenied(w http.ResponseWriter, fileID string, err error) {
+	if err != nil {
+		h.logger.Error("Ошибка проверки доступа к коллекции",
+			slog.String("file_id", fileID),
+			slog.String("error", err.Error()),
+		)
+		apierrors.InternalError(w, "Внутренняя ошибка при проверке доступа к файлу")
+		return
+	}
+	apierrors.NotFound(w, "Файл не найден")
+}
+
 // contains проверяет, содержит ли строка хотя бы одну из подстрок.
 func contains(s string, substrs ...string) bool {
 	for _, sub := range substrs {
@@ -152,6 +152,15 @@ func parseUUID(s string) uuid.UUID {
 	return u
 }
 
+// parseUUIDPtr конвертирует необязательный UUID (nil — nil).
+func parseUUIDPtr(s *string) *uuid.UUID {
+	if s == nil {
+		return nil
+	}
+	u := parseUUID(*s)
+	return &u
+}
+
 // tagsToPtr конвертирует []string в *[]string для API-ответов.
 // nil или пустой срез → nil (omitempty в JSON).
 func tagsToPtr(tags []string) *[]string {
@@ -10,6 +10,8 @@ import (
 
 	apierrors "github.com/bigkaa/goartstore/query-module/internal/api/errors"
 	"github.com/bigkaa/goartstore/query-module/internal/api/generated"
+	"github.com/bigkaa/goartstore/query-module/internal/api/middleware"
+	"github.com/bigkaa/goartstore/query-module/internal/domain/grants"
 	"github.com/bigkaa/goartstore/query-module/internal/domain/model"
 	"github.com/bigkaa/goartstore/query-module/internal/repository"
 )
@@ -86,6 +88,21 @@ func (h *APIHandler) handleSearchFiles(w http.ResponseWriter, r *http.Request) {
 		Offset:          offset,
 	}
 
+	if req.CollectionId != nil {
+		id := req.CollectionId.String()
+		params.CollectionID = &id
+	}
+
+	if claims := middleware.ClaimsFromContext(r.Context()); claims != nil {
+		// Grants SA: только файлы, разрешённые files:read
+		if claims.SubjectType == middleware.SubjectTypeSA {
+			params.Grants = claims.Grants.For(grants.ScopeFilesRead)
+			params.GrantSubjects = []string{claims.Subject, claims.ClientID}
+		}
+		// Коллекции: только файлы коллекций, где субъект — участник с правом read
+		params.Collections = claims.CollectionSubject()
+	}
+
 	// Вызов service
 	result, err := h.searchService.Search(r.Context(), params)
 	if err != nil {
@@ -160,5 +177,6 @@ func fileRecordToSearchItem(r *model.FileRecord) generated.SearchResultItem {
 		RetentionPolicy:  generated.SearchResultItemRetentionPolicy(r.RetentionPolicy),
 		TtlDays:          r.TTLDays,
 		ExpiresAt:        r.ExpiresAt,
+		CollectionId:     parseUUIDPtr(r.CollectionID),
 	}
 }
@@ -1,6 +1,7 @@
 // auth.go — JWT middleware для аутентификации и авторизации Query Module.
 // Извлекает claims из Keycloak JWT, определяет тип субъекта (User / Service Account),
-// маппит группы в роли. Упрощённая версия без RoleOverrideProvider (QM read-only).
+// маппит группы в роли. Разрешения Admin Module (collections:write)
+// вычисляются так же, как в Admin Module: по ролям из общей БД (RoleSource).
 // Fallback-валидация подписи через JWKS Keycloak (основная — на API Gateway).
 package middleware
 
@@ -13,6 +14,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,6 +23,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 
 	apierrors "github.com/bigkaa/goartstore/query-module/internal/api/errors"
+	"github.com/bigkaa/goartstore/query-module/internal/domain/grants"
+	"github.com/bigkaa/goartstore/query-module/internal/domain/model"
 )
 
 // contextKey — тип для ключей контекста (избегаем коллизий).
@@ -47,6 +51,14 @@ const (
 	RoleAdmin    = "admin"
 )
 
+// PermCollectionsWrite — разрешение Admin Module на доступ к файлам всех
+// коллекций.
+const PermCollectionsWrite = "collections:write"
+
+// adminPermissions — разрешения встроенной роли admin, которые проверяет QM
+// (в Admin Module у admin все разрешения).
+var adminPermissions = []string{PermCollectionsWrite}
+
 // roleWeight — вес роли для сравнения.
 var roleWeight = map[string]int{
 	RoleReadonly: 1,
@@ -74,6 +86,9 @@ type AuthClaims struct {
 	// EffectiveRole — роль, вычисленная из групп IdP (admin, readonly, "").
 	// QM не использует role overrides — IdP роль является итоговой.
 	EffectiveRole string
+	// Permissions — разрешения Admin Module: объединение разрешений ролей из
+	// групп IdP (или realm roles) и role override, как в Admin Module.
+	Permissions []string
 
 	// --- Для Service Account ---
 
@@ -81,6 +96,8 @@ type AuthClaims struct {
 	Scopes []string
 	// ClientID — client_id из JWT (для Service Account).
 	ClientID string
+	// Grants — ограничения scopes по ресурсам из claim "artstore_grants".
+	Grants grants.Set
 }
 
 // HasRole проверяет, есть ли у субъекта указанная роль.
@@ -98,6 +115,11 @@ func (c *AuthClaims) HasAnyRole(roles ...string) bool {
 	return false
 }
 
+// HasPermission проверяет наличие у пользователя разрешения Admin Module.
+func (c *AuthClaims) HasPermission(permission string) bool {
+	return slices.Contains(c.Permissions, permission)
+}
+
 // HasScope проверяет наличие указанного scope.
 func (c *AuthClaims) HasScope(scope string) bool {
 	for _, s := range c.Scopes {
@@ -118,6 +140,28 @@ func (c *AuthClaims) HasAnyScope(scopes ...string) bool {
 	return false
 }
 
+// AllowsGrant проверяет доступ к ресурсу с учётом grants SA.
+// Для User grants не применяются.
+func (c *AuthClaims) AllowsGrant(scope string, access grants.Access) bool {
+	if c.SubjectType != SubjectTypeSA {
+		return true
+	}
+	return c.Grants.Allows(scope, access, c.Subject, c.ClientID)
+}
+
+// CollectionSubject возвращает субъект для проверки членства в коллекциях:
+// username пользователя или client_id SA. Пользователю с разрешением
+// collections:write доступны все коллекции (nil) — как в Admin Module.
+func (c *AuthClaims) CollectionSubject() *model.CollectionSubject {
+	if c.SubjectType == SubjectTypeSA {
+		return &model.CollectionSubject{Type: string(SubjectTypeSA), ID: c.ClientID}
+	}
+	if c.HasPermission(PermCollectionsWrite) {
+		return nil
+	}
+	return &model.CollectionSubject{Type: string(SubjectTypeUser), ID: c.PreferredUsername}
+}
+
 // keycloakClaims — raw claims из Keycloak JWT для парсинга.
 type keycloakClaims struct {
 	jwt.RegisteredClaims
@@ -133,6 +177,8 @@ type keycloakClaims struct {
 	Scope string `json:"scope,omitempty"`
 	// ClientID — client_id (для Service Account).
 	ClientID string `json:"client_id,omitempty"`
+	// Grants — ограничения scopes SA по ресурсам.
+	Grants grants.Set `json:"artstore_grants,omitempty"`
 }
 
 // realmAccess — вложенная структура realm_access в Keycloak JWT.
@@ -140,14 +186,26 @@ type realmAccess struct {
 	Roles []string `json:"roles"`
 }
 
+// RoleSource — роли Admin Module из общей БД: пользовательские роли и
+// role overrides. Реализуется repository.RoleRepository.
+type RoleSource interface {
+	// ListRoles возвращает пользовательские роли.
+	ListRoles(ctx context.Context) ([]*model.Role, error)
+	// GetRoleOverride возвращает дополнительную роль пользователя.
+	// Если override не найден — возвращает nil, nil.
+	GetRoleOverride(ctx context.Context, userID string) (*string, error)
+}
+
 // JWTAuth — middleware для JWT-аутентификации через JWKS Keycloak.
 type JWTAuth struct {
 	jwks           keyfunc.Keyfunc
 	logger         *slog.Logger
 	adminGroups    []string
 	readonlyGroups []string
-	issuer         string
-	jwtLeeway      time.Duration
+	// roles — роли Admin Module (nil — только встроенные роли)
+	roles     RoleSource
+	issuer    string
+	jwtLeeway time.Duration
 }
 
 // NewJWTAuth создаёт JWT middleware с JWKS из Keycloak.
@@ -215,6 +273,12 @@ func NewJWTAuth(
 	}, nil
 }
 
+// SetRoleSource задаёт источник ролей Admin Module для вычисления
+// разрешений пользователей. Вызывается до начала обработки запросов.
+func (j *JWTAuth) SetRoleSource(roles RoleSource) {
+	j.roles = roles
+}
+
 // httpClientWithCA создаёт HTTP-клиент с кастомным CA-сертификатом.
 func httpClientWithCA(caCertPath string, timeout time.Duration) (*http.Client, error) {
 	caCert, err := os.ReadFile(caCertPath)
@@ -239,7 +303,7 @@ func httpClientWithCA(caCertPath string, timeout time.Duration) (*http.Client, e
 }
 
 // Middleware возвращает HTTP middleware для JWT-аутентификации.
-// Извлекает Bearer token, валидирует подпись (RS256), извлекает claims,
+// Извлекает Bearer token, валидирует подпись (RS256 или ES256), извлекает claims,
 // определяет тип субъекта, вычисляет effective role и помещает в контекст.
 func (j *JWTAuth) Middleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -266,7 +330,7 @@ func (j *JWTAuth) Middleware() func(http.Handler) http.Handler {
 			// Парсинг и валидация JWT через JWKS
 			rawClaims := &keycloakClaims{}
 			parserOpts := []jwt.ParserOption{
-				jwt.WithValidMethods([]string{"RS256"}),
+				jwt.WithValidMethods([]string{"RS256", "ES256"}),
 				jwt.WithExpirationRequired(),
 				jwt.WithLeeway(j.jwtLeeway),
 			}
@@ -297,7 +361,7 @@ func (j *JWTAuth) Middleware() func(http.Handler) http.Handler {
 			}
 
 			// Формируем AuthClaims
-			authClaims := j.buildAuthClaims(rawClaims)
+			authClaims := j.buildAuthClaims(r.Context(), rawClaims)
 
 			// Помещаем claims в контекст
 			ctx := context.WithValue(r.Context(), ContextKeyClaims, authClaims)
@@ -307,9 +371,8 @@ func (j *JWTAuth) Middleware() func(http.Handler) http.Handler {
 }
 
 // buildAuthClaims формирует AuthClaims из raw Keycloak claims.
-// Определяет тип субъекта, маппит группы → роли.
-// QM не использует role overrides — упрощённая версия.
-func (j *JWTAuth) buildAuthClaims(raw *keycloakClaims) *AuthClaims {
+// Определяет тип субъекта, маппит группы → роли и вычисляет разрешения.
+func (j *JWTAuth) buildAuthClaims(ctx context.Context, raw *keycloakClaims) *AuthClaims {
 	claims := &AuthClaims{
 		Subject:           raw.Subject,
 		PreferredUsername: raw.PreferredUsername,
@@ -323,6 +386,7 @@ func (j *JWTAuth) buildAuthClaims(raw *keycloakClaims) *AuthClaims {
 		j.buildSAClaims(claims, raw)
 	} else {
 		j.buildUserClaims(claims, raw)
+		j.resolvePermissions(ctx, claims)
 	}
 
 	return claims
@@ -333,6 +397,7 @@ func (j *JWTAuth) buildSAClaims(claims *AuthClaims, raw *keycloakClaims) {
 	claims.SubjectType = SubjectTypeSA
 	claims.ClientID = raw.ClientID
 	claims.Scopes = parseScopeString(raw.Scope)
+	claims.Grants = raw.Grants
 }
 
 // buildUserClaims заполняет claims для User (Authorization Code flow).
@@ -362,6 +427,82 @@ func (j *JWTAuth) buildUserClaims(claims *AuthClaims, raw *keycloakClaims) {
 	}
 }
 
+// catalogRole — роль каталога Admin Module: встроенная или пользовательская.
+type catalogRole struct {
+	name        string
+	permissions []string
+	groups      []string
+}
+
+// resolvePermissions загружает пользовательские роли и role override из
+// БД и вычисляет разрешения пользователя. При ошибке БД учитываются
+// только встроенные роли (или роли без override).
+func (j *JWTAuth) resolvePermissions(ctx context.Context, claims *AuthClaims) {
+	catalog := []catalogRole{
+		{name: RoleAdmin, permissions: adminPermissions, groups: j.adminGroups},
+		{name: RoleReadonly, groups: j.readonlyGroups},
+	}
+	var override *string
+	if j.roles != nil {
+		custom, err := j.roles.ListRoles(ctx)
+		if err != nil {
+			j.logger.Warn("Ошибка получения ролей Admin Module", slog.String("error", err.Error()))
+		}
+		for _, r := range custom {
+			if r.Name == RoleAdmin || r.Name == RoleReadonly {
+				continue
+			}
+			catalog = append(catalog, catalogRole{name: r.Name, permissions: r.Permissions, groups: r.Groups})
+		}
+
+		override, err = j.roles.GetRoleOverride(ctx, claims.Subject)
+		if err != nil {
+			j.logger.Warn("Ошибка получения role override",
+				slog.String("user_id", claims.Subject),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	claims.Permissions = resolveCatalogPermissions(catalog, claims.Groups, claims.Roles, override)
+}
+
+// resolveCatalogPermissions повторяет rbac.Catalog.Resolve Admin Module:
+// роли из групп IdP, realm roles — если группы не дали ролей, и role
+// override; итог — объединение разрешений ролей каталога.
+func resolveCatalogPermissions(catalog []catalogRole, groups, realmRoles []string, override *string) []string {
+	var roles []catalogRole
+	for _, r := range catalog {
+		if slices.ContainsFunc(r.groups, func(g string) bool { return slices.Contains(groups, g) }) {
+			roles = append(roles, r)
+		}
+	}
+	if len(roles) == 0 {
+		for _, r := range catalog {
+			if slices.Contains(realmRoles, r.name) {
+				roles = append(roles, r)
+			}
+		}
+	}
+	if override != nil {
+		for _, r := range catalog {
+			if r.name == *override {
+				roles = append(roles, r)
+			}
+		}
+	}
+
+	var permissions []string
+	for _, r := range roles {
+		for _, p := range r.permissions {
+			if !slices.Contains(permissions, p) {
+				permissions = append(permissions, p)
+			}
+		}
+	}
+	return permissions
+}
+
 // parseScopeString разбирает строку scopes из JWT (space-separated).
 func parseScopeString(scope string) []string {
 	if scope == "" {
@@ -0,0 +1,65 @@
+// auth_test.go — unit-тесты вычисления разрешений Admin Module пользователя
+// и субъекта проверки коллекций.
+package middleware
+
+import (
+	"testing"
+
+	"github.com/bigkaa/goartstore/query-module/internal/domain/model"
+)
+
+func TestResolveCatalogPermissions(t *testing.T) {
+	catalog := []catalogRole{
+		{name: RoleAdmin, permissions: adminPermissions, groups: []string{"artstore-admins"}},
+		{name: RoleReadonly, groups: []string{"artstore-viewers"}},
+		{name: "curators", permissions: []string{"files:read", PermCollectionsWrite}, groups: []string{"team-curators"}},
+		{name: "file-curator", permissions: []string{"files:read", "files:write"}, groups: []string{"team-files"}},
+	}
+	curators, unknown := "curators", "removed"
+
+	tests := []struct {
+		name       string
+		groups     []string
+		realmRoles []string
+		override   *string
+		want       bool
+	}{
+		{"группа admin", []string{"artstore-admins"}, nil, nil, true},
+		{"группа readonly", []string{"artstore-viewers"}, nil, nil, false},
+		{"пользовательская роль с collections:write", []string{"team-curators"}, nil, nil, true},
+		{"realm role admin без групп", nil, []string{"admin"}, nil, true},
+		{"группа роли — realm roles не учитываются", []string{"team-files"}, []string{"admin"}, nil, false},
+		{"override пользовательской ролью", []string{"artstore-viewers"}, nil, &curators, true},
+		{"override неизвестной ролью", []string{"artstore-viewers"}, nil, &unknown, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := &AuthClaims{
+				SubjectType: SubjectTypeUser,
+				Permissions: resolveCatalogPermissions(catalog, tt.groups, tt.realmRoles, tt.override),
+			}
+			if got := claims.HasPermission(PermCollectionsWrite); got != tt.want {
+				t.Errorf("HasPermission(collections:write) = %v, хотели %v (разрешения %v)", got, tt.want, claims.Permissions)
+			}
+		})
+	}
+}
+
+func TestCollectionSubject(t *testing.T) {
+	admin := &AuthClaims{SubjectType: SubjectTypeUser, PreferredUsername: "root", EffectiveRole: RoleReadonly,
+		Permissions: []string{PermCollectionsWrite}}
+	if s := admin.CollectionSubject(); s != nil {
+		t.Errorf("CollectionSubject(collections:write) = %+v, хотели nil", s)
+	}
+
+	// Доступ ко всем коллекциям определяет разрешение, а не EffectiveRole
+	user := &AuthClaims{SubjectType: SubjectTypeUser, PreferredUsername: "alice", EffectiveRole: RoleAdmin}
+	if s := user.CollectionSubject(); s == nil || *s != (model.CollectionSubject{Type: "user", ID: "alice"}) {
+		t.Errorf("CollectionSubject(alice) = %+v, хотели user/alice", s)
+	}
+
+	sa := &AuthClaims{SubjectType: SubjectTypeSA, ClientID: "sa_ingest"}
+	if s := sa.CollectionSubject(); s == nil || *s != (model.CollectionSubject{Type: "service_account", ID: "sa_ingest"}) {
+		t.Errorf("CollectionSubject(SA) = %+v, хотели service_account/sa_ingest", s)
+	}
+}
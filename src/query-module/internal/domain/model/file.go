@@ -40,4 +40,15 @@ type FileRecord struct {
 	CreatedAt time.Time
 	// UpdatedAt — время последнего обновления
 	UpdatedAt time.Time
+	// CollectionID — UUID коллекции файла (nil — файл вне коллекций)
+	CollectionID *string
+}
+
+// CollectionSubject — субъект, для которого проверяется членство в
+// коллекциях (таблица collection_members, owned by Admin Module).
+type CollectionSubject struct {
+	// Type — тип субъекта: user (по username) или service_account (по client_id)
+	Type string
+	// ID — username пользователя или client_id Service Account
+	ID string
 }
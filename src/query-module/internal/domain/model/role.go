@@ -0,0 +1,13 @@
+package model
+
+// Role — пользовательская роль Admin Module (таблица roles, owned by Admin
+// Module): набор разрешений и группы IdP, членство в которых даёт роль.
+// Встроенные роли admin и readonly в таблице не хранятся.
+type Role struct {
+	// Name — имя роли
+	Name string
+	// Permissions — разрешения вида <ресурс>:<действие>
+	Permissions []string
+	// Groups — группы IdP, членство в которых даёт роль
+	Groups []string
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 
+	"github.com/bigkaa/goartstore/query-module/internal/domain/grants"
 	"github.com/bigkaa/goartstore/query-module/internal/domain/model"
 )
 
@@ -16,7 +17,8 @@ import (
 // DRY: одно место для всех SELECT'ов.
 const fileColumns = `file_id, original_filename, content_type, size, checksum,
 	storage_element_id, uploaded_by, uploaded_at, description, tags,
-	status, retention_policy, ttl_days, expires_at, created_at, updated_at`
+	status, retention_policy, ttl_days, expires_at, created_at, updated_at,
+	collection_id`
 
 // SearchParams — параметры поиска файлов.
 // Все поля — указатели, nil = фильтр не применяется.
@@ -53,6 +55,15 @@ type SearchParams struct {
 	Limit int
 	// Offset — смещение
 	Offset int
+	// Grants — ограничения files:read SA: видны только подходящие файлы
+	Grants []grants.Grant
+	// GrantSubjects — идентификаторы SA для ограничения «только свои файлы»
+	GrantSubjects []string
+	// CollectionID — фильтр по коллекции (UUID)
+	CollectionID *string
+	// Collections — субъект, которому видны файлы вне коллекций и файлы
+	// коллекций с правом read (nil — все коллекции)
+	Collections *model.CollectionSubject
 }
 
 // FileRepository — интерфейс доступа к файлам в file_registry.
@@ -65,6 +76,10 @@ type FileRepository interface {
 	Search(ctx context.Context, params SearchParams) ([]*model.FileRecord, int, error)
 	// MarkDeleted обновляет статус файла на 'deleted' (lazy cleanup при 404 от SE).
 	MarkDeleted(ctx context.Context, fileID string) error
+	// ReplicaLocations возвращает ID SE с подтверждёнными (synced) репликами файла.
+	ReplicaLocations(ctx context.Context, fileID string) ([]string, error)
+	// CanReadCollection проверяет право read субъекта в коллекции.
+	CanReadCollection(ctx context.Context, collectionID string, subject model.CollectionSubject) (bool, error)
 }
 
 // fileRepo — реализация FileRepository через pgx.
@@ -86,6 +101,7 @@ func (r *fileRepo) GetByID(ctx context.Context, fileID string) (*model.FileRecor
 		&f.FileID, &f.OriginalFilename, &f.ContentType, &f.Size, &f.Checksum,
 		&f.StorageElementID, &f.UploadedBy, &f.UploadedAt, &f.Description, &f.Tags,
 		&f.Status, &f.RetentionPolicy, &f.TTLDays, &f.ExpiresAt, &f.CreatedAt, &f.UpdatedAt,
+		&f.CollectionID,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -126,6 +142,7 @@ func (r *fileRepo) Search(ctx context.Context, params SearchParams) ([]*model.Fi
 			&f.FileID, &f.OriginalFilename, &f.ContentType, &f.Size, &f.Checksum,
 			&f.StorageElementID, &f.UploadedBy, &f.UploadedAt, &f.Description, &f.Tags,
 			&f.Status, &f.RetentionPolicy, &f.TTLDays, &f.ExpiresAt, &f.CreatedAt, &f.UpdatedAt,
+			&f.CollectionID,
 		); err != nil {
 			return nil, 0, fmt.Errorf("ошибка сканирования файла: %w", err)
 		}
@@ -165,6 +182,48 @@ func (r *fileRepo) MarkDeleted(ctx context.Context, fileID string) error {
 	return nil
 }
 
+// ReplicaLocations возвращает ID SE, на которых есть synced-реплика файла.
+// Таблица file_replicas ведётся Admin Module (репликация между SE).
+func (r *fileRepo) ReplicaLocations(ctx context.Context, fileID string) ([]string, error) {
+	query := `
+		SELECT storage_element_id
+		FROM file_replicas
+		WHERE file_id = $1 AND status = 'synced'
+		ORDER BY replicated_at`
+
+	rows, err := r.db.Query(ctx, query, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения реплик файла: %w", err)
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var seID string
+		if err := rows.Scan(&seID); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования реплики: %w", err)
+		}
+		result = append(result, seID)
+	}
+	return result, rows.Err()
+}
+
+// CanReadCollection проверяет, что субъект — участник коллекции с правом read.
+// Таблица collection_members ведётся Admin Module.
+func (r *fileRepo) CanReadCollection(ctx context.Context, collectionID string, subject model.CollectionSubject) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM collection_members
+			WHERE collection_id = $1 AND subject_type = $2 AND subject_id = $3
+				AND 'read' = ANY(permissions))`
+
+	var ok bool
+	if err := r.db.QueryRow(ctx, query, collectionID, subject.Type, subject.ID).Scan(&ok); err != nil {
+		return false, fmt.Errorf("ошибка проверки членства в коллекции: %w", err)
+	}
+	return ok, nil
+}
+
 // buildSearchWhere строит WHERE-условие и аргументы для поиска файлов.
 // startArg — номер первого $-параметра (для корректной нумерации).
 //
@@ -254,6 +313,52 @@ func buildSearchWhere(params SearchParams, startArg int) (whereClause string, ar
 	if params.UploadedBefore != nil {
 		conditions = append(conditions, fmt.Sprintf("uploaded_at <= $%d", argNum))
 		args = append(args, *params.UploadedBefore)
+		argNum++
+	}
+
+	// Фильтр по коллекции
+	if params.CollectionID != nil && *params.CollectionID != "" {
+		conditions = append(conditions, fmt.Sprintf("collection_id = $%d", argNum))
+		args = append(args, *params.CollectionID)
+		argNum++
+	}
+
+	// Коллекции: файлы вне коллекций и коллекции, где субъект — участник с правом read
+	if cs := params.Collections; cs != nil {
+		conditions = append(conditions, fmt.Sprintf(`(collection_id IS NULL OR collection_id IN (
+			SELECT m.collection_id FROM collection_members m
+			WHERE m.subject_type = $%d AND m.subject_id = $%d AND 'read' = ANY(m.permissions)))`,
+			argNum, argNum+1))
+		args = append(args, cs.Type, cs.ID)
+		argNum += 2
+	}
+
+	// Grants SA: хотя бы один grant (OR), ограничения внутри grant — AND
+	if len(params.Grants) > 0 {
+		alternatives := make([]string, 0, len(params.Grants))
+		for _, g := range params.Grants {
+			var conds []string
+			if g.SE != nil {
+				conds = append(conds, fmt.Sprintf("storage_element_id::text = ANY($%d)", argNum))
+				args = append(args, g.SE)
+				argNum++
+			}
+			if len(g.Retention) > 0 {
+				conds = append(conds, fmt.Sprintf("retention_policy = ANY($%d)", argNum))
+				args = append(args, g.Retention)
+				argNum++
+			}
+			if g.Own {
+				conds = append(conds, fmt.Sprintf("uploaded_by = ANY($%d)", argNum))
+				args = append(args, params.GrantSubjects)
+				argNum++
+			}
+			if len(conds) == 0 {
+				conds = append(conds, "TRUE")
+			}
+			alternatives = append(alternatives, "("+strings.Join(conds, " AND ")+")")
+		}
+		conditions = append(conditions, "("+strings.Join(alternatives, " OR ")+")")
 	}
 
 	where := ""
@@ -3,6 +3,9 @@ package repository
 import (
 	"strings"
 	"testing"
+
+	"github.com/bigkaa/goartstore/query-module/internal/domain/grants"
+	"github.com/bigkaa/goartstore/query-module/internal/domain/model"
 )
 
 // --- Тесты buildSearchWhere ---
@@ -162,6 +165,66 @@ func TestBuildSearchWhere_StartArgOffset(t *testing.T) {
 	}
 }
 
+// TestBuildSearchWhere_Grants проверяет условие grants SA:
+// OR между grants, AND внутри grant, пустой список SE.
+func TestBuildSearchWhere_Grants(t *testing.T) {
+	status := "active"
+	params := SearchParams{
+		Status: &status,
+		Grants: []grants.Grant{
+			{Scope: grants.ScopeFilesRead, SE: []string{"se-1"}, Retention: []string{"temporary"}},
+			{Scope: grants.ScopeFilesRead, Own: true},
+		},
+		GrantSubjects: []string{"kc-sub", "sa_ingest"},
+	}
+	where, args := buildSearchWhere(params, 1)
+
+	want := "((storage_element_id::text = ANY($2) AND retention_policy = ANY($3)) OR (uploaded_by = ANY($4)))"
+	if !strings.Contains(where, "status = $1 AND "+want) {
+		t.Errorf("where = %q, ожидалось условие grants %q", where, want)
+	}
+	if len(args) != 4 {
+		t.Fatalf("args count = %d, ожидался 4", len(args))
+	}
+	if subjects, ok := args[3].([]string); !ok || len(subjects) != 2 {
+		t.Errorf("args[3] = %v, ожидались субъекты SA", args[3])
+	}
+
+	// Пустой список SE — ни один файл не подходит (ANY пустого массива — false)
+	params = SearchParams{Grants: []grants.Grant{{Scope: grants.ScopeFilesRead, SE: []string{}}}}
+	where, args = buildSearchWhere(params, 1)
+	if where != "WHERE ((storage_element_id::text = ANY($1)))" || len(args) != 1 {
+		t.Errorf("where = %q, args = %v", where, args)
+	}
+}
+
+// TestBuildSearchWhere_Collections проверяет фильтр по коллекции и
+// ограничение членством: файлы вне коллекций или коллекции с правом read.
+func TestBuildSearchWhere_Collections(t *testing.T) {
+	collectionID := "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+	params := SearchParams{
+		CollectionID: &collectionID,
+		Collections:  &model.CollectionSubject{Type: "service_account", ID: "sa_ingest"},
+	}
+	where, args := buildSearchWhere(params, 1)
+
+	if !strings.Contains(where, "collection_id = $1 AND (collection_id IS NULL OR collection_id IN (") {
+		t.Errorf("where = %q, ожидались фильтр и ограничение коллекций", where)
+	}
+	if !strings.Contains(where, "m.subject_type = $2 AND m.subject_id = $3 AND 'read' = ANY(m.permissions)") {
+		t.Errorf("where = %q, ожидалась проверка членства с правом read", where)
+	}
+	if len(args) != 3 || args[0] != collectionID || args[1] != "service_account" || args[2] != "sa_ingest" {
+		t.Errorf("args = %v", args)
+	}
+
+	// Без субъекта (admin) — ограничение не применяется
+	where, _ = buildSearchWhere(SearchParams{}, 1)
+	if strings.Contains(where, "collection_members") {
+		t.Errorf("where = %q, ограничение коллекций не ожидалось", where)
+	}
+}
+
 // --- Тесты buildOrderBy ---
 
 // TestBuildOrderBy_Default проверяет сортировку по умолчанию.
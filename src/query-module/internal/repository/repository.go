@@ -1,6 +1,7 @@
 // Пакет repository — слой доступа к данным PostgreSQL для Query Module.
-// QM — read-only потребитель таблицы file_registry (owned by Admin Module),
-// за исключением обновления статуса при lazy cleanup (MarkDeleted).
+// QM — read-only потребитель таблиц Admin Module: file_registry (за
+// исключением обновления статуса при lazy cleanup, MarkDeleted),
+// collection_members, roles и role_overrides.
 // Все запросы — чистый SQL через pgx, без ORM.
 package repository
 
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bigkaa/goartstore/query-module/internal/domain/model"
+)
+
+// RoleRepository — чтение ролей Admin Module: пользовательские роли
+// (таблица roles) и role overrides пользователей (таблица role_overrides).
+type RoleRepository interface {
+	// ListRoles возвращает пользовательские роли.
+	ListRoles(ctx context.Context) ([]*model.Role, error)
+	// GetRoleOverride возвращает дополнительную роль пользователя по sub
+	// (keycloak_user_id). Если override нет — nil, nil.
+	GetRoleOverride(ctx context.Context, userID string) (*string, error)
+}
+
+// roleRepo — реализация RoleRepository.
+type roleRepo struct {
+	db DBTX
+}
+
+// NewRoleRepository создаёт репозиторий ролей.
+func NewRoleRepository(db DBTX) RoleRepository {
+	return &roleRepo{db: db}
+}
+
+func (r *roleRepo) ListRoles(ctx context.Context) ([]*model.Role, error) {
+	rows, err := r.db.Query(ctx, `SELECT name, permissions, groups FROM roles ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка получения ролей: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*model.Role
+	for rows.Next() {
+		role := &model.Role{}
+		if err := rows.Scan(&role.Name, &role.Permissions, &role.Groups); err != nil {
+			return nil, fmt.Errorf("ошибка сканирования роли: %w", err)
+		}
+		result = append(result, role)
+	}
+	return result, rows.Err()
+}
+
+func (r *roleRepo) GetRoleOverride(ctx context.Context, userID string) (*string, error) {
+	var role string
+	err := r.db.QueryRow(ctx,
+		`SELECT additional_role FROM role_overrides WHERE keycloak_user_id = $1`, userID,
+	).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ошибка получения role override: %w", err)
+	}
+	return &role, nil
+}
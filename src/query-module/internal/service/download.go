@@ -1,6 +1,7 @@
 // download.go — сервис proxy download файлов из Storage Elements.
 // Полный pipeline: FileRecord (cache/DB) → SE URL (Admin Module) → streaming download.
-// Поддержка HTTP Range requests, ленивая очистка при 404 от SE.
+// Поддержка HTTP Range requests, переключение на реплики (file_replicas)
+// при недоступности основного SE, ленивая очистка при 404 от всех SE.
 package service
 
 import (
@@ -54,6 +55,18 @@ var (
 		Name: "qm_lazy_cleanup_total",
 		Help: "Количество операций lazy cleanup (файл не найден на SE).",
 	})
+
+	downloadFailoversTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "qm_download_failovers_total",
+		Help: "Количество скачиваний, обслуженных репликой вместо основного SE.",
+	})
+)
+
+// Исходы попытки скачивания с одного SE (совпадают с метками qm_downloads_total).
+const (
+	outcomeNotFound = "not_found"
+	outcomeAMError  = "am_error"
+	outcomeSEError  = "se_error"
 )
 
 // DownloadService — сервис proxy download файлов из Storage Elements.
@@ -88,11 +101,12 @@ func NewDownloadService(
 //  1. Получить FileRecord (из кэша или БД)
 //  2. Получить SE URL из Admin Module (по storage_element_id)
 //  3. Запросить файл у SE (пробросить Range header)
-//  4. Если SE вернул 404 → lazy cleanup (mark deleted + invalidate cache)
-//  5. Streaming copy в ResponseWriter с пробросом заголовков
+//  4. Если основной SE недоступен или не отдал файл → по очереди synced-реплики
+//  5. Если все SE вернули 404 → lazy cleanup (mark deleted + invalidate cache)
+//  6. Streaming copy в ResponseWriter с пробросом заголовков
 //
-// Возвращает ошибку только при невосстановимых проблемах. При 404 от SE
-// записывает ответ клиенту напрямую и возвращает ErrFileDeleted.
+// Возвращает ошибку только при невосстановимых проблемах. При 404 от всех SE
+// возвращает ErrFileDeleted.
 func (ds *DownloadService) Download(ctx context.Context, w http.ResponseWriter, fileID, rangeHeader string) error {
 	start := time.Now()
 	activeDownloads.Inc()
@@ -111,46 +125,58 @@ func (ds *DownloadService) Download(ctx context.Context, w http.ResponseWriter,
 		return ErrNotFound
 	}
 
-	// 2. Получить SE URL из Admin Module
-	seInfo, err := ds.adminClient.GetStorageElement(ctx, record.StorageElementID)
-	if err != nil {
-		downloadsTotal.WithLabelValues("am_error").Inc()
-		return fmt.Errorf("получение информации о SE %s: %w", record.StorageElementID, err)
-	}
-
-	ds.logger.Debug("SE URL получен",
-		slog.String("file_id", fileID),
-		slog.String("se_id", record.StorageElementID),
-		slog.String("se_url", seInfo.URL),
-	)
+	// 2-3. Запросить файл у основного SE
+	resp, outcome, err := ds.fetchFromSE(ctx, record.StorageElementID, fileID, rangeHeader)
+	if resp == nil {
+		// 4. Failover на реплики.
+		// failOutcome/failErr — последняя ошибка, отличная от 404: она
+		// возвращается клиенту, если файл не удалось получить ни с одного SE.
+		outcomes := []string{outcome}
+		failOutcome, failErr := outcome, err
+		replicas, repErr := ds.fileRepo.ReplicaLocations(ctx, fileID)
+		if repErr != nil {
+			ds.logger.Warn("Не удалось получить реплики файла",
+				slog.String("file_id", fileID),
+				slog.String("error", repErr.Error()),
+			)
+		}
+		for _, seID := range replicas {
+			var replicaErr error
+			resp, outcome, replicaErr = ds.fetchFromSE(ctx, seID, fileID, rangeHeader)
+			if resp != nil {
+				downloadFailoversTotal.Inc()
+				ds.logger.Info("Файл отдан с реплики",
+					slog.String("file_id", fileID),
+					slog.String("primary_se_id", record.StorageElementID),
+					slog.String("replica_se_id", seID),
+				)
+				break
+			}
+			outcomes = append(outcomes, outcome)
+			if outcome != outcomeNotFound {
+				failOutcome, failErr = outcome, replicaErr
+			}
+		}
 
-	// 3. Запросить файл у SE (streaming)
-	resp, err := ds.seClient.Download(ctx, seInfo.URL, fileID, rangeHeader)
-	if err != nil {
-		downloadsTotal.WithLabelValues("se_error").Inc()
-		return fmt.Errorf("скачивание файла %s из SE: %w", fileID, err)
+		if resp == nil {
+			// 5. Файл отсутствует на всех SE → lazy cleanup
+			if allNotFound(outcomes) {
+				ds.logger.Warn("Файл не найден ни на одном SE, выполняется lazy cleanup",
+					slog.String("file_id", fileID),
+					slog.String("se_id", record.StorageElementID),
+					slog.Int("replicas", len(replicas)),
+				)
+				ds.lazyCleanup(ctx, fileID)
+				downloadsTotal.WithLabelValues("lazy_cleanup").Inc()
+				return ErrFileDeleted
+			}
+			downloadsTotal.WithLabelValues(failOutcome).Inc()
+			return failErr
+		}
 	}
 	defer resp.Body.Close()
 
-	// 4. SE вернул 404 → lazy cleanup
-	if resp.StatusCode == http.StatusNotFound {
-		ds.logger.Warn("Файл не найден на SE, выполняется lazy cleanup",
-			slog.String("file_id", fileID),
-			slog.String("se_id", record.StorageElementID),
-			slog.String("se_url", seInfo.URL),
-		)
-		ds.lazyCleanup(ctx, fileID)
-		downloadsTotal.WithLabelValues("lazy_cleanup").Inc()
-		return ErrFileDeleted
-	}
-
-	// Проверяем допустимые статусы: 200 (полный файл) или 206 (частичный контент)
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
-		downloadsTotal.WithLabelValues("se_error").Inc()
-		return fmt.Errorf("SE вернул неожиданный статус %d для файла %s", resp.StatusCode, fileID)
-	}
-
-	// 5. Streaming copy: проброс заголовков и тела ответа
+	// 6. Streaming copy: проброс заголовков и тела ответа
 	ds.copyHeaders(w, resp)
 	w.WriteHeader(resp.StatusCode)
 
@@ -182,6 +208,53 @@ func (ds *DownloadService) Download(ctx context.Context, w http.ResponseWriter,
 	return nil
 }
 
+// fetchFromSE запрашивает файл у одного SE.
+// При успехе (200/206) возвращает ответ, который вызывающий обязан закрыть.
+// Иначе возвращает nil, исход попытки (outcome*) и ошибку.
+func (ds *DownloadService) fetchFromSE(ctx context.Context, seID, fileID, rangeHeader string) (*http.Response, string, error) {
+	seInfo, err := ds.adminClient.GetStorageElement(ctx, seID)
+	if err != nil {
+		return nil, outcomeAMError, fmt.Errorf("получение информации о SE %s: %w", seID, err)
+	}
+
+	ds.logger.Debug("SE URL получен",
+		slog.String("file_id", fileID),
+		slog.String("se_id", seID),
+		slog.String("se_url", seInfo.URL),
+	)
+
+	resp, err := ds.seClient.Download(ctx, seInfo.URL, fileID, rangeHeader)
+	if err != nil {
+		return nil, outcomeSEError, fmt.Errorf("скачивание файла %s из SE: %w", fileID, err)
+	}
+
+	// Допустимые статусы: 200 (полный файл) или 206 (частичный контент)
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
+		return resp, "", nil
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		ds.logger.Warn("Файл не найден на SE",
+			slog.String("file_id", fileID),
+			slog.String("se_id", seID),
+			slog.String("se_url", seInfo.URL),
+		)
+		return nil, outcomeNotFound, ErrFileDeleted
+	}
+	return nil, outcomeSEError, fmt.Errorf("SE вернул неожиданный статус %d для файла %s", resp.StatusCode, fileID)
+}
+
+// allNotFound возвращает true, если все SE ответили 404.
+func allNotFound(outcomes []string) bool {
+	for _, o := range outcomes {
+		if o != outcomeNotFound {
+			return false
+		}
+	}
+	return len(outcomes) > 0
+}
+
 // getFileRecord получает FileRecord из кэша или БД.
 func (ds *DownloadService) getFileRecord(ctx context.Context, fileID string) (*model.FileRecord, error) {
 	// Проверяем кэш
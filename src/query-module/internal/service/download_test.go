@@ -46,6 +46,28 @@ func newMockAMServer(seURL string) *httptest.Server {
 	}))
 }
 
+// newMockAMServerMulti создаёт mock Admin Module, возвращающий URL SE по его ID.
+func newMockAMServerMulti(seURLs map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/auth/token" && r.Method == http.MethodPost:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"test-token","expires_in":3600,"token_type":"bearer"}`))
+		case strings.HasPrefix(r.URL.Path, "/api/v1/storage-elements/"):
+			seID := strings.TrimPrefix(r.URL.Path, "/api/v1/storage-elements/")
+			seURL, ok := seURLs[seID]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"` + seID + `","name":"` + seID + `","url":"` + seURL + `","mode":"rw","status":"online"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
 // newTestDownloadService создаёт DownloadService для тестов с mock-серверами.
 func newTestDownloadService(
 	t *testing.T,
@@ -341,6 +363,112 @@ func TestDownloadService_SEError(t *testing.T) {
 	// Тоже допустимо — SE вернул ошибку
 }
 
+// TestDownloadService_ReplicaFailover проверяет скачивание с реплики,
+// когда основной SE недоступен.
+func TestDownloadService_ReplicaFailover(t *testing.T) {
+	primarySrv := newMockSEServer(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer primarySrv.Close()
+
+	lostSrv := newMockSEServer(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer lostSrv.Close()
+
+	replicaSrv := newMockSEServer(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("replica content"))
+	})
+	defer replicaSrv.Close()
+
+	amSrv := newMockAMServerMulti(map[string]string{
+		"se-primary": primarySrv.URL,
+		"se-lost":    lostSrv.URL,
+		"se-replica": replicaSrv.URL,
+	})
+	defer amSrv.Close()
+
+	repo := &mockFileRepo{
+		getByIDFn: func(_ context.Context, _ string) (*model.FileRecord, error) {
+			return &model.FileRecord{
+				FileID:           "file-1",
+				StorageElementID: "se-primary",
+				Status:           "active",
+			}, nil
+		},
+		replicasFn: func(_ context.Context, _ string) ([]string, error) {
+			return []string{"se-unknown", "se-lost", "se-replica"}, nil
+		},
+		markDeletedFn: func(_ context.Context, _ string) error {
+			t.Error("MarkDeleted не должен вызываться при наличии реплики")
+			return nil
+		},
+	}
+
+	svc := newTestDownloadService(t, repo, amSrv, primarySrv)
+
+	rec := httptest.NewRecorder()
+	if err := svc.Download(context.Background(), rec, "file-1", ""); err != nil {
+		t.Fatalf("Download вернул ошибку: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("статус = %d, ожидался 200", rec.Code)
+	}
+	if body, _ := io.ReadAll(rec.Body); string(body) != "replica content" {
+		t.Errorf("тело = %q, ожидалось содержимое реплики", body)
+	}
+}
+
+// TestDownloadService_ReplicaFailover_NoCleanupOnError проверяет, что lazy cleanup
+// не выполняется, если основной SE недоступен, а реплика вернула 404.
+func TestDownloadService_ReplicaFailover_NoCleanupOnError(t *testing.T) {
+	primarySrv := newMockSEServer(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer primarySrv.Close()
+
+	replicaSrv := newMockSEServer(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer replicaSrv.Close()
+
+	amSrv := newMockAMServerMulti(map[string]string{
+		"se-primary": primarySrv.URL,
+		"se-replica": replicaSrv.URL,
+	})
+	defer amSrv.Close()
+
+	repo := &mockFileRepo{
+		getByIDFn: func(_ context.Context, _ string) (*model.FileRecord, error) {
+			return &model.FileRecord{
+				FileID:           "file-1",
+				StorageElementID: "se-primary",
+				Status:           "active",
+			}, nil
+		},
+		replicasFn: func(_ context.Context, _ string) ([]string, error) {
+			return []string{"se-replica"}, nil
+		},
+		markDeletedFn: func(_ context.Context, _ string) error {
+			t.Error("MarkDeleted не должен вызываться, пока основной SE не ответил 404")
+			return nil
+		},
+	}
+
+	svc := newTestDownloadService(t, repo, amSrv, primarySrv)
+
+	rec := httptest.NewRecorder()
+	err := svc.Download(context.Background(), rec, "file-1", "")
+	if err == nil {
+		t.Fatal("ожидалась ошибка")
+	}
+	if errors.Is(err, ErrFileDeleted) {
+		t.Errorf("ошибка = %v, не ожидалась ErrFileDeleted", err)
+	}
+}
+
 // TestDownloadService_CacheInvalidation проверяет инвалидацию кэша при lazy cleanup.
 func TestDownloadService_CacheInvalidation(t *testing.T) {
 	getByIDCount := 0
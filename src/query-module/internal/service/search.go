@@ -121,3 +121,17 @@ func (s *SearchService) GetFileMetadata(ctx context.Context, fileID string) (*mo
 
 	return record, nil
 }
+
+// CanRead проверяет доступ субъекта к коллекции файла: файлы вне коллекций
+// доступны всем, файлы коллекции — её участникам с правом read.
+// subject nil — доступны все коллекции.
+func (s *SearchService) CanRead(ctx context.Context, record *model.FileRecord, subject *model.CollectionSubject) (bool, error) {
+	if subject == nil || record.CollectionID == nil {
+		return true, nil
+	}
+	ok, err := s.fileRepo.CanReadCollection(ctx, *record.CollectionID, *subject)
+	if err != nil {
+		return false, fmt.Errorf("проверка доступа к коллекции: %w", err)
+	}
+	return ok, nil
+}
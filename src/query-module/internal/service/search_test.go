@@ -18,6 +18,8 @@ type mockFileRepo struct {
 	getByIDFn     func(ctx context.Context, fileID string) (*model.FileRecord, error)
 	searchFn      func(ctx context.Context, params repository.SearchParams) ([]*model.FileRecord, int, error)
 	markDeletedFn func(ctx context.Context, fileID string) error
+	replicasFn    func(ctx context.Context, fileID string) ([]string, error)
+	collectionFn  func(ctx context.Context, collectionID string, subject model.CollectionSubject) (bool, error)
 }
 
 func (m *mockFileRepo) GetByID(ctx context.Context, fileID string) (*model.FileRecord, error) {
@@ -41,6 +43,20 @@ func (m *mockFileRepo) MarkDeleted(ctx context.Context, fileID string) error {
 	return nil
 }
 
+func (m *mockFileRepo) ReplicaLocations(ctx context.Context, fileID string) ([]string, error) {
+	if m.replicasFn != nil {
+		return m.replicasFn(ctx, fileID)
+	}
+	return nil, nil
+}
+
+func (m *mockFileRepo) CanReadCollection(ctx context.Context, collectionID string, subject model.CollectionSubject) (bool, error) {
+	if m.collectionFn != nil {
+		return m.collectionFn(ctx, collectionID, subject)
+	}
+	return false, nil
+}
+
 // --- Тесты SearchService ---
 
 // TestSearchService_Search проверяет выполнение поиска через repository.
@@ -167,3 +183,58 @@ func TestSearchService_GetFileMetadata_NotFound(t *testing.T) {
 		t.Errorf("ошибка = %v, ожидалась ErrNotFound", err)
 	}
 }
+
+// TestSearchService_CanRead проверяет доступ к коллекции файла: файлы вне
+// коллекций и субъект без ограничений — без запроса к БД, иначе членство.
+func TestSearchService_CanRead(t *testing.T) {
+	collectionID := "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+	var checked []model.CollectionSubject
+	repo := &mockFileRepo{
+		collectionFn: func(_ context.Context, id string, subject model.CollectionSubject) (bool, error) {
+			if id != collectionID {
+				t.Errorf("collectionID = %q, ожидался %q", id, collectionID)
+			}
+			checked = append(checked, subject)
+			return subject.ID == "alice", nil
+		},
+	}
+	svc := NewSearchService(repo, NewCacheService(100, 5*time.Minute), slog.Default())
+
+	shared := &model.FileRecord{FileID: "file-1"}
+	inCollection := &model.FileRecord{FileID: "file-2", CollectionID: &collectionID}
+	alice := &model.CollectionSubject{Type: "user", ID: "alice"}
+	bob := &model.CollectionSubject{Type: "user", ID: "bob"}
+
+	tests := []struct {
+		name    string
+		record  *model.FileRecord
+		subject *model.CollectionSubject
+		want    bool
+	}{
+		{"файл вне коллекций", shared, bob, true},
+		{"без ограничений", inCollection, nil, true},
+		{"участник коллекции", inCollection, alice, true},
+		{"не участник", inCollection, bob, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.CanRead(context.Background(), tt.record, tt.subject)
+			if err != nil {
+				t.Fatalf("CanRead ошибка: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CanRead = %v, ожидалось %v", got, tt.want)
+			}
+		})
+	}
+	if len(checked) != 2 {
+		t.Errorf("проверок членства = %d, ожидалось 2", len(checked))
+	}
+
+	repo.collectionFn = func(_ context.Context, _ string, _ model.CollectionSubject) (bool, error) {
+		return false, errors.New("db down")
+	}
+	if _, err := svc.CanRead(context.Background(), inCollection, alice); err == nil {
+		t.Error("ожидалась ошибка проверки членства")
+	}
+}
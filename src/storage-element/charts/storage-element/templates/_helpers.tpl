@@ -94,6 +94,14 @@ app.kubernetes.io/instance: {{ .Values.elementId }}
   value: {{ .Values.gcInterval | quote }}
 - name: SE_RECONCILE_INTERVAL
   value: {{ .Values.reconcileInterval | quote }}
+- name: SE_DISK_CHECK_INTERVAL
+  value: {{ .Values.diskCheckInterval | quote }}
+- name: SE_QUOTA_REFRESH_INTERVAL
+  value: {{ .Values.quotaRefreshInterval | quote }}
+- name: SE_ARCHIVE_BUNDLE_SIZE
+  value: {{ .Values.archiveBundleSize | quote }}
+- name: SE_RESTORE_TTL
+  value: {{ .Values.restoreTtl | quote }}
 - name: SE_JWKS_URL
   value: {{ .Values.jwksUrl | quote }}
 {{- if .Values.caCertPath }}
@@ -17,6 +17,7 @@ import (
 	"github.com/bigkaa/goartstore/storage-element/internal/service"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/quota"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/wal"
 )
 
@@ -110,16 +111,20 @@ func main() {
 		}
 	}
 
-	// 4. Файловое хранилище
-	store, err := filestore.New(cfg.DataDir)
+	// 4. Файловое хранилище (одна или несколько директорий данных)
+	store, err := filestore.NewMultiDir(cfg.DataDirs, filestore.PlacementPolicy(cfg.PlacementPolicy))
 	if err != nil {
 		logger.Error("Ошибка инициализации FileStore", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
+	logger.Info("Директории данных",
+		slog.Any("data_dirs", cfg.DataDirs),
+		slog.String("placement_policy", cfg.PlacementPolicy),
+	)
 
 	// 5. In-memory индекс метаданных
 	idx := index.New(logger)
-	if err = idx.BuildFromDir(cfg.DataDir); err != nil {
+	if err = idx.BuildFromDirs(cfg.DataDirs); err != nil {
 		logger.Error("Ошибка построения индекса", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
@@ -127,15 +132,33 @@ func main() {
 	// Обновляем Prometheus метрики файлов
 	updateFileMetrics(idx)
 
+	// 5.1. Квоты загрузки по субъектам (отсутствие файла — квот нет)
+	quotas := quota.New(cfg.QuotaFile, logger)
+	if err = quotas.Load(); err != nil {
+		logger.Error("Ошибка загрузки квот", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
 	// 6. Сервисы
-	uploadSvc := service.NewUploadService(cfg, walEngine, store, idx, sm, logger)
-	downloadSvc := service.NewDownloadService(store, idx, sm, logger)
+	uploadSvc := service.NewUploadService(cfg, walEngine, store, idx, sm, quotas, logger)
+	coldSvc := service.NewColdService(cfg, store, idx, sm, logger)
+	downloadSvc := service.NewDownloadService(store, idx, sm, coldSvc, logger)
+	archiveSvc := service.NewArchiveService(cfg, walEngine, store, idx, sm, logger)
 
 	ctx := context.Background()
 
 	// 7. Фоновые процессы и leader election
 	gcSvc := service.NewGCService(store, idx, cfg.GCInterval, logger)
-	reconcileSvc := service.NewReconcileService(store, idx, cfg.DataDir, cfg.ReconcileInterval, logger)
+	reconcileSvc := service.NewReconcileService(store, idx, cfg.ReconcileInterval, logger)
+
+	// Проверка дисков — на каждом экземпляре (leader и follower)
+	diskSvc := service.NewDiskService(store, cfg.DiskCheckInterval, logger)
+	diskSvc.Start(ctx)
+
+	// Квоты — на каждом экземпляре (файл квот и метрики usage)
+	quotaSvc := service.NewQuotaService(quotas, idx, cfg.QuotaRefreshInterval, logger)
+	quotaSvc.RunOnce()
+	quotaSvc.Start(ctx)
 
 	// RoleProvider и proxy middleware — зависят от replica mode
 	var roleProvider handlers.RoleProvider
@@ -149,7 +172,7 @@ func main() {
 			cfg.DataDir,
 			cfg.Port,
 			cfg.ElectionRetryInterval,
-			// onBecomeLeader: запустить GC и Reconcile
+			// onBecomeLeader: запустить GC, Reconcile и холодный архив
 			func() {
 				logger.Info("Стал leader — запуск GC и Reconcile")
 				gcSvc.Start(ctx)
@@ -158,12 +181,15 @@ func main() {
 				if loadedMode, loadErr := replica.LoadMode(modeFilePath); loadErr == nil {
 					sm.ForceMode(loadedMode)
 				}
+				// После загрузки mode — в режиме ar возобновляется упаковка
+				coldSvc.Start(ctx)
 			},
-			// onBecomeFollower: остановить GC и Reconcile, запустить refresh
+			// onBecomeFollower: остановить GC, Reconcile и холодный архив, запустить refresh
 			func() {
 				logger.Info("Стал follower — остановка GC и Reconcile")
 				gcSvc.Stop()
 				reconcileSvc.Stop()
+				coldSvc.Stop()
 			},
 			logger,
 		)
@@ -176,7 +202,7 @@ func main() {
 		// Если follower — запустить FollowerRefreshService
 		if !election.IsLeader() {
 			refreshSvc = replica.NewFollowerRefreshService(
-				idx, sm, cfg.DataDir, modeFilePath,
+				idx, sm, cfg.DataDirs, modeFilePath,
 				cfg.IndexRefreshInterval, logger,
 			)
 			refreshSvc.Start(ctx)
@@ -185,9 +211,10 @@ func main() {
 		roleProvider = &roleProviderAdapter{election: election}
 		proxyMiddleware = replica.NewLeaderProxy(election, cfg.TLSSkipVerify, logger)
 	} else {
-		// --- Standalone mode: GC/Reconcile стартуют безусловно ---
+		// --- Standalone mode: GC/Reconcile/холодный архив стартуют безусловно ---
 		gcSvc.Start(ctx)
 		reconcileSvc.Start(ctx)
+		coldSvc.Start(ctx)
 		roleProvider = &standaloneRoleAdapter{}
 	}
 
@@ -249,10 +276,14 @@ func main() {
 
 	// 9. Handlers
 	filesHandler := handlers.NewFilesHandler(uploadSvc, downloadSvc, store, idx, sm)
-	systemHandler := handlers.NewSystemHandler(cfg, sm, idx, roleProvider)
-	modeHandler := handlers.NewModeHandler(sm, logger, modePersister)
+	systemHandler := handlers.NewSystemHandler(cfg, sm, idx, store, roleProvider)
+	modeHandler := handlers.NewModeHandler(sm, logger, modePersister, coldSvc)
 	maintenanceHandler := handlers.NewMaintenanceHandler(reconcileSvc)
-	healthHandler := handlers.NewHealthHandlerFull(cfg.DataDir, cfg.WALDir, idx, roleProvider)
+	archiveHandler := handlers.NewArchiveHandler(archiveSvc, cfg.StorageID, logger)
+	diskHandler := handlers.NewDiskHandler(diskSvc)
+	quotaHandler := handlers.NewQuotaHandler(quotaSvc)
+	coldHandler := handlers.NewColdHandler(coldSvc, logger)
+	healthHandler := handlers.NewHealthHandlerFull(store, cfg.WALDir, idx, roleProvider)
 	metricsHandler := server.NewMetricsHandler()
 
 	// Единый API handler
@@ -261,6 +292,10 @@ func main() {
 		systemHandler,
 		modeHandler,
 		maintenanceHandler,
+		archiveHandler,
+		diskHandler,
+		quotaHandler,
+		coldHandler,
 		healthHandler,
 		metricsHandler,
 	)
@@ -310,6 +345,9 @@ func main() {
 
 	gcSvc.Stop()
 	reconcileSvc.Stop()
+	coldSvc.Stop()
+	diskSvc.Stop()
+	quotaSvc.Stop()
 	if dephealthSvc != nil {
 		dephealthSvc.Stop()
 	}
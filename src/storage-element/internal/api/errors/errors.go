@@ -20,7 +20,10 @@ const (
 	CodeInvalidRange         = "INVALID_RANGE"
 	CodeFileTooLarge         = "FILE_TOO_LARGE"
 	CodeStorageFull          = "STORAGE_FULL"
+	CodeQuotaExceeded        = "QUOTA_EXCEEDED"
 	CodeReconcileInProgress  = "RECONCILE_IN_PROGRESS"
+	CodeDrainInProgress      = "DRAIN_IN_PROGRESS"
+	CodeRestoreRequired      = "RESTORE_REQUIRED"
 	CodeInternalError        = "INTERNAL_ERROR"
 )
 
@@ -105,6 +108,11 @@ func ReconcileInProgress(w http.ResponseWriter, message string) {
 	WriteError(w, http.StatusConflict, CodeReconcileInProgress, message)
 }
 
+// DrainInProgress — 409 перенос файлов с диска уже выполняется.
+func DrainInProgress(w http.ResponseWriter, message string) {
+	WriteError(w, http.StatusConflict, CodeDrainInProgress, message)
+}
+
 // InternalError — 500 внутренняя ошибка.
 func InternalError(w http.ResponseWriter, message string) {
 	WriteError(w, http.StatusInternalServerError, CodeInternalError, message)
@@ -21,6 +21,9 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Состояние холодного архива
+	// (GET /api/v1/archive)
+	GetArchiveStatus(w http.ResponseWriter, r *http.Request)
 	// Список файлов
 	// (GET /api/v1/files)
 	ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams)
@@ -39,15 +42,30 @@ type ServerInterface interface {
 	// Скачивание файла
 	// (GET /api/v1/files/{file_id}/download)
 	DownloadFile(w http.ResponseWriter, r *http.Request, fileId FileId, params DownloadFileParams)
+	// Восстановление файла из холодного архива
+	// (POST /api/v1/files/{file_id}/restore)
+	RestoreFile(w http.ResponseWriter, r *http.Request, fileId FileId)
 	// Информация о Storage Element
 	// (GET /api/v1/info)
 	GetStorageInfo(w http.ResponseWriter, r *http.Request)
+	// Перенос файлов с диска
+	// (POST /api/v1/maintenance/drain)
+	DrainDisk(w http.ResponseWriter, r *http.Request)
+	// Экспорт архива SE
+	// (GET /api/v1/maintenance/export)
+	ExportArchive(w http.ResponseWriter, r *http.Request, params ExportArchiveParams)
+	// Импорт архива SE
+	// (POST /api/v1/maintenance/import)
+	ImportArchive(w http.ResponseWriter, r *http.Request)
 	// Ручная сверка (reconciliation)
 	// (POST /api/v1/maintenance/reconcile)
 	Reconcile(w http.ResponseWriter, r *http.Request)
 	// Смена режима работы
 	// (POST /api/v1/mode/transition)
 	TransitionMode(w http.ResponseWriter, r *http.Request)
+	// Использование квот по субъектам
+	// (GET /api/v1/quotas)
+	GetQuotaUsage(w http.ResponseWriter, r *http.Request)
 	// Liveness probe
 	// (GET /health/live)
 	HealthLive(w http.ResponseWriter, r *http.Request)
@@ -63,6 +81,12 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// Состояние холодного архива
+// (GET /api/v1/archive)
+func (_ Unimplemented) GetArchiveStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Список файлов
 // (GET /api/v1/files)
 func (_ Unimplemented) ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams) {
@@ -99,12 +123,36 @@ func (_ Unimplemented) DownloadFile(w http.ResponseWriter, r *http.Request, file
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Восстановление файла из холодного архива
+// (POST /api/v1/files/{file_id}/restore)
+func (_ Unimplemented) RestoreFile(w http.ResponseWriter, r *http.Request, fileId FileId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Информация о Storage Element
 // (GET /api/v1/info)
 func (_ Unimplemented) GetStorageInfo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Перенос файлов с диска
+// (POST /api/v1/maintenance/drain)
+func (_ Unimplemented) DrainDisk(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Экспорт архива SE
+// (GET /api/v1/maintenance/export)
+func (_ Unimplemented) ExportArchive(w http.ResponseWriter, r *http.Request, params ExportArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Импорт архива SE
+// (POST /api/v1/maintenance/import)
+func (_ Unimplemented) ImportArchive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Ручная сверка (reconciliation)
 // (POST /api/v1/maintenance/reconcile)
 func (_ Unimplemented) Reconcile(w http.ResponseWriter, r *http.Request) {
@@ -117,6 +165,12 @@ func (_ Unimplemented) TransitionMode(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Использование квот по субъектам
+// (GET /api/v1/quotas)
+func (_ Unimplemented) GetQuotaUsage(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Liveness probe
 // (GET /health/live)
 func (_ Unimplemented) HealthLive(w http.ResponseWriter, r *http.Request) {
@@ -144,6 +198,26 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetArchiveStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetArchiveStatus(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArchiveStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListFiles operation middleware
 func (siw *ServerInterfaceWrapper) ListFiles(w http.ResponseWriter, r *http.Request) {
 
@@ -182,6 +256,14 @@ func (siw *ServerInterfaceWrapper) ListFiles(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "updated_since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_since", r.URL.Query(), &params.UpdatedSince)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updated_since", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListFiles(w, r, params)
 	}))
@@ -361,6 +443,37 @@ func (siw *ServerInterfaceWrapper) DownloadFile(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
+// RestoreFile operation middleware
+func (siw *ServerInterfaceWrapper) RestoreFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "file_id" -------------
+	var fileId FileId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file_id", chi.URLParam(r, "file_id"), &fileId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"files:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreFile(w, r, fileId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetStorageInfo operation middleware
 func (siw *ServerInterfaceWrapper) GetStorageInfo(w http.ResponseWriter, r *http.Request) {
 
@@ -375,6 +488,95 @@ func (siw *ServerInterfaceWrapper) GetStorageInfo(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// DrainDisk operation middleware
+func (siw *ServerInterfaceWrapper) DrainDisk(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DrainDisk(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportArchive operation middleware
+func (siw *ServerInterfaceWrapper) ExportArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportArchiveParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "uploaded_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "uploaded_after", r.URL.Query(), &params.UploadedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploaded_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "uploaded_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "uploaded_before", r.URL.Query(), &params.UploadedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploaded_before", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportArchive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportArchive operation middleware
+func (siw *ServerInterfaceWrapper) ImportArchive(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportArchive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // Reconcile operation middleware
 func (siw *ServerInterfaceWrapper) Reconcile(w http.ResponseWriter, r *http.Request) {
 
@@ -415,6 +617,26 @@ func (siw *ServerInterfaceWrapper) TransitionMode(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// GetQuotaUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetQuotaUsage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"storage:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuotaUsage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // HealthLive operation middleware
 func (siw *ServerInterfaceWrapper) HealthLive(w http.ResponseWriter, r *http.Request) {
 
@@ -570,6 +792,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/archive", wrapper.GetArchiveStatus)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/files", wrapper.ListFiles)
 	})
@@ -588,15 +813,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/files/{file_id}/download", wrapper.DownloadFile)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/files/{file_id}/restore", wrapper.RestoreFile)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/info", wrapper.GetStorageInfo)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/maintenance/drain", wrapper.DrainDisk)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/maintenance/export", wrapper.ExportArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/maintenance/import", wrapper.ImportArchive)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/maintenance/reconcile", wrapper.Reconcile)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/mode/transition", wrapper.TransitionMode)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/quotas", wrapper.GetQuotaUsage)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health/live", wrapper.HealthLive)
 	})
@@ -613,155 +853,235 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+R97XLbRrbgq3QhWxXSC1HUl+NwKz80tpxoVrZ8JXlTe8OUCJEtCQkJcABQjsZRlT7i",
-	"2Lnyja6z2ZqpuzvOZGZq76+tomTRomWJfoXGK8yT3OrT3UA30CApW/ZkavIjFkmgcfqcPt8fuG9U3UbT",
-	"dbAT+EbpvtG0PKuBA+zBp5t2Hc/W6F817Fc9uxnYrmOUDPJnck665JS0ycvwMTkP98kLRLrkmHTIebhL",
-	"uuE37Odwl/TCbRR+Q9rkBXlJ2ih39+7sDbQxmTdMw6ZrNa1g3TANx2pgo2Ss2nW8bNcM0/Dwb1q2h2tG",
-	"KfBa2DT86jpuWBQW/JXVaNbp1VNTRXxtslgcweMfroxMjtUmR6wPxq6OTE5evTo1NTlZLBaLhmmsul7D",
-	"CoyS0WrB0sFmk97tB57trBlbW1v0aX7TdXzMtu16K3athh36oeo6AXYC+qfVbNbtqkWRMPqF7zoKMPcN",
-	"7Hmux26p0fVvzi/8avbGjZnbhmk0sO9ba/Rb8gfSIcekF+6EuxxBD8k56SHyKtwmbXKEyDF5GR4gchTu",
-	"k1ekR16Sc4pX0qVf9sgr0qEXht+SLukaFPgYNf/Fw6tGyXhvNKbqKPvVH52h4C3wfbJdJ6g6ELKcX3Wb",
-	"mFL6JemicJsCFz5GFD4EoJ6QI/jugHTC3QSw4fd5Y8s0Zp0Ae45Vn4mx9boInr29NLNwe3pueWZhYX5B",
-	"xfIP5DzcC3fDbUDdeXgAyAsfkS45pCcThTsA2hED8FLxeOFnm8ZtN7jptpzamyHk9vzS8s35u7dvqLj4",
-	"C+M+Tqdz+ASseqm77vMU07jrWK1g3fXs3+I33OPd29N3lz6ZX5j955nENv8ECD8M9+jhC3co0tuUDimh",
-	"RA/jwaXu/Sk8cA/+v0uOGAiCT4B9j6isBBHJpOWvP11CgfsldiQ4QPhct5pW1Q42Z51VVyN5f0/Ow2+o",
-	"UCVnYieUzw5R+ISckVPOvV0UPgCePWcwhN+RtmEaTc9tYi+wmZizNiy7bq3U8fLKZsC+SjzsR7ZcuEde",
-	"CSHfI4fhv9BnISqpDimVQVo8MMyYalMTV699UPxwbLxYlKSv7QRXJ2PxazsBXsMePR2BG1j1TCieksPw",
-	"O9JVn57eXz+AxoofTHwwOXZtfHJIiFo+rmUC9Dv64PAg3H1rKNmS9d9nCn4U2MwUET+PFnNXvsDVgG5G",
-	"Pb/p/fxMwSXn5Ji0w+1wl5NaOma7svjqohzoia44EpG62iEdet8O6cIh7JCzcB9Ne4HvejifOn4RY6tf",
-	"MzZPwfh/SRsgOAet1AWI4QEUglPSI8cKjAVEfgB9dEZ65DlA2kHkhIql8KHQpqWyM4Iq/2N6bvbG9NLs",
-	"/G2mRyror9s/Mqal7LQNUuWUo6VD9/mAPk58PAbUwQdYLpLBbB16N4iG7XAnLRrhDlmi8Zt2LyTKYJXI",
-	"2pDg76fM4aZb8zdmlinE03Nz85+Kxyf09oGyGBMGjN0AoNNwL/yO0gL2Sp6TLjnjuJi9DchdXlqYvr04",
-	"S1GsAkdehXtMXglKsud2GIoRXYg8J8fhnrx2m5yRLqx/ff72zdmFW4x4CzP/dHd2QdrDIexAHOfE0iqK",
-	"mfVyTGU3XPWckSdCrtjIwvTtj2f6HpAXaMFy1jBax1YNe4wys3Mzy0vz88tz0wvibmERM3J0qK0XPqJH",
-	"msLyErbZDXfh9sWl+YXpj2eWb96dm4sfHe5SRqPW1iE7jaRHnlHynsF52yVtuHlh5vr87esUgNnby3cW",
-	"5j9emFlc5CDs8M2CRbJHnrPDHdmczIijh4+jQDa2OCBHAyydsiPLQMU+SRjhkiZP8f5/kA55SXoUXMC4",
-	"xP+kR6Gm57Ub7nB90FEkgQJAHyMl7RaoMhjkUgxlWs4mrmfiTSeOqVc1Z/tBH4n8E2mTZyDtumBlH0VC",
-	"BuQr222PnMaeVY8cpSTsuuUvN1xP94D/Daz8GDEDpRN+Fz6h4rHNl+4CQ4Q75CXpqFxOnw8EB1yH34b7",
-	"MoKZq8Z3vOK6dWw5dMt2gBsAUvRHP3uLIugWDqyaFVhgHrD1LM+zNunnut2wgwy9TNmJUr8T4StmJ9Ui",
-	"KOr0vru66mPd2n+ScNChNKB89h0TEqQjr1zMtHAybRu6JCgxaso85Ax8RHoKeVEu3EHhXvgwfELFOZW3",
-	"VAu8DB8DPXrkKK9scKo40K5g1BDQCcRGWDDjE5R1jiMy6TR2B7hUUpBKIABOcI+LXNVwhp+OuSTuApef",
-	"hXvICgKvQF2EtDFRXcfVL/1WIw3H4ifTI+NTV6nB+K/hI93Sz2REtxV5YY2tjFcnapN4avXqB9c+LFor",
-	"1RpeHRufmJwa6rNOznHXZ5n9kAT31uytmRHQiK+ygLIb1hoe/aKJ13TLK8tpzpsqKWWCgD7+lnRJDwQj",
-	"RHZIL68K0D+QNijWHhdAcDafAR275BVpo41xHVT4q6btYX/ZCrRuBlXTbUTFzhHokjOqR7gVGdtrVPTQ",
-	"gw4KS9j/4rfc7OI8una1OGaiu0vX84WyQ/5I2uFOuMP0RbgPUjSyp05Jm5yiSqtZd60ari1bAfqvKAjq",
-	"yzVr068Uyk7FadXrFWHeNrHXsKiYUniykFBw48XxqyPFiZHxiaWxqdJEsVQs/rMcgKpZAR4J7AbVJHR5",
-	"argnpGaMMxELu8TwmwLs5UTPTMP17DXbserLFGAWx0ufu3CbdLlKi44WlXpdoLV0DEGCd5kyehZuh3vk",
-	"hJyqEtZorruBu1y3nJpftZq48EVTywoepoxmu85y063b1U2tomVCd5chLHWsmIsQ4EbT9SxvU1hOaGlp",
-	"zkTUjGaiGFDNRTe4SOEeyL2XCSMqOkXcfjokHXLS71iz8+VQwfaZEYFBxZ9YiYpmCTPR1xp8+PZvdbT5",
-	"I2mDo9RRI7X9nNnxyfFr14bzpP3AClp+ltdJ/Qmq1qLHMoRb1cDewBxJsuPRIeeRw6mGQl/AjUzO1Nid",
-	"S0tzXIqET8gpCLjnwCbczmaWJBUylGQfX8/DEjVcx4FYAozhMyGEwGKMaCuU/1ALS2RkmzOEUKQ8xZ+p",
-	"0jK6LkXIwFrzM2yUZ/TwXUyof2bU3TWXQuFZq/TgbIxTQCJTLf34hD0mpKaWyNvMTk2Kaxbnph7DA5QD",
-	"JqKgnVIPhpG3kuTej2IuzL+eeJ4omkbD+spuUDpMXJ0yjYbtsE9jmfJYDgrFumJ4LabKsW5ST2nUx/jI",
-	"+Nhg9ZGiSgTdyqY2bpilHZi/Fz4mJ8zRAHMfGIyH+UWwmtoNkZvZJqewxh6s2TbLjrLTLjkCI1y2rVCO",
-	"dMkJRD6rdctuoIrfWqnkEyrUt5ZtZw37AfaWrZXq2PjEQNcsThqlVVHC3uJC0IwNRhVxKpEj6aXRJYMs",
-	"4rtNSq0slUPRG4mxQ0DrkZAo9MuzpPEcPpDYuoDID5QsgnZMGCbZHCzuv27/WHbkZ4QH4ffcBlLZjsdH",
-	"FHtdrM8YqWE7dySreyxphPc3Pf8Az9f66xkGCvmLYi+0QWxQKCHEeJCyPQ1g7znsrAXr4OEVh5edAJ3G",
-	"v95lh5i6X1Eyjqmjx+H3jLvPgLEOuOfCfEThzoTfs9C1XuCu0rN6EVm7pTl0n2CrHqxfp+dZq9w75CTc",
-	"Y26iCOXugjAQVtgLZnP1onAQWF/HEP2lSHkcHqT8rexgzY9AXYaoLpclEfW6muxFTlh8DIhDYAP684OE",
-	"6/EjBENYqI0uAR5BSq+kwpSczaTghtY2iswUoabdL0Exr3lUGlBiWXZdVdBwRX/RxJf9PJNuc/YG7hMI",
-	"eir8Y1S3N7CDfR81PXcFpwjiY2/DrmI1P+4Hrmet4RFcx40sm1C374G7NA2qhfzAajTVJ76RBtvAnm8n",
-	"EoDGWKFYKA6LZxmueD0zwk42HRawVdscihAetmp2H0owzTIMK4b7CWYEMa8wY4+cph5AdZu/6Qe4MSiQ",
-	"JouGLdO4x0JQQ9+hUbP8uWwtHTbf/ByqWBtBFffLCsqNF4t5HnPmmi8pstgXPJwb7nNbnrGvvAAIjENA",
-	"fJvLbKqRnkUxzSjeEOUZKOtXUG6qOJGPAvCIa54eOVKs+9cTG3+vDCUMKe1ZuOXW8JJnOb5NibmAf9PC",
-	"fv+47Q73sHa4UlXzPhLpIOycTBw6q7bHI4CrVqseGKVVq+5jU2eBaXI9zDKJskbC0lXyRmB7PSWH4QFV",
-	"KJJ668X2HLh9bZaCqXhuBf312x9QxbtXKSDyBBz+Coe1hKirUZHuQWAinYAD0Q6/4yd0svhh2aHIgUQn",
-	"xH4zcl8Jj4fvPx2QDyxvDQfLDX2y9f9BwqUDsLyQSFBATL0rWbtOAkXhPvPhvXti524F5cJHPA8J0Srm",
-	"aUe4sbxBV9DFcjGazGT+LoHRfNmB+B+HG1VwzQ4q1P444QGfKKsi3HxlB4gcjYKzIm4859iQEsoFhe29",
-	"e9RJoEad5ansDl/2ZzaZGsMwUqaWSlt7ESvtK6yU5p6W51EvKeNExOmPrnIimFXME0VJVslL+KF4pBi6",
-	"EJookHjDdlt+Flw/MZcl3CfHGugu9Px7WqkcYT3L7f8hdvUT2x/a1b9aKl5EricOj4oiU6Vkege687WA",
-	"q65Ttet41vdbWdbPsWrKJ6x1UziybYgAPGcJuPjSrpxv7hgXcx2TWQv14WoKUsrx9vRVWdwr6EKotmPG",
-	"aaWU90avNy4SmZ+9oQbfOsAZXSZ4jvjCHXKev4xA/MAEApTW6nhmj+V+4/RxuJdEC5e24OzKfhzIZgX6",
-	"mhVYoywi3/KxN7ZMj3ZxfHyMh2+yovP6/Bf5E6S+khRmKsX1musWPceUAMlCigT8PLYeERcWaNi+bztr",
-	"0v0x8UUwXoqAq0vCCiJutNyw/YYVVNfjkgyWWzwir3jchh42cTnc69u/xUPeB5YOD8qrW6cADwRdtUhl",
-	"rEEYJ0aCFAmLIONBMvmz8nRVbiZWG6DoWAxOJnlfeXRRVRe7AzJTKzFhZtJINWr0C40xSbcXDCH0T0ib",
-	"B6UeSXnKGJJ+Un+qND4xvDUP/tcykAvrsoL/rikgUNykTjqUyMpGpKKB8Qld5NumeiGjNjMp87lJmJLQ",
-	"Q5V+JDSRJtngB5Y3DF1YpV8bGHlYelzMu/JbjYblbQ69pUV+vcbVEjsy1YOXpHlEiPjhfdlnMYYwmZcB",
-	"RjiGnCO14JjRpPATaZOzJOb0RReRpMD6yGpHEXCCSaQIfP/aGVnCaB4giUGlgCkhE3vkKCnQ5SePa6uB",
-	"vhySzdSnsCerLJDgMn3xkSyodajklg31TwZoO+Vx2rSsLOEvRDdZNakB+8EFRxD6SGwzSWBTe67SEOtO",
-	"/iILNF2odh7xm9AMi04JR75m+1V3A3ubkNE7g8xKV4SeyTl5piupr9fde7i2TL+DjgZtTlTKLyST2GYi",
-	"gM0ign0rbVHUiqMKt894KovqW/eew/9ssbSUadRtP1BSD8Jc6HsXS1RLtw9MDld5P8MgMan0PVCuv6Aj",
-	"mogNvaHf52FoSslyO/+oPvaEHIVPwu2oyAgYRQLCDyynZtVdBxvR0ql0v3JRGiC3rgeEtWCF/0pOyQmY",
-	"M68gx7ctSiniZbnFKWr3meyK6yTTS6CcjIaP4pVYcKYOJc58Vdbb0gm36WmNtziinNSciK+RQxb/FiVZ",
-	"kugOH7PVV13gJb6++NR3dSWfGT5krhZ1GNX6CwXRbBOg89kTLkKUzNqWxCndkUpdcuEenI9vSBdEUByI",
-	"CnfQdK1hO+iWW2vVcZ77PE7djmiXzkBK0eweZC5ZYd5h1F3grq5KCyQL+cVVcaD8NUPkDYvKfMdyqvGj",
-	"2iyeukP9S6AS5w16i+yfwA5Z2Sn/S4qfSwsnwujiNg1ZQKBfSu3c4kwBQYD6mLTDJyJx/pDb0ycsJHsO",
-	"Nz0L9+Jex7Ijwh4ZAgL6aFjjJHR2tMEnD3cQT5Is2zUIG4jTsx3Fo+FPlgZt55MViD4eabh+1b03Uhwb",
-	"kBJIdS3GmfXFGWXRYVMGEdq5+JbqJ+LUQaQQTJ261NbV+7ja8uxgc5HqCqZpV7DlYW+6xSIc7NNNYbb/",
-	"+tMlIxn1//WnS2hhcXzqKmu7M1mvw7FUWC8zX6HskJ/lSuFwF0Hzq49y3D5YZCkQNF2tui0n8POiYIaK",
-	"6ugqtuhdH3s+pxUoPAjHA9AxWteDoMm6C21uv1RdJ7Cq4Ojw/mjeTYWWsNVIVfsaSUsG+PAMQtp7TE18",
-	"A5GouFKRVz8ns+iKPatv6CqUnbLz3nuIPOVlQUfC9WMc8S3p8JKULrvyPTQdBJ690grwyE3b84PI8Lrl",
-	"1nC97JB/J23o9uHdZyyuA+JMeLE9yNbEVbwSnGcIWIjaZofAUBT0ypVCZBJXTACN8TV7xgEriIlLNDOU",
-	"45koRmZNVF3YYvQlNMJF6R9N5Q40oymNMqyb8RQq0uUoIZPgrBorbv7KU2HCY8s7kISQhREICSncoda/",
-	"Mcx/Oj2H7nhu4FZdimhePJToJU82gEBGjiVEDqgOiIXep54d4JHpdWzV0Jy7ppQydWAPctU2k6Vgc28L",
-	"3UW6pbJDocJO4G1Cjkc2AeQoHP0t3jaPAMf7pT/Thapuo2EH0Y5jE41lPiLbEOWoaPLzZSfJLSmtR305",
-	"0Vt1xoXxQyDKfrgdPgkfyHZ4jxyZiB/VDg+WH7DyH2rCJ6x6DfpLFPSvJcDR1+gu2OLoa3SD2+LwHbXF",
-	"6Xdgi6Ov0ZztB6O3cGChr8vO1yPsP/Fv/IfuO+nHEXqzSHh9DY2N/f+By7172RezNjX1creS/kX6rLnF",
-	"8ir6SzJvKjvkR6pmEeDxhMs1Hvn6Ftg3qjRm2wVBqUkOCtNYyndFVaiI67u8qUkfavKF6aRolPuExydg",
-	"pY/lsErVrfyZsMenUdJYzZMyiwPgeRQ+YYZlVn8jeJO5ZPa0IMkIZn5IFYWJzsqOZvcnPKHPO6QgV8rY",
-	"ckGUUaI7UEapLa//JgpzXKjSPqprJEe8R60nSfCPr7NAV7KtBDJTov5eV6QvgTNMvT4XP//Wr12XIxc7",
-	"taZrOwG1KU5hSerGVEbXoQhn9ErFRJXRBg48u+pXqJtfGbWa9ujG2Cg1ECp5KQlO9152qI3zK7AqmJGD",
-	"cmDx5AcbO2VnkVk3khojz/Wlv7Ioa6PcrfFb3FWB+E3Jw5ZwIyQXTF/SytKCpxCqjd2DjqLEpKXvUb3D",
-	"2UWtq47zj1IhLY+NJMv1U4tzpsqEPFW1SNVjDy3OKHdLwFHUsC5r+bGyrjiDoJLeO+qAmVi3q5hnPrjt",
-	"d8ezN1gUpuXVub3ol0ZH1+xgvbVSqLqNUcsLWt6X3mbrS3eDfqCGGisuCsCQT6q86Tuzkm0uDP0t03Cb",
-	"2LGatlEyJgrFwoTBkolgeYszGAUp17QNkz9o6lgyW1eZt5hSyDv01EAXLLR9xwWrSucjI0mHvIBzfOWK",
-	"OraCntgjpfYhfFC6ckXUkeWYBjBBdJogSU0QyqyE5GeA73ncQ95jIVelGpjF5naYzUkvNHlMnRUPt8kZ",
-	"/wLstl1+LHcL5LiQ5wXaJ9zDfEja6J9a2NsUrIkWZ1BkUwhhHFmtiRLuQ3A1RSEzqDJuoJJnzPmIPK3Z",
-	"mlEyqOFwkwdd5clPnw2XW4rNxQ59HKvckpuDIcQOU55+Q/cUj3kSjabx/JWoZAsac6MuDVbELbdppCPM",
-	"mgCr0pwLhQCphNArHjtvZ4AY9cBqYJRBKg4F0l/i88rPhhQTCvfUCngdOJEjLc3BGqqfKOmzf54YdjVe",
-	"LA4xkGe4QTmpznbdrJyfs9rXt0xjsjiW9YwI6FFllhDcNDH4pnim15ZpTLE9979DHVQlhyGAQeQABK+N",
-	"BQVifE5RHOUG++xX9CLwu43P6TMU6TrKY/Gl+0bTzSydfMazr1zGKgURKZEaO3GNVj2wm5YXjK66XmOk",
-	"ZgXWhQSoLDhBVv4EDuO3ICF2SmVnrIAghqK6q5HTV3bGCygu1om9v0SjrpzpKjsT2kUVP5/rjWT3OUQq",
-	"JwsxACxSccZmA0CL8A4z4MjvU02piXRbZA50AfcRrMDZj8CNfAlRkL1S2ancj9qSKDNvLd9v+fRkwZ9R",
-	"eS39umXXtgr38VfBVkUjrZkzeJPVWXismvZXbm0zwb4auqoc/CaVV1mdOsO1hQ/uzoEqkoHHnE+LEcDI",
-	"GfsV22ENskM2/vx6cf72CBzqnXCHGmFSy08BkZ/ipqg44MJaxyMf45Q1y0Z4T4ZkPytDv0/ZMFGZ9Viy",
-	"PzfGy8bnQzW36aKiW8kRiVspwT52qYI9nsjRZ/gbVWfgDz5iguNEIhwfBTc5lMLh6GPNRiw/fJOfDUm2",
-	"KuNcwj2gDH3kObVqrXpLO0EuOfBJnSLHOsI76H2K+PeZjZ4q9qY4uOTBi7pRQpEj3Qt3WEGepp5RcIGp",
-	"GzTXEce5m3+H+nWy+OGbDflLzqVSSfQ71fVLeRL6WVVyqvB9z33/cgcAaiZmKYEYMEYHDc0C3I1NvOHY",
-	"UWXclIq5rFb/fkOoUDw8LxoI8HYGR2qg0A0JkwtQXteao3d98GZ4ludyDTfhdcDArnczyzV6nNZAHM7O",
-	"hVBHytDNZMv2MKbufV5UvcV0NJSZ6Jun2TiGpLXLlbKIBj6JIl05310NEFtRydzq4kWyW0baZSeZQ8qD",
-	"yZrMoXXSQSb9JLWs0ORrGN4aA5ElBLiBmPDndacpvmSUT3rWeIeT2XVoqekYWTR4p6pncvAd0bTdt6+r",
-	"/twn+JhsVu4lwjlJpUXp/ktVW2/LpdaLmkys6kSNeYEIpcZv7KiakhKGzbWYvXHZAccUR3+MA8X0vjy2",
-	"Lr4736D/ILhfsmx4p3GiAWjSnesmtGdkVeX3pDhxekRmd1TMd9lNjQ1iPa6JJRQ9+IaRIj61pJKa10Kv",
-	"kge20M++/Vv2PS8LrphlpyINbaG/SVNb4EGJaS1S9VuXmY5MK7MG1oI22FKzmC69HObLitZcDt/xYTND",
-	"RQX+xpyfNLvC/eGDAu/S3/4Htlme6hKp2vRt2tfu/T352u/WaBkara/pOI1GBfuZSdmf++Xa2ci/I1YM",
-	"ywYA74rwphgECIOQ/MDDVsN21vKvrQaY4RMpg2NpJKsoCf9kaekOH6bNxWdcncBKa3qqjoqHuaZKCsKD",
-	"spPzsN9qWCt1jASi/Dwv7n0GjhqrMDxFFXhqCcFE/Y+gPWsEOzWm9KRJMOSInJKX4feRN8rCndFap1DU",
-	"NYIq1xmjjixRjQaqSD/mVbmYRcqlgmxN4CYndPhDCGDvkm5eWeOG7Tdd1v1dQlYQWNV16uX/NyT07Udl",
-	"o1AolA0xNL3vmE5Yerpaxc1gBFDkcxxJ1ekxJem5UckH988sWWvRYEcgJpRBKlVXbCwUKyCPqks7UceY",
-	"zlIWBXJv5P2m8rbSGWQD3QXQMcJZXCXz3BXKDvlL/CKFEqokT1UiW8B+Lo6MFSevTX0wJdLB66JPgeeD",
-	"ASglHfxmiV63GuBghHG2qmoHplY0IvonURshupvVicsJw5btDYBUTpc6t0a88SJ7x6ahOffqIhk8AO3r",
-	"hS+aa2VjwBPo8VWXLCtTogcsQJcYL159Z4T4j8QxzSBHTmHU/ACavAYFhrmFHek0zVHEDKN88OyQNOpP",
-	"hn9Q4+7nAeV2Qxp1lvfLTaBcfdPXikkv+0hnnjRvGDgmXaheYNVX5xD85VNgutwsQEph+L4SAHkHmUTG",
-	"W+++9KWfudnfuBU9MBeI3aWnS36v6e8t9Wn3MrM6Ss2yI6cGTOV1W+FjqOY81o3GShT5MxPy9/o+D6Uo",
-	"9wJtyMIK71NzzPkp9V6hK1fAFBOVyMOWGrKRsuF34R5FsQbvPBlNL+J8QM7YxcrUW3lwBEDZZQR4xsd2",
-	"x70pizMFfXxUbvl+i7EO+TE6xhuut/xyOFBlsiGfHDMbn+GocJvUZTnqifERA+rO4KxDzqejTF8J95L1",
-	"WGo32eBxLIW4nozPVoEjCM5U9hAEE4V7SosXj1CEu1K7UE5MHwDzzwd3ST9Bos9yfSrDcrxYRVo+mqSQ",
-	"eNlJNAddFwPIyS6fKbs+ZYf8W0Y3RFudPdNGsOxpn1c7sfJiuL7L9VXUWlF2csDau7wJoC3K9BLFUJr+",
-	"V9IhL6hv/f8Bd7sa+XIOu4tex1Z2hGO1He5JLhWPyPGDJgRdylhgTTaRC85ezvc4fuGbPIi4x5DBB8Vc",
-	"uRK9ceKQybfwEavPT7+Pp6MZLq/Ko2jyytsURenpSDqB1H8+0t9TMZD2HWZJo3bIV5mx+RrQ/wRnApoN",
-	"dTOcLtUqGxq8t2OhKR0qKSPtj5zhUsIjJxSBDaTKSypEacpX9Ihbw6PxyME+GkRUVqoRtNQsliOlrC/d",
-	"j6e2dnotJ7Ab2Cw7vFXLwxBoycPE/H1ykiguTnVBDdM4iBZnSkwQLSV7AUtXrsjAXXzsaDxzFGpPyDEY",
-	"SM8SDURwZWJYbVbX3ZUrd5Ldg5CNPv47GuT6u3hnUVFJFrS8ofPbHxA9WCDUX8R4VOfZgnb+P9FVEuUA",
-	"Vk6HEd6kyVFgyh9hN5RoYMo+YJ6OOsR3N90N2iHneRW1gDje5s7VHSskf0ba+cgaYq/lI6/CfcBfRxk2",
-	"nEAyZ4DUqGHNfGFW916ZLH6IrrvOat2uBqyIfshxw6oOjAfV3mIzIN5G8lM/Wvod5z8zxvJmaWMusBIV",
-	"0nE++slF6qPfcemx7hXWMbv8YmuL5cHgljgp7HAodeSaV4e/6jMkPGa6fnXmWpZJZFrjud9UiLzvue9T",
-	"lfe+d+/94d9Qi3IKj+fFDJENq27XlhRdLG35D0O9grff/tJv901W0ivv8oUNQglbtEU2/+Y4lnmSas7R",
-	"S4dvzM9fckl+AniNBNe/YvpUaHLxCtoeOS390l+GrGiOsoMucvbSCvtvYcT+zGQoe0dA5gsDIvMVBq2D",
-	"3cp77ev2Bs6MNv731gr2HBxgP/EWlugIqIHI8WLRRPFUl1dyXxw43JDmLpQdyoTKiFZRrcUcEghD0OPF",
-	"3+mfUwIn3F6PAydtqa1Yp5jjF868Te9U81obDX9FWOgb25pLvvNGEJBRTSWhhy1mYAyiYeIFLgWUjjRJ",
-	"L/eIp60B6TQD7OhvvIWbK8xwv5Sq7eVr9I19URcGPNNBrznKUw/nnOljiK93oK0RotCSx6A/R8zRkV6/",
-	"GI3XVl+0onndlfTKk3f2dhUenxFTAERgR0J25lmHl/q8/cOuvjso47TH+8m5X4pRYQKDeSYzJ/4WgCWw",
-	"naPkyPdly4XUG5C0fMknl2QncaA8EGgLL/A5QlIce5d00B3PbeBgHbd8ELRRpCD8XsSCB8/iTfbaU4UJ",
-	"tz4lh+G/hE+oRS6YC2hCpTjKwRuxTdTycc1E1oZlw6R9Fs79mb2CG/julPnhygxXlGPVqGZUZ2Ty9hN2",
-	"//8Sw8Q4V5wxh/4lbzE8F2P3QebLJ10wXlbIX8nQyqqPgx0PoRR5luTrqD6+PvSlanAIbkvQUyd8OuQF",
-	"CtymW3fXNvn5QDmr2Vyu4SZ2atipbi6zQ2RSOyTxS90K4F+fPrvmm8nf2ZAE3Z3sl+UaDujp1meSbvHz",
-	"OlBeBPirYLRZt+wEQ8Z1C++hT2bm7iAfs5nGy3Cc0EXe9l523kNL//POTHKNNau1hsuO+u19tr2PynwW",
-	"RNnYQmNT4xPZ1/FREfTCyfHsy/gcCXrZB4C0wRUn6iGIWbivRIkvQ42ICmmhwt8vpp9Rkgwd2o4fQIAy",
-	"OS/n/rrrB1ul+03XC7bAwfFsyt1A7fUoWslnfRh1t2rV4est06D3qD9fK44VDV0BVLgt3LpkfQBpI3rX",
-	"yLXi2IcUh59He73ftywCpiVJySeqQVEuPKA6n7X2iUmPB/m4Uozl1jUzSYZOHIoRJCxvqFnpz4PHHSUs",
-	"cb4kGOKaBZ/qByPxNHsX4mKRjORDXMSSUmg6vbJkBoLu8nlWXTqz8VL84G19vvWfAQAA//8UWXCqJ5EA",
-	"AA==",
+	"H4sIAAAAAAAC/+x9e3Mb15XnV7mFbJUBL0CCLz2Yyh+MRDnMSqRCUpuZMVxEE2hSHQENptGUrbFZJZJj",
+	"Wx5qzJHXW0nNjO04ye78NVUQRVgQRUJf4fZXmE+ydc599L23bzcAkVIes/kjFoHG7fs497zP73ycq7Wa",
+	"Wy3f9cN2bvbj3JYTOE03dAP864bXcBfq8K+6264F3lbotfzcbI7+gZ7RHj2hHfoyekzPogP6gtAePaZd",
+	"ehbt0V70D+zraI/2o4ck+gfaoS/oS9oh+Tt3Fq6T+9OFXDHnwVhbTng3V8z5TtPNzeY2vIa75tVzxVzg",
+	"/nrbC9x6bjYMtt1irl276zYdmIv7kdPcasDTMzNl98p0uVxyJ6+ul6Yn6tMl5/LEpdL09KVLMzPT0+Vy",
+	"uZwr5jZaQdMJc7O57W0cOnywBb9uh4Hnb+Z2dnbgbe2tlt922bJbwbpXr7s+/FFr+aHrh/BPZ2ur4dUc",
+	"2ITxX7VbvjaZj3NuELQC9pM6jH9jafmnC9evzy/mirmm2247m/Ap/YZ26THtR7vRHt+gz+kZ7RP6KnpI",
+	"O/SI0GP6Mjok9Cg6oK9on76kZ7CvtAcf9ukr2oUHo89oj/ZyOzvq1vy3wN3IzeZ+NB6f6jj7tj0+D9Nb",
+	"5utkqzZOdeDM8u1aa8uFk35JeyR6CJOLHhOYH8GpPqdH+Nkh7UZ7xmSjLwu5nWJuwQ/dwHca8/Fuve4G",
+	"Lyyuzi8vzt1cm19eXlrWd/krehbtR3vRQ9y6s+gQNy96RHv0KVAmiXZxakdsghe6jyO/u5hbbIU3Wtt+",
+	"/Xwbsri0unZj6c7idX0v/shuHz+nM/wLr+qFrjrjLcXcHd/ZDu+2Au/v3XOu8c7i3J3Vny0tL/zdvLHM",
+	"3+OGP432gfiiXdj0DpxDgikBMR5e6Nq/xRfu4//v0SM2BXFP8PoeAa9EFsm45c9/uUrC1j3XV+aBzGcu",
+	"qN317rsroRNuty2s93t+QfvRITLhLok+RSbRh7Fpnz6DG9uJHkaf0h68NlfMbQWtLTcIPcbeHPaG+how",
+	"W9sr/gXH60Wf0y5bD+3HLLxPj4ok2qevaIeewF+0g0R+EH1KgHk9xb+BhR3kivFBTsxMTpUl6/X80N10",
+	"A6CM9W2/Pvw0lIXxV8r3wVy0N07aXgfHG7jttltfW38QWt/7LX0a/WP0hJ4agyNRA3fuRbv0hHbVl129",
+	"dGnq0uVL0xOXFHHj+eGl6ZxtFq3A2/R8p5E6h99Gu3isglxoX04qZe/VA9K34cqV6avliStXrgw1tS2n",
+	"dg/EYnJOX8XyKDqUd0ybDjI3ZSJZFLHhNNqunMF6q9VwHR9n4Pp1z988B3nSPv0B71on+jL6gvaiT81p",
+	"9tSZWOkycNthKxjxliDDeyGYgHw5fBft4scdvKNH9CXtyqPDWb2iPfpCndVUclY7qlr0vjyq+BIVzctt",
+	"7maC9Cw3IrH2D+RMWuu/cmshbM81Z8upeeGDBX+jZaNfehb9A+h+9FQwXKRhAiQM68XN6JHoU1Qtzhir",
+	"jL6gnbGKT38DNw02Vmg9R9FB9Dneu5fRYfSlYO89+pzgXezj63r0WbQfPaS96GF8OQRHBOZ7SntwBGRl",
+	"nuRX5tduzf3N2rW523PXFlb/tlDxYTq70T49xTk/lD/FGT6np0xaE6cWevfdUoLMe0jkXXoC0n2s4if5",
+	"7n3HazjrDTed83ASOYoe0S6S1SHB98LHfXzLKxRy8J6XtBN9qq1rloStUJwsKZFteaawqf+GM+zRU1gi",
+	"KZM8E9SneFlQXD2NDqI90Of6qLz0os9QDexKJf+0wNYlaXRm6tKVy+WrE5PloXiLMj2rZMs8SMYH5XJN",
+	"SlqZl8zmBeqvnybPGLbhG9oVC+zQH2gHl47vfC4uM/AH+kI795jtd8h/PvyawHngtpxotFUk9Dnt0GN1",
+	"xnBiPcYZXiG5dI1NnChfnro8PXFlcnq4XdzOEl7fqwQcHZjLSCNeZdtgk75S7xvtqvfN80tNt9kKHugU",
+	"3yH5pfxEoUjoCcwg2odfRntCUsM8dqPPoyfRHgxMT85FSQYfVMlK255i4s7ZWNl1r30vhY0llS2kg4e4",
+	"6D3kbz12uLEgzsekUiAr87CduzGlRo8Z/SQJrgu0o/M99ZhoH/cQhsY7eRodkHw7dMKNdqFY8eEQ+9Ee",
+	"l0g9TuQEf/tMeY+FG9IjonLz12NdX/PlgZg9o31pxMJ1eIX70TMJTT3+ySvl8uUrl8tT5fKQKhSKprVa",
+	"a9sPLdMZpCPoR7Yyn6Xbpaqu6LpIvvs7NAQeE3oymFrU9+TG607ojNe99r2JpJcCjsRrOsEDu9zgagXQ",
+	"z6HltdEhyVdX5teuz63OrV1fWK4WxghQN9zf6CFoS8AF4brvgzSgT/HSduWeRQcVP99s1d0xMNGKpOE6",
+	"dTcgjVbtXuHHhHajJ4z8wCX0nHCzv4tUe4xjM/Zc3XC8RtW4+qmKYHt4Gyi+c7MVv0SqLb/h+W4V7xo9",
+	"VmizS88EbUafM9sQd4f2VEb5Rfw57mtCw8aXwGLcOn/JGe0mX4REJa9A9LhI+DRfRl8CN6RnjKnaXo2v",
+	"qAeO53v+ZlVwDX4a8Raf4TsPOYvOC5sz/hruPVjABwVB5NHDaJ8+0/etV6z4RK5WO3lm0WtT7AgdjJ2k",
+	"v90EPsw2HRxuuDG5Yk5MP/eBct7xcwkSz1YQfqcIskGsMYPXTJdH5TWZEvc3cJtR1vXZBmdOjXazpjYx",
+	"WY7/N7og5L5UwSjkDSqOIiJ11moVmHCqy+6vt912mLIhoPT0o12DTE3rMNqNCTDppwBGeE72SvIwMjx5",
+	"RKpCzo/BNlULKax30uIg1ncZ55WxL9xPZKPfLn2OmtFj5mE91/bgaa01W/fderYTQ2MF0RO5N9pGXSBR",
+	"MpOy4YZufc0JrY6Eh3hLuXqAbtDokeB7iU3RDmqyPHmpVJ4sTU6sTlyanZ6cnSj/nerirzuhWwq9ppW9",
+	"XABFDU814h5xZvhafowTFKr96CGyZMaH93E6L5HtPzZPd4/2Kn5e+vClpaxrN0XmrT9G8+opPZNGFEqy",
+	"I6E1dlA1KujSupyujKXRom2hqVSZ6sdK08LaoRMMQ2pMte5gFGoEEiuXZ8tDk5iNU2gzNO6GvnNF7U4b",
+	"9GNjOLpj2mYM7nHfG7hN94QhFjtm9tS4RI/kUYfp8efiOBQoEAkJOxeE6CUqJDiTdNnrHzMHfmKS/0Y7",
+	"OIUzjDehScLeAFMAg+VYm+QYoV9hpIk5LpiaalpOTAn8n3M3F67PrS4sLbIIkaKpneClEhedD3KkuFy7",
+	"yq2nXRxORlfYOPBrdPo/jHbZ1VSDHvgLNVbBf7Q3UpACR5FxxKSmaQ3T4Y9uLV2fX4MZz928ufRL8Xoj",
+	"IneYVFvPmEGIEzqJ9lEdPcW1okv1lO/FwiJu7trq8tziygJssT45+iraZzxFnCS/cWyL0atFf6DH0b46",
+	"dgdsUhz/2tLijYXlW+zwlud/cWdhWVnDU1zBHj2zDa1vseB0ezza9gM7Hrm5YiHLc4vvzWcSyAuy7Pib",
+	"LrmLpg87mYWb82urS0trN+eWxa8F/xKuOrAdHnE3U2x2489XVpeW596bX7tx5+bN+NXMEAPN8ake0xGO",
+	"wA7++Bd3llbn1ub/5tr8/HW5Neoru+wsT2Ao+BVzbj6N/pGviQ2zPH9tafEarGNhce328tJ7y/MrK3wl",
+	"u3zP0KuPdqEWlI49QzjS9eW5hcXkKDqrzVBwhnjF8jxs2bxBEPGOH5mxsFM1YNQt2q4f93VzYlADynz+",
+	"RwOiubp41GKwCY1ARisTXPDfaZdbDIz2FE5I++jN4W6UjrB4FZ6oTSAjEDtIXiGHjmeZFDnG84zR2yQT",
+	"ZI7c9NphhnD6jnbQCD2zuHmjXb7aPj0xNQJdqNx1QF4Gthf8b+5vYwZxN/oiesIUTumPeoXS/yXt6vwO",
+	"3s8dxDC5z/S4FUtHSXorvNBt4pTkP7JiyrBBt9zQAQ0ytyPHc4LAeQB/N7yml21cPRIRJM0trjuWrQpT",
+	"a2Oj7drG/r2yB104A8UfobvEyqne/RRThA1JTyyaoMYR8sAi9pmjGO8vykM0mBiRFHSdsDzQJmanIWYn",
+	"NlbuQjGmoDQ6lsdk0126eEsVVUFLdkIK7nPhoycHRLuq9w1v+Wm0T5wwDNDHVkiN21vmIa68GRs2WSIj",
+	"FskSST4WvqTqBOgV/Jo5x5Anoyv5iN+Dw4pv4Z/V20srq2Tc2fLG70+Mo8o6/jFP49oZ59x1aJdf7a5b",
+	"u9febibXuPKzudLkzCWIGP5T9Mi2fc9UYtJVemdifbI2VZ92ZzYuXb5yteys1+ruxsTk1PTMUH/beHmt",
+	"1Wi4NZgdJKylWT0v8U4xZSsPSW7tLafmFlQqoUdkrt70fHKrVd9uuGMkJZ0k2o9/hS5I9PfKWyXfRF+M",
+	"EeQToPY/4cckwlDA/56hA/A5WIKE56egGxKVMkXmGoeWu7o+uXGpNuGWputTTml644pbgo0tTdWm6zPu",
+	"pY3LzpX1wel2RZH8s8a+MDfu1sKt+RJqjq/SjtNrOpvu+K+23E2rla8OZ+FGuhxVryvqrbCDfRSbGPak",
+	"fd1TRL+hHVRA+1w8cWO5z6ZMO+S+1RngfrTlBW7bbqZ+jepsB0/jKDZZubmlRoR24c1MXRKhc/FdfmFl",
+	"iVy5VJ4okjur1zDY+TvawbwD1CaiA7zK0u5gnrHq9laj5dTRHiX/nYRhY63uPGjDja36241GVdiBW27Q",
+	"dECIaVRo0ggznqdKk1OrEzOzU5nGMwzvrCdkqu5AWfMuNAE1V7zw/FElpwImzDJZk3QH948rPJK02MU7",
+	"VUN9nbSrqs18624rbK01HL/erjlb7tivtqxXIXDhogGL2mo1vNoDqxrGRPIe7VnJipnSodvcagVO8ECY",
+	"BmR19WaRYK4CCmrcaiWcKf1UhgovqYgNRJ+CTzSLrLUIg5wGJrbwkfTgQvyxZT/a3t+7wwYWOlk+0ZnJ",
+	"6ckrV4bzhqaHsZgBD5ay8lq24SxQnx3AMpKBX+APGZ/hUanV1Zuci0RP6AkyOJ4YxRN0WZQfA3c98t61",
+	"Ag5Rd9FBJe23Pj0VTIhHOPjZCtVwqIGVY2SLywmmiJEi9k79LOVzyUCRs9lO0WCf0Z6ym0Mx9fdzjdZm",
+	"i8WrNoBw7k/CRKQin3y9oa0Lrmk95IfMijHZNQtCgz35Kcnr+STseKvm7f1JfAsLr8eewYPadD7ymnAO",
+	"U5dmirmm57O/JlL5sRoI2wL+XR9JiEkjC1aL59NXtI14O05NXTr61BRpsxXf4IqdIhEubHqkEKQytXGg",
+	"lSI3Zxj57sYXD0dQsmWZqgYJFaBUvkIv0F6sFevu+XguP6jpfMfMvw3RaTknvhMwSpHFUkF562vit2oX",
+	"ppOlycnV8tXZiVE80cWcMvDwh6Xvbs88gFQ/+SBRnz679QfW9ME0Sc438TF9zmwcnp92KIsSRGp9j+dE",
+	"sHRoeoJj7OOYnaJBRz2ecadaEBhKf4552rWG4zVJtb29XjWCIbm2s+b5m247dIM1Z702MTk10MkSl7gk",
+	"1QZDN+YCSzGL9I3TD1kJ9ybk/iDb9g5e7DT1gKWUcJFjXLa0qxuzYPDYw7HIC0BsLBlt5/98+HXFV9+h",
+	"ZHvqLFJ4FVXLW4zPrlHT828r9vNEIrycaSZ8w6P3Fs9bijJJ/6jpdpiHw0Kbu2yXDDshh6z4putvQhbR",
+	"RLlcTlBOqpz7hqdpmJ6yPUbEYNnL0iGedhZ9yW63YuOhRxhcM3rCMn1hF44bQKujyMUdC9H9zHUa4d1r",
+	"QM/DRchhv45FDiqmUzD/l/RNo6Z8jCEt2JTH0WHCc5Ludv2a9uVG9eJcV3Z6PUsSc15o56948PQlumg7",
+	"0aeGmfi1JQUrqQMkQi/JtDmrHitVSpl7cw+VqM0AuAFPwTGybu4NZE182A9Sz+2md9/NcOl+KzxdpOHd",
+	"d3233SZbQWvdTRxI2w3uezVXr+YDT5Gz6ZbchttM099t6x64ymIOpFA7dJpb+hvPJcHuu0HbM8qVchNj",
+	"5bHysPusziseryh3J/0cll2n/mCogwhcp+5lnASTLMNcxejAuIxM2VEvY5+eJF6AHsEH7dBtDnKJq6xh",
+	"p5j70GmM9AuLmOXvZWPZdvP8dKjvGmQe3quS/GS5XODRIy75TJbFPuCBmeiA213s+qoDIMN4yiJ4nGeD",
+	"RHomoxPSNyRjp5hjSfIz5amCDCoSLnnQb6im7L0W2/hLvVBCkbLSwgLYVuFCu73tZnjYVVvBSNB5wXf6",
+	"aXQADyr2gxrhyo2mh5juSl3u6JGp3DWuJqZ5x3F++N0RxgmOY5qScYfcKH64heu6qd1FS6/H7Lsjrlh0",
+	"6VnhItxuA92FKfnYv41NGzUTXQkMW1xr2203mFgDei5PTk5w3T7dzea0raf3HQr+z5liyB07DWDJD9bc",
+	"j7x22DYi2OAI2kNrBSIyfNtlYLwrAppnWMOEw6FxHnjhgzUMxrLxhLmgVGorlSxJIgAdWyMCPvR9p+HV",
+	"11w/FH4/W2oE7Wi7Gz1Wt7YjpqAPzVns2gZ6MGypC5mVPjjGh4EXuuqqtRJr/bjV9DfdE6WdBmISaPuJ",
+	"nyjbgEZWPHcQLfE0dMaZHCmbh3Ei0uMX6YxqtDxTlRl1SOgEpcwS4XMmcGpvy8qtm5mdnBpeQLzhTEor",
+	"w4YLNzDy7eGJDD21NNEwOAPS/nYQWe0U7sMysb6QuQIy4pc1CS39P1ccLqNBlZ8WF2n7nre1NUJ6aHLu",
+	"xtaQvMEfTWsWRFthQEXta6aPDk3g50gezcoalQQX76tSdcEJwsY7brXq7mrg+G0PljhkDj/zmXLPgZ6w",
+	"p+in0YGFjfgbXsCD+RvOdiOUoX+Lm8mSpMfcLzLdT6gxWsIfOpggx+QQmJFiw8dFaLwyiSW9VYNWlfzn",
+	"Z1+RavBhdYzQJxiBqvK5zhJQLqrKb7B6G9QZnMUXXGWaLl+t+NEuC74fY6pKStLisKkPoRNsuuFa054l",
+	"+39hWbyi6oVyBOiaNtMtu8YWRQdM9wg+FCtvVUk+esSkAwufstCP3BsnGPQEDJaPt8lMrjN3tFDxMSAt",
+	"003cuhdWmabI7r9kQVreoFgBoUfj8LD84RnfDSUTeEyT7cGH4AltYVG8Lprxw+zbp57GMBdpBGEsr9KB",
+	"dpWSt2c7CFyfzyErW6unUQRz/fG8NvOqFJT9gX3MFUfcJpike99rbbfT5vUd88tGB/TYMruR3v+h1fSU",
+	"uz4E1zaWP3Q843ysW9+ion6SyRXY6OsX263QuQNeS0ijtCOEmLEQwTF5yi/6wxNZv/Q0QWhSvFtqyeUv",
+	"o4Miq5vfTdUZ8H2oEfJ6OMxoi6FLon1GmwTHPRBTBmCoIXWMlW3coHhzbJrGr+FbjKlk1/pIFWdf2TJm",
+	"WlmqfMZw2HaKjWwQgDIFsTLbIS+7tZZfA5C1FK/Dt5rzuxMdGva/Ev/sqHHI+NGemsbdfZPOh9hF0rej",
+	"IZk1SNIktGpuf31+iBTS07eFi1RWl9TT0Df0sEwOqfI1nBX2rDv6e0y4M0+YV1MHW3cdn+PBmEn3xvx5",
+	"Ro9u7ze9dluA0LDfx4fPf6CcnzEkjiBcGmtNr910wtrd2CFh82iJx/G3EEQd8neqp0RfOkx44NR136q6",
+	"axiQjDdBienKmfFwr/q39nZdOBqjDdBmWDR5kGdB8qNR9ZnYsa1e6gGV0H36IsGPzud4UGdygW4HVg4N",
+	"xzWq/Xoka18GFzeOZNp/m+T5XAQnOPRQgtWQRDb7/TXs5GHPY7Q4QXu7KXAwhlrSCn9+FANbP3N5EPHL",
+	"M6/PSjxDU6XikBgnrAi1zzRj7T6BimbunC1mp3AO154j0NUYnLgkSi5JtldL5TCWFyhsUPO1GjxxAHCe",
+	"FaSvde+1IF3Ym/UrMIwDTWPU7fTgT3RgriQh7bTX2d6lcfiRzk2HIRtwegapYxDPWKZ5wEUrXSVnbKd8",
+	"LPMYzSedBorXM9KhESBlRIzLzGz7mFvpiazRYfqkeOqJQOrrpeXpjR72TE9y/+2fKp9d4v8NYvZZRxhD",
+	"0eB9PI6/Kgzcx9HDx6lJdupatCoMGx2vsNjOSKCGhP+IzLN8AeF1rHvtWuu+i0BpDGaPpRgxVIkz+sxC",
+	"tk6j0frQra/BZ4iIazXLlYwvMwW8aKQUCWyPjHpuIqGc9dN4nycXgt7Y+tDn/2QZwFhH1w61ZDCh9mb+",
+	"iqV5Kz8fmFpd49Bkg8S9BkjJsT7sQGUDIGMAHPLnP126DrVw3/FkUjCneYhRR9oyQLUgsZQX5utZjYyL",
+	"qQ+vcMfwUOqZhKizbNCo7kHDY39Ob1zgInpzmjPwd/prwYv+BLxHivfnUJlEO3T8utNoIToUHzpRFaA9",
+	"lJxQq2GfCMMqj/6JntDnaH+8gmsK05rlMWkxLDcRBRYGUzbiYtvkECSvbsNP4pGYy5yBpfFRGQg0BuKP",
+	"SLzEknYl8yLqAfUnauWWFmhno2+0kGnw8cVfmaNrqbQxDBrt6mUa2kazRSA3Zm8Y5VBSS2AMKlUS80ke",
+	"srWZ5ENeG4cHol2tYLJggXxLJr/GZA8fchd5nz6VYB2tjQ1lAAucm5GjNXp2FkKusrhaj+lxRQU1D1gG",
+	"YrD1Gd0xliF1TRO0kB1+0wGVz3f8WjzxDnO5CjA/WcBLe9rpShQ2vnA9EUwZeFjwNpEYcREFeyvzlhpW",
+	"VP0fMiXfhIrlLQYqvvB6prAbfgpoKe9zansMx8az/SCezHGF8duHMuaI/+ROdBO9NNd2S81Wu9b6sFSe",
+	"GJDbZmpTSoq44XgeNvdNbjsXBkohQJwDJ+Vo0aZlWJWhhLP9daIQmDaKajtKAT0a0bdEI5rOR6lYe/8q",
+	"c4LiqAKr8bK6nJUKQ/utLJwHhxcmmmYxKhNNYh4YMG8XNfty2Y73wIIR7dZ2UEs5Qgki1GP1zAqiNGOS",
+	"qgxM5nuZISaR81XlQX/QdOw17UUSu+pjoBp6YgzJ3OTcu9VLKVMXQotRLXIzfLshp+TXFr8S+2pAIIzk",
+	"29vrRBQHFZUKrvUHVe1kC/ZSoTUomrfWRJ0b2NmE19HP5RzI3Ti1UZDwR53PxOQQWB7x4WkIlsrkrJA1",
+	"bbe2DYl4K6BNc/BE1wncYG47vBv/dUNsw89/uZozM1SgDmx5BQAosGtHkcEUqUDfGoZDxU+g62LvnDbJ",
+	"c/NwheUkk7kaAm22C+LWgAIrn2KD3mm7QZvLHDQJMHUEJx2f1t0w3GLNSTxuvkItmcMImrdXEphtZNV1",
+	"mgmshJxpySIHAsv1mHlukrDVTDgm6lqMY7fixo1BQsiPfkRU9GLmw2ay/TPa5VViPfbkj8hcGAbe+nbo",
+	"lm54QTuUljckYzQqPv0XhHQ/Fih3IqeW9rn0PsJEkWMFBEGZKGJFIe49pLCwXHlSfXdM+vaqiXxvVuCp",
+	"VrinGA2nAstBslnajz+E5w9kspKlmA4x7zQUKlSX6QlCoajhTlUcxyAt0NxAZELsYsqMqlYhK1PiNnr5",
+	"MNv5X87dJLeDVtiqtRqID78rKuOUZlQmuhLsOU/fOQTdOFbffhl4oVuaAyg1crO1qVUXdnENKugF0wrj",
+	"rgxs02YrPswK03IxI0mXSbEDEb6Ll83Vk3i98DUMVGs1m14oVxybrtGBoucD6jooWe1CxTevS8IaoEdC",
+	"92GnTZ+zTdiLDqKH0RMICMV2mehconR4iA7jFiKGW8ey/bMw9U+UiZNPyB2UTeQTcp07Y/AzcMbAZ+iM",
+	"IZ8QSDEZh1JQ8knF/6TE/vdJyfyH7TPlyxL8WKRnfYLGSvZ/8HFII0t7iqHh6Y+3qslvlL8tP4FUNvWb",
+	"ODuK+wYzB6j49GuWyP4D8r4zNZz3GV5lCdrAli7cPWZaWyy2ZaaWLOgnXIsvFC2Jb5ZMt2Q6n8zaw9cb",
+	"c6Uv5FwVoAD+TlzjtzLdUc/wY0oGzucR4pUzUOeUbE1Yk5n3N6bwi4RrzABz7FpW/5ybzByKLDqIr+j/",
+	"GQHZquKzagzjDbRrbJ+Ke67VMyvQWsycjNF1Ucb9wD01Xa3jEUsSVTtlMFYgpNye6KLH5ITWgwb/FLhX",
+	"GqSKfLWKoMj4tRqAB/bLJLPyEy7Uk8BlMKmXsUiizxJMR2AGcR6FBz+GFyQNMkxr2JXA6CIg1ICgsblN",
+	"dIDJOmiRV/zhcMVmFTkPZLRrHpKU9Cwsz6EO+L3YR4cBj0VEXyac5/tQNM29+YJ/cme6EE9APyvzawKZ",
+	"cnX1ZlXS57Kowie3sQrfiqQTk9tIoDoK8XGwwr6ibbx3jSfjGQhSjLw41I4Nj0el/iGgefg9/OcsBFt+",
+	"+V2/vtXy/BA04BMcElyR1fG7WMM5/m61SKrjTTcMvFob0oJJVZw9qLPVgpJeDGuv+KCR/xR1YKaSkzzq",
+	"54XBqnnFX2G6uKJy0R/syBEqQXRI/tbkLe5uRIqcheohvnOKG9WOiICbb14RFU9A9pXAobGwiI09JOiJ",
+	"QiC0mzY4Z/qpM08UvQNb6IuiK/FrZXKwNRzQRHmtqtcwfmf3SXbRqGl4NZfHjbmlcjvw7rOQ0XbQ4NZN",
+	"e3Z8fNML726vj9VazfF1b/Oe44xvthxuUbDC1BCtbVM3m7u9oLjDZnPlsYmxMvygteX6zpaXm81NjZXH",
+	"pnIsfQ+NREGAHHgRPtq0Amd+ZSkQQO47UqPGWTs+pt6EsFjx05ov9obpe2fWRfXMRoM9VZapjQeLFd8+",
+	"v2Gb2+HNo38w2gVy+b7PrkbMrl8NI6zhCaYZaZghij0mcmCZMxcInbei0SIiMBgzr6VPFPoe595zQ70f",
+	"p9EpeLJcHqKb6XBdRvUX2bqMjt76c6eYmy5PpL1ZLmVca8+KP5oa/KO4TfJOMTdTLg/+hd77V3XN5Gbf",
+	"150y7+dURpX7YOcDJfHr9bqgMiyV93McFeADeL+mX4x8we0YxTyJ3DQOd0Eq8BZ8CgLgCx3ilrHcLr8t",
+	"776bbKylkW4n+nT23XcFzECeWSBFVN2LeFeKQvtFj9RLYT8w2PQ+0xw1sBiWKLDL/B/wYJEnqjFsmQ49",
+	"5R8gm9njYmdvjB6PFTh+D2+BB2mA5BfbkAEhkE3h6gn7VhgD8sYaCD9P0bvJJ2Fopra7CkbsDdH0Umlj",
+	"/v5wCZux66LLKvk7Bgo07YqW5b+GNcU9ywWicHzNZbHbRFkFXGMYPyriWtLfacn20FCYiSxTULMsXyld",
+	"YmxTlGDHljmqUyoPNaU/xvQqa0xk3FZFqU2bjgxPxdMZDhowEQmzBJRjEoqV2WKKxaO481CCqqh0WuOr",
+	"s+gx5BmDOBXBWtlbtOIzLYcrwB2Sr8Y4eaSyXS5PuT8h4qO2B7HaIhGFSJBm8lu7267iq4qr1FbhBpzE",
+	"dky0p3OTXXgAVXXMOKLPOU/pmenYz0T6aVcQOs9IsZyYNnvt4IZKzvrgDcrNBNS8XXTaefVftISMTRCL",
+	"fEzFzxdikAdMElJwnCdwQf+WVmpxsIA9lP1TlWqQhOiLHb/N7UbobTlBOA50U4IalpEEnSrgKj736fSB",
+	"OYMKOlvxJ3hfQ93FLR3FFX9yjMSVSrHH2HAW6IARU9ZBtdgAl+8mj0FMnumxeAJMHz7lAdaeuOgVn8OU",
+	"pJfBxH17erj3saYM9/gRup5fok9pf7biVz+W6IJwhXfWPoYiIf5PiZIDH2979Z2xj92Pwp2qRaoyB/IN",
+	"VmQSsHrxn7bqD4zrazlX/QabZR4Xg53OziV2cineHrVMpxfHP+LKKvGcygKJznBlAV0KR2W+r35svsRe",
+	"GROTndWiCShJYbGuxRvRrgK8PlxYjlKal2Z1n6C5jY0RENB7TyTTWN5T5O9IUosuTmS8EXpRYppZctax",
+	"HYbBS9P8H8OQm8zySMDQa1UwXMezbvBDBtsrxJDc3DeCPv+68PCvgwI/GOAxpUzVYLG8iZKYjLrMdc9n",
+	"eNhDYkf+fGVpsYQMdRcJ+0hBjZQZr109QMi8vtLPeMKwseWdN0/q/QpCRlZyRVJhkMrsn/cnK7kPRgeD",
+	"/Wd6lEh7B7M/bozO8wKIen9UYATNs6BaGRU/i75nDWp9nir3wM9vo/++wN5B7T0dvJaH+RdE5ydMlYoX",
+	"06en5h5PbUzUJp3p9dJM/ZJbmt64XC5dmbg6WXKm1qdr8NnljStDZcnbUia0xyCKs5PQ3yYuVH+LO+FY",
+	"dLe4uQmPDD9i+oGBvsy0smH0Sr6LuBJeA3ODX0NFhdLaKKl6P/gRnQar15at7lhzu5zZck7ppiSx9rvk",
+	"Hdj4d5hvLYFaAnuwUxxy8/QOgLbd+yalmRl9rkC8pKWgCfouiiBkh+cvc5CgPY63zkWJJtYL59WtRzjF",
+	"+MWxyq2f5je8GRwXqZ0kHpwp+77MOmfZk08/4G8GtOabFfHlkSZChhJ9ePgb6cvPnNgbWaulWxDjs0qA",
+	"g00bUxTnP6q5bt2tG1P/LqOnXda09QZ5xkUcpU8ekZl73PGlAFpYFzlRnr4yc/mSbB5hxhVpn5Tll2/g",
+	"umedCskr/SSLPJqJjbawYQOyJ+0aE9ZlqOJnqa6CASiCXMQP8cQrviFaY+1zxJaFiROQAyViE0a/JJI3",
+	"eyZCtQOym6vDsxsbpZm9NnVa+40eu0u4iu39N9XoxDtB653cRRLJt5YuoFqmhziJzMIx3LuJqfPtnd5C",
+	"U9+5tLYsWY01SZzFrV6xi9u7P2a097Q1PlWzdF/XDQS/uny+fVZ7jQ7Hxwc0Ic29Fa4lX2f1LA3nIGNy",
+	"xvSQpV7LzjA+MpmJwuwVLGq0N09grXNMNxm3qEQ6xxOZqpBvtzZCwkbUCl5sAX+9kQk0hNOdUgX0dZkZ",
+	"u91kloC952pabslreOwsniWWfcg9S0bAxkZN8SNoMizUcxa38nQWdLPRySjtDN6iT3i6PD34F4ut8EZr",
+	"26+/BVn1h4zskaQSo8frTKEF5/7nKrbelC/ezmpSd9XGaoojhKCtQS1NUsLBsL42C9cvOqJsy5bQjPmL",
+	"u9blt+dtyG7p+ufMG95qgGnANtnoegtBrdKwjJTUHUuz6964gDLfS7R4GyOWITQ5eM4QE+9aVE30a4Kn",
+	"1IZN8DfApeDnHEylWqz4WolYUe/5VUz2eVNKkLWurAzbdcwapYHo68VdvrQwz8XcOzbb4fyMf+Kbb6pd",
+	"0cHwbsa36cH7L6yzfGvLhLXm3yZt7f5fkq39dpWWobf1NQ2ncQkPk5p1931WsjTuGD1iGAKsqGdPxKa4",
+	"3c+8Pu0wcJ2m528WXlsMMMXnx/BgXGIjy4iGrVXg0J5aZC0dbqqD6auiNuGQd5VMdoQ2qw8KUmYdKx1g",
+	"BHzIz1ZXb5Nlx990CefycRa8NZE27qxubQifD9z2dhPQUGWFRLvAoRue8YTMIwyQVvGtswSLdn+C2Hsl",
+	"169Xx2T50RGvmYMqpehLaTTT59pYJ1joViLVa4yflFZB8OKR2DuHaw+zaKwC3mHxLwngKhls7xW0Ma57",
+	"7a0Ww2+ehSwDp3YXnBE/JkIt+EklNzY2VsnJtiRZnZ9x6Llazd0KS7hFbb5HMZkpJwnkrR8f/n5+1dmU",
+	"vYLxMLE0VKs+Y5hcDB5EVtx2JRygTaEXRYPnMtITOXoKDd5FUBkx6XjDmfsnle4g8eCPskZhb5ZUTaoy",
+	"oqXs63KJeeZnRJLbXYFpw7PccFJadtv5EtlatdANS4wB6RrBwPC9RZJ8J3J0BXSt3uDJ0L/Z2nCSGnVp",
+	"sjYnSujTV1zMWeheHyTlDiA2MaAQV3ID3gDkqw9ZyUGAqzZVn3ZnNi4NGACGmCxfemsH8e8GmaYcR167",
+	"qIUBZ/IaJzDMTxhJJ8+cyMswznuZD3lG2cfwV6GDssQADmimqERxYTUqcbG7X+SXWOu7smKU2Zrt9wOK",
+	"xYbTaBHYjdtarExKX9XvE/FL8VzGxIXGIbqc6BMXHtdh8ChZLjsr71Gy8tT2bEXNP00RFV1MUukEbN19",
+	"enrBAdaL0edJvmqePZYoc81DTadN7o1R6nKqbZbo75SqW1b8fNU8PhkKnbh0PlNtYREzYNaW5xbNaF7c",
+	"UuiRUpYOK4MveLHHGR51vGTU/oiGiXCguePeQqYMY6FvP4M7y/gZzdRSLn5KSvfv0mqnFVpEOLpkObdS",
+	"n9hJq7ImcZE1K4jX+6FC3QKvcx8j9F/4o5b2yHFArOJX35tPt7mEMVLFMUg1xn2tAv0bNpMocdpHyf0S",
+	"TQ9sTEImp7lWyjDfuGXF8xEFCbPKdR4ieRkdioZkmWDCZiW8ipLL9x62Cj89sXSOlgi6o1m1TmCLxXH8",
+	"5gsOxl2ck8zEl84MzKfs+n9dF9myEJUGoGV2AM8JLpK7vt4cgFzfOuf9Kg3U2nA/DYdLnsGmBU7XCAG/",
+	"ZEv6Ly0Q1LMZ0JrFNCzgYsVX8wmKWttV7I9pS3BJwhAxNplS0kZsFW1DIGULJpeBNME1sUQ63rvvomNE",
+	"4E8MW4AKSM70afQFE1+Wfec5sfAQV1foKXtYAbPSe3TgLNPqHQDz1B5UVVHJ3yCTVV9jY7DDwZ9fzHXV",
+	"b+SQb84u8VYQbcfrgeP5GerQd2axkIiHK/goeaUEoat1Ty7AX7ZK0biaS1R3JRCRKr58BiiCeRaeAcNh",
+	"PjsBbYzVY6wsLHZjfhGD74OKc/vm3LX5W/OLq2u3l24uXPvbauENFJV/zWYb22FHCXNFRXWu4tZ7/iYD",
+	"b2ArVdy9MSKdbV0Vn3HkZDNY5h4Xc9AwJRiY8HeJnoj8dOMaOyLwtUSLuocCjMiG3s5wWta8Oq5EtrQG",
+	"9JBq0kwnIowrSsTEUSTsSTYH6fmGnyD7fc6LvntqsQDL3eL9GN59lzE3bMKCjCx6xOzDGCy3q6Fa2RjO",
+	"dTggAJzPvZlIMI4vOs++5Rgwf3eGFmnpU2KQy/8PAJenh1h8hrIKeuqNpTuLhtdIcBLWbxH6N1zlV+cM",
+	"L9Mx0+Iv0OIXb0y8haFeIZYn4w56x4gXZseIC9Hhry/PLSyuLSyu3V5eem95fmXFLB5SyFBnMNLetGVx",
+	"XuiOGZPIfu+fZ7IzT9Q2e6QYOPsvle6xUlDoyAEX63rCaaGu+MwK+DnkHIdF1bEH/bNoTEEaVO0arVFA",
+	"mr7lfgT9ytNtne9k9F7gvUCTDd6m4aHihcKpJBruHJHQCUoK+iPfHK4LMFi3GK1D+KL02CizPXr0mRDG",
+	"zJHaiY8F3VTzb0CH+mc58cwa/10OHnQS7RPPLzXdZgttJgVcUuiVCiCJJibgu17F52qE1LiYA4wrS7Ev",
+	"Cm60KHVN4rexzgnMgkFLLEZWAMSVRGJ1l7x3DXLUFQ9hdGgcNGLbxb3/taJ63Hg17quZ2nr0mwFBW9xs",
+	"x8xHrgMVw2KxQv2YIUhUP1a1uR0V5iEVPTNvjQHGNgEiTxgDs+EGxHINCmEanoSREhQizVs9F0W9hB4A",
+	"T4ZWnLN5vJ8cgWwgftLw+D9xv2fTsYr7yG0q9dcHhT8jzCCjwlhpc81NiYSR94LDYoF9KBF+LD3OJkrl",
+	"iVXse8l7nNlBd0Sa6EboBtrSh2uJdu6VHtP+668R+7hNTs3OXJ2duTpwjevuBos5vlFooY9KoROcOwNg",
+	"VZc2SoqZHuR/3cwJtZtMSbR1npgpT5dnxkInGCIdYqRC+JEL2XXClPattFufRgfMZn2FPkzQEc1zfvOB",
+	"O7yoHfQiIL8GkC8Da++vAh/Rrsn9h6Y+aYJyZX5U9Y1JjpEQoXSFrKgD8DPzVQvgJbXFc2btW3tBqD0J",
+	"0K0n3fzHHB5UYCOKXu2KojBLhu43oMJw46OKjmY2HDAgjCSEkHQvFYnul5flAhWf1Qsw6NcktrpFTRkj",
+	"13i+H4mbZLEMSB2sR05DeJTUjaj4SncNvP56K3WGPktPZXIhZxE9eipIUldwhWonBZLiW9Mx+oTJ2RVR",
+	"CSwLnc/UGhPqzkJTV3eGc3O9rux4e+4ttq5R/VvqqbzdAqc3Whfw23hZ1nZ+iWBj0Povk/ifLTu0nTuf",
+	"5AhES/QBwiORRsdTQ6J9E2bP8EkYmJYvtG5D8MFYDBOoApFhDnl6Y28wX03Ww6FbYs6eFx21GaAJZonb",
+	"u6JnDJcB+Jfn4ETK8LI7eF8kzHME2x5Pm7NVaORVPl9UM77BskxpNtBhHR34OdAOwWFPMhx+zHWAz/e4",
+	"gBWD9Sp+HmOoe6KhrkBfNHDGLE0daZe+gJKC/8C927MEcs9oR0ZHMMFNun8QMU8G37So0FuNvyRx07tm",
+	"nyVLTo64Om80sYa/ZEShoVBG7y9JZCzPX1tavAaYI6l+9u9Vms9ycDNYH/QzIU2gHog0gwNwBt+7YA/8",
+	"0NP7U0iO3/ELl2AeeSEIPDyqwlBypFV3x8PA8WPLeUC4Xi8csriJdV+G2Y5J7/IVbPvgcoC2BwzJCLIo",
+	"nSAEXvQVZPwZmLGJJiPD9I0iK/OzjBGtmq2gwKpRJsebS9kaS4lCIaNxEsasOfoG+sRFdEHrz4FPan2Y",
+	"YlZpNl16993bZvMomKU9LciYz2yyZVU+esTYCTsKVmWl9XfIfiL4UNTUYXZT0Uj+IWYnKjyU38QrUzzT",
+	"9tnyfl6ffSVAUpnzSwkHxPsIP6D/Kp+Kv2Bz5edQ4gWEn32lBAHU1RhJth1RlsfywPaSzcC69Kygby1u",
+	"nG4OMXzgZ7RTkNoQ93u/AlcNahvxRtKOscn8Ahj7qU/ti/gqVPzqdPkqudbyNxpeLWTYyCc8onBKqteW",
+	"Fm8sLN9i3iWZgm6Tgavy9t9ijY3fREICDB2/6E+UmWBOYoA05gzLQMSM0QKejIKH+ZahJpNIkup1Kfy5",
+	"qhMcaBJvgCMohRHHgHqa9H552qXLqrexXhmjDl6MxDj/O0HrHRB57wQfvmMyxrQJQeKYdscLoqHsfafh",
+	"1Vc1WZxEl0xgn+lcNWt9om5kdXlucWUBVmlNfmAD8QUCS42XmFKShQeQh0eH78tYuOCSIWPyFg4OKoXe",
+	"4FH0wUVJDiUzPRQsJ0wwJbdLwQwZfBK84xm09dVcqQyuvpTGodkr9I6NiaE1yVHxySi0lxTYfwol9nvG",
+	"Q0G/VvZG0w5V9bVVN/RWBDMdsZlQnKFHrFiW9o7a9sAda7olvN+nmNZtNN3HFiUqJI3a0hw66PUUSEWh",
+	"S6qeCg7RyWtpGK4mQDnyFFP6ld4eiR6pfYC16bDGh+bikBQJ7VkftvSk79MXvPEQd8hDHvZRPF5XThlx",
+	"wVm7SmNXjuKWh7bxLUkD0pao+PF2xW3vf0z40bzCp4/YrVb3lYXHeWdwFb28YPS6F95sCVhutARkZX8Q",
+	"X+Fm6JkQLvy6Yku3DJf4e274C6DbO8hx36CiE78FmrbYE83V2oE4R0c5xL/exmaD1y5TLjRkXHqalv7O",
+	"G2w2sjoY/o/tdTfwXagLh+d8t90mW0Fr3R0jVnY1WS4XSdx2/JXahAXdgJgiBDAN39Au/15rA8HdJHiX",
+	"QOgxYUXymjuXexFid25H6TVmI+Kf4VJviqDOG6Lg+C1ZUl/uQmZpw01tt5UjZKemHyEQzINhzhAe9LRD",
+	"/C55As84ezgSLdWjx3h0gmE85T0gZUdeVY2PDmYTeJB8jEyPPPK8REItnLNR4FgoYq5/h4tBuAAQM8V8",
+	"XMWPYacjJoO+V9OKmN7Uulcl+clyuRBjq7yMpx59qewKPl93NwMQkuqvEl3ewbrts7RBnLvE5pHq1Ibj",
+	"NaokP1OeKkg1TXmVkdOlBJ7FZqfS+jKSxBsndnzNIGqP15Nv3ROw3GIHC4yXTv0pJmbsdh6Oo5B5LZf1",
+	"C5R2L3m74nRV799Y4gl6+3pEze9krRNvB62mG951t9vIaKX/EiQ1Uqy1V6FeXGIm4IEswJ9+K1VKebnw",
+	"TICLk3zYCp1GkWy33XqROPcdrwEoTizI9H30OQZlPhcT10v8XpA80x6LEvSpyCGL2e//F7/w4lac4sXH",
+	"IiZRvHcmeb5K6eLipVV8afFaVSHn05abIMvstLBFdIApqEM+qrus8WfGedqYD3SPDFtbrUZr8wGnD5J3",
+	"trbW6u6W69ddv/ZgjRFREawj45uGE+J/2/Duertofs+yIG2/ZN+s1d0QqNuu4d3i9DqQX4TuR+H4VoMX",
+	"ycUXMs5c+xH52fzN26TtIhhmew3JidEceIy7dhtG70b9I7L6t7fnzTE2ne1Nt+Lrn37MlveTCk/2rOR2",
+	"yMTM5FT6czwXFB6cnkx/jCeKwmOXK749gSPzUsdXOJOjxI+RpjyFJFPBEYL79sRbM6Dh+e3Q8WvJJtkf",
+	"3221w53ZjyEbZAfdLoEHtxtP+66MofAGoLlGq+Y08GNAaW0FxtdXyhPlnA2NiiUIoMfaQPGgHQK/Kl0p",
+	"T1yFPfxArvXjzDQJNPhMKzAPidnAH1hs9hiv6WEhziHFg7UAjQ1fN8oH4nqzZaQ/DO5xbvgH+JDoHrAM",
+	"+K29Gzqvsu6ht17ySF5yIYZUAmY7xQw1EGVXmxc2KDQbD8UJb+eDnf83ADPw29gJ9AAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
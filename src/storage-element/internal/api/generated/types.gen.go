@@ -13,6 +13,13 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for DiskInfoStatus.
+const (
+	DiskInfoStatusDraining DiskInfoStatus = "draining"
+	DiskInfoStatusFailed   DiskInfoStatus = "failed"
+	DiskInfoStatusOnline   DiskInfoStatus = "online"
+)
+
 // Defines values for FileMetadataRetentionPolicy.
 const (
 	Permanent FileMetadataRetentionPolicy = "permanent"
@@ -45,6 +52,15 @@ const (
 	HealthReadyResponseStatusOk       HealthReadyResponseStatus = "ok"
 )
 
+// Defines values for ImportIssueReason.
+const (
+	AlreadyExists  ImportIssueReason = "already_exists"
+	IntegrityError ImportIssueReason = "integrity_error"
+	InvalidEntry   ImportIssueReason = "invalid_entry"
+	StorageFull    ImportIssueReason = "storage_full"
+	WriteError     ImportIssueReason = "write_error"
+)
+
 // Defines values for ModeTransitionRequestTargetMode.
 const (
 	ModeTransitionRequestTargetModeAr ModeTransitionRequestTargetMode = "ar"
@@ -115,6 +131,12 @@ const (
 	StorageInfoStatusOnline      StorageInfoStatus = "online"
 )
 
+// Defines values for SubjectQuotaUsageQuotaSource.
+const (
+	Default SubjectQuotaUsageQuotaSource = "default"
+	Subject SubjectQuotaUsageQuotaSource = "subject"
+)
+
 // Defines values for ListFilesParamsStatus.
 const (
 	ListFilesParamsStatusActive  ListFilesParamsStatus = "active"
@@ -122,6 +144,37 @@ const (
 	ListFilesParamsStatusExpired ListFilesParamsStatus = "expired"
 )
 
+// Defines values for ExportArchiveParamsStatus.
+const (
+	ExportArchiveParamsStatusActive  ExportArchiveParamsStatus = "active"
+	ExportArchiveParamsStatusDeleted ExportArchiveParamsStatus = "deleted"
+	ExportArchiveParamsStatusExpired ExportArchiveParamsStatus = "expired"
+)
+
+// ArchiveStatus Состояние холодного архива
+type ArchiveStatus struct {
+	// ArchivedFiles Количество файлов, упакованных в бандлы
+	ArchivedFiles int `json:"archived_files"`
+
+	// Bundles Количество архивных бандлов
+	Bundles int `json:"bundles"`
+
+	// CompressedBytes Объём бандлов на диске
+	CompressedBytes int64 `json:"compressed_bytes"`
+
+	// OriginalBytes Исходный объём упакованных файлов
+	OriginalBytes int64 `json:"original_bytes"`
+
+	// Packing Выполняется упаковка файлов в бандлы
+	Packing bool `json:"packing"`
+
+	// PendingFiles Количество файлов, ожидающих упаковки
+	PendingFiles int `json:"pending_files"`
+
+	// RestoredFiles Количество действующих восстановленных копий
+	RestoredFiles int `json:"restored_files"`
+}
+
 // CapacityInfo Информация об ёмкости хранилища
 type CapacityInfo struct {
 	// AvailableBytes Доступный объём в байтах
@@ -134,6 +187,72 @@ type CapacityInfo struct {
 	UsedBytes int64 `json:"used_bytes"`
 }
 
+// DiskInfo Состояние директории данных (диска) SE.
+// Ёмкость — физические значения файловой системы (statfs),
+// в отличие от логического лимита в CapacityInfo.
+type DiskInfo struct {
+	// AvailableBytes Доступно для записи в байтах
+	AvailableBytes int64 `json:"available_bytes"`
+
+	// FilesCount Количество файлов данных SE на диске
+	FilesCount int `json:"files_count"`
+
+	// Path Путь к директории данных
+	Path string `json:"path"`
+
+	// Primary Основная директория (`SE_DATA_DIR`). Содержит служебные файлы
+	// (mode.json, leader lock); её отказ переводит SE в `fail`.
+	Primary bool `json:"primary"`
+
+	// Status Состояние диска:
+	// - `online` — доступен для чтения и размещения новых файлов
+	// - `failed` — недоступен на запись, исключён из размещения
+	// - `draining` — файлы переносятся (или перенесены) на другие диски,
+	//   новые файлы не размещаются
+	Status DiskInfoStatus `json:"status"`
+
+	// TotalBytes Размер файловой системы в байтах
+	TotalBytes int64 `json:"total_bytes"`
+
+	// UsedBytes Занято на файловой системе в байтах
+	UsedBytes int64 `json:"used_bytes"`
+}
+
+// DiskInfoStatus Состояние диска:
+//   - `online` — доступен для чтения и размещения новых файлов
+//   - `failed` — недоступен на запись, исключён из размещения
+//   - `draining` — файлы переносятся (или перенесены) на другие диски,
+//     новые файлы не размещаются
+type DiskInfoStatus string
+
+// DrainRequest Запрос переноса файлов с диска
+type DrainRequest struct {
+	// Disk Путь к директории данных (как в `DiskInfo.path`)
+	Disk string `json:"disk"`
+}
+
+// DrainResponse Результат переноса файлов с диска
+type DrainResponse struct {
+	// BytesMoved Объём перенесённых данных в байтах
+	BytesMoved int64 `json:"bytes_moved"`
+
+	// CompletedAt Время завершения переноса
+	CompletedAt time.Time `json:"completed_at"`
+
+	// Disk Путь к директории данных
+	Disk string `json:"disk"`
+
+	// FilesFailed Количество файлов, которые не удалось перенести
+	// (остаются на диске, подробности — в логах SE)
+	FilesFailed int `json:"files_failed"`
+
+	// FilesMoved Количество перенесённых файлов
+	FilesMoved int `json:"files_moved"`
+
+	// StartedAt Время начала переноса
+	StartedAt time.Time `json:"started_at"`
+}
+
 // ErrorResponse Стандартный формат ошибки (единый для всей системы Artstore)
 type ErrorResponse struct {
 	Error struct {
@@ -149,6 +268,7 @@ type ErrorResponse struct {
 		// - `FILE_TOO_LARGE` — файл превышает лимит
 		// - `STORAGE_FULL` — нет свободного места
 		// - `RECONCILE_IN_PROGRESS` — сверка уже выполняется
+		// - `DRAIN_IN_PROGRESS` — перенос файлов с диска уже выполняется
 		// - `INTERNAL_ERROR` — внутренняя ошибка
 		Code string `json:"code"`
 
@@ -175,9 +295,17 @@ type FileListResponse struct {
 
 // FileMetadata Метаданные файла (соответствует содержимому attr.json)
 type FileMetadata struct {
+	// Archived Файл упакован в холодный архив (режим `ar`). Для скачивания
+	// требуется `POST /api/v1/files/{file_id}/restore`.
+	Archived *bool `json:"archived,omitempty"`
+
 	// Checksum SHA-256 хэш содержимого файла
 	Checksum string `json:"checksum"`
 
+	// CollectionId Коллекция (namespace) файла в Admin Module. Отсутствует у файлов
+	// вне коллекций. Задаётся при загрузке и не изменяется.
+	CollectionId *openapi_types.UUID `json:"collection_id,omitempty"`
+
 	// ContentType MIME-тип файла
 	ContentType string `json:"content_type"`
 
@@ -216,6 +344,11 @@ type FileMetadata struct {
 	// `null` для permanent файлов.
 	TtlDays *int `json:"ttl_days"`
 
+	// UpdatedAt Дата и время последнего изменения метаданных (ISO 8601, UTC):
+	// загрузка, обновление description/tags, смена статуса, архивация,
+	// импорт. Для файлов, загруженных до появления поля, равно `uploaded_at`.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
 	// UploadedAt Дата и время загрузки (ISO 8601, UTC)
 	UploadedAt time.Time `json:"uploaded_at"`
 
@@ -291,6 +424,55 @@ type HealthReadyResponse struct {
 // - `fail` (503) — не готов
 type HealthReadyResponseStatus string
 
+// ImportIssue Файл архива, который не был импортирован
+type ImportIssue struct {
+	// Description Описание проблемы
+	Description string `json:"description"`
+
+	// FileId ID файла (если известен)
+	FileId *openapi_types.UUID `json:"file_id"`
+
+	// Path Имя записи в архиве
+	Path *string `json:"path,omitempty"`
+
+	// Reason Причина:
+	// - `already_exists` — файл с таким file_id уже есть на SE
+	// - `integrity_error` — checksum или размер не совпадают с attr.json
+	// - `invalid_entry` — некорректная запись архива или attr.json
+	// - `storage_full` — превышен лимит ёмкости SE
+	// - `write_error` — ошибка записи на диск
+	Reason ImportIssueReason `json:"reason"`
+}
+
+// ImportIssueReason Причина:
+// - `already_exists` — файл с таким file_id уже есть на SE
+// - `integrity_error` — checksum или размер не совпадают с attr.json
+// - `invalid_entry` — некорректная запись архива или attr.json
+// - `storage_full` — превышен лимит ёмкости SE
+// - `write_error` — ошибка записи на диск
+type ImportIssueReason string
+
+// ImportResponse Результат импорта tar-архива
+type ImportResponse struct {
+	// CompletedAt Время завершения импорта
+	CompletedAt time.Time `json:"completed_at"`
+
+	// Failed Количество файлов, которые не удалось импортировать
+	Failed int `json:"failed"`
+
+	// Imported Количество импортированных файлов
+	Imported int `json:"imported"`
+
+	// Issues Пропущенные и неимпортированные файлы
+	Issues []ImportIssue `json:"issues"`
+
+	// Skipped Количество пропущенных файлов (file_id уже существует)
+	Skipped int `json:"skipped"`
+
+	// StartedAt Время начала импорта
+	StartedAt time.Time `json:"started_at"`
+}
+
 // ModeTransitionRequest Запрос на смену режима работы
 type ModeTransitionRequest struct {
 	// Confirm Подтверждение обратного перехода. Обязательно для отката
@@ -333,6 +515,15 @@ type ModeTransitionResponseCurrentMode string
 // ModeTransitionResponsePreviousMode Предыдущий режим
 type ModeTransitionResponsePreviousMode string
 
+// QuotaUsageList Использование квот по субъектам
+type QuotaUsageList struct {
+	// Items Субъекты, отсортированные по занятому объёму (по убыванию)
+	Items []SubjectQuotaUsage `json:"items"`
+
+	// QuotaFile Путь к файлу квот на SE
+	QuotaFile string `json:"quota_file"`
+}
+
 // ReconcileIssue Отдельная проблема, обнаруженная при сверке
 type ReconcileIssue struct {
 	// Description Описание проблемы
@@ -397,6 +588,18 @@ type ReconcileSummary struct {
 	SizeMismatches int `json:"size_mismatches"`
 }
 
+// RestoreResponse Результат восстановления файла из холодного архива
+type RestoreResponse struct {
+	// ExpiresAt Время удаления восстановленной копии
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// FileId Идентификатор файла
+	FileId openapi_types.UUID `json:"file_id"`
+
+	// RestoredAt Время восстановления (или продления) копии
+	RestoredAt time.Time `json:"restored_at"`
+}
+
 // StorageInfo Информация о Storage Element для discovery и мониторинга
 type StorageInfo struct {
 	// AllowedOperations Список операций, доступных в текущем режиме и роли
@@ -405,6 +608,10 @@ type StorageInfo struct {
 	// Capacity Информация об ёмкости хранилища
 	Capacity CapacityInfo `json:"capacity"`
 
+	// Disks Директории данных SE (JBOD). Первая — основная (`SE_DATA_DIR`),
+	// остальные — из `SE_DATA_DIRS`.
+	Disks *[]DiskInfo `json:"disks,omitempty"`
+
 	// Mode Текущий режим работы
 	Mode StorageInfoMode `json:"mode"`
 
@@ -420,7 +627,8 @@ type StorageInfo struct {
 	// Status Текущий статус (унифицирован с Admin Module):
 	// - `online` — полностью работоспособен
 	// - `offline` — недоступен
-	// - `degraded` — работает с ограничениями
+	// - `degraded` — работает с ограничениями (например, отказал
+	//   один из дисков данных)
 	// - `maintenance` — на обслуживании
 	Status StorageInfoStatus `json:"status"`
 
@@ -448,12 +656,39 @@ type StorageInfoReplicaMode string
 type StorageInfoRole string
 
 // StorageInfoStatus Текущий статус (унифицирован с Admin Module):
-// - `online` — полностью работоспособен
-// - `offline` — недоступен
-// - `degraded` — работает с ограничениями
-// - `maintenance` — на обслуживании
+//   - `online` — полностью работоспособен
+//   - `offline` — недоступен
+//   - `degraded` — работает с ограничениями (например, отказал
+//     один из дисков данных)
+//   - `maintenance` — на обслуживании
 type StorageInfoStatus string
 
+// SubjectQuotaUsage Использование квоты одним субъектом
+type SubjectQuotaUsage struct {
+	// MaxBytes Лимит объёма (отсутствует — без ограничения)
+	MaxBytes *int64 `json:"max_bytes,omitempty"`
+
+	// MaxFiles Лимит количества файлов (отсутствует — без ограничения)
+	MaxFiles *int `json:"max_files,omitempty"`
+
+	// QuotaSource Источник лимита: собственная запись субъекта или `default`.
+	// Отсутствует, если квота к субъекту не применяется.
+	QuotaSource *SubjectQuotaUsageQuotaSource `json:"quota_source,omitempty"`
+
+	// Subject Субъект (sub из JWT, `uploaded_by` файлов)
+	Subject string `json:"subject"`
+
+	// UsedBytes Суммарный размер active файлов субъекта
+	UsedBytes int64 `json:"used_bytes"`
+
+	// UsedFiles Количество active файлов субъекта
+	UsedFiles int `json:"used_files"`
+}
+
+// SubjectQuotaUsageQuotaSource Источник лимита: собственная запись субъекта или `default`.
+// Отсутствует, если квота к субъекту не применяется.
+type SubjectQuotaUsageQuotaSource string
+
 // FileId defines model for FileId.
 type FileId = openapi_types.UUID
 
@@ -479,6 +714,11 @@ type ListFilesParams struct {
 
 	// Status Фильтр по статусу файла
 	Status *ListFilesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// UpdatedSince Только файлы, метаданные которых изменены не раньше указанного
+	// момента (`updated_at >= updated_since`, ISO 8601). Используется
+	// Admin Module для инкрементальной синхронизации файлового реестра.
+	UpdatedSince *time.Time `form:"updated_since,omitempty" json:"updated_since,omitempty"`
 }
 
 // ListFilesParamsStatus defines parameters for ListFiles.
@@ -486,6 +726,14 @@ type ListFilesParamsStatus string
 
 // UploadFileMultipartBody defines parameters for UploadFile.
 type UploadFileMultipartBody struct {
+	// CollectionId Коллекция (namespace) файла. Сохраняется в attr.json и
+	// переносится в реестр Admin Module при синхронизации.
+	// Допускаются коллекции из claim `artstore_collections`
+	// токена (право write участника коллекции, claim формирует
+	// Admin Module) или любая коллекция при scope `storage:write`.
+	// Квоты коллекций проверяет Admin Module при регистрации.
+	CollectionId *openapi_types.UUID `json:"collection_id,omitempty"`
+
 	// Description Описание файла
 	Description *string `json:"description,omitempty"`
 
@@ -494,6 +742,11 @@ type UploadFileMultipartBody struct {
 
 	// Tags JSON-массив тегов. Передаётся как строка в multipart.
 	Tags *string `json:"tags,omitempty"`
+
+	// UploadedBy Автор файла вместо subject токена. Допускается только
+	// при scope `storage:write`: Admin Module загружает файлы
+	// коллекций от имени пользователя Admin UI своим токеном.
+	UploadedBy *string `json:"uploaded_by,omitempty"`
 }
 
 // DownloadFileParams defines parameters for DownloadFile.
@@ -503,11 +756,29 @@ type DownloadFileParams struct {
 	Range *string `json:"Range,omitempty"`
 }
 
+// ExportArchiveParams defines parameters for ExportArchive.
+type ExportArchiveParams struct {
+	// Status Фильтр по статусу файла (по умолчанию — все статусы)
+	Status *ExportArchiveParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// UploadedAfter Только файлы, загруженные после указанной даты (ISO 8601)
+	UploadedAfter *time.Time `form:"uploaded_after,omitempty" json:"uploaded_after,omitempty"`
+
+	// UploadedBefore Только файлы, загруженные до указанной даты (ISO 8601)
+	UploadedBefore *time.Time `form:"uploaded_before,omitempty" json:"uploaded_before,omitempty"`
+}
+
+// ExportArchiveParamsStatus defines parameters for ExportArchive.
+type ExportArchiveParamsStatus string
+
 // UploadFileMultipartRequestBody defines body for UploadFile for multipart/form-data ContentType.
 type UploadFileMultipartRequestBody UploadFileMultipartBody
 
 // UpdateFileMetadataJSONRequestBody defines body for UpdateFileMetadata for application/json ContentType.
 type UpdateFileMetadataJSONRequestBody = FileMetadataUpdate
 
+// DrainDiskJSONRequestBody defines body for DrainDisk for application/json ContentType.
+type DrainDiskJSONRequestBody = DrainRequest
+
 // TransitionModeJSONRequestBody defines body for TransitionMode for application/json ContentType.
 type TransitionModeJSONRequestBody = ModeTransitionRequest
@@ -0,0 +1,98 @@
+// archive.go — обработчики GET /api/v1/maintenance/export
+// и POST /api/v1/maintenance/import.
+// Делегируют работу с tar-архивом в ArchiveService.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	apierrors "github.com/bigkaa/goartstore/storage-element/internal/api/errors"
+	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/model"
+	"github.com/bigkaa/goartstore/storage-element/internal/service"
+)
+
+// ArchiveHandler — обработчик экспорта и импорта архивов.
+type ArchiveHandler struct {
+	archiveSvc *service.ArchiveService
+	storageID  string
+	logger     *slog.Logger
+}
+
+// NewArchiveHandler создаёт обработчик экспорта и импорта архивов.
+func NewArchiveHandler(archiveSvc *service.ArchiveService, storageID string, logger *slog.Logger) *ArchiveHandler {
+	return &ArchiveHandler{
+		archiveSvc: archiveSvc,
+		storageID:  storageID,
+		logger:     logger.With(slog.String("component", "archive_handler")),
+	}
+}
+
+// ExportArchive обрабатывает GET /api/v1/maintenance/export.
+// Отдаёт tar-архив потоково; размер архива заранее неизвестен.
+func (h *ArchiveHandler) ExportArchive(w http.ResponseWriter, r *http.Request, params generated.ExportArchiveParams) {
+	filter := service.ExportFilter{
+		UploadedAfter:  params.UploadedAfter,
+		UploadedBefore: params.UploadedBefore,
+	}
+
+	if params.Status != nil {
+		filter.Status = model.FileStatus(string(*params.Status))
+		switch filter.Status {
+		case model.StatusActive, model.StatusDeleted, model.StatusExpired:
+			// ok
+		default:
+			apierrors.ValidationError(w, fmt.Sprintf("Недопустимый статус: %s", filter.Status))
+			return
+		}
+	}
+
+	if filter.UploadedAfter != nil && filter.UploadedBefore != nil &&
+		filter.UploadedAfter.After(*filter.UploadedBefore) {
+		apierrors.ValidationError(w, "uploaded_after не может быть позже uploaded_before")
+		return
+	}
+
+	// Экспорт большого хранилища длится дольше HTTP write timeout
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	filename := fmt.Sprintf("%s-%s.tar", h.storageID, time.Now().UTC().Format("20060102150405"))
+	w.Header().Set("Content-Type", "application/x-tar")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+
+	// Заголовки уже отправлены — ошибку можно только залогировать,
+	// клиент увидит обрыв потока (незавершённый tar)
+	if _, err := h.archiveSvc.Export(w, filter); err != nil {
+		h.logger.Error("Экспорт прерван",
+			slog.String("remote_addr", r.RemoteAddr),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// ImportArchive обрабатывает POST /api/v1/maintenance/import.
+// Тело запроса — tar-архив в формате экспорта.
+func (h *ArchiveHandler) ImportArchive(w http.ResponseWriter, r *http.Request) {
+	// Загрузка большого архива длится дольше HTTP read timeout
+	_ = http.NewResponseController(w).SetReadDeadline(time.Time{})
+
+	result, err := h.archiveSvc.Import(r.Body)
+	if err != nil {
+		if errors.Is(err, service.ErrImportModeNotAllowed) {
+			apierrors.ModeNotAllowed(w, err.Error())
+			return
+		}
+		apierrors.InternalError(w, "Ошибка импорта архива")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,70 @@
+// cold.go — обработчики холодного архива (режим ar):
+// POST /api/v1/files/{file_id}/restore и GET /api/v1/archive.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	apierrors "github.com/bigkaa/goartstore/storage-element/internal/api/errors"
+	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
+	"github.com/bigkaa/goartstore/storage-element/internal/service"
+)
+
+// ColdHandler — обработчик endpoints холодного архива.
+type ColdHandler struct {
+	coldSvc *service.ColdService
+	logger  *slog.Logger
+}
+
+// NewColdHandler создаёт обработчик endpoints холодного архива.
+func NewColdHandler(coldSvc *service.ColdService, logger *slog.Logger) *ColdHandler {
+	return &ColdHandler{
+		coldSvc: coldSvc,
+		logger:  logger.With(slog.String("component", "cold_handler")),
+	}
+}
+
+// RestoreFile обрабатывает POST /api/v1/files/{file_id}/restore.
+// Распаковка выполняется синхронно — размер ответа не зависит от размера файла.
+func (h *ColdHandler) RestoreFile(w http.ResponseWriter, _ *http.Request, fileID generated.FileId) {
+	resp, err := h.coldSvc.Restore(fileID.String())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotArchiveMode):
+			apierrors.ModeNotAllowed(w, "Restore доступен только в режиме ar")
+		case errors.Is(err, service.ErrFileNotFound):
+			apierrors.NotFound(w, fmt.Sprintf("Файл %s не найден", fileID))
+		default:
+			h.logger.Error("Ошибка восстановления файла из архива",
+				slog.String("file_id", fileID.String()),
+				slog.String("error", err.Error()),
+			)
+			apierrors.InternalError(w, "Ошибка восстановления файла из архива")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// GetArchiveStatus обрабатывает GET /api/v1/archive.
+func (h *ColdHandler) GetArchiveStatus(w http.ResponseWriter, _ *http.Request) {
+	status, err := h.coldSvc.Status()
+	if err != nil {
+		h.logger.Error("Ошибка чтения состояния холодного архива",
+			slog.String("error", err.Error()),
+		)
+		apierrors.InternalError(w, "Ошибка чтения состояния холодного архива")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(status)
+}
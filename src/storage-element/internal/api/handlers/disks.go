@@ -0,0 +1,63 @@
+// disks.go — обработчик POST /api/v1/maintenance/drain.
+// Делегирует перенос файлов с диска в DiskService.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apierrors "github.com/bigkaa/goartstore/storage-element/internal/api/errors"
+	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
+	"github.com/bigkaa/goartstore/storage-element/internal/service"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
+)
+
+// DiskHandler — обработчик endpoints управления дисками.
+type DiskHandler struct {
+	diskSvc *service.DiskService
+}
+
+// NewDiskHandler создаёт обработчик endpoints управления дисками.
+func NewDiskHandler(diskSvc *service.DiskService) *DiskHandler {
+	return &DiskHandler{diskSvc: diskSvc}
+}
+
+// DrainDisk обрабатывает POST /api/v1/maintenance/drain.
+// Синхронно переносит файлы с диска и возвращает результат.
+func (h *DiskHandler) DrainDisk(w http.ResponseWriter, r *http.Request) {
+	var req generated.DrainRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierrors.ValidationError(w, "Некорректный JSON: "+err.Error())
+		return
+	}
+	if strings.TrimSpace(req.Disk) == "" {
+		apierrors.ValidationError(w, "Поле disk обязательно")
+		return
+	}
+
+	// Перенос большого диска длится дольше HTTP write timeout
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	result, err := h.diskSvc.Drain(req.Disk)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrDrainInProgress):
+			apierrors.DrainInProgress(w, "Перенос файлов уже выполняется")
+		case errors.Is(err, filestore.ErrDiskNotFound):
+			apierrors.NotFound(w, fmt.Sprintf("Диск %s не найден", req.Disk))
+		case errors.Is(err, filestore.ErrNoDiskAvailable):
+			apierrors.StorageFull(w, "Нет доступных дисков для переноса файлов")
+		default:
+			apierrors.InternalError(w, "Ошибка переноса файлов")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(result)
+}
@@ -6,16 +6,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
+	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/bigkaa/goartstore/storage-element/internal/api/errors"
 	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
 	"github.com/bigkaa/goartstore/storage-element/internal/api/middleware"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/grants"
 	"github.com/bigkaa/goartstore/storage-element/internal/domain/mode"
 	"github.com/bigkaa/goartstore/storage-element/internal/domain/model"
 	"github.com/bigkaa/goartstore/storage-element/internal/service"
-	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
 )
@@ -47,11 +49,20 @@ func NewFilesHandler(
 }
 
 // UploadFile обрабатывает POST /api/v1/files/upload.
-// Multipart form: file (обязательно), description (опционально), tags (опционально, JSON).
+// Multipart form: file (обязательно), description (опционально), tags (опционально, JSON),
+// collection_id (опционально, UUID), uploaded_by (опционально, только со
+// scope storage:write).
 func (h *FilesHandler) UploadFile(w http.ResponseWriter, r *http.Request) {
 	// Извлекаем subject из JWT контекста
 	subject := middleware.SubjectFromContext(r.Context())
 
+	// Grants: файл получит политику хранения текущего режима
+	retention := string(h.uploadSvc.RetentionPolicy())
+	if !middleware.AllowsGrant(r.Context(), grants.ScopeFilesWrite, grants.Access{Retention: retention}) {
+		errors.Forbidden(w, "Недостаточно прав: политика хранения "+retention+" вне grants токена")
+		return
+	}
+
 	// Парсим multipart form (ограничение по MaxFileSize + запас на заголовки)
 	if err := r.ParseMultipartForm(32 << 20); err != nil { // 32 MB buffer
 		errors.ValidationError(w, fmt.Sprintf("Ошибка парсинга multipart: %s", err.Error()))
@@ -75,6 +86,17 @@ func (h *FilesHandler) UploadFile(w http.ResponseWriter, r *http.Request) {
 	// Извлекаем опциональные поля
 	description := r.FormValue("description")
 	tagsJSON := r.FormValue("tags")
+	collectionID := r.FormValue("collection_id")
+
+	// Автор файла от имени пользователя: Admin Module загружает файлы
+	// коллекций своим токеном и передаёт subject пользователя
+	if uploadedBy := r.FormValue("uploaded_by"); uploadedBy != "" {
+		if !slices.Contains(middleware.ScopesFromContext(r.Context()), middleware.ScopeStorageWrite) {
+			errors.Forbidden(w, "Недостаточно прав: uploaded_by допускается только со scope "+middleware.ScopeStorageWrite)
+			return
+		}
+		subject = uploadedBy
+	}
 
 	// Вызываем сервис загрузки
 	result, uploadErr := h.uploadSvc.Upload(service.UploadParams{
@@ -83,8 +105,11 @@ func (h *FilesHandler) UploadFile(w http.ResponseWriter, r *http.Request) {
 		ContentType:      contentType,
 		Size:             header.Size,
 		UploadedBy:       subject,
+		Scopes:           middleware.ScopesFromContext(r.Context()),
 		Description:      description,
 		TagsJSON:         tagsJSON,
+		CollectionID:     collectionID,
+		Collections:      middleware.CollectionsFromContext(r.Context()),
 	})
 
 	if uploadErr != nil {
@@ -103,6 +128,11 @@ func (h *FilesHandler) UploadFile(w http.ResponseWriter, r *http.Request) {
 // DownloadFile обрабатывает GET /api/v1/files/{file_id}/download.
 // Поддерживает Range requests (206) и ETag (If-None-Match → 304).
 func (h *FilesHandler) DownloadFile(w http.ResponseWriter, r *http.Request, fileId generated.FileId, _ generated.DownloadFileParams) { //nolint:revive // имя fileId задано сгенерированным интерфейсом
+	if meta := h.idx.Get(fileId.String()); meta != nil && !allowsFile(r, grants.ScopeFilesRead, meta) {
+		errors.Forbidden(w, "Недостаточно прав: файл вне grants токена")
+		return
+	}
+
 	downloadErr := h.downloadSvc.Serve(w, r, fileId.String())
 	if downloadErr != nil {
 		errors.WriteError(w, downloadErr.StatusCode, downloadErr.Code, downloadErr.Message)
@@ -111,7 +141,7 @@ func (h *FilesHandler) DownloadFile(w http.ResponseWriter, r *http.Request, file
 
 // ListFiles обрабатывает GET /api/v1/files.
 // Пагинация: limit, offset. Фильтр: status.
-func (h *FilesHandler) ListFiles(w http.ResponseWriter, _ *http.Request, params generated.ListFilesParams) {
+func (h *FilesHandler) ListFiles(w http.ResponseWriter, r *http.Request, params generated.ListFilesParams) {
 	// Значения по умолчанию
 	limit := 50
 	offset := 0
@@ -145,8 +175,21 @@ func (h *FilesHandler) ListFiles(w http.ResponseWriter, _ *http.Request, params
 		}
 	}
 
-	// Получаем данные из индекса
-	items, total := h.idx.List(limit, offset, statusFilter)
+	var updatedSince time.Time
+	if params.UpdatedSince != nil {
+		updatedSince = *params.UpdatedSince
+	}
+
+	// Получаем данные из индекса (с учётом grants токена)
+	var items []*model.FileMetadata
+	var total int
+	if middleware.GrantsFromContext(r.Context()).Restricted(grants.ScopeFilesRead) {
+		items, total = h.idx.ListMatching(limit, offset, statusFilter, updatedSince, func(m *model.FileMetadata) bool {
+			return allowsFile(r, grants.ScopeFilesRead, m)
+		})
+	} else {
+		items, total = h.idx.ListUpdatedSince(limit, offset, statusFilter, updatedSince)
+	}
 
 	// Преобразуем в API-формат
 	apiItems := make([]generated.FileMetadata, 0, len(items))
@@ -170,12 +213,16 @@ func (h *FilesHandler) ListFiles(w http.ResponseWriter, _ *http.Request, params
 }
 
 // GetFileMetadata обрабатывает GET /api/v1/files/{file_id}.
-func (h *FilesHandler) GetFileMetadata(w http.ResponseWriter, _ *http.Request, fileId generated.FileId) { //nolint:revive // имя fileId задано сгенерированным интерфейсом
+func (h *FilesHandler) GetFileMetadata(w http.ResponseWriter, r *http.Request, fileId generated.FileId) { //nolint:revive // имя fileId задано сгенерированным интерфейсом
 	meta := h.idx.Get(fileId.String())
 	if meta == nil {
 		errors.NotFound(w, fmt.Sprintf("Файл %s не найден", fileId.String()))
 		return
 	}
+	if !allowsFile(r, grants.ScopeFilesRead, meta) {
+		errors.Forbidden(w, "Недостаточно прав: файл вне grants токена")
+		return
+	}
 
 	resp := domainToAPIMetadata(meta)
 
@@ -212,6 +259,10 @@ func (h *FilesHandler) UpdateFileMetadata(w http.ResponseWriter, r *http.Request
 		errors.NotFound(w, fmt.Sprintf("Файл %s не найден", fileId.String()))
 		return
 	}
+	if !allowsFile(r, grants.ScopeFilesWrite, meta) {
+		errors.Forbidden(w, "Недостаточно прав: файл вне grants токена")
+		return
+	}
 
 	// Проверяем статус
 	if meta.Status != model.StatusActive {
@@ -228,8 +279,7 @@ func (h *FilesHandler) UpdateFileMetadata(w http.ResponseWriter, r *http.Request
 	}
 
 	// Записываем обновлённый attr.json
-	attrPath := attr.AttrFilePath(h.store.FullPath(meta.StoragePath))
-	if err := attr.Write(attrPath, meta); err != nil {
+	if err := h.store.WriteAttr(meta.StoragePath, meta); err != nil {
 		errors.InternalError(w, "Ошибка обновления метаданных на диске")
 		return
 	}
@@ -250,7 +300,7 @@ func (h *FilesHandler) UpdateFileMetadata(w http.ResponseWriter, r *http.Request
 // DeleteFile обрабатывает DELETE /api/v1/files/{file_id}.
 // Soft delete: помечает файл как deleted (физическое удаление — GC).
 // Доступно только в режиме edit.
-func (h *FilesHandler) DeleteFile(w http.ResponseWriter, _ *http.Request, fileId generated.FileId) { //nolint:revive // имя fileId задано сгенерированным интерфейсом
+func (h *FilesHandler) DeleteFile(w http.ResponseWriter, r *http.Request, fileId generated.FileId) { //nolint:revive // имя fileId задано сгенерированным интерфейсом
 	// Проверяем допустимость delete
 	if !h.sm.CanPerform(mode.OpDelete) {
 		errors.ModeNotAllowed(w, fmt.Sprintf("Удаление файлов недоступно в режиме %s", h.sm.CurrentMode()))
@@ -263,6 +313,10 @@ func (h *FilesHandler) DeleteFile(w http.ResponseWriter, _ *http.Request, fileId
 		errors.NotFound(w, fmt.Sprintf("Файл %s не найден", fileId.String()))
 		return
 	}
+	if !allowsFile(r, grants.ScopeFilesWrite, meta) {
+		errors.Forbidden(w, "Недостаточно прав: файл вне grants токена")
+		return
+	}
 
 	// Проверяем статус
 	if meta.Status == model.StatusDeleted {
@@ -274,8 +328,7 @@ func (h *FilesHandler) DeleteFile(w http.ResponseWriter, _ *http.Request, fileId
 	meta.Status = model.StatusDeleted
 
 	// Записываем обновлённый attr.json
-	attrPath := attr.AttrFilePath(h.store.FullPath(meta.StoragePath))
-	if err := attr.Write(attrPath, meta); err != nil {
+	if err := h.store.WriteAttr(meta.StoragePath, meta); err != nil {
 		errors.InternalError(w, "Ошибка обновления метаданных на диске")
 		return
 	}
@@ -293,6 +346,15 @@ func (h *FilesHandler) DeleteFile(w http.ResponseWriter, _ *http.Request, fileId
 
 // domainToAPIMetadata преобразует доменную модель в API-формат.
 // Исключает поле StoragePath (внутреннее).
+// allowsFile проверяет grants токена для файла (политика хранения и
+// загрузивший). Допуск к самому SE проверяет middleware.RequireSEGrant.
+func allowsFile(r *http.Request, scope string, m *model.FileMetadata) bool {
+	return middleware.AllowsGrant(r.Context(), scope, grants.Access{
+		Retention:  string(m.RetentionPolicy),
+		UploadedBy: m.UploadedBy,
+	})
+}
+
 func domainToAPIMetadata(m *model.FileMetadata) generated.FileMetadata {
 	fileID := openapi_types.UUID{}
 	_ = fileID.UnmarshalText([]byte(m.FileID))
@@ -311,6 +373,9 @@ func domainToAPIMetadata(m *model.FileMetadata) generated.FileMetadata {
 		ExpiresAt:        m.ExpiresAt,
 	}
 
+	changedAt := m.ChangedAt()
+	result.UpdatedAt = &changedAt
+
 	// Описание
 	if m.Description != "" {
 		desc := m.Description
@@ -324,5 +389,19 @@ func domainToAPIMetadata(m *model.FileMetadata) generated.FileMetadata {
 		result.Tags = &tags
 	}
 
+	// Коллекция
+	if m.CollectionID != "" {
+		collectionID := openapi_types.UUID{}
+		if err := collectionID.UnmarshalText([]byte(m.CollectionID)); err == nil {
+			result.CollectionId = &collectionID
+		}
+	}
+
+	// Холодный архив
+	if m.IsArchived() {
+		archived := true
+		result.Archived = &archived
+	}
+
 	return result
 }
@@ -16,6 +16,10 @@ type APIHandler struct {
 	system      *SystemHandler
 	modeHandler *ModeHandler
 	maintenance *MaintenanceHandler
+	archive     *ArchiveHandler
+	disks       *DiskHandler
+	quotas      *QuotaHandler
+	cold        *ColdHandler
 	health      *HealthHandler
 	metrics     *server.MetricsHandler
 }
@@ -26,6 +30,10 @@ func NewAPIHandler(
 	system *SystemHandler,
 	modeHandler *ModeHandler,
 	maintenance *MaintenanceHandler,
+	archive *ArchiveHandler,
+	disks *DiskHandler,
+	quotas *QuotaHandler,
+	cold *ColdHandler,
 	health *HealthHandler,
 	metrics *server.MetricsHandler,
 ) *APIHandler {
@@ -34,6 +42,10 @@ func NewAPIHandler(
 		system:      system,
 		modeHandler: modeHandler,
 		maintenance: maintenance,
+		archive:     archive,
+		disks:       disks,
+		quotas:      quotas,
+		cold:        cold,
 		health:      health,
 		metrics:     metrics,
 	}
@@ -65,12 +77,24 @@ func (h *APIHandler) DownloadFile(w http.ResponseWriter, r *http.Request, fileId
 	h.files.DownloadFile(w, r, fileId, params)
 }
 
+func (h *APIHandler) RestoreFile(w http.ResponseWriter, r *http.Request, fileId generated.FileId) { //nolint:revive // имя fileId задано сгенерированным интерфейсом
+	h.cold.RestoreFile(w, r, fileId)
+}
+
 // --- System ---
 
 func (h *APIHandler) GetStorageInfo(w http.ResponseWriter, r *http.Request) {
 	h.system.GetStorageInfo(w, r)
 }
 
+func (h *APIHandler) GetQuotaUsage(w http.ResponseWriter, r *http.Request) {
+	h.quotas.GetQuotaUsage(w, r)
+}
+
+func (h *APIHandler) GetArchiveStatus(w http.ResponseWriter, r *http.Request) {
+	h.cold.GetArchiveStatus(w, r)
+}
+
 // --- Mode ---
 
 func (h *APIHandler) TransitionMode(w http.ResponseWriter, r *http.Request) {
@@ -83,6 +107,18 @@ func (h *APIHandler) Reconcile(w http.ResponseWriter, r *http.Request) {
 	h.maintenance.Reconcile(w, r)
 }
 
+func (h *APIHandler) ExportArchive(w http.ResponseWriter, r *http.Request, params generated.ExportArchiveParams) {
+	h.archive.ExportArchive(w, r, params)
+}
+
+func (h *APIHandler) ImportArchive(w http.ResponseWriter, r *http.Request) {
+	h.archive.ImportArchive(w, r)
+}
+
+func (h *APIHandler) DrainDisk(w http.ResponseWriter, r *http.Request) {
+	h.disks.DrainDisk(w, r)
+}
+
 // --- Health ---
 
 func (h *APIHandler) HealthLive(w http.ResponseWriter, r *http.Request) {
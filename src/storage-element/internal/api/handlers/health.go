@@ -9,21 +9,33 @@ import (
 	"time"
 
 	"github.com/bigkaa/goartstore/storage-element/internal/config"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
 )
 
-// statusFail — строковая константа для статуса "fail" в health checks.
-const statusFail = "fail"
+// Строковые константы статусов в health checks.
+const (
+	// statusFail — проверка не пройдена
+	statusFail = "fail"
+	// statusDegraded — работа с ограничениями
+	statusDegraded = "degraded"
+)
 
 // IndexReadinessChecker — интерфейс для проверки готовности индекса.
 type IndexReadinessChecker interface {
 	IsReady() bool
 }
 
+// DiskChecker — интерфейс проверки директорий данных (JBOD).
+type DiskChecker interface {
+	// CheckDisks проверяет запись на диски и возвращает их состояние.
+	CheckDisks() []filestore.DiskStatus
+}
+
 // HealthHandler реализует health endpoints: /health/live, /health/ready.
 type HealthHandler struct {
 	version string
-	// dataDir — путь к директории данных (для проверки FS)
-	dataDir string
+	// disks — проверка директорий данных (для проверки FS)
+	disks DiskChecker
 	// walDir — путь к директории WAL (для проверки WAL)
 	walDir string
 	// idx — ссылка на индекс для проверки готовности
@@ -42,10 +54,10 @@ func NewHealthHandler() *HealthHandler {
 
 // NewHealthHandlerFull создаёт обработчик health endpoints с реальными проверками.
 // roleProvider — провайдер роли (nil для standalone).
-func NewHealthHandlerFull(dataDir, walDir string, idx IndexReadinessChecker, roleProvider RoleProvider) *HealthHandler {
+func NewHealthHandlerFull(disks DiskChecker, walDir string, idx IndexReadinessChecker, roleProvider RoleProvider) *HealthHandler {
 	return &HealthHandler{
 		version:      config.Version,
-		dataDir:      dataDir,
+		disks:        disks,
 		walDir:       walDir,
 		idx:          idx,
 		roleProvider: roleProvider,
@@ -73,18 +85,22 @@ func (h *HealthHandler) HealthReady(w http.ResponseWriter, _ *http.Request) {
 	overallStatus := "ok"
 	httpStatus := http.StatusOK
 
-	// Проверка файловой системы
+	// Проверка файловой системы: отказ основного диска — fail,
+	// отказ дополнительного — degraded (SE работает на остальных дисках)
 	fsCheck := h.checkFilesystem()
-	if fsCheck["status"] != "ok" {
+	switch fsCheck["status"] {
+	case statusFail:
 		overallStatus = statusFail
 		httpStatus = http.StatusServiceUnavailable
+	case statusDegraded:
+		overallStatus = statusDegraded
 	}
 
 	// Проверка WAL
 	walCheck := h.checkWAL()
 	if walCheck["status"] != "ok" {
 		if overallStatus != statusFail {
-			overallStatus = "degraded"
+			overallStatus = statusDegraded
 		}
 	}
 
@@ -109,7 +125,7 @@ func (h *HealthHandler) HealthReady(w http.ResponseWriter, _ *http.Request) {
 		checks["leader_connection"] = leaderCheck
 		if leaderCheck["status"] != "ok" {
 			if overallStatus != statusFail {
-				overallStatus = "degraded"
+				overallStatus = statusDegraded
 			}
 		}
 	}
@@ -127,23 +143,42 @@ func (h *HealthHandler) HealthReady(w http.ResponseWriter, _ *http.Request) {
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
-// checkFilesystem проверяет доступность директории данных на запись.
+// checkFilesystem проверяет доступность директорий данных на запись.
+// Отказ основной директории — fail, отказ дополнительной — degraded.
 func (h *HealthHandler) checkFilesystem() map[string]any {
-	if h.dataDir == "" {
+	if h.disks == nil {
 		return map[string]any{
 			"status":  "ok",
 			"message": "Проверка не настроена",
 		}
 	}
 
-	testFile := filepath.Join(h.dataDir, ".health_check")
-	if err := os.WriteFile(testFile, []byte("ok"), 0o600); err != nil {
+	var failed []string
+	primaryFailed := false
+	for _, disk := range h.disks.CheckDisks() {
+		if disk.State != filestore.DiskFailed {
+			continue
+		}
+		failed = append(failed, disk.Path)
+		if disk.Primary {
+			primaryFailed = true
+		}
+	}
+
+	switch {
+	case primaryFailed:
 		return map[string]any{
-			"status":  statusFail,
-			"message": "Директория данных недоступна для записи: " + err.Error(),
+			"status":       statusFail,
+			"message":      "Основная директория данных недоступна для записи",
+			"failed_disks": failed,
+		}
+	case len(failed) > 0:
+		return map[string]any{
+			"status":       statusDegraded,
+			"message":      "Часть директорий данных недоступна для записи",
+			"failed_disks": failed,
 		}
 	}
-	_ = os.Remove(testFile)
 
 	return map[string]any{
 		"status": "ok",
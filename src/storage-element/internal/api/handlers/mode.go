@@ -20,20 +20,28 @@ type ModePersister interface {
 	SaveMode(m mode.StorageMode) error
 }
 
+// ArchivePacker — интерфейс запуска упаковки в холодный архив при переходе в ar.
+type ArchivePacker interface {
+	StartPacking()
+}
+
 // ModeHandler — обработчик endpoint смены режима.
 type ModeHandler struct {
 	sm            *mode.StateMachine
 	logger        *slog.Logger
 	modePersister ModePersister
+	archiver      ArchivePacker
 }
 
 // NewModeHandler создаёт обработчик смены режима.
 // modePersister — сохранение mode.json (nil для standalone).
-func NewModeHandler(sm *mode.StateMachine, logger *slog.Logger, modePersister ModePersister) *ModeHandler {
+// archiver — упаковка файлов в холодный архив после перехода в ar (может быть nil).
+func NewModeHandler(sm *mode.StateMachine, logger *slog.Logger, modePersister ModePersister, archiver ArchivePacker) *ModeHandler {
 	return &ModeHandler{
 		sm:            sm,
 		logger:        logger.With(slog.String("component", "mode_handler")),
 		modePersister: modePersister,
+		archiver:      archiver,
 	}
 }
 
@@ -92,6 +100,11 @@ func (h *ModeHandler) TransitionMode(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Переход в ar — упаковываем файлы данных в архивные бандлы (в фоне)
+	if targetMode == mode.ModeAR && h.archiver != nil {
+		h.archiver.StartPacking()
+	}
+
 	now := time.Now().UTC()
 
 	// Логируем переход
@@ -0,0 +1,27 @@
+// quotas.go — обработчик GET /api/v1/quotas.
+// Возвращает использование квот загрузки по субъектам JWT.
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/service"
+)
+
+// QuotaHandler — обработчик endpoints квот.
+type QuotaHandler struct {
+	quotaSvc *service.QuotaService
+}
+
+// NewQuotaHandler создаёт обработчик endpoints квот.
+func NewQuotaHandler(quotaSvc *service.QuotaService) *QuotaHandler {
+	return &QuotaHandler{quotaSvc: quotaSvc}
+}
+
+// GetQuotaUsage обрабатывает GET /api/v1/quotas.
+func (h *QuotaHandler) GetQuotaUsage(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(h.quotaSvc.Usage())
+}
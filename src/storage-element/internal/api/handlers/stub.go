@@ -56,18 +56,42 @@ func (s *StubHandler) DownloadFile(w http.ResponseWriter, _ *http.Request, _ gen
 	notImplemented(w)
 }
 
+func (s *StubHandler) RestoreFile(w http.ResponseWriter, _ *http.Request, _ generated.FileId) {
+	notImplemented(w)
+}
+
 // --- System ---
 
 func (s *StubHandler) GetStorageInfo(w http.ResponseWriter, _ *http.Request) {
 	notImplemented(w)
 }
 
+func (s *StubHandler) GetQuotaUsage(w http.ResponseWriter, _ *http.Request) {
+	notImplemented(w)
+}
+
+func (s *StubHandler) GetArchiveStatus(w http.ResponseWriter, _ *http.Request) {
+	notImplemented(w)
+}
+
 // --- Maintenance ---
 
 func (s *StubHandler) Reconcile(w http.ResponseWriter, _ *http.Request) {
 	notImplemented(w)
 }
 
+func (s *StubHandler) ExportArchive(w http.ResponseWriter, _ *http.Request, _ generated.ExportArchiveParams) {
+	notImplemented(w)
+}
+
+func (s *StubHandler) ImportArchive(w http.ResponseWriter, _ *http.Request) {
+	notImplemented(w)
+}
+
+func (s *StubHandler) DrainDisk(w http.ResponseWriter, _ *http.Request) {
+	notImplemented(w)
+}
+
 // --- Mode ---
 
 func (s *StubHandler) TransitionMode(w http.ResponseWriter, _ *http.Request) {
@@ -9,6 +9,7 @@ import (
 	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
 	"github.com/bigkaa/goartstore/storage-element/internal/config"
 	"github.com/bigkaa/goartstore/storage-element/internal/domain/mode"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
 )
 
@@ -20,26 +21,35 @@ type RoleProvider interface {
 	LeaderAddr() string
 }
 
+// DiskStatusProvider — интерфейс получения состояния директорий данных.
+type DiskStatusProvider interface {
+	Disks() []filestore.DiskStatus
+}
+
 // SystemHandler — обработчик системных endpoints.
 type SystemHandler struct {
 	cfg          *config.Config
 	sm           *mode.StateMachine
 	idx          *index.Index
+	disks        DiskStatusProvider
 	roleProvider RoleProvider
 }
 
 // NewSystemHandler создаёт обработчик системных endpoints.
+// disks — провайдер состояния дисков (nil — без информации о дисках).
 // roleProvider — провайдер роли (nil для standalone).
 func NewSystemHandler(
 	cfg *config.Config,
 	sm *mode.StateMachine,
 	idx *index.Index,
+	disks DiskStatusProvider,
 	roleProvider RoleProvider,
 ) *SystemHandler {
 	return &SystemHandler{
 		cfg:          cfg,
 		sm:           sm,
 		idx:          idx,
+		disks:        disks,
 		roleProvider: roleProvider,
 	}
 }
@@ -74,6 +84,28 @@ func (h *SystemHandler) GetStorageInfo(w http.ResponseWriter, _ *http.Request) {
 		AvailableBytes: availableBytes,
 	}
 
+	// Состояние дисков: отказ любого диска — degraded
+	var disks *[]generated.DiskInfo
+	if h.disks != nil {
+		statuses := h.disks.Disks()
+		apiDisks := make([]generated.DiskInfo, 0, len(statuses))
+		for _, d := range statuses {
+			apiDisks = append(apiDisks, generated.DiskInfo{
+				Path:           d.Path,
+				Primary:        d.Primary,
+				Status:         generated.DiskInfoStatus(d.State),
+				TotalBytes:     d.TotalBytes,
+				UsedBytes:      d.UsedBytes,
+				AvailableBytes: d.AvailableBytes,
+				FilesCount:     d.FilesCount,
+			})
+			if d.State == filestore.DiskFailed && status == generated.StorageInfoStatusOnline {
+				status = generated.StorageInfoStatusDegraded
+			}
+		}
+		disks = &apiDisks
+	}
+
 	// Режим развёртывания
 	replicaMode := generated.StorageInfoReplicaModeStandalone
 	if h.cfg.ReplicaMode == "replicated" {
@@ -93,6 +125,7 @@ func (h *SystemHandler) GetStorageInfo(w http.ResponseWriter, _ *http.Request) {
 		Version:           config.Version,
 		AllowedOperations: apiOps,
 		Capacity:          capacity,
+		Disks:             disks,
 		ReplicaMode:       &replicaMode,
 		Role:              &role,
 	}
@@ -1,6 +1,8 @@
 // auth.go — JWT middleware для аутентификации и авторизации.
-// Использует RS256 + JWKS для валидации токенов от Admin Module.
-// Claims: sub (subject), scopes (массив строк).
+// Использует RS256/ES256 + JWKS для валидации токенов от Admin Module.
+// Claims: sub (subject), scopes (массив строк), artstore_grants (ограничения
+// scopes SA по ресурсам), artstore_collections (коллекции, в которые SA может
+// загружать файлы).
 // Публичные endpoints (health, info, metrics) — без аутентификации.
 package middleware
 
@@ -20,6 +22,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 
 	apierrors "github.com/bigkaa/goartstore/storage-element/internal/api/errors"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/grants"
 )
 
 // contextKey — тип для ключей контекста (избегаем коллизий).
@@ -30,8 +33,18 @@ const (
 	ContextKeySubject contextKey = "jwt_subject"
 	// ContextKeyScopes — ключ для scopes из JWT в контексте запроса.
 	ContextKeyScopes contextKey = "jwt_scopes"
+	// ContextKeyClientID — ключ для client_id из JWT в контексте запроса.
+	ContextKeyClientID contextKey = "jwt_client_id"
+	// ContextKeyGrants — ключ для grants SA из JWT в контексте запроса.
+	ContextKeyGrants contextKey = "jwt_grants"
+	// ContextKeyCollections — ключ для коллекций SA из JWT в контексте запроса.
+	ContextKeyCollections contextKey = "jwt_collections"
 )
 
+// ScopeStorageWrite — scope обслуживания SE. Импорт с этим scope записывает
+// attr.json целиком, поэтому он допускает загрузку в любую коллекцию.
+const ScopeStorageWrite = "storage:write"
+
 // Claims — структура JWT claims для Storage Element.
 // Поддерживает два формата scopes:
 //   - Keycloak стандартный: "scope" (пробело-разделённая строка)
@@ -42,6 +55,13 @@ type Claims struct {
 	ScopeString string `json:"scope"`
 	// ScopeArray — кастомный claim (массив строк), альтернативный формат
 	ScopeArray []string `json:"scopes"`
+	// ClientID — client_id Service Account (Keycloak)
+	ClientID string `json:"client_id,omitempty"`
+	// Grants — ограничения scopes SA по ресурсам
+	Grants grants.Set `json:"artstore_grants,omitempty"`
+	// Collections — UUID коллекций, в которые SA может загружать файлы
+	// (право write участника коллекции, claim формирует Admin Module)
+	Collections []string `json:"artstore_collections,omitempty"`
 }
 
 // Scopes возвращает объединённый список scope'ов из обоих форматов.
@@ -164,7 +184,7 @@ func NewJWTAuthWithKeyfunc(kf keyfunc.Keyfunc, jwtLeeway time.Duration, logger *
 }
 
 // Middleware возвращает HTTP middleware для JWT-аутентификации.
-// Извлекает Bearer token из заголовка Authorization, валидирует подпись (RS256),
+// Извлекает Bearer token из заголовка Authorization, валидирует подпись (RS256 или ES256),
 // проверяет exp/nbf, помещает sub и scopes в контекст запроса.
 func (j *JWTAuth) Middleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -191,7 +211,7 @@ func (j *JWTAuth) Middleware() func(http.Handler) http.Handler {
 			// Парсинг и валидация JWT
 			claims := &Claims{}
 			token, err := jwt.ParseWithClaims(tokenString, claims, j.jwks.KeyfuncCtx(r.Context()),
-				jwt.WithValidMethods([]string{"RS256"}),
+				jwt.WithValidMethods([]string{"RS256", "ES256"}),
 				jwt.WithExpirationRequired(),
 				jwt.WithLeeway(j.jwtLeeway),
 			)
@@ -219,6 +239,9 @@ func (j *JWTAuth) Middleware() func(http.Handler) http.Handler {
 			// Помещаем claims в контекст
 			ctx := context.WithValue(r.Context(), ContextKeySubject, subject)
 			ctx = context.WithValue(ctx, ContextKeyScopes, claims.Scopes())
+			ctx = context.WithValue(ctx, ContextKeyClientID, claims.ClientID)
+			ctx = context.WithValue(ctx, ContextKeyGrants, claims.Grants)
+			ctx = context.WithValue(ctx, ContextKeyCollections, claims.Collections)
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
@@ -249,6 +272,41 @@ func RequireScope(scope string) func(http.Handler) http.Handler {
 	}
 }
 
+// RequireSEGrant возвращает middleware, проверяющий, что grants субъекта
+// по scope допускают этот SE (storageID). Без grants доступ не ограничен.
+// Должен использоваться ПОСЛЕ JWTAuth.Middleware().
+func RequireSEGrant(scope, storageID string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !GrantsFromContext(r.Context()).Allows(scope, grants.Access{SE: []string{storageID}}) {
+				apierrors.Forbidden(w, "Недостаточно прав: SE вне grants токена для scope "+scope)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// AllowsGrant проверяет доступ к ресурсу по grants из контекста запроса.
+func AllowsGrant(ctx context.Context, scope string, access grants.Access) bool {
+	clientID, _ := ctx.Value(ContextKeyClientID).(string)
+	return GrantsFromContext(ctx).Allows(scope, access, SubjectFromContext(ctx), clientID)
+}
+
+// GrantsFromContext извлекает grants из контекста запроса.
+// Возвращает nil, если grants нет (доступ не ограничен).
+func GrantsFromContext(ctx context.Context) grants.Set {
+	set, _ := ctx.Value(ContextKeyGrants).(grants.Set)
+	return set
+}
+
+// CollectionsFromContext извлекает коллекции SA из контекста запроса.
+// Возвращает nil, если claim artstore_collections нет.
+func CollectionsFromContext(ctx context.Context) []string {
+	collections, _ := ctx.Value(ContextKeyCollections).([]string)
+	return collections
+}
+
 // SubjectFromContext извлекает sub из контекста запроса.
 // Возвращает пустую строку, если sub не найден.
 func SubjectFromContext(ctx context.Context) string {
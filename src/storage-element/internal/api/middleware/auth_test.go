@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -15,8 +17,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/MicahParks/jwkset"
 	"github.com/MicahParks/keyfunc/v3"
 	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/grants"
 )
 
 // testKeyID — идентификатор ключа для тестов.
@@ -122,6 +127,56 @@ func TestJWTAuth_ValidToken(t *testing.T) {
 	}
 }
 
+// TestJWTAuth_ES256Token проверяет токен, подписанный ES256
+// (ключи встроенного IdP Admin Module при AM_KEYS_ALGORITHM=ES256).
+func TestJWTAuth_ES256Token(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := jwkset.NewJWKFromKey(&key.PublicKey, jwkset.JWKOptions{
+		Metadata: jwkset.JWKMetadataOptions{KID: testKeyID, ALG: jwkset.AlgES256, USE: jwkset.UseSig},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksJSON, err := json.Marshal(jwkset.JWKSMarshal{Keys: []jwkset.JWKMarshal{jwk.Marshal()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kf, err := keyfunc.NewJWKSetJSON(jwksJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := NewJWTAuthWithKeyfunc(kf, 5*time.Second, slog.New(slog.DiscardHandler))
+	handler := auth.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "sa-client",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		ScopeArray: []string{"files:read"},
+	})
+	token.Header["kid"] = testKeyID
+	tokenString, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/files", nil)
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("ожидался статус 200, получен %d, тело: %s", rec.Code, rec.Body.String())
+	}
+}
+
 // TestJWTAuth_MissingToken проверяет отсутствие Authorization header.
 func TestJWTAuth_MissingToken(t *testing.T) {
 	key, _ := generateTestKey()
@@ -251,6 +306,96 @@ func TestRequireScope_NoScopes(t *testing.T) {
 	}
 }
 
+// TestJWTAuth_GrantsClaim проверяет разбор claims artstore_grants
+// и artstore_collections в контекст.
+func TestJWTAuth_GrantsClaim(t *testing.T) {
+	key, err := generateTestKey()
+	if err != nil {
+		t.Fatalf("Ошибка генерации ключа: %v", err)
+	}
+	auth := newTestJWTAuth(key)
+
+	tokenStr, err := generateTestToken(key, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "kc-sub-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		ScopeString: "files:read files:write",
+		ClientID:    "sa_ingest",
+		Grants: grants.Set{
+			{Scope: grants.ScopeFilesWrite, SE: []string{"se-edge"}},
+			{Scope: grants.ScopeFilesRead, Own: true},
+		},
+		Collections: []string{"9b2f6c1e-4d3a-4f8e-a1b2-3c4d5e6f7a8b"},
+	})
+	if err != nil {
+		t.Fatalf("Ошибка генерации токена: %v", err)
+	}
+
+	var ctx context.Context
+	handler := auth.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+		w.WriteHeader(http.StatusOK)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+tokenStr)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ожидался статус 200, получен %d", rec.Code)
+	}
+	if got := GrantsFromContext(ctx); len(got) != 2 {
+		t.Fatalf("ожидалось 2 grants в контексте, получено %d", len(got))
+	}
+	if !AllowsGrant(ctx, grants.ScopeFilesRead, grants.Access{UploadedBy: "sa_ingest"}) {
+		t.Error("чтение своего файла (по client_id) должно разрешаться")
+	}
+	if AllowsGrant(ctx, grants.ScopeFilesRead, grants.Access{UploadedBy: "sa_other"}) {
+		t.Error("чтение чужого файла должно запрещаться")
+	}
+	if got := CollectionsFromContext(ctx); len(got) != 1 || got[0] != "9b2f6c1e-4d3a-4f8e-a1b2-3c4d5e6f7a8b" {
+		t.Errorf("коллекции в контексте = %v", got)
+	}
+}
+
+// TestRequireSEGrant проверяет допуск к SE по grants токена.
+func TestRequireSEGrant(t *testing.T) {
+	set := grants.Set{{Scope: grants.ScopeFilesWrite, SE: []string{"se-edge", "edge-uuid"}}}
+
+	tests := []struct {
+		name      string
+		grants    grants.Set
+		scope     string
+		storageID string
+		want      int
+	}{
+		{"разрешённый SE", set, grants.ScopeFilesWrite, "se-edge", http.StatusOK},
+		{"запрет: другой SE", set, grants.ScopeFilesWrite, "se-core", http.StatusForbidden},
+		{"scope без grants", set, grants.ScopeFilesRead, "se-core", http.StatusOK},
+		{"токен без grants", nil, grants.ScopeFilesWrite, "se-core", http.StatusOK},
+		{"запрет: пустой список SE", grants.Set{{Scope: grants.ScopeStorageWrite, SE: []string{}}},
+			grants.ScopeStorageWrite, "se-edge", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := RequireSEGrant(tt.scope, tt.storageID)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			ctx := context.WithValue(context.Background(), ContextKeyGrants, tt.grants)
+			req := httptest.NewRequest(http.MethodPost, "/", nil).WithContext(ctx)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("ожидался статус %d, получен %d", tt.want, rec.Code)
+			}
+		})
+	}
+}
+
 // TestSubjectFromContext проверяет извлечение subject из контекста.
 func TestSubjectFromContext_Empty(t *testing.T) {
 	if sub := SubjectFromContext(context.Background()); sub != "" {
@@ -129,12 +129,25 @@ func normalizePath(path string) string {
 		return "/api/v1/mode/transition"
 	case path == "/api/v1/maintenance/reconcile":
 		return "/api/v1/maintenance/reconcile"
+	case path == "/api/v1/maintenance/export":
+		return "/api/v1/maintenance/export"
+	case path == "/api/v1/maintenance/import":
+		return "/api/v1/maintenance/import"
+	case path == "/api/v1/maintenance/drain":
+		return "/api/v1/maintenance/drain"
+	case path == "/api/v1/quotas":
+		return "/api/v1/quotas"
+	case path == "/api/v1/archive":
+		return "/api/v1/archive"
 	case len(path) > len("/api/v1/files/") && isUUIDSegment(path, "/api/v1/files/"):
-		// /api/v1/files/{uuid}/download или /api/v1/files/{uuid}
+		// /api/v1/files/{uuid}/download, /api/v1/files/{uuid}/restore или /api/v1/files/{uuid}
 		suffix := path[len("/api/v1/files/")+36:]
 		if suffix == "/download" {
 			return "/api/v1/files/{id}/download"
 		}
+		if suffix == "/restore" {
+			return "/api/v1/files/{id}/restore"
+		}
 		if suffix == "" {
 			return "/api/v1/files/{id}"
 		}
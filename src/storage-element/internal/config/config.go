@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -13,12 +14,24 @@ import (
 
 // Значения по умолчанию для таймаутов и интервалов.
 const (
-	defaultHTTPClientTimeout   = 30 * time.Second
-	defaultHTTPReadTimeout     = 30 * time.Second
-	defaultHTTPWriteTimeout    = 60 * time.Second
-	defaultHTTPIdleTimeout     = 120 * time.Second
-	defaultJWKSRefreshInterval = 15 * time.Second
-	defaultJWTLeeway           = 5 * time.Second
+	defaultHTTPClientTimeout    = 30 * time.Second
+	defaultHTTPReadTimeout      = 30 * time.Second
+	defaultHTTPWriteTimeout     = 60 * time.Second
+	defaultHTTPIdleTimeout      = 120 * time.Second
+	defaultJWKSRefreshInterval  = 15 * time.Second
+	defaultJWTLeeway            = 5 * time.Second
+	defaultDiskCheckInterval    = 30 * time.Second
+	defaultQuotaRefreshInterval = 30 * time.Second
+	defaultRestoreTTL           = 24 * time.Hour
+	defaultArchiveBundleSize    = 1 << 30 // 1 GB
+)
+
+// Политики размещения новых файлов между директориями данных (SE_PLACEMENT_POLICY).
+const (
+	// PlacementMostFree — диск с наибольшим свободным местом
+	PlacementMostFree = "most_free"
+	// PlacementRoundRobin — диски по очереди
+	PlacementRoundRobin = "round_robin"
 )
 
 // Версия приложения, задаётся при сборке через -ldflags.
@@ -30,8 +43,14 @@ type Config struct {
 	Port int
 	// Уникальный идентификатор SE (например, "se-moscow-01")
 	StorageID string
-	// Путь к директории хранения файлов
+	// Путь к основной директории хранения файлов (служебные файлы: mode.json, leader lock)
 	DataDir string
+	// Все директории данных (JBOD): DataDir первой, затем SE_DATA_DIRS
+	DataDirs []string
+	// Политика размещения новых файлов между директориями данных
+	PlacementPolicy string
+	// Интервал проверки доступности директорий данных
+	DiskCheckInterval time.Duration
 	// Путь к директории WAL
 	WALDir string
 	// Начальный режим работы (edit, rw, ro, ar)
@@ -44,6 +63,14 @@ type Config struct {
 	GCInterval time.Duration
 	// Интервал автоматической сверки
 	ReconcileInterval time.Duration
+	// Путь к файлу квот загрузки по субъектам JWT
+	QuotaFile string
+	// Интервал перечитывания файла квот и обновления метрик usage
+	QuotaRefreshInterval time.Duration
+	// Целевой размер архивного бандла (режим ar) в байтах
+	ArchiveBundleSize int64
+	// Время хранения восстановленной из архива копии файла
+	RestoreTTL time.Duration
 	// URL JWKS endpoint Admin Module
 	JWKSUrl string
 
@@ -140,6 +167,28 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	// SE_DATA_DIRS — дополнительные директории данных через запятую (JBOD)
+	cfg.DataDirs, err = parseDataDirs(cfg.DataDir, getEnvDefault("SE_DATA_DIRS", ""))
+	if err != nil {
+		return nil, fmt.Errorf("SE_DATA_DIRS: %w", err)
+	}
+
+	// SE_PLACEMENT_POLICY — политика размещения файлов (по умолчанию most_free)
+	cfg.PlacementPolicy = getEnvDefault("SE_PLACEMENT_POLICY", PlacementMostFree)
+	if cfg.PlacementPolicy != PlacementMostFree && cfg.PlacementPolicy != PlacementRoundRobin {
+		return nil, fmt.Errorf("SE_PLACEMENT_POLICY: недопустимое значение %q, допустимые: %s, %s",
+			cfg.PlacementPolicy, PlacementMostFree, PlacementRoundRobin)
+	}
+
+	// SE_DISK_CHECK_INTERVAL — интервал проверки директорий данных (по умолчанию 30s)
+	cfg.DiskCheckInterval, err = getEnvDuration("SE_DISK_CHECK_INTERVAL", defaultDiskCheckInterval)
+	if err != nil {
+		return nil, fmt.Errorf("SE_DISK_CHECK_INTERVAL: %w", err)
+	}
+	if cfg.DiskCheckInterval <= 0 {
+		return nil, fmt.Errorf("SE_DISK_CHECK_INTERVAL: значение должно быть > 0")
+	}
+
 	// SE_WAL_DIR — обязательный
 	cfg.WALDir, err = getEnvRequired("SE_WAL_DIR")
 	if err != nil {
@@ -185,6 +234,36 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("SE_RECONCILE_INTERVAL: %w", err)
 	}
 
+	// SE_QUOTA_FILE — файл квот (по умолчанию {SE_DATA_DIR}/.quotas.json)
+	cfg.QuotaFile = getEnvDefault("SE_QUOTA_FILE", filepath.Join(cfg.DataDir, ".quotas.json"))
+
+	// SE_QUOTA_REFRESH_INTERVAL — интервал перечитывания файла квот (по умолчанию 30s)
+	cfg.QuotaRefreshInterval, err = getEnvDuration("SE_QUOTA_REFRESH_INTERVAL", defaultQuotaRefreshInterval)
+	if err != nil {
+		return nil, fmt.Errorf("SE_QUOTA_REFRESH_INTERVAL: %w", err)
+	}
+	if cfg.QuotaRefreshInterval <= 0 {
+		return nil, fmt.Errorf("SE_QUOTA_REFRESH_INTERVAL: значение должно быть > 0")
+	}
+
+	// SE_ARCHIVE_BUNDLE_SIZE — целевой размер архивного бандла (по умолчанию 1 GB)
+	cfg.ArchiveBundleSize, err = getEnvInt64("SE_ARCHIVE_BUNDLE_SIZE", defaultArchiveBundleSize)
+	if err != nil {
+		return nil, fmt.Errorf("SE_ARCHIVE_BUNDLE_SIZE: %w", err)
+	}
+	if cfg.ArchiveBundleSize <= 0 {
+		return nil, fmt.Errorf("SE_ARCHIVE_BUNDLE_SIZE: значение должно быть положительным")
+	}
+
+	// SE_RESTORE_TTL — время хранения восстановленной копии (по умолчанию 24h)
+	cfg.RestoreTTL, err = getEnvDuration("SE_RESTORE_TTL", defaultRestoreTTL)
+	if err != nil {
+		return nil, fmt.Errorf("SE_RESTORE_TTL: %w", err)
+	}
+	if cfg.RestoreTTL <= 0 {
+		return nil, fmt.Errorf("SE_RESTORE_TTL: значение должно быть > 0")
+	}
+
 	// SE_JWKS_URL — обязательный
 	cfg.JWKSUrl, err = getEnvRequired("SE_JWKS_URL")
 	if err != nil {
@@ -455,6 +534,29 @@ func getEnvDurationFallback(key string, fallbackVal time.Duration) (time.Duratio
 	return d, nil
 }
 
+// parseDataDirs формирует список директорий данных: основная директория
+// первой, затем дополнительные из списка через запятую.
+// Пустые элементы пропускаются, дубликаты — ошибка.
+func parseDataDirs(primary, extra string) ([]string, error) {
+	dirs := []string{filepath.Clean(primary)}
+	seen := map[string]bool{dirs[0]: true}
+
+	for _, item := range strings.Split(extra, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		dir := filepath.Clean(item)
+		if seen[dir] {
+			return nil, fmt.Errorf("директория %q указана повторно", dir)
+		}
+		seen[dir] = true
+		dirs = append(dirs, dir)
+	}
+
+	return dirs, nil
+}
+
 // parseLogLevel преобразует строку уровня логирования в slog.Level.
 func parseLogLevel(level string) (slog.Level, error) {
 	switch strings.ToLower(level) {
@@ -53,6 +53,10 @@ func clearAllSEEnvVars(t *testing.T) func() {
 		"SE_HTTP_CLIENT_TIMEOUT", "SE_JWKS_CLIENT_TIMEOUT",
 		"SE_HTTP_READ_TIMEOUT", "SE_HTTP_WRITE_TIMEOUT", "SE_HTTP_IDLE_TIMEOUT",
 		"SE_JWKS_REFRESH_INTERVAL", "SE_JWT_LEEWAY",
+		// JBOD
+		"SE_DATA_DIRS", "SE_PLACEMENT_POLICY", "SE_DISK_CHECK_INTERVAL",
+		"SE_QUOTA_FILE", "SE_QUOTA_REFRESH_INTERVAL",
+		"SE_ARCHIVE_BUNDLE_SIZE", "SE_RESTORE_TTL",
 	}
 	originals := make(map[string]string)
 	origSet := make(map[string]bool)
@@ -400,6 +404,9 @@ func TestLoad_InvalidDuration(t *testing.T) {
 		"SE_HTTP_CLIENT_TIMEOUT", "SE_HTTP_READ_TIMEOUT",
 		"SE_HTTP_WRITE_TIMEOUT", "SE_HTTP_IDLE_TIMEOUT",
 		"SE_JWKS_REFRESH_INTERVAL", "SE_JWT_LEEWAY",
+		"SE_DISK_CHECK_INTERVAL",
+		"SE_QUOTA_REFRESH_INTERVAL",
+		"SE_RESTORE_TTL",
 	}
 
 	for _, varName := range durationVars {
@@ -680,3 +687,137 @@ func TestLoad_InvalidJWKSClientTimeout(t *testing.T) {
 		t.Error("ожидалась ошибка для отрицательного SE_JWKS_CLIENT_TIMEOUT")
 	}
 }
+
+func TestLoad_DataDirsDefault(t *testing.T) {
+	cleanup := clearAllSEEnvVars(t)
+	defer cleanup()
+
+	cleanupVars := setEnvVars(t, requiredEnvVars())
+	defer cleanupVars()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+
+	if len(cfg.DataDirs) != 1 || cfg.DataDirs[0] != "/tmp/data" {
+		t.Errorf("DataDirs = %v, ожидалось [/tmp/data]", cfg.DataDirs)
+	}
+	if cfg.PlacementPolicy != PlacementMostFree {
+		t.Errorf("PlacementPolicy = %q, ожидалось %q", cfg.PlacementPolicy, PlacementMostFree)
+	}
+	if cfg.DiskCheckInterval != 30*time.Second {
+		t.Errorf("DiskCheckInterval = %v, ожидалось 30s", cfg.DiskCheckInterval)
+	}
+}
+
+func TestLoad_QuotaFile(t *testing.T) {
+	cleanup := clearAllSEEnvVars(t)
+	defer cleanup()
+
+	cleanupVars := setEnvVars(t, requiredEnvVars())
+	defer cleanupVars()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+	if cfg.QuotaFile != "/tmp/data/.quotas.json" {
+		t.Errorf("QuotaFile = %q, ожидалось /tmp/data/.quotas.json", cfg.QuotaFile)
+	}
+	if cfg.QuotaRefreshInterval != 30*time.Second {
+		t.Errorf("QuotaRefreshInterval = %v, ожидалось 30s", cfg.QuotaRefreshInterval)
+	}
+
+	t.Setenv("SE_QUOTA_FILE", "/etc/se/quotas.json")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+	if cfg.QuotaFile != "/etc/se/quotas.json" {
+		t.Errorf("QuotaFile = %q, ожидалось /etc/se/quotas.json", cfg.QuotaFile)
+	}
+}
+
+func TestLoad_ArchiveTier(t *testing.T) {
+	cleanup := clearAllSEEnvVars(t)
+	defer cleanup()
+
+	cleanupVars := setEnvVars(t, requiredEnvVars())
+	defer cleanupVars()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+	if cfg.ArchiveBundleSize != 1<<30 {
+		t.Errorf("ArchiveBundleSize = %d, ожидалось 1GiB", cfg.ArchiveBundleSize)
+	}
+	if cfg.RestoreTTL != 24*time.Hour {
+		t.Errorf("RestoreTTL = %v, ожидалось 24h", cfg.RestoreTTL)
+	}
+
+	t.Setenv("SE_ARCHIVE_BUNDLE_SIZE", "0")
+	if _, err := Load(); err == nil {
+		t.Error("ожидалась ошибка для SE_ARCHIVE_BUNDLE_SIZE=0")
+	}
+}
+
+func TestLoad_DataDirsMultiple(t *testing.T) {
+	cleanup := clearAllSEEnvVars(t)
+	defer cleanup()
+
+	vars := requiredEnvVars()
+	vars["SE_DATA_DIRS"] = "/mnt/disk2, /mnt/disk3/,"
+	vars["SE_PLACEMENT_POLICY"] = "round_robin"
+	cleanupVars := setEnvVars(t, vars)
+	defer cleanupVars()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("неожиданная ошибка: %v", err)
+	}
+
+	expected := []string{"/tmp/data", "/mnt/disk2", "/mnt/disk3"}
+	if len(cfg.DataDirs) != len(expected) {
+		t.Fatalf("DataDirs = %v, ожидалось %v", cfg.DataDirs, expected)
+	}
+	for i, dir := range expected {
+		if cfg.DataDirs[i] != dir {
+			t.Errorf("DataDirs[%d] = %q, ожидалось %q", i, cfg.DataDirs[i], dir)
+		}
+	}
+	if cfg.PlacementPolicy != PlacementRoundRobin {
+		t.Errorf("PlacementPolicy = %q, ожидалось %q", cfg.PlacementPolicy, PlacementRoundRobin)
+	}
+}
+
+func TestLoad_DataDirsDuplicate(t *testing.T) {
+	cleanup := clearAllSEEnvVars(t)
+	defer cleanup()
+
+	vars := requiredEnvVars()
+	vars["SE_DATA_DIRS"] = "/tmp/data/"
+	cleanupVars := setEnvVars(t, vars)
+	defer cleanupVars()
+
+	_, err := Load()
+	if err == nil {
+		t.Error("ожидалась ошибка для повторной директории в SE_DATA_DIRS")
+	}
+}
+
+func TestLoad_InvalidPlacementPolicy(t *testing.T) {
+	cleanup := clearAllSEEnvVars(t)
+	defer cleanup()
+
+	vars := requiredEnvVars()
+	vars["SE_PLACEMENT_POLICY"] = "random"
+	cleanupVars := setEnvVars(t, vars)
+	defer cleanupVars()
+
+	_, err := Load()
+	if err == nil {
+		t.Error("ожидалась ошибка для невалидного SE_PLACEMENT_POLICY")
+	}
+}
@@ -0,0 +1,129 @@
+// Пакет grants — ограничения scopes Service Account по ресурсам.
+//
+// Scope SA (files:read, files:write, storage:read, storage:write) по умолчанию
+// действует на всю систему. Grant сужает scope: доступ разрешён только к
+// указанным SE, файлам с указанными политиками хранения и/или только к своим
+// файлам (uploaded_by = субъект токена).
+//
+// Grants передаются в JWT claim "artstore_grants" (массив Grant) и проверяются
+// Admin Module, Storage Element и Query Module по одинаковым правилам:
+//   - нет grants для scope — scope действует без ограничений;
+//   - есть grants для scope — доступ разрешён, если подходит хотя бы один grant;
+//   - внутри grant все указанные ограничения должны выполняться одновременно.
+package grants
+
+import "slices"
+
+// ClaimName — имя claim в JWT, содержащего grants SA.
+const ClaimName = "artstore_grants"
+
+// Scopes, которые можно ограничить по ресурсам.
+const (
+	ScopeFilesRead    = "files:read"
+	ScopeFilesWrite   = "files:write"
+	ScopeStorageRead  = "storage:read"
+	ScopeStorageWrite = "storage:write"
+)
+
+// Grant — ограничение scope по ресурсам (элемент claim "artstore_grants").
+type Grant struct {
+	// Scope — ограничиваемый scope.
+	Scope string `json:"scope"`
+	// SE — разрешённые SE: UUID записи в Admin Module и storage_id SE.
+	// nil (null в JSON) — любой SE, пустой список — ни один SE.
+	SE []string `json:"se"`
+	// Retention — разрешённые политики хранения файлов (пусто — любые).
+	Retention []string `json:"retention,omitempty"`
+	// Own — только файлы, загруженные самим субъектом.
+	Own bool `json:"own,omitempty"`
+}
+
+// Access — проверяемый доступ. Пустые поля не проверяются: так SE
+// проверяет допуск к себе до чтения метаданных файла.
+type Access struct {
+	// SE — идентификаторы SE, на котором находится ресурс (любой из них).
+	SE []string
+	// Retention — политика хранения файла.
+	Retention string
+	// UploadedBy — загрузивший файл.
+	UploadedBy string
+}
+
+// Set — grants субъекта.
+type Set []Grant
+
+// For возвращает grants для scope.
+func (s Set) For(scope string) []Grant {
+	var result []Grant
+	for _, g := range s {
+		if g.Scope == scope {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// Restricted проверяет, ограничен ли scope grants.
+func (s Set) Restricted(scope string) bool {
+	return len(s.For(scope)) > 0
+}
+
+// SEs возвращает SE, доступные по scope: объединение SE всех grants.
+// nil — SE не ограничены (нет grants или есть grant без ограничения SE).
+func (s Set) SEs(scope string) []string {
+	scoped := s.For(scope)
+	if len(scoped) == 0 {
+		return nil
+	}
+	result := []string{}
+	for _, g := range scoped {
+		if g.SE == nil {
+			return nil
+		}
+		for _, se := range g.SE {
+			if !slices.Contains(result, se) {
+				result = append(result, se)
+			}
+		}
+	}
+	return result
+}
+
+// Allows проверяет доступ по scope. subjects — идентификаторы субъекта
+// (sub и client_id), с которыми сравнивается uploaded_by при own.
+func (s Set) Allows(scope string, access Access, subjects ...string) bool {
+	scoped := s.For(scope)
+	if len(scoped) == 0 {
+		return true
+	}
+	for _, g := range scoped {
+		if g.allows(access, subjects) {
+			return true
+		}
+	}
+	return false
+}
+
+// allows проверяет все ограничения одного grant.
+func (g Grant) allows(access Access, subjects []string) bool {
+	if g.SE != nil && len(access.SE) > 0 && !containsAny(g.SE, access.SE) {
+		return false
+	}
+	if len(g.Retention) > 0 && access.Retention != "" && !slices.Contains(g.Retention, access.Retention) {
+		return false
+	}
+	if g.Own && access.UploadedBy != "" && !slices.Contains(subjects, access.UploadedBy) {
+		return false
+	}
+	return true
+}
+
+// containsAny проверяет, есть ли в allowed хотя бы один из values.
+func containsAny(allowed, values []string) bool {
+	for _, v := range values {
+		if v != "" && slices.Contains(allowed, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -59,6 +59,11 @@ type FileMetadata struct {
 	// UploadedAt — дата и время загрузки (UTC)
 	UploadedAt time.Time `json:"uploaded_at"`
 
+	// UpdatedAt — дата и время последней записи attr.json (UTC).
+	// Проставляется attr.Write; отсутствует в файлах, записанных до
+	// появления поля (см. ChangedAt).
+	UpdatedAt time.Time `json:"updated_at,omitzero"`
+
 	// Status — текущий статус файла
 	Status FileStatus `json:"status"`
 
@@ -78,6 +83,15 @@ type FileMetadata struct {
 
 	// Description — описание файла (опционально)
 	Description string `json:"description,omitempty"`
+
+	// CollectionID — UUID коллекции (namespace) Admin Module (опционально).
+	// Задаётся при загрузке; членство и квоты проверяет Admin Module.
+	CollectionID string `json:"collection_id,omitempty"`
+
+	// ArchiveBundle — идентификатор архивного бандла (режим ar).
+	// Непустое значение означает, что файл данных упакован в бандл
+	// и удалён с диска; для скачивания требуется restore.
+	ArchiveBundle string `json:"archive_bundle,omitempty"`
 }
 
 // IsExpired проверяет, истёк ли срок хранения файла.
@@ -91,6 +105,20 @@ func (m *FileMetadata) IsExpired(now time.Time) bool {
 	return now.After(*m.ExpiresAt)
 }
 
+// ChangedAt возвращает момент последнего изменения метаданных:
+// UpdatedAt, а для файлов без этого поля — UploadedAt.
+func (m *FileMetadata) ChangedAt() time.Time {
+	if m.UpdatedAt.IsZero() {
+		return m.UploadedAt
+	}
+	return m.UpdatedAt
+}
+
+// IsArchived проверяет, что файл данных упакован в архивный бандл.
+func (m *FileMetadata) IsArchived() bool {
+	return m.ArchiveBundle != ""
+}
+
 // IsActive проверяет, что файл в активном состоянии.
 func (m *FileMetadata) IsActive() bool {
 	return m.Status == StatusActive
@@ -20,7 +20,7 @@ import (
 type FollowerRefreshService struct {
 	idx          *index.Index
 	sm           *mode.StateMachine
-	dataDir      string
+	dataDirs     []string
 	modeFilePath string
 	interval     time.Duration
 	logger       *slog.Logger
@@ -33,14 +33,14 @@ type FollowerRefreshService struct {
 // Параметры:
 //   - idx: in-memory индекс метаданных
 //   - sm: конечный автомат режимов
-//   - dataDir: директория данных (общая NFS)
+//   - dataDirs: директории данных (общая NFS)
 //   - modeFilePath: путь к mode.json
 //   - interval: интервал обновления (SE_INDEX_REFRESH_INTERVAL)
 //   - logger: логгер
 func NewFollowerRefreshService(
 	idx *index.Index,
 	sm *mode.StateMachine,
-	dataDir string,
+	dataDirs []string,
 	modeFilePath string,
 	interval time.Duration,
 	logger *slog.Logger,
@@ -48,7 +48,7 @@ func NewFollowerRefreshService(
 	return &FollowerRefreshService{
 		idx:          idx,
 		sm:           sm,
-		dataDir:      dataDir,
+		dataDirs:     dataDirs,
 		modeFilePath: modeFilePath,
 		interval:     interval,
 		logger:       logger.With(slog.String("component", "follower_refresh")),
@@ -96,7 +96,7 @@ func (s *FollowerRefreshService) run(ctx context.Context) {
 // refresh выполняет одно обновление: пересборка индекса + синхронизация режима.
 func (s *FollowerRefreshService) refresh() {
 	// 1. Пересборка индекса из attr.json на NFS
-	if err := s.idx.RebuildFromDir(s.dataDir); err != nil {
+	if err := s.idx.RebuildFromDirs(s.dataDirs, nil, nil); err != nil {
 		s.logger.Error("Ошибка пересборки индекса",
 			slog.String("error", err.Error()),
 		)
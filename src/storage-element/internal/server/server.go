@@ -72,7 +72,7 @@ func New(cfg *config.Config, logger *slog.Logger, handler generated.ServerInterf
 
 			// Files — files:read
 			r.Group(func(rr chi.Router) {
-				rr.Use(middleware.RequireScope("files:read"))
+				rr.Use(middleware.RequireScope("files:read"), middleware.RequireSEGrant("files:read", cfg.StorageID))
 				// ListFiles и GetFileMetadata через wrapper
 				rr.Get("/api/v1/files", func(w http.ResponseWriter, r *http.Request) {
 					// Парсим параметры вручную для ListFiles
@@ -87,11 +87,15 @@ func New(cfg *config.Config, logger *slog.Logger, handler generated.ServerInterf
 					siw := &generated.ServerInterfaceWrapper{Handler: handler, ErrorHandlerFunc: defaultErrorHandler}
 					siw.DownloadFile(w, r)
 				})
+				rr.Post("/api/v1/files/{file_id}/restore", func(w http.ResponseWriter, r *http.Request) {
+					siw := &generated.ServerInterfaceWrapper{Handler: handler, ErrorHandlerFunc: defaultErrorHandler}
+					siw.RestoreFile(w, r)
+				})
 			})
 
 			// Files — files:write
 			r.Group(func(rr chi.Router) {
-				rr.Use(middleware.RequireScope("files:write"))
+				rr.Use(middleware.RequireScope("files:write"), middleware.RequireSEGrant("files:write", cfg.StorageID))
 				rr.Post("/api/v1/files/upload", handler.UploadFile)
 				rr.Patch("/api/v1/files/{file_id}", func(w http.ResponseWriter, r *http.Request) {
 					siw := &generated.ServerInterfaceWrapper{Handler: handler, ErrorHandlerFunc: defaultErrorHandler}
@@ -103,11 +107,24 @@ func New(cfg *config.Config, logger *slog.Logger, handler generated.ServerInterf
 				})
 			})
 
+			// Storage — storage:read
+			r.Group(func(rr chi.Router) {
+				rr.Use(middleware.RequireScope("storage:read"), middleware.RequireSEGrant("storage:read", cfg.StorageID))
+				rr.Get("/api/v1/quotas", handler.GetQuotaUsage)
+				rr.Get("/api/v1/archive", handler.GetArchiveStatus)
+			})
+
 			// Storage — storage:write
 			r.Group(func(rr chi.Router) {
-				rr.Use(middleware.RequireScope("storage:write"))
+				rr.Use(middleware.RequireScope("storage:write"), middleware.RequireSEGrant("storage:write", cfg.StorageID))
 				rr.Post("/api/v1/mode/transition", handler.TransitionMode)
 				rr.Post("/api/v1/maintenance/reconcile", handler.Reconcile)
+				rr.Get("/api/v1/maintenance/export", func(w http.ResponseWriter, r *http.Request) {
+					siw := &generated.ServerInterfaceWrapper{Handler: handler, ErrorHandlerFunc: defaultErrorHandler}
+					siw.ExportArchive(w, r)
+				})
+				rr.Post("/api/v1/maintenance/import", handler.ImportArchive)
+				rr.Post("/api/v1/maintenance/drain", handler.DrainDisk)
 			})
 		})
 	} else {
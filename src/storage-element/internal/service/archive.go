@@ -0,0 +1,466 @@
+// archive.go — сервис экспорта и импорта tar-архивов Storage Element.
+//
+// Экспорт формирует архив по снимку in-memory индекса и читает файлы
+// напрямую с диска, не блокируя обслуживание трафика.
+// Для каждого файла в архив пишутся две записи подряд:
+//  1. {storage_path}.attr.json — содержимое attr.json
+//  2. {storage_path} — содержимое файла
+//
+// Импорт принимает такой архив и проводит каждый файл через стандартный
+// путь записи: WAL → filestore (SHA-256 на лету) → attr.json → индекс → WAL commit.
+// file_id и остальные метаданные сохраняются, storage_path назначается заново.
+package service
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
+	"github.com/bigkaa/goartstore/storage-element/internal/api/middleware"
+	"github.com/bigkaa/goartstore/storage-element/internal/config"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/mode"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/model"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/wal"
+)
+
+// maxArchiveAttrSize — максимальный размер записи attr.json в архиве.
+// Совпадает с ограничением attr.Write (4 КБ).
+const maxArchiveAttrSize = 4096
+
+// ErrImportModeNotAllowed — импорт недоступен в текущем режиме SE.
+var ErrImportModeNotAllowed = errors.New("импорт недоступен в текущем режиме")
+
+// ExportFilter — фильтр файлов для экспорта.
+type ExportFilter struct {
+	// Status — фильтр по статусу ("" = все статусы)
+	Status model.FileStatus
+	// UploadedAfter — только файлы, загруженные после указанного времени
+	UploadedAfter *time.Time
+	// UploadedBefore — только файлы, загруженные до указанного времени
+	UploadedBefore *time.Time
+}
+
+// matches проверяет, попадает ли файл под фильтр по дате загрузки.
+// Фильтр по статусу применяется на уровне индекса.
+func (f ExportFilter) matches(meta *model.FileMetadata) bool {
+	if f.UploadedAfter != nil && !meta.UploadedAt.After(*f.UploadedAfter) {
+		return false
+	}
+	if f.UploadedBefore != nil && !meta.UploadedAt.Before(*f.UploadedBefore) {
+		return false
+	}
+	return true
+}
+
+// ExportResult — результат экспорта.
+type ExportResult struct {
+	// Exported — количество файлов, записанных в архив
+	Exported int
+	// Skipped — количество файлов, исчезнувших с диска во время экспорта
+	Skipped int
+	// Bytes — суммарный размер содержимого файлов в архиве
+	Bytes int64
+}
+
+// ArchiveService — сервис экспорта и импорта tar-архивов.
+type ArchiveService struct {
+	cfg       *config.Config
+	walEngine *wal.WAL
+	store     *filestore.FileStore
+	idx       *index.Index
+	sm        *mode.StateMachine
+	logger    *slog.Logger
+
+	// importing — file_id файлов, импорт которых выполняется
+	importMu  sync.Mutex
+	importing map[string]struct{}
+}
+
+// NewArchiveService создаёт сервис экспорта и импорта архивов.
+func NewArchiveService(
+	cfg *config.Config,
+	walEngine *wal.WAL,
+	store *filestore.FileStore,
+	idx *index.Index,
+	sm *mode.StateMachine,
+	logger *slog.Logger,
+) *ArchiveService {
+	return &ArchiveService{
+		cfg:       cfg,
+		walEngine: walEngine,
+		store:     store,
+		idx:       idx,
+		sm:        sm,
+		logger:    logger.With(slog.String("component", "archive_service")),
+		importing: make(map[string]struct{}),
+	}
+}
+
+// Export записывает tar-архив файлов, подходящих под фильтр, в w.
+// Ошибка возвращается только при сбое записи в w — файлы, которые
+// не удалось прочитать с диска (например, удалены GC), пропускаются.
+func (s *ArchiveService) Export(w io.Writer, filter ExportFilter) (*ExportResult, error) {
+	files, _ := s.idx.List(0, 0, filter.Status)
+
+	tw := tar.NewWriter(w)
+	result := &ExportResult{}
+
+	for _, meta := range files {
+		if !filter.matches(meta) {
+			continue
+		}
+
+		written, err := s.exportFile(tw, meta)
+		if err != nil {
+			return result, err
+		}
+		if !written {
+			result.Skipped++
+			continue
+		}
+		result.Exported++
+		result.Bytes += meta.Size
+	}
+
+	if err := tw.Close(); err != nil {
+		return result, fmt.Errorf("ошибка завершения tar-архива: %w", err)
+	}
+
+	middleware.OperationsTotal.WithLabelValues("export", "success").Inc()
+
+	s.logger.Info("Экспорт завершён",
+		slog.Int("exported", result.Exported),
+		slog.Int("skipped", result.Skipped),
+		slog.Int64("bytes", result.Bytes),
+	)
+
+	return result, nil
+}
+
+// exportFile пишет в архив attr.json и содержимое одного файла.
+// Возвращает false без ошибки, если файл недоступен на диске.
+func (s *ArchiveService) exportFile(tw *tar.Writer, meta *model.FileMetadata) (bool, error) {
+	// Файл открывается до чтения attr.json: открытый дескриптор
+	// остаётся валидным, даже если GC удалит файл во время экспорта.
+	file, err := s.store.ReadFile(meta.StoragePath)
+	if err != nil {
+		s.logger.Warn("Экспорт: файл недоступен, пропуск",
+			slog.String("file_id", meta.FileID),
+			slog.String("error", err.Error()),
+		)
+		return false, nil
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		s.logger.Warn("Экспорт: ошибка stat файла, пропуск",
+			slog.String("file_id", meta.FileID),
+			slog.String("error", err.Error()),
+		)
+		return false, nil
+	}
+
+	attrData, err := os.ReadFile(attr.AttrFilePath(s.store.FullPath(meta.StoragePath)))
+	if err != nil {
+		s.logger.Warn("Экспорт: attr.json недоступен, пропуск",
+			slog.String("file_id", meta.FileID),
+			slog.String("error", err.Error()),
+		)
+		return false, nil
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    meta.StoragePath + attr.AttrSuffix,
+		Mode:    0o640,
+		Size:    int64(len(attrData)),
+		ModTime: meta.UploadedAt,
+	}); err != nil {
+		return false, fmt.Errorf("ошибка записи заголовка attr.json %s: %w", meta.FileID, err)
+	}
+	if _, err := tw.Write(attrData); err != nil {
+		return false, fmt.Errorf("ошибка записи attr.json %s: %w", meta.FileID, err)
+	}
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    meta.StoragePath,
+		Mode:    0o640,
+		Size:    stat.Size(),
+		ModTime: meta.UploadedAt,
+	}); err != nil {
+		return false, fmt.Errorf("ошибка записи заголовка файла %s: %w", meta.FileID, err)
+	}
+	if _, err := io.CopyN(tw, file, stat.Size()); err != nil {
+		return false, fmt.Errorf("ошибка записи содержимого файла %s: %w", meta.FileID, err)
+	}
+
+	return true, nil
+}
+
+// Import читает tar-архив из r и импортирует файлы через WAL.
+// Ошибки отдельных файлов попадают в Issues и не прерывают импорт.
+// Повреждённый поток архива прерывает импорт — уже импортированные
+// файлы сохраняются, проблема фиксируется в Issues.
+//
+//nolint:gocognit // последовательный разбор пар записей архива
+func (s *ArchiveService) Import(r io.Reader) (*generated.ImportResponse, error) {
+	if !s.sm.CanPerform(mode.OpUpload) {
+		return nil, fmt.Errorf("%w: %s", ErrImportModeNotAllowed, s.sm.CurrentMode())
+	}
+
+	resp := &generated.ImportResponse{
+		StartedAt: time.Now().UTC(),
+		Issues:    []generated.ImportIssue{},
+	}
+
+	addIssue := func(reason generated.ImportIssueReason, fileID, name, description string) {
+		issue := generated.ImportIssue{
+			Reason:      reason,
+			Description: description,
+		}
+		if name != "" {
+			entryName := name
+			issue.Path = &entryName
+		}
+		if parsed, err := uuid.Parse(fileID); err == nil {
+			issue.FileId = &parsed
+		}
+		resp.Issues = append(resp.Issues, issue)
+		if reason == generated.AlreadyExists {
+			resp.Skipped++
+		} else {
+			resp.Failed++
+		}
+	}
+
+	tr := tar.NewReader(r)
+
+	// pending — метаданные из последней записи attr.json,
+	// ожидающие записи с содержимым файла.
+	var pending *model.FileMetadata
+	var pendingName string
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			addIssue(generated.InvalidEntry, "", "", fmt.Sprintf("Ошибка чтения архива: %s", err.Error()))
+			break
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(hdr.Name)
+
+		if attr.IsAttrFile(name) {
+			if pending != nil {
+				addIssue(generated.InvalidEntry, pending.FileID, pendingName, "attr.json без содержимого файла")
+			}
+			pending, pendingName = nil, ""
+
+			meta, parseErr := readArchiveAttr(tr, hdr)
+			if parseErr != nil {
+				addIssue(generated.InvalidEntry, "", name, parseErr.Error())
+				continue
+			}
+			pending, pendingName = meta, name
+			continue
+		}
+
+		if pending == nil || attr.DataFilePathFromAttr(pendingName) != name {
+			addIssue(generated.InvalidEntry, "", name, "Содержимое файла без предшествующего attr.json")
+			pending, pendingName = nil, ""
+			continue
+		}
+
+		meta := pending
+		pending, pendingName = nil, ""
+
+		if reason, description := s.importFile(tr, hdr.Size, meta); reason != "" {
+			addIssue(reason, meta.FileID, name, description)
+			continue
+		}
+		resp.Imported++
+	}
+
+	if pending != nil {
+		addIssue(generated.InvalidEntry, pending.FileID, pendingName, "attr.json без содержимого файла")
+	}
+
+	resp.CompletedAt = time.Now().UTC()
+
+	s.logger.Info("Импорт завершён",
+		slog.Int("imported", resp.Imported),
+		slog.Int("skipped", resp.Skipped),
+		slog.Int("failed", resp.Failed),
+		slog.Duration("duration", resp.CompletedAt.Sub(resp.StartedAt)),
+	)
+
+	return resp, nil
+}
+
+// readArchiveAttr читает и валидирует запись attr.json из архива.
+func readArchiveAttr(tr *tar.Reader, hdr *tar.Header) (*model.FileMetadata, error) {
+	if hdr.Size > maxArchiveAttrSize {
+		return nil, fmt.Errorf("размер attr.json (%d байт) превышает максимум (%d байт)", hdr.Size, maxArchiveAttrSize)
+	}
+
+	data, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения attr.json: %w", err)
+	}
+
+	var meta model.FileMetadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("ошибка десериализации attr.json: %w", err)
+	}
+
+	if _, err := uuid.Parse(meta.FileID); err != nil {
+		return nil, fmt.Errorf("некорректный file_id %q", meta.FileID)
+	}
+	switch meta.Status {
+	case model.StatusActive, model.StatusDeleted, model.StatusExpired:
+	default:
+		return nil, fmt.Errorf("недопустимый статус %q", meta.Status)
+	}
+	if meta.Checksum == "" {
+		return nil, fmt.Errorf("attr.json без checksum")
+	}
+
+	return &meta, nil
+}
+
+// importFile записывает один файл из архива через WAL.
+// Возвращает причину и описание, если файл не импортирован.
+func (s *ArchiveService) importFile(
+	reader io.Reader,
+	size int64,
+	meta *model.FileMetadata,
+) (generated.ImportIssueReason, string) {
+	if !s.reserveImport(meta.FileID) {
+		return generated.AlreadyExists, "Файл с таким file_id уже существует"
+	}
+	defer s.releaseImport(meta.FileID)
+
+	if size != meta.Size {
+		return generated.IntegrityError,
+			fmt.Sprintf("Размер записи архива %d байт не совпадает с attr.json (%d байт)", size, meta.Size)
+	}
+
+	if meta.Status == model.StatusActive && s.idx.TotalActiveSize()+meta.Size > s.cfg.MaxCapacity {
+		return generated.StorageFull,
+			fmt.Sprintf("Недостаточно места: требуется %d байт, доступно %d байт",
+				meta.Size, s.cfg.MaxCapacity-s.idx.TotalActiveSize())
+	}
+
+	walEntry, err := s.walEngine.StartTransaction(wal.OpFileCreate, meta.FileID)
+	if err != nil {
+		s.logger.Error("Импорт: ошибка создания WAL-транзакции",
+			slog.String("file_id", meta.FileID),
+			slog.String("error", err.Error()),
+		)
+		return generated.WriteError, "Внутренняя ошибка при создании транзакции"
+	}
+
+	var saved *filestore.SaveResult
+	rollback := func() {
+		if saved != nil {
+			_ = s.store.DeleteFile(saved.StoragePath)
+			_ = attr.Delete(attr.AttrFilePath(s.store.FullPath(saved.StoragePath)))
+		}
+		if rbErr := s.walEngine.Rollback(walEntry.TransactionID); rbErr != nil {
+			s.logger.Error("Импорт: ошибка отката WAL",
+				slog.String("tx_id", walEntry.TransactionID),
+				slog.String("error", rbErr.Error()),
+			)
+		}
+	}
+
+	saved, err = s.store.SaveFile(reader, meta.OriginalFilename, meta.UploadedBy)
+	if err != nil {
+		rollback()
+		s.logger.Error("Импорт: ошибка сохранения файла",
+			slog.String("file_id", meta.FileID),
+			slog.String("error", err.Error()),
+		)
+		return generated.WriteError, "Ошибка сохранения файла на диск"
+	}
+
+	if saved.Size != meta.Size || saved.Checksum != meta.Checksum {
+		rollback()
+		middleware.OperationsTotal.WithLabelValues("import", "integrity_error").Inc()
+		return generated.IntegrityError, "Checksum содержимого не совпадает с attr.json"
+	}
+
+	meta.StoragePath = saved.StoragePath
+
+	if err := s.store.WriteAttr(saved.StoragePath, meta); err != nil {
+		rollback()
+		s.logger.Error("Импорт: ошибка записи attr.json",
+			slog.String("file_id", meta.FileID),
+			slog.String("error", err.Error()),
+		)
+		return generated.WriteError, "Ошибка записи метаданных"
+	}
+
+	s.idx.Add(meta)
+
+	if err := s.walEngine.Commit(walEntry.TransactionID); err != nil {
+		s.logger.Error("Импорт: ошибка коммита WAL (данные сохранены)",
+			slog.String("tx_id", walEntry.TransactionID),
+			slog.String("file_id", meta.FileID),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	middleware.OperationsTotal.WithLabelValues("import", "success").Inc()
+	middleware.FilesTotal.WithLabelValues(string(meta.Status)).Inc()
+
+	s.logger.Debug("Файл импортирован",
+		slog.String("file_id", meta.FileID),
+		slog.String("filename", meta.OriginalFilename),
+		slog.Int64("size", meta.Size),
+	)
+
+	return "", ""
+}
+
+// reserveImport резервирует file_id за текущим импортом. Возвращает false,
+// если файл уже есть в индексе или импортируется параллельно: проверка
+// и запись файла должны выполняться атомарно для каждого file_id.
+func (s *ArchiveService) reserveImport(fileID string) bool {
+	s.importMu.Lock()
+	defer s.importMu.Unlock()
+
+	if _, ok := s.importing[fileID]; ok {
+		return false
+	}
+	if s.idx.Get(fileID) != nil {
+		return false
+	}
+	s.importing[fileID] = struct{}{}
+	return true
+}
+
+// releaseImport снимает резервирование file_id после импорта.
+func (s *ArchiveService) releaseImport(fileID string) {
+	s.importMu.Lock()
+	delete(s.importing, fileID)
+	s.importMu.Unlock()
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
+	"github.com/bigkaa/goartstore/storage-element/internal/config"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/mode"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/model"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/wal"
+)
+
+// setupArchiveTestEnv создаёт ArchiveService поверх временных директорий.
+func setupArchiveTestEnv(t *testing.T, storageMode mode.StorageMode) (string, *index.Index, *ArchiveService) {
+	t.Helper()
+
+	dir := t.TempDir()
+	store, err := filestore.New(dir)
+	if err != nil {
+		t.Fatalf("Ошибка создания FileStore: %v", err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	walEngine, err := wal.New(t.TempDir(), logger)
+	if err != nil {
+		t.Fatalf("Ошибка создания WAL: %v", err)
+	}
+
+	sm, err := mode.NewStateMachine(storageMode)
+	if err != nil {
+		t.Fatalf("Ошибка создания StateMachine: %v", err)
+	}
+
+	idx := index.New(logger)
+	cfg := &config.Config{MaxCapacity: 1 << 30}
+
+	return dir, idx, NewArchiveService(cfg, walEngine, store, idx, sm, logger)
+}
+
+// createArchiveTestFile создаёт файл с корректным checksum, attr.json и запись в индексе.
+func createArchiveTestFile(t *testing.T, dir string, idx *index.Index, fileID, content string, uploadedAt time.Time) *model.FileMetadata {
+	t.Helper()
+
+	sum := sha256.Sum256([]byte(content))
+	meta := &model.FileMetadata{
+		FileID:           fileID,
+		OriginalFilename: fileID + ".txt",
+		StoragePath:      fileID + ".txt",
+		ContentType:      "text/plain",
+		Size:             int64(len(content)),
+		Checksum:         hex.EncodeToString(sum[:]),
+		UploadedBy:       "test-user",
+		UploadedAt:       uploadedAt,
+		Status:           model.StatusActive,
+		RetentionPolicy:  model.RetentionPermanent,
+	}
+
+	filePath := filepath.Join(dir, meta.StoragePath)
+	if err := os.WriteFile(filePath, []byte(content), 0o640); err != nil {
+		t.Fatalf("Ошибка создания тестового файла: %v", err)
+	}
+	if err := attr.Write(attr.AttrFilePath(filePath), meta); err != nil {
+		t.Fatalf("Ошибка создания attr.json: %v", err)
+	}
+	idx.Add(meta)
+
+	return meta
+}
+
+// tarEntryNames возвращает имена записей tar-архива.
+func tarEntryNames(t *testing.T, data []byte) []string {
+	t.Helper()
+
+	var names []string
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Ошибка чтения архива: %v", err)
+		}
+		names = append(names, hdr.Name)
+	}
+	return names
+}
+
+func TestArchiveExportImport_Roundtrip(t *testing.T) {
+	srcDir, srcIdx, srcSvc := setupArchiveTestEnv(t, mode.ModeRW)
+	now := time.Now().UTC()
+
+	createArchiveTestFile(t, srcDir, srcIdx, "11111111-1111-1111-1111-111111111111", "first file", now.Add(-2*time.Hour))
+	createArchiveTestFile(t, srcDir, srcIdx, "22222222-2222-2222-2222-222222222222", "second file", now.Add(-time.Hour))
+
+	var buf bytes.Buffer
+	exported, err := srcSvc.Export(&buf, ExportFilter{})
+	if err != nil {
+		t.Fatalf("Export: неожиданная ошибка: %v", err)
+	}
+	if exported.Exported != 2 {
+		t.Fatalf("Exported: хотели 2, получили %d", exported.Exported)
+	}
+
+	names := tarEntryNames(t, buf.Bytes())
+	if len(names) != 4 {
+		t.Fatalf("Записей в архиве: хотели 4, получили %d (%v)", len(names), names)
+	}
+	if !attr.IsAttrFile(names[0]) || attr.DataFilePathFromAttr(names[0]) != names[1] {
+		t.Errorf("Порядок записей: attr.json должен предшествовать файлу, получили %v", names)
+	}
+
+	dstDir, dstIdx, dstSvc := setupArchiveTestEnv(t, mode.ModeEdit)
+	result, err := dstSvc.Import(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Import: неожиданная ошибка: %v", err)
+	}
+	if result.Imported != 2 || result.Skipped != 0 || result.Failed != 0 {
+		t.Fatalf("Import: хотели 2/0/0, получили %d/%d/%d (%+v)",
+			result.Imported, result.Skipped, result.Failed, result.Issues)
+	}
+
+	meta := dstIdx.Get("11111111-1111-1111-1111-111111111111")
+	if meta == nil {
+		t.Fatal("Импортированный файл отсутствует в индексе")
+	}
+	data, err := os.ReadFile(filepath.Join(dstDir, meta.StoragePath))
+	if err != nil {
+		t.Fatalf("Импортированный файл отсутствует на диске: %v", err)
+	}
+	if string(data) != "first file" {
+		t.Errorf("Содержимое: хотели %q, получили %q", "first file", string(data))
+	}
+	if _, err := attr.Read(attr.AttrFilePath(filepath.Join(dstDir, meta.StoragePath))); err != nil {
+		t.Errorf("attr.json импортированного файла: %v", err)
+	}
+	if dstIdx.TotalActiveSize() != srcIdx.TotalActiveSize() {
+		t.Errorf("TotalActiveSize: хотели %d, получили %d", srcIdx.TotalActiveSize(), dstIdx.TotalActiveSize())
+	}
+
+	// Повторный импорт — все file_id уже существуют
+	result, err = dstSvc.Import(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Повторный Import: неожиданная ошибка: %v", err)
+	}
+	if result.Imported != 0 || result.Skipped != 2 {
+		t.Errorf("Повторный Import: хотели imported=0 skipped=2, получили %d/%d", result.Imported, result.Skipped)
+	}
+	for _, issue := range result.Issues {
+		if issue.Reason != generated.AlreadyExists {
+			t.Errorf("Reason: хотели %s, получили %s", generated.AlreadyExists, issue.Reason)
+		}
+	}
+}
+
+// TestArchiveImport_ConcurrentSameFileID проверяет, что параллельные импорты
+// одного file_id записывают файл ровно один раз.
+func TestArchiveImport_ConcurrentSameFileID(t *testing.T) {
+	srcDir, srcIdx, srcSvc := setupArchiveTestEnv(t, mode.ModeRW)
+	createArchiveTestFile(t, srcDir, srcIdx, "33333333-3333-3333-3333-333333333333", "shared file", time.Now().UTC())
+
+	var buf bytes.Buffer
+	if _, err := srcSvc.Export(&buf, ExportFilter{}); err != nil {
+		t.Fatalf("Export: неожиданная ошибка: %v", err)
+	}
+
+	dstDir, dstIdx, dstSvc := setupArchiveTestEnv(t, mode.ModeEdit)
+
+	const workers = 8
+	results := make([]*generated.ImportResponse, workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := dstSvc.Import(bytes.NewReader(buf.Bytes()))
+			if err != nil {
+				t.Errorf("Import: неожиданная ошибка: %v", err)
+				return
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	imported, skipped := 0, 0
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		imported += result.Imported
+		skipped += result.Skipped
+	}
+	if imported != 1 || skipped != workers-1 {
+		t.Errorf("Import: хотели imported=1 skipped=%d, получили %d/%d", workers-1, imported, skipped)
+	}
+
+	dataFiles := 0
+	err := filepath.WalkDir(dstDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && !attr.IsAttrFile(path) {
+			dataFiles++
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Обход директории: %v", err)
+	}
+	if dataFiles != 1 {
+		t.Errorf("Файлов данных на диске: хотели 1, получили %d", dataFiles)
+	}
+	if dstIdx.TotalActiveSize() != int64(len("shared file")) {
+		t.Errorf("TotalActiveSize: хотели %d, получили %d", len("shared file"), dstIdx.TotalActiveSize())
+	}
+}
+
+func TestArchiveExport_FilterByDate(t *testing.T) {
+	dir, idx, svc := setupArchiveTestEnv(t, mode.ModeRW)
+	now := time.Now().UTC()
+
+	createArchiveTestFile(t, dir, idx, "11111111-1111-1111-1111-111111111111", "old", now.Add(-48*time.Hour))
+	createArchiveTestFile(t, dir, idx, "22222222-2222-2222-2222-222222222222", "new", now.Add(-time.Hour))
+
+	after := now.Add(-24 * time.Hour)
+	var buf bytes.Buffer
+	result, err := svc.Export(&buf, ExportFilter{UploadedAfter: &after})
+	if err != nil {
+		t.Fatalf("Export: неожиданная ошибка: %v", err)
+	}
+	if result.Exported != 1 {
+		t.Fatalf("Exported: хотели 1, получили %d", result.Exported)
+	}
+
+	names := tarEntryNames(t, buf.Bytes())
+	if len(names) != 2 || !strings.HasPrefix(names[1], "22222222") {
+		t.Errorf("В архиве должен быть только новый файл, получили %v", names)
+	}
+}
+
+func TestArchiveImport_ChecksumMismatch(t *testing.T) {
+	_, idx, svc := setupArchiveTestEnv(t, mode.ModeEdit)
+
+	meta := model.FileMetadata{
+		FileID:           "33333333-3333-3333-3333-333333333333",
+		OriginalFilename: "bad.txt",
+		StoragePath:      "bad.txt",
+		ContentType:      "text/plain",
+		Size:             4,
+		Checksum:         strings.Repeat("0", 64),
+		UploadedBy:       "test-user",
+		UploadedAt:       time.Now().UTC(),
+		Status:           model.StatusActive,
+		RetentionPolicy:  model.RetentionPermanent,
+	}
+	attrData, _ := json.Marshal(meta)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	_ = tw.WriteHeader(&tar.Header{Name: "bad.txt" + attr.AttrSuffix, Mode: 0o640, Size: int64(len(attrData))})
+	_, _ = tw.Write(attrData)
+	_ = tw.WriteHeader(&tar.Header{Name: "bad.txt", Mode: 0o640, Size: 4})
+	_, _ = tw.Write([]byte("data"))
+	_ = tw.Close()
+
+	result, err := svc.Import(&buf)
+	if err != nil {
+		t.Fatalf("Import: неожиданная ошибка: %v", err)
+	}
+	if result.Imported != 0 || result.Failed != 1 {
+		t.Fatalf("Import: хотели imported=0 failed=1, получили %d/%d", result.Imported, result.Failed)
+	}
+	if result.Issues[0].Reason != generated.IntegrityError {
+		t.Errorf("Reason: хотели %s, получили %s", generated.IntegrityError, result.Issues[0].Reason)
+	}
+	if idx.Count() != 0 {
+		t.Errorf("Индекс должен быть пустым, получили %d файлов", idx.Count())
+	}
+}
+
+func TestArchiveImport_ModeNotAllowed(t *testing.T) {
+	_, _, svc := setupArchiveTestEnv(t, mode.ModeRO)
+
+	_, err := svc.Import(bytes.NewReader(nil))
+	if !errors.Is(err, ErrImportModeNotAllowed) {
+		t.Errorf("Хотели ErrImportModeNotAllowed, получили %v", err)
+	}
+}
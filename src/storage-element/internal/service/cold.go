@@ -0,0 +1,531 @@
+// cold.go — сервис холодного архива (режим ar).
+//
+// При переходе ro → ar файлы данных упаковываются в сжатые бандлы
+// с индексом и контрольными суммами ({SE_DATA_DIR}/.archive), после чего
+// удаляются с дисков. attr.json остаются на месте и получают поле
+// archive_bundle — метаданные и листинг продолжают работать.
+//
+// Для скачивания архивного файла выполняется restore: файл распаковывается
+// с проверкой SHA-256 во временную копию ({SE_DATA_DIR}/.restore/{file_id}),
+// доступную для download в течение SE_RESTORE_TTL.
+//
+// Упаковка идемпотентна и возобновляется при старте leader в режиме ar:
+// завершённые бандлы доводятся до конца (attr.json, удаление файлов),
+// незавершённые .bin удаляются.
+package service
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
+	"github.com/bigkaa/goartstore/storage-element/internal/config"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/mode"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/model"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/bundle"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
+)
+
+const (
+	// archiveDirName — директория бандлов в основной директории данных
+	archiveDirName = ".archive"
+	// restoreDirName — директория восстановленных копий в основной директории данных
+	restoreDirName = ".restore"
+	// restoreCleanupInterval — интервал удаления просроченных восстановленных копий
+	restoreCleanupInterval = 5 * time.Minute
+)
+
+// Prometheus метрики холодного архива
+var (
+	// coldPackedFilesTotal — количество файлов, обработанных упаковкой.
+	coldPackedFilesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "se_archive_packed_files_total",
+		Help: "Общее количество файлов, обработанных упаковкой в архивные бандлы",
+	}, []string{"result"})
+
+	// coldBundlesTotal — количество созданных бандлов.
+	coldBundlesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "se_archive_bundles_created_total",
+		Help: "Общее количество созданных архивных бандлов",
+	})
+
+	// coldRestoresTotal — количество restore-запросов.
+	coldRestoresTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "se_archive_restores_total",
+		Help: "Общее количество restore-запросов архивных файлов",
+	}, []string{"result"})
+)
+
+// Ошибки холодного архива.
+var (
+	// ErrNotArchiveMode — операция доступна только в режиме ar.
+	ErrNotArchiveMode = errors.New("операция доступна только в режиме ar")
+	// ErrFileNotFound — файл не найден в индексе.
+	ErrFileNotFound = errors.New("файл не найден")
+	// ErrNotRestored — восстановленной копии нет или срок её хранения истёк.
+	ErrNotRestored = errors.New("файл не восстановлен из архива")
+)
+
+// PackResult — результат упаковки.
+type PackResult struct {
+	Bundles int
+	Packed  int
+	Failed  int
+}
+
+// ColdService — сервис упаковки в архивные бандлы и restore.
+type ColdService struct {
+	store      *filestore.FileStore
+	idx        *index.Index
+	sm         *mode.StateMachine
+	archiveDir string
+	restoreDir string
+	bundleSize int64
+	restoreTTL time.Duration
+	logger     *slog.Logger
+
+	packMu  sync.Mutex // защита packing
+	packing bool
+
+	restoreMu sync.Mutex // сериализация restore
+	cancel    context.CancelFunc
+}
+
+// NewColdService создаёт сервис холодного архива.
+// Бандлы и восстановленные копии хранятся на основном диске (SE_DATA_DIR).
+func NewColdService(
+	cfg *config.Config,
+	store *filestore.FileStore,
+	idx *index.Index,
+	sm *mode.StateMachine,
+	logger *slog.Logger,
+) *ColdService {
+	return &ColdService{
+		store:      store,
+		idx:        idx,
+		sm:         sm,
+		archiveDir: filepath.Join(store.DataDir(), archiveDirName),
+		restoreDir: filepath.Join(store.DataDir(), restoreDirName),
+		bundleSize: cfg.ArchiveBundleSize,
+		restoreTTL: cfg.RestoreTTL,
+		logger:     logger.With(slog.String("component", "cold_archive")),
+	}
+}
+
+// Start запускает очистку просроченных копий и, в режиме ar,
+// возобновляет упаковку. Вызывается на leader (или standalone).
+func (s *ColdService) Start(ctx context.Context) {
+	coldCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	go s.run(coldCtx)
+
+	if s.sm.CurrentMode() == mode.ModeAR {
+		s.StartPacking()
+	}
+
+	s.logger.Info("Холодный архив запущен",
+		slog.String("archive_dir", s.archiveDir),
+		slog.String("restore_ttl", s.restoreTTL.String()),
+	)
+}
+
+// Stop останавливает фоновую очистку. Выполняющаяся упаковка
+// завершает текущий бандл и продолжится при следующем Start.
+func (s *ColdService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.logger.Info("Холодный архив остановлен")
+}
+
+// run — основной цикл фоновой горутины.
+func (s *ColdService) run(ctx context.Context) {
+	ticker := time.NewTicker(restoreCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.CleanupRestored()
+		}
+	}
+}
+
+// StartPacking запускает упаковку в фоне. Повторный вызов во время
+// упаковки игнорируется.
+func (s *ColdService) StartPacking() {
+	go func() {
+		if _, err := s.Pack(); err != nil && !errors.Is(err, errPackInProgress) {
+			s.logger.Error("Ошибка упаковки в архивные бандлы",
+				slog.String("error", err.Error()),
+			)
+		}
+	}()
+}
+
+// IsPacking возвращает true, если выполняется упаковка.
+func (s *ColdService) IsPacking() bool {
+	s.packMu.Lock()
+	defer s.packMu.Unlock()
+	return s.packing
+}
+
+// errPackInProgress — упаковка уже выполняется.
+var errPackInProgress = errors.New("упаковка уже выполняется")
+
+// Pack упаковывает в бандлы все файлы, ещё не перенесённые в архив.
+// Доступна только в режиме ar. Ошибки отдельных файлов не прерывают
+// упаковку — такие файлы остаются на диске и обрабатываются повторно.
+func (s *ColdService) Pack() (*PackResult, error) {
+	if s.sm.CurrentMode() != mode.ModeAR {
+		return nil, ErrNotArchiveMode
+	}
+
+	s.packMu.Lock()
+	if s.packing {
+		s.packMu.Unlock()
+		return nil, errPackInProgress
+	}
+	s.packing = true
+	s.packMu.Unlock()
+
+	defer func() {
+		s.packMu.Lock()
+		s.packing = false
+		s.packMu.Unlock()
+	}()
+
+	start := time.Now()
+	result := &PackResult{}
+
+	// 1. Доводим до конца бандлы, прерванные до обновления attr.json
+	if err := s.finishBundles(); err != nil {
+		return result, err
+	}
+
+	// 2. Упаковываем оставшиеся файлы (старые первыми)
+	files, _ := s.idx.List(0, 0, "")
+	var w *bundle.Writer
+	closeBundle := func() error {
+		if w == nil {
+			return nil
+		}
+		writer := w
+		w = nil
+		if writer.Len() == 0 {
+			writer.Abort()
+			return nil
+		}
+		bundleIdx, err := writer.Close()
+		if err != nil {
+			writer.Abort()
+			return err
+		}
+		coldBundlesTotal.Inc()
+		result.Bundles++
+		s.commitBundle(bundleIdx)
+		return nil
+	}
+
+	for i := len(files) - 1; i >= 0; i-- {
+		meta := files[i]
+		if meta.IsArchived() {
+			continue
+		}
+
+		if w == nil {
+			var err error
+			w, err = bundle.Create(s.archiveDir, newBundleID(), gzip.DefaultCompression)
+			if err != nil {
+				return result, err
+			}
+		}
+
+		if err := s.addToBundle(w, meta); err != nil {
+			result.Failed++
+			coldPackedFilesTotal.WithLabelValues("error").Inc()
+			s.logger.Error("Ошибка упаковки файла, файл остаётся на диске",
+				slog.String("file_id", meta.FileID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		result.Packed++
+		coldPackedFilesTotal.WithLabelValues("success").Inc()
+
+		if w.Size() >= s.bundleSize {
+			if err := closeBundle(); err != nil {
+				return result, err
+			}
+		}
+	}
+	if err := closeBundle(); err != nil {
+		return result, err
+	}
+
+	s.logger.Info("Упаковка в архивные бандлы завершена",
+		slog.Int("bundles", result.Bundles),
+		slog.Int("packed", result.Packed),
+		slog.Int("failed", result.Failed),
+		slog.Duration("duration", time.Since(start)),
+	)
+
+	return result, nil
+}
+
+// addToBundle добавляет файл данных в бандл с проверкой checksum.
+func (s *ColdService) addToBundle(w *bundle.Writer, meta *model.FileMetadata) error {
+	file, err := s.store.ReadFile(meta.StoragePath)
+	if err != nil {
+		return fmt.Errorf("ошибка открытия файла: %w", err)
+	}
+	defer file.Close()
+
+	_, err = w.Add(meta.FileID, meta.StoragePath, meta.Checksum, file)
+	return err
+}
+
+// finishBundles доводит до конца все завершённые бандлы: отмечает
+// файлы в attr.json и удаляет оставшиеся файлы данных.
+func (s *ColdService) finishBundles() error {
+	indexes, err := bundle.List(s.archiveDir, true)
+	if err != nil {
+		return err
+	}
+	for _, bundleIdx := range indexes {
+		s.commitBundle(bundleIdx)
+	}
+	return nil
+}
+
+// commitBundle отмечает файлы бандла в attr.json и индексе,
+// затем удаляет файлы данных с дисков. Идемпотентна.
+func (s *ColdService) commitBundle(bundleIdx *bundle.Index) {
+	for _, entry := range bundleIdx.Entries {
+		meta := s.idx.Get(entry.FileID)
+		if meta == nil {
+			continue
+		}
+		// Файл мог быть уже упакован в другой бандл (повторная упаковка после сбоя)
+		if meta.IsArchived() && meta.ArchiveBundle != bundleIdx.ID {
+			continue
+		}
+
+		if !meta.IsArchived() {
+			meta.ArchiveBundle = bundleIdx.ID
+			if err := s.store.WriteAttr(meta.StoragePath, meta); err != nil {
+				s.logger.Error("Ошибка записи attr.json архивного файла",
+					slog.String("file_id", meta.FileID),
+					slog.String("error", err.Error()),
+				)
+				continue
+			}
+			_ = s.idx.Update(meta)
+		}
+
+		if err := s.store.DeleteFile(meta.StoragePath); err != nil && !os.IsNotExist(err) {
+			s.logger.Warn("Ошибка удаления упакованного файла данных",
+				slog.String("file_id", meta.FileID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// Restore восстанавливает файл из архива во временную копию для download.
+// Повторный restore продлевает срок хранения копии.
+func (s *ColdService) Restore(fileID string) (*generated.RestoreResponse, error) {
+	if s.sm.CurrentMode() != mode.ModeAR {
+		return nil, ErrNotArchiveMode
+	}
+	meta := s.idx.Get(fileID)
+	if meta == nil {
+		return nil, ErrFileNotFound
+	}
+
+	s.restoreMu.Lock()
+	defer s.restoreMu.Unlock()
+
+	stagedPath := s.stagedPath(fileID)
+	now := time.Now()
+
+	if _, err := os.Stat(stagedPath); err == nil {
+		// Копия уже есть — продлеваем срок хранения
+		if err := os.Chtimes(stagedPath, now, now); err != nil {
+			return nil, fmt.Errorf("ошибка продления копии %s: %w", fileID, err)
+		}
+	} else if err := s.stage(meta, stagedPath); err != nil {
+		coldRestoresTotal.WithLabelValues("error").Inc()
+		return nil, err
+	}
+
+	coldRestoresTotal.WithLabelValues("success").Inc()
+	s.logger.Info("Файл восстановлен из архива",
+		slog.String("file_id", fileID),
+		slog.String("bundle", meta.ArchiveBundle),
+	)
+
+	parsedID, _ := uuid.Parse(fileID)
+	return &generated.RestoreResponse{
+		FileId:     parsedID,
+		RestoredAt: now.UTC(),
+		ExpiresAt:  now.Add(s.restoreTTL).UTC(),
+	}, nil
+}
+
+// stage распаковывает файл во временную копию (через .tmp + rename).
+// Файл, ещё не упакованный в бандл, копируется с диска.
+func (s *ColdService) stage(meta *model.FileMetadata, stagedPath string) error {
+	var (
+		src io.ReadCloser
+		err error
+	)
+	if meta.IsArchived() {
+		src, err = s.openArchived(meta)
+	} else {
+		src, err = s.store.ReadFile(meta.StoragePath)
+	}
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(s.restoreDir, 0o750); err != nil {
+		return fmt.Errorf("ошибка создания директории restore: %w", err)
+	}
+
+	tmpPath := stagedPath + ".tmp"
+	dst, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("ошибка создания копии %s: %w", meta.FileID, err)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("ошибка распаковки %s: %w", meta.FileID, err)
+	}
+	if err := dst.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("ошибка записи копии %s: %w", meta.FileID, err)
+	}
+	if err := os.Rename(tmpPath, stagedPath); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("ошибка записи копии %s: %w", meta.FileID, err)
+	}
+	return nil
+}
+
+// openArchived открывает содержимое файла из бандла с проверкой checksum.
+func (s *ColdService) openArchived(meta *model.FileMetadata) (io.ReadCloser, error) {
+	bundleIdx, err := bundle.ReadIndex(s.archiveDir, meta.ArchiveBundle)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range bundleIdx.Entries {
+		if entry.FileID == meta.FileID {
+			return bundle.Open(s.archiveDir, bundleIdx.ID, entry)
+		}
+	}
+	return nil, fmt.Errorf("файл %s отсутствует в индексе бандла %s", meta.FileID, bundleIdx.ID)
+}
+
+// OpenRestored открывает восстановленную копию файла.
+// Возвращает ErrNotRestored, если копии нет или срок её хранения истёк.
+func (s *ColdService) OpenRestored(fileID string) (*os.File, error) {
+	stagedPath := s.stagedPath(fileID)
+	info, err := os.Stat(stagedPath)
+	if err != nil || time.Since(info.ModTime()) > s.restoreTTL {
+		return nil, ErrNotRestored
+	}
+	return os.Open(stagedPath)
+}
+
+// CleanupRestored удаляет восстановленные копии с истёкшим сроком хранения.
+func (s *ColdService) CleanupRestored() int {
+	entries, err := os.ReadDir(s.restoreDir)
+	if err != nil {
+		return 0
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		info, infoErr := entry.Info()
+		if infoErr != nil || time.Since(info.ModTime()) <= s.restoreTTL {
+			continue
+		}
+		if os.Remove(filepath.Join(s.restoreDir, entry.Name())) == nil {
+			removed++
+		}
+	}
+
+	if removed > 0 {
+		s.logger.Info("Удалены просроченные восстановленные копии",
+			slog.Int("removed", removed),
+		)
+	}
+	return removed
+}
+
+// Status возвращает состояние холодного архива.
+func (s *ColdService) Status() (*generated.ArchiveStatus, error) {
+	indexes, err := bundle.List(s.archiveDir, false)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &generated.ArchiveStatus{
+		Packing: s.IsPacking(),
+		Bundles: len(indexes),
+	}
+	for _, bundleIdx := range indexes {
+		status.CompressedBytes += bundleIdx.Size
+		status.OriginalBytes += bundleIdx.OriginalSize()
+	}
+
+	files, _ := s.idx.List(0, 0, "")
+	for _, meta := range files {
+		if meta.IsArchived() {
+			status.ArchivedFiles++
+		} else {
+			status.PendingFiles++
+		}
+	}
+
+	if entries, readErr := os.ReadDir(s.restoreDir); readErr == nil {
+		for _, entry := range entries {
+			info, infoErr := entry.Info()
+			if infoErr == nil && time.Since(info.ModTime()) <= s.restoreTTL {
+				status.RestoredFiles++
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// stagedPath возвращает путь к восстановленной копии файла.
+func (s *ColdService) stagedPath(fileID string) string {
+	return filepath.Join(s.restoreDir, fileID)
+}
+
+// newBundleID формирует идентификатор бандла: время создания + случайный суффикс.
+// Лексикографический порядок идентификаторов совпадает с порядком создания.
+func newBundleID() string {
+	return time.Now().UTC().Format("20060102T150405Z") + "-" + uuid.New().String()[:8]
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/config"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/mode"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/quota"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/wal"
+)
+
+// coldTestEnv — окружение теста холодного архива.
+type coldTestEnv struct {
+	store    *filestore.FileStore
+	idx      *index.Index
+	sm       *mode.StateMachine
+	cold     *ColdService
+	download *DownloadService
+	files    map[string][]byte // file_id → содержимое
+}
+
+// setupColdTestEnv загружает файлы в режиме rw и переводит SE в ar.
+func setupColdTestEnv(t *testing.T, contents ...string) *coldTestEnv {
+	t.Helper()
+
+	dir := t.TempDir()
+	store, err := filestore.New(dir)
+	if err != nil {
+		t.Fatalf("Ошибка создания FileStore: %v", err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	walEngine, err := wal.New(t.TempDir(), logger)
+	if err != nil {
+		t.Fatalf("Ошибка создания WAL: %v", err)
+	}
+
+	sm, err := mode.NewStateMachine(mode.ModeRW)
+	if err != nil {
+		t.Fatalf("Ошибка создания StateMachine: %v", err)
+	}
+
+	idx := index.New(logger)
+	cfg := &config.Config{
+		MaxFileSize:       1 << 20,
+		MaxCapacity:       1 << 30,
+		ArchiveBundleSize: 64, // маленький бандл — по бандлу на файл
+		RestoreTTL:        time.Hour,
+	}
+
+	uploadSvc := NewUploadService(cfg, walEngine, store, idx, sm, quota.New(filepath.Join(dir, "quotas.json"), logger), logger)
+	files := make(map[string][]byte, len(contents))
+	for _, content := range contents {
+		data := bytes.Repeat([]byte(content), 50)
+		result, uploadErr := uploadSvc.Upload(UploadParams{
+			Reader:           bytes.NewReader(data),
+			OriginalFilename: "file.txt",
+			ContentType:      "text/plain",
+			Size:             int64(len(data)),
+			UploadedBy:       "admin",
+		})
+		if uploadErr != nil {
+			t.Fatalf("Ошибка загрузки: %v", uploadErr)
+		}
+		files[result.Metadata.FileID] = data
+	}
+
+	for _, target := range []mode.StorageMode{mode.ModeRO, mode.ModeAR} {
+		if err := sm.TransitionTo(target, false, "admin"); err != nil {
+			t.Fatalf("Ошибка перехода в %s: %v", target, err)
+		}
+	}
+
+	cold := NewColdService(cfg, store, idx, sm, logger)
+	return &coldTestEnv{
+		store:    store,
+		idx:      idx,
+		sm:       sm,
+		cold:     cold,
+		download: NewDownloadService(store, idx, sm, cold, logger),
+		files:    files,
+	}
+}
+
+// serve выполняет download и возвращает код ответа и тело.
+func (env *coldTestEnv) serve(fileID string) (int, []byte) {
+	rec := httptest.NewRecorder()
+	if dlErr := env.download.Serve(rec, httptest.NewRequest("GET", "/", nil), fileID); dlErr != nil {
+		return dlErr.StatusCode, []byte(dlErr.Code)
+	}
+	body, _ := io.ReadAll(rec.Body)
+	return rec.Code, body
+}
+
+func TestColdPack_RestoreAndDownload(t *testing.T) {
+	env := setupColdTestEnv(t, "first", "second", "third")
+
+	result, err := env.cold.Pack()
+	if err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+	if result.Packed != 3 || result.Failed != 0 || result.Bundles != 3 {
+		t.Errorf("Pack: хотели packed=3 failed=0 bundles=3, получили %+v", result)
+	}
+
+	for fileID, data := range env.files {
+		meta := env.idx.Get(fileID)
+		if !meta.IsArchived() {
+			t.Fatalf("Файл %s не отмечен как архивный", fileID)
+		}
+		if env.store.FileExists(meta.StoragePath) {
+			t.Errorf("Файл данных %s не удалён после упаковки", fileID)
+		}
+		onDisk, err := attr.Read(attr.AttrFilePath(env.store.FullPath(meta.StoragePath)))
+		if err != nil || onDisk.ArchiveBundle != meta.ArchiveBundle {
+			t.Errorf("attr.json %s не содержит archive_bundle: %v", fileID, err)
+		}
+
+		// До restore — RESTORE_REQUIRED
+		if code, body := env.serve(fileID); code != 409 || string(body) != "RESTORE_REQUIRED" {
+			t.Errorf("Download до restore: хотели 409 RESTORE_REQUIRED, получили %d %s", code, body)
+		}
+
+		resp, err := env.cold.Restore(fileID)
+		if err != nil {
+			t.Fatalf("Restore %s: %v", fileID, err)
+		}
+		if resp.ExpiresAt.Sub(resp.RestoredAt) != time.Hour {
+			t.Errorf("Срок хранения копии: хотели 1h, получили %s", resp.ExpiresAt.Sub(resp.RestoredAt))
+		}
+
+		code, body := env.serve(fileID)
+		if code != 200 || !bytes.Equal(body, data) {
+			t.Errorf("Download после restore: код %d, содержимое совпадает: %v", code, bytes.Equal(body, data))
+		}
+	}
+
+	status, err := env.cold.Status()
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if status.Bundles != 3 || status.ArchivedFiles != 3 || status.PendingFiles != 0 || status.RestoredFiles != 3 {
+		t.Errorf("Status: получили %+v", status)
+	}
+
+	// Повторная упаковка — файлов для упаковки нет
+	result, err = env.cold.Pack()
+	if err != nil || result.Packed != 0 || result.Bundles != 0 {
+		t.Errorf("Повторный Pack: %+v, %v", result, err)
+	}
+}
+
+func TestColdRestore_Expiry(t *testing.T) {
+	env := setupColdTestEnv(t, "only")
+	if _, err := env.cold.Pack(); err != nil {
+		t.Fatalf("Pack: %v", err)
+	}
+
+	var fileID string
+	for id := range env.files {
+		fileID = id
+	}
+	if _, err := env.cold.Restore(fileID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	// Копия старше SE_RESTORE_TTL недоступна и удаляется очисткой
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(env.cold.stagedPath(fileID), old, old); err != nil {
+		t.Fatalf("Chtimes: %v", err)
+	}
+	if _, err := env.cold.OpenRestored(fileID); !errors.Is(err, ErrNotRestored) {
+		t.Errorf("OpenRestored: хотели ErrNotRestored, получили %v", err)
+	}
+	if removed := env.cold.CleanupRestored(); removed != 1 {
+		t.Errorf("CleanupRestored: хотели 1, получили %d", removed)
+	}
+}
+
+func TestColdRestore_Errors(t *testing.T) {
+	env := setupColdTestEnv(t)
+
+	if _, err := env.cold.Restore("00000000-0000-0000-0000-000000000000"); !errors.Is(err, ErrFileNotFound) {
+		t.Errorf("Хотели ErrFileNotFound, получили %v", err)
+	}
+
+	env.sm.ForceMode(mode.ModeRO)
+	if _, err := env.cold.Restore("00000000-0000-0000-0000-000000000000"); !errors.Is(err, ErrNotArchiveMode) {
+		t.Errorf("Хотели ErrNotArchiveMode, получили %v", err)
+	}
+	if _, err := env.cold.Pack(); !errors.Is(err, ErrNotArchiveMode) {
+		t.Errorf("Pack: хотели ErrNotArchiveMode, получили %v", err)
+	}
+}
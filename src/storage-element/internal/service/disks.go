@@ -0,0 +1,255 @@
+// disks.go — сервис директорий данных (JBOD).
+//
+// Выполняет периодическую проверку записи на каждый диск
+// (SE_DISK_CHECK_INTERVAL), обновляет метрики ёмкости по дискам
+// и переносит файлы с диска (drain) перед его выводом из эксплуатации.
+//
+// Отказавший диск исключается из размещения новых файлов,
+// SE продолжает работу на остальных дисках.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
+)
+
+// Prometheus метрики дисков
+var (
+	// diskUp — доступность диска (1 — online/draining, 0 — failed).
+	diskUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "se_disk_up",
+		Help: "Доступность директории данных (1 — доступна, 0 — отказ)",
+	}, []string{"disk"})
+
+	// diskTotalBytes — размер файловой системы диска.
+	diskTotalBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "se_disk_total_bytes",
+		Help: "Размер файловой системы директории данных в байтах",
+	}, []string{"disk"})
+
+	// diskAvailableBytes — доступное место на диске.
+	diskAvailableBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "se_disk_available_bytes",
+		Help: "Доступное место в директории данных в байтах",
+	}, []string{"disk"})
+
+	// diskFiles — количество файлов данных на диске.
+	diskFiles = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "se_disk_files",
+		Help: "Количество файлов данных в директории данных",
+	}, []string{"disk"})
+
+	// diskDrainedFilesTotal — количество файлов, перенесённых с дисков.
+	diskDrainedFilesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "se_disk_drained_files_total",
+		Help: "Общее количество файлов, перенесённых с директории данных",
+	}, []string{"disk", "result"})
+)
+
+// ErrDrainInProgress — перенос файлов уже выполняется.
+var ErrDrainInProgress = errors.New("перенос файлов уже выполняется")
+
+// DiskService — сервис проверки дисков и переноса файлов.
+type DiskService struct {
+	store    *filestore.FileStore
+	interval time.Duration
+	logger   *slog.Logger
+
+	mu        sync.Mutex // защита lastState
+	lastState map[string]filestore.DiskState
+
+	drainMu    sync.Mutex // защита от параллельного drain
+	inProgress bool
+	cancel     context.CancelFunc
+}
+
+// NewDiskService создаёт сервис дисков.
+func NewDiskService(
+	store *filestore.FileStore,
+	interval time.Duration,
+	logger *slog.Logger,
+) *DiskService {
+	return &DiskService{
+		store:     store,
+		interval:  interval,
+		logger:    logger.With(slog.String("component", "disks")),
+		lastState: make(map[string]filestore.DiskState),
+	}
+}
+
+// Start запускает фоновую проверку дисков с периодическим тикером.
+func (s *DiskService) Start(ctx context.Context) {
+	diskCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	go s.run(diskCtx)
+
+	s.logger.Info("Проверка дисков запущена",
+		slog.String("interval", s.interval.String()),
+		slog.Int("disks", len(s.store.DataDirs())),
+	)
+}
+
+// Stop останавливает фоновую проверку дисков.
+func (s *DiskService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.logger.Info("Проверка дисков остановлена")
+}
+
+// run — основной цикл фоновой горутины.
+func (s *DiskService) run(ctx context.Context) {
+	s.RunOnce()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunOnce()
+		}
+	}
+}
+
+// RunOnce проверяет диски, логирует смену состояния и обновляет метрики.
+func (s *DiskService) RunOnce() []filestore.DiskStatus {
+	disks := s.store.CheckDisks()
+
+	s.mu.Lock()
+	for _, d := range disks {
+		prev, known := s.lastState[d.Path]
+		if known && prev != d.State {
+			switch d.State {
+			case filestore.DiskFailed:
+				s.logger.Error("Диск недоступен, исключён из размещения",
+					slog.String("disk", d.Path),
+				)
+			case filestore.DiskOnline:
+				s.logger.Info("Диск снова доступен",
+					slog.String("disk", d.Path),
+				)
+			}
+		} else if !known && d.State == filestore.DiskFailed {
+			s.logger.Error("Диск недоступен при старте, исключён из размещения",
+				slog.String("disk", d.Path),
+			)
+		}
+		s.lastState[d.Path] = d.State
+	}
+	s.mu.Unlock()
+
+	updateDiskMetrics(disks)
+	return disks
+}
+
+// IsInProgress возвращает true, если выполняется перенос файлов.
+func (s *DiskService) IsInProgress() bool {
+	s.drainMu.Lock()
+	defer s.drainMu.Unlock()
+	return s.inProgress
+}
+
+// Drain переносит все файлы с диска на остальные диски.
+// Диск переводится в draining и остаётся исключённым из размещения
+// до перезапуска SE. Ошибки переноса отдельных файлов не прерывают drain.
+//
+// Возвращает ErrDrainInProgress, filestore.ErrDiskNotFound
+// или filestore.ErrNoDiskAvailable.
+func (s *DiskService) Drain(path string) (*generated.DrainResponse, error) {
+	s.drainMu.Lock()
+	if s.inProgress {
+		s.drainMu.Unlock()
+		return nil, ErrDrainInProgress
+	}
+	s.inProgress = true
+	s.drainMu.Unlock()
+
+	defer func() {
+		s.drainMu.Lock()
+		s.inProgress = false
+		s.drainMu.Unlock()
+	}()
+
+	path = filepath.Clean(path)
+
+	if !s.store.HasPlacementTarget(path) {
+		return nil, filestore.ErrNoDiskAvailable
+	}
+	// Сначала исключаем диск из размещения, затем берём список файлов —
+	// новые файлы на диск уже не попадут
+	if err := s.store.SetDraining(path, true); err != nil {
+		return nil, err
+	}
+	files, err := s.store.FilesOnDisk(path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &generated.DrainResponse{
+		Disk:      path,
+		StartedAt: time.Now().UTC(),
+	}
+
+	s.logger.Info("Перенос файлов с диска начат",
+		slog.String("disk", path),
+		slog.Int("files", len(files)),
+	)
+
+	for _, storagePath := range files {
+		size, moveErr := s.store.Relocate(storagePath)
+		if moveErr != nil {
+			resp.FilesFailed++
+			diskDrainedFilesTotal.WithLabelValues(path, "error").Inc()
+			s.logger.Error("Ошибка переноса файла",
+				slog.String("disk", path),
+				slog.String("storage_path", storagePath),
+				slog.String("error", moveErr.Error()),
+			)
+			continue
+		}
+		resp.FilesMoved++
+		resp.BytesMoved += size
+		diskDrainedFilesTotal.WithLabelValues(path, "success").Inc()
+	}
+
+	resp.CompletedAt = time.Now().UTC()
+	updateDiskMetrics(s.store.Disks())
+
+	s.logger.Info("Перенос файлов с диска завершён",
+		slog.String("disk", path),
+		slog.Int("files_moved", resp.FilesMoved),
+		slog.Int("files_failed", resp.FilesFailed),
+		slog.Int64("bytes_moved", resp.BytesMoved),
+		slog.Duration("duration", resp.CompletedAt.Sub(resp.StartedAt)),
+	)
+
+	return resp, nil
+}
+
+// updateDiskMetrics обновляет Prometheus метрики по дискам.
+func updateDiskMetrics(disks []filestore.DiskStatus) {
+	for _, d := range disks {
+		up := 1.0
+		if d.State == filestore.DiskFailed {
+			up = 0
+		}
+		diskUp.WithLabelValues(d.Path).Set(up)
+		diskTotalBytes.WithLabelValues(d.Path).Set(float64(d.TotalBytes))
+		diskAvailableBytes.WithLabelValues(d.Path).Set(float64(d.AvailableBytes))
+		diskFiles.WithLabelValues(d.Path).Set(float64(d.FilesCount))
+	}
+}
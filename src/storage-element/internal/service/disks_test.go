@@ -0,0 +1,82 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
+)
+
+// setupDiskTestEnv создаёт FileStore с двумя дисками и DiskService.
+func setupDiskTestEnv(t *testing.T) ([]string, *filestore.FileStore, *DiskService) {
+	t.Helper()
+
+	dirs := []string{t.TempDir(), t.TempDir()}
+	store, err := filestore.NewMultiDir(dirs, filestore.PlacementRoundRobin)
+	if err != nil {
+		t.Fatalf("Ошибка создания FileStore: %v", err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	return dirs, store, NewDiskService(store, time.Hour, logger)
+}
+
+func TestDiskDrain_MovesAllFiles(t *testing.T) {
+	dirs, store, svc := setupDiskTestEnv(t)
+
+	for range 4 {
+		saved, err := store.SaveFile(bytes.NewReader([]byte("data")), "file.txt", "admin")
+		if err != nil {
+			t.Fatalf("Ошибка сохранения: %v", err)
+		}
+		if err := os.WriteFile(attr.AttrFilePath(saved.FullPath), []byte("{}"), 0o640); err != nil {
+			t.Fatalf("Ошибка создания attr.json: %v", err)
+		}
+	}
+
+	result, err := svc.Drain(dirs[0])
+	if err != nil {
+		t.Fatalf("Drain: неожиданная ошибка: %v", err)
+	}
+	if result.FilesMoved != 2 || result.FilesFailed != 0 {
+		t.Errorf("Drain: хотели moved=2 failed=0, получили %d/%d", result.FilesMoved, result.FilesFailed)
+	}
+	if result.BytesMoved != 8 {
+		t.Errorf("BytesMoved: хотели 8, получили %d", result.BytesMoved)
+	}
+
+	entries, _ := filepath.Glob(filepath.Join(dirs[0], "file_*"))
+	if len(entries) != 0 {
+		t.Errorf("На диске после drain остались файлы: %v", entries)
+	}
+
+	disks := store.Disks()
+	if disks[0].State != filestore.DiskDraining {
+		t.Errorf("Состояние диска: хотели %s, получили %s", filestore.DiskDraining, disks[0].State)
+	}
+	if disks[1].FilesCount != 4 {
+		t.Errorf("Файлов на втором диске: хотели 4, получили %d", disks[1].FilesCount)
+	}
+}
+
+func TestDiskDrain_Errors(t *testing.T) {
+	dirs, store, svc := setupDiskTestEnv(t)
+
+	if _, err := svc.Drain("/nonexistent"); !errors.Is(err, filestore.ErrDiskNotFound) {
+		t.Errorf("Хотели ErrDiskNotFound, получили %v", err)
+	}
+
+	// Второй диск исключён — переносить с первого некуда
+	if err := store.SetDraining(dirs[1], true); err != nil {
+		t.Fatalf("Ошибка SetDraining: %v", err)
+	}
+	if _, err := svc.Drain(dirs[0]); !errors.Is(err, filestore.ErrNoDiskAvailable) {
+		t.Errorf("Хотели ErrNoDiskAvailable, получили %v", err)
+	}
+}
@@ -20,20 +20,25 @@ type DownloadService struct {
 	store  *filestore.FileStore
 	idx    *index.Index
 	sm     *mode.StateMachine
+	cold   *ColdService
 	logger *slog.Logger
 }
 
 // NewDownloadService создаёт сервис скачивания файлов.
+// cold — сервис холодного архива; если задан, в режиме ar отдаются
+// восстановленные копии файлов (может быть nil).
 func NewDownloadService(
 	store *filestore.FileStore,
 	idx *index.Index,
 	sm *mode.StateMachine,
+	cold *ColdService,
 	logger *slog.Logger,
 ) *DownloadService {
 	return &DownloadService{
 		store:  store,
 		idx:    idx,
 		sm:     sm,
+		cold:   cold,
 		logger: logger.With(slog.String("component", "download_service")),
 	}
 }
@@ -55,8 +60,10 @@ func (e *DownloadError) Error() string {
 //   - w, r: HTTP writer и request
 //   - fileID: идентификатор файла
 func (s *DownloadService) Serve(w http.ResponseWriter, r *http.Request, fileID string) *DownloadError {
-	// 1. Проверяем допустимость download в текущем режиме
-	if !s.sm.CanPerform(mode.OpDownload) {
+	// 1. Проверяем допустимость download в текущем режиме.
+	// В режиме ar скачивание возможно после restore из холодного архива.
+	archived := s.sm.CurrentMode() == mode.ModeAR && s.cold != nil
+	if !archived && !s.sm.CanPerform(mode.OpDownload) {
 		return &DownloadError{
 			StatusCode: 409,
 			Code:       apierrors.CodeModeNotAllowed,
@@ -83,8 +90,23 @@ func (s *DownloadService) Serve(w http.ResponseWriter, r *http.Request, fileID s
 		}
 	}
 
-	// 4. Открываем файл
-	file, err := s.store.ReadFile(meta.StoragePath)
+	// 4. Открываем файл (в режиме ar — восстановленную копию)
+	var (
+		file *os.File
+		err  error
+	)
+	if archived {
+		file, err = s.cold.OpenRestored(fileID)
+		if err != nil {
+			return &DownloadError{
+				StatusCode: 409,
+				Code:       apierrors.CodeRestoreRequired,
+				Message:    fmt.Sprintf("Файл %s находится в архиве, выполните restore перед скачиванием", fileID),
+			}
+		}
+	} else {
+		file, err = s.store.ReadFile(meta.StoragePath)
+	}
 	if err != nil {
 		s.logger.Error("Файл не найден на диске",
 			slog.String("file_id", fileID),
@@ -190,8 +190,7 @@ func (gc *GCService) markExpired(now time.Time) int {
 		meta.Status = model.StatusExpired
 
 		// Обновляем attr.json
-		attrPath := attr.AttrFilePath(gc.store.FullPath(meta.StoragePath))
-		if err := attr.Write(attrPath, meta); err != nil {
+		if err := gc.store.WriteAttr(meta.StoragePath, meta); err != nil {
 			gc.logger.Error("GC: ошибка обновления attr.json",
 				slog.String("file_id", meta.FileID),
 				slog.String("error", err.Error()),
@@ -0,0 +1,166 @@
+// quota.go — сервис квот загрузки по субъектам JWT.
+//
+// Периодически (SE_QUOTA_REFRESH_INTERVAL) перечитывает файл квот
+// при его изменении и обновляет метрики использования по субъектам.
+// Счётчики использования поддерживаются index.Index инкрементально,
+// проверка квоты при загрузке выполняется в UploadService.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/quota"
+)
+
+// Prometheus метрики квот
+var (
+	// quotaUsedBytes — суммарный размер active файлов субъекта.
+	quotaUsedBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "se_quota_used_bytes",
+		Help: "Суммарный размер active файлов, загруженных субъектом",
+	}, []string{"subject"})
+
+	// quotaUsedFiles — количество active файлов субъекта.
+	quotaUsedFiles = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "se_quota_used_files",
+		Help: "Количество active файлов, загруженных субъектом",
+	}, []string{"subject"})
+
+	// quotaRejectionsTotal — количество загрузок, отклонённых по квоте.
+	quotaRejectionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "se_quota_rejections_total",
+		Help: "Общее количество загрузок, отклонённых по квоте субъекта",
+	}, []string{"subject", "reason"})
+)
+
+// QuotaService — сервис перечитывания квот и метрик использования.
+type QuotaService struct {
+	quotas   *quota.Store
+	idx      *index.Index
+	interval time.Duration
+	logger   *slog.Logger
+	cancel   context.CancelFunc
+}
+
+// NewQuotaService создаёт сервис квот.
+func NewQuotaService(
+	quotas *quota.Store,
+	idx *index.Index,
+	interval time.Duration,
+	logger *slog.Logger,
+) *QuotaService {
+	return &QuotaService{
+		quotas:   quotas,
+		idx:      idx,
+		interval: interval,
+		logger:   logger.With(slog.String("component", "quota")),
+	}
+}
+
+// Start запускает фоновое обновление квот с периодическим тикером.
+func (s *QuotaService) Start(ctx context.Context) {
+	quotaCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	go s.run(quotaCtx)
+
+	s.logger.Info("Обновление квот запущено",
+		slog.String("interval", s.interval.String()),
+		slog.String("quota_file", s.quotas.Path()),
+	)
+}
+
+// Stop останавливает фоновое обновление квот.
+func (s *QuotaService) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.logger.Info("Обновление квот остановлено")
+}
+
+// run — основной цикл фоновой горутины.
+func (s *QuotaService) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunOnce()
+		}
+	}
+}
+
+// RunOnce перечитывает файл квот (если изменился) и обновляет метрики.
+// Ошибка разбора файла логируется, действуют ранее загруженные квоты.
+func (s *QuotaService) RunOnce() {
+	if _, err := s.quotas.Reload(); err != nil {
+		s.logger.Error("Ошибка перечитывания файла квот, действуют прежние квоты",
+			slog.String("error", err.Error()),
+		)
+	}
+
+	usage := s.idx.UsageBySubject()
+
+	// Сбрасываем метрики, чтобы удалить субъекты без active файлов
+	quotaUsedBytes.Reset()
+	quotaUsedFiles.Reset()
+	for subject, u := range usage {
+		quotaUsedBytes.WithLabelValues(subject).Set(float64(u.Bytes))
+		quotaUsedFiles.WithLabelValues(subject).Set(float64(u.Files))
+	}
+}
+
+// Usage возвращает использование квот по субъектам: субъекты с active
+// файлами и субъекты с собственной записью в файле квот.
+// Групповые лимиты не отображаются — они зависят от scopes токена.
+func (s *QuotaService) Usage() *generated.QuotaUsageList {
+	usage := s.idx.UsageBySubject()
+	for _, subject := range s.quotas.Subjects() {
+		if _, ok := usage[subject]; !ok {
+			usage[subject] = index.Usage{}
+		}
+	}
+
+	items := make([]generated.SubjectQuotaUsage, 0, len(usage))
+	for subject, u := range usage {
+		item := generated.SubjectQuotaUsage{
+			Subject:   subject,
+			UsedBytes: u.Bytes,
+			UsedFiles: u.Files,
+		}
+		if limit, ok := s.quotas.Resolve(subject, nil); ok {
+			source := generated.SubjectQuotaUsageQuotaSource(limit.Source)
+			item.QuotaSource = &source
+			if limit.MaxBytes > 0 {
+				item.MaxBytes = &limit.MaxBytes
+			}
+			if limit.MaxFiles > 0 {
+				item.MaxFiles = &limit.MaxFiles
+			}
+		}
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].UsedBytes != items[j].UsedBytes {
+			return items[i].UsedBytes > items[j].UsedBytes
+		}
+		return items[i].Subject < items[j].Subject
+	})
+
+	return &generated.QuotaUsageList{
+		QuotaFile: s.quotas.Path(),
+		Items:     items,
+	}
+}
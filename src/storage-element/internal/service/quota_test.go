@@ -0,0 +1,141 @@
+package service
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/api/generated"
+	"github.com/bigkaa/goartstore/storage-element/internal/config"
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/mode"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/quota"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/wal"
+)
+
+// setupQuotaTestEnv создаёт UploadService и QuotaService с файлом квот.
+func setupQuotaTestEnv(t *testing.T, quotaJSON string) (*UploadService, *QuotaService) {
+	t.Helper()
+
+	dir := t.TempDir()
+	store, err := filestore.New(dir)
+	if err != nil {
+		t.Fatalf("Ошибка создания FileStore: %v", err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	walEngine, err := wal.New(t.TempDir(), logger)
+	if err != nil {
+		t.Fatalf("Ошибка создания WAL: %v", err)
+	}
+
+	sm, err := mode.NewStateMachine(mode.ModeRW)
+	if err != nil {
+		t.Fatalf("Ошибка создания StateMachine: %v", err)
+	}
+
+	quotaPath := filepath.Join(dir, "quotas.json")
+	if err := os.WriteFile(quotaPath, []byte(quotaJSON), 0o640); err != nil {
+		t.Fatalf("Ошибка записи файла квот: %v", err)
+	}
+	quotas := quota.New(quotaPath, logger)
+	if err := quotas.Load(); err != nil {
+		t.Fatalf("Ошибка загрузки квот: %v", err)
+	}
+
+	idx := index.New(logger)
+	cfg := &config.Config{MaxFileSize: 1 << 20, MaxCapacity: 1 << 30}
+
+	return NewUploadService(cfg, walEngine, store, idx, sm, quotas, logger),
+		NewQuotaService(quotas, idx, time.Hour, logger)
+}
+
+// uploadAs загружает файл указанного размера от имени субъекта.
+func uploadAs(svc *UploadService, subject string, size int, scopes ...string) *UploadError {
+	data := bytes.Repeat([]byte("x"), size)
+	_, uploadErr := svc.Upload(UploadParams{
+		Reader:           bytes.NewReader(data),
+		OriginalFilename: "file.bin",
+		ContentType:      "application/octet-stream",
+		Size:             int64(size),
+		UploadedBy:       subject,
+		Scopes:           scopes,
+	})
+	return uploadErr
+}
+
+func TestUpload_QuotaExceeded(t *testing.T) {
+	uploadSvc, _ := setupQuotaTestEnv(t, `{
+		"subjects": {"alice": {"max_bytes": 100}},
+		"groups":   {"files:write": {"max_files": 2}}
+	}`)
+
+	// Квота по объёму
+	if err := uploadAs(uploadSvc, "alice", 60); err != nil {
+		t.Fatalf("Первая загрузка: неожиданная ошибка: %v", err)
+	}
+	err := uploadAs(uploadSvc, "alice", 60)
+	if err == nil || err.Code != "QUOTA_EXCEEDED" || err.StatusCode != 403 {
+		t.Fatalf("Хотели QUOTA_EXCEEDED/403, получили %v", err)
+	}
+
+	// Групповая квота по количеству файлов
+	for i := range 2 {
+		if err := uploadAs(uploadSvc, "bob", 10, "files:write"); err != nil {
+			t.Fatalf("Загрузка %d: неожиданная ошибка: %v", i, err)
+		}
+	}
+	if err := uploadAs(uploadSvc, "bob", 10, "files:write"); err == nil || err.Code != "QUOTA_EXCEEDED" {
+		t.Errorf("Хотели QUOTA_EXCEEDED по количеству файлов, получили %v", err)
+	}
+
+	// Субъект без квоты не ограничен
+	if err := uploadAs(uploadSvc, "carol", 500); err != nil {
+		t.Errorf("carol: неожиданная ошибка: %v", err)
+	}
+}
+
+func TestQuotaUsage(t *testing.T) {
+	uploadSvc, quotaSvc := setupQuotaTestEnv(t, `{
+		"default":  {"max_files": 10},
+		"subjects": {"alice": {"max_bytes": 1000}, "idle": {"max_files": 5}}
+	}`)
+
+	if err := uploadAs(uploadSvc, "alice", 30); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+	if err := uploadAs(uploadSvc, "bob", 20); err != nil {
+		t.Fatalf("Неожиданная ошибка: %v", err)
+	}
+
+	usage := quotaSvc.Usage()
+	if len(usage.Items) != 3 {
+		t.Fatalf("Хотели 3 субъекта, получили %d", len(usage.Items))
+	}
+
+	alice := usage.Items[0]
+	if alice.Subject != "alice" || alice.UsedBytes != 30 || alice.UsedFiles != 1 {
+		t.Errorf("alice: получили %+v", alice)
+	}
+	if alice.MaxBytes == nil || *alice.MaxBytes != 1000 || alice.MaxFiles != nil {
+		t.Errorf("alice: некорректные лимиты %+v", alice)
+	}
+	if alice.QuotaSource == nil || *alice.QuotaSource != generated.Subject {
+		t.Errorf("alice: хотели quota_source=subject")
+	}
+
+	bob := usage.Items[1]
+	if bob.Subject != "bob" || bob.QuotaSource == nil || *bob.QuotaSource != generated.Default {
+		t.Errorf("bob: хотели quota_source=default, получили %+v", bob)
+	}
+
+	idle := usage.Items[2]
+	if idle.Subject != "idle" || idle.UsedFiles != 0 {
+		t.Errorf("idle: получили %+v", idle)
+	}
+}
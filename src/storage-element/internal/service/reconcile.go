@@ -60,7 +60,6 @@ var (
 type ReconcileService struct {
 	store    *filestore.FileStore
 	idx      *index.Index
-	dataDir  string
 	interval time.Duration
 	logger   *slog.Logger
 
@@ -73,14 +72,12 @@ type ReconcileService struct {
 func NewReconcileService(
 	store *filestore.FileStore,
 	idx *index.Index,
-	dataDir string,
 	interval time.Duration,
 	logger *slog.Logger,
 ) *ReconcileService {
 	return &ReconcileService{
 		store:    store,
 		idx:      idx,
-		dataDir:  dataDir,
 		interval: interval,
 		logger:   logger.With(slog.String("component", "reconcile")),
 	}
@@ -153,10 +150,26 @@ func (rs *ReconcileService) RunOnce() (*generated.ReconcileResponse, bool) {
 	startedAt := time.Now().UTC()
 	rs.logger.Info("Reconciliation начата")
 
-	issues := rs.reconcile()
+	// Обновляем карту размещения файлов по дискам
+	rs.store.Rescan()
 
-	// Пересобираем индекс из attr.json
-	if err := rs.idx.RebuildFromDir(rs.dataDir); err != nil {
+	var issues []generated.ReconcileIssue
+	var failedDirs []string
+	for _, disk := range rs.store.Disks() {
+		// Файлы на недоступном диске не проверяются до его восстановления
+		if disk.State == filestore.DiskFailed {
+			rs.logger.Warn("Диск недоступен, пропуск при reconciliation",
+				slog.String("disk", disk.Path),
+			)
+			failedDirs = append(failedDirs, disk.Path)
+			continue
+		}
+		issues = append(issues, rs.reconcile(disk.Path)...)
+	}
+
+	// Пересобираем индекс из attr.json доступных дисков; записи файлов
+	// недоступных дисков сохраняются до их восстановления
+	if err := rs.idx.RebuildFromDirs(rs.store.DataDirs(), failedDirs, rs.store.DiskOf); err != nil {
 		rs.logger.Error("Ошибка пересборки индекса",
 			slog.String("error", err.Error()),
 		)
@@ -213,10 +226,10 @@ func (rs *ReconcileService) RunOnce() (*generated.ReconcileResponse, bool) {
 	}, false
 }
 
-// reconcile выполняет сверку данных на диске.
+// reconcile выполняет сверку данных в одной директории данных.
 //
 //nolint:gocognit // TODO: упростить reconcile
-func (rs *ReconcileService) reconcile() []generated.ReconcileIssue {
+func (rs *ReconcileService) reconcile(dataDir string) []generated.ReconcileIssue {
 	var issues []generated.ReconcileIssue
 
 	// Собираем все файлы на диске (не attr.json)
@@ -224,9 +237,10 @@ func (rs *ReconcileService) reconcile() []generated.ReconcileIssue {
 	// Собираем все attr.json на диске
 	attrFiles := make(map[string]bool)
 
-	entries, err := os.ReadDir(rs.dataDir)
+	entries, err := os.ReadDir(dataDir)
 	if err != nil {
 		rs.logger.Error("Ошибка чтения директории данных",
+			slog.String("data_dir", dataDir),
 			slog.String("error", err.Error()),
 		)
 		return issues
@@ -272,10 +286,15 @@ func (rs *ReconcileService) reconcile() []generated.ReconcileIssue {
 		dataFile := strings.TrimSuffix(attrFile, attr.AttrSuffix)
 		if !dataFiles[dataFile] {
 			// Читаем attr.json для получения file_id
-			attrPath := filepath.Join(rs.dataDir, attrFile)
+			attrPath := filepath.Join(dataDir, attrFile)
 			meta, readErr := attr.Read(attrPath)
 			path := dataFile
 
+			// Файл упакован в холодный архив (режим ar) — отсутствие на диске штатно
+			if readErr == nil && meta.IsArchived() {
+				continue
+			}
+
 			issue := generated.ReconcileIssue{
 				Type:        generated.MissingFile,
 				Path:        &path,
@@ -301,7 +320,7 @@ func (rs *ReconcileService) reconcile() []generated.ReconcileIssue {
 		}
 
 		// Читаем метаданные из attr.json
-		attrPath := filepath.Join(rs.dataDir, attrFile)
+		attrPath := filepath.Join(dataDir, attrFile)
 		meta, readErr := attr.Read(attrPath)
 		if readErr != nil {
 			rs.logger.Warn("Ошибка чтения attr.json при reconciliation",
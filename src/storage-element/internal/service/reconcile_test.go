@@ -73,7 +73,7 @@ func TestReconcileRunOnce_NoIssues(t *testing.T) {
 		t.Fatalf("Ошибка построения индекса: %v", err)
 	}
 
-	rs := NewReconcileService(store, idx, dir, time.Hour, logger)
+	rs := NewReconcileService(store, idx, time.Hour, logger)
 	result, skipped := rs.RunOnce()
 
 	if skipped {
@@ -107,7 +107,7 @@ func TestReconcileRunOnce_OrphanedFile(t *testing.T) {
 		t.Fatalf("Ошибка построения индекса: %v", err)
 	}
 
-	rs := NewReconcileService(store, idx, dir, time.Hour, logger)
+	rs := NewReconcileService(store, idx, time.Hour, logger)
 	result, _ := rs.RunOnce()
 
 	if result == nil {
@@ -156,7 +156,7 @@ func TestReconcileRunOnce_MissingFile(t *testing.T) {
 		t.Fatalf("Ошибка построения индекса: %v", err)
 	}
 
-	rs := NewReconcileService(store, idx, dir, time.Hour, logger)
+	rs := NewReconcileService(store, idx, time.Hour, logger)
 	result, _ := rs.RunOnce()
 
 	if result == nil {
@@ -214,7 +214,7 @@ func TestReconcileRunOnce_SizeMismatch(t *testing.T) {
 		t.Fatalf("Ошибка построения индекса: %v", err)
 	}
 
-	rs := NewReconcileService(store, idx, dir, time.Hour, logger)
+	rs := NewReconcileService(store, idx, time.Hour, logger)
 	result, _ := rs.RunOnce()
 
 	if result == nil {
@@ -269,7 +269,7 @@ func TestReconcileRunOnce_ChecksumMismatch(t *testing.T) {
 		t.Fatalf("Ошибка построения индекса: %v", err)
 	}
 
-	rs := NewReconcileService(store, idx, dir, time.Hour, logger)
+	rs := NewReconcileService(store, idx, time.Hour, logger)
 	result, _ := rs.RunOnce()
 
 	if result == nil {
@@ -307,7 +307,7 @@ func TestReconcileRunOnce_SkipsHiddenAndTmpFiles(t *testing.T) {
 		t.Fatalf("Ошибка построения индекса: %v", err)
 	}
 
-	rs := NewReconcileService(store, idx, dir, time.Hour, logger)
+	rs := NewReconcileService(store, idx, time.Hour, logger)
 	result, _ := rs.RunOnce()
 
 	if result == nil {
@@ -329,7 +329,7 @@ func TestReconcileRunOnce_ConcurrentProtection(t *testing.T) {
 		t.Fatalf("Ошибка построения индекса: %v", err)
 	}
 
-	rs := NewReconcileService(store, idx, dir, time.Hour, logger)
+	rs := NewReconcileService(store, idx, time.Hour, logger)
 
 	// Запускаем из нескольких горутин
 	results := make(chan bool, 5)
@@ -361,7 +361,7 @@ func TestReconcileRunOnce_EmptyDirectory(t *testing.T) {
 		t.Fatalf("Ошибка построения индекса: %v", err)
 	}
 
-	rs := NewReconcileService(store, idx, dir, time.Hour, logger)
+	rs := NewReconcileService(store, idx, time.Hour, logger)
 	result, skipped := rs.RunOnce()
 
 	if skipped {
@@ -376,7 +376,7 @@ func TestReconcileRunOnce_EmptyDirectory(t *testing.T) {
 }
 
 func TestReconcileRunOnce_RebuildIndex(t *testing.T) {
-	dir, store, idx := setupReconcileTestEnv(t)
+	_, store, idx := setupReconcileTestEnv(t)
 	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
 
 	// Добавляем файл напрямую в индекс (без диска)
@@ -397,7 +397,7 @@ func TestReconcileRunOnce_RebuildIndex(t *testing.T) {
 		t.Fatalf("Индекс должен содержать 1 файл, содержит %d", idx.Count())
 	}
 
-	rs := NewReconcileService(store, idx, dir, time.Hour, logger)
+	rs := NewReconcileService(store, idx, time.Hour, logger)
 	rs.RunOnce()
 
 	// После reconciliation индекс пересобран — phantom файла нет на диске
@@ -405,3 +405,65 @@ func TestReconcileRunOnce_RebuildIndex(t *testing.T) {
 		t.Errorf("После reconciliation индекс должен быть пуст, содержит %d файлов", idx.Count())
 	}
 }
+
+// TestReconcileRunOnce_FailedSecondaryDisk проверяет, что файлы недоступного
+// дополнительного диска остаются в индексе после reconciliation.
+func TestReconcileRunOnce_FailedSecondaryDisk(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	dirs := []string{t.TempDir(), filepath.Join(t.TempDir(), "disk2")}
+
+	store, err := filestore.NewMultiDir(dirs, filestore.PlacementRoundRobin)
+	if err != nil {
+		t.Fatalf("Ошибка создания FileStore: %v", err)
+	}
+
+	writeFile := func(dir, fileID, name string) {
+		filePath := filepath.Join(dir, name)
+		if err := os.WriteFile(filePath, []byte("test data"), 0o640); err != nil {
+			t.Fatalf("Ошибка записи файла: %v", err)
+		}
+		meta := &model.FileMetadata{
+			FileID:           fileID,
+			OriginalFilename: name,
+			StoragePath:      name,
+			ContentType:      "text/plain",
+			Size:             9,
+			Checksum:         "abc",
+			UploadedBy:       "test",
+			UploadedAt:       time.Now().UTC(),
+			Status:           model.StatusActive,
+			RetentionPolicy:  model.RetentionPermanent,
+		}
+		if err := attr.Write(attr.AttrFilePath(filePath), meta); err != nil {
+			t.Fatalf("Ошибка записи attr.json: %v", err)
+		}
+	}
+	writeFile(dirs[0], "primary-1", "primary.txt")
+	writeFile(dirs[1], "secondary-1", "secondary.txt")
+
+	store.Rescan()
+	idx := index.New(logger)
+	if err := idx.BuildFromDirs(dirs); err != nil {
+		t.Fatalf("Ошибка построения индекса: %v", err)
+	}
+
+	// Дополнительный диск отказал
+	if err := os.RemoveAll(dirs[1]); err != nil {
+		t.Fatalf("Ошибка удаления директории: %v", err)
+	}
+
+	rs := NewReconcileService(store, idx, time.Hour, logger)
+	if _, skipped := rs.RunOnce(); skipped {
+		t.Fatal("Reconciliation пропущена")
+	}
+
+	if idx.Get("secondary-1") == nil {
+		t.Error("Файл недоступного диска удалён из индекса")
+	}
+	if idx.Get("primary-1") == nil {
+		t.Error("Файл основного диска отсутствует в индексе")
+	}
+	if got := idx.TotalActiveSize(); got != 18 {
+		t.Errorf("TotalActiveSize: ожидалось 18, получено %d", got)
+	}
+}
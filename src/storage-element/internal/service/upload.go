@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,6 +20,7 @@ import (
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/filestore"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/index"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/quota"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/wal"
 )
 
@@ -34,10 +36,17 @@ type UploadParams struct {
 	Size int64
 	// UploadedBy — идентификатор пользователя (sub из JWT)
 	UploadedBy string
+	// Scopes — scopes из JWT (для определения групповой квоты)
+	Scopes []string
 	// Description — описание файла (опционально)
 	Description string
 	// Tags — теги файла (опционально, JSON-строка)
 	TagsJSON string
+	// CollectionID — UUID коллекции файла (опционально)
+	CollectionID string
+	// Collections — коллекции, в которые субъект может загружать файлы
+	// (claim artstore_collections)
+	Collections []string
 }
 
 // UploadResult — результат загрузки файла.
@@ -63,16 +72,19 @@ type UploadService struct {
 	store     *filestore.FileStore
 	idx       *index.Index
 	sm        *mode.StateMachine
+	quotas    *quota.Store
 	logger    *slog.Logger
 }
 
 // NewUploadService создаёт сервис загрузки файлов.
+// quotas может быть nil — квоты по субъектам не применяются.
 func NewUploadService(
 	cfg *config.Config,
 	walEngine *wal.WAL,
 	store *filestore.FileStore,
 	idx *index.Index,
 	sm *mode.StateMachine,
+	quotas *quota.Store,
 	logger *slog.Logger,
 ) *UploadService {
 	return &UploadService{
@@ -81,6 +93,7 @@ func NewUploadService(
 		store:     store,
 		idx:       idx,
 		sm:        sm,
+		quotas:    quotas,
 		logger:    logger.With(slog.String("component", "upload_service")),
 	}
 }
@@ -89,7 +102,7 @@ func NewUploadService(
 //
 // Поток:
 //  1. Проверка mode (edit/rw)
-//  2. Проверка размера файла
+//  2. Проверка размера файла, ёмкости SE и квоты субъекта
 //  3. WAL StartTransaction
 //  4. SaveFile (streaming + SHA-256)
 //  5. WriteAttrFile
@@ -128,6 +141,32 @@ func (s *UploadService) Upload(params UploadParams) (*UploadResult, *UploadError
 		}
 	}
 
+	// 2.2. Проверяем квоту субъекта (так же racy, как и проверка ёмкости)
+	if quotaErr := s.checkQuota(params); quotaErr != nil {
+		return nil, quotaErr
+	}
+
+	// 2.3. Проверяем коллекцию: формат и право записи. Admin Module переносит
+	// collection_id из attr.json в реестр, поэтому SE принимает только
+	// коллекции из claim artstore_collections (или scope storage:write)
+	if params.CollectionID != "" {
+		if _, err := uuid.Parse(params.CollectionID); err != nil {
+			return nil, &UploadError{
+				StatusCode: 400,
+				Code:       apierrors.CodeValidationError,
+				Message:    fmt.Sprintf("Некорректный collection_id: %s", params.CollectionID),
+			}
+		}
+		if !slices.Contains(params.Scopes, middleware.ScopeStorageWrite) &&
+			!slices.Contains(params.Collections, params.CollectionID) {
+			return nil, &UploadError{
+				StatusCode: 403,
+				Code:       apierrors.CodeForbidden,
+				Message:    fmt.Sprintf("Недостаточно прав: нет права записи в коллекцию %s", params.CollectionID),
+			}
+		}
+	}
+
 	// 3. Генерируем file_id
 	fileID := uuid.New().String()
 
@@ -177,11 +216,10 @@ func (s *UploadService) Upload(params UploadParams) (*UploadResult, *UploadError
 	}
 
 	// 6. Определяем retention policy из режима
-	retentionPolicy := model.RetentionPermanent
+	retentionPolicy := s.RetentionPolicy()
 	var ttlDays *int
 	var expiresAt *time.Time
-	if s.sm.CurrentMode() == mode.ModeEdit {
-		retentionPolicy = model.RetentionTemporary
+	if retentionPolicy == model.RetentionTemporary {
 		defaultTTL := 30
 		ttlDays = &defaultTTL
 		exp := time.Now().UTC().AddDate(0, 0, defaultTTL)
@@ -218,11 +256,11 @@ func (s *UploadService) Upload(params UploadParams) (*UploadResult, *UploadError
 		ExpiresAt:        expiresAt,
 		Tags:             tags,
 		Description:      params.Description,
+		CollectionID:     params.CollectionID,
 	}
 
 	// 9. Записываем attr.json
-	attrPath := attr.AttrFilePath(s.store.FullPath(savedResult.StoragePath))
-	if err := attr.Write(attrPath, metadata); err != nil {
+	if err := s.store.WriteAttr(savedResult.StoragePath, metadata); err != nil {
 		rollback()
 		s.logger.Error("Ошибка записи attr.json",
 			slog.String("file_id", fileID),
@@ -263,3 +301,55 @@ func (s *UploadService) Upload(params UploadParams) (*UploadResult, *UploadError
 
 	return &UploadResult{Metadata: metadata}, nil
 }
+
+// RetentionPolicy возвращает политику хранения загружаемых файлов в текущем
+// режиме: edit — temporary, остальные — permanent.
+func (s *UploadService) RetentionPolicy() model.RetentionPolicy {
+	if s.sm.CurrentMode() == mode.ModeEdit {
+		return model.RetentionTemporary
+	}
+	return model.RetentionPermanent
+}
+
+// checkQuota проверяет, что загрузка не превысит квоту субъекта
+// по объёму и количеству active файлов.
+func (s *UploadService) checkQuota(params UploadParams) *UploadError {
+	if s.quotas == nil {
+		return nil
+	}
+	limit, ok := s.quotas.Resolve(params.UploadedBy, params.Scopes)
+	if !ok {
+		return nil
+	}
+
+	usage := s.idx.SubjectUsage(params.UploadedBy)
+
+	var reason, message string
+	switch {
+	case limit.MaxFiles > 0 && usage.Files+1 > limit.MaxFiles:
+		reason = "files"
+		message = fmt.Sprintf("Превышена квота субъекта %s по количеству файлов: %d из %d",
+			params.UploadedBy, usage.Files, limit.MaxFiles)
+	case limit.MaxBytes > 0 && usage.Bytes+params.Size > limit.MaxBytes:
+		reason = "bytes"
+		message = fmt.Sprintf("Превышена квота субъекта %s по объёму: требуется %d байт, доступно %d байт",
+			params.UploadedBy, params.Size, max(limit.MaxBytes-usage.Bytes, 0))
+	default:
+		return nil
+	}
+
+	quotaRejectionsTotal.WithLabelValues(params.UploadedBy, reason).Inc()
+	s.logger.Warn("Загрузка отклонена по квоте",
+		slog.String("uploaded_by", params.UploadedBy),
+		slog.String("reason", reason),
+		slog.String("quota_source", limit.Source),
+		slog.Int64("used_bytes", usage.Bytes),
+		slog.Int("used_files", usage.Files),
+	)
+
+	return &UploadError{
+		StatusCode: 403,
+		Code:       apierrors.CodeQuotaExceeded,
+		Message:    message,
+	}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
+)
+
+func TestUpload_CollectionID(t *testing.T) {
+	uploadSvc, _ := setupQuotaTestEnv(t, `{}`)
+
+	const collectionID = "9b2f6c1e-4d3a-4f8e-a1b2-3c4d5e6f7a8b"
+	upload := func(collectionID string, scopes, collections []string) (*UploadResult, *UploadError) {
+		return uploadSvc.Upload(UploadParams{
+			Reader:           bytes.NewReader([]byte("data")),
+			OriginalFilename: "file.bin",
+			ContentType:      "application/octet-stream",
+			Size:             4,
+			UploadedBy:       "alice",
+			Scopes:           scopes,
+			CollectionID:     collectionID,
+			Collections:      collections,
+		})
+	}
+
+	// Некорректный UUID коллекции отклоняется до записи файла
+	if _, err := upload("team-a", nil, []string{"team-a"}); err == nil || err.StatusCode != 400 {
+		t.Fatalf("Ожидалась ошибка 400 для некорректного collection_id, получено %v", err)
+	}
+	if total := uploadSvc.idx.Count(); total != 0 {
+		t.Fatalf("Файл с некорректным collection_id попал в индекс: %d", total)
+	}
+
+	// Коллекция вне claim artstore_collections отклоняется
+	if _, err := upload(collectionID, []string{"files:write"}, []string{"0f8fad5b-d9cb-469f-a165-70867728950e"}); err == nil || err.StatusCode != 403 {
+		t.Fatalf("Ожидалась ошибка 403 для коллекции вне claim, получено %v", err)
+	}
+
+	// storage:write (Admin Module) допускает любую коллекцию
+	if _, err := upload(collectionID, []string{"files:write", "storage:write"}, nil); err != nil {
+		t.Fatalf("Неожиданная ошибка загрузки со storage:write: %v", err)
+	}
+
+	// Коллекция из claim сохраняется в attr.json
+	result, uploadErr := upload(collectionID, []string{"files:write"}, []string{collectionID})
+	if uploadErr != nil {
+		t.Fatalf("Неожиданная ошибка загрузки: %v", uploadErr)
+	}
+	stored, err := attr.Read(attr.AttrFilePath(uploadSvc.store.FullPath(result.Metadata.StoragePath)))
+	if err != nil {
+		t.Fatalf("Ошибка чтения attr.json: %v", err)
+	}
+	if stored.CollectionID != collectionID {
+		t.Errorf("collection_id в attr.json = %q, ожидался %q", stored.CollectionID, collectionID)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/bigkaa/goartstore/storage-element/internal/domain/model"
 )
@@ -42,8 +43,12 @@ func IsAttrFile(path string) bool {
 
 // Write атомарно записывает метаданные в attr.json файл.
 // Паттерн: JSON → temp файл → fsync → atomic rename.
+// Проставляет meta.UpdatedAt — по нему Admin Module инкрементально
+// синхронизирует файловый реестр (GET /api/v1/files?updated_since=...).
 // Возвращает ошибку, если сериализованные данные превышают 4 КБ.
 func Write(path string, meta *model.FileMetadata) error {
+	meta.UpdatedAt = time.Now().UTC()
+
 	data, err := json.MarshalIndent(meta, "", "  ")
 	if err != nil {
 		return fmt.Errorf("ошибка сериализации метаданных: %w", err)
@@ -88,6 +88,9 @@ func TestWriteAndRead(t *testing.T) {
 	if readMeta.Description != meta.Description {
 		t.Errorf("Description: ожидалось %q, получено %q", meta.Description, readMeta.Description)
 	}
+	if meta.UpdatedAt.IsZero() || !readMeta.UpdatedAt.Equal(meta.UpdatedAt) {
+		t.Errorf("UpdatedAt: ожидалось %v, получено %v", meta.UpdatedAt, readMeta.UpdatedAt)
+	}
 }
 
 // TestWrite_AtomicNoTmpFile проверяет, что temp файл не остаётся после записи.
@@ -0,0 +1,390 @@
+// Пакет bundle — архивные бандлы холодного хранения (режим ar).
+//
+// Бандл — пара файлов в директории архива ({SE_DATA_DIR}/.archive):
+//   - bundle-{id}.bin — последовательность независимых gzip-потоков,
+//     по одному на файл данных;
+//   - bundle-{id}.index.json — индекс: смещение и размер gzip-потока
+//     каждого файла, исходный размер и SHA-256, SHA-256 всего .bin.
+//
+// Независимые gzip-потоки позволяют восстановить один файл чтением
+// только его диапазона, без распаковки всего бандла.
+//
+// Индекс записывается после fsync .bin и является признаком завершённого
+// бандла: .bin без индекса — незавершённая запись, удаляется при сборе.
+package bundle
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// dataSuffix — суффикс файла данных бандла
+	dataSuffix = ".bin"
+	// indexSuffix — суффикс индекса бандла
+	indexSuffix = ".index.json"
+	// namePrefix — префикс имени бандла
+	namePrefix = "bundle-"
+)
+
+// ErrChecksumMismatch — содержимое бандла не совпадает с контрольной суммой.
+var ErrChecksumMismatch = errors.New("контрольная сумма не совпадает")
+
+// Entry — запись индекса бандла об одном файле.
+type Entry struct {
+	// FileID — идентификатор файла
+	FileID string `json:"file_id"`
+	// StoragePath — имя файла данных на диске до архивации
+	StoragePath string `json:"storage_path"`
+	// Offset — смещение gzip-потока файла в .bin
+	Offset int64 `json:"offset"`
+	// CompressedSize — размер gzip-потока файла
+	CompressedSize int64 `json:"compressed_size"`
+	// Size — исходный размер файла
+	Size int64 `json:"size"`
+	// Checksum — SHA-256 исходного содержимого файла
+	Checksum string `json:"checksum"`
+}
+
+// Index — индекс бандла.
+type Index struct {
+	// ID — идентификатор бандла (часть имени файлов)
+	ID string `json:"id"`
+	// CreatedAt — время создания бандла (UTC)
+	CreatedAt time.Time `json:"created_at"`
+	// Size — размер .bin в байтах
+	Size int64 `json:"size"`
+	// Checksum — SHA-256 всего .bin
+	Checksum string `json:"checksum"`
+	// Entries — файлы бандла в порядке записи
+	Entries []Entry `json:"entries"`
+}
+
+// OriginalSize возвращает суммарный исходный размер файлов бандла.
+func (idx *Index) OriginalSize() int64 {
+	var total int64
+	for _, e := range idx.Entries {
+		total += e.Size
+	}
+	return total
+}
+
+// DataPath возвращает путь к .bin бандла в директории архива.
+func DataPath(dir, id string) string {
+	return filepath.Join(dir, namePrefix+id+dataSuffix)
+}
+
+// IndexPath возвращает путь к индексу бандла в директории архива.
+func IndexPath(dir, id string) string {
+	return filepath.Join(dir, namePrefix+id+indexSuffix)
+}
+
+// Writer — последовательная запись файлов в новый бандл.
+// Не потокобезопасен.
+type Writer struct {
+	dir    string
+	index  Index
+	file   *os.File
+	offset int64
+	level  int
+}
+
+// Create создаёт новый бандл в директории архива.
+// level — уровень сжатия gzip (gzip.DefaultCompression и т.п.).
+func Create(dir, id string, level int) (*Writer, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("ошибка создания директории архива %s: %w", dir, err)
+	}
+
+	f, err := os.OpenFile(DataPath(dir, id), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка создания бандла %s: %w", id, err)
+	}
+
+	return &Writer{
+		dir:   dir,
+		index: Index{ID: id},
+		file:  f,
+		level: level,
+	}, nil
+}
+
+// Size возвращает текущий размер .bin бандла.
+func (w *Writer) Size() int64 {
+	return w.offset
+}
+
+// Len возвращает количество файлов в бандле.
+func (w *Writer) Len() int {
+	return len(w.index.Entries)
+}
+
+// Add сжимает содержимое r в отдельный gzip-поток и добавляет запись в индекс.
+// Контрольная сумма исходного содержимого сверяется с checksum
+// (пустая строка — без проверки); при несовпадении возвращается
+// ErrChecksumMismatch. При любой ошибке записанный поток отбрасывается,
+// бандл остаётся пригодным для добавления следующих файлов.
+func (w *Writer) Add(fileID, storagePath, checksum string, r io.Reader) (*Entry, error) {
+	counter := &countingWriter{w: w.file}
+	gz, err := gzip.NewWriterLevel(counter, w.level)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка создания gzip: %w", err)
+	}
+	gz.Name = storagePath
+
+	contentHash := sha256.New()
+	size, err := io.Copy(gz, io.TeeReader(r, contentHash))
+	if err == nil {
+		err = gz.Close()
+	}
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("ошибка сжатия %s: %w", storagePath, err), w.discard())
+	}
+
+	sum := hex.EncodeToString(contentHash.Sum(nil))
+	if checksum != "" && sum != checksum {
+		return nil, errors.Join(fmt.Errorf("%s: %w", storagePath, ErrChecksumMismatch), w.discard())
+	}
+
+	entry := Entry{
+		FileID:         fileID,
+		StoragePath:    storagePath,
+		Offset:         w.offset,
+		CompressedSize: counter.n,
+		Size:           size,
+		Checksum:       sum,
+	}
+	w.index.Entries = append(w.index.Entries, entry)
+	w.offset += counter.n
+	return &entry, nil
+}
+
+// discard отбрасывает байты, записанные после последнего успешного Add.
+func (w *Writer) discard() error {
+	if err := w.file.Truncate(w.offset); err != nil {
+		return fmt.Errorf("ошибка усечения бандла %s: %w", w.index.ID, err)
+	}
+	if _, err := w.file.Seek(w.offset, io.SeekStart); err != nil {
+		return fmt.Errorf("ошибка позиционирования в бандле %s: %w", w.index.ID, err)
+	}
+	return nil
+}
+
+// Close завершает бандл: fsync .bin, подсчёт SHA-256, атомарная запись индекса.
+func (w *Writer) Close() (*Index, error) {
+	if err := w.file.Sync(); err != nil {
+		_ = w.file.Close()
+		return nil, fmt.Errorf("ошибка fsync бандла %s: %w", w.index.ID, err)
+	}
+	if err := w.file.Close(); err != nil {
+		return nil, fmt.Errorf("ошибка закрытия бандла %s: %w", w.index.ID, err)
+	}
+
+	checksum, err := fileChecksum(DataPath(w.dir, w.index.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	w.index.CreatedAt = time.Now().UTC()
+	w.index.Size = w.offset
+	w.index.Checksum = checksum
+
+	data, err := json.MarshalIndent(&w.index, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("ошибка сериализации индекса %s: %w", w.index.ID, err)
+	}
+
+	indexPath := IndexPath(w.dir, w.index.ID)
+	tmpPath := indexPath + ".tmp"
+	if err := writeFileSync(tmpPath, data); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, indexPath); err != nil {
+		_ = os.Remove(tmpPath)
+		return nil, fmt.Errorf("ошибка записи индекса %s: %w", w.index.ID, err)
+	}
+
+	index := w.index
+	return &index, nil
+}
+
+// Abort прерывает запись и удаляет незавершённый .bin.
+func (w *Writer) Abort() {
+	_ = w.file.Close()
+	_ = os.Remove(DataPath(w.dir, w.index.ID))
+}
+
+// ReadIndex читает индекс бандла.
+func ReadIndex(dir, id string) (*Index, error) {
+	data, err := os.ReadFile(IndexPath(dir, id))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения индекса бандла %s: %w", id, err)
+	}
+	var idx Index
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("ошибка разбора индекса бандла %s: %w", id, err)
+	}
+	return &idx, nil
+}
+
+// List возвращает индексы всех завершённых бандлов директории архива,
+// отсортированные по ID. Отсутствие директории — пустой список.
+// Незавершённые .bin (без индекса) удаляются, если cleanup = true.
+func List(dir string, cleanup bool) ([]*Index, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения директории архива %s: %w", dir, err)
+	}
+
+	names := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		names[e.Name()] = true
+	}
+
+	var result []*Index
+	for _, e := range entries {
+		name := e.Name()
+		if !strings.HasPrefix(name, namePrefix) {
+			continue
+		}
+		switch {
+		case strings.HasSuffix(name, indexSuffix):
+			id := strings.TrimSuffix(strings.TrimPrefix(name, namePrefix), indexSuffix)
+			idx, err := ReadIndex(dir, id)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, idx)
+		case strings.HasSuffix(name, dataSuffix) && cleanup:
+			id := strings.TrimSuffix(strings.TrimPrefix(name, namePrefix), dataSuffix)
+			if !names[namePrefix+id+indexSuffix] {
+				_ = os.Remove(filepath.Join(dir, name))
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+// Open возвращает распакованное содержимое файла из бандла.
+// Контрольная сумма проверяется при чтении: по достижении EOF
+// reader возвращает ErrChecksumMismatch, если содержимое повреждено.
+func Open(dir, id string, entry Entry) (io.ReadCloser, error) {
+	f, err := os.Open(DataPath(dir, id))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка открытия бандла %s: %w", id, err)
+	}
+
+	gz, err := gzip.NewReader(io.NewSectionReader(f, entry.Offset, entry.CompressedSize))
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("ошибка чтения gzip %s в бандле %s: %w", entry.FileID, id, err)
+	}
+	gz.Multistream(false)
+
+	return &verifyingReader{
+		r:        gz,
+		file:     f,
+		hasher:   sha256.New(),
+		checksum: entry.Checksum,
+		size:     entry.Size,
+	}, nil
+}
+
+// Verify проверяет SHA-256 всего .bin бандла по индексу.
+func Verify(dir string, idx *Index) error {
+	checksum, err := fileChecksum(DataPath(dir, idx.ID))
+	if err != nil {
+		return err
+	}
+	if checksum != idx.Checksum {
+		return fmt.Errorf("бандл %s: %w", idx.ID, ErrChecksumMismatch)
+	}
+	return nil
+}
+
+// fileChecksum вычисляет SHA-256 файла.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("ошибка открытия %s: %w", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("ошибка чтения %s: %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyingReader — распаковка файла с проверкой размера и SHA-256 по EOF.
+type verifyingReader struct {
+	r        *gzip.Reader
+	file     *os.File
+	hasher   hash.Hash
+	checksum string
+	size     int64
+	read     int64
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.r.Read(p)
+	v.hasher.Write(p[:n])
+	v.read += int64(n)
+	if errors.Is(err, io.EOF) {
+		if v.read != v.size || hex.EncodeToString(v.hasher.Sum(nil)) != v.checksum {
+			return n, ErrChecksumMismatch
+		}
+	}
+	return n, err
+}
+
+func (v *verifyingReader) Close() error {
+	_ = v.r.Close()
+	return v.file.Close()
+}
+
+// countingWriter — подсчёт записанных байт.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// writeFileSync записывает файл с fsync.
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("ошибка создания %s: %w", path, err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("ошибка записи %s: %w", path, err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("ошибка fsync %s: %w", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,178 @@
+package bundle
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+// sum возвращает SHA-256 содержимого в hex.
+func sum(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func TestBundle_Roundtrip(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"id-1": bytes.Repeat([]byte("a"), 4096),
+		"id-2": []byte("второй файл"),
+		"id-3": {},
+	}
+
+	w, err := Create(dir, "b1", gzip.BestSpeed)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	for _, id := range []string{"id-1", "id-2", "id-3"} {
+		if _, err := w.Add(id, id+".bin", sum(files[id]), bytes.NewReader(files[id])); err != nil {
+			t.Fatalf("Add %s: %v", id, err)
+		}
+	}
+	idx, err := w.Close()
+	if err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if len(idx.Entries) != 3 {
+		t.Fatalf("Записей в индексе: хотели 3, получили %d", len(idx.Entries))
+	}
+	if idx.OriginalSize() != int64(4096+len(files["id-2"])) {
+		t.Errorf("OriginalSize: получили %d", idx.OriginalSize())
+	}
+	if err := Verify(dir, idx); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+
+	read, err := ReadIndex(dir, "b1")
+	if err != nil {
+		t.Fatalf("ReadIndex: %v", err)
+	}
+	for _, entry := range read.Entries {
+		rc, err := Open(dir, "b1", entry)
+		if err != nil {
+			t.Fatalf("Open %s: %v", entry.FileID, err)
+		}
+		got, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatalf("Чтение %s: %v", entry.FileID, err)
+		}
+		if !bytes.Equal(got, files[entry.FileID]) {
+			t.Errorf("Содержимое %s не совпадает", entry.FileID)
+		}
+	}
+}
+
+func TestBundle_ChecksumMismatchOnAdd(t *testing.T) {
+	dir := t.TempDir()
+
+	w, err := Create(dir, "b1", gzip.DefaultCompression)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	good := []byte("хороший файл")
+	if _, err := w.Add("id-1", "f1", sum(good), bytes.NewReader(good)); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	sizeBefore := w.Size()
+
+	// Содержимое не совпадает с checksum — поток отбрасывается
+	_, err = w.Add("id-2", "f2", sum([]byte("другое")), bytes.NewReader([]byte("повреждён")))
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("Хотели ErrChecksumMismatch, получили %v", err)
+	}
+	if w.Size() != sizeBefore || w.Len() != 1 {
+		t.Errorf("После отброшенного Add: size %d→%d, len %d", sizeBefore, w.Size(), w.Len())
+	}
+
+	idx, err := w.Close()
+	if err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := Verify(dir, idx); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+}
+
+func TestBundle_CorruptionDetected(t *testing.T) {
+	dir := t.TempDir()
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+
+	w, err := Create(dir, "b1", gzip.NoCompression)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	entry, err := w.Add("id-1", "f1", sum(data), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	idx, err := w.Close()
+	if err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// Портим байт в середине несжатого содержимого
+	f, err := os.OpenFile(DataPath(dir, "b1"), os.O_RDWR, 0)
+	if err != nil {
+		t.Fatalf("Открытие .bin: %v", err)
+	}
+	if _, err := f.WriteAt([]byte("X"), entry.Offset+entry.CompressedSize/2); err != nil {
+		t.Fatalf("Запись: %v", err)
+	}
+	_ = f.Close()
+
+	if err := Verify(dir, idx); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("Verify: хотели ErrChecksumMismatch, получили %v", err)
+	}
+
+	rc, err := Open(dir, "b1", *entry)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer rc.Close()
+	if _, err := io.ReadAll(rc); err == nil {
+		t.Error("Чтение повреждённого файла завершилось без ошибки")
+	}
+}
+
+func TestList_CleanupIncomplete(t *testing.T) {
+	dir := t.TempDir()
+
+	w, err := Create(dir, "b1", gzip.DefaultCompression)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := w.Add("id-1", "f1", "", bytes.NewReader([]byte("data"))); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if _, err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// Незавершённый бандл — .bin без индекса
+	if _, err := Create(dir, "b2", gzip.DefaultCompression); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	indexes, err := List(dir, true)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(indexes) != 1 || indexes[0].ID != "b1" {
+		t.Errorf("List: хотели [b1], получили %d бандлов", len(indexes))
+	}
+	if _, err := os.Stat(DataPath(dir, "b2")); !os.IsNotExist(err) {
+		t.Errorf("Незавершённый бандл не удалён: %v", err)
+	}
+
+	// Отсутствующая директория архива — пустой список
+	if indexes, err := List(dir+"/missing", false); err != nil || len(indexes) != 0 {
+		t.Errorf("List несуществующей директории: %v, %d", err, len(indexes))
+	}
+}
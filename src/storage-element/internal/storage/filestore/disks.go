@@ -0,0 +1,465 @@
+// disks.go — управление директориями данных (JBOD): карта размещения файлов,
+// политика размещения, проверка доступности дисков и перенос файлов.
+package filestore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
+)
+
+// PlacementPolicy — политика выбора диска для нового файла.
+type PlacementPolicy string
+
+const (
+	// PlacementMostFree — диск с наибольшим свободным местом
+	PlacementMostFree PlacementPolicy = "most_free"
+	// PlacementRoundRobin — диски по очереди
+	PlacementRoundRobin PlacementPolicy = "round_robin"
+)
+
+// DiskState — состояние директории данных.
+type DiskState string
+
+const (
+	// DiskOnline — диск доступен для чтения и размещения
+	DiskOnline DiskState = "online"
+	// DiskFailed — диск недоступен на запись, исключён из размещения
+	DiskFailed DiskState = "failed"
+	// DiskDraining — файлы переносятся с диска, размещение запрещено
+	DiskDraining DiskState = "draining"
+)
+
+var (
+	// ErrDiskNotFound — путь не входит в список директорий данных.
+	ErrDiskNotFound = errors.New("диск не найден")
+	// ErrNoDiskAvailable — нет дисков, доступных для размещения файлов.
+	ErrNoDiskAvailable = errors.New("нет доступных дисков для размещения файлов")
+)
+
+// healthCheckFile — имя временного файла для проверки записи на диск.
+const healthCheckFile = ".health_check"
+
+// disk — директория данных.
+type disk struct {
+	path    string
+	primary bool
+	// failed — диск недоступен (ошибка создания или записи)
+	failed atomic.Bool
+	// draining — файлы переносятся с диска
+	draining atomic.Bool
+	// files — количество файлов данных на диске (по карте размещения)
+	files atomic.Int64
+}
+
+// state возвращает текущее состояние диска.
+func (d *disk) state() DiskState {
+	switch {
+	case d.failed.Load():
+		return DiskFailed
+	case d.draining.Load():
+		return DiskDraining
+	default:
+		return DiskOnline
+	}
+}
+
+// DiskStatus — состояние и ёмкость директории данных.
+type DiskStatus struct {
+	// Path — путь к директории данных
+	Path string
+	// Primary — основная директория (SE_DATA_DIR)
+	Primary bool
+	// State — состояние диска
+	State DiskState
+	// TotalBytes — размер файловой системы (statfs)
+	TotalBytes int64
+	// UsedBytes — занято на файловой системе
+	UsedBytes int64
+	// AvailableBytes — доступно для записи
+	AvailableBytes int64
+	// FilesCount — количество файлов данных на диске
+	FilesCount int
+}
+
+// Disks возвращает состояние всех директорий данных.
+// Ёмкость недоступного диска — нули.
+func (fs *FileStore) Disks() []DiskStatus {
+	result := make([]DiskStatus, 0, len(fs.disks))
+	for _, d := range fs.disks {
+		status := DiskStatus{
+			Path:       d.path,
+			Primary:    d.primary,
+			State:      d.state(),
+			FilesCount: int(d.files.Load()),
+		}
+		if status.State != DiskFailed {
+			if total, avail, err := diskUsage(d.path); err == nil {
+				status.TotalBytes = total
+				status.AvailableBytes = avail
+				status.UsedBytes = total - avail
+			}
+		}
+		result = append(result, status)
+	}
+	return result
+}
+
+// CheckDisks проверяет запись на каждый диск и обновляет признак отказа.
+// Восстановившийся диск возвращается в работу, его файлы пересканируются.
+// Возвращает состояние дисков после проверки.
+func (fs *FileStore) CheckDisks() []DiskStatus {
+	for _, d := range fs.disks {
+		wasFailed := d.failed.Load()
+		ok := probeWrite(d.path)
+		d.failed.Store(!ok)
+		if wasFailed && ok {
+			fs.rescanDisk(d)
+		}
+	}
+	return fs.Disks()
+}
+
+// SetDraining переводит диск в режим переноса (draining=true)
+// или возвращает его в размещение (draining=false).
+func (fs *FileStore) SetDraining(path string, draining bool) error {
+	d := fs.diskByPath(path)
+	if d == nil {
+		return fmt.Errorf("%w: %s", ErrDiskNotFound, path)
+	}
+	d.draining.Store(draining)
+	return nil
+}
+
+// HasPlacementTarget проверяет, есть ли диск для размещения файлов,
+// кроме указанного.
+func (fs *FileStore) HasPlacementTarget(excludePath string) bool {
+	return len(fs.placementOrder(fs.diskByPath(excludePath))) > 0
+}
+
+// FilesOnDisk возвращает storage_path всех файлов, размещённых на диске.
+func (fs *FileStore) FilesOnDisk(path string) ([]string, error) {
+	d := fs.diskByPath(path)
+	if d == nil {
+		return nil, fmt.Errorf("%w: %s", ErrDiskNotFound, path)
+	}
+
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	var result []string
+	for storagePath, loc := range fs.locations {
+		if loc == d {
+			result = append(result, storagePath)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// DiskOf возвращает директорию данных, на которой размещён файл, по карте
+// размещения. Для файла вне карты возвращается пустая строка.
+func (fs *FileStore) DiskOf(storagePath string) string {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	if d, ok := fs.locations[storagePath]; ok {
+		return d.path
+	}
+	return ""
+}
+
+// Relocate переносит файл и его attr.json на другой диск согласно
+// политике размещения. storage_path файла не меняется.
+// Возвращает размер перенесённого файла.
+//
+// Порядок: копия файла (fsync + rename) → копия attr.json → смена
+// размещения → удаление исходных файлов. Копия attr.json и смена размещения
+// выполняются под блокировкой attr.json файла, поэтому запись метаданных
+// (WriteAttr) попадает либо в исходный attr.json до копирования, либо
+// в attr.json на новом диске. При сбое в процессе копия может остаться
+// на двух дисках; повторный перенос её устраняет.
+func (fs *FileStore) Relocate(storagePath string) (int64, error) {
+	src := fs.locate(storagePath)
+	candidates := fs.placementOrder(src)
+	if len(candidates) == 0 {
+		return 0, ErrNoDiskAvailable
+	}
+	dst := candidates[0]
+
+	srcPath := filepath.Join(src.path, storagePath)
+	dstPath := filepath.Join(dst.path, storagePath)
+	srcAttr := attr.AttrFilePath(srcPath)
+	dstAttr := attr.AttrFilePath(dstPath)
+
+	size, err := copyFileSync(srcPath, dstPath)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := fs.relocateAttr(storagePath, srcAttr, dstAttr, dst); err != nil {
+		_ = os.Remove(dstPath)
+		return 0, err
+	}
+
+	_ = os.Remove(srcAttr)
+	_ = os.Remove(srcPath)
+
+	return size, nil
+}
+
+// relocateAttr копирует attr.json на диск dst и переключает на него
+// размещение файла под блокировкой attr.json.
+func (fs *FileStore) relocateAttr(storagePath, srcAttr, dstAttr string, dst *disk) error {
+	lock := fs.attrLock(storagePath)
+	lock.Lock()
+	defer lock.Unlock()
+
+	attrData, err := os.ReadFile(srcAttr)
+	if err != nil {
+		return fmt.Errorf("ошибка чтения attr.json %s: %w", storagePath, err)
+	}
+	if err := writeFileSync(dstAttr, attrData); err != nil {
+		return err
+	}
+
+	fs.setLocation(storagePath, dst)
+	return nil
+}
+
+// Rescan перестраивает карту размещения сканированием всех доступных дисков.
+// Размещение файлов на недоступных дисках сохраняется.
+func (fs *FileStore) Rescan() {
+	for _, d := range fs.disks {
+		if !d.failed.Load() {
+			fs.rescanDisk(d)
+		}
+	}
+}
+
+// rescanDisk заменяет записи карты размещения для одного диска.
+// Ошибка чтения директории помечает диск как failed.
+func (fs *FileStore) rescanDisk(d *disk) {
+	names, err := listDataFiles(d.path)
+	if err != nil {
+		d.failed.Store(true)
+		return
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	for storagePath, loc := range fs.locations {
+		if loc == d {
+			delete(fs.locations, storagePath)
+		}
+	}
+	d.files.Store(0)
+
+	for _, name := range names {
+		if prev, ok := fs.locations[name]; ok {
+			prev.files.Add(-1)
+		}
+		fs.locations[name] = d
+		d.files.Add(1)
+	}
+}
+
+// locate возвращает диск, на котором находится файл.
+// Если файл не найден в карте размещения, диски проверяются по очереди
+// (по файлу данных или его attr.json). Неизвестный файл — основной диск.
+func (fs *FileStore) locate(storagePath string) *disk {
+	fs.mu.RLock()
+	d, ok := fs.locations[storagePath]
+	fs.mu.RUnlock()
+	if ok {
+		return d
+	}
+
+	for _, d := range fs.disks {
+		fullPath := filepath.Join(d.path, storagePath)
+		if _, err := os.Lstat(fullPath); err == nil {
+			fs.setLocation(storagePath, d)
+			return d
+		}
+		if _, err := os.Lstat(attr.AttrFilePath(fullPath)); err == nil {
+			return d
+		}
+	}
+
+	return fs.disks[0]
+}
+
+// setLocation записывает размещение файла.
+func (fs *FileStore) setLocation(storagePath string, d *disk) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if prev, ok := fs.locations[storagePath]; ok {
+		prev.files.Add(-1)
+	}
+	fs.locations[storagePath] = d
+	d.files.Add(1)
+}
+
+// forgetLocation удаляет файл из карты размещения.
+func (fs *FileStore) forgetLocation(storagePath string) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if prev, ok := fs.locations[storagePath]; ok {
+		prev.files.Add(-1)
+		delete(fs.locations, storagePath)
+	}
+}
+
+// diskByPath находит диск по пути директории.
+func (fs *FileStore) diskByPath(path string) *disk {
+	clean := filepath.Clean(path)
+	for _, d := range fs.disks {
+		if d.path == clean {
+			return d
+		}
+	}
+	return nil
+}
+
+// placementOrder возвращает диски, доступные для размещения, в порядке
+// предпочтения по политике. exclude — диск, исключаемый из выбора (или nil).
+func (fs *FileStore) placementOrder(exclude *disk) []*disk {
+	var available []*disk
+	for _, d := range fs.disks {
+		if d == exclude || d.failed.Load() || d.draining.Load() {
+			continue
+		}
+		available = append(available, d)
+	}
+	if len(available) <= 1 {
+		return available
+	}
+
+	if fs.policy == PlacementRoundRobin {
+		start := int((fs.rrNext.Add(1) - 1) % uint64(len(available)))
+		ordered := make([]*disk, 0, len(available))
+		ordered = append(ordered, available[start:]...)
+		return append(ordered, available[:start]...)
+	}
+
+	// most_free: по убыванию свободного места
+	free := make(map[*disk]int64, len(available))
+	for _, d := range available {
+		if _, avail, err := diskUsage(d.path); err == nil {
+			free[d] = avail
+		}
+	}
+	sort.SliceStable(available, func(i, j int) bool {
+		return free[available[i]] > free[available[j]]
+	})
+	return available
+}
+
+// listDataFiles возвращает имена файлов данных в директории
+// (без attr.json, служебных и временных файлов).
+func listDataFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения директории %s: %w", dir, err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") ||
+			strings.HasSuffix(name, ".tmp") || attr.IsAttrFile(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// diskUsage возвращает размер файловой системы и доступное место (statfs).
+func diskUsage(path string) (total, available int64, err error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, 0, fmt.Errorf("ошибка statfs %s: %w", path, err)
+	}
+	blockSize := int64(st.Bsize) //nolint:unconvert // тип Bsize зависит от платформы
+	return int64(st.Blocks) * blockSize, int64(st.Bavail) * blockSize, nil
+}
+
+// probeWrite проверяет возможность записи в директорию.
+func probeWrite(dir string) bool {
+	testFile := filepath.Join(dir, healthCheckFile)
+	if err := os.WriteFile(testFile, []byte("ok"), 0o600); err != nil {
+		return false
+	}
+	_ = os.Remove(testFile)
+	return true
+}
+
+// copyFileSync копирует файл через temp файл с fsync и атомарным rename.
+func copyFileSync(srcPath, dstPath string) (int64, error) {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка открытия файла %s: %w", srcPath, err)
+	}
+	defer src.Close()
+
+	tmpPath := dstPath + ".tmp"
+	dst, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, fmt.Errorf("ошибка создания временного файла: %w", err)
+	}
+
+	size, err := io.Copy(dst, src)
+	if err == nil {
+		err = dst.Sync()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, dstPath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return 0, fmt.Errorf("ошибка копирования файла %s: %w", srcPath, err)
+	}
+
+	return size, nil
+}
+
+// writeFileSync атомарно записывает данные в файл (temp → fsync → rename).
+func writeFileSync(path string, data []byte) error {
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("ошибка создания временного файла: %w", err)
+	}
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("ошибка записи файла %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package filestore
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/model"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
+)
+
+// newTestMultiDir создаёт FileStore с несколькими временными директориями.
+func newTestMultiDir(t *testing.T, n int, policy PlacementPolicy) (*FileStore, []string) {
+	t.Helper()
+
+	dirs := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		dirs = append(dirs, t.TempDir())
+	}
+
+	fs, err := NewMultiDir(dirs, policy)
+	if err != nil {
+		t.Fatalf("ошибка создания FileStore: %v", err)
+	}
+	return fs, dirs
+}
+
+// TestSaveFile_RoundRobin проверяет чередование дисков при размещении.
+func TestSaveFile_RoundRobin(t *testing.T) {
+	fs, dirs := newTestMultiDir(t, 2, PlacementRoundRobin)
+
+	var paths []string
+	for i := 0; i < 4; i++ {
+		result, err := fs.SaveFile(bytes.NewReader([]byte("data")), "file.txt", "admin")
+		if err != nil {
+			t.Fatalf("ошибка сохранения: %v", err)
+		}
+		paths = append(paths, result.FullPath)
+	}
+
+	for i, p := range paths {
+		expectedDir := dirs[i%2]
+		if filepath.Dir(p) != expectedDir {
+			t.Errorf("файл %d: ожидалась директория %s, получена %s", i, expectedDir, filepath.Dir(p))
+		}
+	}
+
+	for _, d := range fs.Disks() {
+		if d.FilesCount != 2 {
+			t.Errorf("диск %s: ожидалось 2 файла, получено %d", d.Path, d.FilesCount)
+		}
+	}
+}
+
+// TestFullPath_ResolvesDisk проверяет поиск файла и attr.json на дополнительном диске.
+func TestFullPath_ResolvesDisk(t *testing.T) {
+	dirs := []string{t.TempDir(), t.TempDir()}
+
+	// Файл создан до запуска FileStore — находится сканированием
+	if err := os.WriteFile(filepath.Join(dirs[1], "existing.txt"), []byte("data"), 0o640); err != nil {
+		t.Fatalf("ошибка создания файла: %v", err)
+	}
+	attrPath := attr.AttrFilePath(filepath.Join(dirs[1], "existing.txt"))
+	if err := os.WriteFile(attrPath, []byte("{}"), 0o640); err != nil {
+		t.Fatalf("ошибка создания attr.json: %v", err)
+	}
+
+	fs, err := NewMultiDir(dirs, PlacementMostFree)
+	if err != nil {
+		t.Fatalf("ошибка создания FileStore: %v", err)
+	}
+
+	if got := fs.FullPath("existing.txt"); got != filepath.Join(dirs[1], "existing.txt") {
+		t.Errorf("FullPath: ожидался путь на втором диске, получен %s", got)
+	}
+
+	// После удаления файла данных attr.json по-прежнему находится на своём диске
+	if err := fs.DeleteFile("existing.txt"); err != nil {
+		t.Fatalf("ошибка удаления: %v", err)
+	}
+	if got := attr.AttrFilePath(fs.FullPath("existing.txt")); got != attrPath {
+		t.Errorf("путь attr.json после удаления файла: ожидался %s, получен %s", attrPath, got)
+	}
+}
+
+// TestRelocate проверяет перенос файла и attr.json на другой диск.
+func TestRelocate(t *testing.T) {
+	fs, dirs := newTestMultiDir(t, 2, PlacementRoundRobin)
+
+	result, err := fs.SaveFile(bytes.NewReader([]byte("relocate me")), "file.txt", "admin")
+	if err != nil {
+		t.Fatalf("ошибка сохранения: %v", err)
+	}
+	if err := os.WriteFile(attr.AttrFilePath(result.FullPath), []byte(`{"file_id":"x"}`), 0o640); err != nil {
+		t.Fatalf("ошибка создания attr.json: %v", err)
+	}
+
+	size, err := fs.Relocate(result.StoragePath)
+	if err != nil {
+		t.Fatalf("ошибка переноса: %v", err)
+	}
+	if size != int64(len("relocate me")) {
+		t.Errorf("размер: ожидалось %d, получено %d", len("relocate me"), size)
+	}
+
+	newPath := filepath.Join(dirs[1], result.StoragePath)
+	if fs.FullPath(result.StoragePath) != newPath {
+		t.Errorf("FullPath после переноса: ожидался %s, получен %s", newPath, fs.FullPath(result.StoragePath))
+	}
+	if _, err := os.Stat(attr.AttrFilePath(newPath)); err != nil {
+		t.Errorf("attr.json не перенесён: %v", err)
+	}
+	if _, err := os.Stat(result.FullPath); !os.IsNotExist(err) {
+		t.Error("исходный файл должен быть удалён")
+	}
+	if _, err := os.Stat(attr.AttrFilePath(result.FullPath)); !os.IsNotExist(err) {
+		t.Error("исходный attr.json должен быть удалён")
+	}
+}
+
+// TestRelocate_ConcurrentWriteAttr проверяет, что запись attr.json во время
+// переноса не теряется: после переносов на диске остаётся последняя версия.
+func TestRelocate_ConcurrentWriteAttr(t *testing.T) {
+	fs, _ := newTestMultiDir(t, 2, PlacementRoundRobin)
+
+	result, err := fs.SaveFile(bytes.NewReader([]byte("relocate me")), "file.txt", "admin")
+	if err != nil {
+		t.Fatalf("ошибка сохранения: %v", err)
+	}
+	meta := &model.FileMetadata{FileID: "x", StoragePath: result.StoragePath}
+	if err := fs.WriteAttr(result.StoragePath, meta); err != nil {
+		t.Fatalf("ошибка создания attr.json: %v", err)
+	}
+
+	const writes = 200
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= writes; i++ {
+			update := *meta
+			update.Description = fmt.Sprintf("v%d", i)
+			if err := fs.WriteAttr(result.StoragePath, &update); err != nil {
+				t.Errorf("ошибка записи attr.json: %v", err)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 20; i++ {
+		if _, err := fs.Relocate(result.StoragePath); err != nil {
+			t.Fatalf("ошибка переноса: %v", err)
+		}
+	}
+	wg.Wait()
+
+	got, err := attr.Read(attr.AttrFilePath(fs.FullPath(result.StoragePath)))
+	if err != nil {
+		t.Fatalf("ошибка чтения attr.json: %v", err)
+	}
+	if want := fmt.Sprintf("v%d", writes); got.Description != want {
+		t.Errorf("description: ожидалось %s, получено %s", want, got.Description)
+	}
+}
+
+// TestDraining_ExcludedFromPlacement проверяет, что диск в draining не получает файлов.
+func TestDraining_ExcludedFromPlacement(t *testing.T) {
+	fs, dirs := newTestMultiDir(t, 2, PlacementRoundRobin)
+
+	if err := fs.SetDraining(dirs[0], true); err != nil {
+		t.Fatalf("ошибка SetDraining: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		result, err := fs.SaveFile(bytes.NewReader([]byte("data")), "file.txt", "admin")
+		if err != nil {
+			t.Fatalf("ошибка сохранения: %v", err)
+		}
+		if filepath.Dir(result.FullPath) != dirs[1] {
+			t.Errorf("файл размещён на диске в draining: %s", result.FullPath)
+		}
+	}
+
+	if fs.HasPlacementTarget(dirs[1]) {
+		t.Error("кроме второго диска не должно быть доступных для размещения")
+	}
+	if err := fs.SetDraining("/nonexistent", true); err == nil {
+		t.Error("ожидалась ошибка для неизвестного диска")
+	}
+}
+
+// TestNewMultiDir_FailedSecondaryDisk проверяет запуск с недоступным дополнительным диском.
+func TestNewMultiDir_FailedSecondaryDisk(t *testing.T) {
+	primary := t.TempDir()
+
+	// Путь внутри обычного файла — MkdirAll завершится ошибкой
+	blocker := filepath.Join(t.TempDir(), "blocker")
+	if err := os.WriteFile(blocker, []byte("x"), 0o640); err != nil {
+		t.Fatalf("ошибка создания файла: %v", err)
+	}
+	broken := filepath.Join(blocker, "disk")
+
+	fs, err := NewMultiDir([]string{primary, broken}, PlacementMostFree)
+	if err != nil {
+		t.Fatalf("отказ дополнительного диска не должен останавливать FileStore: %v", err)
+	}
+
+	disks := fs.CheckDisks()
+	if disks[0].State != DiskOnline {
+		t.Errorf("основной диск: ожидалось %s, получено %s", DiskOnline, disks[0].State)
+	}
+	if disks[1].State != DiskFailed {
+		t.Errorf("дополнительный диск: ожидалось %s, получено %s", DiskFailed, disks[1].State)
+	}
+
+	result, err := fs.SaveFile(bytes.NewReader([]byte("data")), "file.txt", "admin")
+	if err != nil {
+		t.Fatalf("ошибка сохранения: %v", err)
+	}
+	if filepath.Dir(result.FullPath) != primary {
+		t.Errorf("файл должен быть размещён на основном диске, получен %s", result.FullPath)
+	}
+}
@@ -1,30 +1,55 @@
 // Пакет filestore — операции с физическими файлами на диске.
 // Обеспечивает streaming-запись с подсчётом SHA-256 на лету,
 // чтение, удаление и получение информации о ёмкости диска.
+//
+// FileStore управляет одной или несколькими директориями данных (JBOD).
+// storage_path файла — имя файла без директории; диск, на котором лежит
+// файл, определяется картой размещения, построенной сканированием дисков.
+// attr.json всегда хранится на том же диске, что и файл.
 package filestore
 
 import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/bigkaa/goartstore/storage-element/internal/domain/model"
+	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
 )
 
-// FileStore — управление физическими файлами на диске.
+// attrLockStripes — число блокировок attr.json (по хэшу storage_path).
+const attrLockStripes = 64
+
+// FileStore — управление физическими файлами на дисках.
 type FileStore struct {
-	// dataDir — корневая директория хранения файлов (SE_DATA_DIR)
-	dataDir string
+	// disks — директории данных; первая — основная (SE_DATA_DIR)
+	disks []*disk
+	// policy — политика размещения новых файлов
+	policy PlacementPolicy
+	// rrNext — счётчик для политики round_robin
+	rrNext atomic.Uint64
+
+	mu sync.RWMutex
+	// locations — карта размещения: storage_path → диск
+	locations map[string]*disk
+
+	// attrLocks — сериализация записи attr.json и переноса файла между дисками
+	attrLocks [attrLockStripes]sync.Mutex
 }
 
 // SaveResult — результат сохранения файла на диск.
 type SaveResult struct {
-	// StoragePath — относительный путь файла в dataDir
+	// StoragePath — имя файла в директории данных
 	StoragePath string
 	// FullPath — абсолютный путь файла на диске
 	FullPath string
@@ -34,14 +59,40 @@ type SaveResult struct {
 	Checksum string
 }
 
-// New создаёт новый FileStore. Проверяет и создаёт директорию
-// если она не существует.
+// New создаёт новый FileStore с одной директорией данных. Проверяет и
+// создаёт директорию если она не существует.
 func New(dataDir string) (*FileStore, error) {
-	if err := os.MkdirAll(dataDir, 0o750); err != nil {
-		return nil, fmt.Errorf("не удалось создать директорию данных %s: %w", dataDir, err)
+	return NewMultiDir([]string{dataDir}, PlacementMostFree)
+}
+
+// NewMultiDir создаёт FileStore с несколькими директориями данных (JBOD).
+// Первая директория — основная: ошибка её создания возвращается.
+// Недоступные дополнительные директории помечаются как failed,
+// SE продолжает работу на остальных дисках.
+func NewMultiDir(dataDirs []string, policy PlacementPolicy) (*FileStore, error) {
+	if len(dataDirs) == 0 {
+		return nil, fmt.Errorf("не задано ни одной директории данных")
+	}
+
+	fs := &FileStore{
+		policy:    policy,
+		locations: make(map[string]*disk),
 	}
 
-	return &FileStore{dataDir: dataDir}, nil
+	for i, dir := range dataDirs {
+		d := &disk{path: dir, primary: i == 0}
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			if d.primary {
+				return nil, fmt.Errorf("не удалось создать директорию данных %s: %w", dir, err)
+			}
+			d.failed.Store(true)
+		}
+		fs.disks = append(fs.disks, d)
+	}
+
+	fs.Rescan()
+
+	return fs, nil
 }
 
 // SaveFile записывает данные из reader на диск с подсчётом SHA-256 на лету.
@@ -50,15 +101,37 @@ func New(dataDir string) (*FileStore, error) {
 //
 // Паттерн: temp файл → запись + SHA-256 → fsync → atomic rename.
 // При ошибке temp файл удаляется.
+//
+// Диск выбирается политикой размещения. Если на выбранном диске не удаётся
+// создать файл, диск помечается как failed и используется следующий.
 func (fs *FileStore) SaveFile(reader io.Reader, originalFilename, uploadedBy string) (*SaveResult, error) {
 	// Генерируем имя файла для хранения
 	storageName := generateStorageName(originalFilename, uploadedBy)
-	fullPath := filepath.Join(fs.dataDir, storageName)
-	tmpPath := fullPath + ".tmp"
 
-	// Создаём temp файл
-	f, err := os.Create(tmpPath)
-	if err != nil {
+	candidates := fs.placementOrder(nil)
+	if len(candidates) == 0 {
+		return nil, ErrNoDiskAvailable
+	}
+
+	// Создаём temp файл на первом доступном диске
+	var (
+		target   *disk
+		fullPath string
+		tmpPath  string
+		f        *os.File
+		err      error
+	)
+	for _, d := range candidates {
+		fullPath = filepath.Join(d.path, storageName)
+		tmpPath = fullPath + ".tmp"
+		f, err = os.Create(tmpPath)
+		if err == nil {
+			target = d
+			break
+		}
+		d.failed.Store(true)
+	}
+	if target == nil {
 		return nil, fmt.Errorf("ошибка создания временного файла: %w", err)
 	}
 
@@ -91,6 +164,8 @@ func (fs *FileStore) SaveFile(reader io.Reader, originalFilename, uploadedBy str
 		return nil, fmt.Errorf("ошибка атомарного переименования: %w", err)
 	}
 
+	fs.setLocation(storageName, target)
+
 	return &SaveResult{
 		StoragePath: storageName,
 		FullPath:    fullPath,
@@ -100,12 +175,16 @@ func (fs *FileStore) SaveFile(reader io.Reader, originalFilename, uploadedBy str
 }
 
 // ReadFile открывает файл для чтения и возвращает io.ReadCloser.
-// storagePath — относительный путь файла в dataDir.
+// storagePath — имя файла в директории данных.
 // Вызывающий код обязан закрыть ReadCloser.
 func (fs *FileStore) ReadFile(storagePath string) (*os.File, error) {
-	fullPath := filepath.Join(fs.dataDir, storagePath)
-
-	f, err := os.Open(fullPath)
+	f, err := os.Open(fs.FullPath(storagePath))
+	if os.IsNotExist(err) {
+		// Файл мог быть перенесён на другой диск другим экземпляром SE
+		// (replicated mode) — сбрасываем размещение и ищем заново
+		fs.forgetLocation(storagePath)
+		f, err = os.Open(fs.FullPath(storagePath))
+	}
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, fmt.Errorf("файл не найден: %s", storagePath)
@@ -117,34 +196,52 @@ func (fs *FileStore) ReadFile(storagePath string) (*os.File, error) {
 }
 
 // FullPath возвращает абсолютный путь к файлу на диске.
+// Для неизвестного файла возвращается путь в основной директории.
 func (fs *FileStore) FullPath(storagePath string) string {
-	return filepath.Join(fs.dataDir, storagePath)
+	return filepath.Join(fs.locate(storagePath).path, storagePath)
+}
+
+// WriteAttr записывает attr.json файла на диск, где файл размещён.
+// Запись не пересекается с переносом файла на другой диск (Relocate).
+func (fs *FileStore) WriteAttr(storagePath string, meta *model.FileMetadata) error {
+	lock := fs.attrLock(storagePath)
+	lock.Lock()
+	defer lock.Unlock()
+
+	return attr.Write(attr.AttrFilePath(fs.FullPath(storagePath)), meta)
+}
+
+// attrLock возвращает блокировку attr.json файла.
+func (fs *FileStore) attrLock(storagePath string) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(storagePath))
+	return &fs.attrLocks[h.Sum32()%attrLockStripes]
 }
 
 // DeleteFile удаляет файл с диска.
-// storagePath — относительный путь файла в dataDir.
+// storagePath — имя файла в директории данных.
 // Возвращает nil если файл уже не существует.
+// attr.json файла остаётся на диске и по-прежнему находится через FullPath.
 func (fs *FileStore) DeleteFile(storagePath string) error {
-	fullPath := filepath.Join(fs.dataDir, storagePath)
+	fullPath := fs.FullPath(storagePath)
 
 	err := os.Remove(fullPath)
 	if err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("ошибка удаления файла %s: %w", storagePath, err)
 	}
+	fs.forgetLocation(storagePath)
 	return nil
 }
 
 // FileExists проверяет существование файла на диске.
 func (fs *FileStore) FileExists(storagePath string) bool {
-	fullPath := filepath.Join(fs.dataDir, storagePath)
-	_, err := os.Stat(fullPath)
+	_, err := os.Stat(fs.FullPath(storagePath))
 	return err == nil
 }
 
 // FileSize возвращает размер файла на диске.
 func (fs *FileStore) FileSize(storagePath string) (int64, error) {
-	fullPath := filepath.Join(fs.dataDir, storagePath)
-	info, err := os.Stat(fullPath)
+	info, err := os.Stat(fs.FullPath(storagePath))
 	if err != nil {
 		return 0, fmt.Errorf("ошибка получения информации о файле %s: %w", storagePath, err)
 	}
@@ -154,9 +251,7 @@ func (fs *FileStore) FileSize(storagePath string) (int64, error) {
 // ComputeChecksum вычисляет SHA-256 хэш существующего файла.
 // Используется при reconciliation для проверки целостности.
 func (fs *FileStore) ComputeChecksum(storagePath string) (string, error) {
-	fullPath := filepath.Join(fs.dataDir, storagePath)
-
-	f, err := os.Open(fullPath)
+	f, err := os.Open(fs.FullPath(storagePath))
 	if err != nil {
 		return "", fmt.Errorf("ошибка открытия файла %s: %w", storagePath, err)
 	}
@@ -170,9 +265,18 @@ func (fs *FileStore) ComputeChecksum(storagePath string) (string, error) {
 	return hex.EncodeToString(hasher.Sum(nil)), nil
 }
 
-// DataDir возвращает путь к директории данных.
+// DataDir возвращает путь к основной директории данных.
 func (fs *FileStore) DataDir() string {
-	return fs.dataDir
+	return fs.disks[0].path
+}
+
+// DataDirs возвращает пути ко всем директориям данных (основная — первой).
+func (fs *FileStore) DataDirs() []string {
+	dirs := make([]string, 0, len(fs.disks))
+	for _, d := range fs.disks {
+		dirs = append(dirs, d.path)
+	}
+	return dirs
 }
 
 // generateStorageName генерирует имя файла для хранения на диске.
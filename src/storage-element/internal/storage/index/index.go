@@ -12,8 +12,10 @@ package index
 import (
 	"fmt"
 	"log/slog"
+	"path/filepath"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/bigkaa/goartstore/storage-element/internal/domain/model"
 	"github.com/bigkaa/goartstore/storage-element/internal/storage/attr"
@@ -26,14 +28,22 @@ type Index struct {
 	mu              sync.RWMutex
 	files           map[string]*model.FileMetadata // file_id → metadata
 	totalActiveSize int64                          // кумулятивный размер active файлов (байты)
+	usage           map[string]Usage               // uploaded_by → объём и количество active файлов
 	ready           bool                           // индекс построен и готов
 	logger          *slog.Logger
 }
 
+// Usage — суммарный размер и количество active файлов субъекта.
+type Usage struct {
+	Bytes int64
+	Files int
+}
+
 // New создаёт пустой индекс. Для заполнения вызовите BuildFromDir.
 func New(logger *slog.Logger) *Index {
 	return &Index{
 		files:  make(map[string]*model.FileMetadata),
+		usage:  make(map[string]Usage),
 		logger: logger.With(slog.String("component", "index")),
 	}
 }
@@ -42,22 +52,97 @@ func New(logger *slog.Logger) *Index {
 // Вызывается при старте сервера. Заменяет текущее содержимое индекса.
 // После успешного построения индекс помечается как ready.
 func (idx *Index) BuildFromDir(dataDir string) error {
+	return idx.BuildFromDirs([]string{dataDir})
+}
+
+// BuildFromDirs строит индекс из attr.json файлов во всех директориях данных (JBOD).
+// Ошибка сканирования первой (основной) директории возвращается,
+// недоступные дополнительные директории пропускаются с предупреждением.
+func (idx *Index) BuildFromDirs(dataDirs []string) error {
+	return idx.build(dataDirs, nil, nil)
+}
+
+// RebuildFromDir полностью пересобирает индекс из attr.json.
+// Аналогичен BuildFromDir, но используется при reconciliation.
+func (idx *Index) RebuildFromDir(dataDir string) error {
+	return idx.BuildFromDir(dataDir)
+}
+
+// RebuildFromDirs полностью пересобирает индекс из attr.json всех директорий данных.
+//
+// Директории failedDirs (отказавшие диски) не сканируются. Записи файлов
+// недоступных директорий — отказавших и тех, что не удалось просканировать, —
+// сохраняются из текущего индекса: отказ диска не должен выглядеть как
+// удаление его файлов (полная синхронизация Admin Module пометила бы их
+// удалёнными). diskOf возвращает директорию файла по storage_path
+// ("" — неизвестна, как и при diskOf == nil); файлы с неизвестной
+// директорией при наличии недоступных директорий тоже сохраняются.
+func (idx *Index) RebuildFromDirs(dataDirs, failedDirs []string, diskOf func(storagePath string) string) error {
+	return idx.build(dataDirs, failedDirs, diskOf)
+}
+
+// build сканирует директории данных и заменяет содержимое индекса.
+func (idx *Index) build(dataDirs, failedDirs []string, diskOf func(storagePath string) string) error {
 	idx.mu.Lock()
 	defer idx.mu.Unlock()
 
+	unavailable := make(map[string]bool, len(failedDirs))
+	for _, dir := range failedDirs {
+		unavailable[filepath.Clean(dir)] = true
+	}
+
 	// Сканируем все attr.json файлы
-	metadatas, err := attr.ScanDir(dataDir)
-	if err != nil {
-		return fmt.Errorf("ошибка сканирования директории %s: %w", dataDir, err)
+	var metadatas []*model.FileMetadata
+	for i, dataDir := range dataDirs {
+		if unavailable[filepath.Clean(dataDir)] {
+			continue
+		}
+		dirMetadatas, err := attr.ScanDir(dataDir)
+		if err != nil {
+			if i == 0 {
+				return fmt.Errorf("ошибка сканирования директории %s: %w", dataDir, err)
+			}
+			idx.logger.Warn("Директория данных пропущена при построении индекса",
+				slog.String("data_dir", dataDir),
+				slog.String("error", err.Error()),
+			)
+			unavailable[filepath.Clean(dataDir)] = true
+			continue
+		}
+		metadatas = append(metadatas, dirMetadatas...)
 	}
 
 	// Очищаем текущий индекс и заполняем новыми данными
+	previous := idx.files
 	idx.files = make(map[string]*model.FileMetadata, len(metadatas))
 	idx.totalActiveSize = 0
+	idx.usage = make(map[string]Usage)
 	for _, meta := range metadatas {
+		// Копия файла на двух дисках (прерванный перенос) учитывается один раз
+		if prev, ok := idx.files[meta.FileID]; ok {
+			idx.unaccount(prev)
+		}
 		idx.files[meta.FileID] = meta
-		if meta.Status == model.StatusActive {
-			idx.totalActiveSize += meta.Size
+		idx.account(meta)
+	}
+
+	// Файлы недоступных директорий остаются в индексе до восстановления диска
+	kept := 0
+	if len(unavailable) > 0 {
+		for fileID, meta := range previous {
+			if _, ok := idx.files[fileID]; ok {
+				continue
+			}
+			dir := ""
+			if diskOf != nil {
+				dir = diskOf(meta.StoragePath)
+			}
+			if dir != "" && !unavailable[filepath.Clean(dir)] {
+				continue
+			}
+			idx.files[fileID] = meta
+			idx.account(meta)
+			kept++
 		}
 	}
 
@@ -65,19 +150,14 @@ func (idx *Index) BuildFromDir(dataDir string) error {
 
 	idx.logger.Info("Индекс метаданных построен",
 		slog.Int("files", len(idx.files)),
+		slog.Int("kept_unavailable", kept),
 		slog.Int64("total_active_size", idx.totalActiveSize),
-		slog.String("data_dir", dataDir),
+		slog.Any("data_dirs", dataDirs),
 	)
 
 	return nil
 }
 
-// RebuildFromDir полностью пересобирает индекс из attr.json.
-// Аналогичен BuildFromDir, но используется при reconciliation.
-func (idx *Index) RebuildFromDir(dataDir string) error {
-	return idx.BuildFromDir(dataDir)
-}
-
 // IsReady возвращает true, если индекс построен и готов к использованию.
 func (idx *Index) IsReady() bool {
 	idx.mu.RLock()
@@ -87,22 +167,18 @@ func (idx *Index) IsReady() bool {
 
 // Add добавляет метаданные файла в индекс.
 // Если файл с таким ID уже существует, он будет перезаписан.
-// Корректно обновляет кумулятивный счётчик totalActiveSize.
+// Корректно обновляет кумулятивные счётчики totalActiveSize и usage.
 func (idx *Index) Add(meta *model.FileMetadata) {
 	idx.mu.Lock()
 	defer idx.mu.Unlock()
 
 	// Если существующий файл был active — вычитаем его размер
 	if existing, ok := idx.files[meta.FileID]; ok {
-		if existing.Status == model.StatusActive {
-			idx.totalActiveSize -= existing.Size
-		}
+		idx.unaccount(existing)
 	}
 
 	// Если новый файл active — прибавляем его размер
-	if meta.Status == model.StatusActive {
-		idx.totalActiveSize += meta.Size
-	}
+	idx.account(meta)
 
 	// Создаём копию, чтобы избежать data race при внешних изменениях
 	copied := *meta
@@ -111,7 +187,7 @@ func (idx *Index) Add(meta *model.FileMetadata) {
 
 // Update обновляет метаданные файла в индексе.
 // Возвращает ошибку, если файл не найден.
-// Корректно обновляет кумулятивный счётчик totalActiveSize.
+// Корректно обновляет кумулятивные счётчики totalActiveSize и usage.
 func (idx *Index) Update(meta *model.FileMetadata) error {
 	idx.mu.Lock()
 	defer idx.mu.Unlock()
@@ -122,14 +198,10 @@ func (idx *Index) Update(meta *model.FileMetadata) error {
 	}
 
 	// Вычитаем старый размер, если файл был active
-	if existing.Status == model.StatusActive {
-		idx.totalActiveSize -= existing.Size
-	}
+	idx.unaccount(existing)
 
 	// Прибавляем новый размер, если файл становится active
-	if meta.Status == model.StatusActive {
-		idx.totalActiveSize += meta.Size
-	}
+	idx.account(meta)
 
 	copied := *meta
 	idx.files[meta.FileID] = &copied
@@ -138,7 +210,7 @@ func (idx *Index) Update(meta *model.FileMetadata) error {
 
 // Remove удаляет файл из индекса по file_id.
 // Возвращает true, если файл был найден и удалён.
-// Если файл был active — уменьшает totalActiveSize.
+// Если файл был active — уменьшает totalActiveSize и usage.
 func (idx *Index) Remove(fileID string) bool {
 	idx.mu.Lock()
 	defer idx.mu.Unlock()
@@ -149,9 +221,7 @@ func (idx *Index) Remove(fileID string) bool {
 	}
 
 	// Если удаляемый файл был active — вычитаем его размер
-	if existing.Status == model.StatusActive {
-		idx.totalActiveSize -= existing.Size
-	}
+	idx.unaccount(existing)
 
 	delete(idx.files, fileID)
 	return true
@@ -182,15 +252,35 @@ func (idx *Index) Get(fileID string) *model.FileMetadata {
 // Возвращает срез метаданных и общее количество файлов (с учётом фильтра).
 // Файлы отсортированы по дате загрузки (новые первые).
 func (idx *Index) List(limit, offset int, statusFilter model.FileStatus) (items []*model.FileMetadata, total int) {
+	return idx.ListUpdatedSince(limit, offset, statusFilter, time.Time{})
+}
+
+// ListUpdatedSince — List с дополнительным фильтром по времени изменения
+// метаданных: только файлы с ChangedAt() >= since (нулевое since — без фильтра).
+func (idx *Index) ListUpdatedSince(limit, offset int, statusFilter model.FileStatus, since time.Time) (items []*model.FileMetadata, total int) {
+	return idx.ListMatching(limit, offset, statusFilter, since, nil)
+}
+
+// ListMatching — ListUpdatedSince с дополнительным фильтром match
+// (например, grants токена). nil match — без фильтра.
+func (idx *Index) ListMatching(
+	limit, offset int, statusFilter model.FileStatus, since time.Time, match func(*model.FileMetadata) bool,
+) (items []*model.FileMetadata, total int) {
 	idx.mu.RLock()
 	defer idx.mu.RUnlock()
 
-	// Собираем все файлы с учётом фильтра
+	// Собираем все файлы с учётом фильтров
 	var filtered []*model.FileMetadata
 	for _, meta := range idx.files {
 		if statusFilter != "" && meta.Status != statusFilter {
 			continue
 		}
+		if !since.IsZero() && meta.ChangedAt().Before(since) {
+			continue
+		}
+		if match != nil && !match(meta) {
+			continue
+		}
 		copied := *meta
 		filtered = append(filtered, &copied)
 	}
@@ -243,3 +333,54 @@ func (idx *Index) TotalActiveSize() int64 {
 	defer idx.mu.RUnlock()
 	return idx.totalActiveSize
 }
+
+// SubjectUsage возвращает суммарный размер и количество active файлов,
+// загруженных субъектом (uploaded_by).
+func (idx *Index) SubjectUsage(subject string) Usage {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.usage[subject]
+}
+
+// UsageBySubject возвращает копию счётчиков usage по всем субъектам
+// с active файлами.
+func (idx *Index) UsageBySubject() map[string]Usage {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	result := make(map[string]Usage, len(idx.usage))
+	for subject, u := range idx.usage {
+		result[subject] = u
+	}
+	return result
+}
+
+// account учитывает active файл в totalActiveSize и usage.
+// Вызывается под idx.mu.
+func (idx *Index) account(meta *model.FileMetadata) {
+	if meta.Status != model.StatusActive {
+		return
+	}
+	idx.totalActiveSize += meta.Size
+	u := idx.usage[meta.UploadedBy]
+	u.Bytes += meta.Size
+	u.Files++
+	idx.usage[meta.UploadedBy] = u
+}
+
+// unaccount исключает active файл из totalActiveSize и usage.
+// Вызывается под idx.mu.
+func (idx *Index) unaccount(meta *model.FileMetadata) {
+	if meta.Status != model.StatusActive {
+		return
+	}
+	idx.totalActiveSize -= meta.Size
+	u := idx.usage[meta.UploadedBy]
+	u.Bytes -= meta.Size
+	u.Files--
+	if u.Files <= 0 {
+		delete(idx.usage, meta.UploadedBy)
+		return
+	}
+	idx.usage[meta.UploadedBy] = u
+}
@@ -261,6 +261,73 @@ func TestList_WithPagination(t *testing.T) {
 	}
 }
 
+// TestListUpdatedSince проверяет фильтр по времени изменения метаданных,
+// включая файлы без UpdatedAt (учитывается UploadedAt).
+func TestListUpdatedSince(t *testing.T) {
+	idx := New(testLogger())
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	old := createTestMetadata("old", model.StatusActive, base.Add(-48*time.Hour))
+	legacy := createTestMetadata("legacy", model.StatusActive, base.Add(time.Hour)) // без UpdatedAt
+	changed := createTestMetadata("changed", model.StatusDeleted, base.Add(-72*time.Hour))
+	changed.UpdatedAt = base
+	idx.Add(old)
+	idx.Add(legacy)
+	idx.Add(changed)
+
+	items, total := idx.ListUpdatedSince(0, 0, "", base)
+	if total != 2 {
+		t.Fatalf("total: ожидалось 2, получено %d", total)
+	}
+	got := map[string]bool{}
+	for _, m := range items {
+		got[m.FileID] = true
+	}
+	if !got["legacy"] || !got["changed"] {
+		t.Errorf("ожидались legacy и changed, получено %v", got)
+	}
+
+	// Комбинация с фильтром по статусу
+	if _, total := idx.ListUpdatedSince(0, 0, model.StatusActive, base); total != 1 {
+		t.Errorf("active с updated_since: ожидалось 1, получено %d", total)
+	}
+
+	// Нулевое since — без фильтра
+	if _, total := idx.ListUpdatedSince(0, 0, "", time.Time{}); total != 3 {
+		t.Errorf("без фильтра: ожидалось 3, получено %d", total)
+	}
+}
+
+// TestListMatching проверяет дополнительный фильтр (grants токена)
+// и пагинацию по отфильтрованному списку.
+func TestListMatching(t *testing.T) {
+	idx := New(testLogger())
+
+	now := time.Now()
+	for i := range 5 {
+		m := createTestMetadata(fmt.Sprintf("f%d", i), model.StatusActive, now.Add(time.Duration(i)*time.Minute))
+		if i%2 == 0 {
+			m.UploadedBy = "sa_ingest"
+		}
+		idx.Add(m)
+	}
+
+	own := func(m *model.FileMetadata) bool { return m.UploadedBy == "sa_ingest" }
+	items, total := idx.ListMatching(2, 0, "", time.Time{}, own)
+	if total != 3 || len(items) != 2 {
+		t.Fatalf("ожидалось total=3 и 2 элемента, получено total=%d, %d", total, len(items))
+	}
+	for _, m := range items {
+		if m.UploadedBy != "sa_ingest" {
+			t.Errorf("файл %s не проходит фильтр", m.FileID)
+		}
+	}
+
+	if _, total := idx.ListMatching(0, 0, "", time.Time{}, nil); total != 5 {
+		t.Errorf("nil match: ожидалось 5, получено %d", total)
+	}
+}
+
 // TestList_WithStatusFilter проверяет фильтрацию по статусу.
 func TestList_WithStatusFilter(t *testing.T) {
 	idx := New(testLogger())
@@ -389,6 +456,80 @@ func TestBuildFromDir(t *testing.T) {
 	}
 }
 
+// TestBuildFromDirs проверяет построение индекса из нескольких директорий данных.
+// Копия файла на двух дисках учитывается в totalActiveSize один раз.
+func TestBuildFromDirs(t *testing.T) {
+	dirs := []string{t.TempDir(), t.TempDir()}
+
+	writeMeta := func(dir, id, name string) {
+		meta := &model.FileMetadata{
+			FileID:           id,
+			OriginalFilename: name,
+			StoragePath:      name,
+			ContentType:      "application/octet-stream",
+			Size:             100,
+			Checksum:         "abc",
+			UploadedBy:       "admin",
+			UploadedAt:       time.Now().UTC(),
+			Status:           model.StatusActive,
+			RetentionPolicy:  model.RetentionPermanent,
+		}
+		if err := attr.Write(filepath.Join(dir, name+attr.AttrSuffix), meta); err != nil {
+			t.Fatalf("ошибка создания attr.json: %v", err)
+		}
+	}
+
+	writeMeta(dirs[0], "id-1", "file1.txt")
+	writeMeta(dirs[1], "id-2", "file2.txt")
+	// Копия после прерванного переноса
+	writeMeta(dirs[1], "id-1", "file1.txt")
+
+	idx := New(testLogger())
+	if err := idx.BuildFromDirs(append(dirs, filepath.Join(dirs[0], "missing"))); err != nil {
+		t.Fatalf("ошибка BuildFromDirs: %v", err)
+	}
+
+	if idx.Count() != 2 {
+		t.Errorf("ожидалось 2 файла, получено %d", idx.Count())
+	}
+	if got := idx.TotalActiveSize(); got != 200 {
+		t.Errorf("TotalActiveSize: ожидалось 200, получено %d", got)
+	}
+}
+
+// TestRebuildFromDirs_KeepsFailedDirFiles проверяет, что пересборка сохраняет
+// записи файлов недоступной директории и удаляет пропавшие с доступной.
+func TestRebuildFromDirs_KeepsFailedDirFiles(t *testing.T) {
+	dirs := []string{t.TempDir(), t.TempDir()}
+
+	idx := New(testLogger())
+	for _, meta := range []*model.FileMetadata{
+		{FileID: "id-1", StoragePath: "file1.txt", Size: 100, Status: model.StatusActive},
+		{FileID: "id-2", StoragePath: "file2.txt", Size: 100, Status: model.StatusActive},
+	} {
+		idx.Add(meta)
+	}
+
+	// file1.txt пропал с доступной директории, file2.txt — на отказавшей
+	diskOf := map[string]string{"file1.txt": dirs[0], "file2.txt": dirs[1]}
+	err := idx.RebuildFromDirs(dirs, []string{dirs[1]}, func(storagePath string) string {
+		return diskOf[storagePath]
+	})
+	if err != nil {
+		t.Fatalf("ошибка RebuildFromDirs: %v", err)
+	}
+
+	if idx.Get("id-1") != nil {
+		t.Error("файл доступной директории должен быть удалён из индекса")
+	}
+	if idx.Get("id-2") == nil {
+		t.Error("файл отказавшей директории должен остаться в индексе")
+	}
+	if got := idx.TotalActiveSize(); got != 100 {
+		t.Errorf("TotalActiveSize: ожидалось 100, получено %d", got)
+	}
+}
+
 // TestBuildFromDir_EmptyDir проверяет построение из пустой директории.
 func TestBuildFromDir_EmptyDir(t *testing.T) {
 	idx := New(testLogger())
@@ -748,3 +889,68 @@ func TestList_PaginationWithFilter(t *testing.T) {
 		t.Errorf("last page: ожидалось 1, получено %d", len(items))
 	}
 }
+
+// TestSubjectUsage проверяет инкрементальный учёт usage по субъектам.
+func TestSubjectUsage(t *testing.T) {
+	idx := New(testLogger())
+
+	a1 := createTestMetadata("a1", model.StatusActive, time.Now())
+	a1.UploadedBy = "alice"
+	a1.Size = 100
+	a2 := createTestMetadata("a2", model.StatusActive, time.Now())
+	a2.UploadedBy = "alice"
+	a2.Size = 200
+	b1 := createTestMetadata("b1", model.StatusActive, time.Now())
+	b1.UploadedBy = "bob"
+	b1.Size = 50
+	idx.Add(a1)
+	idx.Add(a2)
+	idx.Add(b1)
+
+	if got := idx.SubjectUsage("alice"); got.Bytes != 300 || got.Files != 2 {
+		t.Errorf("alice: ожидалось 300/2, получено %d/%d", got.Bytes, got.Files)
+	}
+
+	// active → deleted: файл перестаёт учитываться
+	deleted := *a2
+	deleted.Status = model.StatusDeleted
+	if err := idx.Update(&deleted); err != nil {
+		t.Fatalf("ошибка Update: %v", err)
+	}
+	if got := idx.SubjectUsage("alice"); got.Bytes != 100 || got.Files != 1 {
+		t.Errorf("alice после удаления: ожидалось 100/1, получено %d/%d", got.Bytes, got.Files)
+	}
+
+	// Последний active файл субъекта — субъект исключается из usage
+	idx.Remove("b1")
+	usage := idx.UsageBySubject()
+	if _, ok := usage["bob"]; ok {
+		t.Error("bob без active файлов не должен присутствовать в usage")
+	}
+	if len(usage) != 1 {
+		t.Errorf("ожидался 1 субъект, получено %d", len(usage))
+	}
+}
+
+// TestSubjectUsage_BuildFromDir проверяет пересчёт usage при построении индекса.
+func TestSubjectUsage_BuildFromDir(t *testing.T) {
+	dir := t.TempDir()
+
+	for i, status := range []model.FileStatus{model.StatusActive, model.StatusActive, model.StatusExpired} {
+		meta := createTestMetadata(fmt.Sprintf("f%d", i), status, time.Now())
+		meta.Size = 10
+		if err := attr.Write(attr.AttrFilePath(filepath.Join(dir, meta.StoragePath)), meta); err != nil {
+			t.Fatalf("ошибка записи attr.json: %v", err)
+		}
+	}
+
+	idx := New(testLogger())
+	idx.Add(createTestMetadata("stale", model.StatusActive, time.Now()))
+	if err := idx.BuildFromDir(dir); err != nil {
+		t.Fatalf("ошибка построения индекса: %v", err)
+	}
+
+	if got := idx.SubjectUsage("admin"); got.Bytes != 20 || got.Files != 2 {
+		t.Errorf("admin: ожидалось 20/2, получено %d/%d", got.Bytes, got.Files)
+	}
+}
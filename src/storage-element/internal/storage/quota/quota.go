@@ -0,0 +1,236 @@
+// Пакет quota — квоты загрузки по субъектам JWT.
+//
+// Квоты задаются в JSON-файле на SE (SE_QUOTA_FILE, по умолчанию
+// {SE_DATA_DIR}/.quotas.json) и ограничивают объём и количество active
+// файлов, загруженных одним субъектом (sub из JWT).
+//
+// Формат файла:
+//
+//	{
+//	  "default":  {"max_bytes": 10737418240, "max_files": 100000},
+//	  "subjects": {"sa_ingest_a1b2": {"max_bytes": 107374182400}},
+//	  "groups":   {"files:write": {"max_bytes": 53687091200}}
+//	}
+//
+// Лимит субъекта определяется в порядке приоритета: запись в subjects →
+// записи в groups для scopes токена (при нескольких совпадениях берётся
+// наибольший лимит) → default. Нулевое значение поля — без ограничения.
+//
+// Отсутствие файла означает отсутствие квот. Файл перечитывается
+// при изменении времени модификации (Reload).
+package quota
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// Источники лимита квоты.
+const (
+	SourceSubject = "subject"
+	SourceGroup   = "group"
+	SourceDefault = "default"
+)
+
+// Limit — лимиты квоты. Нулевое значение поля — без ограничения.
+type Limit struct {
+	// MaxBytes — максимальный суммарный размер active файлов (байты)
+	MaxBytes int64 `json:"max_bytes,omitempty"`
+	// MaxFiles — максимальное количество active файлов
+	MaxFiles int `json:"max_files,omitempty"`
+}
+
+// Config — содержимое файла квот.
+type Config struct {
+	// Default — лимит для субъектов без собственной записи и групп
+	Default *Limit `json:"default,omitempty"`
+	// Subjects — лимиты по sub из JWT
+	Subjects map[string]Limit `json:"subjects,omitempty"`
+	// Groups — лимиты по scope из JWT
+	Groups map[string]Limit `json:"groups,omitempty"`
+}
+
+// Resolved — лимит, применимый к субъекту.
+type Resolved struct {
+	Limit
+	// Source — источник лимита: subject, group или default
+	Source string
+}
+
+// Store — потокобезопасное хранилище квот, загружаемое из файла.
+type Store struct {
+	path   string
+	logger *slog.Logger
+
+	mu      sync.RWMutex
+	cfg     Config
+	modTime time.Time
+}
+
+// New создаёт хранилище квот для указанного файла. Для чтения файла вызовите Load.
+func New(path string, logger *slog.Logger) *Store {
+	return &Store{
+		path:   path,
+		logger: logger.With(slog.String("component", "quota")),
+	}
+}
+
+// Path возвращает путь к файлу квот.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Load читает файл квот. Отсутствие файла не является ошибкой —
+// квоты сбрасываются. При ошибке разбора текущие квоты сохраняются.
+func (s *Store) Load() error {
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		s.mu.Lock()
+		s.cfg = Config{}
+		s.modTime = time.Time{}
+		s.mu.Unlock()
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("ошибка чтения файла квот %s: %w", s.path, err)
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("ошибка чтения файла квот %s: %w", s.path, err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("ошибка разбора файла квот %s: %w", s.path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return fmt.Errorf("некорректный файл квот %s: %w", s.path, err)
+	}
+
+	s.mu.Lock()
+	s.cfg = cfg
+	s.modTime = info.ModTime()
+	s.mu.Unlock()
+
+	s.logger.Info("Квоты загружены",
+		slog.String("path", s.path),
+		slog.Int("subjects", len(cfg.Subjects)),
+		slog.Int("groups", len(cfg.Groups)),
+		slog.Bool("default", cfg.Default != nil),
+	)
+	return nil
+}
+
+// Reload перечитывает файл квот, если он изменился с момента последней загрузки.
+// Возвращает true, если квоты были перечитаны.
+func (s *Store) Reload() (bool, error) {
+	var modTime time.Time
+	info, err := os.Stat(s.path)
+	switch {
+	case err == nil:
+		modTime = info.ModTime()
+	case !errors.Is(err, os.ErrNotExist):
+		return false, fmt.Errorf("ошибка чтения файла квот %s: %w", s.path, err)
+	}
+
+	s.mu.RLock()
+	unchanged := modTime.Equal(s.modTime)
+	s.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	if err := s.Load(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Resolve возвращает лимит для субъекта с указанными scopes.
+// Второе значение — false, если квота к субъекту не применяется.
+func (s *Store) Resolve(subject string, scopes []string) (Resolved, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if limit, ok := s.cfg.Subjects[subject]; ok {
+		return Resolved{Limit: limit, Source: SourceSubject}, true
+	}
+
+	var (
+		merged  Limit
+		matched bool
+	)
+	for _, scope := range scopes {
+		limit, ok := s.cfg.Groups[scope]
+		if !ok {
+			continue
+		}
+		if !matched {
+			merged = limit
+			matched = true
+			continue
+		}
+		merged.MaxBytes = maxLimit(merged.MaxBytes, limit.MaxBytes)
+		merged.MaxFiles = int(maxLimit(int64(merged.MaxFiles), int64(limit.MaxFiles)))
+	}
+	if matched {
+		return Resolved{Limit: merged, Source: SourceGroup}, true
+	}
+
+	if s.cfg.Default != nil {
+		return Resolved{Limit: *s.cfg.Default, Source: SourceDefault}, true
+	}
+	return Resolved{}, false
+}
+
+// Subjects возвращает субъекты с собственной записью в файле квот.
+func (s *Store) Subjects() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	subjects := make([]string, 0, len(s.cfg.Subjects))
+	for subject := range s.cfg.Subjects {
+		subjects = append(subjects, subject)
+	}
+	return subjects
+}
+
+// validate проверяет, что лимиты не отрицательны.
+func (c *Config) validate() error {
+	check := func(name string, l Limit) error {
+		if l.MaxBytes < 0 || l.MaxFiles < 0 {
+			return fmt.Errorf("отрицательный лимит для %s", name)
+		}
+		return nil
+	}
+	if c.Default != nil {
+		if err := check("default", *c.Default); err != nil {
+			return err
+		}
+	}
+	for subject, l := range c.Subjects {
+		if err := check("subject "+subject, l); err != nil {
+			return err
+		}
+	}
+	for scope, l := range c.Groups {
+		if err := check("group "+scope, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxLimit возвращает наибольший из двух лимитов (0 — без ограничения).
+func maxLimit(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return max(a, b)
+}
@@ -0,0 +1,123 @@
+package quota
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestStore создаёт Store с файлом квот заданного содержимого.
+func newTestStore(t *testing.T, content string) (*Store, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "quotas.json")
+	if content != "" {
+		if err := os.WriteFile(path, []byte(content), 0o640); err != nil {
+			t.Fatalf("ошибка записи файла квот: %v", err)
+		}
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	s := New(path, logger)
+	if err := s.Load(); err != nil {
+		t.Fatalf("ошибка загрузки квот: %v", err)
+	}
+	return s, path
+}
+
+// TestResolve_Priority проверяет порядок приоритета: subject → groups → default.
+func TestResolve_Priority(t *testing.T) {
+	s, _ := newTestStore(t, `{
+		"default":  {"max_bytes": 100, "max_files": 10},
+		"subjects": {"alice": {"max_bytes": 1000}},
+		"groups":   {"files:write": {"max_bytes": 500, "max_files": 50}, "bulk": {"max_bytes": 0, "max_files": 20}}
+	}`)
+
+	limit, ok := s.Resolve("alice", []string{"files:write"})
+	if !ok || limit.Source != SourceSubject || limit.MaxBytes != 1000 || limit.MaxFiles != 0 {
+		t.Errorf("alice: получено %+v", limit)
+	}
+
+	limit, ok = s.Resolve("bob", []string{"files:read", "files:write"})
+	if !ok || limit.Source != SourceGroup || limit.MaxBytes != 500 || limit.MaxFiles != 50 {
+		t.Errorf("bob: получено %+v", limit)
+	}
+
+	// Несколько групп — наибольший лимит, 0 (без ограничения) побеждает
+	limit, _ = s.Resolve("carol", []string{"files:write", "bulk"})
+	if limit.MaxBytes != 0 || limit.MaxFiles != 50 {
+		t.Errorf("carol: ожидалось 0/50, получено %d/%d", limit.MaxBytes, limit.MaxFiles)
+	}
+
+	limit, ok = s.Resolve("dave", nil)
+	if !ok || limit.Source != SourceDefault || limit.MaxBytes != 100 {
+		t.Errorf("dave: получено %+v", limit)
+	}
+}
+
+// TestLoad_MissingFile проверяет, что отсутствие файла означает отсутствие квот.
+func TestLoad_MissingFile(t *testing.T) {
+	s, _ := newTestStore(t, "")
+
+	if _, ok := s.Resolve("alice", []string{"files:write"}); ok {
+		t.Error("без файла квот квота не должна применяться")
+	}
+}
+
+// TestLoad_Invalid проверяет отклонение некорректного файла.
+func TestLoad_Invalid(t *testing.T) {
+	s, path := newTestStore(t, `{"subjects": {"alice": {"max_bytes": 10}}}`)
+
+	for _, content := range []string{`{not json`, `{"default": {"max_files": -1}}`} {
+		if err := os.WriteFile(path, []byte(content), 0o640); err != nil {
+			t.Fatalf("ошибка записи файла квот: %v", err)
+		}
+		if err := s.Load(); err == nil {
+			t.Errorf("ожидалась ошибка для %q", content)
+		}
+	}
+
+	// Действуют ранее загруженные квоты
+	if limit, ok := s.Resolve("alice", nil); !ok || limit.MaxBytes != 10 {
+		t.Errorf("ожидалась прежняя квота, получено %+v", limit)
+	}
+}
+
+// TestReload проверяет перечитывание файла только при изменении.
+func TestReload(t *testing.T) {
+	s, path := newTestStore(t, `{"default": {"max_files": 1}}`)
+
+	reloaded, err := s.Reload()
+	if err != nil || reloaded {
+		t.Fatalf("без изменений: reloaded=%v, err=%v", reloaded, err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"default": {"max_files": 2}}`), 0o640); err != nil {
+		t.Fatalf("ошибка записи файла квот: %v", err)
+	}
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatalf("ошибка Chtimes: %v", err)
+	}
+
+	reloaded, err = s.Reload()
+	if err != nil || !reloaded {
+		t.Fatalf("после изменения: reloaded=%v, err=%v", reloaded, err)
+	}
+	if limit, _ := s.Resolve("alice", nil); limit.MaxFiles != 2 {
+		t.Errorf("MaxFiles: ожидалось 2, получено %d", limit.MaxFiles)
+	}
+
+	// Удаление файла сбрасывает квоты
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("ошибка удаления: %v", err)
+	}
+	if reloaded, err = s.Reload(); err != nil || !reloaded {
+		t.Fatalf("после удаления: reloaded=%v, err=%v", reloaded, err)
+	}
+	if _, ok := s.Resolve("alice", nil); ok {
+		t.Error("после удаления файла квота не должна применяться")
+	}
+}
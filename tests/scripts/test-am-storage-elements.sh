@@ -135,23 +135,39 @@ else
     test_fail "Тест 19: SE update → HTTP ${CODE} (ожидался 200)"
 fi
 
-# ---------- Тест 20: POST /api/v1/storage-elements/{id}/sync — ручная синхронизация ----------
+# ---------- Тест 20: POST /api/v1/storage-elements/{id}/sync — фоновая синхронизация ----------
 log_info "Тест 20: POST /api/v1/storage-elements/{id}/sync"
 RESPONSE=$(http_post "$AM_URL" "$ADMIN_TOKEN" "/api/v1/storage-elements/${SE_ID}/sync" "")
 CODE=$(get_response_code "$RESPONSE")
 BODY=$(get_response_body "$RESPONSE")
 
-if [[ "$CODE" == "200" ]]; then
-    HAS_SE=$(echo "$BODY" | jq 'has("storage_element")')
-    HAS_SYNC=$(echo "$BODY" | jq 'has("file_sync")')
-    if [[ "$HAS_SE" == "true" && "$HAS_SYNC" == "true" ]]; then
-        FILES_ON_SE=$(echo "$BODY" | jq -r '.file_sync.files_on_se')
-        test_pass "Тест 20: sync → storage_element и file_sync присутствуют, files_on_se=${FILES_ON_SE}"
+# 409 — синхронизация уже выполняется (например, запущенная при регистрации SE)
+if [[ "$CODE" == "202" || "$CODE" == "409" ]]; then
+    JOB_ID=$(echo "$BODY" | jq -r '.id // empty')
+    if [[ "$CODE" == "409" ]]; then
+        RESPONSE=$(http_get "$AM_URL" "$ADMIN_TOKEN" "/api/v1/sync-jobs?storage_element_id=${SE_ID}&status=running")
+        JOB_ID=$(get_response_body "$RESPONSE" | jq -r '.items[0].id // empty')
+    fi
+
+    # Ожидаем завершения задачи (до 30 секунд)
+    JOB_STATUS=""
+    for _ in $(seq 1 30); do
+        [[ -z "$JOB_ID" ]] && break
+        RESPONSE=$(http_get "$AM_URL" "$ADMIN_TOKEN" "/api/v1/sync-jobs/${JOB_ID}")
+        JOB_BODY=$(get_response_body "$RESPONSE")
+        JOB_STATUS=$(echo "$JOB_BODY" | jq -r '.status')
+        [[ "$JOB_STATUS" != "queued" && "$JOB_STATUS" != "running" ]] && break
+        sleep 1
+    done
+
+    if [[ "$JOB_STATUS" == "succeeded" ]]; then
+        FILES_ON_SE=$(echo "$JOB_BODY" | jq -r '.files_on_se')
+        test_pass "Тест 20: sync-задача ${JOB_ID} завершена, files_on_se=${FILES_ON_SE}"
     else
-        test_fail "Тест 20: sync → has_se=${HAS_SE}, has_sync=${HAS_SYNC}"
+        test_fail "Тест 20: sync-задача ${JOB_ID:-?} → status=${JOB_STATUS:-?} (ожидался succeeded)"
     fi
 else
-    test_fail "Тест 20: sync → HTTP ${CODE} (ожидался 200)"
+    test_fail "Тест 20: sync → HTTP ${CODE} (ожидался 202)"
     if echo "$BODY" | jq . >/dev/null 2>&1; then
         log_fail "  Ответ: $(echo "$BODY" | jq -c '.')"
     fi